@@ -16,9 +16,15 @@ import (
 	"github.com/flowline-io/flowbot/cmd/agent/config"
 	"github.com/flowline-io/flowbot/cmd/agent/run"
 	"github.com/flowline-io/flowbot/pkg/agent/dcg"
+	agentevent "github.com/flowline-io/flowbot/pkg/agent/event"
 	"github.com/flowline-io/flowbot/pkg/flog"
 )
 
+const (
+	formatText       = "text"
+	formatStreamJSON = "stream-json"
+)
+
 func main() {
 	os.Exit(runMain(os.Args[1:]))
 }
@@ -27,27 +33,31 @@ func runMain(args []string) int {
 	fs := flag.NewFlagSet("flowbot-agent", flag.ContinueOnError)
 	fs.SetOutput(os.Stderr)
 
-	printMode := fs.Bool("p", false, "print mode (non-interactive; required)")
+	printMode := fs.Bool("p", false, "print mode: run one prompt and exit (default: interactive REPL)")
 	printLong := fs.Bool("print", false, "alias for -p")
 	force := fs.Bool("force", false, "allow file writes and terminal commands")
 	_ = fs.Bool("trust", false, "accepted for Cursor CLI compatibility (no-op in v1)")
 	workspace := fs.String("workspace", "", "workspace root (default: cwd)")
-	outputFormat := fs.String("output-format", "text", "output format (text only in v1)")
+	outputFormat := fs.String("output-format", formatText, "output format: text or stream-json")
+	resume := fs.String("resume", "", "resume the session with this id")
+	sessionDir := fs.String("session-dir", "", "session JSONL directory (default: ~/.config/flowbot/agent/sessions)")
 	cfgPath := fs.String("config", "agent.yaml", "path to agent.yaml")
 	logLevel := fs.String("log-level", "info", "log level: debug, info, warn, error")
-	timeout := fs.Duration("timeout", 30*time.Minute, "run timeout")
+	timeout := fs.Duration("timeout", 30*time.Minute, "per-turn timeout")
 
 	if err := fs.Parse(args); err != nil {
 		return 2
 	}
 
-	flog.Init(flog.Config{Level: *logLevel})
+	flog.Init(flog.Config{Level: *logLevel, Output: os.Stderr})
 
 	opts, err := parseCLI(cliInput{
 		Print:        *printMode || *printLong,
 		Force:        *force,
 		Workspace:    *workspace,
 		OutputFormat: *outputFormat,
+		Resume:       *resume,
+		SessionDir:   *sessionDir,
 		PromptArgs:   fs.Args(),
 	})
 	if err != nil {
@@ -67,16 +77,47 @@ func runMain(args []string) int {
 
 	dcg.Init()
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	// The REPL handles SIGINT per turn so Ctrl-C stops the agent, not the session.
+	signals := []os.Signal{syscall.SIGTERM}
+	if !opts.Interactive {
+		signals = append(signals, os.Interrupt)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), signals...)
 	defer stop()
 
-	result, err := run.Execute(ctx, run.Options{
-		Config:    cfg,
-		Workspace: opts.Workspace,
-		Prompt:    opts.Prompt,
-		Force:     opts.Force,
-		Timeout:   *timeout,
+	sess, err := run.Open(ctx, run.Options{
+		Config:     cfg,
+		Workspace:  opts.Workspace,
+		Force:      opts.Force,
+		Timeout:    *timeout,
+		SessionDir: opts.SessionDir,
+		SessionID:  opts.Resume,
 	})
+	if err != nil {
+		flog.Error(fmt.Errorf("open session: %w", err))
+		return 1
+	}
+
+	if opts.Interactive {
+		return runREPL(ctx, sess, replIO{In: os.Stdin, Out: os.Stdout, Err: os.Stderr}, opts)
+	}
+	return runPrint(ctx, sess, opts)
+}
+
+func runPrint(ctx context.Context, sess *run.Session, opts parsedCLI) int {
+	if opts.Format == formatStreamJSON {
+		enc := run.NewStreamJSON(os.Stdout, sess.ID())
+		_ = enc.Session(sess.Resumed())
+		result, err := sess.Turn(ctx, opts.Prompt, func(ev agentevent.Event) { _ = enc.Event(ev) })
+		_ = enc.Result(result, err)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return 1
+		}
+		return 0
+	}
+
+	result, err := sess.Turn(ctx, opts.Prompt, nil)
+	flog.Info("flowbot-agent session id=%s (resume with --resume %s)", sess.ID(), sess.ID())
 	if err != nil && !errors.Is(err, context.Canceled) {
 		flog.Error(fmt.Errorf("run failed: %w", err))
 		if result.Text != "" {
@@ -93,28 +134,32 @@ type cliInput struct {
 	Force        bool
 	Workspace    string
 	OutputFormat string
+	Resume       string
+	SessionDir   string
 	PromptArgs   []string
 }
 
 type parsedCLI struct {
-	Force     bool
-	Workspace string
-	Prompt    string
+	Interactive bool
+	Force       bool
+	Workspace   string
+	Format      string
+	Resume      string
+	SessionDir  string
+	// Prompt is required in print mode; in the REPL it becomes the first turn.
+	Prompt string
 }
 
 func parseCLI(in cliInput) (parsedCLI, error) {
-	if !in.Print {
-		return parsedCLI{}, fmt.Errorf("headless mode requires -p / --print")
-	}
 	format := strings.ToLower(strings.TrimSpace(in.OutputFormat))
 	if format == "" {
-		format = "text"
+		format = formatText
 	}
-	if format != "text" {
-		return parsedCLI{}, fmt.Errorf("unsupported --output-format %q (v1 supports text only)", in.OutputFormat)
+	if format != formatText && format != formatStreamJSON {
+		return parsedCLI{}, fmt.Errorf("unsupported --output-format %q (use text or stream-json)", in.OutputFormat)
 	}
 	prompt := strings.TrimSpace(strings.Join(in.PromptArgs, " "))
-	if prompt == "" {
+	if in.Print && prompt == "" {
 		return parsedCLI{}, fmt.Errorf("prompt is required")
 	}
 	ws := strings.TrimSpace(in.Workspace)
@@ -129,9 +174,26 @@ func parseCLI(in cliInput) (parsedCLI, error) {
 	if err != nil {
 		return parsedCLI{}, fmt.Errorf("workspace: %w", err)
 	}
+	dir := strings.TrimSpace(in.SessionDir)
+	if dir == "" {
+		dir, err = run.DefaultSessionDir()
+		if err != nil {
+			return parsedCLI{}, fmt.Errorf("session dir: %w", err)
+		}
+	}
+	resume := strings.TrimSpace(in.Resume)
+	if resume != "" {
+		if _, err := run.SessionPath(dir, resume); err != nil {
+			return parsedCLI{}, err
+		}
+	}
 	return parsedCLI{
-		Force:     in.Force,
-		Workspace: abs,
-		Prompt:    prompt,
+		Interactive: !in.Print,
+		Force:       in.Force,
+		Workspace:   abs,
+		Format:      format,
+		Resume:      resume,
+		SessionDir:  dir,
+		Prompt:      prompt,
 	}, nil
 }
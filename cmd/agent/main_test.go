@@ -17,16 +17,32 @@ func TestParseCLI(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "hello world", got.Prompt)
 	assert.NotEmpty(t, got.Workspace)
+	assert.False(t, got.Interactive)
+	assert.NotEmpty(t, got.SessionDir)
 
-	_, err = parseCLI(cliInput{Print: false, PromptArgs: []string{"x"}})
-	require.Error(t, err)
+	got, err = parseCLI(cliInput{Print: false, SessionDir: t.TempDir()})
+	require.NoError(t, err)
+	assert.True(t, got.Interactive)
+	assert.Equal(t, formatText, got.Format)
 
 	_, err = parseCLI(cliInput{Print: true, OutputFormat: "json", PromptArgs: []string{"x"}})
 	require.Error(t, err)
 
+	got, err = parseCLI(cliInput{Print: true, OutputFormat: "stream-json", PromptArgs: []string{"x"}})
+	require.NoError(t, err)
+	assert.Equal(t, formatStreamJSON, got.Format)
+
 	_, err = parseCLI(cliInput{Print: true, OutputFormat: "text"})
 	require.Error(t, err)
 
+	got, err = parseCLI(cliInput{Print: true, PromptArgs: []string{"x"}, Resume: "abc-123", SessionDir: "/tmp/s"})
+	require.NoError(t, err)
+	assert.Equal(t, "abc-123", got.Resume)
+	assert.Equal(t, "/tmp/s", got.SessionDir)
+
+	_, err = parseCLI(cliInput{Print: true, PromptArgs: []string{"x"}, Resume: "../etc/passwd"})
+	require.Error(t, err)
+
 	got, err = parseCLI(cliInput{
 		Print:        true,
 		Force:        true,
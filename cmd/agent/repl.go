@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/cmd/agent/run"
+	agentevent "github.com/flowline-io/flowbot/pkg/agent/event"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+)
+
+// turner is the part of *run.Session the REPL drives.
+type turner interface {
+	ID() string
+	Resumed() bool
+	Turn(ctx context.Context, prompt string, onEvent func(agentevent.Event)) (run.Result, error)
+}
+
+type replIO struct {
+	In  io.Reader
+	Out io.Writer
+	Err io.Writer
+}
+
+// maxInputLine bounds one REPL input line (a stream-json prompt may be large).
+const maxInputLine = 4 << 20
+
+// runREPL reads one prompt per input line and runs it as the next turn of the
+// session until EOF or /exit. In stream-json mode no prompt or banner is
+// printed; a line holding a JSON object with a "prompt" string is decoded so
+// driving tools can send prompts that contain newlines.
+func runREPL(ctx context.Context, sess turner, rio replIO, opts parsedCLI) int {
+	streamJSON := opts.Format == formatStreamJSON
+	var enc *run.StreamJSON
+	if streamJSON {
+		enc = run.NewStreamJSON(rio.Out, sess.ID())
+		_ = enc.Session(sess.Resumed())
+	} else {
+		state := "new"
+		if sess.Resumed() {
+			state = "resumed"
+		}
+		_, _ = fmt.Fprintf(rio.Err, "flowbot-agent session %s (%s). Type /exit to quit.\n", sess.ID(), state)
+	}
+
+	turn := func(prompt string) {
+		turnCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+		if streamJSON {
+			result, err := sess.Turn(turnCtx, prompt, func(ev agentevent.Event) { _ = enc.Event(ev) })
+			_ = enc.Result(result, err)
+			return
+		}
+		printer := &textPrinter{out: rio.Out, err: rio.Err}
+		result, err := sess.Turn(turnCtx, prompt, printer.event)
+		printer.finish(result, err, turnCtx.Err() != nil)
+	}
+
+	if opts.Prompt != "" {
+		turn(opts.Prompt)
+	}
+
+	scanner := bufio.NewScanner(rio.In)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxInputLine)
+	for {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if !streamJSON {
+			_, _ = fmt.Fprint(rio.Err, "> ")
+		}
+		if !scanner.Scan() {
+			break
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if streamJSON {
+			line = decodeStreamPrompt(line)
+		}
+		switch line {
+		case "":
+			continue
+		case "/exit", "/quit":
+			return 0
+		}
+		turn(line)
+	}
+	if err := scanner.Err(); err != nil {
+		_, _ = fmt.Fprintf(rio.Err, "read input: %v\n", err)
+		return 1
+	}
+	if !streamJSON {
+		_, _ = fmt.Fprintln(rio.Err)
+	}
+	return 0
+}
+
+func decodeStreamPrompt(line string) string {
+	if !strings.HasPrefix(line, "{") {
+		return line
+	}
+	var in struct {
+		Prompt string `json:"prompt"`
+	}
+	if err := sonic.UnmarshalString(line, &in); err != nil || in.Prompt == "" {
+		return line
+	}
+	return strings.TrimSpace(in.Prompt)
+}
+
+// textPrinter streams assistant text to out and tool activity to err.
+type textPrinter struct {
+	out      io.Writer
+	err      io.Writer
+	streamed bool
+	midLine  bool
+}
+
+func (p *textPrinter) event(ev agentevent.Event) {
+	switch ev.Type {
+	case agentevent.TypeMessageUpdate:
+		if ev.TextDelta != "" {
+			_, _ = io.WriteString(p.out, ev.TextDelta)
+			p.streamed = true
+			p.midLine = true
+		}
+	case agentevent.TypeToolExecutionStart:
+		if call, ok := ev.ToolCall.(msg.ToolCallPart); ok {
+			p.endLine()
+			_, _ = fmt.Fprintf(p.err, "[tool] %s\n", call.Name)
+		}
+	}
+}
+
+// finish prints the final text when the model did not stream any deltas.
+func (p *textPrinter) finish(result run.Result, err error, interrupted bool) {
+	if !p.streamed && result.Text != "" {
+		_, _ = io.WriteString(p.out, result.Text)
+		p.midLine = true
+	}
+	p.endLine()
+	switch {
+	case interrupted:
+		_, _ = fmt.Fprintln(p.err, "interrupted")
+	case err != nil:
+		_, _ = fmt.Fprintf(p.err, "error: %v\n", err)
+	}
+}
+
+func (p *textPrinter) endLine() {
+	if p.midLine {
+		_, _ = fmt.Fprintln(p.out)
+		p.midLine = false
+	}
+}
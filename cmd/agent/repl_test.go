@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/cmd/agent/run"
+	agentevent "github.com/flowline-io/flowbot/pkg/agent/event"
+)
+
+type fakeSession struct {
+	prompts []string
+}
+
+func (f *fakeSession) ID() string    { return "s1" }
+func (f *fakeSession) Resumed() bool { return true }
+
+func (f *fakeSession) Turn(_ context.Context, prompt string, onEvent func(agentevent.Event)) (run.Result, error) {
+	f.prompts = append(f.prompts, prompt)
+	reply := "echo: " + prompt
+	onEvent(agentevent.Event{Type: agentevent.TypeMessageUpdate, TextDelta: reply})
+	return run.Result{SessionID: "s1", Text: reply}, nil
+}
+
+func TestRunREPL(t *testing.T) {
+	t.Run("text mode runs one turn per line until exit", func(t *testing.T) {
+		sess := &fakeSession{}
+		var out, errOut bytes.Buffer
+		code := runREPL(context.Background(), sess, replIO{
+			In:  strings.NewReader("first\n\nsecond\n/exit\nignored\n"),
+			Out: &out,
+			Err: &errOut,
+		}, parsedCLI{Format: formatText, Prompt: "zero"})
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, []string{"zero", "first", "second"}, sess.prompts)
+		assert.Equal(t, "echo: zero\necho: first\necho: second\n", out.String())
+		assert.Contains(t, errOut.String(), "session s1 (resumed)")
+	})
+
+	t.Run("stream-json mode emits NDJSON and decodes prompt objects", func(t *testing.T) {
+		sess := &fakeSession{}
+		var out bytes.Buffer
+		code := runREPL(context.Background(), sess, replIO{
+			In:  strings.NewReader("{\"prompt\":\"line one\\nline two\"}\nplain\n"),
+			Out: &out,
+			Err: &bytes.Buffer{},
+		}, parsedCLI{Format: formatStreamJSON})
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, []string{"line one\nline two", "plain"}, sess.prompts)
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 5)
+		var types []string
+		for _, line := range lines {
+			var decoded run.StreamLine
+			require.NoError(t, sonic.UnmarshalString(line, &decoded))
+			assert.Equal(t, "s1", decoded.SessionID)
+			types = append(types, decoded.Type)
+		}
+		assert.Equal(t, []string{"session", "message_update", "result", "message_update", "result"}, types)
+	})
+}
@@ -1,4 +1,4 @@
-// Package run executes one headless agent turn.
+// Package run executes headless agent turns against a resumable session.
 package run
 
 import (
@@ -18,6 +18,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/agent/hooks"
 	"github.com/flowline-io/flowbot/pkg/agent/loop"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/session"
 	"github.com/flowline-io/flowbot/pkg/agent/subagent"
 	"github.com/flowline-io/flowbot/pkg/agent/tool"
 	"github.com/flowline-io/flowbot/pkg/agent/tools/coding"
@@ -31,39 +32,72 @@ type Options struct {
 	Prompt    string
 	Force     bool
 	Timeout   time.Duration
+	// SessionDir stores session JSONL files. Empty keeps the session in memory.
+	SessionDir string
+	// SessionID resumes an existing session in SessionDir. Empty starts a new one.
+	SessionID string
+	// OnEvent receives every agent lifecycle event of a turn, in order.
+	OnEvent func(agentevent.Event)
 }
 
 // Result is the outcome of a headless run.
 type Result struct {
-	Text string
+	SessionID string
+	Text      string
+}
+
+// Session is a multi-turn agent conversation, optionally persisted as JSONL.
+type Session struct {
+	id      string
+	path    string
+	resumed bool
+	harness *harness.Harness
+	timeout time.Duration
 }
 
 // Execute runs one print-mode agent turn and returns final assistant text.
 func Execute(ctx context.Context, opts Options) (Result, error) {
-	if opts.Config == nil {
-		return Result{}, fmt.Errorf("config is required")
-	}
-	if err := opts.Config.Validate(); err != nil {
-		return Result{}, err
-	}
 	prompt := strings.TrimSpace(opts.Prompt)
 	if prompt == "" {
 		return Result{}, fmt.Errorf("prompt is required")
 	}
+	s, err := Open(ctx, opts)
+	if err != nil {
+		return Result{}, err
+	}
+	return s.Turn(ctx, prompt, opts.OnEvent)
+}
+
+// Open creates the agent harness and loads or starts its session.
+func Open(ctx context.Context, opts Options) (*Session, error) {
+	if opts.Config == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if err := opts.Config.Validate(); err != nil {
+		return nil, err
+	}
 	workspace := strings.TrimSpace(opts.Workspace)
 	if workspace == "" {
-		return Result{}, fmt.Errorf("workspace is required")
+		return nil, fmt.Errorf("workspace is required")
 	}
 	if opts.Timeout <= 0 {
 		opts.Timeout = 30 * time.Minute
 	}
 
-	runCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
-	defer cancel()
+	store, s, err := openStorage(opts.SessionDir, opts.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	sess := session.New(store)
+	systemPrompt := headlessSystemPrompt(workspace, opts.Force)
+	branch, err := sess.GetBranch(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("load session %s: %w", s.id, err)
+	}
 
 	model, err := newProxyModel(opts.Config)
 	if err != nil {
-		return Result{}, err
+		return nil, err
 	}
 
 	ws := coding.Workspace{
@@ -74,47 +108,85 @@ func Execute(ctx context.Context, opts Options) (Result, error) {
 	registry := tool.NewRegistry()
 	active, err := coding.RegisterHeadless(registry, ws, env.Default(), coding.HeadlessOptions{Force: opts.Force})
 	if err != nil {
-		return Result{}, fmt.Errorf("register tools: %w", err)
+		return nil, fmt.Errorf("register tools: %w", err)
 	}
 	registry.SetActive(active)
 
 	hookReg := hooks.NewRegistry()
 	registerDCGHook(hookReg)
 
-	h := harness.New(harness.Options{
+	// The prompt lives only in InitialState: the harness merges its own
+	// SystemPrompt into the state on every Prompt, which would repeat it once
+	// per turn in a multi-turn session.
+	s.timeout = opts.Timeout
+	s.harness = harness.New(harness.Options{
 		AgentOptions: loop.Options{
-			Model:    model,
-			Registry: registry,
+			InitialState: session.ToAgentContext(session.BuildContext(branch), systemPrompt),
+			Model:        model,
+			Registry:     registry,
 			Config: msg.Config{
 				MaxSteps: 50,
 			},
 		},
-		Hooks:        hookReg,
-		SystemPrompt: headlessSystemPrompt(workspace, opts.Force),
-		ModelName:    "flowbot",
+		Session:   sess,
+		Hooks:     hookReg,
+		ModelName: "flowbot",
 	})
+	flog.Info("flowbot-agent session open id=%s resumed=%v entries=%d workspace=%s force=%v",
+		s.id, s.resumed, len(branch), workspace, opts.Force)
+	return s, nil
+}
+
+// ID returns the session identifier accepted by --resume.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// Path returns the session JSONL file, or "" for an in-memory session.
+func (s *Session) Path() string {
+	return s.path
+}
 
-	flog.Info("flowbot-agent run start workspace=%s force=%v prompt_len=%d timeout=%s",
-		workspace, opts.Force, len(prompt), opts.Timeout)
+// Resumed reports whether the session was loaded from an existing file.
+func (s *Session) Resumed() bool {
+	return s.resumed
+}
+
+// Turn sends one user prompt and waits for the agent to finish. onEvent, when
+// set, receives every lifecycle event of the turn.
+func (s *Session) Turn(ctx context.Context, prompt string, onEvent func(agentevent.Event)) (Result, error) {
+	prompt = strings.TrimSpace(prompt)
+	if prompt == "" {
+		return Result{SessionID: s.id}, fmt.Errorf("prompt is required")
+	}
+	runCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	flog.Info("flowbot-agent turn start session=%s prompt_len=%d timeout=%s", s.id, len(prompt), s.timeout)
 	start := time.Now()
 
-	_, err = h.Prompt(runCtx, msg.NewUserMessage(prompt))
+	stream, err := s.harness.Prompt(runCtx, msg.NewUserMessage(prompt))
 	if err != nil {
-		return Result{}, fmt.Errorf("prompt: %w", err)
+		return Result{SessionID: s.id}, fmt.Errorf("prompt: %w", err)
+	}
+	for ev := range stream.Events() {
+		if onEvent != nil {
+			onEvent(ev)
+		}
 	}
-	if err := h.WaitIdle(runCtx); err != nil {
-		return Result{}, fmt.Errorf("wait idle: %w", err)
+	if err := s.harness.WaitIdle(runCtx); err != nil {
+		return Result{SessionID: s.id}, fmt.Errorf("wait idle: %w", err)
 	}
-	last := h.LastRunResult()
+	last := s.harness.LastRunResult()
 	text := finalText(last)
 	if last.Err != nil {
-		flog.Warn("flowbot-agent run failed duration=%s text_len=%d err=%v",
-			time.Since(start).Round(time.Millisecond), len(text), last.Err)
-		return Result{Text: text}, last.Err
+		flog.Warn("flowbot-agent turn failed session=%s duration=%s text_len=%d err=%v",
+			s.id, time.Since(start).Round(time.Millisecond), len(text), last.Err)
+		return Result{SessionID: s.id, Text: text}, last.Err
 	}
-	flog.Info("flowbot-agent run complete duration=%s text_len=%d",
-		time.Since(start).Round(time.Millisecond), len(text))
-	return Result{Text: text}, nil
+	flog.Info("flowbot-agent turn complete session=%s duration=%s text_len=%d",
+		s.id, time.Since(start).Round(time.Millisecond), len(text))
+	return Result{SessionID: s.id, Text: text}, nil
 }
 
 func newProxyModel(cfg *config.Config) (llms.Model, error) {
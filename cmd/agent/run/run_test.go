@@ -31,3 +31,33 @@ func TestExecute_UnreachableProxy(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestOpen_ResumeUnknownSession(t *testing.T) {
+	cfg := &config.Config{FlowbotURL: "http://127.0.0.1:9", AccessToken: "tok"}
+	_, err := run.Open(context.Background(), run.Options{
+		Config:     cfg,
+		Workspace:  t.TempDir(),
+		SessionDir: t.TempDir(),
+		SessionID:  "missing",
+	})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestOpen_NewSessionGetsID(t *testing.T) {
+	cfg := &config.Config{FlowbotURL: "http://127.0.0.1:9", AccessToken: "tok"}
+	dir := t.TempDir()
+	s, err := run.Open(context.Background(), run.Options{
+		Config:     cfg,
+		Workspace:  t.TempDir(),
+		SessionDir: dir,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, s.ID())
+	require.False(t, s.Resumed())
+	path, err := run.SessionPath(dir, s.ID())
+	require.NoError(t, err)
+	require.Equal(t, path, s.Path())
+
+	_, err = run.SessionPath(dir, "../escape")
+	require.Error(t, err)
+}
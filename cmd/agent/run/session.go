@@ -0,0 +1,73 @@
+package run
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/flowline-io/flowbot/pkg/agent/session"
+)
+
+var sessionIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// DefaultSessionDir returns ~/.config/flowbot/agent/sessions, honoring $HOME.
+func DefaultSessionDir() (string, error) {
+	home := os.Getenv("HOME")
+	if home == "" {
+		var err error
+		home, err = os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+	}
+	return filepath.Join(home, ".config", "flowbot", "agent", "sessions"), nil
+}
+
+// SessionPath returns the JSONL file for id inside dir.
+func SessionPath(dir, id string) (string, error) {
+	if !sessionIDPattern.MatchString(id) {
+		return "", fmt.Errorf("invalid session id %q", id)
+	}
+	return filepath.Join(dir, id+".jsonl"), nil
+}
+
+// openStorage resolves the session store. Without a dir the session lives in
+// memory; resuming then is an error because nothing was ever written.
+func openStorage(dir, id string) (session.Storage, *Session, error) {
+	dir = strings.TrimSpace(dir)
+	id = strings.TrimSpace(id)
+	if dir == "" {
+		if id != "" {
+			return nil, nil, fmt.Errorf("resume session %s: no session directory", id)
+		}
+		return session.NewMemoryStorage(), &Session{id: uuid.NewString()}, nil
+	}
+
+	resumed := id != ""
+	if !resumed {
+		id = uuid.NewString()
+	}
+	path, err := SessionPath(dir, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resumed {
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil, fmt.Errorf("session %s not found in %s", id, dir)
+			}
+			return nil, nil, err
+		}
+	} else if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, nil, fmt.Errorf("session dir: %w", err)
+	}
+	store, err := session.OpenFileStorage(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return store, &Session{id: id, path: path, resumed: resumed}, nil
+}
@@ -0,0 +1,140 @@
+package run
+
+import (
+	"io"
+	"sync"
+
+	"github.com/bytedance/sonic"
+
+	agentevent "github.com/flowline-io/flowbot/pkg/agent/event"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/session"
+)
+
+// Stream-json line types emitted in addition to the agentevent types.
+const (
+	StreamTypeSession = "session"
+	StreamTypeResult  = "result"
+)
+
+// StreamLine is one NDJSON record of --output-format stream-json. Lifecycle
+// lines use the agentevent type names; message_update lines carry only the
+// text or reasoning delta.
+type StreamLine struct {
+	Type        string           `json:"type"`
+	SessionID   string           `json:"session_id"`
+	Resumed     bool             `json:"resumed,omitempty"`
+	Text        string           `json:"text,omitempty"`
+	Reasoning   string           `json:"reasoning,omitempty"`
+	Update      string           `json:"update,omitempty"`
+	Message     map[string]any   `json:"message,omitempty"`
+	Messages    []map[string]any `json:"messages,omitempty"`
+	ToolCall    *StreamToolCall  `json:"tool_call,omitempty"`
+	ToolResult  map[string]any   `json:"tool_result,omitempty"`
+	ToolResults []map[string]any `json:"tool_results,omitempty"`
+	DurationMs  int64            `json:"duration_ms,omitempty"`
+	Step        int              `json:"step,omitempty"`
+	IsError     bool             `json:"is_error,omitempty"`
+	Error       string           `json:"error,omitempty"`
+}
+
+// StreamToolCall is the tool_call payload of tool_execution_* lines.
+type StreamToolCall struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// StreamJSON writes agent events as newline-delimited JSON.
+type StreamJSON struct {
+	mu        sync.Mutex
+	w         io.Writer
+	sessionID string
+}
+
+// NewStreamJSON returns an encoder that tags every line with sessionID.
+func NewStreamJSON(w io.Writer, sessionID string) *StreamJSON {
+	return &StreamJSON{w: w, sessionID: sessionID}
+}
+
+// Session writes the opening line announcing the session id.
+func (s *StreamJSON) Session(resumed bool) error {
+	return s.write(StreamLine{Type: StreamTypeSession, Resumed: resumed})
+}
+
+// Event writes one agent lifecycle event.
+func (s *StreamJSON) Event(ev agentevent.Event) error {
+	line := StreamLine{
+		Type:        string(ev.Type),
+		Text:        ev.TextDelta,
+		Reasoning:   ev.ReasoningDelta,
+		Update:      ev.Update,
+		Message:     messageJSON(ev.Message),
+		Messages:    messagesJSON(ev.Messages),
+		ToolCall:    toolCallJSON(ev.ToolCall),
+		ToolResult:  messageJSON(ev.ToolResult),
+		ToolResults: messagesJSON(ev.ToolResults),
+		DurationMs:  ev.DurationMs,
+		Step:        ev.Step,
+	}
+	if ev.Err != nil {
+		line.IsError = true
+		line.Error = ev.Err.Error()
+	}
+	return s.write(line)
+}
+
+// Result writes the closing line of a turn with the final assistant text.
+func (s *StreamJSON) Result(res Result, err error) error {
+	line := StreamLine{Type: StreamTypeResult, Text: res.Text}
+	if err != nil {
+		line.IsError = true
+		line.Error = err.Error()
+	}
+	return s.write(line)
+}
+
+func (s *StreamJSON) write(line StreamLine) error {
+	line.SessionID = s.sessionID
+	data, err := sonic.Marshal(line)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+func messageJSON(v any) map[string]any {
+	m, ok := v.(msg.AgentMessage)
+	if !ok || m == nil {
+		return nil
+	}
+	return session.MessageJSON(m)
+}
+
+func messagesJSON(v any) []map[string]any {
+	var out []map[string]any
+	switch items := v.(type) {
+	case []msg.AgentMessage:
+		for _, item := range items {
+			if raw := messageJSON(item); raw != nil {
+				out = append(out, raw)
+			}
+		}
+	case []msg.ToolResultMessage:
+		for _, item := range items {
+			out = append(out, session.MessageJSON(item))
+		}
+	}
+	return out
+}
+
+func toolCallJSON(v any) *StreamToolCall {
+	call, ok := v.(msg.ToolCallPart)
+	if !ok {
+		return nil
+	}
+	return &StreamToolCall{ID: call.ID, Name: call.Name, Arguments: call.Arguments}
+}
@@ -0,0 +1,47 @@
+package run_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/cmd/agent/run"
+	agentevent "github.com/flowline-io/flowbot/pkg/agent/event"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+)
+
+func TestStreamJSON(t *testing.T) {
+	var buf bytes.Buffer
+	enc := run.NewStreamJSON(&buf, "sess-1")
+
+	require.NoError(t, enc.Session(false))
+	require.NoError(t, enc.Event(agentevent.Event{Type: agentevent.TypeMessageUpdate, TextDelta: "hi"}))
+	require.NoError(t, enc.Event(agentevent.Event{
+		Type:     agentevent.TypeToolExecutionStart,
+		ToolCall: msg.ToolCallPart{ID: "c1", Name: "read_file", Arguments: `{"path":"a.go"}`},
+	}))
+	require.NoError(t, enc.Event(agentevent.Event{Type: agentevent.TypeMessageEnd, Message: msg.NewUserMessage("done")}))
+	require.NoError(t, enc.Result(run.Result{Text: "partial"}, errors.New("boom")))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 5)
+	decoded := make([]run.StreamLine, len(lines))
+	for i, line := range lines {
+		require.NoError(t, sonic.UnmarshalString(line, &decoded[i]))
+		assert.Equal(t, "sess-1", decoded[i].SessionID)
+	}
+
+	assert.Equal(t, run.StreamTypeSession, decoded[0].Type)
+	assert.Equal(t, "hi", decoded[1].Text)
+	require.NotNil(t, decoded[2].ToolCall)
+	assert.Equal(t, "read_file", decoded[2].ToolCall.Name)
+	assert.Equal(t, "user", decoded[3].Message["role"])
+	assert.Equal(t, run.StreamTypeResult, decoded[4].Type)
+	assert.True(t, decoded[4].IsError)
+	assert.Equal(t, "boom", decoded[4].Error)
+}
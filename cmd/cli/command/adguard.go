@@ -0,0 +1,231 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/flowline-io/flowbot/cmd/cli/utils"
+	"github.com/flowline-io/flowbot/pkg/client"
+)
+
+// AdguardCommand returns the root command for AdGuard Home DNS filtering.
+func AdguardCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adguard",
+		Short: "Work with AdGuard Home DNS filtering",
+		Long:  "Inspect the AdGuard Home query log and toggle protection via Flowbot server",
+	}
+	cmd.AddCommand(
+		adguardStatusCommand(),
+		adguardStatsCommand(),
+		adguardQueryLogCommand(),
+		adguardProtectionCommand(),
+		adguardFilteringCommand(),
+		adguardHealthCommand(),
+	)
+	return cmd
+}
+
+func adguardStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show DNS server status",
+		Long:  "Show whether AdGuard Home is running and protection is enabled",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := c.Adguard.Status(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("get status: %w", err)
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(status)
+			}
+			_, _ = fmt.Printf("Running: %t\nProtection: %t\nVersion: %s\n", status.Running, status.ProtectionEnabled, status.Version)
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func adguardStatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show DNS query counters",
+		Long:  "Show DNS query and block counters for the AdGuard Home stats window",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			stats, err := c.Adguard.Stats(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("get stats: %w", err)
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(stats)
+			}
+			_, _ = fmt.Printf("Queries: %d\nBlocked: %d\nAvg processing: %.2fms\n", stats.Queries, stats.BlockedFiltering, stats.AvgProcessingMs)
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func adguardQueryLogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "querylog",
+		Short: "Search the DNS query log",
+		Long:  "Search the AdGuard Home query log, newest first",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, _ := cmd.Flags().GetInt("limit")
+			cursor, _ := cmd.Flags().GetString("cursor")
+			search, _ := cmd.Flags().GetString("search")
+			status, _ := cmd.Flags().GetString("status")
+			result, err := c.Adguard.QueryLog(cmd.Context(), client.AdguardQueryLogQuery{
+				Limit:  limit,
+				Cursor: cursor,
+				Search: search,
+				Status: status,
+			})
+			if err != nil {
+				return fmt.Errorf("search query log: %w", err)
+			}
+
+			if len(result.Items) == 0 {
+				return PrintEmptyList(cmd, "No queries found")
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(result.Items)
+			}
+			for _, item := range result.Items {
+				verdict := "allowed"
+				if item.Blocked {
+					verdict = "blocked"
+				}
+				_, _ = fmt.Printf("%s\t%s\t%s\t%s\n", item.Time.Format("2006-01-02 15:04:05"), item.Client, verdict, item.Domain)
+			}
+			if result.Page != nil {
+				printNextCursor(result.Page.NextCursor)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	cmd.Flags().IntP("limit", "n", 50, "Maximum number of entries")
+	cmd.Flags().StringP("cursor", "c", "", "Pagination cursor")
+	cmd.Flags().StringP("search", "s", "", "Domain or client substring")
+	cmd.Flags().String("status", "", "Filter by status (all, filtered, blocked, whitelisted, rewritten, processed)")
+	return cmd
+}
+
+func adguardProtectionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protection <on|off>",
+		Short: "Toggle DNS protection",
+		Long:  "Enable or disable AdGuard Home protection, optionally for a limited duration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled, err := parseOnOff(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, _ := cmd.Flags().GetString("duration")
+			if err := c.Adguard.SetProtection(cmd.Context(), enabled, duration); err != nil {
+				return fmt.Errorf("set protection: %w", err)
+			}
+			_, _ = fmt.Printf("Protection %s\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringP("duration", "d", "", "Disable only for this long (e.g. 10m)")
+	return cmd
+}
+
+func adguardFilteringCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filtering <on|off>",
+		Short: "Toggle filter lists",
+		Long:  "Enable or disable AdGuard Home filter lists",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled, err := parseOnOff(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := c.Adguard.SetFiltering(cmd.Context(), enabled); err != nil {
+				return fmt.Errorf("set filtering: %w", err)
+			}
+			_, _ = fmt.Printf("Filtering %s\n", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
+
+func adguardHealthCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Check AdGuard Home backend health",
+		Long:  "Check whether the AdGuard Home backend is reachable",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			healthy, err := c.Adguard.Health(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("check health: %w", err)
+			}
+
+			if healthy {
+				_, _ = fmt.Println("AdGuard Home backend is healthy")
+			} else {
+				_, _ = fmt.Println("AdGuard Home backend is NOT healthy")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// parseOnOff converts an "on"/"off" argument into a bool.
+func parseOnOff(arg string) (bool, error) {
+	switch arg {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected on or off, got %q", arg)
+	}
+}
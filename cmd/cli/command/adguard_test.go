@@ -0,0 +1,53 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdguardCommand(t *testing.T) {
+	t.Parallel()
+	cmd := AdguardCommand()
+	require.Equal(t, "adguard", cmd.Use)
+	subNames := subcommandNames(cmd)
+	for _, name := range []string{"status", "stats", "querylog", "protection", "filtering", "health"} {
+		require.Contains(t, subNames, name)
+	}
+
+	querylog := findSubcommand(cmd, "querylog")
+	require.NotNil(t, querylog)
+	for _, flag := range []string{"limit", "cursor", "search", "status", "output"} {
+		require.NotNil(t, querylog.Flags().Lookup(flag), flag)
+	}
+
+	protection := findSubcommand(cmd, "protection")
+	require.NotNil(t, protection)
+	require.NotNil(t, protection.Flags().Lookup("duration"))
+}
+
+func TestParseOnOff(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		arg     string
+		want    bool
+		wantErr bool
+	}{
+		{arg: "on", want: true},
+		{arg: "off", want: false},
+		{arg: "yes", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseOnOff(tt.arg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/flowline-io/flowbot/cmd/cli/utils"
+	"github.com/flowline-io/flowbot/pkg/client"
+)
+
+// ArchiveboxCommand returns the root command for ArchiveBox snapshots.
+func ArchiveboxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archivebox",
+		Short: "Work with ArchiveBox snapshots",
+		Long:  "Archive URLs and browse ArchiveBox snapshots via Flowbot server",
+	}
+	cmd.AddCommand(
+		archiveboxAddCommand(),
+		archiveboxListCommand(),
+		archiveboxGetCommand(),
+		archiveboxHealthCommand(),
+	)
+	return cmd
+}
+
+func archiveboxAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <url>...",
+		Short: "Archive URLs",
+		Long:  "Queue one or more URLs for archiving in ArchiveBox",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			tags, _ := cmd.Flags().GetStringSlice("tags")
+			urls, err := c.Archivebox.Add(cmd.Context(), args, tags)
+			if err != nil {
+				return fmt.Errorf("add snapshots: %w", err)
+			}
+			_, _ = fmt.Printf("Queued %d URL(s) for archiving\n", len(urls))
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceP("tags", "t", nil, "Tags to apply to the snapshots")
+	return cmd
+}
+
+func archiveboxListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List snapshots",
+		Long:  "Display ArchiveBox snapshots, newest first",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, _ := cmd.Flags().GetInt("limit")
+			cursor, _ := cmd.Flags().GetString("cursor")
+			search, _ := cmd.Flags().GetString("search")
+			tag, _ := cmd.Flags().GetString("tag")
+			result, err := c.Archivebox.List(cmd.Context(), client.ArchiveboxListQuery{
+				Limit:  limit,
+				Cursor: cursor,
+				Search: search,
+				Tag:    tag,
+			})
+			if err != nil {
+				return fmt.Errorf("list snapshots: %w", err)
+			}
+
+			if len(result.Items) == 0 {
+				return PrintEmptyList(cmd, "No snapshots found")
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(result.Items)
+			}
+			for _, item := range result.Items {
+				_, _ = fmt.Printf("%s\t%s\t%s\t%s\n", item.ID, item.Status, item.URL, strings.Join(item.Tags, ","))
+			}
+			if result.Page != nil {
+				printNextCursor(result.Page.NextCursor)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	cmd.Flags().IntP("limit", "n", 20, "Maximum number of snapshots")
+	cmd.Flags().StringP("cursor", "c", "", "Pagination cursor")
+	cmd.Flags().StringP("search", "s", "", "URL or title substring")
+	cmd.Flags().String("tag", "", "Only snapshots with this tag")
+	return cmd
+}
+
+func archiveboxGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a snapshot",
+		Long:  "Show a single ArchiveBox snapshot by its timestamp ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			item, err := c.Archivebox.Get(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("get snapshot: %w", err)
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(item)
+			}
+			_, _ = fmt.Printf("ID: %s\nURL: %s\nTitle: %s\nStatus: %s\n", item.ID, item.URL, item.Title, item.Status)
+			if len(item.Tags) > 0 {
+				_, _ = fmt.Printf("Tags: %s\n", strings.Join(item.Tags, ", "))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func archiveboxHealthCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Check ArchiveBox backend health",
+		Long:  "Check whether the ArchiveBox backend is reachable",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			healthy, err := c.Archivebox.Health(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("check health: %w", err)
+			}
+
+			if healthy {
+				_, _ = fmt.Println("ArchiveBox backend is healthy")
+			} else {
+				_, _ = fmt.Println("ArchiveBox backend is NOT healthy")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,28 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveboxCommand(t *testing.T) {
+	t.Parallel()
+	cmd := ArchiveboxCommand()
+	require.Equal(t, "archivebox", cmd.Use)
+	subNames := subcommandNames(cmd)
+	for _, name := range []string{"add", "list", "get", "health"} {
+		require.Contains(t, subNames, name)
+	}
+
+	add := findSubcommand(cmd, "add")
+	require.NotNil(t, add)
+	require.NotNil(t, add.Flags().Lookup("tags"))
+	require.Error(t, add.Args(add, nil), "add needs at least one URL")
+
+	list := findSubcommand(cmd, "list")
+	require.NotNil(t, list)
+	for _, flag := range []string{"limit", "cursor", "search", "tag", "output"} {
+		require.NotNil(t, list.Flags().Lookup(flag), flag)
+	}
+}
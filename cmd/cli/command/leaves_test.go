@@ -27,6 +27,11 @@ func TestAllLeafCommandsHaveRunE(t *testing.T) {
 		{name: "email", fn: EmailCommand},
 		{name: "nocodb", fn: NocodbCommand},
 		{name: "devops", fn: DevopsCommand},
+		{name: "adguard", fn: AdguardCommand},
+		{name: "archivebox", fn: ArchiveboxCommand},
+		{name: "n8n", fn: N8nCommand},
+		{name: "slash", fn: SlashCommand},
+		{name: "mcp", fn: MCPCommand},
 		{name: "config", fn: ConfigCommand},
 		{name: "version", fn: func() *cobra.Command { return VersionCommand("test") }},
 	}
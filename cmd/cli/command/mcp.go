@@ -0,0 +1,64 @@
+package command
+
+import (
+	"io"
+	"time"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/spf13/cobra"
+
+	"github.com/flowline-io/flowbot/cmd/cli/utils"
+	"github.com/flowline-io/flowbot/pkg/mcp"
+	"github.com/flowline-io/flowbot/version"
+)
+
+// MCPCommand returns the root CLI command for Model Context Protocol access.
+func MCPCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Expose Flowbot capabilities to MCP clients",
+		Long:  "Serve the Flowbot capability registry as Model Context Protocol tools.",
+	}
+	cmd.AddCommand(mcpServeCommand())
+	return cmd
+}
+
+func mcpServeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve MCP over stdio",
+		Long: "Serve MCP over stdin and stdout, forwarding tool calls to the server's /mcp endpoint " +
+			"with the stored token. Tools are filtered by the token's scopes.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetDuration("timeout")
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			info := &sdk.Implementation{Name: "flowbot", Version: version.Buildtags}
+			remote, err := sdk.NewClient(info, nil).Connect(ctx, c.MCP.Transport(timeout), nil)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = remote.Close() }()
+			proxy, err := mcp.NewProxy(ctx, info, remote)
+			if err != nil {
+				return err
+			}
+			return proxy.Run(ctx, &sdk.IOTransport{
+				Reader: io.NopCloser(cmd.InOrStdin()),
+				Writer: nopWriteCloser{cmd.OutOrStdout()},
+			})
+		},
+	}
+	cmd.Flags().Duration("timeout", 5*time.Minute, "Per-request timeout for forwarded tool calls")
+	return cmd
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
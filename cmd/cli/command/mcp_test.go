@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMCPCommand(t *testing.T) {
+	t.Parallel()
+	cmd := MCPCommand()
+	require.Equal(t, "mcp", cmd.Use)
+	require.Contains(t, subcommandNames(cmd), "serve")
+
+	serve := findSubcommand(cmd, "serve")
+	require.NotNil(t, serve)
+	require.NotNil(t, serve.Flags().Lookup("timeout"))
+}
+
+func TestMCPServeProxiesTools(t *testing.T) {
+	srv := sdk.NewServer(&sdk.Implementation{Name: "flowbot"}, nil)
+	srv.AddTool(&sdk.Tool{Name: "kanboard_list_tasks", InputSchema: map[string]any{"type": "object"}},
+		func(_ context.Context, req *sdk.CallToolRequest) (*sdk.CallToolResult, error) {
+			return &sdk.CallToolResult{Content: []sdk.Content{&sdk.TextContent{Text: "args " + string(req.Params.Arguments)}}}, nil
+		})
+	handler := sdk.NewStreamableHTTPHandler(func(*http.Request) *sdk.Server { return srv }, &sdk.StreamableHTTPOptions{Stateless: true, JSONResponse: true})
+	setupMockCLI(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mcp", r.URL.Path)
+		assert.Equal(t, "test-token", r.Header.Get("X-AccessToken"))
+		handler.ServeHTTP(w, r)
+	})
+
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+	cmd := mcpServeCommand()
+	wireClientFlags(cmd)
+	cmd.SetIn(stdinR)
+	cmd.SetOut(stdoutW)
+	cmd.SetContext(context.Background())
+	cmd.SetArgs(nil)
+	done := make(chan error, 1)
+	go func() { done <- cmd.Execute() }()
+
+	ctx := context.Background()
+	session, err := sdk.NewClient(&sdk.Implementation{Name: "editor"}, nil).
+		Connect(ctx, &sdk.IOTransport{Reader: stdoutR, Writer: stdinW}, nil)
+	require.NoError(t, err)
+	tools, err := session.ListTools(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, tools.Tools, 1)
+	assert.Equal(t, "kanboard_list_tasks", tools.Tools[0].Name)
+
+	res, err := session.CallTool(ctx, &sdk.CallToolParams{Name: "kanboard_list_tasks", Arguments: map[string]any{"project_id": 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Content, 1)
+	assert.JSONEq(t, `{"project_id":3}`, strings.TrimPrefix(res.Content[0].(*sdk.TextContent).Text, "args "))
+
+	require.NoError(t, session.Close())
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,228 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/flowline-io/flowbot/cmd/cli/utils"
+	"github.com/flowline-io/flowbot/pkg/client"
+)
+
+// N8nCommand returns the root command for n8n workflows.
+func N8nCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "n8n",
+		Short: "Work with n8n workflows",
+		Long:  "List, trigger and toggle n8n workflows and inspect executions via Flowbot server",
+	}
+	cmd.AddCommand(
+		n8nListCommand(),
+		n8nGetCommand(),
+		n8nActivateCommand(true),
+		n8nActivateCommand(false),
+		n8nTriggerCommand(),
+		n8nExecutionsCommand(),
+		n8nHealthCommand(),
+	)
+	return cmd
+}
+
+func n8nListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List workflows",
+		Long:  "Display n8n workflows and whether they are active",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			items, err := c.N8n.ListWorkflows(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list workflows: %w", err)
+			}
+
+			if len(items) == 0 {
+				return PrintEmptyList(cmd, "No workflows found")
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(items)
+			}
+			for _, item := range items {
+				state := "inactive"
+				if item.Active {
+					state = "active"
+				}
+				_, _ = fmt.Printf("%s\t%s\t%s\n", item.ID, state, item.Name)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func n8nGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a workflow",
+		Long:  "Show a single n8n workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			item, err := c.N8n.GetWorkflow(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("get workflow: %w", err)
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(item)
+			}
+			_, _ = fmt.Printf("ID: %s\nName: %s\nActive: %t\n", item.ID, item.Name, item.Active)
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func n8nActivateCommand(active bool) *cobra.Command {
+	use, short, verb := "deactivate <id>", "Deactivate a workflow", "deactivated"
+	if active {
+		use, short, verb = "activate <id>", "Activate a workflow", "activated"
+	}
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := c.N8n.SetWorkflowActive(cmd.Context(), args[0], active); err != nil {
+				return fmt.Errorf("set workflow active: %w", err)
+			}
+			_, _ = fmt.Printf("Workflow %s %s\n", args[0], verb)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func n8nTriggerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger <id>",
+		Short: "Trigger a workflow",
+		Long:  "Start an n8n workflow run, optionally passing a JSON object as input",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data map[string]any
+			if raw, _ := cmd.Flags().GetString("data"); raw != "" {
+				if err := json.Unmarshal([]byte(raw), &data); err != nil {
+					return fmt.Errorf("parse --data: %w", err)
+				}
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := c.N8n.TriggerWorkflow(cmd.Context(), args[0], data); err != nil {
+				return fmt.Errorf("trigger workflow: %w", err)
+			}
+			_, _ = fmt.Printf("Workflow %s triggered\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String("data", "", "JSON object passed to the workflow")
+	return cmd
+}
+
+func n8nExecutionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executions",
+		Short: "List workflow executions",
+		Long:  "Display n8n workflow executions, newest first",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, _ := cmd.Flags().GetInt("limit")
+			cursor, _ := cmd.Flags().GetString("cursor")
+			workflowID, _ := cmd.Flags().GetString("workflow")
+			status, _ := cmd.Flags().GetString("status")
+			result, err := c.N8n.ListExecutions(cmd.Context(), client.N8nExecutionsQuery{
+				Limit:      limit,
+				Cursor:     cursor,
+				WorkflowID: workflowID,
+				Status:     status,
+			})
+			if err != nil {
+				return fmt.Errorf("list executions: %w", err)
+			}
+
+			if len(result.Items) == 0 {
+				return PrintEmptyList(cmd, "No executions found")
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(result.Items)
+			}
+			for _, item := range result.Items {
+				_, _ = fmt.Printf("%s\t%s\t%s\t%s\n", item.ID, item.WorkflowID, item.Status, item.StartedAt.Format("2006-01-02 15:04:05"))
+			}
+			if result.Page != nil {
+				printNextCursor(result.Page.NextCursor)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	cmd.Flags().IntP("limit", "n", 20, "Maximum number of executions")
+	cmd.Flags().StringP("cursor", "c", "", "Pagination cursor")
+	cmd.Flags().StringP("workflow", "w", "", "Only executions of this workflow ID")
+	cmd.Flags().String("status", "", "Filter by status (success, error, waiting, running, canceled)")
+	return cmd
+}
+
+func n8nHealthCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Check n8n backend health",
+		Long:  "Check whether the n8n backend is reachable",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			healthy, err := c.N8n.Health(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("check health: %w", err)
+			}
+
+			if healthy {
+				_, _ = fmt.Println("n8n backend is healthy")
+			} else {
+				_, _ = fmt.Println("n8n backend is NOT healthy")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestN8nCommand(t *testing.T) {
+	t.Parallel()
+	cmd := N8nCommand()
+	require.Equal(t, "n8n", cmd.Use)
+	subNames := subcommandNames(cmd)
+	for _, name := range []string{"list", "get", "activate", "deactivate", "trigger", "executions", "health"} {
+		require.Contains(t, subNames, name)
+	}
+
+	trigger := findSubcommand(cmd, "trigger")
+	require.NotNil(t, trigger)
+	require.NotNil(t, trigger.Flags().Lookup("data"))
+
+	executions := findSubcommand(cmd, "executions")
+	require.NotNil(t, executions)
+	for _, flag := range []string{"limit", "cursor", "workflow", "status", "output"} {
+		require.NotNil(t, executions.Flags().Lookup(flag), flag)
+	}
+}
+
+func TestN8nTriggerInvalidData(t *testing.T) {
+	t.Parallel()
+	cmd := n8nTriggerCommand()
+	require.NoError(t, cmd.Flags().Set("data", "{not json"))
+	err := cmd.RunE(cmd, []string{"1"})
+	require.ErrorContains(t, err, "parse --data")
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/bytedance/sonic"
@@ -16,7 +17,7 @@ func PipelineCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pipeline",
 		Short: "Manage pipelines",
-		Long:  "Apply, list, get, export, delete, and run database-backed pipelines.",
+		Long:  "Apply, list, get, export, delete, and run database-backed pipelines, and cancel, retry, or re-run their runs.",
 	}
 	cmd.AddCommand(
 		pipelineApplyCommand(),
@@ -26,6 +27,9 @@ func PipelineCommand() *cobra.Command {
 		pipelineDeleteCommand(),
 		pipelineRunCommand(),
 		pipelineRunsCommand(),
+		pipelineCancelCommand(),
+		pipelineRetryCommand(),
+		pipelineRerunCommand(),
 	)
 	return cmd
 }
@@ -259,3 +263,83 @@ func pipelineRunsCommand() *cobra.Command {
 	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 	return cmd
 }
+
+func pipelineCancelCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel <run-id>",
+		Short: "Cancel a running pipeline run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := parseRunIDArg(args)
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			if err := c.Pipeline.Cancel(cmd.Context(), runID); err != nil {
+				return fmt.Errorf("cancel pipeline run: %w", err)
+			}
+			_, _ = fmt.Printf("Pipeline run %d cancelled\n", runID)
+			return nil
+		},
+	}
+}
+
+func pipelineRetryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <run-id>",
+		Short: "Retry a failed pipeline run from the failed step",
+		Long:  "Resume a failed or cancelled run of a resumable pipeline from its checkpoint. Completed steps are not executed again.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := parseRunIDArg(args)
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := c.Pipeline.Retry(cmd.Context(), runID); err != nil {
+				return fmt.Errorf("retry pipeline run: %w", err)
+			}
+			_, _ = fmt.Printf("Pipeline run %d retrying\n", runID)
+			return nil
+		},
+	}
+}
+
+func pipelineRerunCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rerun <run-id>",
+		Short: "Start a new pipeline run with the same event",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := parseRunIDArg(args)
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			result, err := c.Pipeline.Rerun(cmd.Context(), runID)
+			if err != nil {
+				return fmt.Errorf("rerun pipeline run: %w", err)
+			}
+			_, _ = fmt.Printf("Pipeline run %d re-run: run_id=%d\n", runID, result.RunID)
+			return nil
+		},
+	}
+}
+
+// parseRunIDArg parses the <run-id> argument shared by the run control subcommands.
+func parseRunIDArg(args []string) (int64, error) {
+	if len(args) == 0 {
+		return 0, fmt.Errorf("run ID is required")
+	}
+	runID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || runID <= 0 {
+		return 0, fmt.Errorf("invalid run ID %q", args[0])
+	}
+	return runID, nil
+}
@@ -24,7 +24,7 @@ func TestPipelineCommand(t *testing.T) {
 			for _, c := range cmd.Commands() {
 				names[c.Name()] = true
 			}
-			for _, want := range []string{"apply", "list", "get", "export", "delete", "run", "runs"} {
+			for _, want := range []string{"apply", "list", "get", "export", "delete", "run", "runs", "cancel", "retry", "rerun"} {
 				require.True(t, names[want], "missing subcommand %s", want)
 			}
 		})
@@ -68,3 +68,30 @@ func TestPipelineRunCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRunIDArg(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		args    []string
+		want    int64
+		wantErr bool
+	}{
+		{name: "valid id", args: []string{"42"}, want: 42},
+		{name: "missing id", wantErr: true},
+		{name: "not a number", args: []string{"abc"}, wantErr: true},
+		{name: "zero", args: []string{"0"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseRunIDArg(tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/flowline-io/flowbot/cmd/cli/utils"
+	"github.com/flowline-io/flowbot/pkg/client"
+)
+
+func SearchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search across bookmarks, feeds, memos, notes, tasks, issues, clips and knowledge",
+		Long: `Run a ranked full-text query against the unified search index.
+
+Sources: bookmark, feed, memo, note, task, issue, clip, knowledge.`,
+		Example: `  flowbot search homelab backups
+  flowbot search kubernetes --source bookmark,note
+  flowbot search deplo --autocomplete`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			query := strings.Join(args, " ")
+			source, _ := cmd.Flags().GetString("source")
+			autocomplete, _ := cmd.Flags().GetBool("autocomplete")
+
+			var results []client.SearchResult
+			if autocomplete {
+				results, err = c.Search.Autocomplete(cmd.Context(), query, source)
+			} else {
+				results, err = c.Search.Search(cmd.Context(), query, source)
+			}
+			if err != nil {
+				return fmt.Errorf("search: %w", err)
+			}
+
+			if len(results) == 0 {
+				return PrintEmptyList(cmd, "No results found")
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(results)
+			}
+			for _, r := range results {
+				_, _ = fmt.Printf("[%s] %s\n", r.Source, r.Title)
+				if r.URL != "" {
+					_, _ = fmt.Printf("  URL: %s\n", r.URL)
+				}
+				if r.Content != "" {
+					_, _ = fmt.Printf("  %s\n", truncate(r.Content, 160))
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("source", "s", "", "Comma-separated sources to search (default all)")
+	cmd.Flags().BoolP("autocomplete", "a", false, "Return title completions for a partial query")
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchCommandRunE(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		args       []string
+		wantSubstr string
+		wantErr    bool
+		errSubstr  string
+	}{
+		{
+			name: "query with source filter",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/search/query", r.URL.Path)
+				assert.Equal(t, "homelab backups", r.URL.Query().Get("q"))
+				assert.Equal(t, "bookmark,note", r.URL.Query().Get("source"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(okJSON(`{"hits":[{"id":"b1","title":"Homelab backups","source":"bookmark","url":"https://example.com"}]}`)))
+			},
+			args:       []string{"homelab", "backups", "--source", "bookmark,note"},
+			wantSubstr: "[bookmark] Homelab backups",
+		},
+		{
+			name: "autocomplete",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/search/autocomplete", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(okJSON(`{"hits":[{"id":"n1","title":"Deploy runbook","source":"note"}]}`)))
+			},
+			args:       []string{"depl", "--autocomplete", "-o", "json"},
+			wantSubstr: `"title": "Deploy runbook"`,
+		},
+		{
+			name: "no results",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(okJSON(`{"hits":[]}`)))
+			},
+			args:       []string{"nothing"},
+			wantSubstr: "No results found",
+		},
+		{
+			name: "api error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"status":"failed","message":"unknown search source"}`))
+			},
+			args:      []string{"x", "--source", "wiki"},
+			wantErr:   true,
+			errSubstr: "unknown search source",
+		},
+		{
+			name:      "query required",
+			wantErr:   true,
+			errSubstr: "requires at least 1 arg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.handler != nil {
+				setupMockCLI(t, tt.handler)
+			}
+			cmd := SearchCommand()
+			require.NotNil(t, cmd.RunE)
+			if tt.wantErr {
+				runCommandExpectError(t, cmd, tt.errSubstr, tt.args...)
+				return
+			}
+			out := runCommand(t, cmd, tt.args...)
+			assert.Contains(t, out, tt.wantSubstr)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/flowline-io/flowbot/cmd/cli/utils"
+	"github.com/flowline-io/flowbot/pkg/client"
+)
+
+// SlashCommand returns the root command for Slash shortcuts.
+func SlashCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash",
+		Short: "Work with Slash shortcuts",
+		Long:  "Manage Slash short links via Flowbot server",
+	}
+	cmd.AddCommand(
+		slashListCommand(),
+		slashGetCommand(),
+		slashCreateCommand(),
+		slashUpdateCommand(),
+		slashDeleteCommand(),
+		slashHealthCommand(),
+	)
+	return cmd
+}
+
+func slashListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List shortcuts",
+		Long:  "Display Slash shortcuts",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			items, err := c.Slash.List(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("list shortcuts: %w", err)
+			}
+
+			if len(items) == 0 {
+				return PrintEmptyList(cmd, "No shortcuts found")
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(items)
+			}
+			for _, item := range items {
+				_, _ = fmt.Printf("%d\t%s\t%s\n", item.ID, item.Name, item.Link)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func slashGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a shortcut",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseShortcutID(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			item, err := c.Slash.Get(cmd.Context(), id)
+			if err != nil {
+				return fmt.Errorf("get shortcut: %w", err)
+			}
+
+			output, _ := cmd.Flags().GetString("output")
+			if output == "json" {
+				return PrintJSON(item)
+			}
+			_, _ = fmt.Printf("ID: %d\nName: %s\nLink: %s\nTitle: %s\nVisibility: %s\nViews: %d\n",
+				item.ID, item.Name, item.Link, item.Title, item.Visibility, item.ViewCount)
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	return cmd
+}
+
+func slashCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a shortcut",
+		Long:  "Create a Slash shortcut that redirects name to link",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			item, err := c.Slash.Create(cmd.Context(), slashRequestFromFlags(cmd))
+			if err != nil {
+				return fmt.Errorf("create shortcut: %w", err)
+			}
+			_, _ = fmt.Printf("Shortcut created: %d (%s)\n", item.ID, item.Name)
+			return nil
+		},
+	}
+	addSlashShortcutFlags(cmd)
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("link")
+	return cmd
+}
+
+func slashUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update a shortcut",
+		Long:  "Update the fields of a Slash shortcut given as flags; other fields are kept",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseShortcutID(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			item, err := c.Slash.Update(cmd.Context(), id, slashRequestFromFlags(cmd))
+			if err != nil {
+				return fmt.Errorf("update shortcut: %w", err)
+			}
+			_, _ = fmt.Printf("Shortcut updated: %d (%s)\n", item.ID, item.Name)
+			return nil
+		},
+	}
+	addSlashShortcutFlags(cmd)
+	return cmd
+}
+
+func slashDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a shortcut",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseShortcutID(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := c.Slash.Delete(cmd.Context(), id); err != nil {
+				return fmt.Errorf("delete shortcut: %w", err)
+			}
+			_, _ = fmt.Printf("Shortcut deleted: %d\n", id)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func slashHealthCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Check Slash backend health",
+		Long:  "Check whether the Slash backend is reachable",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			healthy, err := c.Slash.Health(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("check health: %w", err)
+			}
+
+			if healthy {
+				_, _ = fmt.Println("Slash backend is healthy")
+			} else {
+				_, _ = fmt.Println("Slash backend is NOT healthy")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func addSlashShortcutFlags(cmd *cobra.Command) {
+	cmd.Flags().String("name", "", "Shortcut name")
+	cmd.Flags().String("link", "", "Target URL")
+	cmd.Flags().String("title", "", "Display title")
+	cmd.Flags().String("description", "", "Description")
+	cmd.Flags().StringSlice("tags", nil, "Tags")
+	cmd.Flags().String("visibility", "", "Visibility (private, workspace, public)")
+}
+
+// slashRequestFromFlags builds a request containing only the flags the user set,
+// so updates leave unspecified fields untouched.
+func slashRequestFromFlags(cmd *cobra.Command) client.SlashShortcutRequest {
+	var req client.SlashShortcutRequest
+	for name, dst := range map[string]**string{
+		"name":        &req.Name,
+		"link":        &req.Link,
+		"title":       &req.Title,
+		"description": &req.Description,
+		"visibility":  &req.Visibility,
+	} {
+		if cmd.Flags().Changed(name) {
+			v, _ := cmd.Flags().GetString(name)
+			*dst = &v
+		}
+	}
+	if cmd.Flags().Changed("tags") {
+		req.Tags, _ = cmd.Flags().GetStringSlice("tags")
+	}
+	return req
+}
+
+func parseShortcutID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid shortcut id %q", arg)
+	}
+	return id, nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashCommand(t *testing.T) {
+	t.Parallel()
+	cmd := SlashCommand()
+	require.Equal(t, "slash", cmd.Use)
+	subNames := subcommandNames(cmd)
+	for _, name := range []string{"list", "get", "create", "update", "delete", "health"} {
+		require.Contains(t, subNames, name)
+	}
+
+	create := findSubcommand(cmd, "create")
+	require.NotNil(t, create)
+	for _, flag := range []string{"name", "link", "title", "description", "tags", "visibility"} {
+		require.NotNil(t, create.Flags().Lookup(flag), flag)
+	}
+}
+
+func TestSlashRequestFromFlags(t *testing.T) {
+	t.Parallel()
+	cmd := slashUpdateCommand()
+	require.NoError(t, cmd.Flags().Set("title", "Go"))
+	require.NoError(t, cmd.Flags().Set("tags", "lang,dev"))
+
+	req := slashRequestFromFlags(cmd)
+	require.NotNil(t, req.Title)
+	assert.Equal(t, "Go", *req.Title)
+	assert.Equal(t, []string{"lang", "dev"}, req.Tags)
+	assert.Nil(t, req.Name, "unset flags must not be sent")
+	assert.Nil(t, req.Link)
+}
+
+func TestParseShortcutID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		arg     string
+		want    int64
+		wantErr bool
+	}{
+		{arg: "42", want: 42},
+		{arg: "0", wantErr: true},
+		{arg: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseShortcutID(tt.arg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
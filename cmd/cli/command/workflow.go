@@ -16,7 +16,7 @@ func WorkflowCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "workflow",
 		Short: "Manage workflows",
-		Long:  "Apply, list, get, export, delete, and run database-backed workflows.",
+		Long:  "Apply, list, get, export, delete, and run database-backed workflows, and cancel, retry, or re-run their runs.",
 	}
 	cmd.AddCommand(
 		workflowApplyCommand(),
@@ -26,6 +26,9 @@ func WorkflowCommand() *cobra.Command {
 		workflowDeleteCommand(),
 		workflowRunCommand(),
 		workflowRunsCommand(),
+		workflowCancelCommand(),
+		workflowRetryCommand(),
+		workflowRerunCommand(),
 	)
 	return cmd
 }
@@ -245,3 +248,71 @@ func workflowRunsCommand() *cobra.Command {
 	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 	return cmd
 }
+
+func workflowCancelCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel <run-id>",
+		Short: "Cancel a running workflow run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := parseRunIDArg(args)
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			if err := c.Workflow.Cancel(cmd.Context(), runID); err != nil {
+				return fmt.Errorf("cancel workflow run: %w", err)
+			}
+			_, _ = fmt.Printf("Workflow run %d cancelled\n", runID)
+			return nil
+		},
+	}
+}
+
+func workflowRetryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <run-id>",
+		Short: "Retry a failed workflow run from the failed task",
+		Long:  "Resume a failed or cancelled run of a resumable workflow from its checkpoint. Completed tasks are not executed again.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := parseRunIDArg(args)
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := c.Workflow.Retry(cmd.Context(), runID); err != nil {
+				return fmt.Errorf("retry workflow run: %w", err)
+			}
+			_, _ = fmt.Printf("Workflow run %d retrying\n", runID)
+			return nil
+		},
+	}
+}
+
+func workflowRerunCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rerun <run-id>",
+		Short: "Start a new workflow run with the same inputs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := parseRunIDArg(args)
+			if err != nil {
+				return err
+			}
+			c, err := utils.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+			result, err := c.Workflow.Rerun(cmd.Context(), runID)
+			if err != nil {
+				return fmt.Errorf("rerun workflow run: %w", err)
+			}
+			_, _ = fmt.Printf("Workflow run %d re-run: run_id=%d\n", runID, result.RunID)
+			return nil
+		},
+	}
+}
@@ -33,6 +33,9 @@ func TestWorkflowCommand(t *testing.T) {
 			require.Contains(t, subNames, "delete")
 			require.Contains(t, subNames, "run")
 			require.Contains(t, subNames, "runs")
+			require.Contains(t, subNames, "cancel")
+			require.Contains(t, subNames, "retry")
+			require.Contains(t, subNames, "rerun")
 		})
 	}
 }
@@ -34,6 +34,7 @@ func main() {
 		command.PipelineCommand(),
 		command.FunctionCommand(),
 		command.WorkflowCommand(),
+		command.SearchCommand(),
 		command.BookmarkCommand(),
 		command.KanbanCommand(),
 		command.ReaderCommand(),
@@ -46,6 +47,11 @@ func main() {
 		command.EmailCommand(),
 		command.NocodbCommand(),
 		command.DevopsCommand(),
+		command.AdguardCommand(),
+		command.ArchiveboxCommand(),
+		command.N8nCommand(),
+		command.SlashCommand(),
+		command.MCPCommand(),
 		command.ConfigCommand(),
 		command.VersionCommand(version.Buildtags),
 	)
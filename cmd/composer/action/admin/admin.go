@@ -3,7 +3,6 @@ package admin
 
 import (
 	"bufio"
-	"database/sql"
 	"fmt"
 	"io"
 	"os"
@@ -16,9 +15,11 @@ import (
 	"github.com/goccy/go-yaml"
 	_ "github.com/jackc/pgx/v5/stdlib" //revive:disable
 	"github.com/spf13/cobra"
+	_ "modernc.org/sqlite" //revive:disable
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/internal/store/ent/schema"
+	"github.com/flowline-io/flowbot/internal/store/sqlite"
 	"github.com/flowline-io/flowbot/pkg/auth"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
@@ -34,6 +35,9 @@ type configType struct {
 	Postgres struct {
 		DSN string `json:"dsn" yaml:"dsn"`
 	} `json:"postgres" yaml:"postgres"`
+	SQLite struct {
+		Path string `json:"path" yaml:"path"`
+	} `json:"sqlite" yaml:"sqlite"`
 }
 
 // AdminCommand returns the admin command group with management subcommands.
@@ -74,19 +78,11 @@ func tokenCreateCommand() *cobra.Command {
 func tokenCreateAction(cmd *cobra.Command, _ []string) error {
 	configFile, _ := cmd.Flags().GetString("config")
 
-	dsn, err := loadDSN(configFile)
+	db, err := openAdminDB(configFile)
 	if err != nil {
-		return fmt.Errorf("load config: %w", err)
-	}
-
-	db, err := sql.Open("pgx", dsn)
-	if err != nil {
-		return fmt.Errorf("open database: %w", err)
+		return err
 	}
 	defer func() { _ = db.Close() }()
-	if err := db.Ping(); err != nil {
-		return fmt.Errorf("ping database: %w", err)
-	}
 
 	userID, _ := cmd.Flags().GetInt("id")
 
@@ -137,29 +133,33 @@ func tokenCreateAction(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
-// loadDSN reads the postgres DSN from a flowbot.yaml config file.
-func loadDSN(path string) (string, error) {
+// loadDSN reads the database driver and DSN from a flowbot.yaml config file.
+// sqlite.path takes precedence over postgres.dsn, matching the server.
+func loadDSN(path string) (driver, dsn string, err error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return "", fmt.Errorf("open config: %w", err)
+		return "", "", fmt.Errorf("open config: %w", err)
 	}
 	defer func() { _ = file.Close() }()
 
 	data, err := io.ReadAll(file)
 	if err != nil {
-		return "", fmt.Errorf("read config: %w", err)
+		return "", "", fmt.Errorf("read config: %w", err)
 	}
 
 	var cfg configType
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return "", fmt.Errorf("parse config: %w", err)
+		return "", "", fmt.Errorf("parse config: %w", err)
 	}
 
+	if cfg.SQLite.Path != "" {
+		return "sqlite", sqlite.DSN(cfg.SQLite.Path, 0), nil
+	}
 	if cfg.Postgres.DSN == "" {
-		return "", fmt.Errorf("postgres DSN is empty")
+		return "", "", fmt.Errorf("postgres DSN and sqlite path are both empty")
 	}
 
-	return cfg.Postgres.DSN, nil
+	return "pgx", cfg.Postgres.DSN, nil
 }
 
 // selectScopes prints available scopes and reads user selection from stdin.
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/bytedance/sonic"
 	"github.com/spf13/cobra"
@@ -81,11 +82,11 @@ func confirmReset2FA(cmd *cobra.Command, username string, yes bool) error {
 }
 
 func openAdminDB(configFile string) (*sql.DB, error) {
-	dsn, err := loadDSN(configFile)
+	driver, dsn, err := loadDSN(configFile)
 	if err != nil {
 		return nil, fmt.Errorf("load config: %w", err)
 	}
-	db, err := sql.Open("pgx", dsn)
+	db, err := sql.Open(driver, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
@@ -104,9 +105,9 @@ func clearWebAccountTOTP(db *sql.DB, username string) error {
 		    totp_enabled = false,
 		    totp_last_step = 0,
 		    backup_code_hashes = $1,
-		    updated_at = NOW()
-		WHERE username = $2
-	`, "[]", username)
+		    updated_at = $2
+		WHERE username = $3
+	`, "[]", time.Now(), username)
 	if err != nil {
 		return fmt.Errorf("reset totp: %w", err)
 	}
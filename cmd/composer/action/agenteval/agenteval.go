@@ -120,6 +120,8 @@ func liveCommand() *cobra.Command {
 		sandboxImage    string
 		sandboxNetwork  string
 		sandboxMemory   string
+		cassettesDir    string
+		record          bool
 	)
 	cmd := &cobra.Command{
 		Use:   "live",
@@ -132,6 +134,7 @@ func liveCommand() *cobra.Command {
 				latencyBudgetMs: latencyBudgetMs, tokenBudget: tokenBudget, repeats: repeats,
 				sandboxMode: sandboxMode, sandboxImage: sandboxImage,
 				sandboxNetwork: sandboxNetwork, sandboxMemory: sandboxMemory,
+				cassettesDir: cassettesDir, record: record,
 			})
 		},
 	}
@@ -153,6 +156,8 @@ func liveCommand() *cobra.Command {
 	cmd.Flags().StringVar(&sandboxImage, "sandbox-image", "", "docker sandbox image override")
 	cmd.Flags().StringVar(&sandboxNetwork, "sandbox-network", "", "docker sandbox network mode")
 	cmd.Flags().StringVar(&sandboxMemory, "sandbox-memory", "", "docker sandbox memory limit, e.g. 512m")
+	cmd.Flags().StringVar(&cassettesDir, "cassettes", "", "replay subject model traffic from <dir>/<case>.json without calling a provider")
+	cmd.Flags().BoolVar(&record, "record", false, "with --cassettes: call the model and (re)write the cassettes")
 	return cmd
 }
 
@@ -163,6 +168,15 @@ type liveFlags struct {
 	latencyBudgetMs                                                                  int64
 	tokenBudget, repeats                                                             int
 	sandboxMode, sandboxImage, sandboxNetwork, sandboxMemory                         string
+	cassettesDir                                                                     string
+	record                                                                           bool
+}
+
+func (f liveFlags) cassetteMode() agentllm.CassetteMode {
+	if f.record {
+		return agentllm.CassetteRecord
+	}
+	return agentllm.CassetteReplay
 }
 
 func compareCommand() *cobra.Command {
@@ -311,6 +325,9 @@ func runHarnessRegression(ctx context.Context, outDir, casesDir, runPattern stri
 }
 
 func runCapability(ctx context.Context, f liveFlags) error {
+	if f.record && f.cassettesDir == "" {
+		return fmt.Errorf("agenteval: --record requires --cassettes")
+	}
 	workspace := filepath.Join(f.outDir, "workspaces", fmt.Sprintf("%d", time.Now().UnixNano()))
 	scenarios, goldDirs, err := loadCapabilityScenarios(f, workspace)
 	if err != nil {
@@ -348,6 +365,8 @@ func runCapability(ctx context.Context, f liveFlags) error {
 			JudgeMode:       judgeModeFromFlags(f, judgeModel != nil),
 			LatencyBudgetMs: f.latencyBudgetMs,
 			TokenBudget:     f.tokenBudget,
+			CassetteDir:     f.cassettesDir,
+			CassetteMode:    f.cassetteMode(),
 		})
 		if err != nil {
 			return err
@@ -476,6 +495,10 @@ func printProgress(ev eval.ProgressEvent) {
 }
 
 func resolveSubjectModel(ctx context.Context, f liveFlags, scenarios []eval.Scenario) (llms.Model, error) {
+	if f.cassettesDir != "" && !f.record {
+		// Replay serves every subject response from cassettes; --model only names the requests.
+		return nil, nil
+	}
 	if f.modelName == "" {
 		trials := f.trials
 		if trials <= 0 {
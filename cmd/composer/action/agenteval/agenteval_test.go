@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/flowline-io/flowbot/pkg/agent/eval"
+	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
 )
 
 func TestEvalCommandShape(t *testing.T) {
@@ -25,6 +26,8 @@ func TestEvalCommandShape(t *testing.T) {
 			require.NotNil(t, sub.Flags().Lookup("cases"))
 			require.NotNil(t, sub.Flags().Lookup("run"))
 			require.NotNil(t, sub.Flags().Lookup("difficulty"))
+			require.NotNil(t, sub.Flags().Lookup("cassettes"))
+			require.NotNil(t, sub.Flags().Lookup("record"))
 		case "run":
 			require.NotNil(t, sub.Flags().Lookup("cases"))
 			require.NotNil(t, sub.Flags().Lookup("run"))
@@ -108,6 +111,31 @@ func TestRunCapabilityFakeRepeatsDoesNotUnderflowScripts(t *testing.T) {
 	require.NotNil(t, report.Scorecard)
 }
 
+func TestRunCapabilityCassetteRecordThenReplay(t *testing.T) {
+	t.Parallel()
+	cassettes := filepath.Join(t.TempDir(), "cassettes")
+	flags := liveFlags{
+		outDir:       t.TempDir(),
+		smoke:        true,
+		trials:       2,
+		judgeFake:    true,
+		cassettesDir: cassettes,
+		record:       true,
+	}
+	require.NoError(t, runCapability(t.Context(), flags))
+	require.FileExists(t, eval.CassettePath(cassettes, eval.DefaultSmokeCaseNames[0]))
+
+	// Replay runs in a new workspace and never builds a subject model.
+	flags.outDir = t.TempDir()
+	flags.record = false
+	require.NoError(t, runCapability(t.Context(), flags))
+
+	flags.trials = 1
+	require.ErrorIs(t, runCapability(t.Context(), flags), agentllm.ErrCassetteMismatch)
+
+	require.ErrorContains(t, runCapability(t.Context(), liveFlags{record: true}), "--record requires --cassettes")
+}
+
 func TestFilterSmokeLogic(t *testing.T) {
 	t.Parallel()
 	scenarios := []eval.Scenario{
@@ -5,6 +5,8 @@ import (
 	"slices"
 
 	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/capability/adguard"
+	"github.com/flowline-io/flowbot/pkg/capability/archivebox"
 	"github.com/flowline-io/flowbot/pkg/capability/core"
 	"github.com/flowline-io/flowbot/pkg/capability/devops"
 	"github.com/flowline-io/flowbot/pkg/capability/email"
@@ -17,7 +19,9 @@ import (
 	"github.com/flowline-io/flowbot/pkg/capability/karakeep"
 	"github.com/flowline-io/flowbot/pkg/capability/memos"
 	"github.com/flowline-io/flowbot/pkg/capability/miniflux"
+	"github.com/flowline-io/flowbot/pkg/capability/n8n"
 	"github.com/flowline-io/flowbot/pkg/capability/nocodb"
+	"github.com/flowline-io/flowbot/pkg/capability/slash"
 	"github.com/flowline-io/flowbot/pkg/capability/transmission"
 	"github.com/flowline-io/flowbot/pkg/capability/trilium"
 	"github.com/flowline-io/flowbot/pkg/hub"
@@ -55,6 +59,10 @@ func workflowCapabilityCatalog() []capDoc {
 		email.CatalogSpec(),
 		nocodb.CatalogSpec(),
 		devops.CatalogSpec(),
+		adguard.CatalogSpec(),
+		archivebox.CatalogSpec(),
+		n8n.CatalogSpec(),
+		slash.CatalogSpec(),
 		gitea.CatalogSpec(),
 		github.CatalogSpec(),
 	}
@@ -105,10 +105,15 @@ Top-level definition fields and triggers: [schema.md](schema.md).
 | Field | Required | Notes |
 |-------|----------|-------|
 | ` + "`" + `name` + "`" + ` | yes | Unique within the pipeline |
-| ` + "`" + `capability` + "`" + ` | yes | Capability type (e.g. ` + "`" + `core` + "`" + `, ` + "`" + `karakeep` + "`" + `) |
-| ` + "`" + `operation` + "`" + ` | yes | Operation name on that capability |
+| ` + "`" + `capability` + "`" + ` | yes* | Capability type (e.g. ` + "`" + `core` + "`" + `, ` + "`" + `karakeep` + "`" + `) |
+| ` + "`" + `operation` + "`" + ` | yes* | Operation name on that capability |
 | ` + "`" + `params` + "`" + ` | no | Template-rendered before execution |
 | ` + "`" + `retry` + "`" + ` | no | ` + "`" + `max_attempts` + "`" + `, ` + "`" + `delay` + "`" + `, ` + "`" + `backoff` + "`" + `, ` + "`" + `max_delay` + "`" + `, ` + "`" + `jitter` + "`" + `, ` + "`" + `retry_on` + "`" + ` |
+| ` + "`" + `when` + "`" + ` | no | Template condition; falsy result (empty, ` + "`" + `false` + "`" + `, ` + "`" + `0` + "`" + `) skips the step |
+| ` + "`" + `on_failure` + "`" + ` | no | Compensating steps run when this step fails; they cannot nest ` + "`" + `on_failure` + "`" + ` |
+| ` + "`" + `foreach` + "`" + ` | no | ` + "`" + `{items, concurrency, continue_on_error}` + "`" + `; runs the step per element, ` + "`" + `{{"{{item}}"}}` + "`" + ` / ` + "`" + `{{"{{item.field}}"}}` + "`" + ` in params |
+| ` + "`" + `approval` + "`" + ` | no | ` + "`" + `{message, timeout, default, channels, uid}` + "`" + `; pauses the run until approved or rejected. *Replaces ` + "`" + `capability` + "`" + `/` + "`" + `operation` + "`" + `; needs ` + "`" + `resumable: true` + "`" + `; result ` + "`" + `{{"{{step \"<name>\" \"decision\"}}"}}` + "`" + ` |
+| ` + "`" + `call` + "`" + ` | no | ` + "`" + `{workflow or pipeline, wait}` + "`" + `; starts a child run with the rendered params as input. *Replaces ` + "`" + `capability` + "`" + `/` + "`" + `operation` + "`" + `; result ` + "`" + `{{"{{step \"<name>\" \"status\"}}"}}` + "`" + `, ` + "`" + `run_id` + "`" + `, ` + "`" + `output` + "`" + ` |
 
 ## Templates
 
@@ -189,10 +194,22 @@ steps:
 
 | Type | Fields |
 |------|--------|
-| ` + "`" + `event` + "`" + ` | ` + "`" + `event` + "`" + ` (DataEvent.EventType) |
+| ` + "`" + `event` + "`" + ` | ` + "`" + `event` + "`" + ` (DataEvent.EventType), optional ` + "`" + `filter` + "`" + ` |
 | ` + "`" + `cron` + "`" + ` | ` + "`" + `cron` + "`" + `, optional ` + "`" + `cron_timeout` + "`" + ` |
 | ` + "`" + `webhook` + "`" + ` | ` + "`" + `webhook.path` + "`" + `, auth token and/or hmac_secret |
 
+Event trigger ` + "`" + `filter` + "`" + ` predicates (all must match; checked before dedup, so rejected events create no run):
+
+` + "```" + `yaml
+filter:
+  app: [karakeep]            # DataEvent.App is one of
+  source: [ability]          # DataEvent.Source is one of
+  tags: { project: alpha }   # DataEvent.Tags; null value = key present
+  data:                      # DataEvent.Data; dotted paths allowed
+    tags: read-later         # list field contains the value
+    status: [open, new]      # any of
+` + "```" + `
+
 ## Authoring checklist
 
 1. Copy the skeleton; set ` + "`" + `name` + "`" + ` and ` + "`" + `enabled: true` + "`" + `.
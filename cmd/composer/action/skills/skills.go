@@ -474,6 +474,116 @@ var metaSpecs = []metaSpec{
 			},
 		},
 	},
+	{
+		Name:         string(hub.CapAdguard),
+		Title:        "AdGuard Home",
+		CommandFn:    command.AdguardCommand,
+		Description:  "Search the AdGuard Home DNS query log and toggle protection or filtering via flowbot adguard.",
+		Keywords:     "adguard, dns, query log, blocked, ad blocking, filtering, protection",
+		ScopesNote:   "`service:adguard:read` / `service:adguard:write`",
+		ResponseHint: "Query log is newest first; pass `next_cursor` back as `--cursor` to page older entries.",
+		Workflows: []workflowSpec{
+			{
+				Title:       "Find blocked domains",
+				Description: "When a user asks why a site is not loading or what got blocked:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot adguard querylog --status blocked --search <domain>"},
+					{Step: 2, Note: "Report the client, rule, and time of matching entries."},
+				},
+			},
+			{
+				Title:       "Pause protection",
+				Description: "When a user wants DNS blocking off for a while:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot adguard protection off --duration 10m"},
+					{Step: 2, Note: "Prefer a duration over turning protection off indefinitely; confirm before disabling."},
+				},
+			},
+		},
+	},
+	{
+		Name:         string(hub.CapArchivebox),
+		Title:        "ArchiveBox",
+		CommandFn:    command.ArchiveboxCommand,
+		Description:  "Archive URLs and browse ArchiveBox snapshots via flowbot archivebox.",
+		Keywords:     "archivebox, archive, snapshot, web archive, save page, wayback",
+		ScopesNote:   "`service:archivebox:read` / `service:archivebox:write`",
+		ResponseHint: "Snapshot ids are ArchiveBox timestamps (strings); use `list` JSON `id`.",
+		Workflows: []workflowSpec{
+			{
+				Title:       "Archive a page",
+				Description: "When a user wants to keep a permanent copy of a URL:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot archivebox add \"<url>\" --tags <tag>"},
+					{Step: 2, Note: "Archiving runs in the background; the snapshot shows as pending until it finishes."},
+				},
+			},
+			{
+				Title:       "Find an archived page",
+				Description: "When a user asks for a previously archived URL:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot archivebox list --search <text>"},
+					{Step: 2, Command: "flowbot archivebox get <id>"},
+				},
+			},
+		},
+	},
+	{
+		Name:         string(hub.CapN8n),
+		Title:        "n8n",
+		CommandFn:    command.N8nCommand,
+		Description:  "List, trigger, and toggle n8n workflows and inspect executions via flowbot n8n.",
+		Keywords:     "n8n, automation, workflow, execution, trigger, zapier",
+		ScopesNote:   "`service:n8n:read` / `service:n8n:write`",
+		ResponseHint: "Workflow and execution ids are strings; use `list` / `executions` JSON `id`.",
+		Workflows: []workflowSpec{
+			{
+				Title:       "Run a workflow",
+				Description: "When a user wants to start an n8n automation:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot n8n list"},
+					{Step: 2, Command: "flowbot n8n trigger <id> --data '{\"key\":\"value\"}'"},
+					{Step: 3, Command: "flowbot n8n executions --workflow <id> -n 1"},
+				},
+			},
+			{
+				Title:       "Investigate failures",
+				Description: "When a user asks which automations failed recently:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot n8n executions --status error"},
+					{Step: 2, Note: "Group failures by workflow id and report the most recent start time."},
+				},
+			},
+		},
+	},
+	{
+		Name:         string(hub.CapSlash),
+		Title:        "Slash",
+		CommandFn:    command.SlashCommand,
+		Description:  "Create, list, update, and delete Slash short links via flowbot slash.",
+		Keywords:     "slash, shortcut, short link, url shortener, go link, redirect",
+		ScopesNote:   "`service:slash:read` / `service:slash:write`",
+		ResponseHint: "Shortcut ids are integers; use `list` JSON `id`.",
+		Workflows: []workflowSpec{
+			{
+				Title:       "Create a short link",
+				Description: "When a user wants a memorable name for a URL:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot slash create --name <name> --link \"<url>\""},
+					{Step: 2, Note: "Names must be unique; list first if unsure."},
+				},
+			},
+			{
+				Title:       "Change or remove a short link",
+				Description: "When a user wants to repoint or delete a shortcut:",
+				Steps: []workflowStep{
+					{Step: 1, Command: "flowbot slash list"},
+					{Step: 2, Command: "flowbot slash update <id> --link \"<new-url>\""},
+					{Step: 3, Note: "Delete only after explicit confirmation: flowbot slash delete <id>."},
+				},
+			},
+		},
+	},
 	{
 		Name:         string(hub.CapGitea),
 		Title:        "Gitea",
@@ -560,6 +560,10 @@ func TestMetaSpecsUseCapabilityIDs(t *testing.T) {
 		{name: "email", skill: "email", wantCap: string(hub.CapEmail), wantTitle: "Email", wantCLI: "email"},
 		{name: "nocodb", skill: "nocodb", wantCap: string(hub.CapNocodb), wantTitle: "NocoDB", wantCLI: "nocodb"},
 		{name: "devops", skill: "devops", wantCap: string(hub.CapDevops), wantTitle: "DevOps", wantCLI: "devops"},
+		{name: "adguard", skill: "adguard", wantCap: string(hub.CapAdguard), wantTitle: "AdGuard Home", wantCLI: "adguard"},
+		{name: "archivebox", skill: "archivebox", wantCap: string(hub.CapArchivebox), wantTitle: "ArchiveBox", wantCLI: "archivebox"},
+		{name: "n8n", skill: "n8n", wantCap: string(hub.CapN8n), wantTitle: "n8n", wantCLI: "n8n"},
+		{name: "slash", skill: "slash", wantCap: string(hub.CapSlash), wantTitle: "Slash", wantCLI: "slash"},
 		{name: "gitea", skill: "gitea", wantCap: string(hub.CapGitea), wantTitle: "Gitea", wantCLI: "forge"},
 		{name: "github", skill: "github", wantCap: string(hub.CapGithub), wantTitle: "GitHub", wantCLI: "github"},
 	}
@@ -594,6 +598,10 @@ func TestMetaSpecsUseCapabilityIDs(t *testing.T) {
 			string(hub.CapEmail):        {},
 			string(hub.CapNocodb):       {},
 			string(hub.CapDevops):       {},
+			string(hub.CapAdguard):      {},
+			string(hub.CapArchivebox):   {},
+			string(hub.CapN8n):          {},
+			string(hub.CapSlash):        {},
 			string(hub.CapGitea):        {},
 			string(hub.CapGithub):       {},
 			string(hub.CapTrilium):      {},
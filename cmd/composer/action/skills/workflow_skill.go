@@ -190,6 +190,7 @@ Top-level definition fields and triggers: [schema.md](schema.md).
 | ` + "`" + `conn` + "`" + ` | no | Upstream task ids (DAG edges; required for parallel scheduling) |
 | ` + "`" + `vars` + "`" + ` | no | Optional string list (advanced; usually omit) |
 | ` + "`" + `retry` + "`" + ` | no | Same shape as pipeline retry (` + "`" + `max_attempts` + "`" + `, ` + "`" + `delay` + "`" + `, ` + "`" + `backoff` + "`" + `, ` + "`" + `max_delay` + "`" + `, ` + "`" + `jitter` + "`" + `); workflows retry all errors |
+| ` + "`" + `outputs` + "`" + ` | no | Docker/shell only: list of ` + "`" + `name` + "`" + ` + workdir-relative ` + "`" + `path` + "`" + `; stored as artifacts, mounted for ` + "`" + `conn` + "`" + ` dependents at ` + "`" + `artifacts/<task>/<name>` + "`" + `, signed URLs via ` + "`" + `{{"{{index (step \"<task>\" \"artifacts\") \"<name>\"}}"}}` + "`" + ` |
 
 With ` + "`" + `max_concurrency > 1` + "`" + `, ` + "`" + `conn` + "`" + ` drives parallel DAG scheduling. Otherwise order follows ` + "`" + `pipeline` + "`" + `.
 
@@ -665,13 +666,73 @@ Workflow does **not** map mounts, env, or timeout from YAML params.`,
     action: "mapper:"
     params:
       echoed: '{{jsonpath (step "build_payload" "result") "message"}}'`,
+		},
+		{
+			Prefix:     "approval:",
+			Title:      "Approval",
+			Summary:    "Human approval gate: pause the run until a person approves or rejects it",
+			ActionForm: "approval:",
+			Inputs: `- **Action:** must be quoted in YAML: ` + "`" + `action: "approval:"` + "`" + `. The workflow needs ` + "`" + `resumable: true` + "`" + `.
+- **Params:**
+  | Key | Type | Required | Meaning |
+  |-----|------|----------|---------|
+  | ` + "`" + `message` + "`" + ` | string | yes | Shown to the approver; supports templates |
+  | ` + "`" + `timeout` + "`" + ` | string | no | Go duration, default ` + "`" + `24h` + "`" + ` |
+  | ` + "`" + `default` + "`" + ` | string | no | ` + "`" + `approve` + "`" + ` or ` + "`" + `reject` + "`" + ` (default) applied on timeout |
+  | ` + "`" + `channels` + "`" + ` | list | no | Notify channels; default inapp plus the default channel |
+  | ` + "`" + `uid` + "`" + ` | string | no | User whose inbox receives the request |`,
+			Outputs: `Plain text ` + "`" + `approve` + "`" + ` once approved. A rejection fails the task and the run.`,
+			Usage: `- Put the gate before the task that needs sign-off and list it in that task's ` + "`" + `conn` + "`" + `.
+- The run waits (status 5) with links to ` + "`" + `/service/web/approvals/<token>` + "`" + `; the wait survives restarts.`,
+			Notes: "Handled inline by the runner; no executor runtime is used.",
+			ExampleYAML: `  - id: confirm
+    action: "approval:"
+    params:
+      message: "Deploy {{input.tag}}?"
+      timeout: 2h
+  - id: deploy
+    action: shell:./deploy.sh
+    conn: [confirm]`,
+		},
+		{
+			Prefix:     "workflow:",
+			Title:      "Call workflow",
+			Summary:    "Start a child run of another stored workflow and wait for its results",
+			ActionForm: "workflow:<name>",
+			Inputs: `- **Action:** ` + "`" + `workflow:<name>` + "`" + ` names a stored workflow.
+- **Params:** the child's inputs; string values support templates. The reserved ` + "`" + `wait` + "`" + ` param (default ` + "`" + `true` + "`" + `) set to ` + "`" + `false` + "`" + ` fires and forgets.`,
+			Outputs: `JSON **object** string ` + "`" + `{"kind","name","run_id","status","output"}` + "`" + `. ` + "`" + `output` + "`" + ` maps the child's task IDs to their results and is only set when waited for.`,
+			Usage: `- Read a child result: ` + "`" + `{{jsonpath (step "archive" "result") "output.say"}}` + "`" + `.
+- A failed or cancelled child fails the task; cancelling the parent cancels a waited-for child.
+- A child that stops at an approval gate fails a waiting call; use ` + "`" + `wait: false` + "`" + ` there.`,
+			Notes: "Child runs link to the parent run and nest at most 5 deep, which also stops self-calls.",
+			ExampleYAML: `  - id: archive
+    action: workflow:archive-and-notify
+    params:
+      url: "{{input.url}}"`,
+		},
+		{
+			Prefix:     "pipeline:",
+			Title:      "Call pipeline",
+			Summary:    "Start a child run of a loaded pipeline with the params as its event data",
+			ActionForm: "pipeline:<name>",
+			Inputs: `- **Action:** ` + "`" + `pipeline:<name>` + "`" + ` names a loaded pipeline.
+- **Params:** the child's event data, read there as ` + "`" + `{{event.<key>}}` + "`" + `. The reserved ` + "`" + `wait` + "`" + ` param works as for ` + "`" + `workflow:` + "`" + `.`,
+			Outputs: `Same JSON object as ` + "`" + `workflow:` + "`" + `; ` + "`" + `output` + "`" + ` maps the child's step names to their results.`,
+			Usage:   `- Use to reuse a pipeline's capability chain from a workflow.`,
+			Notes:   "The child has trigger source call and records the parent run.",
+			ExampleYAML: `  - id: audit
+    action: pipeline:audit-log
+    params:
+      wait: false
+      run: "{{input.id}}"`,
 		},
 		{
 			Prefix:     "free-form / echo",
 			Title:      "Free-form and echo",
 			Summary:    "Actions without a known prefix fall through to shell-style run; bare echo is a special type name",
 			ActionForm: "<command> or echo",
-			Inputs: `- **Action:** bare ` + "`" + `echo` + "`" + ` or any string without a known prefix (` + "`" + `capability:` + "`" + `/` + "`" + `docker:` + "`" + `/` + "`" + `shell:` + "`" + `/` + "`" + `machine:` + "`" + `/` + "`" + `mapper:` + "`" + `).
+			Inputs: `- **Action:** bare ` + "`" + `echo` + "`" + ` or any string without a known prefix (` + "`" + `capability:` + "`" + `/` + "`" + `docker:` + "`" + `/` + "`" + `shell:` + "`" + `/` + "`" + `machine:` + "`" + `/` + "`" + `mapper:` + "`" + `/` + "`" + `approval:` + "`" + `/` + "`" + `workflow:` + "`" + `/` + "`" + `pipeline:` + "`" + `).
 - **Params:** optional ` + "`" + `cmd` + "`" + ` (string), same override behavior as shell when treated as a shell run.`,
 			Outputs: `Plain text stdout (same as shell).`,
 			Usage: `- Prefer ` + "`" + `shell:` + "`" + `, ` + "`" + `docker:` + "`" + `, ` + "`" + `capability:` + "`" + `, or ` + "`" + `mapper:` + "`" + ` in new YAML.
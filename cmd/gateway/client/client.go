@@ -3,6 +3,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -69,12 +70,12 @@ func decodeData[T any](data any) (T, error) {
 	return out, nil
 }
 
-// Claim requests one pending job.
-func (c *Client) Claim(ctx context.Context, workerID string) (*types.GatewayJob, error) {
+// Claim requests one pending job for one of clis (any CLI when empty).
+func (c *Client) Claim(ctx context.Context, workerID string, clis []types.GatewayCLI) (*types.GatewayJob, error) {
 	resp, httpResp, err := c.do(ctx, apiCall{
 		method: http.MethodPost,
 		path:   "/gateway/v1/claim",
-		body:   types.GatewayClaimRequest{WorkerID: workerID},
+		body:   types.GatewayClaimRequest{WorkerID: workerID, CLIs: clis},
 	})
 	if err != nil {
 		return nil, err
@@ -111,6 +112,32 @@ func (c *Client) Complete(ctx context.Context, jobID string, req types.GatewayCo
 	return nil
 }
 
+// ErrLogRejected means the server refused a log chunk for good: the job is no
+// longer running, belongs to another worker, or the server has no log endpoint.
+var ErrLogRejected = errors.New("log append rejected")
+
+// AppendLog streams one output chunk of a running job.
+func (c *Client) AppendLog(ctx context.Context, jobID string, req types.GatewayLogAppendRequest) error {
+	resp, httpResp, err := c.do(ctx, apiCall{
+		method: http.MethodPost,
+		path:   "/gateway/v1/jobs/" + jobID + "/logs",
+		body:   req,
+	})
+	if err != nil {
+		return err
+	}
+	if code := httpResp.StatusCode(); code >= 400 && code < 500 {
+		return fmt.Errorf("%w: status %d: %s", ErrLogRejected, code, httpResp.String())
+	}
+	if httpResp.StatusCode() >= 300 {
+		return fmt.Errorf("append log: status %d: %s", httpResp.StatusCode(), httpResp.String())
+	}
+	if resp.Status != protocol.Success {
+		return fmt.Errorf("append log failed: %s", resp.Message)
+	}
+	return nil
+}
+
 // Heartbeat renews worker last-seen and optional job lease.
 func (c *Client) Heartbeat(ctx context.Context, workerID, jobID string) error {
 	_, httpResp, err := c.do(ctx, apiCall{
@@ -25,9 +25,26 @@ type Config struct {
 	CursorBinary       string        `yaml:"cursor_binary"`
 	CursorAPIKey       string        `yaml:"cursor_api_key"`
 	AgentAccessToken   string        `yaml:"agent_access_token"`
+	OpenCodeBinary     string        `yaml:"opencode_binary"`
+	OpenCodeModel      string        `yaml:"opencode_model"`
+	MaxOutputBytes     int           `yaml:"max_output_bytes"`
+	LogFlushInterval   time.Duration `yaml:"log_flush_interval"`
+	Runners            []RunnerSpec  `yaml:"runners"`
 	Listen             string        `yaml:"listen"`
 }
 
+// RunnerSpec configures a generic command runner. Jobs whose cli equals Name
+// run Binary with Args; Args and Env values are Go templates over the job
+// ({{.Prompt}}, {{.Workspace}}, {{.JobID}}, {{.UID}}, {{.FlowbotURL}},
+// {{.AgentToken}}) plus {{env "NAME"}} for the worker environment.
+type RunnerSpec struct {
+	Name    string            `yaml:"name"`
+	Binary  string            `yaml:"binary"`
+	Args    []string          `yaml:"args"`
+	Env     map[string]string `yaml:"env"`
+	Timeout time.Duration     `yaml:"timeout"`
+}
+
 // Load reads gateway YAML from path.
 func Load(path string) (*Config, error) {
 	raw, err := os.ReadFile(path)
@@ -62,9 +79,23 @@ func (c *Config) applyDefaults() {
 	if c.JobTimeout <= 0 {
 		c.JobTimeout = 30 * time.Minute
 	}
+	if c.LogFlushInterval <= 0 {
+		c.LogFlushInterval = 2 * time.Second
+	}
 	if c.CursorBinary == "" {
 		c.CursorBinary = "agent"
 	}
+	if c.OpenCodeBinary == "" {
+		c.OpenCodeBinary = "opencode"
+	}
+	if c.MaxOutputBytes == 0 {
+		c.MaxOutputBytes = 1 << 20
+	}
+	for i := range c.Runners {
+		if c.Runners[i].Timeout <= 0 {
+			c.Runners[i].Timeout = c.JobTimeout
+		}
+	}
 	c.applyEnvOverrides()
 }
 
@@ -100,5 +131,19 @@ func (c *Config) Validate() error {
 	if len(c.WorkspaceAllowlist) == 0 {
 		c.WorkspaceAllowlist = []string{c.DefaultWorkspace}
 	}
+	seen := map[types.GatewayCLI]bool{types.GatewayCLICursor: true, types.GatewayCLIOpenCode: true}
+	for i, r := range c.Runners {
+		name := types.GatewayCLI(r.Name)
+		if !types.ValidGatewayCLI(name) {
+			return fmt.Errorf("runners[%d]: invalid name %q (lowercase letters, digits, '.', '_', '-')", i, r.Name)
+		}
+		if seen[name] {
+			return fmt.Errorf("runners[%d]: name %q is already used", i, r.Name)
+		}
+		seen[name] = true
+		if strings.TrimSpace(r.Binary) == "" {
+			return fmt.Errorf("runners[%d]: binary is required", i)
+		}
+	}
 	return nil
 }
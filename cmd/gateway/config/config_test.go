@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRunners(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		runners []RunnerSpec
+		wantErr string
+	}{
+		{name: "none"},
+		{name: "valid", runners: []RunnerSpec{{Name: "aider", Binary: "aider"}, {Name: "flowbot-agent", Binary: "flowbot-agent"}}},
+		{name: "invalid name", runners: []RunnerSpec{{Name: "Aider", Binary: "aider"}}, wantErr: "invalid name"},
+		{name: "builtin name", runners: []RunnerSpec{{Name: "cursor", Binary: "agent"}}, wantErr: "already used"},
+		{name: "duplicate", runners: []RunnerSpec{{Name: "a", Binary: "a"}, {Name: "a", Binary: "b"}}, wantErr: "already used"},
+		{name: "missing binary", runners: []RunnerSpec{{Name: "a"}}, wantErr: "binary is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := &Config{FlowbotURL: "http://x", AccessToken: "t", DefaultWorkspace: "/w", Runners: tt.runners}
+			err := cfg.Validate()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApplyDefaultsRunnerTimeout(t *testing.T) {
+	t.Parallel()
+	cfg := &Config{JobTimeout: 5 * time.Minute, Runners: []RunnerSpec{{Name: "a"}, {Name: "b", Timeout: time.Minute}}}
+	cfg.applyDefaults()
+	assert.Equal(t, 5*time.Minute, cfg.Runners[0].Timeout)
+	assert.Equal(t, time.Minute, cfg.Runners[1].Timeout)
+	assert.Equal(t, "opencode", cfg.OpenCodeBinary)
+	assert.Equal(t, 1<<20, cfg.MaxOutputBytes)
+}
@@ -41,20 +41,13 @@ func run(cfgPath, logLevel string) int {
 		return 1
 	}
 
-	if path, lookErr := exec.LookPath(cfg.CursorBinary); lookErr != nil {
-		flog.Warn("cursor binary not found in PATH; jobs will fail until cursor_binary is fixed (cursor_binary=%s): %v",
-			cfg.CursorBinary, lookErr)
-	} else {
-		flog.Info("cursor binary resolved cursor_binary=%s path=%s", cfg.CursorBinary, path)
+	runners, err := buildRunners(cfg)
+	if err != nil {
+		flog.Error(fmt.Errorf("invalid runner config: %w", err))
+		return 1
 	}
 
 	api := client.New(cfg.FlowbotURL, cfg.AccessToken)
-	cursorRunner := runner.NewCursor(cfg.CursorBinary, cfg.CursorAPIKey, cfg.JobTimeout).
-		WithFlowbotAgent(cfg.FlowbotURL, cfg.AgentAccessToken)
-	runners := map[types.GatewayCLI]runner.Runner{
-		types.GatewayCLICursor:   cursorRunner,
-		types.GatewayCLIOpenCode: runner.Unsupported{Name: "opencode"},
-	}
 	w := worker.New(api, cfg, runners)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -64,9 +57,9 @@ func run(cfgPath, logLevel string) int {
 		go serveHealthz(ctx, cfg.Listen)
 	}
 
-	flog.Info("flowbot-gateway starting worker_id=%s flowbot_url=%s default_workspace=%s max_concurrent=%d claim_interval=%s heartbeat_interval=%s job_timeout=%s cursor_binary=%s",
+	flog.Info("flowbot-gateway starting worker_id=%s flowbot_url=%s default_workspace=%s max_concurrent=%d claim_interval=%s heartbeat_interval=%s job_timeout=%s runners=%v",
 		cfg.WorkerID, cfg.FlowbotURL, cfg.DefaultWorkspace, cfg.MaxConcurrent,
-		cfg.ClaimInterval, cfg.HeartbeatInterval, cfg.JobTimeout, cfg.CursorBinary)
+		cfg.ClaimInterval, cfg.HeartbeatInterval, cfg.JobTimeout, w.CLIs())
 	if err := w.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
 		flog.Error(fmt.Errorf("worker stopped: %w", err))
 		return 1
@@ -75,6 +68,52 @@ func run(cfgPath, logLevel string) int {
 	return 0
 }
 
+// buildRunners wires the built-in cursor and opencode runners plus any generic
+// runners from gateway.yaml. Missing binaries only warn: jobs for that CLI
+// fail until the binary is installed.
+func buildRunners(cfg *config.Config) (map[types.GatewayCLI]runner.Runner, error) {
+	cursorRunner := runner.NewCursor(cfg.CursorBinary, cfg.CursorAPIKey, cfg.JobTimeout).
+		WithFlowbotAgent(cfg.FlowbotURL, cfg.AgentAccessToken)
+	cursorRunner.MaxOutputBytes = cfg.MaxOutputBytes
+	openCodeRunner := runner.NewOpenCode(cfg.OpenCodeBinary, cfg.OpenCodeModel, cfg.JobTimeout)
+	openCodeRunner.MaxOutputBytes = cfg.MaxOutputBytes
+
+	runners := map[types.GatewayCLI]runner.Runner{
+		types.GatewayCLICursor:   cursorRunner,
+		types.GatewayCLIOpenCode: openCodeRunner,
+	}
+	binaries := map[types.GatewayCLI]string{
+		types.GatewayCLICursor:   cfg.CursorBinary,
+		types.GatewayCLIOpenCode: cfg.OpenCodeBinary,
+	}
+	for _, spec := range cfg.Runners {
+		g, err := runner.NewGeneric(runner.GenericSpec{
+			Name:             spec.Name,
+			Binary:           spec.Binary,
+			Args:             spec.Args,
+			Env:              spec.Env,
+			Timeout:          spec.Timeout,
+			MaxOutputBytes:   cfg.MaxOutputBytes,
+			FlowbotURL:       cfg.FlowbotURL,
+			AgentAccessToken: cfg.AgentAccessToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		runners[types.GatewayCLI(spec.Name)] = g
+		binaries[types.GatewayCLI(spec.Name)] = spec.Binary
+	}
+	for cli, binary := range binaries {
+		if path, lookErr := exec.LookPath(binary); lookErr != nil {
+			flog.Warn("%s binary not found in PATH; %s jobs will fail until it is installed (binary=%s): %v",
+				cli, cli, binary, lookErr)
+		} else {
+			flog.Info("%s binary resolved binary=%s path=%s", cli, binary, path)
+		}
+	}
+	return runners, nil
+}
+
 func serveHealthz(ctx context.Context, addr string) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
@@ -1,15 +1,11 @@
 package runner
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
-	"os"
-	"os/exec"
+	"io"
 	"time"
 
-	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
 
@@ -25,6 +21,8 @@ type Cursor struct {
 	// AgentAccessToken is injected for flowbot-agent as FLOWBOT_AGENT_TOKEN (agent:headless).
 	AgentAccessToken string
 	Timeout          time.Duration
+	// MaxOutputBytes caps the returned output; <= 0 disables the cap.
+	MaxOutputBytes int
 }
 
 // NewCursor builds a Cursor/flowbot-agent runner.
@@ -35,7 +33,7 @@ func NewCursor(binary, apiKey string, timeout time.Duration) *Cursor {
 	if timeout <= 0 {
 		timeout = 30 * time.Minute
 	}
-	return &Cursor{Binary: binary, APIKey: apiKey, Timeout: timeout}
+	return &Cursor{Binary: binary, APIKey: apiKey, Timeout: timeout, MaxOutputBytes: DefaultMaxOutputBytes}
 }
 
 // WithFlowbotAgent injects Flowbot URL and agent:headless token for flowbot-agent.
@@ -46,52 +44,24 @@ func (c *Cursor) WithFlowbotAgent(flowbotURL, agentToken string) *Cursor {
 }
 
 // Run implements Runner.
-func (c *Cursor) Run(ctx context.Context, job *types.GatewayJob, workspace string) Result {
+func (c *Cursor) Run(ctx context.Context, job *types.GatewayJob, workspace string, progress io.Writer) Result {
 	if job == nil {
 		return Result{ExitCode: 1, Err: errors.New("nil job")}
 	}
-	runCtx, cancel := context.WithTimeout(ctx, c.Timeout)
-	defer cancel()
-
-	args := []string{
-		"-p", "--force", "--trust",
-		"--workspace", workspace,
-		"--output-format", "text",
-		job.Prompt,
-	}
-	cmd := exec.CommandContext(runCtx, c.Binary, args...)
-	cmd.Dir = workspace
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-	cmd.Env = append(os.Environ(), c.childEnv()...)
-	flog.Info("starting cursor cli job_id=%s binary=%s workspace=%s timeout=%s",
-		job.JobID, c.Binary, workspace, c.Timeout)
-	start := time.Now()
-	err := cmd.Run()
-	out := stdout.String()
-	if stderr.Len() > 0 {
-		if out != "" {
-			out += "\n"
-		}
-		out += stderr.String()
-	}
-	code := 0
-	if err != nil {
-		var ee *exec.ExitError
-		if errors.As(err, &ee) {
-			code = ee.ExitCode()
-		} else {
-			code = 1
-			err = fmt.Errorf("cursor cli: %w", err)
-		}
-		flog.Warn("cursor cli finished with error job_id=%s exit_code=%d duration_ms=%d stdout_len=%d stderr_len=%d err=%v output=%q",
-			job.JobID, code, time.Since(start).Milliseconds(), stdout.Len(), stderr.Len(), err, truncateForLog(out, 512))
-	} else {
-		flog.Info("cursor cli finished job_id=%s exit_code=%d duration_ms=%d stdout_len=%d stderr_len=%d",
-			job.JobID, code, time.Since(start).Milliseconds(), stdout.Len(), stderr.Len())
-	}
-	return Result{Output: out, ExitCode: code, Err: err}
+	return command{
+		name:   "cursor cli",
+		binary: c.Binary,
+		args: []string{
+			"-p", "--force", "--trust",
+			"--workspace", workspace,
+			"--output-format", "text",
+			job.Prompt,
+		},
+		env:            c.childEnv(),
+		timeout:        c.Timeout,
+		maxOutputBytes: c.MaxOutputBytes,
+		progress:       progress,
+	}.run(ctx, job, workspace)
 }
 
 func (c *Cursor) childEnv() []string {
@@ -107,10 +77,3 @@ func (c *Cursor) childEnv() []string {
 	}
 	return env
 }
-
-func truncateForLog(s string, maxLen int) string {
-	if maxLen <= 0 || len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen] + "..."
-}
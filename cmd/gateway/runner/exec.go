@@ -0,0 +1,105 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+	"unicode/utf8"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// DefaultMaxOutputBytes caps combined stdout+stderr sent back to Flowbot.
+const DefaultMaxOutputBytes = 1 << 20
+
+// truncatedMarker is appended when output exceeds the runner's cap.
+const truncatedMarker = "\n...[output truncated]"
+
+// command is one prepared CLI invocation shared by all runners.
+type command struct {
+	// name labels log lines and wrapped errors ("cursor cli", "opencode cli", ...).
+	name           string
+	binary         string
+	args           []string
+	env            []string
+	timeout        time.Duration
+	maxOutputBytes int
+	// progress, when set, also receives output as it is written (see Runner).
+	progress io.Writer
+}
+
+// run executes c in workspace under timeout. Cancelling ctx (server-side job
+// cancel watched by the worker) or hitting the timeout kills the process.
+func (c command) run(ctx context.Context, job *types.GatewayJob, workspace string) Result {
+	runCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(runCtx, c.binary, c.args...)
+	cmd.Dir = workspace
+	cmd.WaitDelay = 5 * time.Second
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if c.progress != nil {
+		cmd.Stdout = io.MultiWriter(&stdout, c.progress)
+		cmd.Stderr = io.MultiWriter(&stderr, c.progress)
+	}
+	cmd.Env = append(os.Environ(), c.env...)
+	flog.Info("starting %s job_id=%s binary=%s workspace=%s timeout=%s",
+		c.name, job.JobID, c.binary, workspace, c.timeout)
+	start := time.Now()
+	err := cmd.Run()
+	out := stdout.String()
+	if stderr.Len() > 0 {
+		if out != "" {
+			out += "\n"
+		}
+		out += stderr.String()
+	}
+	out = truncateOutput(out, c.maxOutputBytes)
+	code := 0
+	if err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			code = ee.ExitCode()
+		} else {
+			code = 1
+			err = fmt.Errorf("%s: %w", c.name, err)
+		}
+		if errors.Is(runCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			err = fmt.Errorf("%s: timed out after %s", c.name, c.timeout)
+		}
+		flog.Warn("%s finished with error job_id=%s exit_code=%d duration_ms=%d stdout_len=%d stderr_len=%d err=%v output=%q",
+			c.name, job.JobID, code, time.Since(start).Milliseconds(), stdout.Len(), stderr.Len(), err, truncateForLog(out, 512))
+	} else {
+		flog.Info("%s finished job_id=%s exit_code=%d duration_ms=%d stdout_len=%d stderr_len=%d",
+			c.name, job.JobID, code, time.Since(start).Milliseconds(), stdout.Len(), stderr.Len())
+	}
+	return Result{Output: out, ExitCode: code, Err: err}
+}
+
+// truncateOutput keeps the head of s within maxBytes (on a rune boundary) and
+// appends truncatedMarker. maxBytes <= 0 disables truncation.
+func truncateOutput(s string, maxBytes int) string {
+	if maxBytes <= 0 || len(s) <= maxBytes {
+		return s
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + truncatedMarker
+}
+
+func truncateForLog(s string, maxLen int) string {
+	if maxLen <= 0 || len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen] + "..."
+}
@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestTruncateOutput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   string
+		max  int
+		want string
+	}{
+		{name: "under cap", in: "abc", max: 10, want: "abc"},
+		{name: "disabled", in: "abcdef", max: 0, want: "abcdef"},
+		{name: "cut", in: "abcdef", max: 3, want: "abc" + truncatedMarker},
+		{name: "rune boundary", in: "a世界", max: 2, want: "a" + truncatedMarker},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, truncateOutput(tt.in, tt.max))
+		})
+	}
+}
+
+func TestCommandRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	t.Parallel()
+	job := &types.GatewayJob{JobID: "j1"}
+
+	t.Run("exit code and stderr", func(t *testing.T) {
+		t.Parallel()
+		res := command{name: "test cli", binary: "sh", args: []string{"-c", "echo out; echo err >&2; exit 3"}, timeout: time.Minute}.
+			run(t.Context(), job, t.TempDir())
+		assert.Equal(t, 3, res.ExitCode)
+		assert.Equal(t, "out\n\nerr\n", res.Output)
+	})
+
+	t.Run("output truncated", func(t *testing.T) {
+		t.Parallel()
+		res := command{name: "test cli", binary: "sh", args: []string{"-c", "printf '%0100d' 0"}, timeout: time.Minute, maxOutputBytes: 10}.
+			run(t.Context(), job, t.TempDir())
+		require.NoError(t, res.Err)
+		assert.True(t, strings.HasSuffix(res.Output, truncatedMarker))
+		assert.Len(t, res.Output, 10+len(truncatedMarker))
+	})
+
+	t.Run("progress", func(t *testing.T) {
+		t.Parallel()
+		var progress syncBuffer
+		res := command{name: "test cli", binary: "sh", args: []string{"-c", "echo a; echo b >&2"}, timeout: time.Minute, progress: &progress}.
+			run(t.Context(), job, t.TempDir())
+		require.NoError(t, res.Err)
+		assert.ElementsMatch(t, []string{"a", "b"}, strings.Fields(progress.String()))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+		res := command{name: "test cli", binary: "sh", args: []string{"-c", "exec sleep 10"}, timeout: 50 * time.Millisecond}.
+			run(t.Context(), job, t.TempDir())
+		require.ErrorContains(t, res.Err, "timed out")
+		assert.NotEqual(t, 0, res.ExitCode)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(t.Context())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		start := time.Now()
+		res := command{name: "test cli", binary: "sh", args: []string{"-c", "exec sleep 10"}, timeout: time.Minute}.
+			run(ctx, job, t.TempDir())
+		require.Error(t, res.Err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf strings.Builder
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestOpenCodeArgs(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"run", "--", "-fix"}, NewOpenCode("", "", 0).args("-fix"))
+	assert.Equal(t, []string{"run", "--model", "anthropic/claude", "--", "p"}, NewOpenCode("", "anthropic/claude", 0).args("p"))
+}
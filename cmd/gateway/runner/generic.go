@@ -0,0 +1,145 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// GenericSpec describes a config-driven runner for any headless CLI.
+// Args and Env values are text/template strings rendered per job with
+// TemplateData; the env template func reads the worker's environment.
+type GenericSpec struct {
+	Name           string
+	Binary         string
+	Args           []string
+	Env            map[string]string
+	Timeout        time.Duration
+	MaxOutputBytes int
+	// FlowbotURL and AgentAccessToken are exposed to templates so flowbot-agent
+	// can be configured as a generic runner.
+	FlowbotURL       string
+	AgentAccessToken string
+}
+
+// TemplateData is the data available to generic runner templates.
+type TemplateData struct {
+	Prompt     string
+	Workspace  string
+	JobID      string
+	UID        string
+	FlowbotURL string
+	AgentToken string
+}
+
+// Generic runs GenericSpec.Binary with rendered arguments and environment.
+type Generic struct {
+	spec GenericSpec
+	args []*template.Template
+	env  map[string]*template.Template
+}
+
+var templateFuncs = template.FuncMap{
+	"env": os.Getenv,
+}
+
+// NewGeneric parses the argument and env templates up front so configuration
+// mistakes surface at startup instead of on the first job.
+func NewGeneric(spec GenericSpec) (*Generic, error) {
+	if strings.TrimSpace(spec.Binary) == "" {
+		return nil, fmt.Errorf("runner %q: binary is required", spec.Name)
+	}
+	if spec.Timeout <= 0 {
+		spec.Timeout = 30 * time.Minute
+	}
+	g := &Generic{spec: spec, env: make(map[string]*template.Template, len(spec.Env))}
+	for i, arg := range spec.Args {
+		t, err := template.New(fmt.Sprintf("%s.args[%d]", spec.Name, i)).Funcs(templateFuncs).Option("missingkey=error").Parse(arg)
+		if err != nil {
+			return nil, fmt.Errorf("runner %q: parse args[%d]: %w", spec.Name, i, err)
+		}
+		g.args = append(g.args, t)
+	}
+	for key, value := range spec.Env {
+		if key == "" || strings.ContainsAny(key, "=\x00") {
+			return nil, fmt.Errorf("runner %q: invalid env name %q", spec.Name, key)
+		}
+		t, err := template.New(spec.Name + ".env." + key).Funcs(templateFuncs).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("runner %q: parse env %s: %w", spec.Name, key, err)
+		}
+		g.env[key] = t
+	}
+	return g, nil
+}
+
+// Run implements Runner.
+func (g *Generic) Run(ctx context.Context, job *types.GatewayJob, workspace string, progress io.Writer) Result {
+	if job == nil {
+		return Result{ExitCode: 1, Err: errors.New("nil job")}
+	}
+	args, env, err := g.render(TemplateData{
+		Prompt:     job.Prompt,
+		Workspace:  workspace,
+		JobID:      job.JobID,
+		UID:        job.UID,
+		FlowbotURL: g.spec.FlowbotURL,
+		AgentToken: g.spec.AgentAccessToken,
+	})
+	if err != nil {
+		return Result{ExitCode: 1, Err: err}
+	}
+	return command{
+		name:           g.spec.Name + " cli",
+		binary:         g.spec.Binary,
+		args:           args,
+		env:            env,
+		timeout:        g.spec.Timeout,
+		maxOutputBytes: g.spec.MaxOutputBytes,
+		progress:       progress,
+	}.run(ctx, job, workspace)
+}
+
+// render expands the argument and env templates for one job. Env entries are
+// sorted so the child environment is deterministic.
+func (g *Generic) render(data TemplateData) ([]string, []string, error) {
+	args := make([]string, 0, len(g.args))
+	for _, t := range g.args {
+		s, err := execTemplate(t, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("runner %q: render %s: %w", g.spec.Name, t.Name(), err)
+		}
+		args = append(args, s)
+	}
+	keys := make([]string, 0, len(g.env))
+	for key := range g.env {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	env := make([]string, 0, len(keys))
+	for _, key := range keys {
+		s, err := execTemplate(g.env[key], data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("runner %q: render env %s: %w", g.spec.Name, key, err)
+		}
+		env = append(env, key+"="+s)
+	}
+	return args, env, nil
+}
+
+func execTemplate(t *template.Template, data TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,87 @@
+package runner
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestNewGeneric(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		spec    GenericSpec
+		wantErr string
+	}{
+		{name: "valid", spec: GenericSpec{Name: "aider", Binary: "aider", Args: []string{"--message", "{{.Prompt}}"}}},
+		{name: "missing binary", spec: GenericSpec{Name: "aider"}, wantErr: "binary is required"},
+		{name: "bad arg template", spec: GenericSpec{Name: "aider", Binary: "aider", Args: []string{"{{.Prompt"}}, wantErr: "parse args[0]"},
+		{name: "bad env name", spec: GenericSpec{Name: "aider", Binary: "aider", Env: map[string]string{"A=B": "x"}}, wantErr: "invalid env name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g, err := NewGeneric(tt.spec)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 30*time.Minute, g.spec.Timeout)
+		})
+	}
+}
+
+func TestGenericRender(t *testing.T) {
+	t.Setenv("GATEWAY_TEST_KEY", "secret")
+	g, err := NewGeneric(GenericSpec{
+		Name:   "flowbot-agent",
+		Binary: "flowbot-agent",
+		Args:   []string{"-p", "--workspace", "{{.Workspace}}", "{{.Prompt}}"},
+		Env: map[string]string{
+			"FLOWBOT_URL":         "{{.FlowbotURL}}",
+			"FLOWBOT_AGENT_TOKEN": "{{.AgentToken}}",
+			"API_KEY":             `{{env "GATEWAY_TEST_KEY"}}`,
+		},
+	})
+	require.NoError(t, err)
+
+	args, env, err := g.render(TemplateData{
+		Prompt: "fix it", Workspace: "/repo", FlowbotURL: "http://flowbot", AgentToken: "tok",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-p", "--workspace", "/repo", "fix it"}, args)
+	assert.Equal(t, []string{"API_KEY=secret", "FLOWBOT_AGENT_TOKEN=tok", "FLOWBOT_URL=http://flowbot"}, env)
+}
+
+func TestGenericRenderUnknownField(t *testing.T) {
+	t.Parallel()
+	g, err := NewGeneric(GenericSpec{Name: "x", Binary: "x", Args: []string{"{{.Nope}}"}})
+	require.NoError(t, err)
+	res := g.Run(t.Context(), &types.GatewayJob{JobID: "j1", Prompt: "p"}, t.TempDir(), nil)
+	require.Error(t, res.Err)
+	assert.Equal(t, 1, res.ExitCode)
+}
+
+func TestGenericRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	t.Parallel()
+	g, err := NewGeneric(GenericSpec{
+		Name:   "echo",
+		Binary: "sh",
+		Args:   []string{"-c", `printf '%s in %s' "$1" "$(pwd)"`, "sh", "{{.Prompt}}"},
+	})
+	require.NoError(t, err)
+	dir := t.TempDir()
+	res := g.Run(t.Context(), &types.GatewayJob{JobID: "j1", Prompt: "hello"}, dir, nil)
+	require.NoError(t, res.Err)
+	assert.Equal(t, 0, res.ExitCode)
+	assert.Contains(t, res.Output, "hello in ")
+}
@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// OpenCode runs `opencode run` non-interactively in the job workspace.
+// Provider credentials come from the worker's environment or OpenCode's own
+// config; tool permissions follow the workspace opencode.json.
+type OpenCode struct {
+	Binary string
+	// Model is passed as --model (provider/model) when set.
+	Model   string
+	Timeout time.Duration
+	// MaxOutputBytes caps the returned output; <= 0 disables the cap.
+	MaxOutputBytes int
+}
+
+// NewOpenCode builds an OpenCode runner.
+func NewOpenCode(binary, model string, timeout time.Duration) *OpenCode {
+	if binary == "" {
+		binary = "opencode"
+	}
+	if timeout <= 0 {
+		timeout = 30 * time.Minute
+	}
+	return &OpenCode{Binary: binary, Model: model, Timeout: timeout, MaxOutputBytes: DefaultMaxOutputBytes}
+}
+
+// Run implements Runner.
+func (o *OpenCode) Run(ctx context.Context, job *types.GatewayJob, workspace string, progress io.Writer) Result {
+	if job == nil {
+		return Result{ExitCode: 1, Err: errors.New("nil job")}
+	}
+	return command{
+		name:           "opencode cli",
+		binary:         o.Binary,
+		args:           o.args(job.Prompt),
+		timeout:        o.Timeout,
+		maxOutputBytes: o.MaxOutputBytes,
+		progress:       progress,
+	}.run(ctx, job, workspace)
+}
+
+func (o *OpenCode) args(prompt string) []string {
+	args := []string{"run"}
+	if o.Model != "" {
+		args = append(args, "--model", o.Model)
+	}
+	// "--" keeps prompts that start with "-" from being parsed as flags.
+	return append(args, "--", prompt)
+}
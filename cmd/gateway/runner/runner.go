@@ -3,6 +3,7 @@ package runner
 
 import (
 	"context"
+	"io"
 
 	"github.com/flowline-io/flowbot/pkg/types"
 )
@@ -14,30 +15,10 @@ type Result struct {
 	Err      error
 }
 
-// Runner executes a gateway job against a local CLI.
+// Runner executes a gateway job against a local CLI. When progress is non-nil
+// it receives stdout and stderr as they are produced; it must be safe for
+// concurrent writes and should not return errors, since a failed write aborts
+// the output copy.
 type Runner interface {
-	Run(ctx context.Context, job *types.GatewayJob, workspace string) Result
-}
-
-// Unsupported is a Runner that always fails (OpenCode placeholder).
-type Unsupported struct {
-	Name string
-}
-
-// Run implements Runner.
-func (u Unsupported) Run(context.Context, *types.GatewayJob, string) Result {
-	return Result{ExitCode: 1, Err: errUnsupported(u.Name)}
-}
-
-func errUnsupported(name string) error {
-	if name == "" {
-		name = "cli"
-	}
-	return &unsupportedError{name: name}
-}
-
-type unsupportedError struct{ name string }
-
-func (e *unsupportedError) Error() string {
-	return e.name + " runner is not enabled"
+	Run(ctx context.Context, job *types.GatewayJob, workspace string, progress io.Writer) Result
 }
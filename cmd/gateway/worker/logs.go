@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/flowline-io/flowbot/cmd/gateway/client"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// maxLogChunkBytes bounds one POST /gateway/v1/jobs/{id}/logs body.
+const maxLogChunkBytes = 32 << 10
+
+type logAppender interface {
+	AppendLog(ctx context.Context, jobID string, req types.GatewayLogAppendRequest) error
+}
+
+// logStreamer buffers runner output and appends it to the server-side job log
+// every interval, or sooner once a full chunk is buffered. It is the progress
+// writer handed to runner.Runner, so Write never blocks on the network and
+// never fails.
+type logStreamer struct {
+	api      logAppender
+	workerID string
+	jobID    string
+	interval time.Duration
+
+	mu       sync.Mutex
+	buf      []byte
+	disabled bool
+
+	kick chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newLogStreamer(api logAppender, workerID, jobID string, interval time.Duration) *logStreamer {
+	return &logStreamer{
+		api:      api,
+		workerID: workerID,
+		jobID:    jobID,
+		interval: interval,
+		kick:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Write implements io.Writer.
+func (s *logStreamer) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	if !s.disabled {
+		s.buf = append(s.buf, p...)
+	}
+	full := len(s.buf) >= maxLogChunkBytes
+	s.mu.Unlock()
+	if full {
+		select {
+		case s.kick <- struct{}{}:
+		default:
+		}
+	}
+	return len(p), nil
+}
+
+// start flushes in the background until close is called.
+func (s *logStreamer) start(ctx context.Context) {
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.flush(ctx, false)
+			case <-s.kick:
+				s.flush(ctx, false)
+			}
+		}
+	}()
+}
+
+// close stops the background loop and sends whatever is still buffered. Call
+// it before reporting the result: the server rejects logs for finished jobs.
+func (s *logStreamer) close(ctx context.Context) {
+	close(s.stop)
+	<-s.done
+	s.flush(ctx, true)
+}
+
+// flush sends buffered output in chunks. Unless final, an incomplete trailing
+// UTF-8 sequence stays buffered for the next write.
+func (s *logStreamer) flush(ctx context.Context, final bool) {
+	for {
+		chunk := s.take(final)
+		if chunk == "" {
+			return
+		}
+		err := s.api.AppendLog(ctx, s.jobID, types.GatewayLogAppendRequest{WorkerID: s.workerID, Content: chunk})
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, client.ErrLogRejected) {
+			flog.Info("log streaming stopped job_id=%s: %v", s.jobID, err)
+			s.mu.Lock()
+			s.disabled = true
+			s.buf = nil
+			s.mu.Unlock()
+			return
+		}
+		// The final result still carries the full output, so a lost chunk is
+		// only a gap in the live view.
+		flog.Warn("append log failed job_id=%s bytes=%d: %v", s.jobID, len(chunk), err)
+	}
+}
+
+func (s *logStreamer) take(final bool) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := min(len(s.buf), maxLogChunkBytes)
+	if n < len(s.buf) || !final {
+		n = completeUTF8Prefix(s.buf[:n])
+	}
+	if n == 0 {
+		return ""
+	}
+	chunk := string(s.buf[:n])
+	s.buf = append(s.buf[:0], s.buf[n:]...)
+	return chunk
+}
+
+// completeUTF8Prefix returns the length of b without a trailing partial rune.
+func completeUTF8Prefix(b []byte) int {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(b[i]) {
+			continue
+		}
+		if utf8.FullRune(b[i:]) {
+			return len(b)
+		}
+		return i
+	}
+	return len(b)
+}
@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/cmd/gateway/client"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type fakeAppender struct {
+	mu     sync.Mutex
+	chunks []string
+	err    error
+}
+
+func (f *fakeAppender) AppendLog(_ context.Context, jobID string, req types.GatewayLogAppendRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.chunks = append(f.chunks, req.Content)
+	return nil
+}
+
+func (f *fakeAppender) joined() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return strings.Join(f.chunks, "")
+}
+
+func TestLogStreamerFlushesOnInterval(t *testing.T) {
+	t.Parallel()
+	api := &fakeAppender{}
+	s := newLogStreamer(api, "w1", "j1", 10*time.Millisecond)
+	s.start(t.Context())
+
+	_, _ = s.Write([]byte("hello "))
+	require.Eventually(t, func() bool { return api.joined() == "hello " }, time.Second, 5*time.Millisecond)
+
+	_, _ = s.Write([]byte("world"))
+	s.close(t.Context())
+	assert.Equal(t, "hello world", api.joined())
+}
+
+func TestLogStreamerSplitsLargeOutput(t *testing.T) {
+	t.Parallel()
+	api := &fakeAppender{}
+	s := newLogStreamer(api, "w1", "j1", time.Hour)
+	s.start(t.Context())
+
+	big := strings.Repeat("界", maxLogChunkBytes/3+10)
+	_, _ = s.Write([]byte(big))
+	s.close(t.Context())
+
+	require.GreaterOrEqual(t, len(api.chunks), 2)
+	for _, c := range api.chunks {
+		assert.LessOrEqual(t, len(c), maxLogChunkBytes)
+		assert.True(t, strings.HasPrefix(c, "界"), "chunks split on rune boundaries")
+	}
+	assert.Equal(t, big, api.joined())
+}
+
+func TestLogStreamerStopsWhenRejected(t *testing.T) {
+	t.Parallel()
+	api := &fakeAppender{err: fmt.Errorf("%w: status 409", client.ErrLogRejected)}
+	s := newLogStreamer(api, "w1", "j1", time.Hour)
+	s.start(t.Context())
+
+	_, _ = s.Write([]byte("a"))
+	s.flush(t.Context(), false)
+	n, err := s.Write([]byte("b"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	s.close(t.Context())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.True(t, s.disabled)
+	assert.Empty(t, s.buf)
+}
+
+func TestCompleteUTF8Prefix(t *testing.T) {
+	t.Parallel()
+	full := []byte("a界")
+	assert.Equal(t, 4, completeUTF8Prefix(full))
+	assert.Equal(t, 1, completeUTF8Prefix(full[:2]))
+	assert.Equal(t, 1, completeUTF8Prefix(full[:3]))
+	assert.Equal(t, 0, completeUTF8Prefix(nil))
+}
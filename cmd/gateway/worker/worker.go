@@ -4,6 +4,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -37,6 +38,16 @@ func New(api *client.Client, cfg *config.Config, runners map[types.GatewayCLI]ru
 	}
 }
 
+// CLIs returns the sorted runner names this worker advertises when claiming.
+func (w *Worker) CLIs() []types.GatewayCLI {
+	clis := make([]types.GatewayCLI, 0, len(w.runners))
+	for cli := range w.runners {
+		clis = append(clis, cli)
+	}
+	slices.Sort(clis)
+	return clis
+}
+
 // Run loops until ctx is canceled.
 func (w *Worker) Run(ctx context.Context) error {
 	claimTicker := time.NewTicker(w.cfg.ClaimInterval)
@@ -50,6 +61,7 @@ func (w *Worker) Run(ctx context.Context) error {
 		flog.Info("initial heartbeat ok worker_id=%s", w.cfg.WorkerID)
 	}
 
+	clis := w.CLIs()
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
@@ -71,7 +83,7 @@ func (w *Worker) Run(ctx context.Context) error {
 				flog.Debug("skip claim: at max_concurrent")
 				continue
 			}
-			job, err := w.api.Claim(ctx, w.cfg.WorkerID)
+			job, err := w.api.Claim(ctx, w.cfg.WorkerID, clis)
 			if err != nil {
 				<-w.sem
 				flog.Warn("claim failed: %v", err)
@@ -116,7 +128,10 @@ func (w *Worker) execute(parent context.Context, job *types.GatewayJob) {
 		return
 	}
 	flog.Info("running job job_id=%s cli=%s workspace=%s", job.JobID, job.CLI, workspace)
-	res := r.Run(ctx, job, workspace)
+	logs := newLogStreamer(w.api, w.cfg.WorkerID, job.JobID, w.cfg.LogFlushInterval)
+	logs.start(parent)
+	res := r.Run(ctx, job, workspace, logs)
+	logs.close(parent)
 	w.reportResult(parent, ctx, job, workspace, res, start)
 }
 
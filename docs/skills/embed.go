@@ -5,5 +5,5 @@ import "embed"
 
 // FS holds Cap-ID skill trees under this directory (SKILL.md, references/, examples/, …).
 //
-//go:embed karakeep kanboard miniflux memos trilium fireflyiii transmission nocodb devops gitea github workflow pipeline
+//go:embed karakeep kanboard miniflux memos trilium fireflyiii transmission nocodb devops adguard archivebox n8n slash gitea github workflow pipeline
 var FS embed.FS
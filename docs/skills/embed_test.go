@@ -25,6 +25,10 @@ func TestEmbeddedSkillTrees(t *testing.T) {
 		{name: "transmission", dir: "transmission"},
 		{name: "nocodb", dir: "nocodb"},
 		{name: "devops", dir: "devops"},
+		{name: "adguard", dir: "adguard"},
+		{name: "archivebox", dir: "archivebox"},
+		{name: "n8n", dir: "n8n"},
+		{name: "slash", dir: "slash"},
 		{name: "gitea", dir: "gitea"},
 		{name: "github", dir: "github"},
 		{
@@ -12,6 +12,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/modules/hub"
 	"github.com/flowline-io/flowbot/internal/modules/life"
 	"github.com/flowline-io/flowbot/internal/modules/pipeline"
+	"github.com/flowline-io/flowbot/internal/modules/search"
 	"github.com/flowline-io/flowbot/internal/modules/web"
 	"github.com/flowline-io/flowbot/internal/modules/workflow"
 	"github.com/flowline-io/flowbot/pkg/config"
@@ -51,6 +52,7 @@ var Modules = fx.Options(
 		web.SetLoginRateLimiterCache,
 		workflow.Register,
 		pipeline.Register,
+		search.Register,
 		functions.Register,
 	),
 )
@@ -13,6 +13,7 @@ import (
 
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/pkg/capability"
+	archiveboxAdapter "github.com/flowline-io/flowbot/pkg/capability/archivebox"
 	emailAdapter "github.com/flowline-io/flowbot/pkg/capability/email"
 	exampleAdapter "github.com/flowline-io/flowbot/pkg/capability/example"
 	giteaAdapter "github.com/flowline-io/flowbot/pkg/capability/gitea"
@@ -20,6 +21,7 @@ import (
 	karakeepAdapter "github.com/flowline-io/flowbot/pkg/capability/karakeep"
 	memosAdapter "github.com/flowline-io/flowbot/pkg/capability/memos"
 	minifluxAdapter "github.com/flowline-io/flowbot/pkg/capability/miniflux"
+	n8nAdapter "github.com/flowline-io/flowbot/pkg/capability/n8n"
 	triliumAdapter "github.com/flowline-io/flowbot/pkg/capability/trilium"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/module"
@@ -102,6 +104,10 @@ func (moduleHandler) Bootstrap() error {
 	flog.Info("hub: registered trilium note poller")
 	mgr.RegisterPolling(emailAdapter.NewPoller())
 	flog.Info("hub: registered email message poller")
+	mgr.RegisterPolling(archiveboxAdapter.NewPoller())
+	flog.Info("hub: registered archivebox snapshot poller")
+	mgr.RegisterPolling(n8nAdapter.NewPoller())
+	flog.Info("hub: registered n8n execution poller")
 	return nil
 }
 
@@ -119,6 +125,10 @@ func (moduleHandler) Webservice(app *fiber.App) {
 	module.Webservice(app, "email", emailWebserviceRules)
 	module.Webservice(app, "nocodb", nocodbWebserviceRules)
 	module.Webservice(app, "devops", devopsWebserviceRules)
+	module.Webservice(app, "adguard", adguardWebserviceRules)
+	module.Webservice(app, "archivebox", archiveboxWebserviceRules)
+	module.Webservice(app, "n8n", n8nWebserviceRules)
+	module.Webservice(app, "slash", slashWebserviceRules)
 }
 
 func (moduleHandler) Rules() []any {
@@ -11,8 +11,12 @@ import (
 
 	"github.com/flowline-io/flowbot/internal/store/ent/schema"
 	"github.com/flowline-io/flowbot/pkg/capability"
+	capadguard "github.com/flowline-io/flowbot/pkg/capability/adguard"
+	caparchivebox "github.com/flowline-io/flowbot/pkg/capability/archivebox"
 	"github.com/flowline-io/flowbot/pkg/capability/devops"
 	capemail "github.com/flowline-io/flowbot/pkg/capability/email"
+	capn8n "github.com/flowline-io/flowbot/pkg/capability/n8n"
+	capslash "github.com/flowline-io/flowbot/pkg/capability/slash"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/hub"
 	"github.com/flowline-io/flowbot/pkg/providers/kanboard"
@@ -2057,4 +2061,314 @@ func invokeDevops(ctx fiber.Ctx, operation string, params map[string]any) error
 	return ctx.JSON(protocol.NewSuccessResponse(res))
 }
 
+// --- AdGuard Home routes (registered under /service/adguard) ---
+
+var adguardWebserviceRules = []webservice.Rule{
+	webservice.Get("/status", adguardStatus),
+	webservice.Get("/stats", adguardStats),
+	webservice.Get("/querylog", adguardQueryLog),
+	webservice.Post("/protection", adguardSetProtection),
+	webservice.Post("/filtering", adguardSetFiltering),
+	webservice.Get("/health", adguardHealth),
+}
+
+func adguardStatus(ctx fiber.Ctx) error {
+	return invokeAdguard(ctx, capadguard.OpStatus, map[string]any{})
+}
+
+func adguardStats(ctx fiber.Ctx) error {
+	return invokeAdguard(ctx, capadguard.OpStats, map[string]any{})
+}
+
+func adguardQueryLog(ctx fiber.Ctx) error {
+	params := map[string]any{}
+	for _, key := range []string{"limit", "cursor", "search", "status"} {
+		if v := ctx.Query(key); v != "" {
+			params[key] = v
+		}
+	}
+	return invokeAdguard(ctx, capadguard.OpQueryLog, params)
+}
+
+func adguardSetProtection(ctx fiber.Ctx) error {
+	var body struct {
+		Enabled  *bool  `json:"enabled"`
+		Duration string `json:"duration"`
+	}
+	if err := ctx.Bind().Body(&body); err != nil {
+		return types.WrapError(types.ErrInvalidArgument, "decode adguard protection request", err)
+	}
+	if body.Enabled == nil {
+		return types.Errorf(types.ErrInvalidArgument, "enabled is required")
+	}
+	params := map[string]any{"enabled": *body.Enabled}
+	if body.Duration != "" {
+		params["duration"] = body.Duration
+	}
+	return invokeAdguard(ctx, capadguard.OpSetProtection, params)
+}
+
+func adguardSetFiltering(ctx fiber.Ctx) error {
+	var body struct {
+		Enabled *bool `json:"enabled"`
+	}
+	if err := ctx.Bind().Body(&body); err != nil {
+		return types.WrapError(types.ErrInvalidArgument, "decode adguard filtering request", err)
+	}
+	if body.Enabled == nil {
+		return types.Errorf(types.ErrInvalidArgument, "enabled is required")
+	}
+	return invokeAdguard(ctx, capadguard.OpSetFiltering, map[string]any{"enabled": *body.Enabled})
+}
+
+func adguardHealth(ctx fiber.Ctx) error {
+	return invokeAdguard(ctx, capadguard.OpHealth, map[string]any{})
+}
+
+func invokeAdguard(ctx fiber.Ctx, operation string, params map[string]any) error {
+	res, err := capability.Invoke(context.Background(), hub.CapAdguard, operation, params)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(res))
+}
+
+// --- ArchiveBox routes (registered under /service/archivebox) ---
+
+var archiveboxWebserviceRules = []webservice.Rule{
+	webservice.Post("/snapshots", addArchiveboxSnapshots),
+	webservice.Get("/snapshots", listArchiveboxSnapshots),
+	webservice.Get("/snapshots/:id", getArchiveboxSnapshot),
+	webservice.Get("/health", archiveboxHealth),
+}
+
+func addArchiveboxSnapshots(ctx fiber.Ctx) error {
+	var body struct {
+		URLs []string `json:"urls"`
+		Tags []string `json:"tags"`
+	}
+	if err := ctx.Bind().Body(&body); err != nil {
+		return types.WrapError(types.ErrInvalidArgument, "decode archivebox add request", err)
+	}
+	if len(body.URLs) == 0 {
+		return types.Errorf(types.ErrInvalidArgument, "urls is required")
+	}
+	return invokeArchivebox(ctx, caparchivebox.OpAdd, map[string]any{
+		"urls": body.URLs,
+		"tags": body.Tags,
+	})
+}
+
+func listArchiveboxSnapshots(ctx fiber.Ctx) error {
+	params := map[string]any{}
+	for _, key := range []string{"limit", "cursor", "search", "tag"} {
+		if v := ctx.Query(key); v != "" {
+			params[key] = v
+		}
+	}
+	return invokeArchivebox(ctx, caparchivebox.OpList, params)
+}
+
+func getArchiveboxSnapshot(ctx fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	return invokeArchivebox(ctx, caparchivebox.OpGet, map[string]any{"id": id})
+}
+
+func archiveboxHealth(ctx fiber.Ctx) error {
+	return invokeArchivebox(ctx, caparchivebox.OpHealth, map[string]any{})
+}
+
+func invokeArchivebox(ctx fiber.Ctx, operation string, params map[string]any) error {
+	res, err := capability.Invoke(context.Background(), hub.CapArchivebox, operation, params)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(res))
+}
+
+// --- n8n routes (registered under /service/n8n) ---
+
+var n8nWebserviceRules = []webservice.Rule{
+	webservice.Get("/workflows", listN8nWorkflows),
+	webservice.Get("/workflows/:id", getN8nWorkflow),
+	webservice.Post("/workflows/:id/activate", activateN8nWorkflow),
+	webservice.Post("/workflows/:id/deactivate", deactivateN8nWorkflow),
+	webservice.Post("/workflows/:id/trigger", triggerN8nWorkflow),
+	webservice.Get("/executions", listN8nExecutions),
+	webservice.Get("/health", n8nHealth),
+}
+
+func listN8nWorkflows(ctx fiber.Ctx) error {
+	return invokeN8n(ctx, capn8n.OpListWorkflows, map[string]any{})
+}
+
+func getN8nWorkflow(ctx fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	return invokeN8n(ctx, capn8n.OpGetWorkflow, map[string]any{"id": id})
+}
+
+func activateN8nWorkflow(ctx fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	return invokeN8n(ctx, capn8n.OpActivateWorkflow, map[string]any{"id": id})
+}
+
+func deactivateN8nWorkflow(ctx fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	return invokeN8n(ctx, capn8n.OpDeactivateWorkflow, map[string]any{"id": id})
+}
+
+func triggerN8nWorkflow(ctx fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	var body struct {
+		Data map[string]any `json:"data"`
+	}
+	if len(ctx.Body()) > 0 {
+		if err := ctx.Bind().Body(&body); err != nil {
+			return types.WrapError(types.ErrInvalidArgument, "decode n8n trigger request", err)
+		}
+	}
+	params := map[string]any{"id": id}
+	if body.Data != nil {
+		params["data"] = body.Data
+	}
+	return invokeN8n(ctx, capn8n.OpTriggerWorkflow, params)
+}
+
+func listN8nExecutions(ctx fiber.Ctx) error {
+	params := map[string]any{}
+	for _, key := range []string{"limit", "cursor", "workflow_id", "status"} {
+		if v := ctx.Query(key); v != "" {
+			params[key] = v
+		}
+	}
+	return invokeN8n(ctx, capn8n.OpListExecutions, params)
+}
+
+func n8nHealth(ctx fiber.Ctx) error {
+	return invokeN8n(ctx, capn8n.OpHealth, map[string]any{})
+}
+
+func invokeN8n(ctx fiber.Ctx, operation string, params map[string]any) error {
+	res, err := capability.Invoke(context.Background(), hub.CapN8n, operation, params)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(res))
+}
+
+// --- Slash routes (registered under /service/slash) ---
+
+var slashWebserviceRules = []webservice.Rule{
+	webservice.Get("/shortcuts", listSlashShortcuts),
+	webservice.Get("/shortcuts/:id", getSlashShortcut),
+	webservice.Post("/shortcuts", createSlashShortcut),
+	webservice.Patch("/shortcuts/:id", updateSlashShortcut),
+	webservice.Delete("/shortcuts/:id", deleteSlashShortcut),
+	webservice.Get("/health", slashHealth),
+}
+
+// slashShortcutBody is the JSON body for creating or updating a shortcut.
+// Pointer fields distinguish "not sent" from "set to empty" on update.
+type slashShortcutBody struct {
+	Name        *string  `json:"name"`
+	Link        *string  `json:"link"`
+	Title       *string  `json:"title"`
+	Description *string  `json:"description"`
+	Tags        []string `json:"tags"`
+	Visibility  *string  `json:"visibility"`
+}
+
+func (b *slashShortcutBody) params() map[string]any {
+	params := map[string]any{}
+	for key, value := range map[string]*string{
+		"name": b.Name, "link": b.Link, "title": b.Title,
+		"description": b.Description, "visibility": b.Visibility,
+	} {
+		if value != nil {
+			params[key] = *value
+		}
+	}
+	if b.Tags != nil {
+		params["tags"] = b.Tags
+	}
+	return params
+}
+
+func slashShortcutID(ctx fiber.Ctx) (int64, error) {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid shortcut id %q", ctx.Params("id"))
+	}
+	return id, nil
+}
+
+func listSlashShortcuts(ctx fiber.Ctx) error {
+	return invokeSlash(ctx, capslash.OpList, map[string]any{})
+}
+
+func getSlashShortcut(ctx fiber.Ctx) error {
+	id, err := slashShortcutID(ctx)
+	if err != nil {
+		return err
+	}
+	return invokeSlash(ctx, capslash.OpGet, map[string]any{"id": id})
+}
+
+func createSlashShortcut(ctx fiber.Ctx) error {
+	var body slashShortcutBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return types.WrapError(types.ErrInvalidArgument, "decode slash create request", err)
+	}
+	return invokeSlash(ctx, capslash.OpCreate, body.params())
+}
+
+func updateSlashShortcut(ctx fiber.Ctx) error {
+	id, err := slashShortcutID(ctx)
+	if err != nil {
+		return err
+	}
+	var body slashShortcutBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return types.WrapError(types.ErrInvalidArgument, "decode slash update request", err)
+	}
+	params := body.params()
+	params["id"] = id
+	return invokeSlash(ctx, capslash.OpUpdate, params)
+}
+
+func deleteSlashShortcut(ctx fiber.Ctx) error {
+	id, err := slashShortcutID(ctx)
+	if err != nil {
+		return err
+	}
+	return invokeSlash(ctx, capslash.OpDelete, map[string]any{"id": id})
+}
+
+func slashHealth(ctx fiber.Ctx) error {
+	return invokeSlash(ctx, capslash.OpHealth, map[string]any{})
+}
+
+func invokeSlash(ctx fiber.Ctx, operation string, params map[string]any) error {
+	res, err := capability.Invoke(context.Background(), hub.CapSlash, operation, params)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(res))
+}
+
 var _ types.MsgPayload
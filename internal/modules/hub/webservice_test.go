@@ -416,6 +416,157 @@ func TestDevopsWebserviceRules_Structure(t *testing.T) {
 	}
 }
 
+func TestAdguardWebserviceRules_Structure(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{
+			name: "should contain adguard endpoints",
+			test: func(t *testing.T) {
+				t.Parallel()
+				paths := make(map[string]bool)
+				for _, r := range adguardWebserviceRules {
+					paths[r.Path] = true
+				}
+				for _, expected := range []string{
+					"/status",
+					"/stats",
+					"/querylog",
+					"/protection",
+					"/filtering",
+					"/health",
+				} {
+					assert.True(t, paths[expected], "expected path %q in adguard webservice rules", expected)
+				}
+			},
+		},
+		{
+			name: "should have six rules",
+			test: func(t *testing.T) {
+				t.Parallel()
+				assert.Len(t, adguardWebserviceRules, 6)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.test)
+	}
+}
+
+func TestArchiveboxWebserviceRules_Structure(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{
+			name: "should contain archivebox endpoints",
+			test: func(t *testing.T) {
+				t.Parallel()
+				paths := make(map[string]bool)
+				for _, r := range archiveboxWebserviceRules {
+					paths[r.Path] = true
+				}
+				for _, expected := range []string{
+					"/snapshots",
+					"/snapshots/:id",
+					"/health",
+				} {
+					assert.True(t, paths[expected], "expected path %q in archivebox webservice rules", expected)
+				}
+			},
+		},
+		{
+			name: "should have four rules",
+			test: func(t *testing.T) {
+				t.Parallel()
+				assert.Len(t, archiveboxWebserviceRules, 4)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.test)
+	}
+}
+
+func TestN8nWebserviceRules_Structure(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{
+			name: "should contain n8n endpoints",
+			test: func(t *testing.T) {
+				t.Parallel()
+				paths := make(map[string]bool)
+				for _, r := range n8nWebserviceRules {
+					paths[r.Path] = true
+				}
+				for _, expected := range []string{
+					"/workflows",
+					"/workflows/:id",
+					"/workflows/:id/activate",
+					"/workflows/:id/deactivate",
+					"/workflows/:id/trigger",
+					"/executions",
+					"/health",
+				} {
+					assert.True(t, paths[expected], "expected path %q in n8n webservice rules", expected)
+				}
+			},
+		},
+		{
+			name: "should have seven rules",
+			test: func(t *testing.T) {
+				t.Parallel()
+				assert.Len(t, n8nWebserviceRules, 7)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.test)
+	}
+}
+
+func TestSlashWebserviceRules_Structure(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{
+			name: "should contain slash endpoints",
+			test: func(t *testing.T) {
+				t.Parallel()
+				paths := make(map[string]bool)
+				for _, r := range slashWebserviceRules {
+					paths[r.Path] = true
+				}
+				for _, expected := range []string{
+					"/shortcuts",
+					"/shortcuts/:id",
+					"/health",
+				} {
+					assert.True(t, paths[expected], "expected path %q in slash webservice rules", expected)
+				}
+			},
+		},
+		{
+			name: "should have six rules",
+			test: func(t *testing.T) {
+				t.Parallel()
+				assert.Len(t, slashWebserviceRules, 6)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.test)
+	}
+}
+
 func TestForgeGetRepo_Validation(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
@@ -20,6 +21,9 @@ var webserviceRules = []webservice.Rule{
 	webservice.Delete("/delete/:name", deletePipeline),
 	webservice.Post("/run", runPipeline),
 	webservice.Get("/runs/:name", listPipelineRuns),
+	webservice.Post("/cancel/:id", cancelPipelineRun),
+	webservice.Post("/retry/:id", retryPipelineRun),
+	webservice.Post("/rerun/:id", rerunPipelineRun),
 }
 
 func applyPipeline(ctx fiber.Ctx) error {
@@ -175,6 +179,55 @@ func listPipelineRuns(ctx fiber.Ctx) error {
 	return ctx.JSON(protocol.NewSuccessResponse(types.KV{"runs": items}))
 }
 
+func cancelPipelineRun(ctx fiber.Ctx) error {
+	runID, err := runIDParam(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	if err := svc.CancelRun(ctx.Context(), runID); err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(types.KV{"run_id": runID, "cancelled": true}))
+}
+
+func retryPipelineRun(ctx fiber.Ctx) error {
+	runID, err := runIDParam(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	if err := svc.RetryRun(ctx.Context(), runID); err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusAccepted).JSON(protocol.NewSuccessResponse(types.KV{"run_id": runID}))
+}
+
+func rerunPipelineRun(ctx fiber.Ctx) error {
+	runID, err := runIDParam(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	newRunID, err := svc.RerunRun(ctx.Context(), runID)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusAccepted).JSON(protocol.NewSuccessResponse(types.KV{
+		"run_id":        newRunID,
+		"source_run_id": runID,
+	}))
+}
+
 func pipelineNameParam(ctx fiber.Ctx) string {
 	name := strings.TrimSpace(ctx.Params("name"))
 	if name == "" {
@@ -190,3 +243,15 @@ func requestUID(ctx fiber.Ctx) string {
 	}
 	return rc.UID.String()
 }
+
+func runIDParam(ctx fiber.Ctx) (int64, error) {
+	raw := strings.TrimSpace(ctx.Params("id"))
+	if raw == "" {
+		return 0, types.Errorf(types.ErrInvalidArgument, "run id is required")
+	}
+	runID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || runID <= 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid run id")
+	}
+	return runID, nil
+}
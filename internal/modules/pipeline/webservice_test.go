@@ -156,6 +156,9 @@ func mountPipelineHandlers(app *fiber.App) {
 	app.Post("/service/pipeline/run", runPipeline)
 	app.Get("/service/pipeline/runs/:name", listPipelineRuns)
 	app.Get("/service/pipeline/runs", listPipelineRuns)
+	app.Post("/service/pipeline/cancel/:id", cancelPipelineRun)
+	app.Post("/service/pipeline/retry/:id", retryPipelineRun)
+	app.Post("/service/pipeline/rerun/:id", rerunPipelineRun)
 }
 
 func samplePipelineYAML(name string) string {
@@ -261,6 +264,27 @@ func TestPipelineHandlers(t *testing.T) {
 			wantStatus: http.StatusServiceUnavailable,
 			wantSubstr: "pipeline engine not ready",
 		},
+		{
+			name:       "cancel rejects invalid id",
+			method:     http.MethodPost,
+			path:       "/service/pipeline/cancel/0",
+			wantStatus: http.StatusBadRequest,
+			wantSubstr: "invalid run id",
+		},
+		{
+			name:       "retry without engine is unavailable",
+			method:     http.MethodPost,
+			path:       "/service/pipeline/retry/9",
+			wantStatus: http.StatusServiceUnavailable,
+			wantSubstr: "pipeline engine not ready",
+		},
+		{
+			name:       "rerun without engine is unavailable",
+			method:     http.MethodPost,
+			path:       "/service/pipeline/rerun/9",
+			wantStatus: http.StatusServiceUnavailable,
+			wantSubstr: "pipeline engine not ready",
+		},
 		{
 			name:       "runs list skips nil and shapes fields",
 			method:     http.MethodGet,
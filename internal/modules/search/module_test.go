@@ -0,0 +1,39 @@
+package search
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetSearchModuleState(t *testing.T) {
+	t.Helper()
+	handler = moduleHandler{}
+	config = configType{}
+}
+
+func TestModuleInitAndRoutes(t *testing.T) {
+	resetSearchModuleState(t)
+	t.Cleanup(func() { resetSearchModuleState(t) })
+
+	require.NoError(t, InitForE2E(json.RawMessage(`{"enabled":true}`)))
+	assert.True(t, handler.IsReady())
+
+	app := fiber.New()
+	MountForE2E(app)
+
+	for _, path := range []string{"/service/search/query?q=x", "/service/search/autocomplete?q=x"} {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+			req, err := http.NewRequest(http.MethodGet, path, http.NoBody)
+			require.NoError(t, err)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.NotEqual(t, http.StatusNotFound, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/flowline-io/flowbot/pkg/route"
+	pkgsearch "github.com/flowline-io/flowbot/pkg/search"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/protocol"
+	"github.com/flowline-io/flowbot/pkg/types/ruleset/webservice"
+)
+
+var webserviceRules = []webservice.Rule{
+	webservice.Get("/query", querySearch),
+	webservice.Get("/autocomplete", autocompleteSearch),
+}
+
+// querySearch returns ranked full-text hits for q, optionally restricted to a
+// comma-separated source list.
+func querySearch(ctx fiber.Ctx) error {
+	q, err := searchQuery(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	hits, err := svc.Query(ctx.Context(), q)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(types.KV{"hits": hits}))
+}
+
+// autocompleteSearch returns title completions for the partial query q.
+func autocompleteSearch(ctx fiber.Ctx) error {
+	q, err := searchQuery(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	hits, err := svc.Autocomplete(ctx.Context(), q)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(types.KV{"hits": hits}))
+}
+
+func searchQuery(ctx fiber.Ctx) (pkgsearch.Query, error) {
+	text := strings.TrimSpace(ctx.Query("q"))
+	if text == "" {
+		return pkgsearch.Query{}, types.Errorf(types.ErrInvalidArgument, "q is required")
+	}
+	sources, err := pkgsearch.ParseSources(ctx.Query("source"))
+	if err != nil {
+		return pkgsearch.Query{}, err
+	}
+	limit := 0
+	if raw := strings.TrimSpace(ctx.Query("limit")); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			return pkgsearch.Query{}, types.Errorf(types.ErrInvalidArgument, "invalid limit %q", raw)
+		}
+	}
+	return pkgsearch.Query{Text: text, Sources: sources, UID: requestUID(ctx), Limit: limit}, nil
+}
+
+func requestUID(ctx fiber.Ctx) string {
+	rc := route.GetRequestContext(ctx)
+	if rc == nil || rc.UID.IsZero() {
+		return ""
+	}
+	return rc.UID.String()
+}
@@ -0,0 +1,128 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgsearch "github.com/flowline-io/flowbot/pkg/search"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/protocol"
+)
+
+// handlerStore matches documents by title substring.
+type handlerStore struct {
+	docs []pkgsearch.Document
+}
+
+func (s *handlerStore) UpsertSearchDocuments(context.Context, []pkgsearch.Document) error {
+	return nil
+}
+
+func (s *handlerStore) DeleteSearchDocuments(context.Context, string, []string) error {
+	return nil
+}
+
+func (s *handlerStore) PruneSearchDocuments(context.Context, string, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (s *handlerStore) FindSearchDocuments(_ context.Context, f pkgsearch.Filter) ([]pkgsearch.Match, error) {
+	var out []pkgsearch.Match
+	for _, d := range s.docs {
+		if len(f.Sources) > 0 && !slices.Contains(f.Sources, d.Source) {
+			continue
+		}
+		if slices.ContainsFunc(f.Terms, func(term string) bool {
+			return !strings.Contains(strings.ToLower(d.Title), term)
+		}) {
+			continue
+		}
+		out = append(out, pkgsearch.Match{Document: d})
+	}
+	return out, nil
+}
+
+func withSearchService(t *testing.T, svc *pkgsearch.Service) {
+	t.Helper()
+	prev := pkgsearch.ActiveService()
+	pkgsearch.SetActiveService(svc)
+	t.Cleanup(func() { pkgsearch.SetActiveService(prev) })
+}
+
+func newSearchHandlerApp() *fiber.App {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: func(c fiber.Ctx, err error) error {
+			code := fiber.StatusInternalServerError
+			var te *types.Error
+			if errors.As(err, &te) {
+				switch te.Kind {
+				case types.ErrInvalidArgument:
+					code = fiber.StatusBadRequest
+				case types.ErrUnavailable:
+					code = fiber.StatusServiceUnavailable
+				}
+			}
+			return c.Status(code).JSON(protocol.NewFailedResponse(err))
+		},
+	})
+	app.Get("/service/search/query", querySearch)
+	app.Get("/service/search/autocomplete", autocompleteSearch)
+	return app
+}
+
+func TestSearchHandlers(t *testing.T) {
+	withSearchService(t, pkgsearch.NewService(&handlerStore{docs: []pkgsearch.Document{
+		{Source: pkgsearch.SourceBookmark, ID: "b1", Title: "Homelab backups", URL: "https://example.com/backups"},
+		{Source: pkgsearch.SourceMemo, ID: "memos/1", Title: "Backup rotation"},
+	}}))
+	app := newSearchHandlerApp()
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantSubstr string
+	}{
+		{name: "query", path: "/service/search/query?q=backups", wantStatus: http.StatusOK, wantSubstr: `"id":"b1"`},
+		{name: "query with source filter", path: "/service/search/query?q=backup&source=memo", wantStatus: http.StatusOK, wantSubstr: `"id":"memos/1"`},
+		{name: "autocomplete", path: "/service/search/autocomplete?q=home", wantStatus: http.StatusOK, wantSubstr: `"title":"Homelab backups"`},
+		{name: "missing q", path: "/service/search/query", wantStatus: http.StatusBadRequest, wantSubstr: "q is required"},
+		{name: "unknown source", path: "/service/search/query?q=x&source=wiki", wantStatus: http.StatusBadRequest, wantSubstr: "unknown search source"},
+		{name: "invalid limit", path: "/service/search/query?q=x&limit=abc", wantStatus: http.StatusBadRequest, wantSubstr: "invalid limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, tt.path, http.NoBody))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			raw, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, string(raw))
+			assert.Contains(t, string(raw), tt.wantSubstr)
+		})
+	}
+}
+
+func TestSearchHandlersServiceUnavailable(t *testing.T) {
+	withSearchService(t, nil)
+	app := newSearchHandlerApp()
+	for _, path := range []string{"/service/search/query?q=x", "/service/search/autocomplete?q=x"} {
+		t.Run(path, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, http.NoBody))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		})
+	}
+}
@@ -34,10 +34,12 @@ func renderAccountSecurity(ctx fiber.Ctx, flash, errorMsg string) error {
 	username := accountUsername(rc)
 	backupRemaining := 0
 	totpEnabled := false
+	var identities []pages.LinkedIdentity
 	ws := store.WebAccountStoreFromDB()
 	if account, err := ws.GetByUsername(context.Background(), username); err == nil && account != nil {
 		backupRemaining = len(account.BackupCodeHashes)
 		totpEnabled = account.TotpEnabled
+		identities = linkedIdentities(context.Background(), account.UID)
 	}
 	csrfTok, err := ensureCSRFCookie(ctx)
 	if err != nil {
@@ -45,7 +47,7 @@ func renderAccountSecurity(ctx fiber.Ctx, flash, errorMsg string) error {
 	}
 	ctx.Set("Cache-Control", "no-store")
 	ctx.Type("html")
-	return pages.AccountSecurityPage(ctx.Context(), username, totpEnabled, backupRemaining, flash, errorMsg, csrfTok).
+	return pages.AccountSecurityPage(ctx.Context(), username, totpEnabled, backupRemaining, identities, flash, errorMsg, csrfTok).
 		Render(ctx.Context(), ctx.Response().BodyWriter())
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/server/chatagent"
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
@@ -101,6 +102,7 @@ func agentKnowledgeCreate(ctx fiber.Ctx) error {
 		}
 		return toastErrorKey(ctx, "toast.agent_knowledge.create_failed")
 	}
+	embed.Kick()
 	flog.Info("[web] agent knowledge created uid=%s id=%d path=%s", getUID(ctx), row.ID, row.Path)
 	ctx.Type("html")
 	ctx.Response().BodyWriter().Write([]byte(`<tr id="agent-knowledge-empty" hx-swap-oob="delete"></tr>`))
@@ -168,6 +170,7 @@ func agentKnowledgeUpdate(ctx fiber.Ctx) error {
 		}
 		return toastErrorKey(ctx, "toast.agent_knowledge.update_failed")
 	}
+	embed.Kick()
 	flog.Info("[web] agent knowledge updated uid=%s id=%d path=%s", getUID(ctx), id, existing.Path)
 	updated, err := loadAgentKnowledgeModel(reqCtx, id)
 	if err != nil {
@@ -192,6 +195,7 @@ func agentKnowledgeDelete(ctx fiber.Ctx) error {
 		}
 		return toastErrorKey(ctx, "toast.agent_knowledge.delete_failed")
 	}
+	embed.Kick()
 	flog.Info("[web] agent knowledge deleted uid=%s id=%d", getUID(ctx), id)
 	items, err := store.AgentStoreFromDB().ListAgentKnowledge(reqCtx, store.AgentKnowledgeListFilter{})
 	if err == nil && len(items) == 0 {
@@ -12,6 +12,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/server/chatagent"
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 	"github.com/flowline-io/flowbot/pkg/types/protocol"
@@ -101,6 +102,7 @@ func agentMemoryUpsertFact(ctx fiber.Ctx) error {
 		return types.WrapError(types.ErrInvalidArgument, "update memory fact", err)
 	}
 	chatagent.InvalidatePromptCache()
+	embed.Kick()
 	return ctx.JSON(protocol.NewSuccessResponse(agentMemoryFactModelFromGen(row)))
 }
 
@@ -125,6 +127,7 @@ func agentMemorySaveFactForm(ctx fiber.Ctx) error {
 		return toastErrorKey(ctx, "toast.agent_memory.save_failed")
 	}
 	chatagent.InvalidatePromptCache()
+	embed.Kick()
 	return renderAgentMemoryTable(ctx, scope)
 }
 
@@ -147,6 +150,7 @@ func agentMemoryDeleteFactForm(ctx fiber.Ctx) error {
 		return toastErrorKey(ctx, "toast.agent_memory.delete_failed")
 	}
 	chatagent.InvalidatePromptCache()
+	embed.Kick()
 	return renderAgentMemoryTable(ctx, scope)
 }
 
@@ -166,6 +170,7 @@ func agentMemoryDeleteFact(ctx fiber.Ctx) error {
 		return types.WrapError(types.ErrInternal, "delete memory fact", err)
 	}
 	chatagent.InvalidatePromptCache()
+	embed.Kick()
 	return ctx.JSON(protocol.NewSuccessResponse(map[string]string{"status": "deleted"}))
 }
 
@@ -213,7 +213,11 @@ func agentsPage(ctx fiber.Ctx) error {
 		}
 	}
 	ctx.Type("html")
-	return pages.AgentsPage(ctx.Context(), items, nextCursor, agentsEndpointsWithFilter(filter, webRequestUID(ctx), pendingCount), enabled).
+	var mcpServers []model.AgentMCPServer
+	if enabled {
+		mcpServers = chatagent.MCPServerStatuses()
+	}
+	return pages.AgentsPage(ctx.Context(), items, nextCursor, agentsEndpointsWithFilter(filter, webRequestUID(ctx), pendingCount), enabled, mcpServers).
 		Render(ctx.Context(), ctx.Response().BodyWriter())
 }
 
@@ -0,0 +1,112 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/ruleset/webservice"
+	"github.com/flowline-io/flowbot/pkg/views/pages"
+	"github.com/flowline-io/flowbot/pkg/views/partials"
+)
+
+var approvalWebserviceRules = []webservice.Rule{
+	webservice.Get("/approvals/:token", approvalPage),
+	webservice.Post("/approvals/:token/:decision", decideApproval),
+}
+
+// approvalPage shows the approval gate behind a notification link with its
+// approve and reject buttons.
+func approvalPage(c fiber.Ctx) error {
+	if err := authenticateWeb(c); err != nil {
+		return err
+	}
+	token := c.Params("token")
+	data, err := loadApprovalData(c.Context(), token)
+	if err != nil {
+		if errors.Is(err, types.ErrNotFound) || errors.Is(err, types.ErrInvalidArgument) {
+			return c.SendStatus(http.StatusNotFound)
+		}
+		return err
+	}
+	if d, err := runapproval.ParseDecision(c.Query("decision")); err == nil {
+		data.Highlight = string(d)
+	}
+
+	c.Type("html")
+	return pages.ApprovalPage(c.Context(), data).Render(c.Context(), c.Response().BodyWriter())
+}
+
+// decideApproval records an approve or reject decision and resumes the run.
+func decideApproval(c fiber.Ctx) error {
+	if err := authenticateWeb(c); err != nil {
+		return err
+	}
+	decision, err := runapproval.ParseDecision(c.Params("decision"))
+	if err != nil {
+		return toastErrorKey(c, "toast.approval.invalid_decision")
+	}
+	token := c.Params("token")
+	kind, _, err := runapproval.ParseToken(token)
+	if err != nil {
+		return toastErrorKey(c, "toast.approval.not_found")
+	}
+	by := getUID(c)
+	switch kind {
+	case runapproval.KindPipeline:
+		err = pipeline.ActiveEngine().DecideApproval(c.Context(), token, decision, by)
+	default:
+		err = getWorkflowService().DecideApproval(c.Context(), token, decision, by)
+	}
+	if err != nil {
+		return runControlError(c, "decideApproval", err)
+	}
+	setShowToastKey(c, "success", "toast.approval."+string(decision))
+	c.Response().Header.Set("HX-Refresh", "true")
+	return c.SendStatus(http.StatusOK)
+}
+
+// loadApprovalData resolves token to the run and gate it belongs to.
+func loadApprovalData(ctx context.Context, token string) (pages.ApprovalData, error) {
+	kind, _, err := runapproval.ParseToken(token)
+	if err != nil {
+		return pages.ApprovalData{}, err
+	}
+	var req *runapproval.Request
+	data := pages.ApprovalData{ActionPath: runapproval.WebPathPrefix + token}
+	switch kind {
+	case runapproval.KindPipeline:
+		run, r, err := pipeline.ActiveEngine().GetApproval(ctx, token)
+		if err != nil {
+			return pages.ApprovalData{}, err
+		}
+		req = r
+		data.RunLabel = fmt.Sprintf("%s #%d", run.PipelineName, run.ID)
+		data.RunURL = partials.PipelineWebPath(run.PipelineName) + "/runs"
+		data.Pending = run.Status == int(types.PipelineWaiting) && !req.Decided()
+	default:
+		run, r, err := getWorkflowService().GetApproval(ctx, token)
+		if err != nil {
+			return pages.ApprovalData{}, err
+		}
+		req = r
+		data.RunLabel = fmt.Sprintf("%s #%d", run.WorkflowName, run.ID)
+		data.RunURL = partials.WorkflowWebPath(run.WorkflowName) + "/runs"
+		data.Pending = run.Status == int(types.WorkflowRunWaiting) && !req.Decided()
+	}
+	data.Step = req.Step
+	data.Message = req.Message
+	data.Default = string(req.Default)
+	data.RequestedAt = req.RequestedAt
+	data.ExpiresAt = req.ExpiresAt
+	data.Decision = string(req.Decision)
+	data.DecidedBy = req.DecidedBy
+	data.DecidedAt = req.DecidedAt
+	return data, nil
+}
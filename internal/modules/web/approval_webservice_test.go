@@ -0,0 +1,51 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+)
+
+func TestApprovalWebservice(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		cookie     bool
+		wantStatus int
+		wantHX     string
+	}{
+		{name: "unauthenticated redirects to login", method: http.MethodGet, path: "/service/web/approvals/p-1-abc", wantStatus: http.StatusSeeOther},
+		{name: "malformed token is not found", method: http.MethodGet, path: "/service/web/approvals/nope", cookie: true, wantStatus: http.StatusNotFound},
+		{name: "invalid decision", method: http.MethodPost, path: "/service/web/approvals/p-1-abc/maybe", cookie: true, wantStatus: http.StatusNoContent, wantHX: "approve or reject"},
+		{name: "malformed token decision", method: http.MethodPost, path: "/service/web/approvals/nope/approve", cookie: true, wantStatus: http.StatusNoContent, wantHX: "Approval request not found"},
+		{name: "no pipeline engine", method: http.MethodPost, path: "/service/web/approvals/p-1-abc/approve", cookie: true, wantStatus: http.StatusNoContent, wantHX: "Run control is not available"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _ := setupTestApp(t)
+			defer func() { store.Database = nil; handler = moduleHandler{}; config = configType{} }()
+			pipeline.SetActiveEngine(nil)
+
+			req := httptest.NewRequest(tt.method, tt.path, http.NoBody)
+			if tt.cookie {
+				req.AddCookie(&http.Cookie{Name: "accessToken", Value: "valid-token"})
+				AttachCSRFForTest(req)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			if tt.wantHX != "" {
+				assert.Contains(t, resp.Header.Get("HX-Trigger"), tt.wantHX)
+			}
+		})
+	}
+}
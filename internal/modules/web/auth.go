@@ -3,6 +3,7 @@ package web
 import (
 	"context"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
@@ -18,13 +19,15 @@ import (
 // AuthConfig holds web login authentication settings.
 // Username/Password/PasswordHash are migration-only after accounts exist in the database.
 type AuthConfig struct {
-	Username      string           `json:"username"`
-	Password      string           `json:"password"`
-	PasswordHash  string           `json:"password_hash"`
-	EncryptionKey string           `json:"encryption_key"`
-	EncryptionDir string           `json:"encryption_key_dir"`
-	CookieSecure  *bool            `json:"cookie_secure"`
-	BruteForce    BruteForceConfig `json:"brute_force"`
+	Username      string            `json:"username"`
+	Password      string            `json:"password"`
+	PasswordHash  string            `json:"password_hash"`
+	EncryptionKey string            `json:"encryption_key"`
+	EncryptionDir string            `json:"encryption_key_dir"`
+	CookieSecure  *bool             `json:"cookie_secure"`
+	BruteForce    BruteForceConfig  `json:"brute_force"`
+	OIDC          OIDCConfig        `json:"oidc"`
+	ForwardAuth   ForwardAuthConfig `json:"forward_auth"`
 }
 
 // cookieSecureEnabled reports whether the accessToken cookie should set Secure.
@@ -50,6 +53,90 @@ type BruteForceConfig struct {
 	WindowDuration string `json:"window_duration"`
 }
 
+// OIDCConfig enables single sign-on through an OpenID Connect provider
+// (Authelia, Authentik, Keycloak, ...). An empty Issuer disables it.
+type OIDCConfig struct {
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	// RedirectURL must match the client registration and end in
+	// /service/web/login/oidc/callback.
+	RedirectURL string `json:"redirect_url"`
+	// Scopes are requested in addition to openid (empty = profile, email, groups).
+	Scopes []string `json:"scopes"`
+	// DisplayName labels the login button (empty = "SSO").
+	DisplayName string `json:"display_name"`
+	// UsernameClaim maps a login to web_account.username (empty = preferred_username).
+	UsernameClaim string `json:"username_claim"`
+	// GroupsClaim holds group membership (empty = groups).
+	GroupsClaim string `json:"groups_claim"`
+	// AllowedGroups admits only members of at least one group; empty admits all.
+	AllowedGroups []string `json:"allowed_groups"`
+	// LinkExistingByUsername links the first login to an existing account whose
+	// username equals UsernameClaim. Off by default because many providers let
+	// users edit that claim. Accounts with TOTP enabled are never linked this way.
+	LinkExistingByUsername bool `json:"link_existing_by_username"`
+	// AutoProvision creates an account on first login when no account matches.
+	AutoProvision bool `json:"auto_provision"`
+}
+
+// enabled reports whether OIDC login is configured.
+func (o OIDCConfig) enabled() bool {
+	return strings.TrimSpace(o.Issuer) != ""
+}
+
+// applyDefaults fills empty OIDC fields with built-in defaults.
+func (o *OIDCConfig) applyDefaults() {
+	if len(o.Scopes) == 0 {
+		o.Scopes = []string{"profile", "email", "groups"}
+	}
+	if o.DisplayName == "" {
+		o.DisplayName = "SSO"
+	}
+	if o.UsernameClaim == "" {
+		o.UsernameClaim = "preferred_username"
+	}
+	if o.GroupsClaim == "" {
+		o.GroupsClaim = "groups"
+	}
+}
+
+// ForwardAuthConfig trusts a reverse proxy that authenticates users itself
+// and forwards the username in a header. An empty TrustedProxies disables it.
+type ForwardAuthConfig struct {
+	// TrustedProxies lists the IPs or CIDRs of proxies allowed to set UserHeader.
+	// Requests from other peers never sign in through the header.
+	TrustedProxies []string `json:"trusted_proxies"`
+	// UserHeader carries the username (empty = Remote-User).
+	UserHeader string `json:"user_header"`
+	// GroupsHeader carries comma-separated groups (empty = Remote-Groups).
+	GroupsHeader string `json:"groups_header"`
+	// EmailHeader carries the email address (empty = Remote-Email).
+	EmailHeader string `json:"email_header"`
+	// AllowedGroups admits only members of at least one group; empty admits all.
+	AllowedGroups []string `json:"allowed_groups"`
+	// AutoProvision creates an account on first login when no account matches.
+	AutoProvision bool `json:"auto_provision"`
+}
+
+// enabled reports whether forward auth is configured.
+func (f ForwardAuthConfig) enabled() bool {
+	return len(f.TrustedProxies) > 0
+}
+
+// applyDefaults fills empty header names with the Authelia/Authentik defaults.
+func (f *ForwardAuthConfig) applyDefaults() {
+	if f.UserHeader == "" {
+		f.UserHeader = "Remote-User"
+	}
+	if f.GroupsHeader == "" {
+		f.GroupsHeader = "Remote-Groups"
+	}
+	if f.EmailHeader == "" {
+		f.EmailHeader = "Remote-Email"
+	}
+}
+
 // bruteForceEnabled reports whether login brute-force protection is active.
 // Defaults to true when enabled is omitted.
 func (b *BruteForceConfig) bruteForceEnabled() bool {
@@ -78,18 +165,18 @@ func (b *BruteForceConfig) applyDefaults() {
 // loginLimiter is the rate limiter instance, set after Init when brute force is enabled.
 var loginLimiter *loginRateLimiter
 
-// loginLimiterStore is the Redis cache injected via fx; limiter wiring waits until Init.
-var loginLimiterStore *cache.RedisStore
+// loginLimiterStore is the shared cache injected via fx; limiter wiring waits until Init.
+var loginLimiterStore cache.Store
 
-// SetLoginRateLimiterCache stores the Redis backend for the login rate limiter.
+// SetLoginRateLimiterCache stores the cache backend for the login rate limiter.
 // The limiter is wired after web module Init so YAML auth.brute_force is applied.
-func SetLoginRateLimiterCache(s *cache.RedisStore) {
+func SetLoginRateLimiterCache(s cache.Store) {
 	loginLimiterStore = s
 	wireLoginRateLimiter()
 }
 
 // wireLoginRateLimiter builds or clears loginLimiter from the current module auth config.
-// No-op until Init has succeeded and a Redis store is available.
+// No-op until Init has succeeded and a cache store is available.
 func wireLoginRateLimiter() {
 	if loginLimiterStore == nil || !handler.initialized {
 		return
@@ -21,6 +21,9 @@ var knownWeakPasswords = []string{
 // validateAuthConfig checks modules.web.auth at module Init.
 // YAML username/password are optional (used only for one-time migration); setup covers empty DB.
 func validateAuthConfig(cfg AuthConfig) error {
+	if err := validateSSOConfig(cfg); err != nil {
+		return err
+	}
 	hasUser := strings.TrimSpace(cfg.Username) != ""
 	hasPassword := cfg.Password != ""
 	hasHash := cfg.PasswordHash != ""
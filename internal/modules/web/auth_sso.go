@@ -0,0 +1,334 @@
+package web
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/views/pages"
+	"github.com/flowline-io/flowbot/pkg/webauth"
+)
+
+// kindOIDCState marks the parameter row that carries an in-flight OIDC login.
+// It never satisfies isAuthenticated, which requires webauth.KindFull.
+const kindOIDCState = "oidc_state"
+
+var (
+	oidcClient     *webauth.OIDCClient
+	trustedProxies webauth.TrustedProxies
+)
+
+var (
+	errSSONoAccount   = errors.New("no linked account")
+	errSSOGroupDenied = errors.New("group not allowed")
+	errSSOTOTPAccount = errors.New("account has totp enabled")
+)
+
+// ssoAccountPolicy says how a login without a linked identity finds its
+// account.
+type ssoAccountPolicy struct {
+	// LinkByUsername links an existing account whose username matches.
+	LinkByUsername bool
+	// LinkTOTPAccounts also allows that link for accounts with TOTP enabled.
+	LinkTOTPAccounts bool
+	// AutoProvision creates an account when no account matches.
+	AutoProvision bool
+}
+
+// validateSSOConfig checks modules.web.auth.oidc and forward_auth.
+func validateSSOConfig(cfg AuthConfig) error {
+	if cfg.OIDC.enabled() {
+		if strings.TrimSpace(cfg.OIDC.ClientID) == "" {
+			return fmt.Errorf("web auth: oidc.client_id is required when oidc.issuer is set")
+		}
+		if strings.TrimSpace(cfg.OIDC.RedirectURL) == "" {
+			return fmt.Errorf("web auth: oidc.redirect_url is required when oidc.issuer is set")
+		}
+	}
+	if _, err := webauth.ParseTrustedProxies(cfg.ForwardAuth.TrustedProxies); err != nil {
+		return fmt.Errorf("web auth: forward_auth.trusted_proxies: %w", err)
+	}
+	return nil
+}
+
+// wireSSO builds the OIDC client and trusted proxy list from cfg. Defaults
+// must already be applied.
+func wireSSO(cfg AuthConfig) error {
+	oidcClient = nil
+	if cfg.OIDC.enabled() {
+		oidcClient = webauth.NewOIDCClient(webauth.OIDCOptions{
+			Issuer:       strings.TrimSpace(cfg.OIDC.Issuer),
+			ClientID:     cfg.OIDC.ClientID,
+			ClientSecret: cfg.OIDC.ClientSecret,
+			RedirectURL:  cfg.OIDC.RedirectURL,
+			Scopes:       cfg.OIDC.Scopes,
+		})
+	}
+	tp, err := webauth.ParseTrustedProxies(cfg.ForwardAuth.TrustedProxies)
+	if err != nil {
+		return fmt.Errorf("web auth: forward_auth.trusted_proxies: %w", err)
+	}
+	trustedProxies = tp
+	return nil
+}
+
+// ssoLoginLabel returns the OIDC button label, or "" when OIDC is off.
+func ssoLoginLabel() string {
+	if oidcClient == nil {
+		return ""
+	}
+	return authConfig().OIDC.DisplayName
+}
+
+// ssoErrorMsg maps an SSO failure to a user-facing message.
+func ssoErrorMsg(ctx fiber.Ctx, err error) string {
+	switch {
+	case errors.Is(err, errSSONoAccount):
+		return webAuthMsg(ctx, "auth.sso_no_account")
+	case errors.Is(err, errSSOGroupDenied):
+		return webAuthMsg(ctx, "auth.sso_group_denied")
+	case errors.Is(err, errSSOTOTPAccount):
+		return webAuthMsg(ctx, "auth.sso_totp_account")
+	default:
+		return webAuthMsg(ctx, "auth.sso_failed")
+	}
+}
+
+// tryForwardAuth signs the browser in from the proxy's user header. It only
+// trusts the header when the direct peer is a configured proxy; ctx.IP() is
+// not used because it may itself come from a client-controlled header.
+// It reports whether the request was answered.
+func tryForwardAuth(ctx fiber.Ctx, next string) (bool, error) {
+	if len(trustedProxies) == 0 {
+		return false, nil
+	}
+	if !trustedProxies.Contains(ctx.RequestCtx().RemoteIP().String()) {
+		return false, nil
+	}
+	fa := authConfig().ForwardAuth
+	username := strings.TrimSpace(ctx.Get(fa.UserHeader))
+	if username == "" {
+		return false, nil
+	}
+	groups := webauth.ClaimStrings(map[string]any{"groups": ctx.Get(fa.GroupsHeader)}, "groups")
+	if !webauth.InAnyGroup(groups, fa.AllowedGroups) {
+		return true, renderLoginPage(ctx, next, ssoErrorMsg(ctx, errSSOGroupDenied))
+	}
+	in := store.IdentityInput{
+		Provider: webauth.ProviderForwardAuth,
+		Subject:  username,
+		Email:    strings.TrimSpace(ctx.Get(fa.EmailHeader)),
+	}
+	// The proxy's user header is the identity itself, so it always maps to
+	// the account of the same name.
+	account, err := resolveSSOAccount(ctx.Context(), username, in, ssoAccountPolicy{
+		LinkByUsername:   true,
+		LinkTOTPAccounts: true,
+		AutoProvision:    fa.AutoProvision,
+	})
+	if err != nil {
+		flog.Warn("web auth: forward auth for %q: %v", username, err)
+		return true, renderLoginPage(ctx, next, ssoErrorMsg(ctx, err))
+	}
+	if err := finishSSOLogin(ctx, account); err != nil {
+		flog.Error(fmt.Errorf("forward auth session: %w", err))
+		return true, renderLoginPage(ctx, next, webAuthMsg(ctx, "auth.internal_error"))
+	}
+	return true, ctx.Redirect().To(safeNext(next))
+}
+
+// oidcStart redirects the browser to the identity provider.
+func oidcStart(ctx fiber.Ctx) error {
+	if oidcClient == nil {
+		return fiber.ErrNotFound
+	}
+	next := ctx.Query("next", "")
+	req, err := oidcClient.Begin(ctx.Context())
+	if err != nil {
+		flog.Error(fmt.Errorf("oidc begin: %w", err))
+		return renderLoginPage(ctx, next, webAuthMsg(ctx, "auth.sso_failed"))
+	}
+	params := types.KV{
+		"kind":     kindOIDCState,
+		"nonce":    req.Nonce,
+		"verifier": req.Verifier,
+		"next":     safeNext(next),
+	}
+	expiredAt := time.Now().Add(webauth.PendingSessionTTL)
+	if err := store.ModuleDataStoreFromDB().ParameterSet(context.Background(), auth.HashToken(req.State), params, expiredAt); err != nil {
+		flog.Error(fmt.Errorf("oidc state: %w", err))
+		return renderLoginPage(ctx, next, webAuthMsg(ctx, "auth.internal_error"))
+	}
+	setOIDCStateCookie(ctx, req.State, int(webauth.PendingSessionTTL.Seconds()))
+	ctx.Set("Cache-Control", "no-store")
+	return ctx.Redirect().To(req.URL)
+}
+
+// oidcCallback completes the authorization-code flow.
+func oidcCallback(ctx fiber.Ctx) error {
+	if oidcClient == nil {
+		return fiber.ErrNotFound
+	}
+	if e := ctx.Query("error"); e != "" {
+		flog.Warn("web auth: oidc provider error %q: %s", e, ctx.Query("error_description"))
+		return renderLoginPage(ctx, "", webAuthMsg(ctx, "auth.sso_failed"))
+	}
+	state := ctx.Query("state")
+	cookie := ctx.Cookies(webauth.CookieOIDCState)
+	setOIDCStateCookie(ctx, "deleted", 0)
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(cookie)) != 1 {
+		return renderLoginPage(ctx, "", webAuthMsg(ctx, "auth.sso_failed"))
+	}
+	mds := store.ModuleDataStoreFromDB()
+	flag := auth.HashToken(state)
+	p, err := mds.ParameterGet(context.Background(), flag)
+	if err != nil || p.ID <= 0 || store.ParameterIsExpired(p) {
+		return renderLoginPage(ctx, "", webAuthMsg(ctx, "auth.sso_failed"))
+	}
+	if err := mds.ParameterDelete(context.Background(), flag); err != nil {
+		flog.Error(fmt.Errorf("oidc state delete: %w", err))
+	}
+	paramKV := types.KV(p.Params)
+	kind, _ := paramKV.String("kind")
+	nonce, _ := paramKV.String("nonce")
+	verifier, _ := paramKV.String("verifier")
+	next, _ := paramKV.String("next")
+	if kind != kindOIDCState || nonce == "" || verifier == "" {
+		return renderLoginPage(ctx, next, webAuthMsg(ctx, "auth.sso_failed"))
+	}
+
+	id, err := oidcClient.Complete(ctx.Context(), ctx.Query("code"), verifier, nonce)
+	if err != nil {
+		flog.Warn("web auth: oidc callback: %v", err)
+		return renderLoginPage(ctx, next, webAuthMsg(ctx, "auth.sso_failed"))
+	}
+	oc := authConfig().OIDC
+	if !webauth.InAnyGroup(webauth.ClaimStrings(id.Claims, oc.GroupsClaim), oc.AllowedGroups) {
+		return renderLoginPage(ctx, next, ssoErrorMsg(ctx, errSSOGroupDenied))
+	}
+	in := store.IdentityInput{
+		Provider: webauth.ProviderOIDC,
+		Issuer:   id.Issuer,
+		Subject:  id.Subject,
+		Email:    webauth.ClaimString(id.Claims, "email"),
+	}
+	username := strings.TrimSpace(webauth.ClaimString(id.Claims, oc.UsernameClaim))
+	account, err := resolveSSOAccount(ctx.Context(), username, in, ssoAccountPolicy{
+		LinkByUsername: oc.LinkExistingByUsername,
+		AutoProvision:  oc.AutoProvision,
+	})
+	if err != nil {
+		flog.Warn("web auth: oidc login for %s: %v", id.Subject, err)
+		return renderLoginPage(ctx, next, ssoErrorMsg(ctx, err))
+	}
+	if err := finishSSOLogin(ctx, account); err != nil {
+		flog.Error(fmt.Errorf("oidc session: %w", err))
+		return renderLoginPage(ctx, next, webAuthMsg(ctx, "auth.internal_error"))
+	}
+	return ctx.Redirect().To(safeNext(next))
+}
+
+// resolveSSOAccount finds the account for an external identity: an existing
+// link first, then (with policy.LinkByUsername) an account whose username
+// matches, then (with policy.AutoProvision) a new account. Matching and new
+// accounts are linked so later logins survive a username change at the
+// provider. A matching account that may not be linked refuses the login.
+func resolveSSOAccount(ctx context.Context, username string, in store.IdentityInput, policy ssoAccountPolicy) (*gen.WebAccount, error) {
+	ws := store.WebAccountStoreFromDB()
+	account, err := ws.GetByIdentity(ctx, in.Provider, in.Issuer, in.Subject)
+	if err == nil {
+		if err := ws.LinkIdentity(ctx, account.UID, in); err != nil {
+			return nil, err
+		}
+		return account, nil
+	}
+	if !errors.Is(err, types.ErrNotFound) {
+		return nil, err
+	}
+	if username == "" {
+		return nil, errSSONoAccount
+	}
+	account, err = ws.GetByUsername(ctx, username)
+	switch {
+	case err == nil:
+		if !policy.LinkByUsername {
+			return nil, errSSONoAccount
+		}
+		if account.TotpEnabled && !policy.LinkTOTPAccounts {
+			return nil, errSSOTOTPAccount
+		}
+		if err := ws.LinkIdentity(ctx, account.UID, in); err != nil {
+			return nil, err
+		}
+		return account, nil
+	case !errors.Is(err, types.ErrNotFound):
+		return nil, err
+	case !policy.AutoProvision:
+		return nil, errSSONoAccount
+	}
+	account, err = ws.CreateProvisionedAccount(ctx, username, in)
+	if err != nil {
+		return nil, err
+	}
+	flog.Info("web auth: provisioned account %q from %s login", username, in.Provider)
+	return account, nil
+}
+
+// finishSSOLogin issues a full session. No TOTP step follows: the identity
+// provider or proxy is responsible for the second factor.
+func finishSSOLogin(ctx fiber.Ctx, account *gen.WebAccount) error {
+	ws := store.WebAccountStoreFromDB()
+	if err := ws.EnsureUser(context.Background(), account.UID, account.Username); err != nil {
+		return err
+	}
+	clearPendingSession(ctx)
+	loginSuccessCleanup(ctx)
+	return issueFullSession(ctx, account.UID, account.Username)
+}
+
+func setOIDCStateCookie(ctx fiber.Ctx, value string, maxAge int) {
+	c := &fiber.Cookie{
+		Name:     webauth.CookieOIDCState,
+		Value:    value,
+		HTTPOnly: true,
+		SameSite: "Lax",
+		Secure:   authConfig().cookieSecureEnabled(),
+		Path:     "/service/web/login/oidc",
+		MaxAge:   maxAge,
+	}
+	if maxAge == 0 {
+		c.Expires = time.Unix(0, 0)
+	}
+	ctx.Cookie(c)
+}
+
+// linkedIdentities returns the account's external identities for the
+// security page.
+func linkedIdentities(ctx context.Context, uid string) []pages.LinkedIdentity {
+	rows, err := store.WebAccountStoreFromDB().ListIdentities(ctx, uid)
+	if err != nil {
+		flog.Error(fmt.Errorf("list identities: %w", err))
+		return nil
+	}
+	out := make([]pages.LinkedIdentity, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, pages.LinkedIdentity{
+			Provider:    r.Provider,
+			Issuer:      r.Issuer,
+			Subject:     r.Subject,
+			Email:       r.Email,
+			LastLoginAt: r.LastLoginAt,
+		})
+	}
+	return out
+}
@@ -0,0 +1,387 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/webauth"
+)
+
+// enableSSOForTest applies cfg to the module globals the way Init does.
+func enableSSOForTest(t *testing.T, mutate func(*AuthConfig)) {
+	t.Helper()
+	cfg := handler.authConfig
+	mutate(&cfg)
+	cfg.OIDC.applyDefaults()
+	cfg.ForwardAuth.applyDefaults()
+	require.NoError(t, validateSSOConfig(cfg))
+	handler.authConfig = cfg
+	config.Auth = cfg
+	require.NoError(t, wireSSO(cfg))
+}
+
+func sessionCookie(resp *http.Response) string {
+	for _, c := range resp.Cookies() {
+		if c.Name == webauth.CookieAccessToken && c.Value != "deleted" {
+			return c.Value
+		}
+	}
+	return ""
+}
+
+func TestValidateSSOConfig(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cfg     AuthConfig
+		wantErr string
+	}{
+		{name: "disabled"},
+		{
+			name: "oidc complete",
+			cfg:  AuthConfig{OIDC: OIDCConfig{Issuer: "https://auth.example.com", ClientID: "flowbot", RedirectURL: "https://flowbot.example.com/service/web/login/oidc/callback"}},
+		},
+		{
+			name:    "oidc without client id",
+			cfg:     AuthConfig{OIDC: OIDCConfig{Issuer: "https://auth.example.com", RedirectURL: "https://x/cb"}},
+			wantErr: "client_id",
+		},
+		{
+			name:    "oidc without redirect url",
+			cfg:     AuthConfig{OIDC: OIDCConfig{Issuer: "https://auth.example.com", ClientID: "flowbot"}},
+			wantErr: "redirect_url",
+		},
+		{
+			name:    "bad trusted proxy",
+			cfg:     AuthConfig{ForwardAuth: ForwardAuthConfig{TrustedProxies: []string{"10.0.0.0/33"}}},
+			wantErr: "trusted_proxies",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateAuthConfig(tt.cfg)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestForwardAuthLogin(t *testing.T) {
+	tests := []struct {
+		name          string
+		proxies       []string
+		user          string
+		groups        string
+		allowed       []string
+		autoProvision bool
+		wantStatus    int
+		wantSession   bool
+		wantBody      string
+	}{
+		{
+			name:        "trusted proxy signs in existing account",
+			proxies:     []string{"0.0.0.0/32"},
+			user:        "admin",
+			wantStatus:  http.StatusSeeOther,
+			wantSession: true,
+		},
+		{
+			name:       "untrusted peer ignores header",
+			proxies:    []string{"10.0.0.0/8"},
+			user:       "admin",
+			wantStatus: http.StatusOK,
+			wantBody:   `data-testid="login-form"`,
+		},
+		{
+			name:       "unknown user without auto provision",
+			proxies:    []string{"0.0.0.0/32"},
+			user:       "stranger",
+			wantStatus: http.StatusOK,
+			wantBody:   "No Flowbot account is linked",
+		},
+		{
+			name:          "unknown user with auto provision",
+			proxies:       []string{"0.0.0.0/32"},
+			user:          "newcomer",
+			autoProvision: true,
+			wantStatus:    http.StatusSeeOther,
+			wantSession:   true,
+		},
+		{
+			name:       "group not allowed",
+			proxies:    []string{"0.0.0.0/32"},
+			user:       "admin",
+			groups:     "users, family",
+			allowed:    []string{"admins"},
+			wantStatus: http.StatusOK,
+			wantBody:   "do not allow access",
+		},
+		{
+			name:        "group allowed",
+			proxies:     []string{"0.0.0.0/32"},
+			user:        "admin",
+			groups:      "users,admins",
+			allowed:     []string{"admins"},
+			wantStatus:  http.StatusSeeOther,
+			wantSession: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _, client := setupTestAppWithDB(t)
+			seedWebAccount(t, client, "admin", "flowbot-dev-pass", true)
+			enableSSOForTest(t, func(c *AuthConfig) {
+				c.ForwardAuth = ForwardAuthConfig{TrustedProxies: tt.proxies, AllowedGroups: tt.allowed, AutoProvision: tt.autoProvision}
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/service/web/login?next=/service/web/events", http.NoBody)
+			req.Header.Set("Remote-User", tt.user)
+			if tt.groups != "" {
+				req.Header.Set("Remote-Groups", tt.groups)
+			}
+			resp, err := app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantSession, sessionCookie(resp) != "")
+			if tt.wantSession {
+				assert.Equal(t, "/service/web/events", resp.Header.Get("Location"))
+				ws := store.NewWebAccountStore(client)
+				acct, err := ws.GetByIdentity(context.Background(), webauth.ProviderForwardAuth, "", tt.user)
+				require.NoError(t, err)
+				assert.Equal(t, tt.user, acct.Username)
+			}
+			if tt.wantBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestAccountPageListsLinkedIdentities(t *testing.T) {
+	app, _, client := setupTestAppWithDB(t)
+	seedWebAccount(t, client, "admin", "flowbot-dev-pass", true)
+	enableSSOForTest(t, func(c *AuthConfig) {
+		c.ForwardAuth = ForwardAuthConfig{TrustedProxies: []string{"0.0.0.0"}}
+	})
+	ws := store.NewWebAccountStore(client)
+	acct, err := ws.GetByUsername(context.Background(), "admin")
+	require.NoError(t, err)
+	require.NoError(t, ws.LinkIdentity(context.Background(), acct.UID, store.IdentityInput{
+		Provider: webauth.ProviderOIDC,
+		Issuer:   "https://auth.example.com",
+		Subject:  "sub-42",
+		Email:    "admin@example.com",
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/service/web/login", http.NoBody)
+	req.Header.Set("Remote-User", "admin")
+	resp, err := app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	resp.Body.Close()
+	token := sessionCookie(resp)
+	require.NotEmpty(t, token)
+
+	req = httptest.NewRequest(http.MethodGet, "/service/web/account", http.NoBody)
+	req.AddCookie(&http.Cookie{Name: webauth.CookieAccessToken, Value: token})
+	resp, err = app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	html := string(body)
+	assert.Equal(t, 2, strings.Count(html, `data-testid="account-identity"`))
+	assert.Contains(t, html, "sub-42")
+	assert.Contains(t, html, "https://auth.example.com")
+	assert.Contains(t, html, "Reverse proxy")
+}
+
+func TestResolveSSOAccount(t *testing.T) {
+	_, _, client := setupTestAppWithDB(t)
+	seedWebAccount(t, client, "admin", "flowbot-dev-pass", false)
+	ctx := context.Background()
+	in := store.IdentityInput{Provider: webauth.ProviderOIDC, Issuer: "https://idp", Subject: "s-1"}
+	linkByName := ssoAccountPolicy{LinkByUsername: true}
+	provision := ssoAccountPolicy{AutoProvision: true}
+
+	_, err := resolveSSOAccount(ctx, "", in, ssoAccountPolicy{LinkByUsername: true, AutoProvision: true})
+	require.ErrorIs(t, err, errSSONoAccount, "no link and no username claim")
+
+	_, err = resolveSSOAccount(ctx, "admin", in, provision)
+	require.ErrorIs(t, err, errSSONoAccount, "username linking is opt-in and never provisions over an existing account")
+
+	acct, err := resolveSSOAccount(ctx, "admin", in, linkByName)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", acct.Username)
+
+	// The link now wins over the username claim.
+	acct, err = resolveSSOAccount(ctx, "renamed-at-idp", in, ssoAccountPolicy{})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", acct.Username)
+
+	other := store.IdentityInput{Provider: webauth.ProviderOIDC, Issuer: "https://idp", Subject: "s-2"}
+	_, err = resolveSSOAccount(ctx, "bob", other, linkByName)
+	require.ErrorIs(t, err, errSSONoAccount)
+	acct, err = resolveSSOAccount(ctx, "bob", other, provision)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", acct.Username)
+	assert.False(t, webauth.CheckPassword(acct.PasswordHash, ""))
+}
+
+func TestResolveSSOAccountRefusesTOTPAccount(t *testing.T) {
+	_, _, client := setupTestAppWithDB(t)
+	seedWebAccount(t, client, "admin", "flowbot-dev-pass", true)
+	ctx := context.Background()
+	in := store.IdentityInput{Provider: webauth.ProviderOIDC, Issuer: "https://idp", Subject: "s-1"}
+
+	_, err := resolveSSOAccount(ctx, "admin", in, ssoAccountPolicy{LinkByUsername: true, AutoProvision: true})
+	require.ErrorIs(t, err, errSSOTOTPAccount)
+	_, err = store.NewWebAccountStore(client).GetByIdentity(ctx, in.Provider, in.Issuer, in.Subject)
+	require.ErrorIs(t, err, types.ErrNotFound, "the refused identity stays unlinked")
+
+	// Forward auth trusts the proxy's user header and still links.
+	fa := store.IdentityInput{Provider: webauth.ProviderForwardAuth, Subject: "admin"}
+	acct, err := resolveSSOAccount(ctx, "admin", fa, ssoAccountPolicy{LinkByUsername: true, LinkTOTPAccounts: true})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", acct.Username)
+}
+
+// newDiscoveryServer serves only OIDC discovery, which is all oidcStart needs.
+func newDiscoveryServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+			"jwks_uri":               srv.URL + "/jwks",
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestOIDCStart(t *testing.T) {
+	t.Run("disabled returns 404", func(t *testing.T) {
+		app, _, _ := setupTestAppWithDB(t)
+		req := httptest.NewRequest(http.MethodGet, "/service/web/login/oidc", http.NoBody)
+		resp, err := app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("redirects to provider and stores state", func(t *testing.T) {
+		app, _, client := setupTestAppWithDB(t)
+		seedWebAccount(t, client, "admin", "flowbot-dev-pass", true)
+		idp := newDiscoveryServer(t)
+		enableSSOForTest(t, func(c *AuthConfig) {
+			c.OIDC = OIDCConfig{Issuer: idp.URL, ClientID: "flowbot", RedirectURL: "http://flowbot.test/service/web/login/oidc/callback", DisplayName: "Authelia"}
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/service/web/login", http.NoBody)
+		resp, err := app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Sign in with Authelia")
+
+		req = httptest.NewRequest(http.MethodGet, "/service/web/login/oidc?next=/service/web/events", http.NoBody)
+		resp, err = app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+		loc, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, idp.URL+"/authorize", loc.Scheme+"://"+loc.Host+loc.Path)
+		state := loc.Query().Get("state")
+		require.NotEmpty(t, state)
+
+		var cookie *http.Cookie
+		for _, c := range resp.Cookies() {
+			if c.Name == webauth.CookieOIDCState {
+				cookie = c
+			}
+		}
+		require.NotNil(t, cookie)
+		assert.Equal(t, state, cookie.Value)
+		assert.True(t, cookie.HttpOnly)
+
+		p, err := store.NewModuleDataStore(client).ParameterGet(context.Background(), auth.HashToken(state))
+		require.NoError(t, err)
+		kv := types.KV(p.Params)
+		kind, _ := kv.String("kind")
+		next, _ := kv.String("next")
+		assert.Equal(t, kindOIDCState, kind)
+		assert.Equal(t, "/service/web/events", next)
+	})
+}
+
+func TestOIDCCallbackRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		cookie string
+		seed   bool
+	}{
+		{name: "provider error", query: "error=access_denied&state=s1", cookie: "s1", seed: true},
+		{name: "missing cookie", query: "code=c&state=s1", seed: true},
+		{name: "state mismatch", query: "code=c&state=s1", cookie: "s2", seed: true},
+		{name: "unknown state", query: "code=c&state=s1", cookie: "s1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _, client := setupTestAppWithDB(t)
+			seedWebAccount(t, client, "admin", "flowbot-dev-pass", true)
+			idp := newDiscoveryServer(t)
+			enableSSOForTest(t, func(c *AuthConfig) {
+				c.OIDC = OIDCConfig{Issuer: idp.URL, ClientID: "flowbot", RedirectURL: "http://flowbot.test/cb"}
+			})
+			if tt.seed {
+				seedTestAccessToken(t, client, "s1", types.KV{"kind": kindOIDCState, "nonce": "n", "verifier": "v"}, time.Now().Add(time.Minute))
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/service/web/login/oidc/callback?"+tt.query, http.NoBody)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: webauth.CookieOIDCState, Value: tt.cookie})
+			}
+			resp, err := app.Test(req, fiber.TestConfig{Timeout: 5 * time.Second})
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Empty(t, sessionCookie(resp))
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), "Single sign-on failed")
+		})
+	}
+}
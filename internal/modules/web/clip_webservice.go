@@ -5,18 +5,35 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 
 	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/capability/core"
 	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/utils"
 	"github.com/flowline-io/flowbot/pkg/views/pages"
+	"github.com/flowline-io/flowbot/pkg/webauth"
 )
 
 // clipPage renders GET /c/:slug — anonymous visitors get title/description meta;
-// authenticated web users see the full markdown body.
+// authenticated web users, and anonymous visitors of password or signed-link
+// clips, get the markdown body. Clips with a password, burn-after-read, or a
+// view limit only render the open form on GET so link previews never spend a view.
 func clipPage(ctx fiber.Ctx) error {
+	return serveClip(ctx, false)
+}
+
+// clipOpen handles POST /c/:slug from the open form: it checks the password,
+// counts the view, and renders the body.
+func clipOpen(ctx fiber.Ctx) error {
+	return serveClip(ctx, true)
+}
+
+func serveClip(ctx fiber.Ctx, submitted bool) error {
 	slug := ctx.Params("slug")
 	if slug == "" {
 		return ctx.Status(http.StatusBadRequest).SendString(webMsg(ctx, "error.clip.missing_slug"))
@@ -46,26 +63,158 @@ func clipPage(ctx fiber.Ctx) error {
 		data.NotFound = true
 		data.Title = webMsg(ctx, "clip.not_found.title")
 		data.Description = webMsg(ctx, "clip.not_found.meta")
-		ctx.Type("html")
-		ctx.Status(http.StatusNotFound)
-		return pages.ClipPage(ctx.Context(), data).Render(ctx.Context(), ctx.Response().BodyWriter())
+		return renderClipPage(ctx, http.StatusNotFound, data)
+	}
+
+	now := time.Now()
+	fillClipStatus(&data, row, now)
+	if data.Gone {
+		return renderClipPage(ctx, http.StatusGone, data)
 	}
 
+	// Signed-in users skip the password and link checks.
+	data.Protected = !authed && (row.PasswordHash != "" || row.LinkSecret != "")
+	if !data.Protected {
+		data.Title = row.Title
+		data.Description = row.Description
+		data.CreatedAt = row.CreatedAt
+		data.WordCount = utils.WordCount(row.Content)
+	}
+
+	if !authed {
+		if row.LinkSecret != "" {
+			data.LinkExp, data.LinkSig = clipParam(ctx, "exp"), clipParam(ctx, "sig")
+			if err := core.VerifyLink(slug, row.LinkSecret, data.LinkExp, data.LinkSig, now); err != nil {
+				data.LinkInvalid = true
+				return renderClipPage(ctx, http.StatusForbidden, data)
+			}
+			// The signature must not leak through the Referer of attachment links.
+			ctx.Set("Referrer-Policy", "no-referrer")
+		}
+		if !data.Protected {
+			return renderClipPage(ctx, http.StatusOK, data)
+		}
+	}
+
+	needsPassword := !authed && row.PasswordHash != ""
+	if needsPassword || row.BurnAfterRead || row.MaxViews > 0 {
+		if !submitted {
+			return renderClipForm(ctx, http.StatusOK, data, needsPassword, "")
+		}
+		if needsPassword {
+			if blocked := checkLoginRateLimit(ctx); blocked != "" {
+				return renderClipForm(ctx, http.StatusTooManyRequests, data, true, blocked)
+			}
+			if !webauth.CheckPassword(row.PasswordHash, ctx.FormValue("password")) {
+				return renderClipForm(ctx, http.StatusForbidden, data, true, recordClipPasswordFailure(ctx))
+			}
+		}
+	}
+
+	ok, err := clipStore.ConsumeClipView(context.Background(), row, now)
+	if err != nil {
+		flog.Error(fmt.Errorf("clipPage: ConsumeClipView: %w", err))
+		return ctx.Status(http.StatusInternalServerError).SendString(webMsg(ctx, "error.clip.load_failed"))
+	}
+	if !ok {
+		// Another reader took the last view (or the clip expired) since the load.
+		if fresh, err := clipStore.GetClipBySlug(context.Background(), slug); err == nil && fresh != nil {
+			row = fresh
+		}
+		fillClipStatus(&data, row, now)
+		if !data.Gone {
+			data.Gone, data.State = true, string(types.ClipExhausted)
+		}
+		return renderClipPage(ctx, http.StatusGone, data)
+	}
+
+	data.Views = row.ViewCount + 1
 	data.Title = row.Title
 	data.Description = row.Description
 	data.CreatedAt = row.CreatedAt
 	data.WordCount = utils.WordCount(row.Content)
 	data.ContentMD = row.Content
+	data.ShowBody = true
+	html, mdErr := utils.MarkdownToSafeHTML([]byte(row.Content))
+	if mdErr != nil {
+		flog.Error(fmt.Errorf("clipPage: MarkdownToSafeHTML: %w", mdErr))
+		html = []byte("<pre>failed to render markdown</pre>")
+	}
+	data.BodyHTML = string(html)
+	data.Attachments = clipAttachmentViews(ctx.Context(), row.Attachments)
+	return renderClipPage(ctx, http.StatusOK, data)
+}
+
+// fillClipStatus copies the lifecycle fields shown in the status chips.
+func fillClipStatus(data *pages.ClipPageData, row *gen.Clip, now time.Time) {
+	state := types.ClipStateAt(row.ExpiresAt, row.BurnedAt, row.MaxViews, row.ViewCount, now)
+	data.State = string(state)
+	data.Gone = state != types.ClipActive
+	data.Version = row.Version
+	data.UpdatedAt = row.UpdatedAt
+	data.Views = row.ViewCount
+	data.MaxViews = row.MaxViews
+	data.ExpiresAt = row.ExpiresAt
+	data.BurnAfterRead = row.BurnAfterRead
+}
+
+// clipParam reads a signed-link parameter from the query, or from the open
+// form on POST.
+func clipParam(ctx fiber.Ctx, key string) string {
+	if v := ctx.Query(key); v != "" {
+		return v
+	}
+	if ctx.Method() == http.MethodPost {
+		return ctx.FormValue(key)
+	}
+	return ""
+}
 
-	if authed {
-		html, mdErr := utils.MarkdownToSafeHTML([]byte(row.Content))
-		if mdErr != nil {
-			flog.Error(fmt.Errorf("clipPage: MarkdownToSafeHTML: %w", mdErr))
-			html = []byte("<pre>failed to render markdown</pre>")
+func renderClipForm(ctx fiber.Ctx, status int, data pages.ClipPageData, needsPassword bool, errMsg string) error {
+	token, err := ensureCSRFCookie(ctx)
+	if err != nil {
+		return err
+	}
+	data.Locked = true
+	data.NeedsPassword = needsPassword
+	data.PasswordError = errMsg
+	data.CSRFToken = token
+	return renderClipPage(ctx, status, data)
+}
+
+// Clip passwords share the per-IP login limiter so a short password cannot be
+// guessed faster than an account one.
+func recordClipPasswordFailure(ctx fiber.Ctx) string {
+	if loginLimiter != nil {
+		if locked, _ := loginLimiter.RecordFailure(ctx.Context(), ctx.IP()); locked {
+			return webAuthMsg(ctx, "auth.too_many_attempts")
+		}
+	}
+	return webMsg(ctx, "clip.open.password_wrong")
+}
+
+// clipAttachmentViews signs a short-lived download link for each attachment.
+// Attachments that cannot be signed are listed without a link.
+func clipAttachmentViews(ctx context.Context, list []types.ClipAttachment) []pages.ClipAttachmentView {
+	out := make([]pages.ClipAttachmentView, 0, len(list))
+	for _, a := range list {
+		view := pages.ClipAttachmentView{Name: a.Name, MimeType: a.MimeType, Size: a.Size}
+		u, err := core.AttachmentURL(ctx, a)
+		if err != nil {
+			flog.Warn("clip attachment %s: %v", a.Name, fmt.Errorf("sign url: %w", err))
+		} else {
+			view.URL = u
 		}
-		data.BodyHTML = string(html)
+		out = append(out, view)
 	}
+	return out
+}
 
+func renderClipPage(ctx fiber.Ctx, status int, data pages.ClipPageData) error {
+	if data.Protected || data.Locked || data.ShowBody {
+		ctx.Set("Cache-Control", "no-store")
+	}
 	ctx.Type("html")
+	ctx.Status(status)
 	return pages.ClipPage(ctx.Context(), data).Render(ctx.Context(), ctx.Response().BodyWriter())
 }
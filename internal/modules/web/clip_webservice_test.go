@@ -5,12 +5,17 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/capability/core"
+	"github.com/flowline-io/flowbot/pkg/webauth"
 )
 
 func TestClipPage_AnonymousAndAuthed(t *testing.T) {
@@ -97,3 +102,180 @@ func TestClipPage_AnonymousAndAuthed(t *testing.T) {
 		})
 	}
 }
+
+func TestClipPage_AccessOptions(t *testing.T) {
+	app, _, dbClient := setupTestAppWithDB(t)
+	defer func() { store.Database = nil; handler = moduleHandler{}; config = configType{} }()
+
+	clipStore := store.NewClipStore(dbClient)
+	ctx := context.Background()
+	hash, err := webauth.HashPassword("open sesame")
+	require.NoError(t, err)
+	past := time.Now().Add(-time.Minute)
+	create := func(in store.ClipCreateInput) {
+		t.Helper()
+		in.Title, in.Description, in.CreatedBy = "Title "+in.Slug, "Desc "+in.Slug, "tester"
+		in.Content = "BODY_" + in.Slug
+		_, err := clipStore.CreateClipFromInput(ctx, in)
+		require.NoError(t, err)
+	}
+	create(store.ClipCreateInput{Slug: "pwclip01", PasswordHash: hash})
+	create(store.ClipCreateInput{Slug: "sgclip01", LinkSecret: "link-secret"})
+	create(store.ClipCreateInput{Slug: "brclip01", BurnAfterRead: true, MaxViews: 1, PasswordHash: hash})
+	create(store.ClipCreateInput{Slug: "exclip01", ExpiresAt: &past})
+	create(store.ClipCreateInput{Slug: "mvclip01", MaxViews: 2})
+
+	signed := core.SignedLink("sgclip01", "link-secret", time.Now().Add(time.Hour))
+	expired := core.SignedLink("sgclip01", "link-secret", time.Now().Add(-time.Hour))
+
+	type step struct {
+		name         string
+		method       string
+		path         string
+		form         url.Values
+		authed       bool
+		noCSRF       bool
+		wantStatus   int
+		wantContains []string
+		wantAbsent   []string
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "password clip hides meta until the form posts the right password",
+			steps: []step{
+				{
+					name: "get shows form", method: http.MethodGet, path: "/c/pwclip01", wantStatus: http.StatusOK,
+					wantContains: []string{"Protected clip", `data-testid="clip-password"`, `name="csrf_token"`, "0 views"},
+					wantAbsent:   []string{"BODY_pwclip01", "Title pwclip01", "Desc pwclip01", "Log in to read"},
+				},
+				{
+					name: "wrong password", method: http.MethodPost, path: "/c/pwclip01",
+					form: url.Values{"password": {"nope"}}, wantStatus: http.StatusForbidden,
+					wantContains: []string{"Wrong password."}, wantAbsent: []string{"BODY_pwclip01"},
+				},
+				{
+					name: "missing csrf", method: http.MethodPost, path: "/c/pwclip01", noCSRF: true,
+					form: url.Values{"password": {"open sesame"}}, wantStatus: http.StatusForbidden,
+					wantAbsent: []string{"BODY_pwclip01"},
+				},
+				{
+					name: "right password", method: http.MethodPost, path: "/c/pwclip01",
+					form: url.Values{"password": {"open sesame"}}, wantStatus: http.StatusOK,
+					wantContains: []string{"BODY_pwclip01", "Title pwclip01", "1 views"},
+				},
+				{
+					name: "signed in users skip the password", method: http.MethodGet, path: "/c/pwclip01", authed: true,
+					wantStatus: http.StatusOK, wantContains: []string{"BODY_pwclip01", "2 views"},
+				},
+			},
+		},
+		{
+			name: "signed link clip needs a valid signature",
+			steps: []step{
+				{
+					name: "plain link", method: http.MethodGet, path: "/c/sgclip01", wantStatus: http.StatusForbidden,
+					wantContains: []string{"Link expired or invalid"}, wantAbsent: []string{"BODY_sgclip01", "Title sgclip01"},
+				},
+				{
+					name: "expired link", method: http.MethodGet, path: expired, wantStatus: http.StatusForbidden,
+					wantAbsent: []string{"BODY_sgclip01"},
+				},
+				{
+					name: "signed link", method: http.MethodGet, path: signed, wantStatus: http.StatusOK,
+					wantContains: []string{"BODY_sgclip01", "Title sgclip01"},
+				},
+			},
+		},
+		{
+			name: "burn after read serves the body once",
+			steps: []step{
+				{
+					name: "get does not burn", method: http.MethodGet, path: "/c/brclip01", wantStatus: http.StatusOK,
+					wantContains: []string{"Burns after reading", "deleted as soon as you open it"},
+					wantAbsent:   []string{"BODY_brclip01"},
+				},
+				{
+					name: "open burns", method: http.MethodPost, path: "/c/brclip01",
+					form: url.Values{"password": {"open sesame"}}, wantStatus: http.StatusOK,
+					wantContains: []string{"BODY_brclip01", "1 of 1 views"},
+				},
+				{
+					name: "second open is gone", method: http.MethodPost, path: "/c/brclip01",
+					form: url.Values{"password": {"open sesame"}}, wantStatus: http.StatusGone,
+					wantContains: []string{"burned after it was read"}, wantAbsent: []string{"BODY_brclip01"},
+				},
+				{
+					name: "signed in users see it gone too", method: http.MethodGet, path: "/c/brclip01", authed: true,
+					wantStatus: http.StatusGone, wantContains: []string{"Burned"},
+				},
+			},
+		},
+		{
+			name: "expired clip is gone",
+			steps: []step{
+				{
+					name: "authed", method: http.MethodGet, path: "/c/exclip01", authed: true, wantStatus: http.StatusGone,
+					wantContains: []string{"This clip has expired."}, wantAbsent: []string{"BODY_exclip01"},
+				},
+			},
+		},
+		{
+			name: "view limit counts confirmed opens",
+			steps: []step{
+				{
+					name: "get shows form", method: http.MethodGet, path: "/c/mvclip01", authed: true, wantStatus: http.StatusOK,
+					wantContains: []string{"0 of 2 views", "2 remaining views"}, wantAbsent: []string{"BODY_mvclip01"},
+				},
+				{
+					name: "first open", method: http.MethodPost, path: "/c/mvclip01", authed: true, wantStatus: http.StatusOK,
+					wantContains: []string{"BODY_mvclip01", "1 of 2 views"},
+				},
+				{
+					name: "second open", method: http.MethodPost, path: "/c/mvclip01", authed: true, wantStatus: http.StatusOK,
+					wantContains: []string{"2 of 2 views"},
+				},
+				{
+					name: "limit reached", method: http.MethodGet, path: "/c/mvclip01", authed: true, wantStatus: http.StatusGone,
+					wantContains: []string{"reached its view limit"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, st := range tt.steps {
+				var body io.Reader = http.NoBody
+				if st.method == http.MethodPost {
+					body = strings.NewReader(st.form.Encode())
+				}
+				req := httptest.NewRequest(st.method, st.path, body)
+				if st.method == http.MethodPost {
+					req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				}
+				if st.authed {
+					req.AddCookie(&http.Cookie{Name: "accessToken", Value: "test-token"})
+				}
+				if st.method == http.MethodPost && !st.noCSRF {
+					AttachCSRFForTest(req)
+				}
+				resp, err := app.Test(req)
+				require.NoError(t, err, st.name)
+				raw, err := io.ReadAll(resp.Body)
+				_ = resp.Body.Close()
+				require.NoError(t, err, st.name)
+				assert.Equal(t, st.wantStatus, resp.StatusCode, st.name)
+				html := string(raw)
+				for _, sub := range st.wantContains {
+					assert.Contains(t, html, sub, st.name)
+				}
+				for _, absent := range st.wantAbsent {
+					assert.NotContains(t, html, absent, st.name)
+				}
+			}
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/ruleset/webservice"
 	"github.com/flowline-io/flowbot/pkg/views/pages"
 	"github.com/flowline-io/flowbot/pkg/views/partials"
@@ -60,18 +62,26 @@ func loadClipListItems(ctx context.Context) ([]partials.ClipListItem, error) {
 }
 
 func clipRowsToListItems(rows []*gen.Clip) []partials.ClipListItem {
+	now := time.Now()
 	items := make([]partials.ClipListItem, 0, len(rows))
 	for _, row := range rows {
 		if row == nil {
 			continue
 		}
 		items = append(items, partials.ClipListItem{
-			Slug:        row.Slug,
-			Title:       row.Title,
-			Description: row.Description,
-			CreatedBy:   row.CreatedBy,
-			CreatedAt:   row.CreatedAt,
-			URL:         "/c/" + row.Slug,
+			Slug:              row.Slug,
+			Title:             row.Title,
+			Description:       row.Description,
+			CreatedBy:         row.CreatedBy,
+			CreatedAt:         row.CreatedAt,
+			URL:               "/c/" + row.Slug,
+			State:             string(types.ClipStateAt(row.ExpiresAt, row.BurnedAt, row.MaxViews, row.ViewCount, now)),
+			Views:             row.ViewCount,
+			MaxViews:          row.MaxViews,
+			ExpiresAt:         row.ExpiresAt,
+			BurnAfterRead:     row.BurnAfterRead,
+			PasswordProtected: row.PasswordHash != "",
+			SignedLink:        row.LinkSecret != "",
 		})
 	}
 	return items
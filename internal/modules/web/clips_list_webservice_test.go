@@ -20,6 +20,10 @@ func TestClipsListPage(t *testing.T) {
 
 	clipStore := store.NewClipStore(dbClient)
 	require.NoError(t, clipStore.CreateClip(context.Background(), "listSlug1", "List Title", "List desc", "# body", "tester"))
+	_, err := clipStore.CreateClipFromInput(context.Background(), store.ClipCreateInput{
+		Slug: "listSlug2", Title: "Burn Title", Content: "# secret", BurnAfterRead: true, MaxViews: 1, PasswordHash: "x",
+	})
+	require.NoError(t, err)
 
 	tests := []struct {
 		name         string
@@ -55,6 +59,11 @@ func TestClipsListPage(t *testing.T) {
 			wantContains: []string{
 				`data-testid="clips-table"`,
 				"List Title",
+				"Views",
+				`data-testid="clip-row-state-chip">Active`,
+				"0 / 1",
+				`data-testid="clip-row-burn"`,
+				`data-testid="clip-row-password"`,
 			},
 		},
 	}
@@ -87,13 +96,15 @@ func TestClipsListPage(t *testing.T) {
 func TestClipRowsToListItems(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name string
-		rows []*gen.Clip
-		want int
+		name  string
+		rows  []*gen.Clip
+		want  int
+		state string
 	}{
 		{name: "nil rows", rows: nil, want: 0},
 		{name: "skips nil entry", rows: []*gen.Clip{nil, {Slug: "a", Title: "T"}}, want: 1},
 		{name: "maps url", rows: []*gen.Clip{{Slug: "abc", Title: "X"}}, want: 1},
+		{name: "maps state", rows: []*gen.Clip{{Slug: "used", MaxViews: 2, ViewCount: 2, LinkSecret: "s"}}, want: 1, state: "exhausted"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -104,6 +115,10 @@ func TestClipRowsToListItems(t *testing.T) {
 			if tt.want == 1 {
 				assert.Equal(t, "/c/"+tt.rows[len(tt.rows)-1].Slug, items[0].URL)
 			}
+			if tt.state != "" {
+				assert.Equal(t, tt.state, items[0].State)
+				assert.True(t, items[0].SignedLink)
+			}
 		})
 	}
 }
@@ -70,10 +70,13 @@ func newCSRFMiddleware() fiber.Handler {
 	})
 }
 
-// Login and setup POST always check CSRF. Other unauthenticated mutations skip
-// so authenticateWeb can redirect.
+// Login, setup, and clip open POSTs always check CSRF. Other unauthenticated
+// mutations skip so authenticateWeb can redirect.
 func csrfSkip(ctx fiber.Ctx) bool {
 	path := string(ctx.Request().URI().Path())
+	if strings.HasPrefix(path, "/c/") {
+		return false
+	}
 	if !strings.HasPrefix(path, "/service/web") {
 		return true
 	}
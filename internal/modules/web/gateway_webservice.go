@@ -0,0 +1,130 @@
+package web
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/ruleset/webservice"
+)
+
+var gatewayWebserviceRules = []webservice.Rule{
+	webservice.Get("/gateway/jobs/:id/logs/watch", watchGatewayJobLogs),
+}
+
+// gatewayLogPollInterval is how often the SSE stream checks for new chunks.
+var gatewayLogPollInterval = time.Second
+
+// gatewayLogSource is the part of store.GatewayStore the log stream reads.
+type gatewayLogSource interface {
+	Get(ctx context.Context, jobID string) (*types.GatewayJob, error)
+	Logs(ctx context.Context, jobID string, afterSeq int64, limit int) ([]types.GatewayLogChunk, error)
+}
+
+// watchGatewayJobLogs streams a gateway job's output chunks as SSE "chunk"
+// events (id = seq, so EventSource resumes via Last-Event-ID) and ends with a
+// "done" event carrying the terminal job.
+func watchGatewayJobLogs(c fiber.Ctx) error {
+	uid, err := webUID(c)
+	if err != nil {
+		return c.SendStatus(http.StatusUnauthorized)
+	}
+	if store.Database == nil || store.Database.GetClient() == nil {
+		return c.Status(fiber.StatusServiceUnavailable).SendString(webMsg(c, "error.gateway.store_unavailable"))
+	}
+	jobID := strings.TrimSpace(c.Params("id"))
+	gs := store.GatewayStoreFromDB()
+	job, err := gs.Get(c.Context(), jobID)
+	if err != nil || job == nil || (job.UID != "" && job.UID != uid.String()) {
+		return c.Status(http.StatusNotFound).SendString(webMsg(c, "error.gateway.job_not_found"))
+	}
+	after := gatewayLogCursor(c)
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+
+	ctx := c.Context()
+	return c.SendStreamWriter(func(w *bufio.Writer) {
+		streamGatewayJobLogs(ctx, w, gs, jobID, after)
+	})
+}
+
+// gatewayLogCursor reads the resume seq from ?after= or the Last-Event-ID header.
+func gatewayLogCursor(c fiber.Ctx) int64 {
+	raw := strings.TrimSpace(c.Query("after"))
+	if raw == "" {
+		raw = strings.TrimSpace(c.Get("Last-Event-ID"))
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+func streamGatewayJobLogs(ctx context.Context, w *bufio.Writer, src gatewayLogSource, jobID string, after int64) {
+	ticker := time.NewTicker(gatewayLogPollInterval)
+	defer ticker.Stop()
+	idle := 0
+	for {
+		// Read the job before its logs: once it is terminal no more chunks can
+		// arrive, so the chunks read next are the last ones.
+		job, err := src.Get(ctx, jobID)
+		if err != nil || job == nil {
+			return
+		}
+		chunks, err := src.Logs(ctx, jobID, after, 0)
+		if err != nil {
+			return
+		}
+		for _, chunk := range chunks {
+			if writeGatewaySSE(w, strconv.FormatInt(chunk.Seq, 10), "chunk", chunk) {
+				return
+			}
+			after = chunk.Seq
+		}
+		if len(chunks) > 0 {
+			idle = 0
+			continue
+		}
+		if types.GatewayJobTerminal(job.Status) {
+			writeGatewaySSE(w, "", "done", job)
+			return
+		}
+		if idle++; idle%15 == 0 && writeHeartbeat(w) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeGatewaySSE writes one SSE event and reports whether the client is gone.
+func writeGatewaySSE(w *bufio.Writer, id, event string, data any) bool {
+	payload, err := sonic.MarshalString(data)
+	if err != nil {
+		return true
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return true
+		}
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return true
+	}
+	return w.Flush() != nil
+}
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type fakeGatewayLogs struct {
+	mu     sync.Mutex
+	status types.GatewayJobStatus
+	chunks []types.GatewayLogChunk
+	gets   int
+}
+
+func (f *fakeGatewayLogs) Get(_ context.Context, jobID string) (*types.GatewayJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gets++
+	// The job finishes after the first poll, with one chunk written meanwhile.
+	if f.gets == 2 {
+		f.chunks = append(f.chunks, types.GatewayLogChunk{JobID: jobID, Seq: 2, Content: "second"})
+		f.status = types.GatewayJobSucceeded
+	}
+	return &types.GatewayJob{JobID: jobID, Status: f.status}, nil
+}
+
+func (f *fakeGatewayLogs) Logs(_ context.Context, _ string, afterSeq int64, _ int) ([]types.GatewayLogChunk, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []types.GatewayLogChunk
+	for _, c := range f.chunks {
+		if c.Seq > afterSeq {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func TestStreamGatewayJobLogs(t *testing.T) {
+	src := &fakeGatewayLogs{
+		status: types.GatewayJobRunning,
+		chunks: []types.GatewayLogChunk{{JobID: "j1", Seq: 1, Content: "first"}},
+	}
+	var out bytes.Buffer
+	w := bufio.NewWriter(&out)
+	streamGatewayJobLogs(t.Context(), w, src, "j1", 0)
+
+	body := out.String()
+	assert.Equal(t, 2, strings.Count(body, "event: chunk\n"))
+	assert.Contains(t, body, "id: 1\n")
+	assert.Contains(t, body, "id: 2\n")
+	assert.Contains(t, body, `"content":"second"`)
+	assert.True(t, strings.HasSuffix(body, "\n\n"))
+	assert.Contains(t, body[strings.LastIndex(body, "event:"):], `event: done`)
+	assert.Contains(t, body, `"status":"succeeded"`)
+}
+
+func TestStreamGatewayJobLogsResumesAfterSeq(t *testing.T) {
+	src := &fakeGatewayLogs{
+		status: types.GatewayJobFailed,
+		chunks: []types.GatewayLogChunk{{JobID: "j1", Seq: 1, Content: "first"}, {JobID: "j1", Seq: 2, Content: "x"}},
+		gets:   5,
+	}
+	var out bytes.Buffer
+	streamGatewayJobLogs(t.Context(), bufio.NewWriter(&out), src, "j1", 1)
+	assert.NotContains(t, out.String(), "first")
+	assert.Equal(t, 1, strings.Count(out.String(), "event: chunk\n"))
+	assert.Contains(t, out.String(), "event: done")
+}
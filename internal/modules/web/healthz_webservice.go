@@ -200,7 +200,7 @@ func collectInfraHealthzData(ctx context.Context) partials.HealthzData {
 	})
 
 	wg.Go(func() {
-		if rs := cache.DefaultRedisStore(); rs != nil {
+		if rs := cache.DefaultStore(); rs != nil {
 			latency, err := rs.Ping(ctx)
 			redisLatency = latency
 			redisOk = err == nil
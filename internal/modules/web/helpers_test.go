@@ -26,6 +26,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/pipeline"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
+	"github.com/flowline-io/flowbot/pkg/views/partials"
 )
 
 func TestParseTimeParam(t *testing.T) {
@@ -103,6 +104,7 @@ func TestPipelineStatusLabels(t *testing.T) {
 		{name: "step running", fn: stepRunStatusLabel, in: 1, want: "running"},
 		{name: "step done", fn: stepRunStatusLabel, in: 2, want: "done"},
 		{name: "step error", fn: stepRunStatusLabel, in: 4, want: "error"},
+		{name: "run cancelled", fn: pipelineRunStatusLabel, in: 3, want: "cancelled"},
 		{name: "run failed", fn: pipelineRunStatusLabel, in: 4, want: "failed"},
 		{name: "run pending default", fn: pipelineRunStatusLabel, in: 0, want: "pending"},
 	}
@@ -115,6 +117,32 @@ func TestPipelineStatusLabels(t *testing.T) {
 	}
 }
 
+func TestPipelineRunActions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		status    types.PipelineState
+		resumable bool
+		want      partials.RunActionsView
+	}{
+		{name: "running run can be cancelled", status: types.PipelineStart, want: partials.RunActionsView{CanCancel: true}},
+		{name: "done run can be re-run", status: types.PipelineDone, want: partials.RunActionsView{CanRerun: true}},
+		{name: "failed resumable run can be retried", status: types.PipelineFailed, resumable: true, want: partials.RunActionsView{CanRetry: true, CanRerun: true}},
+		{name: "failed one-shot run can only be re-run", status: types.PipelineFailed, want: partials.RunActionsView{CanRerun: true}},
+		{name: "cancelled resumable run can be retried", status: types.PipelineCancel, resumable: true, want: partials.RunActionsView{CanRetry: true, CanRerun: true}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := pipelineRunActions("demo", 7, int(tt.status), tt.resumable)
+			assert.Equal(t, "/service/web/pipelines/demo/runs/7", got.BasePath)
+			got.BasePath = ""
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPipelineNameParamHandler(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
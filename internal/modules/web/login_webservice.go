@@ -24,6 +24,8 @@ import (
 var loginWebserviceRules = []webservice.Rule{
 	webservice.Get("/login", loginPage, route.WithNotAuth()),
 	webservice.Post("/login", loginSubmit, route.WithNotAuth()),
+	webservice.Get("/login/oidc", oidcStart, route.WithNotAuth()),
+	webservice.Get("/login/oidc/callback", oidcCallback, route.WithNotAuth()),
 	webservice.Get("/login/2fa", login2FAPage, route.WithNotAuth()),
 	webservice.Post("/login/2fa", login2FASubmit, route.WithNotAuth()),
 	webservice.Get("/setup", setupPage, route.WithNotAuth()),
@@ -45,6 +47,10 @@ func loginPage(ctx fiber.Ctx) error {
 	if isAuthenticated(ctx) {
 		return ctx.Redirect().To(safeNext(ctx.Query("next", "/service/web/home")))
 	}
+	next := ctx.Query("next", "")
+	if handled, err := tryForwardAuth(ctx, next); handled {
+		return err
+	}
 	ws := store.WebAccountStoreFromDB()
 	if n, err := ws.Count(context.Background()); err == nil && n == 0 {
 		return ctx.Redirect().To("/service/web/setup")
@@ -60,14 +66,18 @@ func loginPage(ctx fiber.Ctx) error {
 			return ctx.Redirect().To("/service/web/setup/backup-codes")
 		}
 	}
-	next := ctx.Query("next", "")
+	return renderLoginPage(ctx, next, "")
+}
+
+// renderLoginPage renders the full login page; renderLoginForm is the HTMX fragment.
+func renderLoginPage(ctx fiber.Ctx, next, errorMsg string) error {
 	csrfTok, err := ensureCSRFCookie(ctx)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "csrf token error")
 	}
 	ctx.Set("Cache-Control", "no-store")
 	ctx.Type("html")
-	return pages.LoginPage(ctx.Context(), next, "", csrfTok).Render(ctx.Context(), ctx.Response().BodyWriter())
+	return pages.LoginPage(ctx.Context(), next, errorMsg, csrfTok, ssoLoginLabel()).Render(ctx.Context(), ctx.Response().BodyWriter())
 }
 
 func renderLoginForm(ctx fiber.Ctx, next, errorMsg string) error {
@@ -77,7 +87,7 @@ func renderLoginForm(ctx fiber.Ctx, next, errorMsg string) error {
 	}
 	ctx.Set("Cache-Control", "no-store")
 	ctx.Type("html")
-	return pages.LoginForm(ctx.Context(), next, errorMsg, csrfTok).Render(ctx.Context(), ctx.Response().BodyWriter())
+	return pages.LoginForm(ctx.Context(), next, errorMsg, csrfTok, ssoLoginLabel()).Render(ctx.Context(), ctx.Response().BodyWriter())
 }
 
 func checkLoginRateLimit(ctx fiber.Ctx) string {
@@ -60,6 +60,8 @@ func (moduleHandler) Init(jsonconf json.RawMessage) error {
 		return err
 	}
 	config.Auth.BruteForce.applyDefaults()
+	config.Auth.OIDC.applyDefaults()
+	config.Auth.ForwardAuth.applyDefaults()
 
 	keyDir := config.Auth.EncryptionDir
 	if keyDir == "" {
@@ -77,6 +79,9 @@ func (moduleHandler) Init(jsonconf json.RawMessage) error {
 		}
 	}
 	setWebEncryptor(enc)
+	if err := wireSSO(config.Auth); err != nil {
+		return err
+	}
 
 	handler.initialized = true
 	handler.authConfig = config.Auth
@@ -164,7 +169,9 @@ func warnResidualYAMLAuth() {
 func (moduleHandler) Webservice(app *fiber.App) {
 	app.Get("/static/*", static.New("", static.Config{FS: webassets.SubFS}))
 	app.Use("/c", localeMiddleware())
+	app.Use("/c", newCSRFMiddleware())
 	app.Get("/c/:slug", clipPage)
+	app.Post("/c/:slug", clipOpen)
 	app.Use("/service/web", localeMiddleware())
 	app.Use("/service/web", newCSRFMiddleware())
 	for _, rules := range allWebserviceRules {
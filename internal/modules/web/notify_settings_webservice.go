@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v3"
@@ -727,6 +728,7 @@ func parseRuleForm(ctx fiber.Ctx) model.NotifyRule {
 		EventPattern:   ctx.FormValue("event_pattern"),
 		ChannelPattern: ctx.FormValue("channel_pattern"),
 		Condition:      ctx.FormValue("condition"),
+		Timezone:       strings.TrimSpace(ctx.FormValue("timezone")),
 		Priority:       prio,
 		ParamsJSON:     buildRuleParamsJSON(action, ctx),
 		Enabled:        enabled,
@@ -800,7 +802,12 @@ func validateRuleForm(c fiber.Ctx, rule model.NotifyRule) map[string]string {
 	}
 	if rule.Condition != "" {
 		if err := notifyrules.ValidateCondition(rule.Condition); err != nil {
-			errs["condition"] = webMsg(c, "error.validation.invalid_condition")
+			errs["condition"] = webMsgData(c, "error.validation.invalid_condition", map[string]any{"Error": err.Error()})
+		}
+	}
+	if rule.Timezone != "" {
+		if _, err := time.LoadLocation(rule.Timezone); err != nil {
+			errs["timezone"] = webMsgData(c, "error.validation.invalid_timezone", map[string]any{"Timezone": rule.Timezone})
 		}
 	}
 	validateNotifyRuleParams(c, rule, &errs)
@@ -891,6 +898,7 @@ func reloadRulesEngine(ctx context.Context) {
 				Channel: r.ChannelPattern,
 			},
 			Condition: cond,
+			Timezone:  r.Timezone,
 			Priority:  r.Priority,
 			Params:    params,
 		})
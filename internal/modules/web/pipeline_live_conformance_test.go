@@ -0,0 +1,162 @@
+package web
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/bytedance/sonic"
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/rdb"
+)
+
+// liveBackend installs one live-progress backend for watchPipelineRunLive and
+// returns a function that publishes a progress event the way that backend's
+// step callback does.
+type liveBackend struct {
+	name    string
+	install func(t *testing.T) func(pipeline.StepProgressEvent)
+}
+
+var liveBackends = []liveBackend{
+	{
+		name: "redis",
+		install: func(t *testing.T) func(pipeline.StepProgressEvent) {
+			mr := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			t.Cleanup(func() { _ = client.Close() })
+			rdb.Client = client
+			return func(evt pipeline.StepProgressEvent) {
+				data, err := sonic.MarshalString(evt)
+				assert.NoError(t, err)
+				assert.NoError(t, client.XAdd(context.Background(), &redis.XAddArgs{
+					Stream: pipeline.StreamName(evt.RunID),
+					Values: map[string]any{"data": data},
+				}).Err())
+			}
+		},
+	},
+	{
+		name: "memory",
+		install: func(*testing.T) func(pipeline.StepProgressEvent) {
+			live := pipeline.NewMemoryProgress()
+			pipeline.LiveProgress = live
+			return func(evt pipeline.StepProgressEvent) {
+				ctx := context.Background()
+				switch evt.Status {
+				case "start":
+					live.OnRunStart(ctx, evt.RunID, evt.PipelineName, "manual", evt.TotalSteps, nil)
+				case "running":
+					live.OnStepStart(ctx, evt.RunID, evt.PipelineName, evt.StepIndex, evt.StepName, evt.Input)
+				case "done":
+					live.OnStepDone(ctx, evt.RunID, evt.PipelineName, evt.StepIndex, evt.StepName, evt.Output, evt.ElapsedMs)
+				default:
+					live.OnRunComplete(ctx, evt.RunID, evt.PipelineName, evt.ElapsedMs, evt.Status == "failed", evt.Error)
+				}
+			}
+		},
+	},
+}
+
+// useLiveGlobals clears the live-progress globals and restores them after t.
+func useLiveGlobals(t *testing.T) {
+	t.Helper()
+	lockWebTestGlobals(t)
+	prevClient, prevLive := rdb.Client, pipeline.LiveProgress
+	rdb.Client, pipeline.LiveProgress = nil, nil
+	t.Cleanup(func() { rdb.Client, pipeline.LiveProgress = prevClient, prevLive })
+}
+
+// watchLive requests the live watch SSE stream for runID and returns the
+// status and the data lines it carried.
+func watchLive(t *testing.T, runID string) (int, []string) {
+	t.Helper()
+	app := fiber.New()
+	app.Get("/pipelines/:name/runs/:runID/live/watch", watchPipelineRunLive)
+	req := httptest.NewRequest(http.MethodGet, "/pipelines/deploy/runs/"+runID+"/live/watch", http.NoBody)
+	resp, err := app.Test(req, fiber.TestConfig{Timeout: 10 * time.Second})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var data []string
+	for line := range strings.SplitSeq(string(body), "\n") {
+		if rest, ok := strings.CutPrefix(line, "data: "); ok {
+			data = append(data, rest)
+		}
+	}
+	return resp.StatusCode, data
+}
+
+func liveRunEvents(runID int64) []pipeline.StepProgressEvent {
+	return []pipeline.StepProgressEvent{
+		{RunID: runID, PipelineName: "deploy", StepIndex: -1, Status: "start", TotalSteps: 1},
+		{RunID: runID, PipelineName: "deploy", StepIndex: 0, StepName: "build", Status: "running", Input: map[string]any{"ref": "main"}},
+		{RunID: runID, PipelineName: "deploy", StepIndex: 0, StepName: "build", Status: "done", ElapsedMs: 4},
+		{RunID: runID, PipelineName: "deploy", StepIndex: -1, Status: "complete", ElapsedMs: 5},
+	}
+}
+
+func assertLiveEvents(t *testing.T, want []pipeline.StepProgressEvent, data []string) {
+	t.Helper()
+	require.Len(t, data, len(want))
+	for i, line := range data {
+		var got pipeline.StepProgressEvent
+		require.NoError(t, sonic.UnmarshalString(line, &got))
+		assert.Equal(t, want[i].Status, got.Status, "event %d", i)
+		assert.Equal(t, want[i].StepIndex, got.StepIndex, "event %d", i)
+		assert.Equal(t, want[i].StepName, got.StepName, "event %d", i)
+	}
+}
+
+func TestWatchPipelineRunLiveConformance(t *testing.T) {
+	for _, backend := range liveBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			t.Run("replays a finished run", func(t *testing.T) {
+				useLiveGlobals(t)
+				publish := backend.install(t)
+				events := liveRunEvents(11)
+				for _, evt := range events {
+					publish(evt)
+				}
+
+				status, data := watchLive(t, "11")
+				assert.Equal(t, http.StatusOK, status)
+				assertLiveEvents(t, events, data)
+			})
+			t.Run("streams a running run until it ends", func(t *testing.T) {
+				useLiveGlobals(t)
+				publish := backend.install(t)
+				events := liveRunEvents(12)
+				publish(events[0])
+				go func() {
+					time.Sleep(100 * time.Millisecond)
+					for _, evt := range events[1:] {
+						publish(evt)
+					}
+				}()
+
+				status, data := watchLive(t, "12")
+				assert.Equal(t, http.StatusOK, status)
+				assertLiveEvents(t, events, data)
+			})
+		})
+	}
+}
+
+func TestWatchPipelineRunLiveUnavailable(t *testing.T) {
+	useLiveGlobals(t)
+	status, data := watchLive(t, "1")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Empty(t, data)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/hub"
 	"github.com/flowline-io/flowbot/pkg/pipeline"
 	"github.com/flowline-io/flowbot/pkg/rdb"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 	"github.com/flowline-io/flowbot/pkg/types/protocol"
@@ -52,6 +53,9 @@ var pipelineWebserviceRules = []webservice.Rule{
 	webservice.Get("/pipelines/:name/runs/:runID/steps", pipelineRunSteps),
 	webservice.Get("/pipelines/:name/runs/:runID/live", pipelineRunLivePage),
 	webservice.Get("/pipelines/:name/runs/:runID/live/watch", watchPipelineRunLive),
+	webservice.Post("/pipelines/:name/runs/:runID/cancel", cancelPipelineRun),
+	webservice.Post("/pipelines/:name/runs/:runID/retry", retryPipelineRun),
+	webservice.Post("/pipelines/:name/runs/:runID/rerun", rerunPipelineRun),
 	webservice.Get("/pipelines/:name/stats", pipelineStats),
 	webservice.Get("/pipelines/:name/versions", listPipelineVersions),
 	webservice.Get("/pipelines/:name/versions/:version", getPipelineVersion),
@@ -242,11 +246,22 @@ func publishPipeline(c fiber.Ctx) error {
 		}
 		return types.Errorf(types.ErrInternal, "publish: get pipeline: %v", err)
 	}
-	if _, err := pipeline.ParseEditorYAML(def.YamlDraft); err != nil {
+	ed, err := pipeline.ParseEditorYAML(def.YamlDraft)
+	if err != nil {
 		return c.Status(400).JSON(fiber.Map{
 			"error": fiber.Map{"code": "VALIDATION_ERROR", "message": webMsg(c, "error.pipeline.yaml_validation_failed")},
 		})
 	}
+	if err := pipeline.ValidateSteps(ed.Steps); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error": fiber.Map{"code": "VALIDATION_ERROR", "message": err.Error()},
+		})
+	}
+	if err := pipeline.ValidateApprovalSteps(ed.Steps, ed.Resumable); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error": fiber.Map{"code": "VALIDATION_ERROR", "message": err.Error()},
+		})
+	}
 	// Backfill owner for pipelines created before created_by existed.
 	if err := s.EnsureDefinitionCreatedBy(context.Background(), name, getUID(c)); err != nil {
 		flog.Error(fmt.Errorf("ensure pipeline created_by before publish: %w", err))
@@ -501,7 +516,45 @@ func testPipelineStep(c fiber.Ctx) error {
 	for i := 0; i <= body.UpToStepIndex; i++ {
 		step := ed.Steps[i]
 		start := time.Now()
+		run, cErr := rc.EvalCondition(step.When)
+		if cErr != nil {
+			results = append(results, stepResult{Name: step.Name, Status: "error", Error: fmt.Sprintf("evaluate when: %v", cErr)})
+			return c.JSON(fiber.Map{"success": false, "error": "Step " + step.Name + " failed", "steps": results})
+		}
+		if !run {
+			results = append(results, stepResult{Name: step.Name, Status: "skipped"})
+			rc.RecordStepResult(step.Name, map[string]any{"skipped": true})
+			continue
+		}
+		// A foreach step is previewed with its first item only so a test run
+		// never fans out real side effects.
+		if step.Foreach != nil {
+			items, lErr := rc.EvalList(step.Foreach.Items)
+			if lErr != nil {
+				results = append(results, stepResult{Name: step.Name, Status: "error", Error: fmt.Sprintf("evaluate foreach: %v", lErr)})
+				return c.JSON(fiber.Map{"success": false, "error": "Step " + step.Name + " failed", "steps": results})
+			}
+			if len(items) == 0 {
+				results = append(results, stepResult{Name: step.Name, Status: "skipped"})
+				rc.RecordStepResult(step.Name, map[string]any{"items": []any{}, "count": 0})
+				continue
+			}
+			rc.Item = items[0]
+		}
+		// A test run does not wait for people: approval gates pass as approved.
+		if step.Approval != nil {
+			msg, mErr := rc.RenderString(step.Approval.Message)
+			if mErr != nil {
+				results = append(results, stepResult{Name: step.Name, Status: "error", Error: fmt.Sprintf("render approval message: %v", mErr)})
+				return c.JSON(fiber.Map{"success": false, "error": "Step " + step.Name + " failed", "steps": results})
+			}
+			output := map[string]any{"decision": string(runapproval.Approve), "decided_by": "test-run", "message": msg}
+			results = append(results, stepResult{Name: step.Name, Status: "ok", Output: output})
+			rc.RecordStepResult(step.Name, output)
+			continue
+		}
 		rendered, rErr := rc.RenderParams(step.Params)
+		rc.Item = nil
 		if rErr != nil {
 			results = append(results, stepResult{Name: step.Name, Status: "error", Error: fmt.Sprintf("render params: %v", rErr)})
 			return c.JSON(fiber.Map{"success": false, "error": "Step " + step.Name + " failed", "steps": results})
@@ -552,6 +605,10 @@ func pipelineRunsTable(c fiber.Ctx) error {
 }
 
 func pipelineRunSteps(c fiber.Ctx) error {
+	name, err := pipelineNameParam(c)
+	if err != nil {
+		return err
+	}
 	runID, err := strconv.ParseInt(c.Params("runID"), 10, 64)
 	if err != nil {
 		return types.Errorf(types.ErrInvalidArgument, "invalid run ID: %v", err)
@@ -561,7 +618,14 @@ func pipelineRunSteps(c fiber.Ctx) error {
 	if err != nil {
 		return types.Errorf(types.ErrInternal, "get step runs: %v", err)
 	}
+	var actions partials.RunActionsView
+	if run, err := s.GetRunByID(context.Background(), runID); err == nil && run != nil {
+		actions = pipelineRunActions(name, runID, run.Status, pipeline.ActiveEngine().IsResumable(run.PipelineName))
+	}
 	c.Type("html")
+	if err := partials.RunDetailActions(c.Context(), actions).Render(c.Context(), c.Response().BodyWriter()); err != nil {
+		return err
+	}
 	return partials.PipelineStepRunsDetail(c.Context(), mapPipelineStepRuns(steps)).Render(c.Context(), c.Response().BodyWriter())
 }
 
@@ -604,7 +668,13 @@ func watchPipelineRunLive(c fiber.Ctx) error {
 	ctx := c.Context()
 	redisClient := rdb.Client
 	if redisClient == nil {
-		return c.Status(fiber.StatusServiceUnavailable).SendString(webMsg(c, "error.pipeline.redis_unavailable"))
+		live := pipeline.LiveProgress
+		if live == nil {
+			return c.Status(fiber.StatusServiceUnavailable).SendString(webMsg(c, "error.pipeline.redis_unavailable"))
+		}
+		return c.SendStreamWriter(func(w *bufio.Writer) {
+			streamLiveProgress(ctx, w, live, runID)
+		})
 	}
 
 	return c.SendStreamWriter(func(w *bufio.Writer) {
@@ -623,11 +693,36 @@ func watchPipelineRunLive(c fiber.Ctx) error {
 	})
 }
 
+// liveReadBlock bounds one wait for progress, matching the Redis XRead block
+// so idle watches get the same heartbeat cadence on both backends.
+const liveReadBlock = 5 * time.Second
+
+// streamLiveProgress writes the in-process progress of runID as SSE events
+// until the run ends, the client leaves or a write fails.
+func streamLiveProgress(ctx context.Context, w *bufio.Writer, live *pipeline.MemoryProgress, runID int64) {
+	next := 0
+	for ctx.Err() == nil {
+		events := live.Read(ctx, runID, next, liveReadBlock)
+		if len(events) == 0 {
+			if ctx.Err() != nil || writeHeartbeat(w) {
+				return
+			}
+			continue
+		}
+		next += len(events)
+		for _, data := range events {
+			if writeSSEEvent(w, data) {
+				return
+			}
+		}
+	}
+}
+
 func broadcastStreamReadArgs(stream, lastID string) *redis.XReadArgs {
 	return &redis.XReadArgs{
 		Streams: []string{stream, lastID},
 		Count:   10,
-		Block:   5 * time.Second,
+		Block:   liveReadBlock,
 	}
 }
 
@@ -725,6 +820,7 @@ func pipelineRunLivePage(c fiber.Ctx) error {
 		TotalSteps:   len(steps),
 		RunStatus:    pipelineRunStatusLabel(run.Status),
 		Steps:        initSteps,
+		Actions:      pipelineRunActions(pipelineName, runID, run.Status, pipeline.ActiveEngine().IsResumable(run.PipelineName)),
 	}).Render(c.Context(), c.Response().BodyWriter())
 }
 
@@ -786,6 +882,8 @@ func pipelineRunStatusLabel(status int) string {
 		return "running"
 	case 2:
 		return "done"
+	case 3:
+		return "cancelled"
 	case 4:
 		return "failed"
 	default:
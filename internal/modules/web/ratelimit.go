@@ -10,7 +10,7 @@ import (
 
 // rateLimitStore is the subset of cache operations needed by the login rate limiter.
 // It combines integer counter operations from IntCache with key existence and deletion
-// from StringCache, both of which are satisfied by cache.RedisStore.
+// from StringCache, both of which are satisfied by cache.Store.
 type rateLimitStore interface {
 	cache.IntCache
 	Exists(ctx context.Context, key cache.Key) (bool, error)
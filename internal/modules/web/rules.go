@@ -5,7 +5,7 @@ import (
 )
 
 // allWebserviceRules lists every route group registered under /service/web.
-// Rules() exposes each slice separately (32 groups).
+// Rules() exposes each slice separately (34 groups).
 var allWebserviceRules = [][]webservice.Rule{
 	homeWebserviceRules,
 	loginWebserviceRules,
@@ -39,4 +39,6 @@ var allWebserviceRules = [][]webservice.Rule{
 	workflowWebserviceRules,
 	commandPaletteWebserviceRules,
 	lifeWebserviceRules,
+	gatewayWebserviceRules,
+	approvalWebserviceRules,
 }
@@ -13,9 +13,9 @@ func TestAllWebserviceRuleGroups(t *testing.T) {
 		wantLen   int
 		wantEmpty bool
 	}{
-		{name: "registers thirty-two route groups", wantLen: 32},
+		{name: "registers thirty-four route groups", wantLen: 34},
 		{name: "every group has at least one route", wantEmpty: false},
-		{name: "Rules matches allWebserviceRules length", wantLen: 32},
+		{name: "Rules matches allWebserviceRules length", wantLen: 34},
 	}
 
 	for _, tt := range tests {
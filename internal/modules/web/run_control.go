@@ -0,0 +1,168 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/views/partials"
+)
+
+// pipelineRunActions returns the run control buttons available for a pipeline run.
+func pipelineRunActions(name string, runID int64, status int, resumable bool) partials.RunActionsView {
+	finished := status == int(types.PipelineFailed) || status == int(types.PipelineCancel)
+	return partials.RunActionsView{
+		BasePath:  partials.PipelineWebPath(name) + "/runs/" + strconv.FormatInt(runID, 10),
+		CanCancel: status == int(types.PipelineStart) || status == int(types.PipelineWaiting),
+		CanRetry:  finished && resumable,
+		CanRerun:  status != int(types.PipelineStart),
+	}
+}
+
+// workflowRunActions returns the run control buttons available for a workflow run.
+func workflowRunActions(name string, runID int64, status int, resumable bool) partials.RunActionsView {
+	finished := status == int(types.WorkflowRunFailed) || status == int(types.WorkflowRunCancelled)
+	return partials.RunActionsView{
+		BasePath:  partials.WorkflowWebPath(name) + "/runs/" + strconv.FormatInt(runID, 10),
+		CanCancel: status == int(types.WorkflowRunRunning) || status == int(types.WorkflowRunWaiting),
+		CanRetry:  finished && resumable,
+		CanRerun:  status != int(types.WorkflowRunRunning),
+	}
+}
+
+func cancelPipelineRun(c fiber.Ctx) error {
+	runID, err := runIDParam(c)
+	if err != nil {
+		return err
+	}
+	if err := pipeline.ActiveEngine().CancelRun(c.Context(), runID); err != nil {
+		return runControlError(c, "cancelPipelineRun", err)
+	}
+	setShowToast(c, "success", webMsgData(c, "toast.run.cancel_requested", map[string]any{"RunID": runID}))
+	c.Response().Header.Set("HX-Refresh", "true")
+	return c.SendStatus(http.StatusOK)
+}
+
+func retryPipelineRun(c fiber.Ctx) error {
+	name, err := pipelineNameParam(c)
+	if err != nil {
+		return err
+	}
+	runID, err := runIDParam(c)
+	if err != nil {
+		return err
+	}
+	if err := pipeline.ActiveEngine().RetryRun(c.Context(), runID); err != nil {
+		return runControlError(c, "retryPipelineRun", err)
+	}
+	setShowToast(c, "success", webMsgData(c, "toast.run.retry_started", map[string]any{"RunID": runID}))
+	c.Response().Header.Set("HX-Redirect", fmt.Sprintf("%s/runs/%d/live", partials.PipelineWebPath(name), runID))
+	return c.SendStatus(http.StatusOK)
+}
+
+func rerunPipelineRun(c fiber.Ctx) error {
+	name, err := pipelineNameParam(c)
+	if err != nil {
+		return err
+	}
+	runID, err := runIDParam(c)
+	if err != nil {
+		return err
+	}
+	newRunID, err := pipeline.ActiveEngine().RerunRun(c.Context(), runID)
+	if err != nil {
+		return runControlError(c, "rerunPipelineRun", err)
+	}
+	setShowToast(c, "success", webMsgData(c, "toast.run.rerun_started", map[string]any{"RunID": newRunID}))
+	c.Response().Header.Set("HX-Redirect", fmt.Sprintf("%s/runs/%d/live", partials.PipelineWebPath(name), newRunID))
+	return c.SendStatus(http.StatusOK)
+}
+
+func cancelWorkflowRun(c fiber.Ctx) error {
+	if err := authenticateWeb(c); err != nil {
+		return err
+	}
+	runID, err := runIDParam(c)
+	if err != nil {
+		return err
+	}
+	if err := getWorkflowService().CancelRun(c.Context(), runID); err != nil {
+		return runControlError(c, "cancelWorkflowRun", err)
+	}
+	setShowToast(c, "success", webMsgData(c, "toast.run.cancel_requested", map[string]any{"RunID": runID}))
+	c.Response().Header.Set("HX-Refresh", "true")
+	return c.SendStatus(http.StatusOK)
+}
+
+func retryWorkflowRun(c fiber.Ctx) error {
+	if err := authenticateWeb(c); err != nil {
+		return err
+	}
+	name, err := workflowNameParam(c)
+	if err != nil {
+		return err
+	}
+	runID, err := runIDParam(c)
+	if err != nil {
+		return err
+	}
+	if err := getWorkflowService().RetryRun(c.Context(), runID); err != nil {
+		return runControlError(c, "retryWorkflowRun", err)
+	}
+	setShowToast(c, "success", webMsgData(c, "toast.run.retry_started", map[string]any{"RunID": runID}))
+	c.Response().Header.Set("HX-Redirect", partials.WorkflowWebPath(name)+"/runs")
+	return c.SendStatus(http.StatusOK)
+}
+
+func rerunWorkflowRun(c fiber.Ctx) error {
+	if err := authenticateWeb(c); err != nil {
+		return err
+	}
+	name, err := workflowNameParam(c)
+	if err != nil {
+		return err
+	}
+	runID, err := runIDParam(c)
+	if err != nil {
+		return err
+	}
+	newRunID, err := getWorkflowService().RerunRun(c.Context(), runID)
+	if err != nil {
+		return runControlError(c, "rerunWorkflowRun", err)
+	}
+	setShowToast(c, "success", webMsgData(c, "toast.run.rerun_started", map[string]any{"RunID": newRunID}))
+	c.Response().Header.Set("HX-Redirect", partials.WorkflowWebPath(name)+"/runs")
+	return c.SendStatus(http.StatusOK)
+}
+
+// runIDParam parses the :runID path parameter of run routes.
+func runIDParam(c fiber.Ctx) (int64, error) {
+	runID, err := strconv.ParseInt(c.Params("runID"), 10, 64)
+	if err != nil || runID <= 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid run ID")
+	}
+	return runID, nil
+}
+
+// runControlError shows a toast explaining why a cancel, retry, or re-run was refused.
+func runControlError(c fiber.Ctx, op string, err error) error {
+	switch {
+	case errors.Is(err, types.ErrConflict):
+		return toastErrorKey(c, "toast.run.state_changed")
+	case errors.Is(err, types.ErrInvalidArgument):
+		return toastErrorKey(c, "toast.run.not_resumable")
+	case errors.Is(err, types.ErrNotFound):
+		return toastErrorKey(c, "toast.run.no_checkpoint")
+	case errors.Is(err, types.ErrUnavailable):
+		return toastErrorKey(c, "toast.run.control_unavailable")
+	default:
+		flog.Error(fmt.Errorf("%s: %w", op, err))
+		return toastErrorKey(c, "toast.run.control_failed")
+	}
+}
@@ -460,6 +460,8 @@ func lockWebTestGlobals(t *testing.T) {
 		config = configType{}
 		loginLimiter = nil
 		totpLimiter = nil
+		oidcClient = nil
+		trustedProxies = nil
 		setWebEncryptor(nil)
 		webTestGlobalsMu.Unlock()
 	})
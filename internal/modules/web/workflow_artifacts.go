@@ -0,0 +1,40 @@
+package web
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+	"github.com/flowline-io/flowbot/pkg/views/partials"
+	pkgworkflow "github.com/flowline-io/flowbot/pkg/workflow"
+)
+
+// workflowRunArtifacts collects the artifacts recorded on step runs and signs a
+// download URL for each. Artifacts that cannot be signed are listed without a link.
+func workflowRunArtifacts(ctx context.Context, steps []model.WorkflowStepRun, h media.Handler) []partials.WorkflowArtifactView {
+	accessor, canSign := media.AsAccessor(h)
+	var out []partials.WorkflowArtifactView
+	for _, s := range steps {
+		for _, a := range pkgworkflow.ArtifactsFromStepResult(s.Result) {
+			view := partials.WorkflowArtifactView{
+				Task:     a.Task,
+				Name:     a.Name,
+				Path:     a.Path,
+				MimeType: a.MimeType,
+				Size:     a.Size,
+			}
+			if canSign && accessor != nil {
+				u, err := accessor.SignGetURL(ctx, a.FileID, pkgworkflow.ArtifactURLTTL)
+				if err != nil {
+					flog.Warn("workflow artifact %s.%s: %v", a.Task, a.Name, fmt.Errorf("sign url: %w", err))
+				} else {
+					view.URL = u
+				}
+			}
+			out = append(out, view)
+		}
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+	"github.com/flowline-io/flowbot/pkg/views/partials"
+)
+
+// signingMedia signs every file except "unsigned".
+type signingMedia struct{}
+
+func (signingMedia) Init(string) error                                     { return nil }
+func (signingMedia) Headers(*http.Request, bool) (http.Header, int, error) { return nil, 0, nil }
+func (signingMedia) Upload(*types.FileDef, io.ReadSeeker) (string, int64, error) {
+	return "", 0, nil
+}
+func (signingMedia) Download(string) (*types.FileDef, media.ReadSeekCloser, error) {
+	return nil, nil, nil
+}
+func (signingMedia) Delete([]string) error         { return nil }
+func (signingMedia) GetIdFromUrl(string) types.Uid { return "" }
+func (signingMedia) OpenByID(context.Context, string) (*types.FileDef, media.ReadSeekCloser, error) {
+	return nil, nil, nil
+}
+func (signingMedia) SignGetURL(_ context.Context, fileID string, _ time.Duration) (string, error) {
+	if fileID == "unsigned" {
+		return "", fmt.Errorf("public_base_url is required")
+	}
+	return "https://media.example/" + fileID, nil
+}
+
+func TestWorkflowRunArtifacts(t *testing.T) {
+	t.Parallel()
+	steps := []model.WorkflowStepRun{
+		{StepID: "lint", Result: map[string]any{"result": "ok"}},
+		{StepID: "build", Result: map[string]any{"artifacts": []any{
+			map[string]any{"task": "build", "name": "bin", "path": "out/app", "file_id": "f1", "size": float64(42)},
+			map[string]any{"task": "build", "name": "log", "path": "build.log", "file_id": "unsigned", "size": float64(3)},
+		}}},
+	}
+
+	got := workflowRunArtifacts(context.Background(), steps, signingMedia{})
+	assert.Equal(t, []partials.WorkflowArtifactView{
+		{Task: "build", Name: "bin", Path: "out/app", Size: 42, URL: "https://media.example/f1"},
+		{Task: "build", Name: "log", Path: "build.log", Size: 3},
+	}, got)
+
+	assert.Empty(t, workflowRunArtifacts(context.Background(), steps, nil)[0].URL, "no media handler lists artifacts without links")
+}
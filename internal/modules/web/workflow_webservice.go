@@ -16,6 +16,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/media"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/ruleset/webservice"
 	"github.com/flowline-io/flowbot/pkg/views/pages"
@@ -35,6 +36,9 @@ var workflowWebserviceRules = []webservice.Rule{
 	webservice.Get("/workflows/:name/runs/list", workflowRunsTable),
 	webservice.Get("/workflows/:name/runs/:runID/steps", workflowRunSteps),
 	webservice.Post("/workflows/:name/run", workflowRunNow),
+	webservice.Post("/workflows/:name/runs/:runID/cancel", cancelWorkflowRun),
+	webservice.Post("/workflows/:name/runs/:runID/retry", retryWorkflowRun),
+	webservice.Post("/workflows/:name/runs/:runID/rerun", rerunWorkflowRun),
 }
 
 func getWorkflowStore() *store.WorkflowStore {
@@ -395,8 +399,21 @@ func workflowRunSteps(c fiber.Ctx) error {
 	if err != nil {
 		return types.Errorf(types.ErrInternal, "get workflow step runs: %v", err)
 	}
+	resumable := false
+	if catalog := getWorkflowCatalog(); catalog != nil {
+		if meta, err := catalog.GetMetadata(c.Context(), name); err == nil && meta != nil {
+			resumable = meta.Resumable
+		}
+	}
 	c.Type("html")
-	return partials.WorkflowStepRunsDetail(c.Context(), mapWorkflowStepRuns(steps)).Render(c.Context(), c.Response().BodyWriter())
+	if err := partials.RunDetailActions(c.Context(), workflowRunActions(name, runID, run.Status, resumable)).Render(c.Context(), c.Response().BodyWriter()); err != nil {
+		return err
+	}
+	stepRuns := mapWorkflowStepRuns(steps)
+	if err := partials.WorkflowRunArtifacts(c.Context(), workflowRunArtifacts(c.Context(), stepRuns, media.FileSystem)).Render(c.Context(), c.Response().BodyWriter()); err != nil {
+		return err
+	}
+	return partials.WorkflowStepRunsDetail(c.Context(), stepRuns).Render(c.Context(), c.Response().BodyWriter())
 }
 
 func workflowRunNow(c fiber.Ctx) error {
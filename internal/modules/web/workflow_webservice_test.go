@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bytedance/sonic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -476,6 +477,7 @@ func TestWorkflowRunSteps(t *testing.T) {
 				`data-testid="workflow-step-row-step1"`,
 				"mapper:",
 				"Input",
+				`data-testid="run-action-cancel"`,
 			},
 		},
 		{
@@ -514,6 +516,98 @@ func TestWorkflowRunSteps(t *testing.T) {
 	}
 }
 
+func TestWorkflowRunControl(t *testing.T) {
+	app, _, client := setupTestAppWithDB(t)
+	t.Cleanup(func() {
+		stopFallbackWorkflowService()
+		store.Database = nil
+		handler = moduleHandler{}
+		config = configType{}
+	})
+	pkgworkflow.SetReloadService(nil)
+
+	ctx := context.Background()
+	ws := store.NewWorkflowStore(client)
+	rs := store.NewWorkflowRunStore(client)
+	_, err := ws.ApplyDefinition(ctx, &types.WorkflowMetadata{
+		Name:     "control-wf",
+		Enabled:  true,
+		Pipeline: []string{"step1"},
+		Tasks:    []types.WorkflowTask{{ID: "step1", Action: "mapper:"}},
+		Triggers: []types.WorkflowTriggerDef{{Type: "manual", Enabled: true}},
+	})
+	require.NoError(t, err)
+	dto, err := ws.GetDefinitionByName(ctx, "control-wf")
+	require.NoError(t, err)
+	run, err := rs.CreateRun(ctx, dto.Workflow.ID, "control-wf", "db", "manual", nil, nil)
+	require.NoError(t, err)
+	// Orphaned: no heartbeat since a start long enough ago to count as stale.
+	require.NoError(t, client.WorkflowRun.UpdateOneID(run.ID).SetStartedAt(time.Now().Add(-time.Hour)).Exec(ctx))
+	base := "/service/web/workflows/control-wf/runs/" + strconv.FormatInt(run.ID, 10)
+
+	tests := []struct {
+		name        string
+		path        string
+		wantStatus  int
+		wantHeader  string
+		wantValue   string
+		wantToast   string
+		wantRunStat types.WorkflowRunState
+	}{
+		{
+			name:        "cancel orphaned running run",
+			path:        base + "/cancel",
+			wantStatus:  http.StatusOK,
+			wantHeader:  "HX-Refresh",
+			wantValue:   "true",
+			wantRunStat: types.WorkflowRunCancelled,
+		},
+		{
+			name:       "cancel finished run is refused",
+			path:       base + "/cancel",
+			wantStatus: http.StatusNoContent,
+			wantToast:  "toast.run.state_changed",
+		},
+		{
+			name:       "retry requires resumable workflow",
+			path:       base + "/retry",
+			wantStatus: http.StatusNoContent,
+			wantToast:  "toast.run.not_resumable",
+		},
+		{
+			name:       "rerun redirects to runs page",
+			path:       base + "/rerun",
+			wantStatus: http.StatusOK,
+			wantHeader: "HX-Redirect",
+			wantValue:  "/service/web/workflows/control-wf/runs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, http.NoBody)
+			req.AddCookie(&http.Cookie{Name: "accessToken", Value: "test-token"})
+			AttachCSRFForTest(req)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			if tt.wantHeader != "" {
+				assert.Equal(t, tt.wantValue, resp.Header.Get(tt.wantHeader))
+			}
+			if tt.wantToast != "" {
+				msg, err := sonic.Marshal(i18n.T(i18n.DefaultContext(), tt.wantToast))
+				require.NoError(t, err)
+				assert.Contains(t, resp.Header.Get("HX-Trigger"), string(msg))
+			}
+			if tt.wantRunStat != 0 {
+				got, err := rs.GetRun(ctx, run.ID)
+				require.NoError(t, err)
+				assert.Equal(t, int(tt.wantRunStat), got.Status)
+			}
+		})
+	}
+}
+
 func boolJSON(v bool) string {
 	if v {
 		return "true"
@@ -617,6 +711,9 @@ func TestWorkflowWebserviceRulesRegistered(t *testing.T) {
 		{name: "runs", path: "/workflows/:name/runs"},
 		{name: "runs list", path: "/workflows/:name/runs/list"},
 		{name: "run", path: "/workflows/:name/run"},
+		{name: "cancel run", path: "/workflows/:name/runs/:runID/cancel"},
+		{name: "retry run", path: "/workflows/:name/runs/:runID/retry"},
+		{name: "rerun run", path: "/workflows/:name/runs/:runID/rerun"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -2,6 +2,7 @@ package workflow
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
@@ -19,6 +20,9 @@ var webserviceRules = []webservice.Rule{
 	webservice.Delete("/delete/:name", deleteWorkflow),
 	webservice.Post("/run", runWorkflow),
 	webservice.Get("/runs/:name", listWorkflowRuns),
+	webservice.Post("/cancel/:id", cancelWorkflowRun),
+	webservice.Post("/retry/:id", retryWorkflowRun),
+	webservice.Post("/rerun/:id", rerunWorkflowRun),
 }
 
 func applyWorkflow(ctx fiber.Ctx) error {
@@ -185,6 +189,55 @@ func listWorkflowRuns(ctx fiber.Ctx) error {
 	return ctx.JSON(protocol.NewSuccessResponse(types.KV{"runs": items}))
 }
 
+func cancelWorkflowRun(ctx fiber.Ctx) error {
+	runID, err := runIDParam(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	if err := svc.CancelRun(context.Background(), runID); err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(types.KV{"run_id": runID, "cancelled": true}))
+}
+
+func retryWorkflowRun(ctx fiber.Ctx) error {
+	runID, err := runIDParam(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	if err := svc.RetryRun(context.Background(), runID); err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusAccepted).JSON(protocol.NewSuccessResponse(types.KV{"run_id": runID}))
+}
+
+func rerunWorkflowRun(ctx fiber.Ctx) error {
+	runID, err := runIDParam(ctx)
+	if err != nil {
+		return err
+	}
+	svc, err := activeService()
+	if err != nil {
+		return err
+	}
+	newRunID, err := svc.RerunRun(context.Background(), runID)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusAccepted).JSON(protocol.NewSuccessResponse(types.KV{
+		"run_id":        newRunID,
+		"source_run_id": runID,
+	}))
+}
+
 func workflowNameParam(ctx fiber.Ctx) string {
 	name := strings.TrimSpace(ctx.Params("name"))
 	if name == "" {
@@ -192,3 +245,15 @@ func workflowNameParam(ctx fiber.Ctx) string {
 	}
 	return name
 }
+
+func runIDParam(ctx fiber.Ctx) (int64, error) {
+	raw := strings.TrimSpace(ctx.Params("id"))
+	if raw == "" {
+		return 0, types.Errorf(types.ErrInvalidArgument, "run id is required")
+	}
+	runID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || runID <= 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid run id")
+	}
+	return runID, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 	"github.com/flowline-io/flowbot/pkg/types/protocol"
@@ -89,6 +90,9 @@ func (s *handlerRunStore) CreateRun(_ context.Context, workflowID int64, workflo
 }
 
 func (*handlerRunStore) UpdateRunStatus(context.Context, int64, int, string) error { return nil }
+func (*handlerRunStore) SwapRunStatus(context.Context, int64, []int, int, string) (bool, error) {
+	return true, nil
+}
 func (*handlerRunStore) CreateStepRun(context.Context, int64, string, string, string, string, map[string]any, int) (*model.WorkflowStepRun, error) {
 	return nil, nil
 }
@@ -99,8 +103,20 @@ func (*handlerRunStore) SaveCheckpoint(context.Context, int64, any) error { retu
 func (*handlerRunStore) GetIncompleteRuns(context.Context) ([]*model.WorkflowRun, error) {
 	return nil, nil
 }
-func (*handlerRunStore) GetCheckpoint(context.Context, int64, any) error { return nil }
-func (*handlerRunStore) GetRun(context.Context, int64) (*model.WorkflowRun, error) {
+func (*handlerRunStore) GetWaitingRuns(context.Context) ([]*model.WorkflowRun, error) {
+	return nil, nil
+}
+func (*handlerRunStore) SetRunParent(context.Context, int64, runcall.Parent) error { return nil }
+func (*handlerRunStore) GetCheckpoint(context.Context, int64, any) error           { return nil }
+func (s *handlerRunStore) GetRun(_ context.Context, runID int64) (*model.WorkflowRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, run := range s.runs {
+		if run.ID == runID {
+			cp := *run
+			return &cp, nil
+		}
+	}
 	return nil, types.Errorf(types.ErrNotFound, "run")
 }
 func (*handlerRunStore) UpdateRunHeartbeat(context.Context, int64) error { return nil }
@@ -140,6 +156,9 @@ func newWorkflowHandlerApp() *fiber.App {
 	app.Delete("/service/workflow/delete/:name", deleteWorkflow)
 	app.Post("/service/workflow/run", runWorkflow)
 	app.Get("/service/workflow/runs/:name", listWorkflowRuns)
+	app.Post("/service/workflow/cancel/:id", cancelWorkflowRun)
+	app.Post("/service/workflow/retry/:id", retryWorkflowRun)
+	app.Post("/service/workflow/rerun/:id", rerunWorkflowRun)
 	return app
 }
 func TestWorkflowHandlers(t *testing.T) {
@@ -224,6 +243,27 @@ tasks:
 			wantStatus: http.StatusAccepted,
 			wantSubstr: "run_id",
 		},
+		{
+			name:       "rerun starts a new run",
+			method:     http.MethodPost,
+			path:       "/service/workflow/rerun/1",
+			wantStatus: http.StatusAccepted,
+			wantSubstr: `"source_run_id":1`,
+		},
+		{
+			name:       "cancel rejects invalid id",
+			method:     http.MethodPost,
+			path:       "/service/workflow/cancel/abc",
+			wantStatus: http.StatusBadRequest,
+			wantSubstr: "invalid run id",
+		},
+		{
+			name:       "retry missing run",
+			method:     http.MethodPost,
+			path:       "/service/workflow/retry/99",
+			wantStatus: http.StatusNotFound,
+			wantSubstr: "run",
+		},
 		{
 			name:       "runs list ok",
 			method:     http.MethodGet,
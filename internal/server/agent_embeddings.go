@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/schema"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+	"github.com/flowline-io/flowbot/pkg/flog"
+)
+
+// agentEmbeddingInterval is how often the agent vector index is reconciled
+// when no write has kicked it.
+const agentEmbeddingInterval = 5 * time.Minute
+
+// initAgentEmbeddings builds the vector index over agent knowledge, memory facts
+// and session summaries when chat_agent.embedding_model is set, and keeps it
+// in line with their content.
+func initAgentEmbeddings(lc fx.Lifecycle) {
+	if store.Database == nil || store.Database.GetClient() == nil {
+		flog.Warn("agent embeddings skipped: store.Database not ready")
+		return
+	}
+	embedder, err := embed.FromConfig()
+	if err != nil {
+		flog.Error(err)
+		return
+	}
+	if embedder == nil {
+		return
+	}
+
+	ix := embed.NewIndex(store.AgentEmbeddingStoreFromDB(), embedder).
+		WithSource(embed.KindKnowledge, knowledgeEmbedItems).
+		WithSource(embed.KindMemoryFact, memoryFactEmbedItems).
+		WithSource(embed.KindSessionSummary, sessionSummaryEmbedItems)
+	embed.SetActive(ix)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				runAgentEmbeddingReconcile(ctx, ix)
+				ticker := time.NewTicker(agentEmbeddingInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					case <-ix.Kicks():
+					}
+					runAgentEmbeddingReconcile(ctx, ix)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			embed.SetActive(nil)
+			return nil
+		},
+	})
+
+	flog.Info("agent embeddings initialized (model=%s, interval=%s)", embedder.Model(), agentEmbeddingInterval)
+}
+
+func runAgentEmbeddingReconcile(ctx context.Context, ix *embed.Index) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	if err := ix.Reconcile(ctx); err != nil {
+		flog.Warn("%v", err)
+	}
+}
+
+// knowledgeEmbedItems embeds each knowledge document by its title, path, tags,
+// summary and content. Knowledge is shared, so it has no scope.
+func knowledgeEmbedItems(ctx context.Context) ([]embed.Item, error) {
+	rows, err := store.AgentStoreFromDB().ListAgentKnowledge(ctx, store.AgentKnowledgeListFilter{})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]embed.Item, 0, len(rows))
+	for _, k := range rows {
+		parts := []string{k.Title, k.Path}
+		if len(k.Tags) > 0 {
+			parts = append(parts, strings.Join(k.Tags, ", "))
+		}
+		parts = append(parts, k.Summary, k.Content)
+		items = append(items, embed.Item{RefID: k.ID, Text: joinNonEmpty(parts)})
+	}
+	return items, nil
+}
+
+// memoryFactEmbedItems embeds each memory fact as "key: value" within its scope.
+func memoryFactEmbedItems(ctx context.Context) ([]embed.Item, error) {
+	rows, err := store.AgentStoreFromDB().ListAllAgentMemoryFacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]embed.Item, 0, len(rows))
+	for _, f := range rows {
+		items = append(items, embed.Item{RefID: f.ID, Scope: f.Scope, Text: f.Key + ": " + f.Value})
+	}
+	return items, nil
+}
+
+// sessionSummaryEmbedItems embeds each ready session summary within its scope.
+func sessionSummaryEmbedItems(ctx context.Context) ([]embed.Item, error) {
+	rows, err := store.AgentStoreFromDB().ListAgentSessionSummaries(ctx, store.AgentSessionSummaryListFilter{
+		Status: schema.AgentSessionSummaryReady,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]embed.Item, 0, len(rows))
+	for _, s := range rows {
+		items = append(items, embed.Item{RefID: s.ID, Scope: s.Scope, Text: joinNonEmpty([]string{s.Title, s.Summary})})
+	}
+	return items, nil
+}
+
+func joinNonEmpty(parts []string) string {
+	kept := parts[:0]
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
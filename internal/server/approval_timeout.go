@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/workflow"
+)
+
+const approvalTimeoutInterval = time.Minute
+
+// approvalExpirer applies the default decision to timed-out approval gates.
+type approvalExpirer interface {
+	ExpireApprovals(ctx context.Context) (int, error)
+}
+
+// startApprovalTimeoutLoop periodically resolves pipeline and workflow runs
+// whose approval gate passed its timeout. Waiting runs live in their
+// checkpoints, so gates opened before a restart are picked up here too.
+func startApprovalTimeoutLoop(lc fx.Lifecycle) {
+	stop := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go approvalTimeoutLoop(stop, func() []approvalExpirer {
+				var out []approvalExpirer
+				if e := pipeline.ActiveEngine(); e != nil {
+					out = append(out, e)
+				}
+				if s := workflow.ActiveService(); s != nil {
+					out = append(out, s)
+				}
+				return out
+			})
+			flog.Info("approval timeout loop started (interval=%s)", approvalTimeoutInterval)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			close(stop)
+			return nil
+		},
+	})
+}
+
+func approvalTimeoutLoop(stop <-chan struct{}, expirersFn func() []approvalExpirer) {
+	ticker := time.NewTicker(approvalTimeoutInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			for _, e := range expirersFn() {
+				n, err := e.ExpireApprovals(context.Background())
+				if err != nil {
+					flog.Warn("approval timeout: %v", err)
+					continue
+				}
+				if n > 0 {
+					flog.Info("approval timeout: resumed %d run(s) with their default decision", n)
+				}
+			}
+		}
+	}
+}
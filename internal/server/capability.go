@@ -4,6 +4,8 @@ package server
 import (
 	"errors"
 
+	"github.com/flowline-io/flowbot/pkg/capability/adguard"
+	"github.com/flowline-io/flowbot/pkg/capability/archivebox"
 	"github.com/flowline-io/flowbot/pkg/capability/devops"
 	"github.com/flowline-io/flowbot/pkg/capability/email"
 	"github.com/flowline-io/flowbot/pkg/capability/fireflyiii"
@@ -13,7 +15,9 @@ import (
 	"github.com/flowline-io/flowbot/pkg/capability/karakeep"
 	"github.com/flowline-io/flowbot/pkg/capability/memos"
 	"github.com/flowline-io/flowbot/pkg/capability/miniflux"
+	"github.com/flowline-io/flowbot/pkg/capability/n8n"
 	"github.com/flowline-io/flowbot/pkg/capability/nocodb"
+	"github.com/flowline-io/flowbot/pkg/capability/slash"
 	"github.com/flowline-io/flowbot/pkg/capability/transmission"
 	"github.com/flowline-io/flowbot/pkg/capability/trilium"
 	"github.com/flowline-io/flowbot/pkg/hub"
@@ -33,6 +37,10 @@ func initCapabilityHub() error {
 		gitea.Register("gitea", gitea.New()),
 		github.Register("github", github.New()),
 		devops.Register("devops", devops.New()),
+		adguard.Register("adguard_home", adguard.New()),
+		archivebox.Register("archivebox", archivebox.New()),
+		n8n.Register("n8n", n8n.New()),
+		slash.Register("slash", slash.New()),
 	)
 	if err != nil {
 		return err
@@ -12,4 +12,6 @@ var (
 	ErrChatAgentDisabled = errors.New("chat agent disabled")
 	// ErrRunInFlight means the session already has an active SSE run.
 	ErrRunInFlight = errors.New("run already in progress")
+	// ErrBudgetExceeded means a chat_agent.budgets hard limit blocks new runs.
+	ErrBudgetExceeded = errors.New("llm budget exceeded")
 )
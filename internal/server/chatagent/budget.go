@@ -0,0 +1,310 @@
+package chatagent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/agent/model"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/cache"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	pkgnotify "github.com/flowline-io/flowbot/pkg/notify"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// Budget scopes and windows accepted in chat_agent.budgets.
+const (
+	BudgetScopeUser          = "user"
+	BudgetScopeAgent         = "agent"
+	BudgetScopeScheduledTask = "scheduled_task"
+	BudgetScopePipelineStep  = "pipeline_step"
+
+	BudgetWindowDaily   = "daily"
+	BudgetWindowMonthly = "monthly"
+)
+
+// BudgetSubject attributes LLM usage to the user, agent, scheduled task and
+// pipeline step that chat_agent.budgets count against.
+type BudgetSubject struct {
+	UID          string
+	Agent        string
+	TaskID       string
+	PipelineStep string
+}
+
+type budgetSubjectKey struct{}
+
+// WithBudgetSubject stores subject on ctx for usage attribution and budget checks.
+func WithBudgetSubject(ctx context.Context, subject BudgetSubject) context.Context {
+	return context.WithValue(ctx, budgetSubjectKey{}, subject)
+}
+
+// BudgetSubjectFromContext returns the subject stored on ctx, or zero when unset.
+func BudgetSubjectFromContext(ctx context.Context) BudgetSubject {
+	if ctx == nil {
+		return BudgetSubject{}
+	}
+	subject, _ := ctx.Value(budgetSubjectKey{}).(BudgetSubject)
+	return subject
+}
+
+// BudgetExceededError reports a hard budget limit that blocks new runs.
+type BudgetExceededError struct {
+	Budget  string
+	Scope   string
+	Subject string
+	Window  string
+	Used    string
+	Limit   string
+	ResetAt time.Time
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("LLM budget %q exhausted for %s %s: used %s of %s this %s. New runs are blocked until %s UTC.",
+		e.Budget, budgetScopeLabel(e.Scope), e.Subject, e.Used, e.Limit, budgetWindowLabel(e.Window),
+		e.ResetAt.Format("2006-01-02 15:04"))
+}
+
+// Unwrap lets callers match ErrBudgetExceeded with errors.Is.
+func (*BudgetExceededError) Unwrap() error { return ErrBudgetExceeded }
+
+// budgetMatch is one configured budget that applies to a subject.
+type budgetMatch struct {
+	cfg     config.ChatAgentBudgetConfig
+	subject string
+}
+
+// budgetsFor returns the configured budgets whose scope resolves to a matching subject.
+func budgetsFor(cfgs []config.ChatAgentBudgetConfig, subject BudgetSubject) []budgetMatch {
+	var out []budgetMatch
+	for _, cfg := range cfgs {
+		value := budgetSubjectValue(cfg.Scope, subject)
+		if value == "" {
+			continue
+		}
+		if len(cfg.Match) > 0 && !slices.Contains(cfg.Match, value) {
+			continue
+		}
+		out = append(out, budgetMatch{cfg: cfg, subject: value})
+	}
+	return out
+}
+
+func budgetSubjectValue(scope string, subject BudgetSubject) string {
+	switch scope {
+	case BudgetScopeUser:
+		return subject.UID
+	case BudgetScopeAgent:
+		return subject.Agent
+	case BudgetScopeScheduledTask:
+		return subject.TaskID
+	case BudgetScopePipelineStep:
+		return subject.PipelineStep
+	default:
+		return ""
+	}
+}
+
+func budgetUsageFilter(scope, value string, since time.Time) types.LLMUsageFilter {
+	filter := types.LLMUsageFilter{Since: since}
+	switch scope {
+	case BudgetScopeUser:
+		filter.UID = value
+	case BudgetScopeAgent:
+		filter.Agent = value
+	case BudgetScopeScheduledTask:
+		filter.TaskID = value
+	case BudgetScopePipelineStep:
+		filter.PipelineStep = value
+	}
+	return filter
+}
+
+// budgetWindow returns the UTC start of the window containing now and when it resets.
+func budgetWindow(window string, now time.Time) (start, reset time.Time) {
+	now = now.UTC()
+	if window == BudgetWindowMonthly {
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+	start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 1)
+}
+
+func budgetWindowLabel(window string) string {
+	if window == BudgetWindowMonthly {
+		return "month"
+	}
+	return "day"
+}
+
+func budgetScopeLabel(scope string) string {
+	switch scope {
+	case BudgetScopeScheduledTask:
+		return "scheduled task"
+	case BudgetScopePipelineStep:
+		return "pipeline step"
+	default:
+		return scope
+	}
+}
+
+// budgetLimit is one soft or hard threshold crossed by usage.
+type budgetLimit struct {
+	used  string
+	limit string
+}
+
+// crossedLimit reports the first token or cost threshold reached by total.
+func crossedLimit(total types.LLMUsageTotal, tokens int64, cost float64) (budgetLimit, bool) {
+	if tokens > 0 && total.Tokens >= tokens {
+		return budgetLimit{used: fmt.Sprintf("%d tokens", total.Tokens), limit: fmt.Sprintf("%d tokens", tokens)}, true
+	}
+	if cost > 0 && total.Cost >= cost {
+		return budgetLimit{used: fmt.Sprintf("$%.2f", total.Cost), limit: fmt.Sprintf("$%.2f", cost)}, true
+	}
+	return budgetLimit{}, false
+}
+
+func sumBudgetUsage(ctx context.Context, match budgetMatch, since time.Time) (types.LLMUsageTotal, bool) {
+	usageStore := store.NewLLMUsageStoreFromDatabase()
+	if usageStore == nil {
+		return types.LLMUsageTotal{}, false
+	}
+	total, err := usageStore.SumLLMUsage(ctx, budgetUsageFilter(match.cfg.Scope, match.subject, since))
+	if err != nil {
+		flog.Warn("[chat-agent] budget %s %s=%s: %v", match.cfg.Name, match.cfg.Scope, match.subject, err)
+		return types.LLMUsageTotal{}, false
+	}
+	return total, true
+}
+
+// CheckBudgets returns a *BudgetExceededError when subject has reached a hard
+// limit in the current window. Usage lookups that fail are logged and allowed.
+func CheckBudgets(ctx context.Context, subject BudgetSubject) error {
+	now := time.Now()
+	for _, match := range budgetsFor(config.App.ChatAgent.Budgets, subject) {
+		if match.cfg.HardTokens == 0 && match.cfg.HardCost == 0 {
+			continue
+		}
+		start, reset := budgetWindow(match.cfg.Window, now)
+		total, ok := sumBudgetUsage(ctx, match, start)
+		if !ok {
+			continue
+		}
+		crossed, hit := crossedLimit(total, match.cfg.HardTokens, match.cfg.HardCost)
+		if !hit {
+			continue
+		}
+		err := &BudgetExceededError{
+			Budget:  match.cfg.Name,
+			Scope:   match.cfg.Scope,
+			Subject: match.subject,
+			Window:  match.cfg.Window,
+			Used:    crossed.used,
+			Limit:   crossed.limit,
+			ResetAt: reset,
+		}
+		sendBudgetAlert(ctx, subject.UID, match, start, reset, "hard", crossed)
+		return err
+	}
+	return nil
+}
+
+// alertBudgets sends one notify alert per budget, subject and window once
+// recorded usage reaches a soft limit.
+func alertBudgets(ctx context.Context, subject BudgetSubject) {
+	now := time.Now()
+	for _, match := range budgetsFor(config.App.ChatAgent.Budgets, subject) {
+		if match.cfg.SoftTokens == 0 && match.cfg.SoftCost == 0 {
+			continue
+		}
+		start, reset := budgetWindow(match.cfg.Window, now)
+		total, ok := sumBudgetUsage(ctx, match, start)
+		if !ok {
+			continue
+		}
+		if crossed, hit := crossedLimit(total, match.cfg.SoftTokens, match.cfg.SoftCost); hit {
+			sendBudgetAlert(ctx, subject.UID, match, start, reset, "soft", crossed)
+		}
+	}
+}
+
+// budgetAlertWG tracks fire-and-forget budget alerts so tests can drain
+// before swapping store.Database.
+var budgetAlertWG sync.WaitGroup
+
+// budgetAlertsSent dedupes alerts when no cache store is configured.
+var budgetAlertsSent sync.Map
+
+// WaitBudgetAlertsForTest blocks until pending budget alert goroutines finish.
+func WaitBudgetAlertsForTest() {
+	budgetAlertWG.Wait()
+}
+
+// claimBudgetAlert reports whether this is the first alert of level for the
+// budget, subject and window.
+func claimBudgetAlert(ctx context.Context, match budgetMatch, level string, start, reset time.Time) bool {
+	id := fmt.Sprintf("%s:%s:%s:%s", match.cfg.Name, match.subject, level, start.Format("2006-01-02"))
+	if cs := cache.DefaultStore(); cs != nil {
+		ok, err := cs.SetNX(ctx, cache.NewKey("chatagent", "budget:alert", id), "1", cache.TTL(time.Until(reset)))
+		if err == nil {
+			return ok
+		}
+		flog.Warn("[chat-agent] budget alert dedupe %s: %v", id, err)
+	}
+	_, loaded := budgetAlertsSent.LoadOrStore(id, struct{}{})
+	return !loaded
+}
+
+func sendBudgetAlert(ctx context.Context, uid string, match budgetMatch, start, reset time.Time, level string, crossed budgetLimit) {
+	if uid == "" || !claimBudgetAlert(ctx, match, level, start, reset) {
+		return
+	}
+	title := "LLM budget soft limit reached"
+	action := "runs continue"
+	if level == "hard" {
+		title = "LLM budget exhausted"
+		action = "new runs are blocked"
+	}
+	payload := map[string]any{
+		pkgnotify.PayloadKeySummary: fmt.Sprintf("Budget %s for %s %s: %s of %s this %s; %s until %s UTC",
+			match.cfg.Name, budgetScopeLabel(match.cfg.Scope), match.subject, crossed.used, crossed.limit,
+			budgetWindowLabel(match.cfg.Window), action, reset.Format("2006-01-02 15:04")),
+		pkgnotify.PayloadKeyTitle:         title,
+		pkgnotify.PayloadKeyCorrelationID: fmt.Sprintf("budget:%s:%s:%s", match.cfg.Name, match.subject, start.Format("2006-01-02")),
+		"budget":                          match.cfg.Name,
+		"scope":                           match.cfg.Scope,
+		"subject":                         match.subject,
+		"level":                           level,
+	}
+	channels := pkgnotify.DefaultInboxChannels(ctx)
+	budgetAlertWG.Go(func() {
+		sendCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := pkgnotify.GatewaySend(sendCtx, types.Uid(uid), pkgnotify.AgentBudgetTemplateID, channels, payload); err != nil {
+			if !pkgnotify.WarnSkipNoDefault(err, "budget alert") {
+				flog.Warn("[chat-agent] budget alert send: %v", err)
+			}
+		}
+	})
+}
+
+// usageCost estimates the USD cost of usage with chat_agent.model_pricing
+// overrides, falling back to catalog pricing.
+func usageCost(modelName string, usage msg.Usage) float64 {
+	if price, ok := config.App.ChatAgent.ModelPricing[modelName]; ok {
+		return model.Pricing{
+			Input:      price.Input,
+			Output:     price.Output,
+			CacheRead:  price.CacheRead,
+			CacheWrite: price.CacheWrite,
+		}.Cost(usage)
+	}
+	return model.PricingFor(modelName).Cost(usage)
+}
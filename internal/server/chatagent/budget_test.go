@@ -0,0 +1,192 @@
+package chatagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/agent"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func seedBudgetUsage(t *testing.T, records ...types.LLMUsageRecordInput) {
+	t.Helper()
+	usageStore := store.NewLLMUsageStoreFromDatabase()
+	require.NotNil(t, usageStore)
+	for i := range records {
+		require.NoError(t, usageStore.RecordLLMUsage(context.Background(), &records[i]))
+	}
+}
+
+func TestBudgetsForScopes(t *testing.T) {
+	cfgs := []config.ChatAgentBudgetConfig{
+		{Name: "per-user", Scope: BudgetScopeUser, HardTokens: 10},
+		{Name: "reviewer", Scope: BudgetScopeAgent, Match: []string{"reviewer"}, HardTokens: 10},
+		{Name: "tasks", Scope: BudgetScopeScheduledTask, HardTokens: 10},
+		{Name: "steps", Scope: BudgetScopePipelineStep, Match: []string{"digest/summarize"}, HardTokens: 10},
+	}
+	tests := []struct {
+		name    string
+		subject BudgetSubject
+		want    []string
+	}{
+		{name: "interactive chat", subject: BudgetSubject{UID: "u1", Agent: agentName}, want: []string{"per-user"}},
+		{name: "matched subagent", subject: BudgetSubject{UID: "u1", Agent: "reviewer"}, want: []string{"per-user", "reviewer"}},
+		{name: "scheduled task", subject: BudgetSubject{UID: "u1", Agent: agentName, TaskID: "t1"}, want: []string{"per-user", "tasks"}},
+		{name: "unmatched pipeline step", subject: BudgetSubject{Agent: agentName, PipelineStep: "digest/fetch"}, want: nil},
+		{name: "matched pipeline step", subject: BudgetSubject{Agent: agentName, PipelineStep: "digest/summarize"}, want: []string{"steps"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var names []string
+			for _, match := range budgetsFor(cfgs, tt.subject) {
+				names = append(names, match.cfg.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestBudgetWindow(t *testing.T) {
+	now := time.Date(2026, 12, 31, 18, 30, 0, 0, time.UTC)
+	tests := []struct {
+		window    string
+		wantStart time.Time
+		wantReset time.Time
+	}{
+		{window: "", wantStart: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), wantReset: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{window: BudgetWindowDaily, wantStart: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), wantReset: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{window: BudgetWindowMonthly, wantStart: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), wantReset: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			t.Parallel()
+			start, reset := budgetWindow(tt.window, now)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantReset, reset)
+		})
+	}
+}
+
+func TestCheckBudgetsHardLimits(t *testing.T) {
+	LockAppConfigForTest(t)
+	prev := config.App.ChatAgent
+	t.Cleanup(func() { config.App.ChatAgent = prev })
+	installSQLiteTestDatabase(t)
+
+	seedBudgetUsage(t,
+		types.LLMUsageRecordInput{UID: "u1", Model: "m", TotalTokens: 600, Agent: agentName, Cost: 1.5},
+		types.LLMUsageRecordInput{UID: "u1", Model: "m", TotalTokens: 500, Agent: "reviewer", Cost: 0.5},
+		types.LLMUsageRecordInput{UID: "u2", Model: "m", TotalTokens: 10, Agent: agentName},
+	)
+
+	tests := []struct {
+		name     string
+		budgets  []config.ChatAgentBudgetConfig
+		subject  BudgetSubject
+		wantText string
+	}{
+		{
+			name:     "user over token limit",
+			budgets:  []config.ChatAgentBudgetConfig{{Name: "daily-user", Scope: BudgetScopeUser, HardTokens: 1000}},
+			subject:  BudgetSubject{UID: "u1", Agent: agentName},
+			wantText: `LLM budget "daily-user" exhausted for user u1: used 1100 tokens of 1000 tokens this day.`,
+		},
+		{
+			name:    "other user under limit",
+			budgets: []config.ChatAgentBudgetConfig{{Name: "daily-user", Scope: BudgetScopeUser, HardTokens: 1000}},
+			subject: BudgetSubject{UID: "u2", Agent: agentName},
+		},
+		{
+			name:     "agent over cost limit",
+			budgets:  []config.ChatAgentBudgetConfig{{Name: "chat-cost", Scope: BudgetScopeAgent, Window: BudgetWindowMonthly, HardCost: 1}},
+			subject:  BudgetSubject{UID: "u2", Agent: agentName},
+			wantText: `LLM budget "chat-cost" exhausted for agent chat: used $1.50 of $1.00 this month.`,
+		},
+		{
+			name:    "soft only never blocks",
+			budgets: []config.ChatAgentBudgetConfig{{Name: "soft", Scope: BudgetScopeUser, SoftTokens: 10}},
+			subject: BudgetSubject{UID: "u1", Agent: agentName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.App.ChatAgent = config.ChatAgentConfig{Budgets: tt.budgets}
+			err := CheckBudgets(context.Background(), tt.subject)
+			if tt.wantText == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrBudgetExceeded))
+			assert.Contains(t, err.Error(), tt.wantText)
+		})
+	}
+}
+
+func TestRecordLLMUsageMessagesAttributesBudgetSubject(t *testing.T) {
+	LockAppConfigForTest(t)
+	prev := config.App.ChatAgent
+	t.Cleanup(func() { config.App.ChatAgent = prev })
+	installSQLiteTestDatabase(t)
+
+	config.App.ChatAgent = config.ChatAgentConfig{
+		ModelPricing: map[string]config.ChatAgentModelPricing{"priced-model": {Input: 2, Output: 10}},
+	}
+	ctx := WithBudgetSubject(context.Background(), BudgetSubject{Agent: "reviewer", TaskID: "digest"})
+	RecordLLMUsageMessages(ctx, "u1", "sess-budget", types.TokenUsageSourceScheduledTask, []agent.AgentMessage{
+		msg.AssistantMessage{
+			Model: "priced-model",
+			Usage: &msg.Usage{PromptTokens: 500_000, CompletionTokens: 100_000, TotalTokens: 600_000},
+		},
+	})
+
+	total, err := store.NewLLMUsageStoreFromDatabase().SumLLMUsage(context.Background(), types.LLMUsageFilter{
+		Agent: "reviewer", TaskID: "digest", Since: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(600_000), total.Tokens)
+	assert.InDelta(t, 2.0, total.Cost, 1e-9)
+}
+
+func TestClaimBudgetAlertOncePerWindow(t *testing.T) {
+	match := budgetMatch{cfg: config.ChatAgentBudgetConfig{Name: "claim-test"}, subject: "u1"}
+	start, reset := budgetWindow(BudgetWindowDaily, time.Now())
+	ctx := context.Background()
+
+	assert.True(t, claimBudgetAlert(ctx, match, "soft", start, reset))
+	assert.False(t, claimBudgetAlert(ctx, match, "soft", start, reset))
+	assert.True(t, claimBudgetAlert(ctx, match, "hard", start, reset))
+	assert.True(t, claimBudgetAlert(ctx, match, "soft", start.AddDate(0, 0, 1), reset.AddDate(0, 0, 1)))
+}
+
+func TestRunEphemeralBlockedByPipelineStepBudget(t *testing.T) {
+	LockAppConfigForTest(t)
+	prev := config.App.ChatAgent
+	t.Cleanup(func() { config.App.ChatAgent = prev })
+	t.Cleanup(DisableSessionTitleLLMForTest())
+	setupEphemeralRunTestDB(t)
+	setupEphemeralRunFakeModel(t, "unused")
+	config.App.ChatAgent.Budgets = []config.ChatAgentBudgetConfig{
+		{Name: "digest-step", Scope: BudgetScopePipelineStep, HardTokens: 100},
+	}
+	seedBudgetUsage(t, types.LLMUsageRecordInput{UID: "user-1", Model: "fake-model", TotalTokens: 150, PipelineStep: "digest/summarize"})
+
+	_, err := RunEphemeral(context.Background(), NewService(), EphemeralRunParams{
+		UID:          "user-1",
+		Prompt:       "summarize",
+		Kind:         RunKindPipeline,
+		PipelineStep: "digest/summarize",
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrBudgetExceeded))
+	assert.Contains(t, err.Error(), "pipeline step digest/summarize")
+}
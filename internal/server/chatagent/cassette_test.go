@@ -0,0 +1,79 @@
+package chatagent
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/internal/store/ent/schema"
+	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// useChatCassette serves the chat model from testdata/cassettes/<name>.json,
+// matched by request fingerprint, so the system prompt, tool set and history
+// the agent sends are pinned. With FLOWBOT_LLM_CASSETTE=record the scripted
+// responses answer instead and the cassette is rewritten.
+func useChatCassette(t *testing.T, name string, scripts ...agentllm.ResponseScript) *agentllm.CassetteModel {
+	t.Helper()
+	ws := t.TempDir()
+	config.App.ChatAgent = config.ChatAgentConfig{Workspace: ws, ChatModel: "fake-model"}
+	config.App.Models = []config.Model{
+		{Provider: agentllm.ProviderOpenAI, ApiKey: "test", ModelNames: []string{"fake-model"}},
+	}
+	mode := agentllm.CassetteModeFromEnv()
+	var inner llms.Model
+	if mode == agentllm.CassetteRecord {
+		inner = agentllm.NewFakeModel(scripts...)
+	}
+	model, err := agentllm.NewCassetteModel(inner, filepath.Join("testdata", "cassettes", name+".json"), agentllm.CassetteOptions{
+		Mode: mode,
+		Replacements: []agentllm.CassetteReplacement{
+			{Value: ws, Placeholder: "$WORKSPACE"},
+			{Value: "Current date: " + time.Now().UTC().Format("2006-01-02"), Placeholder: "Current date: $DATE"},
+		},
+	})
+	require.NoError(t, err)
+	origNewModel := NewModelForTest
+	NewModelForTest = func(_ context.Context, _ string) (llms.Model, string, error) {
+		return model, "fake-model", nil
+	}
+	t.Cleanup(func() {
+		WaitForSessionTitleGenerationForTest()
+		ResetSessionTitleGenerationForTest()
+		NewModelForTest = origNewModel
+	})
+	return model
+}
+
+func TestScheduledTaskRunMatchesCassette(t *testing.T) {
+	LockAppConfigForTest(t)
+	t.Cleanup(DisableSessionTitleLLMForTest())
+	setupEphemeralRunTestDB(t)
+	cassette := useChatCassette(t, "scheduled_task_run", agentllm.ResponseScript{Content: "Inbox checked: nothing new."})
+
+	task := &gen.ChatScheduledTask{
+		Flag:         "task-cassette",
+		UID:          "user:alice",
+		Name:         "inbox",
+		ScheduleKind: string(schema.ChatScheduledTaskKindOnce),
+		Prompt:       "check inbox",
+		State:        string(schema.ChatScheduledTaskStateActive),
+	}
+	require.NoError(t, store.ChatStoreFromDB().CreateChatScheduledTask(context.Background(), task))
+	ExecuteScheduledTaskForTest(context.Background(), task)
+	WaitForSessionTitleGenerationForTest()
+
+	runs, err := store.ChatStoreFromDB().ListChatScheduledTaskRuns(context.Background(), "task-cassette", 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, string(schema.ChatScheduledTaskRunStateCompleted), runs[0].State, runs[0].Error)
+	assert.Equal(t, "Inbox checked: nothing new.", runs[0].Reply)
+	require.NoError(t, cassette.Verify())
+}
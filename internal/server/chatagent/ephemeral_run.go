@@ -18,6 +18,8 @@ type EphemeralRunParams struct {
 	Tools       []string
 	Skills      []string
 	MemoryScope string
+	// PipelineStep attributes usage to a pipeline agent_run step for LLM budgets.
+	PipelineStep string
 }
 
 // EphemeralRunResult holds the outcome of one ephemeral run.
@@ -79,6 +81,9 @@ func RunEphemeral(ctx context.Context, svc *Service, params EphemeralRunParams)
 		flog.Info("[pipeline-agent] autonomous prompt start session=%s prompt_len=%d timeout=%s",
 			sessionID, len(strings.TrimSpace(params.Prompt)), RunTimeout())
 	}
+	if params.PipelineStep != "" {
+		ctx = WithBudgetSubject(ctx, BudgetSubject{PipelineStep: params.PipelineStep})
+	}
 	promptStart := time.Now()
 	reply, err := RunAutonomousPrompt(ctx, svc, sessionID, params.Prompt, params.Kind, params.Tools, params.Skills, params.MemoryScope)
 	if params.Kind == RunKindPipeline {
@@ -0,0 +1,79 @@
+package chatagent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/agent/hooks"
+	"github.com/flowline-io/flowbot/pkg/agent/tools/coding"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/flog"
+)
+
+// registerExternalHooks subscribes chat_agent.hooks entries scoped to deps.Agent.
+// Command hooks run in the workspace through the sandbox when it is enabled.
+func registerExternalHooks(reg *hooks.Registry, deps ChatHookDeps) {
+	specs := externalHooksFor(config.App.ChatAgent.Hooks, hookAgentName(deps))
+	if len(specs) == 0 {
+		return
+	}
+	execEnv := executionEnvForWorkspace(coding.Workspace{Root: deps.WorkspaceRoot})
+	hooks.RegisterExternal(reg, specs, hooks.ExternalDeps{
+		Agent:     hookAgentName(deps),
+		SessionID: deps.SessionID,
+		Exec:      execEnv,
+		Dir:       deps.WorkspaceRoot,
+		Warn: func(format string, args ...any) {
+			flog.Warn("[chat-agent] session=%s "+format, append([]any{deps.SessionID}, args...)...)
+		},
+	})
+}
+
+func hookAgentName(deps ChatHookDeps) string {
+	if deps.Agent == "" {
+		return agentName
+	}
+	return deps.Agent
+}
+
+// externalHooksFor converts hook config entries that apply to agent.
+// Empty Agents means the main chat agent only; "*" matches every agent.
+func externalHooksFor(cfgs []config.ChatAgentHookConfig, agent string) []hooks.ExternalHook {
+	var out []hooks.ExternalHook
+	for _, cfg := range cfgs {
+		agents := cfg.Agents
+		if len(agents) == 0 {
+			agents = []string{agentName}
+		}
+		if !slices.Contains(agents, agent) && !slices.Contains(agents, "*") {
+			continue
+		}
+		out = append(out, hooks.ExternalHook{
+			Name:       cfg.Name,
+			Events:     cfg.Events,
+			Tools:      cfg.Tools,
+			Command:    strings.TrimSpace(cfg.Command),
+			URL:        strings.TrimSpace(cfg.URL),
+			Headers:    cfg.Headers,
+			Timeout:    cfg.Timeout,
+			FailClosed: cfg.OnError == "block",
+		})
+	}
+	return out
+}
+
+// hooksConfigHash digests chat_agent.hooks so pooled harnesses rebuild when hooks change.
+func hooksConfigHash() string {
+	if len(config.App.ChatAgent.Hooks) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(config.App.ChatAgent.Hooks)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return "hooks=" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,119 @@
+package chatagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/agent/dcg"
+	"github.com/flowline-io/flowbot/pkg/agent/hooks"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/permission"
+	"github.com/flowline-io/flowbot/pkg/config"
+)
+
+// recordingChecker allows every command and remembers the last one checked.
+type recordingChecker struct{ command string }
+
+func (c *recordingChecker) Check(_ context.Context, command string) (dcg.Decision, error) {
+	c.command = command
+	return dcg.Decision{Allow: true}, nil
+}
+
+func TestExternalHooksForScopesAgents(t *testing.T) {
+	cfgs := []config.ChatAgentHookConfig{
+		{Name: "chat-only", Events: []string{"tool_call"}, Command: "a.sh"},
+		{Name: "reviewer", Events: []string{"tool_call"}, Command: "b.sh", Agents: []string{"reviewer"}},
+		{Name: "all", Events: []string{"tool_call"}, URL: "https://hooks.example.com", Agents: []string{"*"}, OnError: "block"},
+	}
+	tests := []struct {
+		agent string
+		want  []string
+	}{
+		{agent: agentName, want: []string{"chat-only", "all"}},
+		{agent: "reviewer", want: []string{"reviewer", "all"}},
+		{agent: "explorer", want: []string{"all"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.agent, func(t *testing.T) {
+			t.Parallel()
+			specs := externalHooksFor(cfgs, tt.agent)
+			names := make([]string, 0, len(specs))
+			for _, spec := range specs {
+				names = append(names, spec.Name)
+			}
+			assert.Equal(t, tt.want, names)
+			assert.True(t, specs[len(specs)-1].FailClosed)
+		})
+	}
+}
+
+func TestRegisterHooksExternalCommand(t *testing.T) {
+	LockAppConfigForTest(t)
+	prev := config.App.ChatAgent
+	t.Cleanup(func() { config.App.ChatAgent = prev })
+
+	tests := []struct {
+		name        string
+		command     string
+		wantBlock   bool
+		wantReason  string
+		wantChecked string
+	}{
+		{
+			name:       "exit two blocks the call",
+			command:    "echo 'deploys are frozen' >&2; exit 2",
+			wantBlock:  true,
+			wantReason: "deploys are frozen",
+		},
+		{
+			name:        "rewritten args reach dcg",
+			command:     `echo '{"args":{"command":"ls -la"}}'`,
+			wantChecked: "ls -la",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.App.ChatAgent = config.ChatAgentConfig{Hooks: []config.ChatAgentHookConfig{{
+				Name: "policy", Events: []string{"tool_call"}, Tools: []string{permission.ToolRunTerminal}, Command: tt.command,
+			}}}
+			checker := &recordingChecker{}
+			reg := hooks.NewRegistry()
+			RegisterHooks(reg, ChatHookDeps{
+				SessionID:     "external-hook-test",
+				DCG:           checker,
+				Service:       NewService(),
+				WorkspaceRoot: t.TempDir(),
+			})
+			result, err := reg.EmitToolCall(context.Background(), hooks.ToolCallEvent{
+				ToolCall: msg.ToolCallPart{Name: permission.ToolRunTerminal},
+				Args:     map[string]any{"command": "ls"},
+			})
+			require.NoError(t, err)
+			if tt.wantBlock {
+				require.NotNil(t, result)
+				assert.True(t, result.Block)
+				assert.Equal(t, tt.wantReason, result.Reason)
+				assert.Empty(t, checker.command, "blocked calls never reach dcg")
+				return
+			}
+			assert.Equal(t, tt.wantChecked, checker.command)
+		})
+	}
+}
+
+func TestHooksConfigHashChangesWithHooks(t *testing.T) {
+	LockAppConfigForTest(t)
+	prev := config.App.ChatAgent
+	t.Cleanup(func() { config.App.ChatAgent = prev })
+
+	config.App.ChatAgent = config.ChatAgentConfig{}
+	assert.Empty(t, hooksConfigHash())
+	config.App.ChatAgent.Hooks = []config.ChatAgentHookConfig{{Name: "a", Events: []string{"tool_call"}, Command: "a.sh"}}
+	first := hooksConfigHash()
+	assert.NotEmpty(t, first)
+	config.App.ChatAgent.Hooks[0].Command = "b.sh"
+	assert.NotEqual(t, first, hooksConfigHash())
+}
@@ -239,6 +239,8 @@ func harnessConfigHash(workspace coding.Workspace) (string, error) {
 			agentsandbox.ResolvedCLIBinary(sandbox.CLIPath),
 		),
 		promptConfigHash(workspace.Root),
+		mcpConfigHash(),
+		hooksConfigHash(),
 	}
 	sum := sha256.Sum256([]byte(strings.Join(parts, "\x1f")))
 	return hex.EncodeToString(sum[:]), nil
@@ -329,6 +331,7 @@ func (s *Service) buildRunHarness(ctx context.Context, req RunRequest, textLen i
 		UID:           uid,
 		SessionMode:   LoadSessionMode(ctx, req.SessionID),
 		Kind:          kind,
+		Agent:         agentName,
 		WorkspaceRoot: workspace.Root,
 		Service:       s,
 		Publisher:     publisher,
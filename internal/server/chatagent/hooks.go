@@ -26,6 +26,8 @@ type ChatHookDeps struct {
 	UID         types.Uid
 	SessionMode string
 	Kind        RunKind
+	// Agent is "chat" for the main assistant or the subagent name; it scopes chat_agent.hooks. Empty means chat.
+	Agent string
 	// WorkspaceRoot is the absolute effective workspace for this run (session subdirectory or config root).
 	WorkspaceRoot string
 	// Service owns hot-path session state (permission sessions). Required for permission grants.
@@ -61,6 +63,8 @@ func RegisterHooks(reg *hooks.Registry, deps ChatHookDeps) {
 	}
 
 	registerLoopDetectHooks(reg, deps)
+	// External hooks run before DCG and permissions so rewritten arguments are still checked.
+	registerExternalHooks(reg, deps)
 	registerDCGHook(reg, deps)
 	registerPermissionHook(reg, deps)
 	registerPathSensors(reg, deps.WorkspaceRoot)
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/bytedance/sonic"
 
 	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
 	"github.com/flowline-io/flowbot/pkg/agent/tool"
 	"github.com/flowline-io/flowbot/pkg/agent/tools/coding"
@@ -30,7 +33,7 @@ func (SearchKnowledgeTool) Name() string { return searchKnowledgeToolName }
 
 // Description explains the tool to the model.
 func (SearchKnowledgeTool) Description() string {
-	return "Search the knowledge base for markdown documents; returns path, title, tags, and summary (not full content)"
+	return "Search the knowledge base for markdown documents by keyword and, when embeddings are configured, by meaning; returns path, title, tags, and summary (not full content)"
 }
 
 // Parameters returns the JSON schema for tool arguments.
@@ -68,16 +71,25 @@ func (SearchKnowledgeTool) Execute(ctx context.Context, id string, args map[stri
 	if query == "" && prefix == "" {
 		return knowledgeToolError(searchKnowledgeToolName, id, "query or path_prefix is required"), nil
 	}
-	limit := knowledgeIntArg(args, "limit")
-	rows, err := store.AgentStoreFromDB().SearchAgentKnowledge(ctx, store.AgentKnowledgeSearchParams{
+	tag := knowledgeStringArg(args, "tag")
+	limit := normalizeHybridLimit(knowledgeIntArg(args, "limit"))
+	agentStore := store.AgentStoreFromDB()
+	rows, err := agentStore.SearchAgentKnowledge(ctx, store.AgentKnowledgeSearchParams{
 		Query:      query,
 		PathPrefix: prefix,
-		Tag:        knowledgeStringArg(args, "tag"),
+		Tag:        tag,
 		Limit:      limit,
 	})
 	if err != nil {
 		return knowledgeToolError(searchKnowledgeToolName, id, err.Error()), nil
 	}
+	rows = hybridRank(ctx, embed.KindKnowledge, "", query, limit, rows,
+		func(row *gen.AgentKnowledge) int64 { return row.ID },
+		agentStore.ListAgentKnowledgeByIDs,
+		func(row *gen.AgentKnowledge) bool {
+			return strings.HasPrefix(row.Path, prefix) && (tag == "" || slices.Contains(row.Tags, tag))
+		},
+	)
 	type hit struct {
 		Path    string   `json:"path"`
 		Title   string   `json:"title"`
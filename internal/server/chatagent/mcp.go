@@ -0,0 +1,124 @@
+package chatagent
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/flowline-io/flowbot/pkg/agent/tool"
+	"github.com/flowline-io/flowbot/pkg/agent/tools/coding"
+	"github.com/flowline-io/flowbot/pkg/agent/tools/mcptools"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+	"github.com/flowline-io/flowbot/version"
+)
+
+var (
+	mcpManagerMu sync.RWMutex
+	mcpManager   *mcptools.Manager
+)
+
+// StartMCP connects the MCP servers in chat_agent.mcp_servers in the
+// background. Tools appear in new runs once their server is ready.
+func StartMCP() {
+	servers := config.App.ChatAgent.MCPServers
+	if len(servers) == 0 {
+		return
+	}
+	maxOutput := config.App.ChatAgent.MaxToolOutput
+	if maxOutput <= 0 {
+		maxOutput = coding.DefaultMaxOutput
+	}
+	m := mcptools.NewManager(servers, mcptools.Options{
+		Info:            &sdk.Implementation{Name: "flowbot", Version: version.Buildtags},
+		DefaultAgent:    agentName,
+		RefreshInterval: config.App.ChatAgent.MCPRefreshInterval,
+		MaxOutput:       maxOutput,
+	})
+	SetMCPManager(m)
+	go m.Start(context.Background())
+	flog.Info("[chat-agent] mcp manager started servers=%d", len(servers))
+}
+
+// StopMCP closes MCP server connections.
+func StopMCP() {
+	mcpManagerMu.Lock()
+	m := mcpManager
+	mcpManager = nil
+	mcpManagerMu.Unlock()
+	if m != nil {
+		m.Stop()
+	}
+}
+
+// SetMCPManager installs m as the process-wide MCP manager. Tests pass nil to
+// clear it.
+func SetMCPManager(m *mcptools.Manager) {
+	mcpManagerMu.Lock()
+	defer mcpManagerMu.Unlock()
+	mcpManager = m
+}
+
+func currentMCPManager() *mcptools.Manager {
+	mcpManagerMu.RLock()
+	defer mcpManagerMu.RUnlock()
+	return mcpManager
+}
+
+// registerMCPTools adds the MCP tools available to agent.
+func registerMCPTools(registry *tool.Registry, agent string) error {
+	m := currentMCPManager()
+	if m == nil {
+		return nil
+	}
+	for _, t := range m.Tools(agent) {
+		if err := registry.Register(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MCPToolNames returns the MCP tool names currently available to agent.
+func MCPToolNames(agent string) []string {
+	m := currentMCPManager()
+	if m == nil {
+		return nil
+	}
+	return m.ToolNames(agent)
+}
+
+// mcpConfigHash changes when the MCP tools for the chat agent change so
+// pooled harnesses rebuild their registry.
+func mcpConfigHash() string {
+	m := currentMCPManager()
+	if m == nil {
+		return ""
+	}
+	return m.Fingerprint(agentName)
+}
+
+// MCPServerStatuses reports the health of each configured MCP server.
+func MCPServerStatuses() []model.AgentMCPServer {
+	m := currentMCPManager()
+	if m == nil {
+		return nil
+	}
+	statuses := m.Statuses()
+	out := make([]model.AgentMCPServer, 0, len(statuses))
+	for _, st := range statuses {
+		out = append(out, model.AgentMCPServer{
+			Name:        st.Name,
+			Transport:   st.Transport,
+			Agents:      st.Agents,
+			State:       string(st.State),
+			Error:       st.Error,
+			Tools:       st.Tools,
+			Dropped:     st.Dropped,
+			RefreshedAt: st.RefreshedAt,
+		})
+	}
+	return out
+}
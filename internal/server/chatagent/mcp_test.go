@@ -0,0 +1,74 @@
+package chatagent
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/agent/tools/mcptools"
+	"github.com/flowline-io/flowbot/pkg/config"
+)
+
+func installTestMCPManager(t *testing.T, servers []config.ChatAgentMCPServerConfig) *mcptools.Manager {
+	t.Helper()
+	srv := sdk.NewServer(&sdk.Implementation{Name: "fake"}, nil)
+	srv.AddTool(&sdk.Tool{Name: "kanboard_list_tasks", Description: "List tasks", InputSchema: map[string]any{"type": "object"}},
+		func(context.Context, *sdk.CallToolRequest) (*sdk.CallToolResult, error) {
+			return &sdk.CallToolResult{Content: []sdk.Content{&sdk.TextContent{Text: "ok"}}}, nil
+		})
+	m := mcptools.NewManager(servers, mcptools.Options{
+		DefaultAgent: agentName,
+		Dial: func(config.ChatAgentMCPServerConfig) (sdk.Transport, error) {
+			clientT, serverT := sdk.NewInMemoryTransports()
+			if _, err := srv.Connect(context.Background(), serverT, nil); err != nil {
+				return nil, err
+			}
+			return clientT, nil
+		},
+	})
+	m.RefreshAll(context.Background())
+	SetMCPManager(m)
+	t.Cleanup(func() {
+		SetMCPManager(nil)
+		m.Stop()
+	})
+	return m
+}
+
+func TestNewRegistryRegistersMCPTools(t *testing.T) {
+	LockAppConfigForTest(t)
+	config.App.ChatAgent = config.ChatAgentConfig{ChatModel: "gpt-test", Workspace: t.TempDir()}
+	ws, err := WorkspaceFromConfig()
+	require.NoError(t, err)
+
+	before := mcpConfigHash()
+	installTestMCPManager(t, []config.ChatAgentMCPServerConfig{
+		{Name: "kb", Command: "kb-mcp"},
+		{Name: "review", Command: "review-mcp", Agents: []string{"reviewer"}},
+	})
+	assert.NotEqual(t, before, mcpConfigHash(), "pooled harnesses rebuild when MCP tools change")
+
+	reg, err := NewRegistry(ws, nil, nil)
+	require.NoError(t, err)
+	_, ok := reg.Get("mcp__kb__kanboard_list_tasks")
+	assert.True(t, ok)
+	_, ok = reg.Get("mcp__review__kanboard_list_tasks")
+	assert.False(t, ok, "servers scoped to other agents are not registered for chat")
+	assert.Contains(t, ActiveToolNames(), "mcp__kb__kanboard_list_tasks")
+	assert.Equal(t, ToolGroupMCP, ToolGroupOf("mcp__kb__kanboard_list_tasks"))
+
+	sub, err := NewSubagentRegistry(ws, "reviewer", nil)
+	require.NoError(t, err)
+	_, ok = sub.Get("mcp__review__kanboard_list_tasks")
+	assert.True(t, ok)
+	_, ok = sub.Get("mcp__kb__kanboard_list_tasks")
+	assert.False(t, ok)
+
+	statuses := MCPServerStatuses()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "ready", statuses[0].State)
+	assert.Equal(t, []string{"chat"}, statuses[0].Agents)
+}
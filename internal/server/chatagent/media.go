@@ -202,7 +202,7 @@ func RejectUnsupportedModalities(modelName string, parts []msg.ContentPart) erro
 		if !ok {
 			continue
 		}
-		if model.SupportsModality(modelName, mp.Kind) {
+		if model.SupportsModality(config.CatalogModelName(modelName), mp.Kind) {
 			continue
 		}
 		return types.Errorf(types.ErrInvalidArgument,
@@ -302,7 +302,7 @@ func saveSessionMediaBinding(ctx context.Context, binding sessionMediaBinding) e
 		return fmt.Errorf("marshal media binding: %w", err)
 	}
 	key := sessionMediaCacheKey(binding.Session, binding.FileID)
-	if rs := cache.DefaultRedisStore(); rs != nil {
+	if rs := cache.DefaultStore(); rs != nil {
 		return rs.Set(ctx, key, payload, cache.TTLNone)
 	}
 	memoryMediaBindings.Store(key.String(), payload)
@@ -312,7 +312,7 @@ func saveSessionMediaBinding(ctx context.Context, binding sessionMediaBinding) e
 func loadSessionMediaBinding(ctx context.Context, sessionID, fileID string) (sessionMediaBinding, error) {
 	key := sessionMediaCacheKey(sessionID, fileID)
 	var raw string
-	if rs := cache.DefaultRedisStore(); rs != nil {
+	if rs := cache.DefaultStore(); rs != nil {
 		val, ok, err := rs.Get(ctx, key)
 		if err != nil {
 			return sessionMediaBinding{}, err
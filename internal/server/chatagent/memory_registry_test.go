@@ -101,7 +101,7 @@ func TestNewSubagentRegistryRegistersMemory(t *testing.T) {
 
 	ws, err := WorkspaceFromConfig()
 	require.NoError(t, err)
-	reg, err := NewSubagentRegistry(ws, nil)
+	reg, err := NewSubagentRegistry(ws, "", nil)
 	require.NoError(t, err)
 	_, ok := reg.Get(memorySetToolName)
 	assert.True(t, ok)
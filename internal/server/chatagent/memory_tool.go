@@ -10,6 +10,8 @@ import (
 	"github.com/bytedance/sonic"
 
 	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
 	"github.com/flowline-io/flowbot/pkg/agent/tool"
 	"github.com/flowline-io/flowbot/pkg/types"
@@ -197,6 +199,7 @@ func (MemorySetTool) Execute(ctx context.Context, id string, args map[string]any
 	if err != nil {
 		return memoryToolError(memorySetToolName, id, err.Error()), nil
 	}
+	embed.Kick()
 	return memoryToolText(memorySetToolName, id, fmt.Sprintf("saved %s/%s (pinned=%t)", scope, row.Key, row.Pinned)), nil
 }
 
@@ -260,33 +263,52 @@ func (MemoryListTool) Name() string { return memoryListToolName }
 
 // Description explains the tool to the model.
 func (MemoryListTool) Description() string {
-	return "List memory fact keys (and pin flags) in the current memory scope"
+	return "List memory fact keys (and pin flags) in the current memory scope; with query, return the best matching facts with their values"
 }
 
 // Parameters returns the JSON schema for tool arguments.
 func (MemoryListTool) Parameters() map[string]any {
 	return map[string]any{
-		"type":       "object",
-		"properties": map[string]any{},
+		"type": "object",
+		"properties": map[string]any{
+			"query": map[string]any{
+				"type":        "string",
+				"description": "Optional query matched against fact keys and values, by keyword and, when embeddings are configured, by meaning",
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": "Max results when query is set (default 10, max 50)",
+			},
+		},
 	}
 }
 
-// Execute lists memory facts.
-func (MemoryListTool) Execute(ctx context.Context, id string, _ map[string]any, _ tool.UpdateHandler) (msg.ToolResultMessage, error) {
+// Execute lists memory facts, ranked by relevance when a query is given.
+func (MemoryListTool) Execute(ctx context.Context, id string, args map[string]any, _ tool.UpdateHandler) (msg.ToolResultMessage, error) {
 	if store.Database == nil {
 		return memoryToolError(memoryListToolName, id, "memory store is not configured"), nil
 	}
-	rows, err := store.AgentStoreFromDB().ListAgentMemoryFacts(ctx, resolveToolMemoryScope(ctx))
+	scope := resolveToolMemoryScope(ctx)
+	rows, err := store.AgentStoreFromDB().ListAgentMemoryFacts(ctx, scope)
 	if err != nil {
 		return memoryToolError(memoryListToolName, id, err.Error()), nil
 	}
+	query := memoryStringArg(args, "query")
+	if query != "" {
+		rows = rankMemoryFacts(ctx, scope, rows, query, normalizeHybridLimit(memoryIntArg(args, "limit")))
+	}
 	type item struct {
 		Key    string `json:"key"`
 		Pinned bool   `json:"pinned"`
+		Value  string `json:"value,omitempty"`
 	}
 	items := make([]item, 0, len(rows))
 	for _, row := range rows {
-		items = append(items, item{Key: row.Key, Pinned: row.Pinned})
+		it := item{Key: row.Key, Pinned: row.Pinned}
+		if query != "" {
+			it.Value = row.Value
+		}
+		items = append(items, it)
 	}
 	if len(items) == 0 {
 		return memoryToolText(memoryListToolName, id, "[]"), nil
@@ -298,6 +320,37 @@ func (MemoryListTool) Execute(ctx context.Context, id string, _ map[string]any,
 	return memoryToolText(memoryListToolName, id, payload), nil
 }
 
+// rankMemoryFacts keeps the facts of scope whose key or value contains
+// query, fused with semantic matches, best first.
+func rankMemoryFacts(ctx context.Context, scope string, rows []*gen.AgentMemoryFact, query string, limit int) []*gen.AgentMemoryFact {
+	q := strings.ToLower(query)
+	byID := make(map[int64]*gen.AgentMemoryFact, len(rows))
+	var keyword []*gen.AgentMemoryFact
+	for _, row := range rows {
+		byID[row.ID] = row
+		if strings.Contains(strings.ToLower(row.Key), q) || strings.Contains(strings.ToLower(row.Value), q) {
+			keyword = append(keyword, row)
+		}
+	}
+	ranked := hybridRank(ctx, embed.KindMemoryFact, scope, query, limit, keyword,
+		func(row *gen.AgentMemoryFact) int64 { return row.ID },
+		func(_ context.Context, ids []int64) ([]*gen.AgentMemoryFact, error) {
+			out := make([]*gen.AgentMemoryFact, 0, len(ids))
+			for _, refID := range ids {
+				if row, ok := byID[refID]; ok {
+					out = append(out, row)
+				}
+			}
+			return out, nil
+		},
+		nil,
+	)
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
 // MemoryDeleteTool deletes one keyed fact.
 type MemoryDeleteTool struct{}
 
@@ -339,6 +392,7 @@ func (MemoryDeleteTool) Execute(ctx context.Context, id string, args map[string]
 		}
 		return memoryToolError(memoryDeleteToolName, id, err.Error()), nil
 	}
+	embed.Kick()
 	return memoryToolText(memoryDeleteToolName, id, fmt.Sprintf("deleted %s/%s", scope, key)), nil
 }
 
@@ -350,7 +404,7 @@ func (SearchSessionSummariesTool) Name() string { return searchSessionSummariesT
 
 // Description explains the tool to the model.
 func (SearchSessionSummariesTool) Description() string {
-	return "Search archived chat session summaries by keyword (title/summary substring match)"
+	return "Search archived chat session summaries by keyword (title/summary substring match) and, when embeddings are configured, by meaning"
 }
 
 // Parameters returns the JSON schema for tool arguments.
@@ -380,14 +434,22 @@ func (SearchSessionSummariesTool) Execute(ctx context.Context, id string, args m
 	if query == "" {
 		return memoryToolError(searchSessionSummariesToolName, id, "query is required"), nil
 	}
-	rows, err := store.AgentStoreFromDB().SearchAgentSessionSummaries(ctx, store.AgentSessionSummarySearchParams{
+	scope := resolveToolMemoryScope(ctx)
+	limit := normalizeHybridLimit(memoryIntArg(args, "limit"))
+	agentStore := store.AgentStoreFromDB()
+	rows, err := agentStore.SearchAgentSessionSummaries(ctx, store.AgentSessionSummarySearchParams{
 		Query: query,
-		Scope: resolveToolMemoryScope(ctx),
-		Limit: memoryIntArg(args, "limit"),
+		Scope: scope,
+		Limit: limit,
 	})
 	if err != nil {
 		return memoryToolError(searchSessionSummariesToolName, id, err.Error()), nil
 	}
+	rows = hybridRank(ctx, embed.KindSessionSummary, scope, query, limit, rows,
+		func(row *gen.AgentSessionSummary) int64 { return row.ID },
+		agentStore.ListReadyAgentSessionSummariesByIDs,
+		func(row *gen.AgentSessionSummary) bool { return row.Scope == scope },
+	)
 	type hit struct {
 		SessionFlag string `json:"session_flag"`
 		Title       string `json:"title"`
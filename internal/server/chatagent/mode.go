@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/flowline-io/flowbot/internal/server/chatagent/tools/clip"
+	agentsearch "github.com/flowline-io/flowbot/internal/server/chatagent/tools/search"
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/internal/store/ent/schema"
 	"github.com/flowline-io/flowbot/pkg/flog"
@@ -22,7 +23,7 @@ const (
 // IsReadOnlyTool reports whether name is allowed in plan mode.
 func IsReadOnlyTool(name string) bool {
 	switch name {
-	case "read_file", "web_search", "web_fetch", "read_skill", "list_dir", "glob_files", "grep_files", listScheduleToolName, listTodosToolName, clip.GetToolName, searchKnowledgeToolName, getKnowledgeToolName:
+	case "read_file", "web_search", "web_fetch", "read_skill", "list_dir", "glob_files", "grep_files", listScheduleToolName, listTodosToolName, clip.GetToolName, searchKnowledgeToolName, getKnowledgeToolName, agentsearch.ToolName:
 		return true
 	case memoryGetToolName, memoryListToolName, searchSessionSummariesToolName:
 		return true
@@ -40,7 +41,7 @@ func ReadOnlyToolNames() []string {
 		"web_search", "web_fetch", "read_skill", listScheduleToolName, listTodosToolName, todoWriteToolName,
 		memoryGetToolName, memoryListToolName, searchSessionSummariesToolName,
 		clip.GetToolName,
-		searchKnowledgeToolName, getKnowledgeToolName,
+		searchKnowledgeToolName, getKnowledgeToolName, agentsearch.ToolName,
 	}
 }
 
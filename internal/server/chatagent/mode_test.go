@@ -28,6 +28,7 @@ func TestIsReadOnlyTool(t *testing.T) {
 		{name: "get_clip allowed", tool: "get_clip", want: true},
 		{name: "search_knowledge allowed", tool: "search_knowledge", want: true},
 		{name: "get_knowledge allowed", tool: "get_knowledge", want: true},
+		{name: "unified_search allowed", tool: "unified_search", want: true},
 		{name: "memory_get allowed", tool: "memory_get", want: true},
 		{name: "memory_list allowed", tool: "memory_list", want: true},
 		{name: "search_session_summaries allowed", tool: "search_session_summaries", want: true},
@@ -60,7 +61,7 @@ func TestReadOnlyToolNames(t *testing.T) {
 				"web_search", "web_fetch", "read_skill", "list_scheduled_tasks", "list_todos", "todo_write",
 				"memory_get", "memory_list", "search_session_summaries",
 				"get_clip",
-				"search_knowledge", "get_knowledge",
+				"search_knowledge", "get_knowledge", "unified_search",
 			},
 		},
 	}
@@ -29,12 +29,13 @@ func RunPipelineAgent(ctx context.Context, params abilityagent.RunParams) (*abil
 		return nil, fmt.Errorf("chatagent: shared service not bound; call BindSharedService at bootstrap")
 	}
 	out, err := RunEphemeral(ctx, svc, EphemeralRunParams{
-		UID:         params.UID,
-		Prompt:      prompt,
-		Kind:        RunKindPipeline,
-		Tools:       params.Tools,
-		Skills:      params.Skills,
-		MemoryScope: params.MemoryScope,
+		UID:          params.UID,
+		Prompt:       prompt,
+		Kind:         RunKindPipeline,
+		Tools:        params.Tools,
+		Skills:       params.Skills,
+		MemoryScope:  params.MemoryScope,
+		PipelineStep: params.PipelineStep,
 	})
 	duration := time.Since(start).Round(time.Millisecond)
 	if err != nil {
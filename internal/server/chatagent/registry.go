@@ -4,6 +4,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/server/chatagent/tools/clip"
 	agentgw "github.com/flowline-io/flowbot/internal/server/chatagent/tools/gateway"
 	agentnotify "github.com/flowline-io/flowbot/internal/server/chatagent/tools/notify"
+	agentsearch "github.com/flowline-io/flowbot/internal/server/chatagent/tools/search"
 	"github.com/flowline-io/flowbot/pkg/agent/env"
 	"github.com/flowline-io/flowbot/pkg/agent/sandbox"
 	"github.com/flowline-io/flowbot/pkg/agent/tool"
@@ -32,6 +33,9 @@ func NewRegistry(ws coding.Workspace, taskDeps *TaskToolDeps, scheduleDeps *Sche
 	if err := agentgw.Register(registry, string(uid)); err != nil {
 		return nil, err
 	}
+	if err := agentsearch.Register(registry, uid); err != nil {
+		return nil, err
+	}
 	if err := registry.Register(ReadSkillTool{}); err != nil {
 		return nil, err
 	}
@@ -59,12 +63,16 @@ func NewRegistry(ws coding.Workspace, taskDeps *TaskToolDeps, scheduleDeps *Sche
 	if err := RegisterMemoryTools(registry); err != nil {
 		return nil, err
 	}
+	if err := registerMCPTools(registry, agentName); err != nil {
+		return nil, err
+	}
 	registry.SetActive(ActiveToolNames())
 	return registry, nil
 }
 
-// NewSubagentRegistry registers coding tools and an optional allowlisted read_skill tool for subagent runs.
-func NewSubagentRegistry(ws coding.Workspace, skillAllowlist []string) (*tool.Registry, error) {
+// NewSubagentRegistry registers coding tools, an optional allowlisted read_skill tool,
+// and the MCP tools configured for the named subagent.
+func NewSubagentRegistry(ws coding.Workspace, name string, skillAllowlist []string) (*tool.Registry, error) {
 	registry := tool.NewRegistry()
 	if err := coding.RegisterAll(registry, ws, executionEnvForWorkspace(ws)); err != nil {
 		return nil, err
@@ -85,6 +93,9 @@ func NewSubagentRegistry(ws coding.Workspace, skillAllowlist []string) (*tool.Re
 	if err := RegisterMemoryTools(registry); err != nil {
 		return nil, err
 	}
+	if err := registerMCPTools(registry, name); err != nil {
+		return nil, err
+	}
 	return registry, nil
 }
 
@@ -94,11 +105,13 @@ func ActiveToolNames() []string {
 	names = append(names, clip.ActiveToolNames()...)
 	names = append(names, agentnotify.ActiveToolNames()...)
 	names = append(names, agentgw.ActiveToolNames()...)
+	names = append(names, agentsearch.ActiveToolNames()...)
 	names = append(names, "read_skill", delegateSubagentToolName)
 	names = append(names, KnowledgeToolNames()...)
 	names = append(names, scheduleToolNames()...)
 	names = append(names, todoToolNames()...)
 	names = append(names, MemoryToolNames()...)
+	names = append(names, MCPToolNames(agentName)...)
 	return names
 }
 
@@ -36,6 +36,7 @@ func executeScheduledTask(ctx context.Context, task *gen.ChatScheduledTask) {
 	}
 	defer CloseEphemeralSession(ctx, svc, runSessionID)
 
+	ctx = WithBudgetSubject(ctx, BudgetSubject{TaskID: task.Flag})
 	reply, runErr := RunAutonomousPrompt(ctx, svc, runSessionID, task.Prompt, RunKindScheduled, nil, nil, task.Flag)
 	finished := time.Now().UTC()
 	persistScheduledTaskRun(ctx, task, runFlag, reply, runErr, finished)
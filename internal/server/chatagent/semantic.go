@@ -0,0 +1,85 @@
+package chatagent
+
+import (
+	"context"
+
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+	"github.com/flowline-io/flowbot/pkg/flog"
+)
+
+const (
+	defaultHybridLimit = 10
+	maxHybridLimit     = 50
+	// hybridSemanticFactor widens the semantic candidate pool so filters
+	// applied afterwards still leave enough matches to fuse.
+	hybridSemanticFactor = 3
+)
+
+func normalizeHybridLimit(limit int) int {
+	if limit <= 0 {
+		return defaultHybridLimit
+	}
+	return min(limit, maxHybridLimit)
+}
+
+// hybridRank merges keyword hits, best first, with semantic matches of kind in
+// scope and returns up to limit rows. Rows found only semantically are loaded
+// by load and kept when keep accepts them. Without an active index, or when
+// embedding fails, the keyword hits are returned as is.
+func hybridRank[T any](
+	ctx context.Context,
+	kind, scope, query string,
+	limit int,
+	keyword []T,
+	id func(T) int64,
+	load func(ctx context.Context, ids []int64) ([]T, error),
+	keep func(T) bool,
+) []T {
+	ix := embed.Active()
+	if ix == nil || query == "" {
+		return keyword
+	}
+	matches, err := ix.Similar(ctx, kind, scope, query, limit*hybridSemanticFactor)
+	if err != nil {
+		flog.Warn("[chat-agent] semantic %s search: %v", kind, err)
+		return keyword
+	}
+
+	byID := make(map[int64]T, len(keyword)+len(matches))
+	keywordIDs := make([]int64, 0, len(keyword))
+	for _, row := range keyword {
+		byID[id(row)] = row
+		keywordIDs = append(keywordIDs, id(row))
+	}
+	var missing []int64
+	for _, m := range matches {
+		if _, ok := byID[m.RefID]; !ok {
+			missing = append(missing, m.RefID)
+		}
+	}
+	if len(missing) > 0 {
+		rows, err := load(ctx, missing)
+		if err != nil {
+			flog.Warn("[chat-agent] load semantic %s hits: %v", kind, err)
+			return keyword
+		}
+		for _, row := range rows {
+			if keep == nil || keep(row) {
+				byID[id(row)] = row
+			}
+		}
+	}
+
+	semantic := make([]embed.Match, 0, len(matches))
+	for _, m := range matches {
+		if _, ok := byID[m.RefID]; ok {
+			semantic = append(semantic, m)
+		}
+	}
+	ranked := embed.Fuse(keywordIDs, semantic, limit)
+	out := make([]T, 0, len(ranked))
+	for _, refID := range ranked {
+		out = append(out, byID[refID])
+	}
+	return out
+}
@@ -0,0 +1,188 @@
+package chatagent
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/internal/store/ent/schema"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+)
+
+// petEmbedder places texts about pets on one axis and everything else on
+// another.
+type petEmbedder struct{}
+
+func (petEmbedder) Model() string { return "stub-embed" }
+
+func (petEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	for i, text := range texts {
+		out[i] = []float32{0, 1}
+		lower := strings.ToLower(text)
+		for _, word := range []string{"cat", "kitten", "feline", "dog"} {
+			if strings.Contains(lower, word) {
+				out[i] = []float32{1, 0}
+			}
+		}
+	}
+	return out, nil
+}
+
+// installStubEmbeddings activates an index backed by petEmbedder.
+func installStubEmbeddings(t *testing.T) *embed.Index {
+	t.Helper()
+	agentStore := store.AgentStoreFromDB()
+	ix := embed.NewIndex(store.AgentEmbeddingStoreFromDB(), petEmbedder{}).
+		WithSource(embed.KindKnowledge, func(ctx context.Context) ([]embed.Item, error) {
+			rows, err := agentStore.ListAgentKnowledge(ctx, store.AgentKnowledgeListFilter{})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]embed.Item, 0, len(rows))
+			for _, row := range rows {
+				items = append(items, embed.Item{RefID: row.ID, Text: row.Title + "\n" + row.Content})
+			}
+			return items, nil
+		}).
+		WithSource(embed.KindMemoryFact, func(ctx context.Context) ([]embed.Item, error) {
+			rows, err := agentStore.ListAllAgentMemoryFacts(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]embed.Item, 0, len(rows))
+			for _, row := range rows {
+				items = append(items, embed.Item{RefID: row.ID, Scope: row.Scope, Text: row.Key + ": " + row.Value})
+			}
+			return items, nil
+		}).
+		WithSource(embed.KindSessionSummary, func(ctx context.Context) ([]embed.Item, error) {
+			rows, err := agentStore.ListAgentSessionSummaries(ctx, store.AgentSessionSummaryListFilter{Status: schema.AgentSessionSummaryReady})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]embed.Item, 0, len(rows))
+			for _, row := range rows {
+				items = append(items, embed.Item{RefID: row.ID, Scope: row.Scope, Text: row.Title + "\n" + row.Summary})
+			}
+			return items, nil
+		})
+	embed.SetActive(ix)
+	t.Cleanup(func() { embed.SetActive(nil) })
+	return ix
+}
+
+func TestHybridRetrievalTools(t *testing.T) {
+	installSQLiteTestDatabase(t)
+	ctx := WithMemoryScope(context.Background(), "default")
+	agentStore := store.AgentStoreFromDB()
+
+	now := time.Now()
+	for _, doc := range []*gen.AgentKnowledge{
+		{Path: "/pets/feline.md", Title: "Feline care", Content: "Grooming a kitten", CreatedAt: now, UpdatedAt: now},
+		{Path: "/ops/catalog.md", Title: "Service catalog", Content: "List of services", CreatedAt: now, UpdatedAt: now},
+		{Path: "/ops/backup.md", Title: "Backups", Content: "Nightly restic", CreatedAt: now, UpdatedAt: now},
+	} {
+		require.NoError(t, agentStore.CreateAgentKnowledge(ctx, doc))
+	}
+	for _, f := range []store.AgentMemoryFactUpsert{
+		{Scope: "default", Key: "pet", Value: "a kitten named Miso"},
+		{Scope: "default", Key: "editor", Value: "helix"},
+		{Scope: "other", Key: "pet", Value: "a dog"},
+	} {
+		_, err := agentStore.UpsertAgentMemoryFact(ctx, f)
+		require.NoError(t, err)
+	}
+	_, err := agentStore.UpsertAgentSessionSummaryPending(ctx, "sess-pets", "default", "Vet visit")
+	require.NoError(t, err)
+	_, err = agentStore.ClaimAgentSessionSummaryPending(ctx, "tok")
+	require.NoError(t, err)
+	require.NoError(t, agentStore.MarkAgentSessionSummaryReady(ctx, "sess-pets", "tok", "Vet visit", "Booked shots for the kitten"))
+
+	ix := installStubEmbeddings(t)
+	require.NoError(t, ix.Reconcile(ctx))
+
+	tests := []struct {
+		name    string
+		run     func() (string, error)
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "knowledge keyword and semantic hits are fused",
+			run: func() (string, error) {
+				res, err := (SearchKnowledgeTool{}).Execute(ctx, "id", map[string]any{"query": "cat"}, nil)
+				return memoryToolResultText(res), err
+			},
+			want:    []string{"/ops/catalog.md", "/pets/feline.md"},
+			notWant: []string{"/ops/backup.md"},
+		},
+		{
+			name: "knowledge path prefix filters semantic hits",
+			run: func() (string, error) {
+				res, err := (SearchKnowledgeTool{}).Execute(ctx, "id", map[string]any{"query": "cat", "path_prefix": "/ops/"}, nil)
+				return memoryToolResultText(res), err
+			},
+			want:    []string{"/ops/catalog.md"},
+			notWant: []string{"/pets/feline.md"},
+		},
+		{
+			name: "session summary found by meaning",
+			run: func() (string, error) {
+				res, err := (SearchSessionSummariesTool{}).Execute(ctx, "id", map[string]any{"query": "cat"}, nil)
+				return memoryToolResultText(res), err
+			},
+			want: []string{"sess-pets"},
+		},
+		{
+			name: "memory list query stays in scope",
+			run: func() (string, error) {
+				res, err := (MemoryListTool{}).Execute(ctx, "id", map[string]any{"query": "cat"}, nil)
+				return memoryToolResultText(res), err
+			},
+			want:    []string{"Miso"},
+			notWant: []string{"helix", "a dog"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.run()
+			require.NoError(t, err)
+			for _, w := range tt.want {
+				assert.Contains(t, text, w)
+			}
+			for _, w := range tt.notWant {
+				assert.NotContains(t, text, w)
+			}
+		})
+	}
+
+	// Knowledge removed from the store drops out of the index.
+	docs, err := agentStore.ListAgentKnowledge(ctx, store.AgentKnowledgeListFilter{Q: "feline"})
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	require.NoError(t, agentStore.DeleteAgentKnowledge(ctx, docs[0].ID))
+	require.NoError(t, ix.Reconcile(ctx))
+	matches, err := ix.Similar(ctx, embed.KindKnowledge, "", "kitten", 10)
+	require.NoError(t, err)
+	for _, m := range matches {
+		assert.NotEqual(t, docs[0].ID, m.RefID)
+	}
+}
+
+func TestHybridRankFallsBackWithoutIndex(t *testing.T) {
+	embed.SetActive(nil)
+	keyword := []int64{3, 1}
+	got := hybridRank(context.Background(), embed.KindKnowledge, "", "q", 10, keyword,
+		func(id int64) int64 { return id },
+		func(context.Context, []int64) ([]int64, error) { return nil, nil },
+		nil,
+	)
+	assert.Equal(t, keyword, got)
+}
@@ -17,6 +17,7 @@ import (
 	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
 	agentresult "github.com/flowline-io/flowbot/pkg/agent/result"
+	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
@@ -96,6 +97,12 @@ func (s *Service) Run(ctx context.Context, req RunRequest, sink StreamSink) (str
 		return "", err
 	}
 
+	ctx, err = s.checkRunBudgets(ctx, req)
+	if err != nil {
+		flog.Warn("[chat-agent] run blocked: session=%s: %v", req.SessionID, err)
+		return "", err
+	}
+
 	ctx = WithMemoryScope(ctx, ResolveMemoryScope(req))
 	ctx = withRunIO(ctx, req.API)
 
@@ -117,6 +124,23 @@ func (*Service) prepareRun(ctx context.Context, req RunRequest) (time.Time, RunR
 	return start, req, nil
 }
 
+// checkRunBudgets attributes the run to its owner and the chat agent, then
+// rejects it when chat_agent.budgets hard limits are exhausted.
+func (*Service) checkRunBudgets(ctx context.Context, req RunRequest) (context.Context, error) {
+	subject := BudgetSubjectFromContext(ctx)
+	subject.Agent = agentName
+	if len(config.App.ChatAgent.Budgets) == 0 {
+		return WithBudgetSubject(ctx, subject), nil
+	}
+	uid, err := SessionOwnerUID(ctx, req.SessionID)
+	if err != nil {
+		flog.Warn("[chat-agent] budget owner session=%s: %v", req.SessionID, err)
+	}
+	subject.UID = uid.String()
+	ctx = WithBudgetSubject(ctx, subject)
+	return ctx, CheckBudgets(ctx, subject)
+}
+
 // lockSession acquires the per-session mutex and returns an unlock function.
 func (s *Service) lockSession(sessionID string) func() {
 	lock := s.sessionLock(sessionID)
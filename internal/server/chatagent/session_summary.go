@@ -12,6 +12,7 @@ import (
 	"github.com/tmc/langchaingo/llms"
 
 	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
 	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
 	"github.com/flowline-io/flowbot/pkg/agent/session"
@@ -182,7 +183,9 @@ func processOneSessionSummary(ctx context.Context) bool {
 	}
 	if err := agentStore.MarkAgentSessionSummaryReady(ctx, row.SessionFlag, claimToken, title, summary); err != nil {
 		flog.Warn("[chat-agent] mark session summary ready session=%s: %v", row.SessionFlag, err)
+		return true
 	}
+	embed.Kick()
 	return true
 }
 
@@ -143,7 +143,8 @@ func resolveThinkingLevel(stored string) string {
 	return agentllm.ThinkingLevelDefault
 }
 
-// BuildSelectableModels returns the model list to show in the UI picker.
+// BuildSelectableModels returns the model list to show in the UI picker,
+// followed by model_aliases labelled with their failover chain.
 // When dual model is enabled, only models sharing the same provider as the
 // configured chat_model are included, because chat and tool models must use
 // the same provider.
@@ -174,5 +175,16 @@ func BuildSelectableModels() []SelectableModel {
 			})
 		}
 	}
+	for _, alias := range config.App.ModelAliases {
+		if seen[alias.Name] || (filterByProvider && config.ModelProviderFor(alias.Name) != defaultProvider) {
+			continue
+		}
+		seen[alias.Name] = true
+		out = append(out, SelectableModel{
+			ID:         alias.Name,
+			Name:       alias.Name + " (" + strings.Join(alias.Chain, " → ") + ")",
+			Multimodal: agentmodel.AcceptsMediaInput(config.CatalogModelName(alias.Name)),
+		})
+	}
 	return out
 }
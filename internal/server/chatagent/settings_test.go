@@ -192,6 +192,33 @@ func TestBuildSelectableModels(t *testing.T) {
 			},
 			want: []string{"a", "d"},
 		},
+		{
+			name: "appends model aliases",
+			cfg: config.Type{
+				ChatAgent: config.ChatAgentConfig{ChatModel: "smart"},
+				Models: []config.Model{
+					{Provider: "openai", ModelNames: []string{"a"}},
+					{Provider: "anthropic", ModelNames: []string{"c"}},
+				},
+				ModelAliases: []config.ModelAlias{{Name: "smart", Chain: []string{"a", "c"}}},
+			},
+			want: []string{"a", "c", "smart"},
+		},
+		{
+			name: "dual model filters aliases by first chain provider",
+			cfg: config.Type{
+				ChatAgent: config.ChatAgentConfig{ChatModel: "a", ToolModel: "b"},
+				Models: []config.Model{
+					{Provider: "openai", ModelNames: []string{"a", "b"}},
+					{Provider: "anthropic", ModelNames: []string{"c"}},
+				},
+				ModelAliases: []config.ModelAlias{
+					{Name: "openai-first", Chain: []string{"a", "c"}},
+					{Name: "anthropic-first", Chain: []string{"c", "a"}},
+				},
+			},
+			want: []string{"a", "b", "openai-first"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -97,6 +97,15 @@ func (t TaskTool) Execute(ctx context.Context, id string, args map[string]any, o
 		return taskToolError(id, fmt.Sprintf("subagent skills: %v", err)), nil
 	}
 
+	subject := BudgetSubjectFromContext(ctx)
+	subject.UID = t.deps.UID.String()
+	subject.Agent = def.Name
+	ctx = WithBudgetSubject(ctx, subject)
+	if err := CheckBudgets(ctx, subject); err != nil {
+		flog.Warn("[chat-agent] task subagent blocked type=%s: %v", subagentType, err)
+		return taskToolError(id, err.Error()), nil
+	}
+
 	taskRecord, err := beginSubagentTask(ctx, t.deps.SessionID, subagentType, description, prompt, t.deps.Depth+1)
 	auditNote := ""
 	if err != nil {
@@ -111,13 +120,13 @@ func (t TaskTool) Execute(ctx context.Context, id string, args map[string]any, o
 		return taskToolError(id, fmt.Sprintf("subagent model: %v", err)), nil
 	}
 
-	childRegistry, err := NewSubagentRegistry(t.workspace, def.Skills)
+	childRegistry, err := NewSubagentRegistry(t.workspace, def.Name, def.Skills)
 	if err != nil {
 		failSubagentTask(ctx, taskRecord, fmt.Sprintf("subagent registry: %v", err))
 		return taskToolError(id, fmt.Sprintf("subagent registry: %v", err)), nil
 	}
 	if active := activeSubagentTools(def.Tools, def.Skills); len(active) > 0 {
-		childRegistry.SetActive(active)
+		childRegistry.SetActive(append(active, MCPToolNames(def.Name)...))
 	}
 
 	cfg, _, _, _, err := agentLoopConfig()
@@ -133,6 +142,7 @@ func (t TaskTool) Execute(ctx context.Context, id string, args map[string]any, o
 		UID:           t.deps.UID,
 		SessionMode:   LoadSessionMode(ctx, t.deps.SessionID),
 		Kind:          t.deps.Kind,
+		Agent:         def.Name,
 		WorkspaceRoot: t.workspace.Root,
 		Service:       t.deps.Service,
 	})
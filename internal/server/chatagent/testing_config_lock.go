@@ -30,12 +30,13 @@ func LockAppConfigForTest(t *testing.T) {
 
 // installTestDatabase replaces store.Database for the test lifetime.
 // It holds storeDatabaseTestMu for the whole test and drains approval-notify
-// goroutines before each swap so parallel tests cannot race the package-level
+// and budget-alert goroutines before each swap so parallel tests cannot race the package-level
 // adapter pointer.
 func installTestDatabase(t *testing.T, db store.Adapter) {
 	t.Helper()
 	storeDatabaseTestMu.Lock()
 	WaitApprovalNotifyForTest()
+	WaitBudgetAlertsForTest()
 	orig := store.Database
 	store.Database = db
 	if db != nil {
@@ -45,6 +46,7 @@ func installTestDatabase(t *testing.T, db store.Adapter) {
 	}
 	t.Cleanup(func() {
 		WaitApprovalNotifyForTest()
+		WaitBudgetAlertsForTest()
 		store.Database = orig
 		storeDatabaseTestMu.Unlock()
 	})
@@ -67,10 +69,11 @@ func InstallTestDatabaseForTest(t *testing.T, db store.Adapter) {
 	installTestDatabase(t, db)
 }
 
-// restoreTestDatabase drains approval notify then assigns store.Database.
+// restoreTestDatabase drains approval and budget notify then assigns store.Database.
 // Callers must already hold storeDatabaseTestMu via installTestDatabase.
 func restoreTestDatabase(db store.Adapter) {
 	WaitApprovalNotifyForTest()
+	WaitBudgetAlertsForTest()
 	store.Database = db
 }
 
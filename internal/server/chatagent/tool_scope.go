@@ -8,6 +8,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/server/chatagent/tools/clip"
 	agentgw "github.com/flowline-io/flowbot/internal/server/chatagent/tools/gateway"
 	agentnotify "github.com/flowline-io/flowbot/internal/server/chatagent/tools/notify"
+	"github.com/flowline-io/flowbot/pkg/agent/permission"
 	"github.com/flowline-io/flowbot/pkg/agent/tools/coding"
 )
 
@@ -21,6 +22,7 @@ const (
 	ToolGroupSubagent = "subagent"
 	ToolGroupMemory   = "memory"
 	ToolGroupTodo     = "todo"
+	ToolGroupMCP      = "mcp"
 )
 
 var scheduleIntentPattern = regexp.MustCompile(`(?i)\b(schedule|cron|scheduled\s+task|remind\s+me|every\s+day|recurring)\b`)
@@ -91,6 +93,9 @@ func ToolGroupOf(name string) string {
 	case todoWriteToolName, listTodosToolName:
 		return ToolGroupTodo
 	default:
+		if _, _, ok := permission.SplitMCPToolName(name); ok {
+			return ToolGroupMCP
+		}
 		if strings.HasPrefix(name, "schedule") {
 			return ToolGroupSchedule
 		}
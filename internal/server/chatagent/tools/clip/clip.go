@@ -70,6 +70,26 @@ func (CreateTool) Parameters() map[string]any {
 				"type":        "string",
 				"description": "Optional creator identifier",
 			},
+			"expires_in": map[string]any{
+				"type":        "string",
+				"description": "Optional lifetime as a Go duration (for example 24h); the clip stops being served after it",
+			},
+			"burn_after_read": map[string]any{
+				"type":        "boolean",
+				"description": "Delete the clip body after its first read",
+			},
+			"max_views": map[string]any{
+				"type":        "integer",
+				"description": "Optional number of reads after which the clip stops being served",
+			},
+			"password": map[string]any{
+				"type":        "string",
+				"description": "Optional password readers must enter; share it separately from the URL",
+			},
+			"signed_link": map[string]any{
+				"type":        "boolean",
+				"description": "Require the signed URL returned here; the plain /c/{slug} link stops working",
+			},
 		},
 		"required": []string{"content"},
 	}
@@ -82,9 +102,16 @@ func (t CreateTool) Execute(ctx context.Context, id string, args map[string]any,
 		return tool.ErrorResult(id, t.Name(), "invalid_args", "content is required", "pass the markdown body to publish"), nil
 	}
 	params := map[string]any{"content": content}
-	if raw, ok := args["created_by"]; ok {
-		if s := strings.TrimSpace(fmt.Sprint(raw)); s != "" && s != "<nil>" {
-			params["created_by"] = s
+	for _, key := range []string{"created_by", "expires_in", "password"} {
+		if raw, ok := args[key]; ok {
+			if s := strings.TrimSpace(fmt.Sprint(raw)); s != "" && s != "<nil>" {
+				params[key] = s
+			}
+		}
+	}
+	for _, key := range []string{"burn_after_read", "max_views", "signed_link"} {
+		if raw, ok := args[key]; ok && raw != nil {
+			params[key] = raw
 		}
 	}
 
@@ -101,6 +128,12 @@ func (t CreateTool) Execute(ctx context.Context, id string, args map[string]any,
 	fullURL := AbsoluteURL(t.PublicBaseURL, relURL)
 	title := stringFromMap(data, "title")
 	text := fmt.Sprintf("clip created\nurl: %s\nslug: %s\ntitle: %s", fullURL, slug, title)
+	if limits := limitsText(data); limits != "" {
+		text += "\nlimits: " + limits
+	}
+	if exp := stringFromMap(data, "link_expires_at"); exp != "" {
+		text += "\nlink expires: " + exp
+	}
 	if !strings.HasPrefix(fullURL, "http://") && !strings.HasPrefix(fullURL, "https://") {
 		text += "\nnote: set flowbot.url for an absolute public link"
 	}
@@ -152,8 +185,12 @@ func (GetTool) Execute(ctx context.Context, id string, args map[string]any, _ to
 	description := stringFromMap(data, "description")
 	content := stringFromMap(data, "content")
 	relURL := stringFromMap(data, "url")
-	text := fmt.Sprintf("slug: %s\ntitle: %s\ndescription: %s\nurl: %s\n\n%s",
-		slug, title, description, AbsoluteURL("", relURL), content)
+	text := fmt.Sprintf("slug: %s\ntitle: %s\ndescription: %s\nurl: %s\nstate: %s\nviews: %s",
+		slug, title, description, AbsoluteURL("", relURL), stringFromMap(data, "state"), viewsText(data))
+	if limits := limitsText(data); limits != "" {
+		text += "\nlimits: " + limits
+	}
+	text += "\n\n" + content
 	return msg.ToolResultMessage{
 		ToolCallID: id,
 		Name:       GetToolName,
@@ -200,6 +237,37 @@ func invokeErrorResult(callID, name string, err error) msg.ToolResultMessage {
 	return tool.ErrorResult(callID, name, code, err.Error(), hint)
 }
 
+// limitsText summarizes the access options of a clip result, or "" when it has none.
+func limitsText(data map[string]any) string {
+	var parts []string
+	if exp := stringFromMap(data, "expires_at"); exp != "" {
+		parts = append(parts, "expires "+exp)
+	}
+	if data["burn_after_read"] == true {
+		parts = append(parts, "burn after read")
+	} else if maxViews := stringFromMap(data, "max_views"); maxViews != "" && maxViews != "0" {
+		parts = append(parts, "max "+maxViews+" views")
+	}
+	if data["password_protected"] == true {
+		parts = append(parts, "password")
+	}
+	if data["signed_link"] == true {
+		parts = append(parts, "signed link")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func viewsText(data map[string]any) string {
+	views := stringFromMap(data, "views")
+	if views == "" {
+		views = "0"
+	}
+	if maxViews := stringFromMap(data, "max_views"); maxViews != "" && maxViews != "0" {
+		return views + " of " + maxViews
+	}
+	return views
+}
+
 func resultDataMap(res *capability.InvokeResult) map[string]any {
 	if res == nil {
 		return nil
@@ -14,18 +14,20 @@ import (
 	capclip "github.com/flowline-io/flowbot/pkg/capability/core"
 	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 type memPersister struct {
 	bySlug map[string]*capclip.Record
 }
 
-func (m *memPersister) CreateClip(_ context.Context, slug, title, description, content, createdBy string) error {
+func (m *memPersister) CreateClip(_ context.Context, in capclip.CreateInput) error {
 	if m.bySlug == nil {
 		m.bySlug = map[string]*capclip.Record{}
 	}
-	m.bySlug[slug] = &capclip.Record{
-		Slug: slug, Title: title, Description: description, Content: content, CreatedBy: createdBy,
+	m.bySlug[in.Slug] = &capclip.Record{
+		Slug: in.Slug, Title: in.Title, Description: in.Description, Content: in.Content,
+		CreatedBy: in.CreatedBy, Version: 1, Limits: in.Limits,
 	}
 	return nil
 }
@@ -37,6 +39,20 @@ func (m *memPersister) GetClipBySlug(_ context.Context, slug string) (*capclip.R
 	return m.bySlug[slug], nil
 }
 
+func (m *memPersister) UpdateClip(_ context.Context, in capclip.UpdateInput) (*capclip.Record, error) {
+	rec := m.bySlug[in.Slug]
+	if rec == nil {
+		return nil, types.ErrNotFound
+	}
+	rec.Content = in.Content
+	rec.Version++
+	return rec, nil
+}
+
+func (m *memPersister) ListClipVersions(_ context.Context, _ string) ([]capclip.Version, error) {
+	return nil, nil
+}
+
 func TestAbsoluteURL(t *testing.T) {
 	prev := config.App.Flowbot.URL
 	t.Cleanup(func() { config.App.Flowbot.URL = prev })
@@ -66,6 +82,9 @@ func TestCreateAndGetTools(t *testing.T) {
 		capability.UnregisterInvoker(hub.CapCore, capclip.OpClipCreate)
 		capability.UnregisterInvoker(hub.CapCore, capclip.OpClipGet)
 		capability.UnregisterInvoker(hub.CapCore, capclip.OpClipHealth)
+		capability.UnregisterInvoker(hub.CapCore, capclip.OpClipUpdate)
+		capability.UnregisterInvoker(hub.CapCore, capclip.OpClipHistory)
+		capability.UnregisterInvoker(hub.CapCore, capclip.OpClipSign)
 		hub.Default.Unregister(hub.CapCore)
 		capclip.SetPersister(nil)
 		capclip.SetMetaLLMForTest(nil)
@@ -116,6 +135,40 @@ func TestCreateAndGetTools(t *testing.T) {
 			},
 			wantSub: "secret-markdown-body",
 		},
+		{
+			name: "create passes limits and reports them",
+			run: func(t *testing.T) string {
+				res, err := create.Execute(context.Background(), "c4", map[string]any{
+					"content":         "one-time secret",
+					"burn_after_read": true,
+					"password":        "hunter22",
+					"expires_in":      "2h",
+				}, nil)
+				require.NoError(t, err)
+				require.False(t, res.IsError, toolText(res))
+				slug := slugFromToolText(toolText(res))
+				res, err = get.Execute(context.Background(), "g2", map[string]any{"slug": slug}, nil)
+				require.NoError(t, err)
+				text := toolText(res)
+				assert.Contains(t, text, "state: active")
+				assert.Contains(t, text, "views: 0 of 1")
+				return text
+			},
+			wantSub: "burn after read, password",
+		},
+		{
+			name: "create rejects bad limits",
+			run: func(t *testing.T) string {
+				res, err := create.Execute(context.Background(), "c5", map[string]any{
+					"content":   "body",
+					"max_views": -1,
+				}, nil)
+				require.NoError(t, err)
+				assert.True(t, res.IsError)
+				return toolText(res)
+			},
+			wantSub: "invalid_args",
+		},
 		{
 			name: "create rejects empty content",
 			run: func(t *testing.T) string {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
 	"github.com/flowline-io/flowbot/pkg/agent/tool"
@@ -19,6 +20,9 @@ import (
 // RunCursorToolName is the agent tool name for local Cursor CLI delegation.
 const RunCursorToolName = "run_cursor"
 
+// liveOutputTail bounds the streamed job output echoed through tool updates.
+const liveOutputTail = 2048
+
 // RunCursorTool submits a CapGateway run job and returns the terminal result.
 type RunCursorTool struct {
 	// UID is recorded on the gateway job for audit.
@@ -52,7 +56,7 @@ func (RunCursorTool) Parameters() map[string]any {
 }
 
 // Execute invokes CapGateway run and formats the job result for the model.
-func (t RunCursorTool) Execute(ctx context.Context, id string, args map[string]any, _ tool.UpdateHandler) (msg.ToolResultMessage, error) {
+func (t RunCursorTool) Execute(ctx context.Context, id string, args map[string]any, onUpdate tool.UpdateHandler) (msg.ToolResultMessage, error) {
 	if !config.App.Gateway.Enabled {
 		return tool.ErrorResult(id, t.Name(), "unavailable", "gateway capability is disabled", "set gateway.enabled=true and run cmd/gateway"), nil
 	}
@@ -73,6 +77,9 @@ func (t RunCursorTool) Execute(ctx context.Context, id string, args map[string]a
 		}
 	}
 
+	if onUpdate != nil {
+		ctx = capgw.WithLogHandler(ctx, liveOutputHandler(onUpdate))
+	}
 	res, err := capability.Invoke(ctx, hub.CapGateway, capgw.OpRun, params)
 	if err != nil {
 		return invokeErrorResult(id, t.Name(), err), nil
@@ -109,6 +116,27 @@ func ActiveToolNames() []string {
 	return []string{RunCursorToolName}
 }
 
+// liveOutputHandler reports the tail of the job output streamed so far, so the
+// chat UI shows a rolling view of the worker CLI while the job runs.
+func liveOutputHandler(onUpdate tool.UpdateHandler) capgw.LogHandler {
+	var tail string
+	return func(chunk types.GatewayLogChunk) {
+		tail = keepTail(tail+chunk.Content, liveOutputTail)
+		_ = onUpdate(tail)
+	}
+}
+
+func keepTail(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := len(s) - maxBytes
+	for cut < len(s) && !utf8.RuneStart(s[cut]) {
+		cut++
+	}
+	return s[cut:]
+}
+
 func formatJob(job *types.GatewayJob) string {
 	var b strings.Builder
 	_, _ = fmt.Fprintf(&b, "status: %s\njob_id: %s\ncli: %s\ncwd: %s\nduration_ms: %d\n",
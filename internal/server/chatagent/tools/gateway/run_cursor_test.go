@@ -0,0 +1,29 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestLiveOutputHandler(t *testing.T) {
+	t.Parallel()
+	var updates []string
+	h := liveOutputHandler(func(update string) error {
+		updates = append(updates, update)
+		return nil
+	})
+	h(types.GatewayLogChunk{Seq: 1, Content: "build\n"})
+	h(types.GatewayLogChunk{Seq: 2, Content: "test\n"})
+	assert.Equal(t, []string{"build\n", "build\ntest\n"}, updates)
+}
+
+func TestKeepTail(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "abc", keepTail("abc", 5))
+	assert.Equal(t, "cde", keepTail("abcde", 3))
+	assert.Equal(t, "界", keepTail("a界", 3))
+	assert.Empty(t, keepTail("a界", 2), "never starts mid-rune")
+}
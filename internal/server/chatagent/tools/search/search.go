@@ -0,0 +1,175 @@
+// Package search provides the chatagent tool for the unified search index.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/tool"
+	pkgsearch "github.com/flowline-io/flowbot/pkg/search"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	// ToolName is the agent tool name for the unified search.
+	ToolName = "unified_search"
+
+	defaultLimit = 10
+)
+
+// Tool queries the unified search index over the user's bookmarks, feed
+// entries, memos, notes, tasks, issues, clips and agent knowledge.
+type Tool struct {
+	// UID scopes results to the user's own documents plus shared ones.
+	UID types.Uid
+}
+
+// Name returns the tool identifier.
+func (Tool) Name() string { return ToolName }
+
+// Description explains the tool to the model.
+func (Tool) Description() string {
+	return "Search the user's bookmarks, feed entries, memos, notes, kanban tasks, issues, clips and knowledge base in one ranked full-text query. Returns id, title, source, url and a snippet; use the source-specific tools to read or change an item."
+}
+
+// Parameters returns the JSON schema for tool arguments.
+func (Tool) Parameters() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"query": map[string]any{
+				"type":        "string",
+				"description": "Words to find; every word must match the title, content, url or tags",
+			},
+			"sources": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string", "enum": pkgsearch.Sources},
+				"description": "Optional sources to restrict the search to; default is all",
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": "Max results (default 10, max 100)",
+			},
+		},
+		"required": []string{"query"},
+	}
+}
+
+// Execute runs a ranked query against the active search service.
+func (t Tool) Execute(ctx context.Context, id string, args map[string]any, _ tool.UpdateHandler) (msg.ToolResultMessage, error) {
+	query := stringArg(args, "query")
+	if query == "" {
+		return tool.ErrorResult(id, t.Name(), "invalid_args", "query is required", "pass the words to search for"), nil
+	}
+	sources, err := pkgsearch.ParseSources(strings.Join(stringSliceArg(args["sources"]), ","))
+	if err != nil {
+		return errorResult(id, t.Name(), err), nil
+	}
+	limit := intArg(args, "limit")
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	svc := pkgsearch.ActiveService()
+	if svc == nil {
+		return errorResult(id, t.Name(), types.Errorf(types.ErrUnavailable, "search service not ready")), nil
+	}
+	hits, err := svc.Query(ctx, pkgsearch.Query{Text: query, Sources: sources, UID: string(t.UID), Limit: limit})
+	if err != nil {
+		return errorResult(id, t.Name(), err), nil
+	}
+	if len(hits) == 0 {
+		return msg.ToolResultMessage{
+			ToolCallID: id,
+			Name:       t.Name(),
+			Parts:      []msg.ContentPart{msg.TextPart{Text: "(no matches)"}},
+		}, nil
+	}
+	payload, err := sonic.Marshal(hits)
+	if err != nil {
+		return errorResult(id, t.Name(), err), nil
+	}
+	return msg.ToolResultMessage{
+		ToolCallID: id,
+		Name:       t.Name(),
+		Parts:      []msg.ContentPart{msg.TextPart{Text: string(payload)}},
+	}, nil
+}
+
+// Register registers unified_search on the given registry.
+func Register(registry *tool.Registry, uid types.Uid) error {
+	if registry == nil {
+		return fmt.Errorf("search tools: registry is nil")
+	}
+	return registry.Register(Tool{UID: uid})
+}
+
+// ActiveToolNames returns the default search tool names.
+func ActiveToolNames() []string {
+	return []string{ToolName}
+}
+
+func stringArg(args map[string]any, key string) string {
+	v, ok := args[key]
+	if !ok || v == nil {
+		return ""
+	}
+	s := strings.TrimSpace(fmt.Sprint(v))
+	if s == "<nil>" {
+		return ""
+	}
+	return s
+}
+
+func stringSliceArg(raw any) []string {
+	switch v := raw.(type) {
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := strings.TrimSpace(fmt.Sprint(item)); s != "" && s != "<nil>" {
+				out = append(out, s)
+			}
+		}
+		return out
+	case string:
+		return []string{v}
+	default:
+		return nil
+	}
+}
+
+func intArg(args map[string]any, key string) int {
+	switch v := args[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		n, _ := strconv.Atoi(strings.TrimSpace(v))
+		return n
+	default:
+		return 0
+	}
+}
+
+func errorResult(callID, name string, err error) msg.ToolResultMessage {
+	code := "tool_error"
+	hint := "retry the search"
+	switch {
+	case errors.Is(err, types.ErrInvalidArgument):
+		code = "invalid_args"
+		hint = "fix the tool arguments; sources are " + strings.Join(pkgsearch.Sources, ", ")
+	case errors.Is(err, types.ErrUnavailable):
+		code = "unavailable"
+		hint = "the search index requires the database"
+	}
+	return tool.ErrorResult(callID, name, code, err.Error(), hint)
+}
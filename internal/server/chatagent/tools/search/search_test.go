@@ -0,0 +1,132 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/tool"
+	pkgsearch "github.com/flowline-io/flowbot/pkg/search"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// toolStore returns its documents for every filter that allows their source
+// and owner.
+type toolStore struct {
+	docs []pkgsearch.Document
+}
+
+func (s *toolStore) UpsertSearchDocuments(context.Context, []pkgsearch.Document) error {
+	return nil
+}
+
+func (s *toolStore) DeleteSearchDocuments(context.Context, string, []string) error {
+	return nil
+}
+
+func (s *toolStore) PruneSearchDocuments(context.Context, string, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (s *toolStore) FindSearchDocuments(_ context.Context, f pkgsearch.Filter) ([]pkgsearch.Match, error) {
+	var out []pkgsearch.Match
+	for _, d := range s.docs {
+		if d.UID != "" && d.UID != f.UID {
+			continue
+		}
+		if len(f.Sources) > 0 && !strings.Contains(strings.Join(f.Sources, ","), d.Source) {
+			continue
+		}
+		out = append(out, pkgsearch.Match{Document: d})
+	}
+	return out, nil
+}
+
+func TestToolExecute(t *testing.T) {
+	prev := pkgsearch.ActiveService()
+	t.Cleanup(func() { pkgsearch.SetActiveService(prev) })
+	pkgsearch.SetActiveService(pkgsearch.NewService(&toolStore{docs: []pkgsearch.Document{
+		{Source: pkgsearch.SourceBookmark, ID: "b1", Title: "Backup strategy"},
+		{Source: pkgsearch.SourceNote, ID: "n1", UID: "u1", Title: "My backup notes"},
+		{Source: pkgsearch.SourceNote, ID: "n2", UID: "u2", Title: "Other backup notes"},
+	}}))
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		wantError  bool
+		wantSubstr []string
+		notSubstr  []string
+	}{
+		{
+			name:       "query sees own and shared documents",
+			args:       map[string]any{"query": "backup"},
+			wantSubstr: []string{`"id":"b1"`, `"id":"n1"`},
+			notSubstr:  []string{`"id":"n2"`},
+		},
+		{
+			name:       "source filter",
+			args:       map[string]any{"query": "backup", "sources": []any{"note"}},
+			wantSubstr: []string{`"id":"n1"`},
+			notSubstr:  []string{`"id":"b1"`},
+		},
+		{
+			name:       "missing query",
+			args:       map[string]any{},
+			wantError:  true,
+			wantSubstr: []string{"query is required"},
+		},
+		{
+			name:       "unknown source",
+			args:       map[string]any{"query": "backup", "sources": []any{"wiki"}},
+			wantError:  true,
+			wantSubstr: []string{"invalid_args", "unknown search source"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := (Tool{UID: types.Uid("u1")}).Execute(context.Background(), "c1", tt.args, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantError, res.IsError)
+			text := toolResultText(res)
+			for _, s := range tt.wantSubstr {
+				assert.Contains(t, text, s)
+			}
+			for _, s := range tt.notSubstr {
+				assert.NotContains(t, text, s)
+			}
+		})
+	}
+}
+
+func TestToolExecuteUnavailable(t *testing.T) {
+	prev := pkgsearch.ActiveService()
+	t.Cleanup(func() { pkgsearch.SetActiveService(prev) })
+	pkgsearch.SetActiveService(nil)
+
+	res, err := (Tool{}).Execute(context.Background(), "c1", map[string]any{"query": "x"}, nil)
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.Contains(t, toolResultText(res), "unavailable")
+}
+
+func TestRegister(t *testing.T) {
+	require.Error(t, Register(nil, types.Uid("u1")))
+	require.NoError(t, Register(tool.NewRegistry(), types.Uid("u1")))
+	assert.Equal(t, []string{ToolName}, ActiveToolNames())
+}
+
+func toolResultText(res msg.ToolResultMessage) string {
+	var b strings.Builder
+	for _, p := range res.Parts {
+		if tp, ok := p.(msg.TextPart); ok {
+			_, _ = b.WriteString(tp.Text)
+		}
+	}
+	return b.String()
+}
@@ -32,6 +32,8 @@ type TrajectoryRow struct {
 	ToolName   string                 `json:"tool_name,omitempty"`
 	ToolStatus string                 `json:"tool_status,omitempty"`
 	Subagent   string                 `json:"subagent,omitempty"`
+	Model      string                 `json:"model,omitempty"`
+	Provider   string                 `json:"provider,omitempty"`
 	CreatedAt  time.Time              `json:"created_at"`
 	Raw        any                    `json:"raw,omitempty"`
 	Sections   []session.TraceSection `json:"sections,omitempty"`
@@ -206,8 +208,10 @@ func trajectoryRowsFromAssistant(entryID string, turn int, createdAt time.Time,
 				Kind:       "assistant",
 				Text:       text,
 				DurationMs: m.TurnDurationMs,
+				Model:      m.Model,
+				Provider:   m.Provider,
 				CreatedAt:  ts,
-				Raw:        map[string]any{"role": "assistant", "text": text},
+				Raw:        assistantTrajectoryRaw(m, text),
 			})
 		}
 		for i, call := range m.ToolCalls() {
@@ -226,11 +230,26 @@ func trajectoryRowsFromAssistant(entryID string, turn int, createdAt time.Time,
 		Kind:       "assistant",
 		Text:       text,
 		DurationMs: m.TurnDurationMs,
+		Model:      m.Model,
+		Provider:   m.Provider,
 		CreatedAt:  ts,
-		Raw:        map[string]any{"role": "assistant", "text": text},
+		Raw:        assistantTrajectoryRaw(m, text),
 	})
 }
 
+// assistantTrajectoryRaw is the inspector payload for an assistant row,
+// including the model and provider that served the turn.
+func assistantTrajectoryRaw(m msg.AssistantMessage, text string) map[string]any {
+	raw := map[string]any{"role": "assistant", "text": text}
+	if m.Model != "" {
+		raw["model"] = m.Model
+	}
+	if m.Provider != "" {
+		raw["provider"] = m.Provider
+	}
+	return raw
+}
+
 func trajectoryRowFromToolCall(entryID string, turn int, ts time.Time, i int, call msg.ToolCallPart, subagentByCall map[string]string) TrajectoryRow {
 	callID := strings.TrimSpace(call.ID)
 	if callID == "" {
@@ -37,6 +37,8 @@ func TestAssembleTrajectory(t *testing.T) {
 				msg.TextPart{Text: "counting"},
 				msg.ToolCallPart{ID: "c1", Name: "run_terminal", Arguments: `{"cmd":"ls"}`},
 			},
+			Model:              "claude-sonnet-4-6",
+			Provider:           "anthropic",
 			ThinkingText:       "need to list",
 			ThinkingDurationMs: 40,
 			TurnDurationMs:     120,
@@ -85,6 +87,9 @@ func TestAssembleTrajectory(t *testing.T) {
 	assert.Equal(t, "tr1/runtime", view.Rows[1].ID)
 	assert.Equal(t, "identity", view.Rows[0].Text)
 	assert.Equal(t, int64(9), view.Rows[0].AssembleMs)
+	assert.Equal(t, "claude-sonnet-4-6", view.Rows[4].Model)
+	assert.Equal(t, "anthropic", view.Rows[4].Provider)
+	assert.Equal(t, "anthropic", view.Rows[4].Raw.(map[string]any)["provider"])
 	assert.Equal(t, "run_terminal", view.Rows[5].ToolName)
 	assert.Contains(t, view.Rows[5].Text, "ls")
 	assert.Equal(t, "explore", view.Rows[7].Subagent)
@@ -6,6 +6,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/pkg/agent"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
@@ -22,7 +23,8 @@ func TokenUsageSourceFromRunKind(kind RunKind) string {
 	}
 }
 
-// RecordLLMUsageMessages persists token usage from assistant messages.
+// RecordLLMUsageMessages persists token usage from assistant messages, attributed
+// to the BudgetSubject on ctx, and alerts on budget soft limits it crosses.
 func RecordLLMUsageMessages(ctx context.Context, uid types.Uid, sessionID, source string, messages []agent.AgentMessage) {
 	if uid.IsZero() {
 		return
@@ -32,6 +34,11 @@ func RecordLLMUsageMessages(ctx context.Context, uid types.Uid, sessionID, sourc
 		return
 	}
 	source = types.NormalizeTokenUsageSource(source)
+	subject := BudgetSubjectFromContext(ctx)
+	if subject.Agent == "" {
+		subject.Agent = agentName
+	}
+	recorded := false
 	for _, raw := range messages {
 		assistant, ok := raw.(msg.AssistantMessage)
 		if !ok || assistant.Usage == nil {
@@ -41,15 +48,26 @@ func RecordLLMUsageMessages(ctx context.Context, uid types.Uid, sessionID, sourc
 			UID:              uid.String(),
 			SessionID:        sessionID,
 			Model:            assistant.Model,
+			Provider:         assistant.Provider,
 			PromptTokens:     assistant.Usage.PromptTokens,
 			CompletionTokens: assistant.Usage.CompletionTokens,
 			TotalTokens:      assistant.Usage.TotalTokens,
 			CacheRead:        assistant.Usage.CacheRead,
 			CacheWrite:       assistant.Usage.CacheWrite,
 			Source:           source,
+			Agent:            subject.Agent,
+			TaskID:           subject.TaskID,
+			PipelineStep:     subject.PipelineStep,
+			Cost:             usageCost(assistant.Model, *assistant.Usage),
 		})
 		if err != nil {
 			flog.Warn("[chat-agent] record llm usage session=%s source=%s: %v", sessionID, source, err)
+			continue
 		}
+		recorded = true
+	}
+	if recorded && len(config.App.ChatAgent.Budgets) > 0 {
+		subject.UID = uid.String()
+		alertBudgets(ctx, subject)
 	}
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/llmusagerecord"
 	"github.com/flowline-io/flowbot/pkg/agent"
 	"github.com/flowline-io/flowbot/pkg/agent/msg"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTokenUsageSourceFromRunKind(t *testing.T) {
@@ -70,3 +73,23 @@ func TestRecordLLMUsageMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordLLMUsageMessagesServedProvider(t *testing.T) {
+	installSQLiteTestDatabase(t)
+
+	ctx := context.Background()
+	RecordLLMUsageMessages(ctx, types.Uid("user-failover"), "sess-failover", types.TokenUsageSourceAgent, []agent.AgentMessage{
+		msg.AssistantMessage{
+			Model:    "claude-sonnet-4-6",
+			Provider: "anthropic",
+			Usage:    &msg.Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		},
+	})
+
+	row, err := store.Database.GetClient().LLMUsageRecord.Query().
+		Where(llmusagerecord.SessionID("sess-failover")).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "claude-sonnet-4-6", row.Model)
+	assert.Equal(t, "anthropic", row.Provider)
+}
@@ -17,6 +17,7 @@ func initChatAgentScheduler(lc fx.Lifecycle) {
 		OnStart: func(ctx context.Context) error {
 			agentdcg.Init()
 			chatagent.StartSessionSummaryWorker(ctx)
+			chatagent.StartMCP()
 			if err := sched.Start(ctx); err != nil {
 				flog.Error(err)
 				return err
@@ -24,6 +25,7 @@ func initChatAgentScheduler(lc fx.Lifecycle) {
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			chatagent.StopMCP()
 			return sched.Stop(ctx)
 		},
 	})
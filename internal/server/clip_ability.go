@@ -2,11 +2,18 @@ package server
 
 import (
 	"context"
+	"time"
+
+	"go.uber.org/fx"
 
 	storepkg "github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/pkg/capability/core"
+	"github.com/flowline-io/flowbot/pkg/flog"
 )
 
+const clipPurgeInterval = 10 * time.Minute
+
 // initClipAbility wires clip persistence and other CapCore deps (registration is deferred to notify OnStart).
 func initClipAbility() error {
 	if storepkg.Database != nil && storepkg.Database.GetClient() != nil {
@@ -21,14 +28,68 @@ func registerCoreCapability() error {
 	return core.Register()
 }
 
+// startClipPurgeLoop periodically clears what burned and expired clips still
+// hold: the body and history of expired clips and the attachment files of
+// both. It waits core.AttachmentURLTTL past the end so links handed to the
+// last reader keep working.
+func startClipPurgeLoop(lc fx.Lifecycle) {
+	stop := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			if storepkg.Database == nil || storepkg.Database.GetClient() == nil {
+				return nil
+			}
+			go clipPurgeLoop(stop, storepkg.ClipStoreFromDB())
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			close(stop)
+			return nil
+		},
+	})
+}
+
+func clipPurgeLoop(stop <-chan struct{}, clips *storepkg.ClipStore) {
+	ticker := time.NewTicker(clipPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			detached, err := clips.PurgeClips(context.Background(), time.Now(), core.AttachmentURLTTL)
+			if err != nil {
+				flog.Warn("clip purge: %v", err)
+			}
+			if len(detached) > 0 {
+				core.DeleteAttachments(detached)
+				flog.Info("clip purge: deleted %d attachment file(s)", len(detached))
+			}
+		}
+	}
+}
+
 // clipStorePersister adapts store.ClipStore to core.Persister.
 type clipStorePersister struct {
 	store *storepkg.ClipStore
 }
 
-// CreateClip inserts a clip row.
-func (p *clipStorePersister) CreateClip(ctx context.Context, slug, title, description, content, createdBy string) error {
-	return p.store.CreateClip(ctx, slug, title, description, content, createdBy)
+// CreateClip inserts a clip row and its first version snapshot.
+func (p *clipStorePersister) CreateClip(ctx context.Context, in core.CreateInput) error {
+	_, err := p.store.CreateClipFromInput(ctx, storepkg.ClipCreateInput{
+		Slug:          in.Slug,
+		Title:         in.Title,
+		Description:   in.Description,
+		Content:       in.Content,
+		CreatedBy:     in.CreatedBy,
+		Attachments:   in.Attachments,
+		ExpiresAt:     in.ExpiresAt,
+		BurnAfterRead: in.BurnAfterRead,
+		MaxViews:      in.MaxViews,
+		PasswordHash:  in.PasswordHash,
+		LinkSecret:    in.LinkSecret,
+	})
+	return err
 }
 
 // GetClipBySlug loads a clip by slug.
@@ -40,6 +101,52 @@ func (p *clipStorePersister) GetClipBySlug(ctx context.Context, slug string) (*c
 	if row == nil {
 		return nil, nil
 	}
+	return clipRecord(row), nil
+}
+
+// UpdateClip edits a clip and snapshots the new version.
+func (p *clipStorePersister) UpdateClip(ctx context.Context, in core.UpdateInput) (*core.Record, error) {
+	row, err := p.store.UpdateClip(ctx, storepkg.ClipUpdateInput{
+		Slug:        in.Slug,
+		BaseVersion: in.BaseVersion,
+		Title:       in.Title,
+		Description: in.Description,
+		Content:     in.Content,
+		Attachments: in.Attachments,
+		EditedBy:    in.EditedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clipRecord(row), nil
+}
+
+// ListClipVersions loads the version history of a clip, newest first.
+func (p *clipStorePersister) ListClipVersions(ctx context.Context, slug string) ([]core.Version, error) {
+	row, err := p.store.GetClipBySlug(ctx, slug)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	rows, err := p.store.ListClipVersions(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]core.Version, 0, len(rows))
+	for _, v := range rows {
+		out = append(out, core.Version{
+			Version:     v.Version,
+			Title:       v.Title,
+			Description: v.Description,
+			Content:     v.Content,
+			Attachments: v.Attachments,
+			CreatedBy:   v.CreatedBy,
+			CreatedAt:   v.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+func clipRecord(row *gen.Clip) *core.Record {
 	return &core.Record{
 		Slug:        row.Slug,
 		Title:       row.Title,
@@ -47,5 +154,17 @@ func (p *clipStorePersister) GetClipBySlug(ctx context.Context, slug string) (*c
 		Content:     row.Content,
 		CreatedBy:   row.CreatedBy,
 		CreatedAt:   row.CreatedAt,
-	}, nil
+		UpdatedAt:   row.UpdatedAt,
+		Version:     row.Version,
+		Attachments: row.Attachments,
+		ViewCount:   row.ViewCount,
+		BurnedAt:    row.BurnedAt,
+		Limits: core.Limits{
+			ExpiresAt:     row.ExpiresAt,
+			BurnAfterRead: row.BurnAfterRead,
+			MaxViews:      row.MaxViews,
+			PasswordHash:  row.PasswordHash,
+			LinkSecret:    row.LinkSecret,
+		},
+	}
 }
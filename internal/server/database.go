@@ -10,6 +10,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/server/chatagent"
 	"github.com/flowline-io/flowbot/internal/store"
 	"github.com/flowline-io/flowbot/internal/store/postgres"
+	"github.com/flowline-io/flowbot/internal/store/sqlite"
 	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/flog"
 )
@@ -17,6 +18,7 @@ import (
 func newDatabaseAdapter(lc fx.Lifecycle, _ *config.Type) (store.Adapter, error) {
 	// init database
 	postgres.Init()
+	sqlite.Init()
 	store.Init()
 
 	// Open database
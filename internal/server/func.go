@@ -6,12 +6,12 @@ import (
 	"github.com/flowline-io/flowbot/pkg/cache"
 )
 
-var cacheStore *cache.RedisStore
+var cacheStore cache.Store
 
 // SetCacheStore sets the cache store for server functions.
-func SetCacheStore(s *cache.RedisStore) {
+func SetCacheStore(s cache.Store) {
 	cacheStore = s
-	cache.SetDefaultRedisStore(s)
+	cache.SetDefaultStore(s)
 }
 
 type structValidator struct {
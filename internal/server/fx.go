@@ -30,7 +30,7 @@ var Modules = fx.Options(
 		config.NewConfig,
 		cache.NewCache,
 		rdb.NewClient,
-		cache.NewRedisStore,
+		cache.NewStore,
 		event.NewRouter,
 		event.NewSubscriber,
 		event.NewPublisher,
@@ -52,8 +52,10 @@ var Modules = fx.Options(
 		handleEvents,
 		initPipeline,
 		initWorkflow,
+		initSearch,
 		initFunctions,
 		initAgentAbility,
+		initAgentEmbeddings,
 		initClipAbility,
 		initGatewayAbility,
 		initChatAgentScheduler,
@@ -62,10 +64,11 @@ var Modules = fx.Options(
 		RunServer,
 		profiling.NewProfiler,
 		initPageDataCleanup,
+		startClipPurgeLoop,
 	),
 )
 
-func setServerCacheStore(store *cache.RedisStore) {
+func setServerCacheStore(store cache.Store) {
 	SetCacheStore(store)
 }
 
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,8 @@ func RegisterGatewayRoutes(a *fiber.App) {
 	a.Post("/gateway/v1/jobs/:id/result", route.Authorize(route.RequireScope(auth.ScopeGatewayWorker, gatewayComplete)))
 	a.Post("/gateway/v1/heartbeat", route.Authorize(route.RequireScope(auth.ScopeGatewayWorker, gatewayHeartbeat)))
 	a.Get("/gateway/v1/jobs/:id", route.Authorize(route.RequireScope(auth.ScopeGatewayWorker, gatewayGetJob)))
+	a.Post("/gateway/v1/jobs/:id/logs", route.Authorize(route.RequireScope(auth.ScopeGatewayWorker, gatewayAppendLog)))
+	a.Get("/gateway/v1/jobs/:id/logs", route.Authorize(route.RequireScope(auth.ScopeGatewayWorker, gatewayListLogs)))
 }
 
 func gatewayLeaseTTL() time.Duration {
@@ -41,12 +44,26 @@ func gatewayMaxOutputBytes() int {
 	return 8192
 }
 
+func gatewayMaxLogBytes() int {
+	if n := config.App.Gateway.MaxLogBytes; n > 0 {
+		return n
+	}
+	return 4 << 20
+}
+
+func gatewayLogTTL() time.Duration {
+	if ttl := config.App.Gateway.LogTTL; ttl > 0 {
+		return ttl
+	}
+	return 7 * 24 * time.Hour
+}
+
 func gatewayClaim(ctx fiber.Ctx) error {
 	var req types.GatewayClaimRequest
 	if err := sonic.Unmarshal(ctx.Body(), &req); err != nil {
 		return types.Errorf(types.ErrInvalidArgument, "invalid json body")
 	}
-	job, err := storepkg.GatewayStoreFromDB().Claim(ctx.Context(), req.WorkerID, gatewayLeaseTTL())
+	job, err := storepkg.GatewayStoreFromDB().Claim(ctx.Context(), req.WorkerID, req.CLIs, gatewayLeaseTTL())
 	if err != nil {
 		return err
 	}
@@ -92,3 +109,53 @@ func gatewayGetJob(ctx fiber.Ctx) error {
 	}
 	return ctx.JSON(protocol.NewSuccessResponse(job))
 }
+
+func gatewayAppendLog(ctx fiber.Ctx) error {
+	jobID := strings.TrimSpace(ctx.Params("id"))
+	var req types.GatewayLogAppendRequest
+	if err := sonic.Unmarshal(ctx.Body(), &req); err != nil {
+		return types.Errorf(types.ErrInvalidArgument, "invalid json body")
+	}
+	chunk, err := storepkg.GatewayStoreFromDB().AppendLog(ctx.Context(), jobID, req, gatewayMaxLogBytes())
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(map[string]any{"chunk": chunk, "dropped": chunk == nil}))
+}
+
+func gatewayListLogs(ctx fiber.Ctx) error {
+	jobID := strings.TrimSpace(ctx.Params("id"))
+	after, err := gatewayQueryInt(ctx, "after")
+	if err != nil {
+		return err
+	}
+	limit, err := gatewayQueryInt(ctx, "limit")
+	if err != nil {
+		return err
+	}
+	gs := storepkg.GatewayStoreFromDB()
+	job, err := gs.Get(ctx.Context(), jobID)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return types.Errorf(types.ErrNotFound, "job not found")
+	}
+	chunks, err := gs.Logs(ctx.Context(), jobID, after, int(limit))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(protocol.NewSuccessResponse(types.GatewayLogsResponse{Chunks: chunks, Status: job.Status}))
+}
+
+func gatewayQueryInt(ctx fiber.Ctx, key string) (int64, error) {
+	raw := strings.TrimSpace(ctx.Query(key))
+	if raw == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || n < 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid %s", key)
+	}
+	return n, nil
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/mcp"
+	"github.com/flowline-io/flowbot/pkg/route"
+	"github.com/flowline-io/flowbot/version"
+)
+
+var mcpServer = mcp.NewServer(&sdk.Implementation{Name: "flowbot", Version: version.Buildtags}, hub.Default, capability.Invoke)
+
+// mcpScopesKey carries the caller's token scopes from the fiber route into the
+// net/http MCP handler.
+type mcpScopesKey struct{}
+
+// RegisterMCPRoutes mounts the MCP streamable HTTP endpoint. Requests are
+// authorized by the router, then served by the SDK handler with the tools the
+// token's scopes allow. The handler is stateless, so GET and DELETE get 405.
+func RegisterMCPRoutes(a *fiber.App) {
+	handler := adaptor.HTTPHandlerWithContext(mcpServer.Handler(mcpRequestScopes))
+	serve := func(c fiber.Ctx) error {
+		c.SetContext(context.WithValue(c.Context(), mcpScopesKey{}, route.GetScopes(c)))
+		return handler(c)
+	}
+	a.Post("/mcp", route.Authorize(route.RequireScope(auth.ScopeHubCapabilitiesRead, serve)))
+	a.Get("/mcp", mcpMethodNotAllowed)
+	a.Delete("/mcp", mcpMethodNotAllowed)
+}
+
+func mcpRequestScopes(r *http.Request) []string {
+	ctx, ok := adaptor.LocalContextFromHTTPRequest(r)
+	if !ok {
+		return nil
+	}
+	scopes, _ := ctx.Value(mcpScopesKey{}).([]string)
+	return scopes
+}
+
+func mcpMethodNotAllowed(c fiber.Ctx) error {
+	c.Set(fiber.HeaderAllow, fiber.MethodPost)
+	return c.SendStatus(fiber.StatusMethodNotAllowed)
+}
@@ -34,7 +34,7 @@ var NotifyModules = fx.Options(
 	),
 )
 
-func initNotificationGateway(lc fx.Lifecycle, store *cache.RedisStore) {
+func initNotificationGateway(lc fx.Lifecycle, store cache.Store) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			templates, err := loadNotifyTemplatesFromDB(ctx)
@@ -54,6 +54,12 @@ func initNotificationGateway(lc fx.Lifecycle, store *cache.RedisStore) {
 			if err := notify.SeedAgentApprovalTemplate(ctx); err != nil {
 				flog.Warn("failed to seed agent.approval template: %v", err)
 			}
+			if err := notify.SeedAgentBudgetTemplate(ctx); err != nil {
+				flog.Warn("failed to seed agent.budget template: %v", err)
+			}
+			if err := notify.SeedRunApprovalTemplate(ctx); err != nil {
+				flog.Warn("failed to seed run.approval template: %v", err)
+			}
 			if err := notify.SeedLifeQuestCompletedTemplate(ctx); err != nil {
 				flog.Warn("failed to seed life.quest.completed template: %v", err)
 			}
@@ -156,6 +162,7 @@ func modelNotifyRulesToManifest(rules []model.NotifyRule) []notify.Rule {
 				Channel: r.ChannelPattern,
 			},
 			Condition: cond,
+			Timezone:  r.Timezone,
 			Priority:  r.Priority,
 			Params:    params,
 		})
@@ -41,8 +41,9 @@ func initPageDataCleanup(lc fx.Lifecycle) {
 	})
 }
 
-// runCleanup performs a single cleanup pass for expired page_data rows and
-// optional data_events retention (including related pipeline history).
+// runCleanup performs a single cleanup pass for expired page_data rows, logs
+// of finished gateway jobs, and optional data_events retention (including
+// related pipeline history).
 func runCleanup() {
 	if storepkg.Database == nil || storepkg.Database.GetClient() == nil {
 		return
@@ -60,6 +61,13 @@ func runCleanup() {
 		flog.Info("page_data cleanup: deleted %d expired rows", count)
 	}
 
+	logs, err := storepkg.NewGatewayStore(client).DeleteLogsFinishedBefore(ctx, time.Now().Add(-gatewayLogTTL()))
+	if err != nil {
+		flog.Err(fmt.Errorf("gateway log cleanup: %w", err))
+	} else if logs > 0 {
+		flog.Info("gateway log cleanup: deleted %d chunks", logs)
+	}
+
 	days := config.App.Retention.DataEventsDays
 	if days <= 0 {
 		return
@@ -249,6 +249,7 @@ func initPipeline(
 
 	registerPipelineHandler(router, subscriber, engine, ec)
 	startOutboxRedeliveryLoop(lc)
+	startApprovalTimeoutLoop(lc)
 
 	lc.Append(fx.Hook{
 		OnStop: func(_ context.Context) error {
@@ -291,6 +292,9 @@ func setupPipelineEngine(
 
 	if rdb.Client != nil {
 		engine.SetCallback(NewPipelineStepCallback(rdb.Client))
+	} else {
+		pipeline.LiveProgress = pipeline.NewMemoryProgress()
+		engine.SetCallback(pipeline.LiveProgress)
 	}
 
 	if err := registerWebhookRoutes(engine); err != nil {
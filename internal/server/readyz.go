@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v3"
 
 	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/rdb"
 )
 
@@ -25,13 +26,14 @@ func isHTTPStopping() bool {
 	return httpStopping.Load()
 }
 
-// readinessOK reports whether Postgres and Redis respond to ping and the
-// server is not shutting down.
+// readinessOK reports whether the database and Redis respond to ping and the
+// server is not shutting down. Redis is skipped with redis.backend: memory.
 func readinessOK(ctx context.Context) bool {
 	if isHTTPStopping() {
 		return false
 	}
-	if store.Database == nil || rdb.Client == nil {
+	inMemory := config.App.Redis.InMemory()
+	if store.Database == nil || (rdb.Client == nil && !inMemory) {
 		return false
 	}
 	pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
@@ -39,6 +41,9 @@ func readinessOK(ctx context.Context) bool {
 	if _, err := store.Database.Ping(pingCtx); err != nil {
 		return false
 	}
+	if inMemory {
+		return true
+	}
 	if err := rdb.Client.Ping(pingCtx).Err(); err != nil {
 		return false
 	}
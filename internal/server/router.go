@@ -72,6 +72,7 @@ func handleRoutes(a *fiber.App, ctl *Controller) {
 	RegisterChatAgentSignedMediaRoute(a)
 	RegisterGatewayRoutes(a)
 	RegisterAgentLLMRoutes(a)
+	RegisterMCPRoutes(a)
 	// platform
 	a.All("/platform/:platform", ctl.platformCallback)
 }
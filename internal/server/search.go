@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bytedance/sonic"
+	"go.uber.org/fx"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/search"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// searchSyncInterval is how often collector-backed sources are re-indexed.
+const searchSyncInterval = 10 * time.Minute
+
+// initSearch builds the unified search service, indexes DataEvents as they
+// arrive and periodically re-indexes sources that emit no events.
+func initSearch(lc fx.Lifecycle, router *message.Router, subscriber message.Subscriber) {
+	if store.Database == nil || store.Database.GetClient() == nil {
+		flog.Warn("search service skipped: store.Database not ready")
+		return
+	}
+
+	svc := search.NewService(store.SearchStoreFromDB()).
+		WithInvoker(capability.Invoke).
+		WithCollector(search.SourceClip, collectClips).
+		WithCollector(search.SourceKnowledge, collectKnowledge).
+		WithCollector(search.SourceMemo, search.MemoCollector(capability.Invoke))
+	search.SetActiveService(svc)
+	registerSearchEventHandler(router, subscriber, svc)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				runSearchSync(ctx, svc)
+				ticker := time.NewTicker(searchSyncInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						runSearchSync(ctx, svc)
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			search.SetActiveService(nil)
+			return nil
+		},
+	})
+
+	flog.Info("search service initialized (sync interval=%s)", searchSyncInterval)
+}
+
+// registerSearchEventHandler indexes bookmarks, feed entries, notes, tasks and
+// issues from the DataEvent stream.
+func registerSearchEventHandler(router *message.Router, subscriber message.Subscriber, svc *search.Service) {
+	router.AddConsumerHandler(
+		"onSearchDataEvent",
+		DataEventTopic,
+		subscriber,
+		func(msg *message.Message) error {
+			var dataEvent types.DataEvent
+			if err := sonic.Unmarshal(msg.Payload, &dataEvent); err != nil {
+				return fmt.Errorf("unmarshal data event: %w", err)
+			}
+			ctx, cancel := context.WithTimeout(msg.Context(), time.Minute)
+			defer cancel()
+			return svc.HandleEvent(ctx, dataEvent)
+		},
+	)
+}
+
+func runSearchSync(ctx context.Context, svc *search.Service) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	if err := svc.Sync(ctx); err != nil {
+		flog.Warn("%v", err)
+	}
+}
+
+// collectClips indexes every active clip under its public /c/<slug> URL.
+// Expired, burned and exhausted clips drop out of the index. Password-protected
+// clips are indexed by title and description only, so search never reveals
+// their body.
+func collectClips(ctx context.Context) ([]search.Document, error) {
+	clips, err := store.ClipStoreFromDB().ListClips(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	docs := make([]search.Document, 0, len(clips))
+	for _, c := range clips {
+		if types.ClipStateAt(c.ExpiresAt, c.BurnedAt, c.MaxViews, c.ViewCount, now) != types.ClipActive {
+			continue
+		}
+		content := c.Description
+		if c.Content != "" && c.PasswordHash == "" {
+			content += "\n" + c.Content
+		}
+		docs = append(docs, search.Document{
+			ID:        c.Slug,
+			Title:     c.Title,
+			Content:   content,
+			URL:       "/c/" + c.Slug,
+			UpdatedAt: clipUpdatedAt(c),
+		})
+	}
+	return docs, nil
+}
+
+// collectKnowledge indexes agent knowledge entries, linking to their editor.
+func collectKnowledge(ctx context.Context) ([]search.Document, error) {
+	items, err := store.AgentStoreFromDB().ListAgentKnowledge(ctx, store.AgentKnowledgeListFilter{})
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]search.Document, 0, len(items))
+	for _, k := range items {
+		title := k.Title
+		if title == "" {
+			title = k.Path
+		}
+		content := k.Summary
+		if k.Content != "" {
+			content += "\n" + k.Content
+		}
+		docs = append(docs, search.Document{
+			ID:        strconv.FormatInt(k.ID, 10),
+			Title:     title,
+			Content:   content,
+			URL:       fmt.Sprintf("/service/web/agent-knowledge/%d/edit", k.ID),
+			Tags:      k.Tags,
+			UpdatedAt: k.UpdatedAt,
+		})
+	}
+	return docs, nil
+}
+
+func clipUpdatedAt(c *gen.Clip) time.Time {
+	if c.UpdatedAt != nil {
+		return *c.UpdatedAt
+	}
+	return c.CreatedAt
+}
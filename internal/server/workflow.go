@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v3"
 	"go.uber.org/fx"
@@ -25,6 +26,8 @@ import (
 
 func initWorkflow(
 	lc fx.Lifecycle,
+	router *message.Router,
+	subscriber message.Subscriber,
 	auditor audit.Auditor,
 	wc *metrics.WorkflowCollector,
 ) error {
@@ -35,17 +38,21 @@ func initWorkflow(
 
 	catalog := store.NewWorkflowCatalogAdapter(store.WorkflowStoreFromDB())
 	runs := store.NewWorkflowRunStoreAdapter(store.NewWorkflowRunStore(store.Database.GetClient()))
-	svc := workflow.NewService(catalog, runs, auditor, wc)
+	svc := workflow.NewService(catalog, runs, auditor, wc).
+		WithConsumptions(store.PipelineStoreFromDB())
 	if err := svc.ReloadTriggers(context.Background()); err != nil {
 		return fmt.Errorf("reload workflow triggers: %w", err)
 	}
 	workflow.SetReloadService(svc)
+	pipeline.SetWorkflowCaller(svc)
 	registerWorkflowWebhookRoutes(svc)
+	registerWorkflowEventHandler(router, subscriber, svc)
 
 	lc.Append(fx.Hook{
 		OnStop: func(_ context.Context) error {
 			svc.Stop()
 			workflow.SetReloadService(nil)
+			pipeline.SetWorkflowCaller(nil)
 			return nil
 		},
 	})
@@ -54,6 +61,26 @@ func initWorkflow(
 	return nil
 }
 
+// registerWorkflowEventHandler subscribes workflow event triggers to the
+// DataEvent topic consumed by the pipeline engine. It is a separate consumer so
+// a slow workflow start never delays pipeline handling.
+func registerWorkflowEventHandler(router *message.Router, subscriber message.Subscriber, svc *workflow.Service) {
+	router.AddConsumerHandler(
+		"onWorkflowDataEvent",
+		DataEventTopic,
+		subscriber,
+		func(msg *message.Message) error {
+			var dataEvent types.DataEvent
+			if err := sonic.Unmarshal(msg.Payload, &dataEvent); err != nil {
+				return fmt.Errorf("unmarshal data event: %w", err)
+			}
+			ctx, cancel := context.WithTimeout(msg.Context(), time.Minute)
+			defer cancel()
+			return svc.HandleEvent(ctx, dataEvent)
+		},
+	)
+}
+
 // registerWorkflowWebhookRoutes mounts a catch-all handler under /webhook/workflow/*
 // so ReloadTriggers can update endpoints without re-registering Fiber routes.
 func registerWorkflowWebhookRoutes(svc *workflow.Service) {
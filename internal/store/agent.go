@@ -668,6 +668,19 @@ func (s *AgentStore) GetAgentKnowledgeByID(ctx context.Context, id int64) (*gen.
 	return row, nil
 }
 
+// ListAgentKnowledgeByIDs returns the agent knowledge with the given ids, in no
+// particular order. Missing ids are skipped.
+func (s *AgentStore) ListAgentKnowledgeByIDs(ctx context.Context, ids []int64) ([]*gen.AgentKnowledge, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := s.client.AgentKnowledge.Query().Where(agentknowledge.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list agent knowledge by ids: %w", err)
+	}
+	return rows, nil
+}
+
 // CreateAgentKnowledge persists a new agent knowledge.
 func (s *AgentStore) CreateAgentKnowledge(ctx context.Context, doc *gen.AgentKnowledge) error {
 	if doc == nil {
@@ -815,6 +828,15 @@ func (s *AgentStore) ListAgentMemoryFacts(ctx context.Context, scope string) ([]
 	return rows, nil
 }
 
+// ListAllAgentMemoryFacts returns the agent memory facts of every scope.
+func (s *AgentStore) ListAllAgentMemoryFacts(ctx context.Context) ([]*gen.AgentMemoryFact, error) {
+	rows, err := s.client.AgentMemoryFact.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list all agent memory facts: %w", err)
+	}
+	return rows, nil
+}
+
 // DeleteAgentMemoryFact deletes the agent memory fact.
 func (s *AgentStore) DeleteAgentMemoryFact(ctx context.Context, scope, key string) error {
 	n, err := s.client.AgentMemoryFact.Delete().
@@ -1122,6 +1144,24 @@ func (s *AgentStore) ListAgentSessionSummaries(ctx context.Context, filter Agent
 	return rows, nil
 }
 
+// ListReadyAgentSessionSummariesByIDs returns the ready agent session summaries
+// with the given ids, in no particular order. Missing ids are skipped.
+func (s *AgentStore) ListReadyAgentSessionSummariesByIDs(ctx context.Context, ids []int64) ([]*gen.AgentSessionSummary, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := s.client.AgentSessionSummary.Query().
+		Where(
+			agentsessionsummary.IDIn(ids...),
+			agentsessionsummary.StatusEQ(schema.AgentSessionSummaryReady),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list session summaries by ids: %w", err)
+	}
+	return rows, nil
+}
+
 // RequeueStaleAgentSessionSummaryPending requeues stale agent session summary pending.
 func (s *AgentStore) RequeueStaleAgentSessionSummaryPending(ctx context.Context, olderThan time.Duration) (int, error) {
 	if olderThan <= 0 {
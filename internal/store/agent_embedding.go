@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentembedding"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+)
+
+// ---------------------------------------------------------------------------
+// AgentEmbeddingStore
+// ---------------------------------------------------------------------------
+
+// AgentEmbeddingStore persists agent retrieval vectors. It implements embed.Store.
+type AgentEmbeddingStore struct {
+	client *gen.Client
+}
+
+// NewAgentEmbeddingStore creates an AgentEmbeddingStore with the given ent client.
+func NewAgentEmbeddingStore(client *gen.Client) *AgentEmbeddingStore {
+	return &AgentEmbeddingStore{client: client}
+}
+
+// AgentEmbeddingStoreFromDB returns an AgentEmbeddingStore using the global database client.
+func AgentEmbeddingStoreFromDB() *AgentEmbeddingStore {
+	return NewAgentEmbeddingStore(ClientFromDB())
+}
+
+var _ embed.Store = (*AgentEmbeddingStore)(nil)
+
+// ListEmbeddingHashes returns the content hash of every vector of kind by ref ID.
+func (s *AgentEmbeddingStore) ListEmbeddingHashes(ctx context.Context, kind string) (map[int64]string, error) {
+	out := map[int64]string{}
+	if s == nil || s.client == nil {
+		return out, nil
+	}
+	rows, err := s.client.AgentEmbedding.Query().
+		Where(agentembedding.KindEQ(kind)).
+		Select(agentembedding.FieldRefID, agentembedding.FieldContentHash).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list agent embedding hashes: %w", err)
+	}
+	for _, row := range rows {
+		out[row.RefID] = row.ContentHash
+	}
+	return out, nil
+}
+
+// UpsertEmbeddings inserts vectors or replaces them by (kind, ref_id).
+func (s *AgentEmbeddingStore) UpsertEmbeddings(ctx context.Context, vectors []embed.Vector) error {
+	if s == nil || s.client == nil || len(vectors) == 0 {
+		return nil
+	}
+	builders := make([]*gen.AgentEmbeddingCreate, 0, len(vectors))
+	for _, v := range vectors {
+		builders = append(builders, s.client.AgentEmbedding.Create().
+			SetKind(v.Kind).
+			SetRefID(v.RefID).
+			SetScope(v.Scope).
+			SetModel(v.Model).
+			SetContentHash(v.Hash).
+			SetVector(embed.EncodeVector(v.Vector)))
+	}
+	err := s.client.AgentEmbedding.CreateBulk(builders...).
+		OnConflictColumns(agentembedding.FieldKind, agentembedding.FieldRefID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres: upsert agent embeddings: %w", err)
+	}
+	return nil
+}
+
+// DeleteEmbeddings removes the vectors of kind for refIDs.
+func (s *AgentEmbeddingStore) DeleteEmbeddings(ctx context.Context, kind string, refIDs []int64) error {
+	if s == nil || s.client == nil || len(refIDs) == 0 {
+		return nil
+	}
+	_, err := s.client.AgentEmbedding.Delete().
+		Where(agentembedding.KindEQ(kind), agentembedding.RefIDIn(refIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres: delete agent embeddings: %w", err)
+	}
+	return nil
+}
+
+// ListEmbeddings returns the vectors of kind built by model, limited to scope
+// when it is non-empty.
+func (s *AgentEmbeddingStore) ListEmbeddings(ctx context.Context, kind, scope, model string) ([]embed.Vector, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	query := s.client.AgentEmbedding.Query().
+		Where(agentembedding.KindEQ(kind), agentembedding.ModelEQ(model))
+	if scope != "" {
+		query = query.Where(agentembedding.ScopeEQ(scope))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list agent embeddings: %w", err)
+	}
+	out := make([]embed.Vector, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, embed.Vector{
+			Kind:   row.Kind,
+			RefID:  row.RefID,
+			Scope:  row.Scope,
+			Model:  row.Model,
+			Hash:   row.ContentHash,
+			Vector: embed.DecodeVector(row.Vector),
+		})
+	}
+	return out, nil
+}
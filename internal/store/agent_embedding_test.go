@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store/sqlitetest"
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+)
+
+func TestAgentEmbeddingStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewAgentEmbeddingStore(sqlitetest.OpenClient(t, "agent_embeddings"))
+
+	require.NoError(t, s.UpsertEmbeddings(ctx, []embed.Vector{
+		{Kind: embed.KindMemoryFact, RefID: 1, Scope: "a", Model: "m", Hash: "h1", Vector: []float32{1, 0}},
+		{Kind: embed.KindMemoryFact, RefID: 2, Scope: "b", Model: "m", Hash: "h2", Vector: []float32{0, 1}},
+		{Kind: embed.KindKnowledge, RefID: 1, Model: "m", Hash: "k1", Vector: []float32{1, 1}},
+	}))
+
+	hashes, err := s.ListEmbeddingHashes(ctx, embed.KindMemoryFact)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{1: "h1", 2: "h2"}, hashes)
+
+	rows, err := s.ListEmbeddings(ctx, embed.KindMemoryFact, "a", "m")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []float32{1, 0}, rows[0].Vector)
+
+	rows, err = s.ListEmbeddings(ctx, embed.KindMemoryFact, "", "other")
+	require.NoError(t, err)
+	assert.Empty(t, rows, "vectors of another model are not comparable")
+
+	// Upsert replaces by (kind, ref_id).
+	require.NoError(t, s.UpsertEmbeddings(ctx, []embed.Vector{
+		{Kind: embed.KindMemoryFact, RefID: 1, Scope: "b", Model: "m", Hash: "h1b", Vector: []float32{0.5, 0.5}},
+	}))
+	rows, err = s.ListEmbeddings(ctx, embed.KindMemoryFact, "b", "m")
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	require.NoError(t, s.DeleteEmbeddings(ctx, embed.KindMemoryFact, []int64{1, 2}))
+	hashes, err = s.ListEmbeddingHashes(ctx, embed.KindMemoryFact)
+	require.NoError(t, err)
+	assert.Empty(t, hashes)
+	hashes, err = s.ListEmbeddingHashes(ctx, embed.KindKnowledge)
+	require.NoError(t, err)
+	assert.Len(t, hashes, 1, "other kinds are untouched")
+}
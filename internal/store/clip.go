@@ -3,9 +3,18 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/clipversion"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
+	"github.com/flowline-io/flowbot/pkg/search"
+	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 // ---------------------------------------------------------------------------
@@ -27,21 +36,177 @@ func ClipStoreFromDB() *ClipStore {
 	return NewClipStore(ClientFromDB())
 }
 
-// CreateClip inserts a new clip row.
+// ClipCreateInput holds the fields of a new clip. Zero limits mean none.
+type ClipCreateInput struct {
+	Slug          string
+	Title         string
+	Description   string
+	Content       string
+	CreatedBy     string
+	Attachments   []types.ClipAttachment
+	ExpiresAt     *time.Time
+	BurnAfterRead bool
+	MaxViews      int
+	// PasswordHash is a bcrypt hash; empty when no password is required.
+	PasswordHash string
+	// LinkSecret signs share links; empty when no signed link is required.
+	LinkSecret string
+}
+
+// ClipUpdateInput holds an edit to an existing clip.
+type ClipUpdateInput struct {
+	Slug string
+	// BaseVersion is the version the edit was made against; 0 edits the current one.
+	BaseVersion int
+	Title       string
+	Description string
+	Content     string
+	Attachments []types.ClipAttachment
+	EditedBy    string
+}
+
+// CreateClip inserts a new clip row without access limits.
 func (s *ClipStore) CreateClip(ctx context.Context, slug, title, description, content, createdBy string) error {
-	if s == nil || s.client == nil {
-		return nil
-	}
-	_, err := s.client.Clip.Create().
-		SetSlug(slug).
-		SetTitle(title).
-		SetDescription(description).
-		SetContent(content).
-		SetCreatedBy(createdBy).
-		Save(ctx)
+	_, err := s.CreateClipFromInput(ctx, ClipCreateInput{
+		Slug:        slug,
+		Title:       title,
+		Description: description,
+		Content:     content,
+		CreatedBy:   createdBy,
+	})
 	return err
 }
 
+// CreateClipFromInput inserts a new clip and its version 1 snapshot in one transaction.
+func (s *ClipStore) CreateClipFromInput(ctx context.Context, in ClipCreateInput) (*gen.Clip, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create clip begin tx: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	create := tx.Clip.Create().
+		SetSlug(in.Slug).
+		SetTitle(in.Title).
+		SetDescription(in.Description).
+		SetContent(in.Content).
+		SetCreatedBy(in.CreatedBy).
+		SetNillableExpiresAt(in.ExpiresAt).
+		SetBurnAfterRead(in.BurnAfterRead).
+		SetMaxViews(in.MaxViews).
+		SetPasswordHash(in.PasswordHash).
+		SetLinkSecret(in.LinkSecret)
+	if len(in.Attachments) > 0 {
+		create = create.SetAttachments(in.Attachments)
+	}
+	row, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := createClipVersion(ctx, tx, row, in.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("create clip commit: %w", err)
+	}
+	committed = true
+	return row, nil
+}
+
+// UpdateClip replaces the body of a clip, bumps its version and snapshots the
+// result. Returns types.ErrNotFound for a missing clip and types.ErrConflict
+// when BaseVersion is stale or the clip was burned meanwhile.
+func (s *ClipStore) UpdateClip(ctx context.Context, in ClipUpdateInput) (*gen.Clip, error) {
+	if s == nil || s.client == nil {
+		return nil, types.ErrNotFound
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update clip begin tx: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	row, err := tx.Clip.Query().Where(clip.SlugEQ(in.Slug)).Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, types.ErrNotFound
+		}
+		return nil, err
+	}
+	if in.BaseVersion > 0 && in.BaseVersion != row.Version {
+		return nil, types.ErrConflict
+	}
+	update := tx.Clip.Update().
+		Where(
+			clip.ID(row.ID),
+			clip.Version(row.Version),
+			clip.BurnedAtIsNil(),
+		).
+		SetTitle(in.Title).
+		SetDescription(in.Description).
+		SetContent(in.Content).
+		SetVersion(row.Version + 1).
+		SetUpdatedAt(time.Now())
+	if len(in.Attachments) > 0 {
+		update = update.SetAttachments(in.Attachments)
+	} else {
+		update = update.ClearAttachments()
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, types.ErrConflict
+	}
+	row, err = tx.Clip.Get(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	editedBy := in.EditedBy
+	if editedBy == "" {
+		editedBy = row.CreatedBy
+	}
+	if err := createClipVersion(ctx, tx, row, editedBy); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("update clip commit: %w", err)
+	}
+	committed = true
+	return row, nil
+}
+
+func createClipVersion(ctx context.Context, tx *gen.Tx, row *gen.Clip, createdBy string) error {
+	create := tx.ClipVersion.Create().
+		SetClipID(row.ID).
+		SetVersion(row.Version).
+		SetTitle(row.Title).
+		SetDescription(row.Description).
+		SetContent(row.Content).
+		SetCreatedBy(createdBy)
+	if len(row.Attachments) > 0 {
+		create = create.SetAttachments(row.Attachments)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		return fmt.Errorf("insert clip version snapshot: %w", err)
+	}
+	return nil
+}
+
 // GetClipBySlug retrieves a clip by slug. Returns nil if not found.
 func (s *ClipStore) GetClipBySlug(ctx context.Context, slug string) (*gen.Clip, error) {
 	if s == nil || s.client == nil {
@@ -71,3 +236,153 @@ func (s *ClipStore) ListClips(ctx context.Context, limit int) ([]*gen.Clip, erro
 	}
 	return q.All(ctx)
 }
+
+// ListClipVersions returns the version snapshots of a clip, newest first.
+func (s *ClipStore) ListClipVersions(ctx context.Context, clipID int64) ([]*gen.ClipVersion, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	return s.client.ClipVersion.Query().
+		Where(clipversion.ClipID(clipID)).
+		Order(gen.Desc(clipversion.FieldVersion)).
+		All(ctx)
+}
+
+// GetClipVersion returns one version snapshot of a clip. Returns nil if not found.
+func (s *ClipStore) GetClipVersion(ctx context.Context, clipID int64, version int) (*gen.ClipVersion, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	row, err := s.client.ClipVersion.Query().
+		Where(
+			clipversion.ClipID(clipID),
+			clipversion.Version(version),
+		).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row, nil
+}
+
+// ConsumeClipView counts one read of row at now. The conditional UPDATE only
+// matches a clip that is still active, so concurrent readers cannot exceed
+// max_views. A burn-after-read clip is burned in the same transaction: its
+// body, description and version history are deleted. Attachments stay listed
+// until PurgeClips removes their files. A burned or exhausted clip also
+// leaves the unified search index in the same transaction. Returns false when
+// the clip is no longer readable.
+func (s *ClipStore) ConsumeClipView(ctx context.Context, row *gen.Clip, now time.Time) (bool, error) {
+	if s == nil || s.client == nil || row == nil {
+		return false, nil
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("consume clip view begin tx: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	update := tx.Clip.Update().
+		Where(
+			clip.ID(row.ID),
+			clip.BurnedAtIsNil(),
+			clip.Or(clip.ExpiresAtIsNil(), clip.ExpiresAtGT(now)),
+			clip.Or(clip.MaxViews(0), predicate.Clip(sql.FieldsLT(clip.FieldViewCount, clip.FieldMaxViews))),
+		).
+		AddViewCount(1)
+	if row.BurnAfterRead {
+		update = update.SetBurnedAt(now).SetContent("").SetDescription("")
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	if row.BurnAfterRead {
+		if _, err := tx.ClipVersion.Delete().Where(clipversion.ClipID(row.ID)).Exec(ctx); err != nil {
+			return false, fmt.Errorf("burn clip versions: %w", err)
+		}
+	}
+	over := row.BurnAfterRead
+	if !over && row.MaxViews > 0 {
+		fresh, err := tx.Clip.Get(ctx, row.ID)
+		if err != nil {
+			return false, fmt.Errorf("consume clip view reload: %w", err)
+		}
+		over = fresh.ViewCount >= fresh.MaxViews
+	}
+	if over {
+		// Drop the clip from unified search now instead of at the next sync.
+		if _, err := tx.SearchDocument.Delete().
+			Where(searchdocument.SourceEQ(search.SourceClip), searchdocument.DocIDEQ(row.Slug)).
+			Exec(ctx); err != nil {
+			return false, fmt.Errorf("unindex consumed clip: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("consume clip view commit: %w", err)
+	}
+	committed = true
+	return true, nil
+}
+
+// PurgeClips removes what burned and expired clips still hold once they have
+// been over for at least grace (the lifetime of attachment links handed to
+// the last reader). Expired clips lose their body, description and version
+// history; both lose their attachment list. The clip rows stay so the list
+// can show their state and views. Returns the detached attachments so the
+// caller can delete the stored files.
+func (s *ClipStore) PurgeClips(ctx context.Context, now time.Time, grace time.Duration) ([]types.ClipAttachment, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	cutoff := now.Add(-grace)
+	rows, err := s.client.Clip.Query().
+		Where(clip.Or(
+			clip.And(clip.BurnedAtNotNil(), clip.BurnedAtLT(cutoff), clip.AttachmentsNotNil()),
+			clip.And(
+				clip.BurnedAtIsNil(),
+				clip.ExpiresAtNotNil(),
+				clip.ExpiresAtLT(cutoff),
+				clip.Or(clip.ContentNEQ(""), clip.AttachmentsNotNil()),
+			),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var detached []types.ClipAttachment
+	for _, row := range rows {
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			return detached, fmt.Errorf("purge clip begin tx: %w", err)
+		}
+		update := tx.Clip.UpdateOneID(row.ID).ClearAttachments()
+		if row.BurnedAt == nil {
+			update = update.SetContent("").SetDescription("")
+		}
+		if err := update.Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return detached, fmt.Errorf("purge clip %s: %w", row.Slug, err)
+		}
+		if _, err := tx.ClipVersion.Delete().Where(clipversion.ClipID(row.ID)).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return detached, fmt.Errorf("purge clip %s versions: %w", row.Slug, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return detached, fmt.Errorf("purge clip %s commit: %w", row.Slug, err)
+		}
+		detached = append(detached, row.Attachments...)
+	}
+	return detached, nil
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentembedding"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
+)
+
+// AgentEmbeddingDelete is the builder for deleting a AgentEmbedding entity.
+type AgentEmbeddingDelete struct {
+	config
+	hooks    []Hook
+	mutation *AgentEmbeddingMutation
+}
+
+// Where appends a list predicates to the AgentEmbeddingDelete builder.
+func (_d *AgentEmbeddingDelete) Where(ps ...predicate.AgentEmbedding) *AgentEmbeddingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AgentEmbeddingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AgentEmbeddingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AgentEmbeddingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(agentembedding.Table, sqlgraph.NewFieldSpec(agentembedding.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AgentEmbeddingDeleteOne is the builder for deleting a single AgentEmbedding entity.
+type AgentEmbeddingDeleteOne struct {
+	_d *AgentEmbeddingDelete
+}
+
+// Where appends a list predicates to the AgentEmbeddingDelete builder.
+func (_d *AgentEmbeddingDeleteOne) Where(ps ...predicate.AgentEmbedding) *AgentEmbeddingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AgentEmbeddingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{agentembedding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AgentEmbeddingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -19,8 +19,9 @@ import (
 // AgentKnowledgeUpdate is the builder for updating AgentKnowledge entities.
 type AgentKnowledgeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentKnowledgeMutation
+	hooks     []Hook
+	mutation  *AgentKnowledgeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentKnowledgeUpdate builder.
@@ -164,6 +165,12 @@ func (_u *AgentKnowledgeUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentKnowledgeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentKnowledgeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentKnowledgeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -199,6 +206,7 @@ func (_u *AgentKnowledgeUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentknowledge.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentknowledge.Label}
@@ -214,9 +222,10 @@ func (_u *AgentKnowledgeUpdate) sqlSave(ctx context.Context) (_node int, err err
 // AgentKnowledgeUpdateOne is the builder for updating a single AgentKnowledge entity.
 type AgentKnowledgeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentKnowledgeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentKnowledgeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPath sets the "path" field.
@@ -367,6 +376,12 @@ func (_u *AgentKnowledgeUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentKnowledgeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentKnowledgeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentKnowledgeUpdateOne) sqlSave(ctx context.Context) (_node *AgentKnowledge, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -419,6 +434,7 @@ func (_u *AgentKnowledgeUpdateOne) sqlSave(ctx context.Context) (_node *AgentKno
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentknowledge.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentKnowledge{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
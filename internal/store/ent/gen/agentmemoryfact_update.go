@@ -18,8 +18,9 @@ import (
 // AgentMemoryFactUpdate is the builder for updating AgentMemoryFact entities.
 type AgentMemoryFactUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentMemoryFactMutation
+	hooks     []Hook
+	mutation  *AgentMemoryFactMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentMemoryFactUpdate builder.
@@ -151,6 +152,12 @@ func (_u *AgentMemoryFactUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentMemoryFactUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentMemoryFactUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentMemoryFactUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -178,6 +185,7 @@ func (_u *AgentMemoryFactUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentmemoryfact.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentmemoryfact.Label}
@@ -193,9 +201,10 @@ func (_u *AgentMemoryFactUpdate) sqlSave(ctx context.Context) (_node int, err er
 // AgentMemoryFactUpdateOne is the builder for updating a single AgentMemoryFact entity.
 type AgentMemoryFactUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentMemoryFactMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentMemoryFactMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetScope sets the "scope" field.
@@ -334,6 +343,12 @@ func (_u *AgentMemoryFactUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentMemoryFactUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentMemoryFactUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentMemoryFactUpdateOne) sqlSave(ctx context.Context) (_node *AgentMemoryFact, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -378,6 +393,7 @@ func (_u *AgentMemoryFactUpdateOne) sqlSave(ctx context.Context) (_node *AgentMe
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentmemoryfact.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentMemoryFact{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // AgentPlanUpdate is the builder for updating AgentPlan entities.
 type AgentPlanUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentPlanMutation
+	hooks     []Hook
+	mutation  *AgentPlanMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentPlanUpdate builder.
@@ -170,6 +171,12 @@ func (_u *AgentPlanUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentPlanUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentPlanUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -200,6 +207,7 @@ func (_u *AgentPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentplan.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentplan.Label}
@@ -215,9 +223,10 @@ func (_u *AgentPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AgentPlanUpdateOne is the builder for updating a single AgentPlan entity.
 type AgentPlanUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentPlanMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentPlanMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -375,6 +384,12 @@ func (_u *AgentPlanUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentPlanUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentPlanUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentPlanUpdateOne) sqlSave(ctx context.Context) (_node *AgentPlan, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -422,6 +437,7 @@ func (_u *AgentPlanUpdateOne) sqlSave(ctx context.Context) (_node *AgentPlan, er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentplan.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentPlan{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
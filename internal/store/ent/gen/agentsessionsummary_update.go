@@ -18,8 +18,9 @@ import (
 // AgentSessionSummaryUpdate is the builder for updating AgentSessionSummary entities.
 type AgentSessionSummaryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentSessionSummaryMutation
+	hooks     []Hook
+	mutation  *AgentSessionSummaryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentSessionSummaryUpdate builder.
@@ -213,6 +214,12 @@ func (_u *AgentSessionSummaryUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSessionSummaryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSessionSummaryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSessionSummaryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -255,6 +262,7 @@ func (_u *AgentSessionSummaryUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentsessionsummary.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentsessionsummary.Label}
@@ -270,9 +278,10 @@ func (_u *AgentSessionSummaryUpdate) sqlSave(ctx context.Context) (_node int, er
 // AgentSessionSummaryUpdateOne is the builder for updating a single AgentSessionSummary entity.
 type AgentSessionSummaryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentSessionSummaryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentSessionSummaryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSessionFlag sets the "session_flag" field.
@@ -473,6 +482,12 @@ func (_u *AgentSessionSummaryUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSessionSummaryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSessionSummaryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSessionSummaryUpdateOne) sqlSave(ctx context.Context) (_node *AgentSessionSummary, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -532,6 +547,7 @@ func (_u *AgentSessionSummaryUpdateOne) sqlSave(ctx context.Context) (_node *Age
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentsessionsummary.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentSessionSummary{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // AgentSkillUpdate is the builder for updating AgentSkill entities.
 type AgentSkillUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentSkillMutation
+	hooks     []Hook
+	mutation  *AgentSkillMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentSkillUpdate builder.
@@ -212,6 +213,12 @@ func (_u *AgentSkillUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSkillUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSkillUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSkillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -251,6 +258,7 @@ func (_u *AgentSkillUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentskill.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentskill.Label}
@@ -266,9 +274,10 @@ func (_u *AgentSkillUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // AgentSkillUpdateOne is the builder for updating a single AgentSkill entity.
 type AgentSkillUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentSkillMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentSkillMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -468,6 +477,12 @@ func (_u *AgentSkillUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSkillUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSkillUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSkillUpdateOne) sqlSave(ctx context.Context) (_node *AgentSkill, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -524,6 +539,7 @@ func (_u *AgentSkillUpdateOne) sqlSave(ctx context.Context) (_node *AgentSkill,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentskill.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentSkill{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
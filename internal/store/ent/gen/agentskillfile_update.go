@@ -18,8 +18,9 @@ import (
 // AgentSkillFileUpdate is the builder for updating AgentSkillFile entities.
 type AgentSkillFileUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentSkillFileMutation
+	hooks     []Hook
+	mutation  *AgentSkillFileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentSkillFileUpdate builder.
@@ -137,6 +138,12 @@ func (_u *AgentSkillFileUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSkillFileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSkillFileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSkillFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -161,6 +168,7 @@ func (_u *AgentSkillFileUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentskillfile.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentskillfile.Label}
@@ -176,9 +184,10 @@ func (_u *AgentSkillFileUpdate) sqlSave(ctx context.Context) (_node int, err err
 // AgentSkillFileUpdateOne is the builder for updating a single AgentSkillFile entity.
 type AgentSkillFileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentSkillFileMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentSkillFileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSkillFlag sets the "skill_flag" field.
@@ -303,6 +312,12 @@ func (_u *AgentSkillFileUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSkillFileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSkillFileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSkillFileUpdateOne) sqlSave(ctx context.Context) (_node *AgentSkillFile, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -344,6 +359,7 @@ func (_u *AgentSkillFileUpdateOne) sqlSave(ctx context.Context) (_node *AgentSki
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentskillfile.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentSkillFile{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
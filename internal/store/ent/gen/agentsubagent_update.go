@@ -19,8 +19,9 @@ import (
 // AgentSubagentUpdate is the builder for updating AgentSubagent entities.
 type AgentSubagentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentSubagentMutation
+	hooks     []Hook
+	mutation  *AgentSubagentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentSubagentUpdate builder.
@@ -235,6 +236,12 @@ func (_u *AgentSubagentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSubagentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSubagentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSubagentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -293,6 +300,7 @@ func (_u *AgentSubagentUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentsubagent.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentsubagent.Label}
@@ -308,9 +316,10 @@ func (_u *AgentSubagentUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // AgentSubagentUpdateOne is the builder for updating a single AgentSubagent entity.
 type AgentSubagentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentSubagentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentSubagentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -532,6 +541,12 @@ func (_u *AgentSubagentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSubagentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSubagentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSubagentUpdateOne) sqlSave(ctx context.Context) (_node *AgentSubagent, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -607,6 +622,7 @@ func (_u *AgentSubagentUpdateOne) sqlSave(ctx context.Context) (_node *AgentSuba
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentsubagent.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentSubagent{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // AgentSubagentTaskUpdate is the builder for updating AgentSubagentTask entities.
 type AgentSubagentTaskUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentSubagentTaskMutation
+	hooks     []Hook
+	mutation  *AgentSubagentTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentSubagentTaskUpdate builder.
@@ -234,6 +235,12 @@ func (_u *AgentSubagentTaskUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSubagentTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSubagentTaskUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSubagentTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -282,6 +289,7 @@ func (_u *AgentSubagentTaskUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentsubagenttask.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agentsubagenttask.Label}
@@ -297,9 +305,10 @@ func (_u *AgentSubagentTaskUpdate) sqlSave(ctx context.Context) (_node int, err
 // AgentSubagentTaskUpdateOne is the builder for updating a single AgentSubagentTask entity.
 type AgentSubagentTaskUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentSubagentTaskMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentSubagentTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSessionID sets the "session_id" field.
@@ -521,6 +530,12 @@ func (_u *AgentSubagentTaskUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentSubagentTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentSubagentTaskUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentSubagentTaskUpdateOne) sqlSave(ctx context.Context) (_node *AgentSubagentTask, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -586,6 +601,7 @@ func (_u *AgentSubagentTaskUpdateOne) sqlSave(ctx context.Context) (_node *Agent
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agentsubagenttask.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentSubagentTask{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // AgentTodoUpdate is the builder for updating AgentTodo entities.
 type AgentTodoUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AgentTodoMutation
+	hooks     []Hook
+	mutation  *AgentTodoMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AgentTodoUpdate builder.
@@ -196,6 +197,12 @@ func (_u *AgentTodoUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentTodoUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentTodoUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentTodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -232,6 +239,7 @@ func (_u *AgentTodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agenttodo.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agenttodo.Label}
@@ -247,9 +255,10 @@ func (_u *AgentTodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AgentTodoUpdateOne is the builder for updating a single AgentTodo entity.
 type AgentTodoUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AgentTodoMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AgentTodoMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -433,6 +442,12 @@ func (_u *AgentTodoUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AgentTodoUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AgentTodoUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AgentTodoUpdateOne) sqlSave(ctx context.Context) (_node *AgentTodo, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -486,6 +501,7 @@ func (_u *AgentTodoUpdateOne) sqlSave(ctx context.Context) (_node *AgentTodo, er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(agenttodo.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AgentTodo{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
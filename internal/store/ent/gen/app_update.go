@@ -18,8 +18,9 @@ import (
 // AppUpdate is the builder for updating App entities.
 type AppUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AppMutation
+	hooks     []Hook
+	mutation  *AppMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AppUpdate builder.
@@ -164,6 +165,12 @@ func (_u *AppUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AppUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AppUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -200,6 +207,7 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(app.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{app.Label}
@@ -215,9 +223,10 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AppUpdateOne is the builder for updating a single App entity.
 type AppUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AppMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AppMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -369,6 +378,12 @@ func (_u *AppUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AppUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AppUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -422,6 +437,7 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(app.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &App{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
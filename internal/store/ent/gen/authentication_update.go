@@ -18,8 +18,9 @@ import (
 // AuthenticationUpdate is the builder for updating Authentication entities.
 type AuthenticationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AuthenticationMutation
+	hooks     []Hook
+	mutation  *AuthenticationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AuthenticationUpdate builder.
@@ -223,6 +224,12 @@ func (_u *AuthenticationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthenticationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthenticationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthenticationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -271,6 +278,7 @@ func (_u *AuthenticationUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(authentication.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authentication.Label}
@@ -286,9 +294,10 @@ func (_u *AuthenticationUpdate) sqlSave(ctx context.Context) (_node int, err err
 // AuthenticationUpdateOne is the builder for updating a single Authentication entity.
 type AuthenticationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AuthenticationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthenticationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -499,6 +508,12 @@ func (_u *AuthenticationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthenticationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthenticationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthenticationUpdateOne) sqlSave(ctx context.Context) (_node *Authentication, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -564,6 +579,7 @@ func (_u *AuthenticationUpdateOne) sqlSave(ctx context.Context) (_node *Authenti
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(authentication.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Authentication{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
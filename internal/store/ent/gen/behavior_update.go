@@ -18,8 +18,9 @@ import (
 // BehaviorUpdate is the builder for updating Behavior entities.
 type BehaviorUpdate struct {
 	config
-	hooks    []Hook
-	mutation *BehaviorMutation
+	hooks     []Hook
+	mutation  *BehaviorMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the BehaviorUpdate builder.
@@ -151,6 +152,12 @@ func (_u *BehaviorUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BehaviorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BehaviorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *BehaviorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -184,6 +191,7 @@ func (_u *BehaviorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(behavior.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{behavior.Label}
@@ -199,9 +207,10 @@ func (_u *BehaviorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // BehaviorUpdateOne is the builder for updating a single Behavior entity.
 type BehaviorUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *BehaviorMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *BehaviorMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -340,6 +349,12 @@ func (_u *BehaviorUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BehaviorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BehaviorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *BehaviorUpdateOne) sqlSave(ctx context.Context) (_node *Behavior, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -390,6 +405,7 @@ func (_u *BehaviorUpdateOne) sqlSave(ctx context.Context) (_node *Behavior, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(behavior.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Behavior{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
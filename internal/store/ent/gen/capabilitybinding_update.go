@@ -18,8 +18,9 @@ import (
 // CapabilityBindingUpdate is the builder for updating CapabilityBinding entities.
 type CapabilityBindingUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CapabilityBindingMutation
+	hooks     []Hook
+	mutation  *CapabilityBindingMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CapabilityBindingUpdate builder.
@@ -132,6 +133,12 @@ func (_u *CapabilityBindingUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CapabilityBindingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CapabilityBindingUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CapabilityBindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -156,6 +163,7 @@ func (_u *CapabilityBindingUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(capabilitybinding.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{capabilitybinding.Label}
@@ -171,9 +179,10 @@ func (_u *CapabilityBindingUpdate) sqlSave(ctx context.Context) (_node int, err
 // CapabilityBindingUpdateOne is the builder for updating a single CapabilityBinding entity.
 type CapabilityBindingUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CapabilityBindingMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CapabilityBindingMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCapability sets the "capability" field.
@@ -293,6 +302,12 @@ func (_u *CapabilityBindingUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CapabilityBindingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CapabilityBindingUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CapabilityBindingUpdateOne) sqlSave(ctx context.Context) (_node *CapabilityBinding, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -334,6 +349,7 @@ func (_u *CapabilityBindingUpdateOne) sqlSave(ctx context.Context) (_node *Capab
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(capabilitybinding.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CapabilityBinding{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // ChatScheduledTaskUpdate is the builder for updating ChatScheduledTask entities.
 type ChatScheduledTaskUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ChatScheduledTaskMutation
+	hooks     []Hook
+	mutation  *ChatScheduledTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ChatScheduledTaskUpdate builder.
@@ -289,6 +290,12 @@ func (_u *ChatScheduledTaskUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatScheduledTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatScheduledTaskUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatScheduledTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -352,6 +359,7 @@ func (_u *ChatScheduledTaskUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(chatscheduledtask.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chatscheduledtask.Label}
@@ -367,9 +375,10 @@ func (_u *ChatScheduledTaskUpdate) sqlSave(ctx context.Context) (_node int, err
 // ChatScheduledTaskUpdateOne is the builder for updating a single ChatScheduledTask entity.
 type ChatScheduledTaskUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ChatScheduledTaskMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatScheduledTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -646,6 +655,12 @@ func (_u *ChatScheduledTaskUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatScheduledTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatScheduledTaskUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatScheduledTaskUpdateOne) sqlSave(ctx context.Context) (_node *ChatScheduledTask, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -726,6 +741,7 @@ func (_u *ChatScheduledTaskUpdateOne) sqlSave(ctx context.Context) (_node *ChatS
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(chatscheduledtask.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ChatScheduledTask{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // ChatScheduledTaskRunUpdate is the builder for updating ChatScheduledTaskRun entities.
 type ChatScheduledTaskRunUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ChatScheduledTaskRunMutation
+	hooks     []Hook
+	mutation  *ChatScheduledTaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ChatScheduledTaskRunUpdate builder.
@@ -184,6 +185,12 @@ func (_u *ChatScheduledTaskRunUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatScheduledTaskRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatScheduledTaskRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatScheduledTaskRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -220,6 +227,7 @@ func (_u *ChatScheduledTaskRunUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.FinishedAtCleared() {
 		_spec.ClearField(chatscheduledtaskrun.FieldFinishedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chatscheduledtaskrun.Label}
@@ -235,9 +243,10 @@ func (_u *ChatScheduledTaskRunUpdate) sqlSave(ctx context.Context) (_node int, e
 // ChatScheduledTaskRunUpdateOne is the builder for updating a single ChatScheduledTaskRun entity.
 type ChatScheduledTaskRunUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ChatScheduledTaskRunMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatScheduledTaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -409,6 +418,12 @@ func (_u *ChatScheduledTaskRunUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatScheduledTaskRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatScheduledTaskRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatScheduledTaskRunUpdateOne) sqlSave(ctx context.Context) (_node *ChatScheduledTaskRun, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -462,6 +477,7 @@ func (_u *ChatScheduledTaskRunUpdateOne) sqlSave(ctx context.Context) (_node *Ch
 	if _u.mutation.FinishedAtCleared() {
 		_spec.ClearField(chatscheduledtaskrun.FieldFinishedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ChatScheduledTaskRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // ChatSessionUpdate is the builder for updating ChatSession entities.
 type ChatSessionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ChatSessionMutation
+	hooks     []Hook
+	mutation  *ChatSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ChatSessionUpdate builder.
@@ -265,6 +266,12 @@ func (_u *ChatSessionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatSessionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -319,6 +326,7 @@ func (_u *ChatSessionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(chatsession.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chatsession.Label}
@@ -334,9 +342,10 @@ func (_u *ChatSessionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // ChatSessionUpdateOne is the builder for updating a single ChatSession entity.
 type ChatSessionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ChatSessionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -589,6 +598,12 @@ func (_u *ChatSessionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatSessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatSessionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatSessionUpdateOne) sqlSave(ctx context.Context) (_node *ChatSession, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -660,6 +675,7 @@ func (_u *ChatSessionUpdateOne) sqlSave(ctx context.Context) (_node *ChatSession
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(chatsession.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ChatSession{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,8 +17,9 @@ import (
 // ChatSessionEntryUpdate is the builder for updating ChatSessionEntry entities.
 type ChatSessionEntryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ChatSessionEntryMutation
+	hooks     []Hook
+	mutation  *ChatSessionEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ChatSessionEntryUpdate builder.
@@ -147,6 +148,12 @@ func (_u *ChatSessionEntryUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatSessionEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatSessionEntryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatSessionEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -177,6 +184,7 @@ func (_u *ChatSessionEntryUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.PayloadCleared() {
 		_spec.ClearField(chatsessionentry.FieldPayload, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chatsessionentry.Label}
@@ -192,9 +200,10 @@ func (_u *ChatSessionEntryUpdate) sqlSave(ctx context.Context) (_node int, err e
 // ChatSessionEntryUpdateOne is the builder for updating a single ChatSessionEntry entity.
 type ChatSessionEntryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ChatSessionEntryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatSessionEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -330,6 +339,12 @@ func (_u *ChatSessionEntryUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatSessionEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatSessionEntryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChatSessionEntryUpdateOne) sqlSave(ctx context.Context) (_node *ChatSessionEntry, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -377,6 +392,7 @@ func (_u *ChatSessionEntryUpdateOne) sqlSave(ctx context.Context) (_node *ChatSe
 	if _u.mutation.PayloadCleared() {
 		_spec.ClearField(chatsessionentry.FieldPayload, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ChatSessionEntry{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -15,6 +15,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agent"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentembedding"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentknowledge"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentmemoryfact"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentplan"
@@ -36,6 +37,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/chatsession"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/chatsessionentry"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/clipversion"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/configdata"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/connection"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/counter"
@@ -50,6 +52,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/functiondefinitionversion"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/functionrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjob"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjoblog"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayworker"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/instruct"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/lifeachievement"
@@ -96,15 +99,19 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/platformuser"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/pollingstate"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/resourcelink"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/topic"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/url"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/user"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccount"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccountidentity"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflow"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowsteprun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowtask"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowtrigger"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -114,6 +121,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AgentEmbedding is the client for interacting with the AgentEmbedding builders.
+	AgentEmbedding *AgentEmbeddingClient
 	// AgentKnowledge is the client for interacting with the AgentKnowledge builders.
 	AgentKnowledge *AgentKnowledgeClient
 	// AgentMemoryFact is the client for interacting with the AgentMemoryFact builders.
@@ -156,6 +165,8 @@ type Client struct {
 	ChatSessionEntry *ChatSessionEntryClient
 	// Clip is the client for interacting with the Clip builders.
 	Clip *ClipClient
+	// ClipVersion is the client for interacting with the ClipVersion builders.
+	ClipVersion *ClipVersionClient
 	// ConfigData is the client for interacting with the ConfigData builders.
 	ConfigData *ConfigDataClient
 	// Connection is the client for interacting with the Connection builders.
@@ -184,6 +195,8 @@ type Client struct {
 	FunctionRun *FunctionRunClient
 	// GatewayJob is the client for interacting with the GatewayJob builders.
 	GatewayJob *GatewayJobClient
+	// GatewayJobLog is the client for interacting with the GatewayJobLog builders.
+	GatewayJobLog *GatewayJobLogClient
 	// GatewayWorker is the client for interacting with the GatewayWorker builders.
 	GatewayWorker *GatewayWorkerClient
 	// Instruct is the client for interacting with the Instruct builders.
@@ -276,6 +289,8 @@ type Client struct {
 	PollingState *PollingStateClient
 	// ResourceLink is the client for interacting with the ResourceLink builders.
 	ResourceLink *ResourceLinkClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// Topic is the client for interacting with the Topic builders.
 	Topic *TopicClient
 	// Url is the client for interacting with the Url builders.
@@ -284,6 +299,8 @@ type Client struct {
 	User *UserClient
 	// WebAccount is the client for interacting with the WebAccount builders.
 	WebAccount *WebAccountClient
+	// WebAccountIdentity is the client for interacting with the WebAccountIdentity builders.
+	WebAccountIdentity *WebAccountIdentityClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowRun is the client for interacting with the WorkflowRun builders.
@@ -306,6 +323,7 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Agent = NewAgentClient(c.config)
+	c.AgentEmbedding = NewAgentEmbeddingClient(c.config)
 	c.AgentKnowledge = NewAgentKnowledgeClient(c.config)
 	c.AgentMemoryFact = NewAgentMemoryFactClient(c.config)
 	c.AgentPlan = NewAgentPlanClient(c.config)
@@ -327,6 +345,7 @@ func (c *Client) init() {
 	c.ChatSession = NewChatSessionClient(c.config)
 	c.ChatSessionEntry = NewChatSessionEntryClient(c.config)
 	c.Clip = NewClipClient(c.config)
+	c.ClipVersion = NewClipVersionClient(c.config)
 	c.ConfigData = NewConfigDataClient(c.config)
 	c.Connection = NewConnectionClient(c.config)
 	c.Counter = NewCounterClient(c.config)
@@ -341,6 +360,7 @@ func (c *Client) init() {
 	c.FunctionDefinitionVersion = NewFunctionDefinitionVersionClient(c.config)
 	c.FunctionRun = NewFunctionRunClient(c.config)
 	c.GatewayJob = NewGatewayJobClient(c.config)
+	c.GatewayJobLog = NewGatewayJobLogClient(c.config)
 	c.GatewayWorker = NewGatewayWorkerClient(c.config)
 	c.Instruct = NewInstructClient(c.config)
 	c.LLMUsageRecord = NewLLMUsageRecordClient(c.config)
@@ -387,10 +407,12 @@ func (c *Client) init() {
 	c.PlatformUser = NewPlatformUserClient(c.config)
 	c.PollingState = NewPollingStateClient(c.config)
 	c.ResourceLink = NewResourceLinkClient(c.config)
+	c.SearchDocument = NewSearchDocumentClient(c.config)
 	c.Topic = NewTopicClient(c.config)
 	c.Url = NewURLClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.WebAccount = NewWebAccountClient(c.config)
+	c.WebAccountIdentity = NewWebAccountIdentityClient(c.config)
 	c.Workflow = NewWorkflowClient(c.config)
 	c.WorkflowRun = NewWorkflowRunClient(c.config)
 	c.WorkflowStepRun = NewWorkflowStepRunClient(c.config)
@@ -489,6 +511,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                       ctx,
 		config:                    cfg,
 		Agent:                     NewAgentClient(cfg),
+		AgentEmbedding:            NewAgentEmbeddingClient(cfg),
 		AgentKnowledge:            NewAgentKnowledgeClient(cfg),
 		AgentMemoryFact:           NewAgentMemoryFactClient(cfg),
 		AgentPlan:                 NewAgentPlanClient(cfg),
@@ -510,6 +533,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ChatSession:               NewChatSessionClient(cfg),
 		ChatSessionEntry:          NewChatSessionEntryClient(cfg),
 		Clip:                      NewClipClient(cfg),
+		ClipVersion:               NewClipVersionClient(cfg),
 		ConfigData:                NewConfigDataClient(cfg),
 		Connection:                NewConnectionClient(cfg),
 		Counter:                   NewCounterClient(cfg),
@@ -524,6 +548,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		FunctionDefinitionVersion: NewFunctionDefinitionVersionClient(cfg),
 		FunctionRun:               NewFunctionRunClient(cfg),
 		GatewayJob:                NewGatewayJobClient(cfg),
+		GatewayJobLog:             NewGatewayJobLogClient(cfg),
 		GatewayWorker:             NewGatewayWorkerClient(cfg),
 		Instruct:                  NewInstructClient(cfg),
 		LLMUsageRecord:            NewLLMUsageRecordClient(cfg),
@@ -570,10 +595,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		PlatformUser:              NewPlatformUserClient(cfg),
 		PollingState:              NewPollingStateClient(cfg),
 		ResourceLink:              NewResourceLinkClient(cfg),
+		SearchDocument:            NewSearchDocumentClient(cfg),
 		Topic:                     NewTopicClient(cfg),
 		Url:                       NewURLClient(cfg),
 		User:                      NewUserClient(cfg),
 		WebAccount:                NewWebAccountClient(cfg),
+		WebAccountIdentity:        NewWebAccountIdentityClient(cfg),
 		Workflow:                  NewWorkflowClient(cfg),
 		WorkflowRun:               NewWorkflowRunClient(cfg),
 		WorkflowStepRun:           NewWorkflowStepRunClient(cfg),
@@ -599,6 +626,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                       ctx,
 		config:                    cfg,
 		Agent:                     NewAgentClient(cfg),
+		AgentEmbedding:            NewAgentEmbeddingClient(cfg),
 		AgentKnowledge:            NewAgentKnowledgeClient(cfg),
 		AgentMemoryFact:           NewAgentMemoryFactClient(cfg),
 		AgentPlan:                 NewAgentPlanClient(cfg),
@@ -620,6 +648,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ChatSession:               NewChatSessionClient(cfg),
 		ChatSessionEntry:          NewChatSessionEntryClient(cfg),
 		Clip:                      NewClipClient(cfg),
+		ClipVersion:               NewClipVersionClient(cfg),
 		ConfigData:                NewConfigDataClient(cfg),
 		Connection:                NewConnectionClient(cfg),
 		Counter:                   NewCounterClient(cfg),
@@ -634,6 +663,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		FunctionDefinitionVersion: NewFunctionDefinitionVersionClient(cfg),
 		FunctionRun:               NewFunctionRunClient(cfg),
 		GatewayJob:                NewGatewayJobClient(cfg),
+		GatewayJobLog:             NewGatewayJobLogClient(cfg),
 		GatewayWorker:             NewGatewayWorkerClient(cfg),
 		Instruct:                  NewInstructClient(cfg),
 		LLMUsageRecord:            NewLLMUsageRecordClient(cfg),
@@ -680,10 +710,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		PlatformUser:              NewPlatformUserClient(cfg),
 		PollingState:              NewPollingStateClient(cfg),
 		ResourceLink:              NewResourceLinkClient(cfg),
+		SearchDocument:            NewSearchDocumentClient(cfg),
 		Topic:                     NewTopicClient(cfg),
 		Url:                       NewURLClient(cfg),
 		User:                      NewUserClient(cfg),
 		WebAccount:                NewWebAccountClient(cfg),
+		WebAccountIdentity:        NewWebAccountIdentityClient(cfg),
 		Workflow:                  NewWorkflowClient(cfg),
 		WorkflowRun:               NewWorkflowRunClient(cfg),
 		WorkflowStepRun:           NewWorkflowStepRunClient(cfg),
@@ -718,17 +750,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Agent, c.AgentKnowledge, c.AgentMemoryFact, c.AgentPlan,
+		c.Agent, c.AgentEmbedding, c.AgentKnowledge, c.AgentMemoryFact, c.AgentPlan,
 		c.AgentSessionSummary, c.AgentSkill, c.AgentSkillFile, c.AgentSubagent,
 		c.AgentSubagentTask, c.AgentTodo, c.App, c.AuditLog, c.Authentication,
 		c.Behavior, c.Bot, c.CapabilityBinding, c.Channel, c.ChatScheduledTask,
 		c.ChatScheduledTaskRun, c.ChatSession, c.ChatSessionEntry, c.Clip,
-		c.ConfigData, c.Connection, c.Counter, c.CounterRecord, c.Data, c.DataEvent,
-		c.EventConsumption, c.EventOutbox, c.Fileupload, c.Form, c.FunctionDefinition,
-		c.FunctionDefinitionVersion, c.FunctionRun, c.GatewayJob, c.GatewayWorker,
-		c.Instruct, c.LLMUsageRecord, c.LifeAIContext, c.LifeAchievement,
-		c.LifeAchievementProgress, c.LifeAchievementUnlock, c.LifeActionDependency,
-		c.LifeActionLog, c.LifeActionOccurrence, c.LifeActionSpec, c.LifeAdjudication,
+		c.ClipVersion, c.ConfigData, c.Connection, c.Counter, c.CounterRecord, c.Data,
+		c.DataEvent, c.EventConsumption, c.EventOutbox, c.Fileupload, c.Form,
+		c.FunctionDefinition, c.FunctionDefinitionVersion, c.FunctionRun, c.GatewayJob,
+		c.GatewayJobLog, c.GatewayWorker, c.Instruct, c.LLMUsageRecord,
+		c.LifeAIContext, c.LifeAchievement, c.LifeAchievementProgress,
+		c.LifeAchievementUnlock, c.LifeActionDependency, c.LifeActionLog,
+		c.LifeActionOccurrence, c.LifeActionSpec, c.LifeAdjudication,
 		c.LifeCharacteristic, c.LifeEquipment, c.LifeEquippedSlots, c.LifeEvidence,
 		c.LifeGoal, c.LifeHabitCheckin, c.LifeInventory, c.LifeLootTable,
 		c.LifePlanNode, c.LifeProfile, c.LifeQuest, c.LifeReward,
@@ -736,9 +769,10 @@ func (c *Client) Use(hooks ...Hook) {
 		c.NotifyChannel, c.NotifyRule, c.NotifyTemplate, c.OAuth, c.Page, c.PageData,
 		c.Parameter, c.PipelineDefinition, c.PipelineDefinitionVersion, c.PipelineRun,
 		c.PipelineStepRun, c.Platform, c.PlatformBot, c.PlatformChannel,
-		c.PlatformChannelUser, c.PlatformUser, c.PollingState, c.ResourceLink, c.Topic,
-		c.Url, c.User, c.WebAccount, c.Workflow, c.WorkflowRun, c.WorkflowStepRun,
-		c.WorkflowTask, c.WorkflowTrigger,
+		c.PlatformChannelUser, c.PlatformUser, c.PollingState, c.ResourceLink,
+		c.SearchDocument, c.Topic, c.Url, c.User, c.WebAccount, c.WebAccountIdentity,
+		c.Workflow, c.WorkflowRun, c.WorkflowStepRun, c.WorkflowTask,
+		c.WorkflowTrigger,
 	} {
 		n.Use(hooks...)
 	}
@@ -748,17 +782,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Agent, c.AgentKnowledge, c.AgentMemoryFact, c.AgentPlan,
+		c.Agent, c.AgentEmbedding, c.AgentKnowledge, c.AgentMemoryFact, c.AgentPlan,
 		c.AgentSessionSummary, c.AgentSkill, c.AgentSkillFile, c.AgentSubagent,
 		c.AgentSubagentTask, c.AgentTodo, c.App, c.AuditLog, c.Authentication,
 		c.Behavior, c.Bot, c.CapabilityBinding, c.Channel, c.ChatScheduledTask,
 		c.ChatScheduledTaskRun, c.ChatSession, c.ChatSessionEntry, c.Clip,
-		c.ConfigData, c.Connection, c.Counter, c.CounterRecord, c.Data, c.DataEvent,
-		c.EventConsumption, c.EventOutbox, c.Fileupload, c.Form, c.FunctionDefinition,
-		c.FunctionDefinitionVersion, c.FunctionRun, c.GatewayJob, c.GatewayWorker,
-		c.Instruct, c.LLMUsageRecord, c.LifeAIContext, c.LifeAchievement,
-		c.LifeAchievementProgress, c.LifeAchievementUnlock, c.LifeActionDependency,
-		c.LifeActionLog, c.LifeActionOccurrence, c.LifeActionSpec, c.LifeAdjudication,
+		c.ClipVersion, c.ConfigData, c.Connection, c.Counter, c.CounterRecord, c.Data,
+		c.DataEvent, c.EventConsumption, c.EventOutbox, c.Fileupload, c.Form,
+		c.FunctionDefinition, c.FunctionDefinitionVersion, c.FunctionRun, c.GatewayJob,
+		c.GatewayJobLog, c.GatewayWorker, c.Instruct, c.LLMUsageRecord,
+		c.LifeAIContext, c.LifeAchievement, c.LifeAchievementProgress,
+		c.LifeAchievementUnlock, c.LifeActionDependency, c.LifeActionLog,
+		c.LifeActionOccurrence, c.LifeActionSpec, c.LifeAdjudication,
 		c.LifeCharacteristic, c.LifeEquipment, c.LifeEquippedSlots, c.LifeEvidence,
 		c.LifeGoal, c.LifeHabitCheckin, c.LifeInventory, c.LifeLootTable,
 		c.LifePlanNode, c.LifeProfile, c.LifeQuest, c.LifeReward,
@@ -766,9 +801,10 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.NotifyChannel, c.NotifyRule, c.NotifyTemplate, c.OAuth, c.Page, c.PageData,
 		c.Parameter, c.PipelineDefinition, c.PipelineDefinitionVersion, c.PipelineRun,
 		c.PipelineStepRun, c.Platform, c.PlatformBot, c.PlatformChannel,
-		c.PlatformChannelUser, c.PlatformUser, c.PollingState, c.ResourceLink, c.Topic,
-		c.Url, c.User, c.WebAccount, c.Workflow, c.WorkflowRun, c.WorkflowStepRun,
-		c.WorkflowTask, c.WorkflowTrigger,
+		c.PlatformChannelUser, c.PlatformUser, c.PollingState, c.ResourceLink,
+		c.SearchDocument, c.Topic, c.Url, c.User, c.WebAccount, c.WebAccountIdentity,
+		c.Workflow, c.WorkflowRun, c.WorkflowStepRun, c.WorkflowTask,
+		c.WorkflowTrigger,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -779,6 +815,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AgentMutation:
 		return c.Agent.mutate(ctx, m)
+	case *AgentEmbeddingMutation:
+		return c.AgentEmbedding.mutate(ctx, m)
 	case *AgentKnowledgeMutation:
 		return c.AgentKnowledge.mutate(ctx, m)
 	case *AgentMemoryFactMutation:
@@ -821,6 +859,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ChatSessionEntry.mutate(ctx, m)
 	case *ClipMutation:
 		return c.Clip.mutate(ctx, m)
+	case *ClipVersionMutation:
+		return c.ClipVersion.mutate(ctx, m)
 	case *ConfigDataMutation:
 		return c.ConfigData.mutate(ctx, m)
 	case *ConnectionMutation:
@@ -849,6 +889,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FunctionRun.mutate(ctx, m)
 	case *GatewayJobMutation:
 		return c.GatewayJob.mutate(ctx, m)
+	case *GatewayJobLogMutation:
+		return c.GatewayJobLog.mutate(ctx, m)
 	case *GatewayWorkerMutation:
 		return c.GatewayWorker.mutate(ctx, m)
 	case *InstructMutation:
@@ -941,6 +983,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PollingState.mutate(ctx, m)
 	case *ResourceLinkMutation:
 		return c.ResourceLink.mutate(ctx, m)
+	case *SearchDocumentMutation:
+		return c.SearchDocument.mutate(ctx, m)
 	case *TopicMutation:
 		return c.Topic.mutate(ctx, m)
 	case *URLMutation:
@@ -949,6 +993,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.User.mutate(ctx, m)
 	case *WebAccountMutation:
 		return c.WebAccount.mutate(ctx, m)
+	case *WebAccountIdentityMutation:
+		return c.WebAccountIdentity.mutate(ctx, m)
 	case *WorkflowMutation:
 		return c.Workflow.mutate(ctx, m)
 	case *WorkflowRunMutation:
@@ -1097,6 +1143,139 @@ func (c *AgentClient) mutate(ctx context.Context, m *AgentMutation) (Value, erro
 	}
 }
 
+// AgentEmbeddingClient is a client for the AgentEmbedding schema.
+type AgentEmbeddingClient struct {
+	config
+}
+
+// NewAgentEmbeddingClient returns a client for the AgentEmbedding from the given config.
+func NewAgentEmbeddingClient(c config) *AgentEmbeddingClient {
+	return &AgentEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `agentembedding.Hooks(f(g(h())))`.
+func (c *AgentEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.AgentEmbedding = append(c.hooks.AgentEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `agentembedding.Intercept(f(g(h())))`.
+func (c *AgentEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AgentEmbedding = append(c.inters.AgentEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a AgentEmbedding entity.
+func (c *AgentEmbeddingClient) Create() *AgentEmbeddingCreate {
+	mutation := newAgentEmbeddingMutation(c.config, OpCreate)
+	return &AgentEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AgentEmbedding entities.
+func (c *AgentEmbeddingClient) CreateBulk(builders ...*AgentEmbeddingCreate) *AgentEmbeddingCreateBulk {
+	return &AgentEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AgentEmbeddingClient) MapCreateBulk(slice any, setFunc func(*AgentEmbeddingCreate, int)) *AgentEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AgentEmbeddingCreateBulk{err: fmt.Errorf("calling to AgentEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AgentEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AgentEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AgentEmbedding.
+func (c *AgentEmbeddingClient) Update() *AgentEmbeddingUpdate {
+	mutation := newAgentEmbeddingMutation(c.config, OpUpdate)
+	return &AgentEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AgentEmbeddingClient) UpdateOne(_m *AgentEmbedding) *AgentEmbeddingUpdateOne {
+	mutation := newAgentEmbeddingMutation(c.config, OpUpdateOne, withAgentEmbedding(_m))
+	return &AgentEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AgentEmbeddingClient) UpdateOneID(id int64) *AgentEmbeddingUpdateOne {
+	mutation := newAgentEmbeddingMutation(c.config, OpUpdateOne, withAgentEmbeddingID(id))
+	return &AgentEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AgentEmbedding.
+func (c *AgentEmbeddingClient) Delete() *AgentEmbeddingDelete {
+	mutation := newAgentEmbeddingMutation(c.config, OpDelete)
+	return &AgentEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AgentEmbeddingClient) DeleteOne(_m *AgentEmbedding) *AgentEmbeddingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AgentEmbeddingClient) DeleteOneID(id int64) *AgentEmbeddingDeleteOne {
+	builder := c.Delete().Where(agentembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AgentEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for AgentEmbedding.
+func (c *AgentEmbeddingClient) Query() *AgentEmbeddingQuery {
+	return &AgentEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAgentEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AgentEmbedding entity by its id.
+func (c *AgentEmbeddingClient) Get(ctx context.Context, id int64) (*AgentEmbedding, error) {
+	return c.Query().Where(agentembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AgentEmbeddingClient) GetX(ctx context.Context, id int64) *AgentEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AgentEmbeddingClient) Hooks() []Hook {
+	return c.hooks.AgentEmbedding
+}
+
+// Interceptors returns the client interceptors.
+func (c *AgentEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.AgentEmbedding
+}
+
+func (c *AgentEmbeddingClient) mutate(ctx context.Context, m *AgentEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AgentEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AgentEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AgentEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AgentEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown AgentEmbedding mutation op: %q", m.Op())
+	}
+}
+
 // AgentKnowledgeClient is a client for the AgentKnowledge schema.
 type AgentKnowledgeClient struct {
 	config
@@ -3890,6 +4069,139 @@ func (c *ClipClient) mutate(ctx context.Context, m *ClipMutation) (Value, error)
 	}
 }
 
+// ClipVersionClient is a client for the ClipVersion schema.
+type ClipVersionClient struct {
+	config
+}
+
+// NewClipVersionClient returns a client for the ClipVersion from the given config.
+func NewClipVersionClient(c config) *ClipVersionClient {
+	return &ClipVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clipversion.Hooks(f(g(h())))`.
+func (c *ClipVersionClient) Use(hooks ...Hook) {
+	c.hooks.ClipVersion = append(c.hooks.ClipVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clipversion.Intercept(f(g(h())))`.
+func (c *ClipVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClipVersion = append(c.inters.ClipVersion, interceptors...)
+}
+
+// Create returns a builder for creating a ClipVersion entity.
+func (c *ClipVersionClient) Create() *ClipVersionCreate {
+	mutation := newClipVersionMutation(c.config, OpCreate)
+	return &ClipVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClipVersion entities.
+func (c *ClipVersionClient) CreateBulk(builders ...*ClipVersionCreate) *ClipVersionCreateBulk {
+	return &ClipVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClipVersionClient) MapCreateBulk(slice any, setFunc func(*ClipVersionCreate, int)) *ClipVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClipVersionCreateBulk{err: fmt.Errorf("calling to ClipVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClipVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClipVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClipVersion.
+func (c *ClipVersionClient) Update() *ClipVersionUpdate {
+	mutation := newClipVersionMutation(c.config, OpUpdate)
+	return &ClipVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClipVersionClient) UpdateOne(_m *ClipVersion) *ClipVersionUpdateOne {
+	mutation := newClipVersionMutation(c.config, OpUpdateOne, withClipVersion(_m))
+	return &ClipVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClipVersionClient) UpdateOneID(id int64) *ClipVersionUpdateOne {
+	mutation := newClipVersionMutation(c.config, OpUpdateOne, withClipVersionID(id))
+	return &ClipVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClipVersion.
+func (c *ClipVersionClient) Delete() *ClipVersionDelete {
+	mutation := newClipVersionMutation(c.config, OpDelete)
+	return &ClipVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClipVersionClient) DeleteOne(_m *ClipVersion) *ClipVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClipVersionClient) DeleteOneID(id int64) *ClipVersionDeleteOne {
+	builder := c.Delete().Where(clipversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClipVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for ClipVersion.
+func (c *ClipVersionClient) Query() *ClipVersionQuery {
+	return &ClipVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClipVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClipVersion entity by its id.
+func (c *ClipVersionClient) Get(ctx context.Context, id int64) (*ClipVersion, error) {
+	return c.Query().Where(clipversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClipVersionClient) GetX(ctx context.Context, id int64) *ClipVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClipVersionClient) Hooks() []Hook {
+	return c.hooks.ClipVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClipVersionClient) Interceptors() []Interceptor {
+	return c.inters.ClipVersion
+}
+
+func (c *ClipVersionClient) mutate(ctx context.Context, m *ClipVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClipVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClipVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClipVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClipVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown ClipVersion mutation op: %q", m.Op())
+	}
+}
+
 // ConfigDataClient is a client for the ConfigData schema.
 type ConfigDataClient struct {
 	config
@@ -5752,6 +6064,139 @@ func (c *GatewayJobClient) mutate(ctx context.Context, m *GatewayJobMutation) (V
 	}
 }
 
+// GatewayJobLogClient is a client for the GatewayJobLog schema.
+type GatewayJobLogClient struct {
+	config
+}
+
+// NewGatewayJobLogClient returns a client for the GatewayJobLog from the given config.
+func NewGatewayJobLogClient(c config) *GatewayJobLogClient {
+	return &GatewayJobLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gatewayjoblog.Hooks(f(g(h())))`.
+func (c *GatewayJobLogClient) Use(hooks ...Hook) {
+	c.hooks.GatewayJobLog = append(c.hooks.GatewayJobLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gatewayjoblog.Intercept(f(g(h())))`.
+func (c *GatewayJobLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GatewayJobLog = append(c.inters.GatewayJobLog, interceptors...)
+}
+
+// Create returns a builder for creating a GatewayJobLog entity.
+func (c *GatewayJobLogClient) Create() *GatewayJobLogCreate {
+	mutation := newGatewayJobLogMutation(c.config, OpCreate)
+	return &GatewayJobLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GatewayJobLog entities.
+func (c *GatewayJobLogClient) CreateBulk(builders ...*GatewayJobLogCreate) *GatewayJobLogCreateBulk {
+	return &GatewayJobLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GatewayJobLogClient) MapCreateBulk(slice any, setFunc func(*GatewayJobLogCreate, int)) *GatewayJobLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GatewayJobLogCreateBulk{err: fmt.Errorf("calling to GatewayJobLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GatewayJobLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GatewayJobLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GatewayJobLog.
+func (c *GatewayJobLogClient) Update() *GatewayJobLogUpdate {
+	mutation := newGatewayJobLogMutation(c.config, OpUpdate)
+	return &GatewayJobLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GatewayJobLogClient) UpdateOne(_m *GatewayJobLog) *GatewayJobLogUpdateOne {
+	mutation := newGatewayJobLogMutation(c.config, OpUpdateOne, withGatewayJobLog(_m))
+	return &GatewayJobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GatewayJobLogClient) UpdateOneID(id int64) *GatewayJobLogUpdateOne {
+	mutation := newGatewayJobLogMutation(c.config, OpUpdateOne, withGatewayJobLogID(id))
+	return &GatewayJobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GatewayJobLog.
+func (c *GatewayJobLogClient) Delete() *GatewayJobLogDelete {
+	mutation := newGatewayJobLogMutation(c.config, OpDelete)
+	return &GatewayJobLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GatewayJobLogClient) DeleteOne(_m *GatewayJobLog) *GatewayJobLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GatewayJobLogClient) DeleteOneID(id int64) *GatewayJobLogDeleteOne {
+	builder := c.Delete().Where(gatewayjoblog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GatewayJobLogDeleteOne{builder}
+}
+
+// Query returns a query builder for GatewayJobLog.
+func (c *GatewayJobLogClient) Query() *GatewayJobLogQuery {
+	return &GatewayJobLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGatewayJobLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GatewayJobLog entity by its id.
+func (c *GatewayJobLogClient) Get(ctx context.Context, id int64) (*GatewayJobLog, error) {
+	return c.Query().Where(gatewayjoblog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GatewayJobLogClient) GetX(ctx context.Context, id int64) *GatewayJobLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GatewayJobLogClient) Hooks() []Hook {
+	return c.hooks.GatewayJobLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *GatewayJobLogClient) Interceptors() []Interceptor {
+	return c.inters.GatewayJobLog
+}
+
+func (c *GatewayJobLogClient) mutate(ctx context.Context, m *GatewayJobLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GatewayJobLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GatewayJobLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GatewayJobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GatewayJobLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown GatewayJobLog mutation op: %q", m.Op())
+	}
+}
+
 // GatewayWorkerClient is a client for the GatewayWorker schema.
 type GatewayWorkerClient struct {
 	config
@@ -11870,6 +12315,139 @@ func (c *ResourceLinkClient) mutate(ctx context.Context, m *ResourceLinkMutation
 	}
 }
 
+// SearchDocumentClient is a client for the SearchDocument schema.
+type SearchDocumentClient struct {
+	config
+}
+
+// NewSearchDocumentClient returns a client for the SearchDocument from the given config.
+func NewSearchDocumentClient(c config) *SearchDocumentClient {
+	return &SearchDocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchdocument.Hooks(f(g(h())))`.
+func (c *SearchDocumentClient) Use(hooks ...Hook) {
+	c.hooks.SearchDocument = append(c.hooks.SearchDocument, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchdocument.Intercept(f(g(h())))`.
+func (c *SearchDocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchDocument = append(c.inters.SearchDocument, interceptors...)
+}
+
+// Create returns a builder for creating a SearchDocument entity.
+func (c *SearchDocumentClient) Create() *SearchDocumentCreate {
+	mutation := newSearchDocumentMutation(c.config, OpCreate)
+	return &SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchDocument entities.
+func (c *SearchDocumentClient) CreateBulk(builders ...*SearchDocumentCreate) *SearchDocumentCreateBulk {
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchDocumentClient) MapCreateBulk(slice any, setFunc func(*SearchDocumentCreate, int)) *SearchDocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchDocumentCreateBulk{err: fmt.Errorf("calling to SearchDocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchDocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchDocument.
+func (c *SearchDocumentClient) Update() *SearchDocumentUpdate {
+	mutation := newSearchDocumentMutation(c.config, OpUpdate)
+	return &SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchDocumentClient) UpdateOne(_m *SearchDocument) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocument(_m))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchDocumentClient) UpdateOneID(id int64) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocumentID(id))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchDocument.
+func (c *SearchDocumentClient) Delete() *SearchDocumentDelete {
+	mutation := newSearchDocumentMutation(c.config, OpDelete)
+	return &SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchDocumentClient) DeleteOne(_m *SearchDocument) *SearchDocumentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchDocumentClient) DeleteOneID(id int64) *SearchDocumentDeleteOne {
+	builder := c.Delete().Where(searchdocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchDocument.
+func (c *SearchDocumentClient) Query() *SearchDocumentQuery {
+	return &SearchDocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchDocument entity by its id.
+func (c *SearchDocumentClient) Get(ctx context.Context, id int64) (*SearchDocument, error) {
+	return c.Query().Where(searchdocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchDocumentClient) GetX(ctx context.Context, id int64) *SearchDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchDocumentClient) Hooks() []Hook {
+	return c.hooks.SearchDocument
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchDocumentClient) Interceptors() []Interceptor {
+	return c.inters.SearchDocument
+}
+
+func (c *SearchDocumentClient) mutate(ctx context.Context, m *SearchDocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown SearchDocument mutation op: %q", m.Op())
+	}
+}
+
 // TopicClient is a client for the Topic schema.
 type TopicClient struct {
 	config
@@ -12402,6 +12980,139 @@ func (c *WebAccountClient) mutate(ctx context.Context, m *WebAccountMutation) (V
 	}
 }
 
+// WebAccountIdentityClient is a client for the WebAccountIdentity schema.
+type WebAccountIdentityClient struct {
+	config
+}
+
+// NewWebAccountIdentityClient returns a client for the WebAccountIdentity from the given config.
+func NewWebAccountIdentityClient(c config) *WebAccountIdentityClient {
+	return &WebAccountIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webaccountidentity.Hooks(f(g(h())))`.
+func (c *WebAccountIdentityClient) Use(hooks ...Hook) {
+	c.hooks.WebAccountIdentity = append(c.hooks.WebAccountIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webaccountidentity.Intercept(f(g(h())))`.
+func (c *WebAccountIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebAccountIdentity = append(c.inters.WebAccountIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a WebAccountIdentity entity.
+func (c *WebAccountIdentityClient) Create() *WebAccountIdentityCreate {
+	mutation := newWebAccountIdentityMutation(c.config, OpCreate)
+	return &WebAccountIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebAccountIdentity entities.
+func (c *WebAccountIdentityClient) CreateBulk(builders ...*WebAccountIdentityCreate) *WebAccountIdentityCreateBulk {
+	return &WebAccountIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebAccountIdentityClient) MapCreateBulk(slice any, setFunc func(*WebAccountIdentityCreate, int)) *WebAccountIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebAccountIdentityCreateBulk{err: fmt.Errorf("calling to WebAccountIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebAccountIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebAccountIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebAccountIdentity.
+func (c *WebAccountIdentityClient) Update() *WebAccountIdentityUpdate {
+	mutation := newWebAccountIdentityMutation(c.config, OpUpdate)
+	return &WebAccountIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebAccountIdentityClient) UpdateOne(_m *WebAccountIdentity) *WebAccountIdentityUpdateOne {
+	mutation := newWebAccountIdentityMutation(c.config, OpUpdateOne, withWebAccountIdentity(_m))
+	return &WebAccountIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebAccountIdentityClient) UpdateOneID(id int64) *WebAccountIdentityUpdateOne {
+	mutation := newWebAccountIdentityMutation(c.config, OpUpdateOne, withWebAccountIdentityID(id))
+	return &WebAccountIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebAccountIdentity.
+func (c *WebAccountIdentityClient) Delete() *WebAccountIdentityDelete {
+	mutation := newWebAccountIdentityMutation(c.config, OpDelete)
+	return &WebAccountIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebAccountIdentityClient) DeleteOne(_m *WebAccountIdentity) *WebAccountIdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebAccountIdentityClient) DeleteOneID(id int64) *WebAccountIdentityDeleteOne {
+	builder := c.Delete().Where(webaccountidentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebAccountIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for WebAccountIdentity.
+func (c *WebAccountIdentityClient) Query() *WebAccountIdentityQuery {
+	return &WebAccountIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebAccountIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebAccountIdentity entity by its id.
+func (c *WebAccountIdentityClient) Get(ctx context.Context, id int64) (*WebAccountIdentity, error) {
+	return c.Query().Where(webaccountidentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebAccountIdentityClient) GetX(ctx context.Context, id int64) *WebAccountIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebAccountIdentityClient) Hooks() []Hook {
+	return c.hooks.WebAccountIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebAccountIdentityClient) Interceptors() []Interceptor {
+	return c.inters.WebAccountIdentity
+}
+
+func (c *WebAccountIdentityClient) mutate(ctx context.Context, m *WebAccountIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebAccountIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebAccountIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebAccountIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebAccountIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown WebAccountIdentity mutation op: %q", m.Op())
+	}
+}
+
 // WorkflowClient is a client for the Workflow schema.
 type WorkflowClient struct {
 	config
@@ -13070,43 +13781,69 @@ func (c *WorkflowTriggerClient) mutate(ctx context.Context, m *WorkflowTriggerMu
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Agent, AgentKnowledge, AgentMemoryFact, AgentPlan, AgentSessionSummary,
-		AgentSkill, AgentSkillFile, AgentSubagent, AgentSubagentTask, AgentTodo, App,
-		AuditLog, Authentication, Behavior, Bot, CapabilityBinding, Channel,
-		ChatScheduledTask, ChatScheduledTaskRun, ChatSession, ChatSessionEntry, Clip,
-		ConfigData, Connection, Counter, CounterRecord, Data, DataEvent,
-		EventConsumption, EventOutbox, Fileupload, Form, FunctionDefinition,
-		FunctionDefinitionVersion, FunctionRun, GatewayJob, GatewayWorker, Instruct,
-		LLMUsageRecord, LifeAIContext, LifeAchievement, LifeAchievementProgress,
-		LifeAchievementUnlock, LifeActionDependency, LifeActionLog,
-		LifeActionOccurrence, LifeActionSpec, LifeAdjudication, LifeCharacteristic,
-		LifeEquipment, LifeEquippedSlots, LifeEvidence, LifeGoal, LifeHabitCheckin,
-		LifeInventory, LifeLootTable, LifePlanNode, LifeProfile, LifeQuest, LifeReward,
-		LifeRewardRedemption, LifeSkill, Message, NotificationRecord, NotifyChannel,
-		NotifyRule, NotifyTemplate, OAuth, Page, PageData, Parameter,
-		PipelineDefinition, PipelineDefinitionVersion, PipelineRun, PipelineStepRun,
-		Platform, PlatformBot, PlatformChannel, PlatformChannelUser, PlatformUser,
-		PollingState, ResourceLink, Topic, Url, User, WebAccount, Workflow,
+		Agent, AgentEmbedding, AgentKnowledge, AgentMemoryFact, AgentPlan,
+		AgentSessionSummary, AgentSkill, AgentSkillFile, AgentSubagent,
+		AgentSubagentTask, AgentTodo, App, AuditLog, Authentication, Behavior, Bot,
+		CapabilityBinding, Channel, ChatScheduledTask, ChatScheduledTaskRun,
+		ChatSession, ChatSessionEntry, Clip, ClipVersion, ConfigData, Connection,
+		Counter, CounterRecord, Data, DataEvent, EventConsumption, EventOutbox,
+		Fileupload, Form, FunctionDefinition, FunctionDefinitionVersion, FunctionRun,
+		GatewayJob, GatewayJobLog, GatewayWorker, Instruct, LLMUsageRecord,
+		LifeAIContext, LifeAchievement, LifeAchievementProgress, LifeAchievementUnlock,
+		LifeActionDependency, LifeActionLog, LifeActionOccurrence, LifeActionSpec,
+		LifeAdjudication, LifeCharacteristic, LifeEquipment, LifeEquippedSlots,
+		LifeEvidence, LifeGoal, LifeHabitCheckin, LifeInventory, LifeLootTable,
+		LifePlanNode, LifeProfile, LifeQuest, LifeReward, LifeRewardRedemption,
+		LifeSkill, Message, NotificationRecord, NotifyChannel, NotifyRule,
+		NotifyTemplate, OAuth, Page, PageData, Parameter, PipelineDefinition,
+		PipelineDefinitionVersion, PipelineRun, PipelineStepRun, Platform, PlatformBot,
+		PlatformChannel, PlatformChannelUser, PlatformUser, PollingState, ResourceLink,
+		SearchDocument, Topic, Url, User, WebAccount, WebAccountIdentity, Workflow,
 		WorkflowRun, WorkflowStepRun, WorkflowTask, WorkflowTrigger []ent.Hook
 	}
 	inters struct {
-		Agent, AgentKnowledge, AgentMemoryFact, AgentPlan, AgentSessionSummary,
-		AgentSkill, AgentSkillFile, AgentSubagent, AgentSubagentTask, AgentTodo, App,
-		AuditLog, Authentication, Behavior, Bot, CapabilityBinding, Channel,
-		ChatScheduledTask, ChatScheduledTaskRun, ChatSession, ChatSessionEntry, Clip,
-		ConfigData, Connection, Counter, CounterRecord, Data, DataEvent,
-		EventConsumption, EventOutbox, Fileupload, Form, FunctionDefinition,
-		FunctionDefinitionVersion, FunctionRun, GatewayJob, GatewayWorker, Instruct,
-		LLMUsageRecord, LifeAIContext, LifeAchievement, LifeAchievementProgress,
-		LifeAchievementUnlock, LifeActionDependency, LifeActionLog,
-		LifeActionOccurrence, LifeActionSpec, LifeAdjudication, LifeCharacteristic,
-		LifeEquipment, LifeEquippedSlots, LifeEvidence, LifeGoal, LifeHabitCheckin,
-		LifeInventory, LifeLootTable, LifePlanNode, LifeProfile, LifeQuest, LifeReward,
-		LifeRewardRedemption, LifeSkill, Message, NotificationRecord, NotifyChannel,
-		NotifyRule, NotifyTemplate, OAuth, Page, PageData, Parameter,
-		PipelineDefinition, PipelineDefinitionVersion, PipelineRun, PipelineStepRun,
-		Platform, PlatformBot, PlatformChannel, PlatformChannelUser, PlatformUser,
-		PollingState, ResourceLink, Topic, Url, User, WebAccount, Workflow,
+		Agent, AgentEmbedding, AgentKnowledge, AgentMemoryFact, AgentPlan,
+		AgentSessionSummary, AgentSkill, AgentSkillFile, AgentSubagent,
+		AgentSubagentTask, AgentTodo, App, AuditLog, Authentication, Behavior, Bot,
+		CapabilityBinding, Channel, ChatScheduledTask, ChatScheduledTaskRun,
+		ChatSession, ChatSessionEntry, Clip, ClipVersion, ConfigData, Connection,
+		Counter, CounterRecord, Data, DataEvent, EventConsumption, EventOutbox,
+		Fileupload, Form, FunctionDefinition, FunctionDefinitionVersion, FunctionRun,
+		GatewayJob, GatewayJobLog, GatewayWorker, Instruct, LLMUsageRecord,
+		LifeAIContext, LifeAchievement, LifeAchievementProgress, LifeAchievementUnlock,
+		LifeActionDependency, LifeActionLog, LifeActionOccurrence, LifeActionSpec,
+		LifeAdjudication, LifeCharacteristic, LifeEquipment, LifeEquippedSlots,
+		LifeEvidence, LifeGoal, LifeHabitCheckin, LifeInventory, LifeLootTable,
+		LifePlanNode, LifeProfile, LifeQuest, LifeReward, LifeRewardRedemption,
+		LifeSkill, Message, NotificationRecord, NotifyChannel, NotifyRule,
+		NotifyTemplate, OAuth, Page, PageData, Parameter, PipelineDefinition,
+		PipelineDefinitionVersion, PipelineRun, PipelineStepRun, Platform, PlatformBot,
+		PlatformChannel, PlatformChannelUser, PlatformUser, PollingState, ResourceLink,
+		SearchDocument, Topic, Url, User, WebAccount, WebAccountIdentity, Workflow,
 		WorkflowRun, WorkflowStepRun, WorkflowTask, WorkflowTrigger []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -3,6 +3,7 @@
 package gen
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
+	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 // Clip is the model entity for the Clip schema.
@@ -25,10 +27,30 @@ type Clip struct {
 	Description string `json:"description,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// Attachments holds the value of the "attachments" field.
+	Attachments []types.ClipAttachment `json:"attachments,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// BurnAfterRead holds the value of the "burn_after_read" field.
+	BurnAfterRead bool `json:"burn_after_read,omitempty"`
+	// MaxViews holds the value of the "max_views" field.
+	MaxViews int `json:"max_views,omitempty"`
+	// ViewCount holds the value of the "view_count" field.
+	ViewCount int `json:"view_count,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"password_hash,omitempty"`
+	// LinkSecret holds the value of the "link_secret" field.
+	LinkSecret string `json:"link_secret,omitempty"`
+	// BurnedAt holds the value of the "burned_at" field.
+	BurnedAt *time.Time `json:"burned_at,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy string `json:"created_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -37,11 +59,15 @@ func (*Clip) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case clip.FieldID:
+		case clip.FieldAttachments:
+			values[i] = new([]byte)
+		case clip.FieldBurnAfterRead:
+			values[i] = new(sql.NullBool)
+		case clip.FieldID, clip.FieldVersion, clip.FieldMaxViews, clip.FieldViewCount:
 			values[i] = new(sql.NullInt64)
-		case clip.FieldSlug, clip.FieldTitle, clip.FieldDescription, clip.FieldContent, clip.FieldCreatedBy:
+		case clip.FieldSlug, clip.FieldTitle, clip.FieldDescription, clip.FieldContent, clip.FieldPasswordHash, clip.FieldLinkSecret, clip.FieldCreatedBy:
 			values[i] = new(sql.NullString)
-		case clip.FieldCreatedAt:
+		case clip.FieldExpiresAt, clip.FieldBurnedAt, clip.FieldCreatedAt, clip.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -88,6 +114,64 @@ func (_m *Clip) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Content = value.String
 			}
+		case clip.FieldAttachments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attachments", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Attachments); err != nil {
+					return fmt.Errorf("unmarshal field attachments: %w", err)
+				}
+			}
+		case clip.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case clip.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case clip.FieldBurnAfterRead:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field burn_after_read", values[i])
+			} else if value.Valid {
+				_m.BurnAfterRead = value.Bool
+			}
+		case clip.FieldMaxViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_views", values[i])
+			} else if value.Valid {
+				_m.MaxViews = int(value.Int64)
+			}
+		case clip.FieldViewCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field view_count", values[i])
+			} else if value.Valid {
+				_m.ViewCount = int(value.Int64)
+			}
+		case clip.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				_m.PasswordHash = value.String
+			}
+		case clip.FieldLinkSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link_secret", values[i])
+			} else if value.Valid {
+				_m.LinkSecret = value.String
+			}
+		case clip.FieldBurnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field burned_at", values[i])
+			} else if value.Valid {
+				_m.BurnedAt = new(time.Time)
+				*_m.BurnedAt = value.Time
+			}
 		case clip.FieldCreatedBy:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -100,6 +184,13 @@ func (_m *Clip) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case clip.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -148,11 +239,47 @@ func (_m *Clip) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(_m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("attachments=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attachments))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("burn_after_read=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BurnAfterRead))
+	builder.WriteString(", ")
+	builder.WriteString("max_views=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxViews))
+	builder.WriteString(", ")
+	builder.WriteString("view_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ViewCount))
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=")
+	builder.WriteString(_m.PasswordHash)
+	builder.WriteString(", ")
+	builder.WriteString("link_secret=")
+	builder.WriteString(_m.LinkSecret)
+	builder.WriteString(", ")
+	if v := _m.BurnedAt; v != nil {
+		builder.WriteString("burned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(_m.CreatedBy)
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,10 +21,30 @@ const (
 	FieldDescription = "description"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldAttachments holds the string denoting the attachments field in the database.
+	FieldAttachments = "attachments"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldBurnAfterRead holds the string denoting the burn_after_read field in the database.
+	FieldBurnAfterRead = "burn_after_read"
+	// FieldMaxViews holds the string denoting the max_views field in the database.
+	FieldMaxViews = "max_views"
+	// FieldViewCount holds the string denoting the view_count field in the database.
+	FieldViewCount = "view_count"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldLinkSecret holds the string denoting the link_secret field in the database.
+	FieldLinkSecret = "link_secret"
+	// FieldBurnedAt holds the string denoting the burned_at field in the database.
+	FieldBurnedAt = "burned_at"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// Table holds the table name of the clip in the database.
 	Table = "clips"
 )
@@ -36,8 +56,18 @@ var Columns = []string{
 	FieldTitle,
 	FieldDescription,
 	FieldContent,
+	FieldAttachments,
+	FieldVersion,
+	FieldExpiresAt,
+	FieldBurnAfterRead,
+	FieldMaxViews,
+	FieldViewCount,
+	FieldPasswordHash,
+	FieldLinkSecret,
+	FieldBurnedAt,
 	FieldCreatedBy,
 	FieldCreatedAt,
+	FieldUpdatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,8 +87,18 @@ var (
 	DefaultTitle string
 	// DefaultDescription holds the default value on creation for the "description" field.
 	DefaultDescription string
-	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
-	ContentValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultBurnAfterRead holds the default value on creation for the "burn_after_read" field.
+	DefaultBurnAfterRead bool
+	// DefaultMaxViews holds the default value on creation for the "max_views" field.
+	DefaultMaxViews int
+	// DefaultViewCount holds the default value on creation for the "view_count" field.
+	DefaultViewCount int
+	// DefaultPasswordHash holds the default value on creation for the "password_hash" field.
+	DefaultPasswordHash string
+	// DefaultLinkSecret holds the default value on creation for the "link_secret" field.
+	DefaultLinkSecret string
 	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
 	DefaultCreatedBy string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -93,6 +133,46 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByBurnAfterRead orders the results by the burn_after_read field.
+func ByBurnAfterRead(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurnAfterRead, opts...).ToFunc()
+}
+
+// ByMaxViews orders the results by the max_views field.
+func ByMaxViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxViews, opts...).ToFunc()
+}
+
+// ByViewCount orders the results by the view_count field.
+func ByViewCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViewCount, opts...).ToFunc()
+}
+
+// ByPasswordHash orders the results by the password_hash field.
+func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
+}
+
+// ByLinkSecret orders the results by the link_secret field.
+func ByLinkSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkSecret, opts...).ToFunc()
+}
+
+// ByBurnedAt orders the results by the burned_at field.
+func ByBurnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurnedAt, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -102,3 +182,8 @@ func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
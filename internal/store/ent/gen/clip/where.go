@@ -74,6 +74,46 @@ func Content(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldContent, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldVersion, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// BurnAfterRead applies equality check predicate on the "burn_after_read" field. It's identical to BurnAfterReadEQ.
+func BurnAfterRead(v bool) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBurnAfterRead, v))
+}
+
+// MaxViews applies equality check predicate on the "max_views" field. It's identical to MaxViewsEQ.
+func MaxViews(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldMaxViews, v))
+}
+
+// ViewCount applies equality check predicate on the "view_count" field. It's identical to ViewCountEQ.
+func ViewCount(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldViewCount, v))
+}
+
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// LinkSecret applies equality check predicate on the "link_secret" field. It's identical to LinkSecretEQ.
+func LinkSecret(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldLinkSecret, v))
+}
+
+// BurnedAt applies equality check predicate on the "burned_at" field. It's identical to BurnedAtEQ.
+func BurnedAt(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBurnedAt, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldCreatedBy, v))
@@ -84,6 +124,11 @@ func CreatedAt(v time.Time) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // SlugEQ applies the EQ predicate on the "slug" field.
 func SlugEQ(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldSlug, v))
@@ -344,6 +389,376 @@ func ContentContainsFold(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldContainsFold(FieldContent, v))
 }
 
+// AttachmentsIsNil applies the IsNil predicate on the "attachments" field.
+func AttachmentsIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldAttachments))
+}
+
+// AttachmentsNotNil applies the NotNil predicate on the "attachments" field.
+func AttachmentsNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldAttachments))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldVersion, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// BurnAfterReadEQ applies the EQ predicate on the "burn_after_read" field.
+func BurnAfterReadEQ(v bool) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBurnAfterRead, v))
+}
+
+// BurnAfterReadNEQ applies the NEQ predicate on the "burn_after_read" field.
+func BurnAfterReadNEQ(v bool) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldBurnAfterRead, v))
+}
+
+// MaxViewsEQ applies the EQ predicate on the "max_views" field.
+func MaxViewsEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldMaxViews, v))
+}
+
+// MaxViewsNEQ applies the NEQ predicate on the "max_views" field.
+func MaxViewsNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldMaxViews, v))
+}
+
+// MaxViewsIn applies the In predicate on the "max_views" field.
+func MaxViewsIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldMaxViews, vs...))
+}
+
+// MaxViewsNotIn applies the NotIn predicate on the "max_views" field.
+func MaxViewsNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldMaxViews, vs...))
+}
+
+// MaxViewsGT applies the GT predicate on the "max_views" field.
+func MaxViewsGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldMaxViews, v))
+}
+
+// MaxViewsGTE applies the GTE predicate on the "max_views" field.
+func MaxViewsGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldMaxViews, v))
+}
+
+// MaxViewsLT applies the LT predicate on the "max_views" field.
+func MaxViewsLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldMaxViews, v))
+}
+
+// MaxViewsLTE applies the LTE predicate on the "max_views" field.
+func MaxViewsLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldMaxViews, v))
+}
+
+// ViewCountEQ applies the EQ predicate on the "view_count" field.
+func ViewCountEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldViewCount, v))
+}
+
+// ViewCountNEQ applies the NEQ predicate on the "view_count" field.
+func ViewCountNEQ(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldViewCount, v))
+}
+
+// ViewCountIn applies the In predicate on the "view_count" field.
+func ViewCountIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldViewCount, vs...))
+}
+
+// ViewCountNotIn applies the NotIn predicate on the "view_count" field.
+func ViewCountNotIn(vs ...int) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldViewCount, vs...))
+}
+
+// ViewCountGT applies the GT predicate on the "view_count" field.
+func ViewCountGT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldViewCount, v))
+}
+
+// ViewCountGTE applies the GTE predicate on the "view_count" field.
+func ViewCountGTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldViewCount, v))
+}
+
+// ViewCountLT applies the LT predicate on the "view_count" field.
+func ViewCountLT(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldViewCount, v))
+}
+
+// ViewCountLTE applies the LTE predicate on the "view_count" field.
+func ViewCountLTE(v int) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldViewCount, v))
+}
+
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// LinkSecretEQ applies the EQ predicate on the "link_secret" field.
+func LinkSecretEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldLinkSecret, v))
+}
+
+// LinkSecretNEQ applies the NEQ predicate on the "link_secret" field.
+func LinkSecretNEQ(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldLinkSecret, v))
+}
+
+// LinkSecretIn applies the In predicate on the "link_secret" field.
+func LinkSecretIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldLinkSecret, vs...))
+}
+
+// LinkSecretNotIn applies the NotIn predicate on the "link_secret" field.
+func LinkSecretNotIn(vs ...string) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldLinkSecret, vs...))
+}
+
+// LinkSecretGT applies the GT predicate on the "link_secret" field.
+func LinkSecretGT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldLinkSecret, v))
+}
+
+// LinkSecretGTE applies the GTE predicate on the "link_secret" field.
+func LinkSecretGTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldLinkSecret, v))
+}
+
+// LinkSecretLT applies the LT predicate on the "link_secret" field.
+func LinkSecretLT(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldLinkSecret, v))
+}
+
+// LinkSecretLTE applies the LTE predicate on the "link_secret" field.
+func LinkSecretLTE(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldLinkSecret, v))
+}
+
+// LinkSecretContains applies the Contains predicate on the "link_secret" field.
+func LinkSecretContains(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContains(FieldLinkSecret, v))
+}
+
+// LinkSecretHasPrefix applies the HasPrefix predicate on the "link_secret" field.
+func LinkSecretHasPrefix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasPrefix(FieldLinkSecret, v))
+}
+
+// LinkSecretHasSuffix applies the HasSuffix predicate on the "link_secret" field.
+func LinkSecretHasSuffix(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldHasSuffix(FieldLinkSecret, v))
+}
+
+// LinkSecretEqualFold applies the EqualFold predicate on the "link_secret" field.
+func LinkSecretEqualFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldEqualFold(FieldLinkSecret, v))
+}
+
+// LinkSecretContainsFold applies the ContainsFold predicate on the "link_secret" field.
+func LinkSecretContainsFold(v string) predicate.Clip {
+	return predicate.Clip(sql.FieldContainsFold(FieldLinkSecret, v))
+}
+
+// BurnedAtEQ applies the EQ predicate on the "burned_at" field.
+func BurnedAtEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldBurnedAt, v))
+}
+
+// BurnedAtNEQ applies the NEQ predicate on the "burned_at" field.
+func BurnedAtNEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldBurnedAt, v))
+}
+
+// BurnedAtIn applies the In predicate on the "burned_at" field.
+func BurnedAtIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldBurnedAt, vs...))
+}
+
+// BurnedAtNotIn applies the NotIn predicate on the "burned_at" field.
+func BurnedAtNotIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldBurnedAt, vs...))
+}
+
+// BurnedAtGT applies the GT predicate on the "burned_at" field.
+func BurnedAtGT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldBurnedAt, v))
+}
+
+// BurnedAtGTE applies the GTE predicate on the "burned_at" field.
+func BurnedAtGTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldBurnedAt, v))
+}
+
+// BurnedAtLT applies the LT predicate on the "burned_at" field.
+func BurnedAtLT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldBurnedAt, v))
+}
+
+// BurnedAtLTE applies the LTE predicate on the "burned_at" field.
+func BurnedAtLTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldBurnedAt, v))
+}
+
+// BurnedAtIsNil applies the IsNil predicate on the "burned_at" field.
+func BurnedAtIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldBurnedAt))
+}
+
+// BurnedAtNotNil applies the NotNil predicate on the "burned_at" field.
+func BurnedAtNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldBurnedAt))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v string) predicate.Clip {
 	return predicate.Clip(sql.FieldEQ(FieldCreatedBy, v))
@@ -449,6 +864,56 @@ func CreatedAtLTE(v time.Time) predicate.Clip {
 	return predicate.Clip(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Clip {
+	return predicate.Clip(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Clip {
+	return predicate.Clip(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Clip {
+	return predicate.Clip(sql.FieldNotNull(FieldUpdatedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Clip) predicate.Clip {
 	return predicate.Clip(sql.AndPredicates(predicates...))
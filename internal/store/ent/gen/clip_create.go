@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
+	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 // ClipCreate is the builder for creating a Clip entity.
@@ -62,6 +63,124 @@ func (_c *ClipCreate) SetContent(v string) *ClipCreate {
 	return _c
 }
 
+// SetAttachments sets the "attachments" field.
+func (_c *ClipCreate) SetAttachments(v []types.ClipAttachment) *ClipCreate {
+	_c.mutation.SetAttachments(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *ClipCreate) SetVersion(v int) *ClipCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableVersion(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ClipCreate) SetExpiresAt(v time.Time) *ClipCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableExpiresAt(v *time.Time) *ClipCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (_c *ClipCreate) SetBurnAfterRead(v bool) *ClipCreate {
+	_c.mutation.SetBurnAfterRead(v)
+	return _c
+}
+
+// SetNillableBurnAfterRead sets the "burn_after_read" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableBurnAfterRead(v *bool) *ClipCreate {
+	if v != nil {
+		_c.SetBurnAfterRead(*v)
+	}
+	return _c
+}
+
+// SetMaxViews sets the "max_views" field.
+func (_c *ClipCreate) SetMaxViews(v int) *ClipCreate {
+	_c.mutation.SetMaxViews(v)
+	return _c
+}
+
+// SetNillableMaxViews sets the "max_views" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableMaxViews(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetMaxViews(*v)
+	}
+	return _c
+}
+
+// SetViewCount sets the "view_count" field.
+func (_c *ClipCreate) SetViewCount(v int) *ClipCreate {
+	_c.mutation.SetViewCount(v)
+	return _c
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableViewCount(v *int) *ClipCreate {
+	if v != nil {
+		_c.SetViewCount(*v)
+	}
+	return _c
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_c *ClipCreate) SetPasswordHash(v string) *ClipCreate {
+	_c.mutation.SetPasswordHash(v)
+	return _c
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_c *ClipCreate) SetNillablePasswordHash(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetPasswordHash(*v)
+	}
+	return _c
+}
+
+// SetLinkSecret sets the "link_secret" field.
+func (_c *ClipCreate) SetLinkSecret(v string) *ClipCreate {
+	_c.mutation.SetLinkSecret(v)
+	return _c
+}
+
+// SetNillableLinkSecret sets the "link_secret" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableLinkSecret(v *string) *ClipCreate {
+	if v != nil {
+		_c.SetLinkSecret(*v)
+	}
+	return _c
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (_c *ClipCreate) SetBurnedAt(v time.Time) *ClipCreate {
+	_c.mutation.SetBurnedAt(v)
+	return _c
+}
+
+// SetNillableBurnedAt sets the "burned_at" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableBurnedAt(v *time.Time) *ClipCreate {
+	if v != nil {
+		_c.SetBurnedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_c *ClipCreate) SetCreatedBy(v string) *ClipCreate {
 	_c.mutation.SetCreatedBy(v)
@@ -90,6 +209,20 @@ func (_c *ClipCreate) SetNillableCreatedAt(v *time.Time) *ClipCreate {
 	return _c
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ClipCreate) SetUpdatedAt(v time.Time) *ClipCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ClipCreate) SetNillableUpdatedAt(v *time.Time) *ClipCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ClipCreate) SetID(v int64) *ClipCreate {
 	_c.mutation.SetID(v)
@@ -139,6 +272,30 @@ func (_c *ClipCreate) defaults() {
 		v := clip.DefaultDescription
 		_c.mutation.SetDescription(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := clip.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.BurnAfterRead(); !ok {
+		v := clip.DefaultBurnAfterRead
+		_c.mutation.SetBurnAfterRead(v)
+	}
+	if _, ok := _c.mutation.MaxViews(); !ok {
+		v := clip.DefaultMaxViews
+		_c.mutation.SetMaxViews(v)
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		v := clip.DefaultViewCount
+		_c.mutation.SetViewCount(v)
+	}
+	if _, ok := _c.mutation.PasswordHash(); !ok {
+		v := clip.DefaultPasswordHash
+		_c.mutation.SetPasswordHash(v)
+	}
+	if _, ok := _c.mutation.LinkSecret(); !ok {
+		v := clip.DefaultLinkSecret
+		_c.mutation.SetLinkSecret(v)
+	}
 	if _, ok := _c.mutation.CreatedBy(); !ok {
 		v := clip.DefaultCreatedBy
 		_c.mutation.SetCreatedBy(v)
@@ -168,10 +325,23 @@ func (_c *ClipCreate) check() error {
 	if _, ok := _c.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`gen: missing required field "Clip.content"`)}
 	}
-	if v, ok := _c.mutation.Content(); ok {
-		if err := clip.ContentValidator(v); err != nil {
-			return &ValidationError{Name: "content", err: fmt.Errorf(`gen: validator failed for field "Clip.content": %w`, err)}
-		}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`gen: missing required field "Clip.version"`)}
+	}
+	if _, ok := _c.mutation.BurnAfterRead(); !ok {
+		return &ValidationError{Name: "burn_after_read", err: errors.New(`gen: missing required field "Clip.burn_after_read"`)}
+	}
+	if _, ok := _c.mutation.MaxViews(); !ok {
+		return &ValidationError{Name: "max_views", err: errors.New(`gen: missing required field "Clip.max_views"`)}
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		return &ValidationError{Name: "view_count", err: errors.New(`gen: missing required field "Clip.view_count"`)}
+	}
+	if _, ok := _c.mutation.PasswordHash(); !ok {
+		return &ValidationError{Name: "password_hash", err: errors.New(`gen: missing required field "Clip.password_hash"`)}
+	}
+	if _, ok := _c.mutation.LinkSecret(); !ok {
+		return &ValidationError{Name: "link_secret", err: errors.New(`gen: missing required field "Clip.link_secret"`)}
 	}
 	if _, ok := _c.mutation.CreatedBy(); !ok {
 		return &ValidationError{Name: "created_by", err: errors.New(`gen: missing required field "Clip.created_by"`)}
@@ -228,6 +398,42 @@ func (_c *ClipCreate) createSpec() (*Clip, *sqlgraph.CreateSpec) {
 		_spec.SetField(clip.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.Attachments(); ok {
+		_spec.SetField(clip.FieldAttachments, field.TypeJSON, value)
+		_node.Attachments = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(clip.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(clip.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.BurnAfterRead(); ok {
+		_spec.SetField(clip.FieldBurnAfterRead, field.TypeBool, value)
+		_node.BurnAfterRead = value
+	}
+	if value, ok := _c.mutation.MaxViews(); ok {
+		_spec.SetField(clip.FieldMaxViews, field.TypeInt, value)
+		_node.MaxViews = value
+	}
+	if value, ok := _c.mutation.ViewCount(); ok {
+		_spec.SetField(clip.FieldViewCount, field.TypeInt, value)
+		_node.ViewCount = value
+	}
+	if value, ok := _c.mutation.PasswordHash(); ok {
+		_spec.SetField(clip.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := _c.mutation.LinkSecret(); ok {
+		_spec.SetField(clip.FieldLinkSecret, field.TypeString, value)
+		_node.LinkSecret = value
+	}
+	if value, ok := _c.mutation.BurnedAt(); ok {
+		_spec.SetField(clip.FieldBurnedAt, field.TypeTime, value)
+		_node.BurnedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedBy(); ok {
 		_spec.SetField(clip.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
@@ -236,6 +442,10 @@ func (_c *ClipCreate) createSpec() (*Clip, *sqlgraph.CreateSpec) {
 		_spec.SetField(clip.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(clip.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -336,6 +546,150 @@ func (u *ClipUpsert) UpdateContent() *ClipUpsert {
 	return u
 }
 
+// SetAttachments sets the "attachments" field.
+func (u *ClipUpsert) SetAttachments(v []types.ClipAttachment) *ClipUpsert {
+	u.Set(clip.FieldAttachments, v)
+	return u
+}
+
+// UpdateAttachments sets the "attachments" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateAttachments() *ClipUpsert {
+	u.SetExcluded(clip.FieldAttachments)
+	return u
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (u *ClipUpsert) ClearAttachments() *ClipUpsert {
+	u.SetNull(clip.FieldAttachments)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ClipUpsert) SetVersion(v int) *ClipUpsert {
+	u.Set(clip.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateVersion() *ClipUpsert {
+	u.SetExcluded(clip.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ClipUpsert) AddVersion(v int) *ClipUpsert {
+	u.Add(clip.FieldVersion, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ClipUpsert) SetExpiresAt(v time.Time) *ClipUpsert {
+	u.Set(clip.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateExpiresAt() *ClipUpsert {
+	u.SetExcluded(clip.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ClipUpsert) ClearExpiresAt() *ClipUpsert {
+	u.SetNull(clip.FieldExpiresAt)
+	return u
+}
+
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (u *ClipUpsert) SetBurnAfterRead(v bool) *ClipUpsert {
+	u.Set(clip.FieldBurnAfterRead, v)
+	return u
+}
+
+// UpdateBurnAfterRead sets the "burn_after_read" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateBurnAfterRead() *ClipUpsert {
+	u.SetExcluded(clip.FieldBurnAfterRead)
+	return u
+}
+
+// SetMaxViews sets the "max_views" field.
+func (u *ClipUpsert) SetMaxViews(v int) *ClipUpsert {
+	u.Set(clip.FieldMaxViews, v)
+	return u
+}
+
+// UpdateMaxViews sets the "max_views" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateMaxViews() *ClipUpsert {
+	u.SetExcluded(clip.FieldMaxViews)
+	return u
+}
+
+// AddMaxViews adds v to the "max_views" field.
+func (u *ClipUpsert) AddMaxViews(v int) *ClipUpsert {
+	u.Add(clip.FieldMaxViews, v)
+	return u
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *ClipUpsert) SetViewCount(v int) *ClipUpsert {
+	u.Set(clip.FieldViewCount, v)
+	return u
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateViewCount() *ClipUpsert {
+	u.SetExcluded(clip.FieldViewCount)
+	return u
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *ClipUpsert) AddViewCount(v int) *ClipUpsert {
+	u.Add(clip.FieldViewCount, v)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *ClipUpsert) SetPasswordHash(v string) *ClipUpsert {
+	u.Set(clip.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *ClipUpsert) UpdatePasswordHash() *ClipUpsert {
+	u.SetExcluded(clip.FieldPasswordHash)
+	return u
+}
+
+// SetLinkSecret sets the "link_secret" field.
+func (u *ClipUpsert) SetLinkSecret(v string) *ClipUpsert {
+	u.Set(clip.FieldLinkSecret, v)
+	return u
+}
+
+// UpdateLinkSecret sets the "link_secret" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateLinkSecret() *ClipUpsert {
+	u.SetExcluded(clip.FieldLinkSecret)
+	return u
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (u *ClipUpsert) SetBurnedAt(v time.Time) *ClipUpsert {
+	u.Set(clip.FieldBurnedAt, v)
+	return u
+}
+
+// UpdateBurnedAt sets the "burned_at" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateBurnedAt() *ClipUpsert {
+	u.SetExcluded(clip.FieldBurnedAt)
+	return u
+}
+
+// ClearBurnedAt clears the value of the "burned_at" field.
+func (u *ClipUpsert) ClearBurnedAt() *ClipUpsert {
+	u.SetNull(clip.FieldBurnedAt)
+	return u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (u *ClipUpsert) SetCreatedBy(v string) *ClipUpsert {
 	u.Set(clip.FieldCreatedBy, v)
@@ -348,6 +702,24 @@ func (u *ClipUpsert) UpdateCreatedBy() *ClipUpsert {
 	return u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ClipUpsert) SetUpdatedAt(v time.Time) *ClipUpsert {
+	u.Set(clip.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ClipUpsert) UpdateUpdatedAt() *ClipUpsert {
+	u.SetExcluded(clip.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ClipUpsert) ClearUpdatedAt() *ClipUpsert {
+	u.SetNull(clip.FieldUpdatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -455,6 +827,174 @@ func (u *ClipUpsertOne) UpdateContent() *ClipUpsertOne {
 	})
 }
 
+// SetAttachments sets the "attachments" field.
+func (u *ClipUpsertOne) SetAttachments(v []types.ClipAttachment) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetAttachments(v)
+	})
+}
+
+// UpdateAttachments sets the "attachments" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateAttachments() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateAttachments()
+	})
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (u *ClipUpsertOne) ClearAttachments() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearAttachments()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ClipUpsertOne) SetVersion(v int) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ClipUpsertOne) AddVersion(v int) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateVersion() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ClipUpsertOne) SetExpiresAt(v time.Time) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateExpiresAt() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ClipUpsertOne) ClearExpiresAt() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (u *ClipUpsertOne) SetBurnAfterRead(v bool) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetBurnAfterRead(v)
+	})
+}
+
+// UpdateBurnAfterRead sets the "burn_after_read" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateBurnAfterRead() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateBurnAfterRead()
+	})
+}
+
+// SetMaxViews sets the "max_views" field.
+func (u *ClipUpsertOne) SetMaxViews(v int) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetMaxViews(v)
+	})
+}
+
+// AddMaxViews adds v to the "max_views" field.
+func (u *ClipUpsertOne) AddMaxViews(v int) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.AddMaxViews(v)
+	})
+}
+
+// UpdateMaxViews sets the "max_views" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateMaxViews() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateMaxViews()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *ClipUpsertOne) SetViewCount(v int) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *ClipUpsertOne) AddViewCount(v int) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateViewCount() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *ClipUpsertOne) SetPasswordHash(v string) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdatePasswordHash() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetLinkSecret sets the "link_secret" field.
+func (u *ClipUpsertOne) SetLinkSecret(v string) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetLinkSecret(v)
+	})
+}
+
+// UpdateLinkSecret sets the "link_secret" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateLinkSecret() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateLinkSecret()
+	})
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (u *ClipUpsertOne) SetBurnedAt(v time.Time) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetBurnedAt(v)
+	})
+}
+
+// UpdateBurnedAt sets the "burned_at" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateBurnedAt() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateBurnedAt()
+	})
+}
+
+// ClearBurnedAt clears the value of the "burned_at" field.
+func (u *ClipUpsertOne) ClearBurnedAt() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearBurnedAt()
+	})
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (u *ClipUpsertOne) SetCreatedBy(v string) *ClipUpsertOne {
 	return u.Update(func(s *ClipUpsert) {
@@ -469,6 +1009,27 @@ func (u *ClipUpsertOne) UpdateCreatedBy() *ClipUpsertOne {
 	})
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ClipUpsertOne) SetUpdatedAt(v time.Time) *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ClipUpsertOne) UpdateUpdatedAt() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ClipUpsertOne) ClearUpdatedAt() *ClipUpsertOne {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ClipUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -742,6 +1303,174 @@ func (u *ClipUpsertBulk) UpdateContent() *ClipUpsertBulk {
 	})
 }
 
+// SetAttachments sets the "attachments" field.
+func (u *ClipUpsertBulk) SetAttachments(v []types.ClipAttachment) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetAttachments(v)
+	})
+}
+
+// UpdateAttachments sets the "attachments" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateAttachments() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateAttachments()
+	})
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (u *ClipUpsertBulk) ClearAttachments() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearAttachments()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ClipUpsertBulk) SetVersion(v int) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ClipUpsertBulk) AddVersion(v int) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateVersion() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ClipUpsertBulk) SetExpiresAt(v time.Time) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateExpiresAt() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ClipUpsertBulk) ClearExpiresAt() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (u *ClipUpsertBulk) SetBurnAfterRead(v bool) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetBurnAfterRead(v)
+	})
+}
+
+// UpdateBurnAfterRead sets the "burn_after_read" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateBurnAfterRead() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateBurnAfterRead()
+	})
+}
+
+// SetMaxViews sets the "max_views" field.
+func (u *ClipUpsertBulk) SetMaxViews(v int) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetMaxViews(v)
+	})
+}
+
+// AddMaxViews adds v to the "max_views" field.
+func (u *ClipUpsertBulk) AddMaxViews(v int) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.AddMaxViews(v)
+	})
+}
+
+// UpdateMaxViews sets the "max_views" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateMaxViews() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateMaxViews()
+	})
+}
+
+// SetViewCount sets the "view_count" field.
+func (u *ClipUpsertBulk) SetViewCount(v int) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetViewCount(v)
+	})
+}
+
+// AddViewCount adds v to the "view_count" field.
+func (u *ClipUpsertBulk) AddViewCount(v int) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.AddViewCount(v)
+	})
+}
+
+// UpdateViewCount sets the "view_count" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateViewCount() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateViewCount()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *ClipUpsertBulk) SetPasswordHash(v string) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdatePasswordHash() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetLinkSecret sets the "link_secret" field.
+func (u *ClipUpsertBulk) SetLinkSecret(v string) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetLinkSecret(v)
+	})
+}
+
+// UpdateLinkSecret sets the "link_secret" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateLinkSecret() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateLinkSecret()
+	})
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (u *ClipUpsertBulk) SetBurnedAt(v time.Time) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetBurnedAt(v)
+	})
+}
+
+// UpdateBurnedAt sets the "burned_at" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateBurnedAt() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateBurnedAt()
+	})
+}
+
+// ClearBurnedAt clears the value of the "burned_at" field.
+func (u *ClipUpsertBulk) ClearBurnedAt() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearBurnedAt()
+	})
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (u *ClipUpsertBulk) SetCreatedBy(v string) *ClipUpsertBulk {
 	return u.Update(func(s *ClipUpsert) {
@@ -756,6 +1485,27 @@ func (u *ClipUpsertBulk) UpdateCreatedBy() *ClipUpsertBulk {
 	})
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ClipUpsertBulk) SetUpdatedAt(v time.Time) *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ClipUpsertBulk) UpdateUpdatedAt() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ClipUpsertBulk) ClearUpdatedAt() *ClipUpsertBulk {
+	return u.Update(func(s *ClipUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ClipUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -6,19 +6,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
+	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 // ClipUpdate is the builder for updating Clip entities.
 type ClipUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ClipMutation
+	hooks     []Hook
+	mutation  *ClipMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ClipUpdate builder.
@@ -83,6 +87,169 @@ func (_u *ClipUpdate) SetNillableContent(v *string) *ClipUpdate {
 	return _u
 }
 
+// SetAttachments sets the "attachments" field.
+func (_u *ClipUpdate) SetAttachments(v []types.ClipAttachment) *ClipUpdate {
+	_u.mutation.SetAttachments(v)
+	return _u
+}
+
+// AppendAttachments appends value to the "attachments" field.
+func (_u *ClipUpdate) AppendAttachments(v []types.ClipAttachment) *ClipUpdate {
+	_u.mutation.AppendAttachments(v)
+	return _u
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (_u *ClipUpdate) ClearAttachments() *ClipUpdate {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ClipUpdate) SetVersion(v int) *ClipUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableVersion(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ClipUpdate) AddVersion(v int) *ClipUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ClipUpdate) SetExpiresAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableExpiresAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ClipUpdate) ClearExpiresAt() *ClipUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (_u *ClipUpdate) SetBurnAfterRead(v bool) *ClipUpdate {
+	_u.mutation.SetBurnAfterRead(v)
+	return _u
+}
+
+// SetNillableBurnAfterRead sets the "burn_after_read" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableBurnAfterRead(v *bool) *ClipUpdate {
+	if v != nil {
+		_u.SetBurnAfterRead(*v)
+	}
+	return _u
+}
+
+// SetMaxViews sets the "max_views" field.
+func (_u *ClipUpdate) SetMaxViews(v int) *ClipUpdate {
+	_u.mutation.ResetMaxViews()
+	_u.mutation.SetMaxViews(v)
+	return _u
+}
+
+// SetNillableMaxViews sets the "max_views" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableMaxViews(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetMaxViews(*v)
+	}
+	return _u
+}
+
+// AddMaxViews adds value to the "max_views" field.
+func (_u *ClipUpdate) AddMaxViews(v int) *ClipUpdate {
+	_u.mutation.AddMaxViews(v)
+	return _u
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *ClipUpdate) SetViewCount(v int) *ClipUpdate {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableViewCount(v *int) *ClipUpdate {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *ClipUpdate) AddViewCount(v int) *ClipUpdate {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *ClipUpdate) SetPasswordHash(v string) *ClipUpdate {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillablePasswordHash(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// SetLinkSecret sets the "link_secret" field.
+func (_u *ClipUpdate) SetLinkSecret(v string) *ClipUpdate {
+	_u.mutation.SetLinkSecret(v)
+	return _u
+}
+
+// SetNillableLinkSecret sets the "link_secret" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableLinkSecret(v *string) *ClipUpdate {
+	if v != nil {
+		_u.SetLinkSecret(*v)
+	}
+	return _u
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (_u *ClipUpdate) SetBurnedAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetBurnedAt(v)
+	return _u
+}
+
+// SetNillableBurnedAt sets the "burned_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableBurnedAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetBurnedAt(*v)
+	}
+	return _u
+}
+
+// ClearBurnedAt clears the value of the "burned_at" field.
+func (_u *ClipUpdate) ClearBurnedAt() *ClipUpdate {
+	_u.mutation.ClearBurnedAt()
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *ClipUpdate) SetCreatedBy(v string) *ClipUpdate {
 	_u.mutation.SetCreatedBy(v)
@@ -97,6 +264,26 @@ func (_u *ClipUpdate) SetNillableCreatedBy(v *string) *ClipUpdate {
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClipUpdate) SetUpdatedAt(v time.Time) *ClipUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ClipUpdate) SetNillableUpdatedAt(v *time.Time) *ClipUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ClipUpdate) ClearUpdatedAt() *ClipUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
 // Mutation returns the ClipMutation object of the builder.
 func (_u *ClipUpdate) Mutation() *ClipMutation {
 	return _u.mutation
@@ -136,14 +323,15 @@ func (_u *ClipUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`gen: validator failed for field "Clip.slug": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Content(); ok {
-		if err := clip.ContentValidator(v); err != nil {
-			return &ValidationError{Name: "content", err: fmt.Errorf(`gen: validator failed for field "Clip.content": %w`, err)}
-		}
-	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClipUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClipUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ClipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -168,9 +356,66 @@ func (_u *ClipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(clip.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Attachments(); ok {
+		_spec.SetField(clip.FieldAttachments, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clip.FieldAttachments, value)
+		})
+	}
+	if _u.mutation.AttachmentsCleared() {
+		_spec.ClearField(clip.FieldAttachments, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(clip.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(clip.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(clip.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(clip.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BurnAfterRead(); ok {
+		_spec.SetField(clip.FieldBurnAfterRead, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxViews(); ok {
+		_spec.SetField(clip.FieldMaxViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxViews(); ok {
+		_spec.AddField(clip.FieldMaxViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(clip.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(clip.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(clip.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LinkSecret(); ok {
+		_spec.SetField(clip.FieldLinkSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BurnedAt(); ok {
+		_spec.SetField(clip.FieldBurnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BurnedAtCleared() {
+		_spec.ClearField(clip.FieldBurnedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(clip.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clip.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(clip.FieldUpdatedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{clip.Label}
@@ -186,9 +431,10 @@ func (_u *ClipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ClipUpdateOne is the builder for updating a single Clip entity.
 type ClipUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ClipMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ClipMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSlug sets the "slug" field.
@@ -247,6 +493,169 @@ func (_u *ClipUpdateOne) SetNillableContent(v *string) *ClipUpdateOne {
 	return _u
 }
 
+// SetAttachments sets the "attachments" field.
+func (_u *ClipUpdateOne) SetAttachments(v []types.ClipAttachment) *ClipUpdateOne {
+	_u.mutation.SetAttachments(v)
+	return _u
+}
+
+// AppendAttachments appends value to the "attachments" field.
+func (_u *ClipUpdateOne) AppendAttachments(v []types.ClipAttachment) *ClipUpdateOne {
+	_u.mutation.AppendAttachments(v)
+	return _u
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (_u *ClipUpdateOne) ClearAttachments() *ClipUpdateOne {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ClipUpdateOne) SetVersion(v int) *ClipUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableVersion(v *int) *ClipUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ClipUpdateOne) AddVersion(v int) *ClipUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ClipUpdateOne) SetExpiresAt(v time.Time) *ClipUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableExpiresAt(v *time.Time) *ClipUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ClipUpdateOne) ClearExpiresAt() *ClipUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (_u *ClipUpdateOne) SetBurnAfterRead(v bool) *ClipUpdateOne {
+	_u.mutation.SetBurnAfterRead(v)
+	return _u
+}
+
+// SetNillableBurnAfterRead sets the "burn_after_read" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableBurnAfterRead(v *bool) *ClipUpdateOne {
+	if v != nil {
+		_u.SetBurnAfterRead(*v)
+	}
+	return _u
+}
+
+// SetMaxViews sets the "max_views" field.
+func (_u *ClipUpdateOne) SetMaxViews(v int) *ClipUpdateOne {
+	_u.mutation.ResetMaxViews()
+	_u.mutation.SetMaxViews(v)
+	return _u
+}
+
+// SetNillableMaxViews sets the "max_views" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableMaxViews(v *int) *ClipUpdateOne {
+	if v != nil {
+		_u.SetMaxViews(*v)
+	}
+	return _u
+}
+
+// AddMaxViews adds value to the "max_views" field.
+func (_u *ClipUpdateOne) AddMaxViews(v int) *ClipUpdateOne {
+	_u.mutation.AddMaxViews(v)
+	return _u
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *ClipUpdateOne) SetViewCount(v int) *ClipUpdateOne {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableViewCount(v *int) *ClipUpdateOne {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *ClipUpdateOne) AddViewCount(v int) *ClipUpdateOne {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *ClipUpdateOne) SetPasswordHash(v string) *ClipUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillablePasswordHash(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// SetLinkSecret sets the "link_secret" field.
+func (_u *ClipUpdateOne) SetLinkSecret(v string) *ClipUpdateOne {
+	_u.mutation.SetLinkSecret(v)
+	return _u
+}
+
+// SetNillableLinkSecret sets the "link_secret" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableLinkSecret(v *string) *ClipUpdateOne {
+	if v != nil {
+		_u.SetLinkSecret(*v)
+	}
+	return _u
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (_u *ClipUpdateOne) SetBurnedAt(v time.Time) *ClipUpdateOne {
+	_u.mutation.SetBurnedAt(v)
+	return _u
+}
+
+// SetNillableBurnedAt sets the "burned_at" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableBurnedAt(v *time.Time) *ClipUpdateOne {
+	if v != nil {
+		_u.SetBurnedAt(*v)
+	}
+	return _u
+}
+
+// ClearBurnedAt clears the value of the "burned_at" field.
+func (_u *ClipUpdateOne) ClearBurnedAt() *ClipUpdateOne {
+	_u.mutation.ClearBurnedAt()
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *ClipUpdateOne) SetCreatedBy(v string) *ClipUpdateOne {
 	_u.mutation.SetCreatedBy(v)
@@ -261,6 +670,26 @@ func (_u *ClipUpdateOne) SetNillableCreatedBy(v *string) *ClipUpdateOne {
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClipUpdateOne) SetUpdatedAt(v time.Time) *ClipUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ClipUpdateOne) SetNillableUpdatedAt(v *time.Time) *ClipUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ClipUpdateOne) ClearUpdatedAt() *ClipUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
 // Mutation returns the ClipMutation object of the builder.
 func (_u *ClipUpdateOne) Mutation() *ClipMutation {
 	return _u.mutation
@@ -313,14 +742,15 @@ func (_u *ClipUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`gen: validator failed for field "Clip.slug": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Content(); ok {
-		if err := clip.ContentValidator(v); err != nil {
-			return &ValidationError{Name: "content", err: fmt.Errorf(`gen: validator failed for field "Clip.content": %w`, err)}
-		}
-	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ClipUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ClipUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ClipUpdateOne) sqlSave(ctx context.Context) (_node *Clip, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -362,9 +792,66 @@ func (_u *ClipUpdateOne) sqlSave(ctx context.Context) (_node *Clip, err error) {
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(clip.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Attachments(); ok {
+		_spec.SetField(clip.FieldAttachments, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clip.FieldAttachments, value)
+		})
+	}
+	if _u.mutation.AttachmentsCleared() {
+		_spec.ClearField(clip.FieldAttachments, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(clip.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(clip.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(clip.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(clip.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BurnAfterRead(); ok {
+		_spec.SetField(clip.FieldBurnAfterRead, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxViews(); ok {
+		_spec.SetField(clip.FieldMaxViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxViews(); ok {
+		_spec.AddField(clip.FieldMaxViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(clip.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(clip.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(clip.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LinkSecret(); ok {
+		_spec.SetField(clip.FieldLinkSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BurnedAt(); ok {
+		_spec.SetField(clip.FieldBurnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BurnedAtCleared() {
+		_spec.ClearField(clip.FieldBurnedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(clip.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clip.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(clip.FieldUpdatedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Clip{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // ConfigDataUpdate is the builder for updating ConfigData entities.
 type ConfigDataUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ConfigDataMutation
+	hooks     []Hook
+	mutation  *ConfigDataMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ConfigDataUpdate builder.
@@ -143,6 +144,12 @@ func (_u *ConfigDataUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConfigDataUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConfigDataUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ConfigDataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -170,6 +177,7 @@ func (_u *ConfigDataUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(configdata.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{configdata.Label}
@@ -185,9 +193,10 @@ func (_u *ConfigDataUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // ConfigDataUpdateOne is the builder for updating a single ConfigData entity.
 type ConfigDataUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ConfigDataMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ConfigDataMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -318,6 +327,12 @@ func (_u *ConfigDataUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConfigDataUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConfigDataUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ConfigDataUpdateOne) sqlSave(ctx context.Context) (_node *ConfigData, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -362,6 +377,7 @@ func (_u *ConfigDataUpdateOne) sqlSave(ctx context.Context) (_node *ConfigData,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(configdata.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ConfigData{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
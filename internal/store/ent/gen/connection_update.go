@@ -18,8 +18,9 @@ import (
 // ConnectionUpdate is the builder for updating Connection entities.
 type ConnectionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ConnectionMutation
+	hooks     []Hook
+	mutation  *ConnectionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ConnectionUpdate builder.
@@ -176,6 +177,12 @@ func (_u *ConnectionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConnectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConnectionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -209,6 +216,7 @@ func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(connection.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{connection.Label}
@@ -224,9 +232,10 @@ func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // ConnectionUpdateOne is the builder for updating a single Connection entity.
 type ConnectionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ConnectionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ConnectionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -390,6 +399,12 @@ func (_u *ConnectionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConnectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConnectionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ConnectionUpdateOne) sqlSave(ctx context.Context) (_node *Connection, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -440,6 +455,7 @@ func (_u *ConnectionUpdateOne) sqlSave(ctx context.Context) (_node *Connection,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(connection.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Connection{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
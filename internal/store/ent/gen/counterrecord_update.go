@@ -17,8 +17,9 @@ import (
 // CounterRecordUpdate is the builder for updating CounterRecord entities.
 type CounterRecordUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CounterRecordMutation
+	hooks     []Hook
+	mutation  *CounterRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CounterRecordUpdate builder.
@@ -101,6 +102,12 @@ func (_u *CounterRecordUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CounterRecordUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CounterRecordUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CounterRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(counterrecord.Table, counterrecord.Columns, sqlgraph.NewFieldSpec(counterrecord.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -122,6 +129,7 @@ func (_u *CounterRecordUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedDigit(); ok {
 		_spec.AddField(counterrecord.FieldDigit, field.TypeInt32, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{counterrecord.Label}
@@ -137,9 +145,10 @@ func (_u *CounterRecordUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // CounterRecordUpdateOne is the builder for updating a single CounterRecord entity.
 type CounterRecordUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CounterRecordMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CounterRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCounterID sets the "counter_id" field.
@@ -229,6 +238,12 @@ func (_u *CounterRecordUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CounterRecordUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CounterRecordUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CounterRecordUpdateOne) sqlSave(ctx context.Context) (_node *CounterRecord, err error) {
 	_spec := sqlgraph.NewUpdateSpec(counterrecord.Table, counterrecord.Columns, sqlgraph.NewFieldSpec(counterrecord.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
@@ -267,6 +282,7 @@ func (_u *CounterRecordUpdateOne) sqlSave(ctx context.Context) (_node *CounterRe
 	if value, ok := _u.mutation.AddedDigit(); ok {
 		_spec.AddField(counterrecord.FieldDigit, field.TypeInt32, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CounterRecord{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
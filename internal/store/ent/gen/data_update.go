@@ -18,8 +18,9 @@ import (
 // DataUpdate is the builder for updating Data entities.
 type DataUpdate struct {
 	config
-	hooks    []Hook
-	mutation *DataMutation
+	hooks     []Hook
+	mutation  *DataMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the DataUpdate builder.
@@ -143,6 +144,12 @@ func (_u *DataUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DataUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *DataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -170,6 +177,7 @@ func (_u *DataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(data.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{data.Label}
@@ -185,9 +193,10 @@ func (_u *DataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // DataUpdateOne is the builder for updating a single Data entity.
 type DataUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *DataMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *DataMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -318,6 +327,12 @@ func (_u *DataUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DataUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *DataUpdateOne) sqlSave(ctx context.Context) (_node *Data, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -362,6 +377,7 @@ func (_u *DataUpdateOne) sqlSave(ctx context.Context) (_node *Data, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(data.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Data{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,8 +17,9 @@ import (
 // DataEventUpdate is the builder for updating DataEvent entities.
 type DataEventUpdate struct {
 	config
-	hooks    []Hook
-	mutation *DataEventMutation
+	hooks     []Hook
+	mutation  *DataEventMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the DataEventUpdate builder.
@@ -238,6 +239,12 @@ func (_u *DataEventUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DataEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataEventUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *DataEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -292,6 +299,7 @@ func (_u *DataEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TagsCleared() {
 		_spec.ClearField(dataevent.FieldTags, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{dataevent.Label}
@@ -307,9 +315,10 @@ func (_u *DataEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // DataEventUpdateOne is the builder for updating a single DataEvent entity.
 type DataEventUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *DataEventMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *DataEventMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetEventID sets the "event_id" field.
@@ -536,6 +545,12 @@ func (_u *DataEventUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DataEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataEventUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *DataEventUpdateOne) sqlSave(ctx context.Context) (_node *DataEvent, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -607,6 +622,7 @@ func (_u *DataEventUpdateOne) sqlSave(ctx context.Context) (_node *DataEvent, er
 	if _u.mutation.TagsCleared() {
 		_spec.ClearField(dataevent.FieldTags, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &DataEvent{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
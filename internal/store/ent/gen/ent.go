@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agent"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentembedding"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentknowledge"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentmemoryfact"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentplan"
@@ -34,6 +35,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/chatsession"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/chatsessionentry"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/clipversion"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/configdata"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/connection"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/counter"
@@ -48,6 +50,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/functiondefinitionversion"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/functionrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjob"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjoblog"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayworker"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/instruct"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/lifeachievement"
@@ -94,10 +97,12 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/platformuser"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/pollingstate"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/resourcelink"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/topic"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/url"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/user"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccount"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccountidentity"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflow"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowsteprun"
@@ -164,6 +169,7 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			agent.Table:                     agent.ValidColumn,
+			agentembedding.Table:            agentembedding.ValidColumn,
 			agentknowledge.Table:            agentknowledge.ValidColumn,
 			agentmemoryfact.Table:           agentmemoryfact.ValidColumn,
 			agentplan.Table:                 agentplan.ValidColumn,
@@ -185,6 +191,7 @@ func checkColumn(t, c string) error {
 			chatsession.Table:               chatsession.ValidColumn,
 			chatsessionentry.Table:          chatsessionentry.ValidColumn,
 			clip.Table:                      clip.ValidColumn,
+			clipversion.Table:               clipversion.ValidColumn,
 			configdata.Table:                configdata.ValidColumn,
 			connection.Table:                connection.ValidColumn,
 			counter.Table:                   counter.ValidColumn,
@@ -199,6 +206,7 @@ func checkColumn(t, c string) error {
 			functiondefinitionversion.Table: functiondefinitionversion.ValidColumn,
 			functionrun.Table:               functionrun.ValidColumn,
 			gatewayjob.Table:                gatewayjob.ValidColumn,
+			gatewayjoblog.Table:             gatewayjoblog.ValidColumn,
 			gatewayworker.Table:             gatewayworker.ValidColumn,
 			instruct.Table:                  instruct.ValidColumn,
 			llmusagerecord.Table:            llmusagerecord.ValidColumn,
@@ -245,10 +253,12 @@ func checkColumn(t, c string) error {
 			platformuser.Table:              platformuser.ValidColumn,
 			pollingstate.Table:              pollingstate.ValidColumn,
 			resourcelink.Table:              resourcelink.ValidColumn,
+			searchdocument.Table:            searchdocument.ValidColumn,
 			topic.Table:                     topic.ValidColumn,
 			url.Table:                       url.ValidColumn,
 			user.Table:                      user.ValidColumn,
 			webaccount.Table:                webaccount.ValidColumn,
+			webaccountidentity.Table:        webaccountidentity.ValidColumn,
 			workflow.Table:                  workflow.ValidColumn,
 			workflowrun.Table:               workflowrun.ValidColumn,
 			workflowsteprun.Table:           workflowsteprun.ValidColumn,
@@ -17,8 +17,9 @@ import (
 // EventConsumptionUpdate is the builder for updating EventConsumption entities.
 type EventConsumptionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EventConsumptionMutation
+	hooks     []Hook
+	mutation  *EventConsumptionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EventConsumptionUpdate builder.
@@ -102,6 +103,12 @@ func (_u *EventConsumptionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventConsumptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventConsumptionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EventConsumptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -120,6 +127,7 @@ func (_u *EventConsumptionUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if value, ok := _u.mutation.EventID(); ok {
 		_spec.SetField(eventconsumption.FieldEventID, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{eventconsumption.Label}
@@ -135,9 +143,10 @@ func (_u *EventConsumptionUpdate) sqlSave(ctx context.Context) (_node int, err e
 // EventConsumptionUpdateOne is the builder for updating a single EventConsumption entity.
 type EventConsumptionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EventConsumptionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EventConsumptionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetConsumerName sets the "consumer_name" field.
@@ -228,6 +237,12 @@ func (_u *EventConsumptionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventConsumptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventConsumptionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EventConsumptionUpdateOne) sqlSave(ctx context.Context) (_node *EventConsumption, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -263,6 +278,7 @@ func (_u *EventConsumptionUpdateOne) sqlSave(ctx context.Context) (_node *EventC
 	if value, ok := _u.mutation.EventID(); ok {
 		_spec.SetField(eventconsumption.FieldEventID, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EventConsumption{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
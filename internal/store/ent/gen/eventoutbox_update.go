@@ -17,8 +17,9 @@ import (
 // EventOutboxUpdate is the builder for updating EventOutbox entities.
 type EventOutboxUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EventOutboxMutation
+	hooks     []Hook
+	mutation  *EventOutboxMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EventOutboxUpdate builder.
@@ -103,6 +104,12 @@ func (_u *EventOutboxUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventOutboxUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventOutboxUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EventOutboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -124,6 +131,7 @@ func (_u *EventOutboxUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Published(); ok {
 		_spec.SetField(eventoutbox.FieldPublished, field.TypeBool, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{eventoutbox.Label}
@@ -139,9 +147,10 @@ func (_u *EventOutboxUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // EventOutboxUpdateOne is the builder for updating a single EventOutbox entity.
 type EventOutboxUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EventOutboxMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EventOutboxMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetEventID sets the "event_id" field.
@@ -233,6 +242,12 @@ func (_u *EventOutboxUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EventOutboxUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventOutboxUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EventOutboxUpdateOne) sqlSave(ctx context.Context) (_node *EventOutbox, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -271,6 +286,7 @@ func (_u *EventOutboxUpdateOne) sqlSave(ctx context.Context) (_node *EventOutbox
 	if value, ok := _u.mutation.Published(); ok {
 		_spec.SetField(eventoutbox.FieldPublished, field.TypeBool, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EventOutbox{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // FileuploadUpdate is the builder for updating Fileupload entities.
 type FileuploadUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FileuploadMutation
+	hooks     []Hook
+	mutation  *FileuploadMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FileuploadUpdate builder.
@@ -217,6 +218,12 @@ func (_u *FileuploadUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileuploadUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileuploadUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FileuploadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -259,6 +266,7 @@ func (_u *FileuploadUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(fileupload.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fileupload.Label}
@@ -274,9 +282,10 @@ func (_u *FileuploadUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // FileuploadUpdateOne is the builder for updating a single Fileupload entity.
 type FileuploadUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FileuploadMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FileuploadMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -481,6 +490,12 @@ func (_u *FileuploadUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileuploadUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileuploadUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FileuploadUpdateOne) sqlSave(ctx context.Context) (_node *Fileupload, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -540,6 +555,7 @@ func (_u *FileuploadUpdateOne) sqlSave(ctx context.Context) (_node *Fileupload,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(fileupload.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Fileupload{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // FormUpdate is the builder for updating Form entities.
 type FormUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FormMutation
+	hooks     []Hook
+	mutation  *FormMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FormUpdate builder.
@@ -188,6 +189,12 @@ func (_u *FormUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FormUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FormUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FormUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -233,6 +240,7 @@ func (_u *FormUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(form.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{form.Label}
@@ -248,9 +256,10 @@ func (_u *FormUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // FormUpdateOne is the builder for updating a single Form entity.
 type FormUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FormMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FormMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFormID sets the "form_id" field.
@@ -426,6 +435,12 @@ func (_u *FormUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FormUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FormUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FormUpdateOne) sqlSave(ctx context.Context) (_node *Form, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -488,6 +503,7 @@ func (_u *FormUpdateOne) sqlSave(ctx context.Context) (_node *Form, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(form.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Form{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
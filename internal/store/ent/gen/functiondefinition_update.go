@@ -18,8 +18,9 @@ import (
 // FunctionDefinitionUpdate is the builder for updating FunctionDefinition entities.
 type FunctionDefinitionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FunctionDefinitionMutation
+	hooks     []Hook
+	mutation  *FunctionDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FunctionDefinitionUpdate builder.
@@ -255,6 +256,12 @@ func (_u *FunctionDefinitionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FunctionDefinitionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FunctionDefinitionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FunctionDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -312,6 +319,7 @@ func (_u *FunctionDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(functiondefinition.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{functiondefinition.Label}
@@ -327,9 +335,10 @@ func (_u *FunctionDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err
 // FunctionDefinitionUpdateOne is the builder for updating a single FunctionDefinition entity.
 type FunctionDefinitionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FunctionDefinitionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FunctionDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -572,6 +581,12 @@ func (_u *FunctionDefinitionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FunctionDefinitionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FunctionDefinitionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FunctionDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *FunctionDefinition, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -646,6 +661,7 @@ func (_u *FunctionDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *Func
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(functiondefinition.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &FunctionDefinition{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
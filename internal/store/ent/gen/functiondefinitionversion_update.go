@@ -17,8 +17,9 @@ import (
 // FunctionDefinitionVersionUpdate is the builder for updating FunctionDefinitionVersion entities.
 type FunctionDefinitionVersionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FunctionDefinitionVersionMutation
+	hooks     []Hook
+	mutation  *FunctionDefinitionVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FunctionDefinitionVersionUpdate builder.
@@ -161,6 +162,12 @@ func (_u *FunctionDefinitionVersionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FunctionDefinitionVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FunctionDefinitionVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FunctionDefinitionVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -191,6 +198,7 @@ func (_u *FunctionDefinitionVersionUpdate) sqlSave(ctx context.Context) (_node i
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(functiondefinitionversion.FieldSource, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{functiondefinitionversion.Label}
@@ -206,9 +214,10 @@ func (_u *FunctionDefinitionVersionUpdate) sqlSave(ctx context.Context) (_node i
 // FunctionDefinitionVersionUpdateOne is the builder for updating a single FunctionDefinitionVersion entity.
 type FunctionDefinitionVersionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FunctionDefinitionVersionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FunctionDefinitionVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFunctionName sets the "function_name" field.
@@ -358,6 +367,12 @@ func (_u *FunctionDefinitionVersionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FunctionDefinitionVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FunctionDefinitionVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FunctionDefinitionVersionUpdateOne) sqlSave(ctx context.Context) (_node *FunctionDefinitionVersion, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -405,6 +420,7 @@ func (_u *FunctionDefinitionVersionUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(functiondefinitionversion.FieldSource, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &FunctionDefinitionVersion{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
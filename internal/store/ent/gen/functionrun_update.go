@@ -17,8 +17,9 @@ import (
 // FunctionRunUpdate is the builder for updating FunctionRun entities.
 type FunctionRunUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FunctionRunMutation
+	hooks     []Hook
+	mutation  *FunctionRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FunctionRunUpdate builder.
@@ -225,6 +226,12 @@ func (_u *FunctionRunUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FunctionRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FunctionRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FunctionRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -279,6 +286,7 @@ func (_u *FunctionRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.IdempotencyKeyCleared() {
 		_spec.ClearField(functionrun.FieldIdempotencyKey, field.TypeString)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{functionrun.Label}
@@ -294,9 +302,10 @@ func (_u *FunctionRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // FunctionRunUpdateOne is the builder for updating a single FunctionRun entity.
 type FunctionRunUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FunctionRunMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FunctionRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFunctionName sets the "function_name" field.
@@ -510,6 +519,12 @@ func (_u *FunctionRunUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FunctionRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FunctionRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FunctionRunUpdateOne) sqlSave(ctx context.Context) (_node *FunctionRun, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -581,6 +596,7 @@ func (_u *FunctionRunUpdateOne) sqlSave(ctx context.Context) (_node *FunctionRun
 	if _u.mutation.IdempotencyKeyCleared() {
 		_spec.ClearField(functionrun.FieldIdempotencyKey, field.TypeString)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &FunctionRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -39,6 +39,10 @@ type GatewayJob struct {
 	Truncated bool `json:"truncated,omitempty"`
 	// DurationMs holds the value of the "duration_ms" field.
 	DurationMs int64 `json:"duration_ms,omitempty"`
+	// Last streamed log chunk seq
+	LogSeq int64 `json:"log_seq,omitempty"`
+	// Total streamed log bytes stored
+	LogBytes int64 `json:"log_bytes,omitempty"`
 	// WorkerID holds the value of the "worker_id" field.
 	WorkerID string `json:"worker_id,omitempty"`
 	// LeaseUntil holds the value of the "lease_until" field.
@@ -61,7 +65,7 @@ func (*GatewayJob) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case gatewayjob.FieldTruncated:
 			values[i] = new(sql.NullBool)
-		case gatewayjob.FieldID, gatewayjob.FieldExitCode, gatewayjob.FieldDurationMs:
+		case gatewayjob.FieldID, gatewayjob.FieldExitCode, gatewayjob.FieldDurationMs, gatewayjob.FieldLogSeq, gatewayjob.FieldLogBytes:
 			values[i] = new(sql.NullInt64)
 		case gatewayjob.FieldJobID, gatewayjob.FieldUID, gatewayjob.FieldCli, gatewayjob.FieldPrompt, gatewayjob.FieldCwd, gatewayjob.FieldStatus, gatewayjob.FieldOutput, gatewayjob.FieldErrorText, gatewayjob.FieldWorkerID:
 			values[i] = new(sql.NullString)
@@ -155,6 +159,18 @@ func (_m *GatewayJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DurationMs = value.Int64
 			}
+		case gatewayjob.FieldLogSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field log_seq", values[i])
+			} else if value.Valid {
+				_m.LogSeq = value.Int64
+			}
+		case gatewayjob.FieldLogBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field log_bytes", values[i])
+			} else if value.Valid {
+				_m.LogBytes = value.Int64
+			}
 		case gatewayjob.FieldWorkerID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field worker_id", values[i])
@@ -265,6 +281,12 @@ func (_m *GatewayJob) String() string {
 	builder.WriteString("duration_ms=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
 	builder.WriteString(", ")
+	builder.WriteString("log_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogSeq))
+	builder.WriteString(", ")
+	builder.WriteString("log_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogBytes))
+	builder.WriteString(", ")
 	builder.WriteString("worker_id=")
 	builder.WriteString(_m.WorkerID)
 	builder.WriteString(", ")
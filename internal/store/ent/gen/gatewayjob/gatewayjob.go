@@ -35,6 +35,10 @@ const (
 	FieldTruncated = "truncated"
 	// FieldDurationMs holds the string denoting the duration_ms field in the database.
 	FieldDurationMs = "duration_ms"
+	// FieldLogSeq holds the string denoting the log_seq field in the database.
+	FieldLogSeq = "log_seq"
+	// FieldLogBytes holds the string denoting the log_bytes field in the database.
+	FieldLogBytes = "log_bytes"
 	// FieldWorkerID holds the string denoting the worker_id field in the database.
 	FieldWorkerID = "worker_id"
 	// FieldLeaseUntil holds the string denoting the lease_until field in the database.
@@ -65,6 +69,8 @@ var Columns = []string{
 	FieldErrorText,
 	FieldTruncated,
 	FieldDurationMs,
+	FieldLogSeq,
+	FieldLogBytes,
 	FieldWorkerID,
 	FieldLeaseUntil,
 	FieldClaimedAt,
@@ -104,6 +110,10 @@ var (
 	DefaultTruncated bool
 	// DefaultDurationMs holds the default value on creation for the "duration_ms" field.
 	DefaultDurationMs int64
+	// DefaultLogSeq holds the default value on creation for the "log_seq" field.
+	DefaultLogSeq int64
+	// DefaultLogBytes holds the default value on creation for the "log_bytes" field.
+	DefaultLogBytes int64
 	// DefaultWorkerID holds the default value on creation for the "worker_id" field.
 	DefaultWorkerID string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -177,6 +187,16 @@ func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
 }
 
+// ByLogSeq orders the results by the log_seq field.
+func ByLogSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogSeq, opts...).ToFunc()
+}
+
+// ByLogBytes orders the results by the log_bytes field.
+func ByLogBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogBytes, opts...).ToFunc()
+}
+
 // ByWorkerID orders the results by the worker_id field.
 func ByWorkerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWorkerID, opts...).ToFunc()
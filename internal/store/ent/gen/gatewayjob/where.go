@@ -109,6 +109,16 @@ func DurationMs(v int64) predicate.GatewayJob {
 	return predicate.GatewayJob(sql.FieldEQ(FieldDurationMs, v))
 }
 
+// LogSeq applies equality check predicate on the "log_seq" field. It's identical to LogSeqEQ.
+func LogSeq(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldEQ(FieldLogSeq, v))
+}
+
+// LogBytes applies equality check predicate on the "log_bytes" field. It's identical to LogBytesEQ.
+func LogBytes(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldEQ(FieldLogBytes, v))
+}
+
 // WorkerID applies equality check predicate on the "worker_id" field. It's identical to WorkerIDEQ.
 func WorkerID(v string) predicate.GatewayJob {
 	return predicate.GatewayJob(sql.FieldEQ(FieldWorkerID, v))
@@ -759,6 +769,86 @@ func DurationMsLTE(v int64) predicate.GatewayJob {
 	return predicate.GatewayJob(sql.FieldLTE(FieldDurationMs, v))
 }
 
+// LogSeqEQ applies the EQ predicate on the "log_seq" field.
+func LogSeqEQ(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldEQ(FieldLogSeq, v))
+}
+
+// LogSeqNEQ applies the NEQ predicate on the "log_seq" field.
+func LogSeqNEQ(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldNEQ(FieldLogSeq, v))
+}
+
+// LogSeqIn applies the In predicate on the "log_seq" field.
+func LogSeqIn(vs ...int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldIn(FieldLogSeq, vs...))
+}
+
+// LogSeqNotIn applies the NotIn predicate on the "log_seq" field.
+func LogSeqNotIn(vs ...int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldNotIn(FieldLogSeq, vs...))
+}
+
+// LogSeqGT applies the GT predicate on the "log_seq" field.
+func LogSeqGT(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldGT(FieldLogSeq, v))
+}
+
+// LogSeqGTE applies the GTE predicate on the "log_seq" field.
+func LogSeqGTE(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldGTE(FieldLogSeq, v))
+}
+
+// LogSeqLT applies the LT predicate on the "log_seq" field.
+func LogSeqLT(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldLT(FieldLogSeq, v))
+}
+
+// LogSeqLTE applies the LTE predicate on the "log_seq" field.
+func LogSeqLTE(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldLTE(FieldLogSeq, v))
+}
+
+// LogBytesEQ applies the EQ predicate on the "log_bytes" field.
+func LogBytesEQ(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldEQ(FieldLogBytes, v))
+}
+
+// LogBytesNEQ applies the NEQ predicate on the "log_bytes" field.
+func LogBytesNEQ(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldNEQ(FieldLogBytes, v))
+}
+
+// LogBytesIn applies the In predicate on the "log_bytes" field.
+func LogBytesIn(vs ...int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldIn(FieldLogBytes, vs...))
+}
+
+// LogBytesNotIn applies the NotIn predicate on the "log_bytes" field.
+func LogBytesNotIn(vs ...int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldNotIn(FieldLogBytes, vs...))
+}
+
+// LogBytesGT applies the GT predicate on the "log_bytes" field.
+func LogBytesGT(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldGT(FieldLogBytes, v))
+}
+
+// LogBytesGTE applies the GTE predicate on the "log_bytes" field.
+func LogBytesGTE(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldGTE(FieldLogBytes, v))
+}
+
+// LogBytesLT applies the LT predicate on the "log_bytes" field.
+func LogBytesLT(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldLT(FieldLogBytes, v))
+}
+
+// LogBytesLTE applies the LTE predicate on the "log_bytes" field.
+func LogBytesLTE(v int64) predicate.GatewayJob {
+	return predicate.GatewayJob(sql.FieldLTE(FieldLogBytes, v))
+}
+
 // WorkerIDEQ applies the EQ predicate on the "worker_id" field.
 func WorkerIDEQ(v string) predicate.GatewayJob {
 	return predicate.GatewayJob(sql.FieldEQ(FieldWorkerID, v))
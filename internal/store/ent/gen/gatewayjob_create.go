@@ -152,6 +152,34 @@ func (_c *GatewayJobCreate) SetNillableDurationMs(v *int64) *GatewayJobCreate {
 	return _c
 }
 
+// SetLogSeq sets the "log_seq" field.
+func (_c *GatewayJobCreate) SetLogSeq(v int64) *GatewayJobCreate {
+	_c.mutation.SetLogSeq(v)
+	return _c
+}
+
+// SetNillableLogSeq sets the "log_seq" field if the given value is not nil.
+func (_c *GatewayJobCreate) SetNillableLogSeq(v *int64) *GatewayJobCreate {
+	if v != nil {
+		_c.SetLogSeq(*v)
+	}
+	return _c
+}
+
+// SetLogBytes sets the "log_bytes" field.
+func (_c *GatewayJobCreate) SetLogBytes(v int64) *GatewayJobCreate {
+	_c.mutation.SetLogBytes(v)
+	return _c
+}
+
+// SetNillableLogBytes sets the "log_bytes" field if the given value is not nil.
+func (_c *GatewayJobCreate) SetNillableLogBytes(v *int64) *GatewayJobCreate {
+	if v != nil {
+		_c.SetLogBytes(*v)
+	}
+	return _c
+}
+
 // SetWorkerID sets the "worker_id" field.
 func (_c *GatewayJobCreate) SetWorkerID(v string) *GatewayJobCreate {
 	_c.mutation.SetWorkerID(v)
@@ -305,6 +333,14 @@ func (_c *GatewayJobCreate) defaults() {
 		v := gatewayjob.DefaultDurationMs
 		_c.mutation.SetDurationMs(v)
 	}
+	if _, ok := _c.mutation.LogSeq(); !ok {
+		v := gatewayjob.DefaultLogSeq
+		_c.mutation.SetLogSeq(v)
+	}
+	if _, ok := _c.mutation.LogBytes(); !ok {
+		v := gatewayjob.DefaultLogBytes
+		_c.mutation.SetLogBytes(v)
+	}
 	if _, ok := _c.mutation.WorkerID(); !ok {
 		v := gatewayjob.DefaultWorkerID
 		_c.mutation.SetWorkerID(v)
@@ -366,6 +402,12 @@ func (_c *GatewayJobCreate) check() error {
 	if _, ok := _c.mutation.DurationMs(); !ok {
 		return &ValidationError{Name: "duration_ms", err: errors.New(`gen: missing required field "GatewayJob.duration_ms"`)}
 	}
+	if _, ok := _c.mutation.LogSeq(); !ok {
+		return &ValidationError{Name: "log_seq", err: errors.New(`gen: missing required field "GatewayJob.log_seq"`)}
+	}
+	if _, ok := _c.mutation.LogBytes(); !ok {
+		return &ValidationError{Name: "log_bytes", err: errors.New(`gen: missing required field "GatewayJob.log_bytes"`)}
+	}
 	if _, ok := _c.mutation.WorkerID(); !ok {
 		return &ValidationError{Name: "worker_id", err: errors.New(`gen: missing required field "GatewayJob.worker_id"`)}
 	}
@@ -452,6 +494,14 @@ func (_c *GatewayJobCreate) createSpec() (*GatewayJob, *sqlgraph.CreateSpec) {
 		_spec.SetField(gatewayjob.FieldDurationMs, field.TypeInt64, value)
 		_node.DurationMs = value
 	}
+	if value, ok := _c.mutation.LogSeq(); ok {
+		_spec.SetField(gatewayjob.FieldLogSeq, field.TypeInt64, value)
+		_node.LogSeq = value
+	}
+	if value, ok := _c.mutation.LogBytes(); ok {
+		_spec.SetField(gatewayjob.FieldLogBytes, field.TypeInt64, value)
+		_node.LogBytes = value
+	}
 	if value, ok := _c.mutation.WorkerID(); ok {
 		_spec.SetField(gatewayjob.FieldWorkerID, field.TypeString, value)
 		_node.WorkerID = value
@@ -666,6 +716,42 @@ func (u *GatewayJobUpsert) AddDurationMs(v int64) *GatewayJobUpsert {
 	return u
 }
 
+// SetLogSeq sets the "log_seq" field.
+func (u *GatewayJobUpsert) SetLogSeq(v int64) *GatewayJobUpsert {
+	u.Set(gatewayjob.FieldLogSeq, v)
+	return u
+}
+
+// UpdateLogSeq sets the "log_seq" field to the value that was provided on create.
+func (u *GatewayJobUpsert) UpdateLogSeq() *GatewayJobUpsert {
+	u.SetExcluded(gatewayjob.FieldLogSeq)
+	return u
+}
+
+// AddLogSeq adds v to the "log_seq" field.
+func (u *GatewayJobUpsert) AddLogSeq(v int64) *GatewayJobUpsert {
+	u.Add(gatewayjob.FieldLogSeq, v)
+	return u
+}
+
+// SetLogBytes sets the "log_bytes" field.
+func (u *GatewayJobUpsert) SetLogBytes(v int64) *GatewayJobUpsert {
+	u.Set(gatewayjob.FieldLogBytes, v)
+	return u
+}
+
+// UpdateLogBytes sets the "log_bytes" field to the value that was provided on create.
+func (u *GatewayJobUpsert) UpdateLogBytes() *GatewayJobUpsert {
+	u.SetExcluded(gatewayjob.FieldLogBytes)
+	return u
+}
+
+// AddLogBytes adds v to the "log_bytes" field.
+func (u *GatewayJobUpsert) AddLogBytes(v int64) *GatewayJobUpsert {
+	u.Add(gatewayjob.FieldLogBytes, v)
+	return u
+}
+
 // SetWorkerID sets the "worker_id" field.
 func (u *GatewayJobUpsert) SetWorkerID(v string) *GatewayJobUpsert {
 	u.Set(gatewayjob.FieldWorkerID, v)
@@ -959,6 +1045,48 @@ func (u *GatewayJobUpsertOne) UpdateDurationMs() *GatewayJobUpsertOne {
 	})
 }
 
+// SetLogSeq sets the "log_seq" field.
+func (u *GatewayJobUpsertOne) SetLogSeq(v int64) *GatewayJobUpsertOne {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.SetLogSeq(v)
+	})
+}
+
+// AddLogSeq adds v to the "log_seq" field.
+func (u *GatewayJobUpsertOne) AddLogSeq(v int64) *GatewayJobUpsertOne {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.AddLogSeq(v)
+	})
+}
+
+// UpdateLogSeq sets the "log_seq" field to the value that was provided on create.
+func (u *GatewayJobUpsertOne) UpdateLogSeq() *GatewayJobUpsertOne {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.UpdateLogSeq()
+	})
+}
+
+// SetLogBytes sets the "log_bytes" field.
+func (u *GatewayJobUpsertOne) SetLogBytes(v int64) *GatewayJobUpsertOne {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.SetLogBytes(v)
+	})
+}
+
+// AddLogBytes adds v to the "log_bytes" field.
+func (u *GatewayJobUpsertOne) AddLogBytes(v int64) *GatewayJobUpsertOne {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.AddLogBytes(v)
+	})
+}
+
+// UpdateLogBytes sets the "log_bytes" field to the value that was provided on create.
+func (u *GatewayJobUpsertOne) UpdateLogBytes() *GatewayJobUpsertOne {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.UpdateLogBytes()
+	})
+}
+
 // SetWorkerID sets the "worker_id" field.
 func (u *GatewayJobUpsertOne) SetWorkerID(v string) *GatewayJobUpsertOne {
 	return u.Update(func(s *GatewayJobUpsert) {
@@ -1431,6 +1559,48 @@ func (u *GatewayJobUpsertBulk) UpdateDurationMs() *GatewayJobUpsertBulk {
 	})
 }
 
+// SetLogSeq sets the "log_seq" field.
+func (u *GatewayJobUpsertBulk) SetLogSeq(v int64) *GatewayJobUpsertBulk {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.SetLogSeq(v)
+	})
+}
+
+// AddLogSeq adds v to the "log_seq" field.
+func (u *GatewayJobUpsertBulk) AddLogSeq(v int64) *GatewayJobUpsertBulk {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.AddLogSeq(v)
+	})
+}
+
+// UpdateLogSeq sets the "log_seq" field to the value that was provided on create.
+func (u *GatewayJobUpsertBulk) UpdateLogSeq() *GatewayJobUpsertBulk {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.UpdateLogSeq()
+	})
+}
+
+// SetLogBytes sets the "log_bytes" field.
+func (u *GatewayJobUpsertBulk) SetLogBytes(v int64) *GatewayJobUpsertBulk {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.SetLogBytes(v)
+	})
+}
+
+// AddLogBytes adds v to the "log_bytes" field.
+func (u *GatewayJobUpsertBulk) AddLogBytes(v int64) *GatewayJobUpsertBulk {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.AddLogBytes(v)
+	})
+}
+
+// UpdateLogBytes sets the "log_bytes" field to the value that was provided on create.
+func (u *GatewayJobUpsertBulk) UpdateLogBytes() *GatewayJobUpsertBulk {
+	return u.Update(func(s *GatewayJobUpsert) {
+		s.UpdateLogBytes()
+	})
+}
+
 // SetWorkerID sets the "worker_id" field.
 func (u *GatewayJobUpsertBulk) SetWorkerID(v string) *GatewayJobUpsertBulk {
 	return u.Update(func(s *GatewayJobUpsert) {
@@ -18,8 +18,9 @@ import (
 // GatewayJobUpdate is the builder for updating GatewayJob entities.
 type GatewayJobUpdate struct {
 	config
-	hooks    []Hook
-	mutation *GatewayJobMutation
+	hooks     []Hook
+	mutation  *GatewayJobMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the GatewayJobUpdate builder.
@@ -188,6 +189,48 @@ func (_u *GatewayJobUpdate) AddDurationMs(v int64) *GatewayJobUpdate {
 	return _u
 }
 
+// SetLogSeq sets the "log_seq" field.
+func (_u *GatewayJobUpdate) SetLogSeq(v int64) *GatewayJobUpdate {
+	_u.mutation.ResetLogSeq()
+	_u.mutation.SetLogSeq(v)
+	return _u
+}
+
+// SetNillableLogSeq sets the "log_seq" field if the given value is not nil.
+func (_u *GatewayJobUpdate) SetNillableLogSeq(v *int64) *GatewayJobUpdate {
+	if v != nil {
+		_u.SetLogSeq(*v)
+	}
+	return _u
+}
+
+// AddLogSeq adds value to the "log_seq" field.
+func (_u *GatewayJobUpdate) AddLogSeq(v int64) *GatewayJobUpdate {
+	_u.mutation.AddLogSeq(v)
+	return _u
+}
+
+// SetLogBytes sets the "log_bytes" field.
+func (_u *GatewayJobUpdate) SetLogBytes(v int64) *GatewayJobUpdate {
+	_u.mutation.ResetLogBytes()
+	_u.mutation.SetLogBytes(v)
+	return _u
+}
+
+// SetNillableLogBytes sets the "log_bytes" field if the given value is not nil.
+func (_u *GatewayJobUpdate) SetNillableLogBytes(v *int64) *GatewayJobUpdate {
+	if v != nil {
+		_u.SetLogBytes(*v)
+	}
+	return _u
+}
+
+// AddLogBytes adds value to the "log_bytes" field.
+func (_u *GatewayJobUpdate) AddLogBytes(v int64) *GatewayJobUpdate {
+	_u.mutation.AddLogBytes(v)
+	return _u
+}
+
 // SetWorkerID sets the "worker_id" field.
 func (_u *GatewayJobUpdate) SetWorkerID(v string) *GatewayJobUpdate {
 	_u.mutation.SetWorkerID(v)
@@ -324,6 +367,12 @@ func (_u *GatewayJobUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GatewayJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GatewayJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GatewayJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -375,6 +424,18 @@ func (_u *GatewayJobUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedDurationMs(); ok {
 		_spec.AddField(gatewayjob.FieldDurationMs, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.LogSeq(); ok {
+		_spec.SetField(gatewayjob.FieldLogSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLogSeq(); ok {
+		_spec.AddField(gatewayjob.FieldLogSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LogBytes(); ok {
+		_spec.SetField(gatewayjob.FieldLogBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLogBytes(); ok {
+		_spec.AddField(gatewayjob.FieldLogBytes, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.WorkerID(); ok {
 		_spec.SetField(gatewayjob.FieldWorkerID, field.TypeString, value)
 	}
@@ -399,6 +460,7 @@ func (_u *GatewayJobUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(gatewayjob.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{gatewayjob.Label}
@@ -414,9 +476,10 @@ func (_u *GatewayJobUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // GatewayJobUpdateOne is the builder for updating a single GatewayJob entity.
 type GatewayJobUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GatewayJobMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *GatewayJobMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -579,6 +642,48 @@ func (_u *GatewayJobUpdateOne) AddDurationMs(v int64) *GatewayJobUpdateOne {
 	return _u
 }
 
+// SetLogSeq sets the "log_seq" field.
+func (_u *GatewayJobUpdateOne) SetLogSeq(v int64) *GatewayJobUpdateOne {
+	_u.mutation.ResetLogSeq()
+	_u.mutation.SetLogSeq(v)
+	return _u
+}
+
+// SetNillableLogSeq sets the "log_seq" field if the given value is not nil.
+func (_u *GatewayJobUpdateOne) SetNillableLogSeq(v *int64) *GatewayJobUpdateOne {
+	if v != nil {
+		_u.SetLogSeq(*v)
+	}
+	return _u
+}
+
+// AddLogSeq adds value to the "log_seq" field.
+func (_u *GatewayJobUpdateOne) AddLogSeq(v int64) *GatewayJobUpdateOne {
+	_u.mutation.AddLogSeq(v)
+	return _u
+}
+
+// SetLogBytes sets the "log_bytes" field.
+func (_u *GatewayJobUpdateOne) SetLogBytes(v int64) *GatewayJobUpdateOne {
+	_u.mutation.ResetLogBytes()
+	_u.mutation.SetLogBytes(v)
+	return _u
+}
+
+// SetNillableLogBytes sets the "log_bytes" field if the given value is not nil.
+func (_u *GatewayJobUpdateOne) SetNillableLogBytes(v *int64) *GatewayJobUpdateOne {
+	if v != nil {
+		_u.SetLogBytes(*v)
+	}
+	return _u
+}
+
+// AddLogBytes adds value to the "log_bytes" field.
+func (_u *GatewayJobUpdateOne) AddLogBytes(v int64) *GatewayJobUpdateOne {
+	_u.mutation.AddLogBytes(v)
+	return _u
+}
+
 // SetWorkerID sets the "worker_id" field.
 func (_u *GatewayJobUpdateOne) SetWorkerID(v string) *GatewayJobUpdateOne {
 	_u.mutation.SetWorkerID(v)
@@ -728,6 +833,12 @@ func (_u *GatewayJobUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GatewayJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GatewayJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GatewayJobUpdateOne) sqlSave(ctx context.Context) (_node *GatewayJob, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -796,6 +907,18 @@ func (_u *GatewayJobUpdateOne) sqlSave(ctx context.Context) (_node *GatewayJob,
 	if value, ok := _u.mutation.AddedDurationMs(); ok {
 		_spec.AddField(gatewayjob.FieldDurationMs, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.LogSeq(); ok {
+		_spec.SetField(gatewayjob.FieldLogSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLogSeq(); ok {
+		_spec.AddField(gatewayjob.FieldLogSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LogBytes(); ok {
+		_spec.SetField(gatewayjob.FieldLogBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLogBytes(); ok {
+		_spec.AddField(gatewayjob.FieldLogBytes, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.WorkerID(); ok {
 		_spec.SetField(gatewayjob.FieldWorkerID, field.TypeString, value)
 	}
@@ -820,6 +943,7 @@ func (_u *GatewayJobUpdateOne) sqlSave(ctx context.Context) (_node *GatewayJob,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(gatewayjob.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &GatewayJob{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjoblog"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
+)
+
+// GatewayJobLogDelete is the builder for deleting a GatewayJobLog entity.
+type GatewayJobLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *GatewayJobLogMutation
+}
+
+// Where appends a list predicates to the GatewayJobLogDelete builder.
+func (_d *GatewayJobLogDelete) Where(ps ...predicate.GatewayJobLog) *GatewayJobLogDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GatewayJobLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GatewayJobLogDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GatewayJobLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gatewayjoblog.Table, sqlgraph.NewFieldSpec(gatewayjoblog.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GatewayJobLogDeleteOne is the builder for deleting a single GatewayJobLog entity.
+type GatewayJobLogDeleteOne struct {
+	_d *GatewayJobLogDelete
+}
+
+// Where appends a list predicates to the GatewayJobLogDelete builder.
+func (_d *GatewayJobLogDeleteOne) Where(ps ...predicate.GatewayJobLog) *GatewayJobLogDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GatewayJobLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gatewayjoblog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GatewayJobLogDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
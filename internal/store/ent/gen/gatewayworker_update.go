@@ -18,8 +18,9 @@ import (
 // GatewayWorkerUpdate is the builder for updating GatewayWorker entities.
 type GatewayWorkerUpdate struct {
 	config
-	hooks    []Hook
-	mutation *GatewayWorkerMutation
+	hooks     []Hook
+	mutation  *GatewayWorkerMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the GatewayWorkerUpdate builder.
@@ -89,6 +90,12 @@ func (_u *GatewayWorkerUpdate) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GatewayWorkerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GatewayWorkerUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GatewayWorkerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(gatewayworker.Table, gatewayworker.Columns, sqlgraph.NewFieldSpec(gatewayworker.FieldID, field.TypeInt64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -104,6 +111,7 @@ func (_u *GatewayWorkerUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(gatewayworker.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{gatewayworker.Label}
@@ -119,9 +127,10 @@ func (_u *GatewayWorkerUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // GatewayWorkerUpdateOne is the builder for updating a single GatewayWorker entity.
 type GatewayWorkerUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GatewayWorkerMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *GatewayWorkerMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetLastSeenAt sets the "last_seen_at" field.
@@ -198,6 +207,12 @@ func (_u *GatewayWorkerUpdateOne) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GatewayWorkerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GatewayWorkerUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GatewayWorkerUpdateOne) sqlSave(ctx context.Context) (_node *GatewayWorker, err error) {
 	_spec := sqlgraph.NewUpdateSpec(gatewayworker.Table, gatewayworker.Columns, sqlgraph.NewFieldSpec(gatewayworker.FieldID, field.TypeInt64))
 	id, ok := _u.mutation.ID()
@@ -230,6 +245,7 @@ func (_u *GatewayWorkerUpdateOne) sqlSave(ctx context.Context) (_node *GatewayWo
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(gatewayworker.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &GatewayWorker{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -21,6 +21,18 @@ func (f AgentFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.AgentMutation", m)
 }
 
+// The AgentEmbeddingFunc type is an adapter to allow the use of ordinary
+// function as AgentEmbedding mutator.
+type AgentEmbeddingFunc func(context.Context, *gen.AgentEmbeddingMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AgentEmbeddingFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.AgentEmbeddingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.AgentEmbeddingMutation", m)
+}
+
 // The AgentKnowledgeFunc type is an adapter to allow the use of ordinary
 // function as AgentKnowledge mutator.
 type AgentKnowledgeFunc func(context.Context, *gen.AgentKnowledgeMutation) (gen.Value, error)
@@ -273,6 +285,18 @@ func (f ClipFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.ClipMutation", m)
 }
 
+// The ClipVersionFunc type is an adapter to allow the use of ordinary
+// function as ClipVersion mutator.
+type ClipVersionFunc func(context.Context, *gen.ClipVersionMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClipVersionFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.ClipVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.ClipVersionMutation", m)
+}
+
 // The ConfigDataFunc type is an adapter to allow the use of ordinary
 // function as ConfigData mutator.
 type ConfigDataFunc func(context.Context, *gen.ConfigDataMutation) (gen.Value, error)
@@ -441,6 +465,18 @@ func (f GatewayJobFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.GatewayJobMutation", m)
 }
 
+// The GatewayJobLogFunc type is an adapter to allow the use of ordinary
+// function as GatewayJobLog mutator.
+type GatewayJobLogFunc func(context.Context, *gen.GatewayJobLogMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GatewayJobLogFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.GatewayJobLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.GatewayJobLogMutation", m)
+}
+
 // The GatewayWorkerFunc type is an adapter to allow the use of ordinary
 // function as GatewayWorker mutator.
 type GatewayWorkerFunc func(context.Context, *gen.GatewayWorkerMutation) (gen.Value, error)
@@ -993,6 +1029,18 @@ func (f ResourceLinkFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.ResourceLinkMutation", m)
 }
 
+// The SearchDocumentFunc type is an adapter to allow the use of ordinary
+// function as SearchDocument mutator.
+type SearchDocumentFunc func(context.Context, *gen.SearchDocumentMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchDocumentFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.SearchDocumentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.SearchDocumentMutation", m)
+}
+
 // The TopicFunc type is an adapter to allow the use of ordinary
 // function as Topic mutator.
 type TopicFunc func(context.Context, *gen.TopicMutation) (gen.Value, error)
@@ -1041,6 +1089,18 @@ func (f WebAccountFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.WebAccountMutation", m)
 }
 
+// The WebAccountIdentityFunc type is an adapter to allow the use of ordinary
+// function as WebAccountIdentity mutator.
+type WebAccountIdentityFunc func(context.Context, *gen.WebAccountIdentityMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebAccountIdentityFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.WebAccountIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.WebAccountIdentityMutation", m)
+}
+
 // The WorkflowFunc type is an adapter to allow the use of ordinary
 // function as Workflow mutator.
 type WorkflowFunc func(context.Context, *gen.WorkflowMutation) (gen.Value, error)
@@ -18,8 +18,9 @@ import (
 // InstructUpdate is the builder for updating Instruct entities.
 type InstructUpdate struct {
 	config
-	hooks    []Hook
-	mutation *InstructMutation
+	hooks     []Hook
+	mutation  *InstructMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the InstructUpdate builder.
@@ -237,6 +238,12 @@ func (_u *InstructUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InstructUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InstructUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *InstructUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -285,6 +292,7 @@ func (_u *InstructUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(instruct.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{instruct.Label}
@@ -300,9 +308,10 @@ func (_u *InstructUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // InstructUpdateOne is the builder for updating a single Instruct entity.
 type InstructUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *InstructMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *InstructMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetNo sets the "no" field.
@@ -527,6 +536,12 @@ func (_u *InstructUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InstructUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InstructUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *InstructUpdateOne) sqlSave(ctx context.Context) (_node *Instruct, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -592,6 +607,7 @@ func (_u *InstructUpdateOne) sqlSave(ctx context.Context) (_node *Instruct, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(instruct.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Instruct{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
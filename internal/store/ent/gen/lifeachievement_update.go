@@ -18,8 +18,9 @@ import (
 // LifeAchievementUpdate is the builder for updating LifeAchievement entities.
 type LifeAchievementUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeAchievementMutation
+	hooks     []Hook
+	mutation  *LifeAchievementMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeAchievementUpdate builder.
@@ -230,6 +231,12 @@ func (_u *LifeAchievementUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAchievementUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAchievementUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAchievementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -278,6 +285,7 @@ func (_u *LifeAchievementUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeachievement.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeachievement.Label}
@@ -293,9 +301,10 @@ func (_u *LifeAchievementUpdate) sqlSave(ctx context.Context) (_node int, err er
 // LifeAchievementUpdateOne is the builder for updating a single LifeAchievement entity.
 type LifeAchievementUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeAchievementMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeAchievementMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -513,6 +522,12 @@ func (_u *LifeAchievementUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAchievementUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAchievementUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAchievementUpdateOne) sqlSave(ctx context.Context) (_node *LifeAchievement, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -578,6 +593,7 @@ func (_u *LifeAchievementUpdateOne) sqlSave(ctx context.Context) (_node *LifeAch
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeachievement.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeAchievement{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeAchievementProgressUpdate is the builder for updating LifeAchievementProgress entities.
 type LifeAchievementProgressUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeAchievementProgressMutation
+	hooks     []Hook
+	mutation  *LifeAchievementProgressMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeAchievementProgressUpdate builder.
@@ -160,6 +161,12 @@ func (_u *LifeAchievementProgressUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAchievementProgressUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAchievementProgressUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAchievementProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -193,6 +200,7 @@ func (_u *LifeAchievementProgressUpdate) sqlSave(ctx context.Context) (_node int
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeachievementprogress.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeachievementprogress.Label}
@@ -208,9 +216,10 @@ func (_u *LifeAchievementProgressUpdate) sqlSave(ctx context.Context) (_node int
 // LifeAchievementProgressUpdateOne is the builder for updating a single LifeAchievementProgress entity.
 type LifeAchievementProgressUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeAchievementProgressMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeAchievementProgressMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -358,6 +367,12 @@ func (_u *LifeAchievementProgressUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAchievementProgressUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAchievementProgressUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAchievementProgressUpdateOne) sqlSave(ctx context.Context) (_node *LifeAchievementProgress, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -408,6 +423,7 @@ func (_u *LifeAchievementProgressUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeachievementprogress.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeAchievementProgress{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,8 +17,9 @@ import (
 // LifeAchievementUnlockUpdate is the builder for updating LifeAchievementUnlock entities.
 type LifeAchievementUnlockUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeAchievementUnlockMutation
+	hooks     []Hook
+	mutation  *LifeAchievementUnlockMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeAchievementUnlockUpdate builder.
@@ -123,6 +124,12 @@ func (_u *LifeAchievementUnlockUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAchievementUnlockUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAchievementUnlockUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAchievementUnlockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -147,6 +154,7 @@ func (_u *LifeAchievementUnlockUpdate) sqlSave(ctx context.Context) (_node int,
 	if value, ok := _u.mutation.AchievementFlag(); ok {
 		_spec.SetField(lifeachievementunlock.FieldAchievementFlag, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeachievementunlock.Label}
@@ -162,9 +170,10 @@ func (_u *LifeAchievementUnlockUpdate) sqlSave(ctx context.Context) (_node int,
 // LifeAchievementUnlockUpdateOne is the builder for updating a single LifeAchievementUnlock entity.
 type LifeAchievementUnlockUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeAchievementUnlockMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeAchievementUnlockMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -276,6 +285,12 @@ func (_u *LifeAchievementUnlockUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAchievementUnlockUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAchievementUnlockUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAchievementUnlockUpdateOne) sqlSave(ctx context.Context) (_node *LifeAchievementUnlock, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -317,6 +332,7 @@ func (_u *LifeAchievementUnlockUpdateOne) sqlSave(ctx context.Context) (_node *L
 	if value, ok := _u.mutation.AchievementFlag(); ok {
 		_spec.SetField(lifeachievementunlock.FieldAchievementFlag, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeAchievementUnlock{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
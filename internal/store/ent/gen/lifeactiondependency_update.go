@@ -17,8 +17,9 @@ import (
 // LifeActionDependencyUpdate is the builder for updating LifeActionDependency entities.
 type LifeActionDependencyUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeActionDependencyMutation
+	hooks     []Hook
+	mutation  *LifeActionDependencyMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeActionDependencyUpdate builder.
@@ -101,6 +102,12 @@ func (_u *LifeActionDependencyUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionDependencyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionDependencyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionDependencyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeactiondependency.Table, lifeactiondependency.Columns, sqlgraph.NewFieldSpec(lifeactiondependency.FieldID, field.TypeInt64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -122,6 +129,7 @@ func (_u *LifeActionDependencyUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.AddedDependsOnPlanNodeID(); ok {
 		_spec.AddField(lifeactiondependency.FieldDependsOnPlanNodeID, field.TypeInt64, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeactiondependency.Label}
@@ -137,9 +145,10 @@ func (_u *LifeActionDependencyUpdate) sqlSave(ctx context.Context) (_node int, e
 // LifeActionDependencyUpdateOne is the builder for updating a single LifeActionDependency entity.
 type LifeActionDependencyUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeActionDependencyMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeActionDependencyMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetActionPlanNodeID sets the "action_plan_node_id" field.
@@ -229,6 +238,12 @@ func (_u *LifeActionDependencyUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionDependencyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionDependencyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionDependencyUpdateOne) sqlSave(ctx context.Context) (_node *LifeActionDependency, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeactiondependency.Table, lifeactiondependency.Columns, sqlgraph.NewFieldSpec(lifeactiondependency.FieldID, field.TypeInt64))
 	id, ok := _u.mutation.ID()
@@ -267,6 +282,7 @@ func (_u *LifeActionDependencyUpdateOne) sqlSave(ctx context.Context) (_node *Li
 	if value, ok := _u.mutation.AddedDependsOnPlanNodeID(); ok {
 		_spec.AddField(lifeactiondependency.FieldDependsOnPlanNodeID, field.TypeInt64, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeActionDependency{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,8 +17,9 @@ import (
 // LifeActionLogUpdate is the builder for updating LifeActionLog entities.
 type LifeActionLogUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeActionLogMutation
+	hooks     []Hook
+	mutation  *LifeActionLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeActionLogUpdate builder.
@@ -282,6 +283,12 @@ func (_u *LifeActionLogUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -357,6 +364,7 @@ func (_u *LifeActionLogUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.DiceRollResultCleared() {
 		_spec.ClearField(lifeactionlog.FieldDiceRollResult, field.TypeFloat64)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeactionlog.Label}
@@ -372,9 +380,10 @@ func (_u *LifeActionLogUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // LifeActionLogUpdateOne is the builder for updating a single LifeActionLog entity.
 type LifeActionLogUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeActionLogMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeActionLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -645,6 +654,12 @@ func (_u *LifeActionLogUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionLogUpdateOne) sqlSave(ctx context.Context) (_node *LifeActionLog, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -737,6 +752,7 @@ func (_u *LifeActionLogUpdateOne) sqlSave(ctx context.Context) (_node *LifeActio
 	if _u.mutation.DiceRollResultCleared() {
 		_spec.ClearField(lifeactionlog.FieldDiceRollResult, field.TypeFloat64)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeActionLog{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
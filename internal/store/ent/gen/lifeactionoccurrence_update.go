@@ -18,8 +18,9 @@ import (
 // LifeActionOccurrenceUpdate is the builder for updating LifeActionOccurrence entities.
 type LifeActionOccurrenceUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeActionOccurrenceMutation
+	hooks     []Hook
+	mutation  *LifeActionOccurrenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeActionOccurrenceUpdate builder.
@@ -244,6 +245,12 @@ func (_u *LifeActionOccurrenceUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionOccurrenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionOccurrenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionOccurrenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -301,6 +308,7 @@ func (_u *LifeActionOccurrenceUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeactionoccurrence.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeactionoccurrence.Label}
@@ -316,9 +324,10 @@ func (_u *LifeActionOccurrenceUpdate) sqlSave(ctx context.Context) (_node int, e
 // LifeActionOccurrenceUpdateOne is the builder for updating a single LifeActionOccurrence entity.
 type LifeActionOccurrenceUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeActionOccurrenceMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeActionOccurrenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -550,6 +559,12 @@ func (_u *LifeActionOccurrenceUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionOccurrenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionOccurrenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionOccurrenceUpdateOne) sqlSave(ctx context.Context) (_node *LifeActionOccurrence, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -624,6 +639,7 @@ func (_u *LifeActionOccurrenceUpdateOne) sqlSave(ctx context.Context) (_node *Li
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeactionoccurrence.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeActionOccurrence{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
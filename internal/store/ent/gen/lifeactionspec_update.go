@@ -18,8 +18,9 @@ import (
 // LifeActionSpecUpdate is the builder for updating LifeActionSpec entities.
 type LifeActionSpecUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeActionSpecMutation
+	hooks     []Hook
+	mutation  *LifeActionSpecMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeActionSpecUpdate builder.
@@ -284,6 +285,12 @@ func (_u *LifeActionSpecUpdate) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionSpecUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionSpecUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionSpecUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeactionspec.Table, lifeactionspec.Columns, sqlgraph.NewFieldSpec(lifeactionspec.FieldID, field.TypeInt64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -347,6 +354,7 @@ func (_u *LifeActionSpecUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeactionspec.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeactionspec.Label}
@@ -362,9 +370,10 @@ func (_u *LifeActionSpecUpdate) sqlSave(ctx context.Context) (_node int, err err
 // LifeActionSpecUpdateOne is the builder for updating a single LifeActionSpec entity.
 type LifeActionSpecUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeActionSpecMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeActionSpecMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPlanNodeID sets the "plan_node_id" field.
@@ -636,6 +645,12 @@ func (_u *LifeActionSpecUpdateOne) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeActionSpecUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeActionSpecUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeActionSpecUpdateOne) sqlSave(ctx context.Context) (_node *LifeActionSpec, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeactionspec.Table, lifeactionspec.Columns, sqlgraph.NewFieldSpec(lifeactionspec.FieldID, field.TypeInt64))
 	id, ok := _u.mutation.ID()
@@ -716,6 +731,7 @@ func (_u *LifeActionSpecUpdateOne) sqlSave(ctx context.Context) (_node *LifeActi
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeactionspec.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeActionSpec{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
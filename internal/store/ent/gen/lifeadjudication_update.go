@@ -19,8 +19,9 @@ import (
 // LifeAdjudicationUpdate is the builder for updating LifeAdjudication entities.
 type LifeAdjudicationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeAdjudicationMutation
+	hooks     []Hook
+	mutation  *LifeAdjudicationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeAdjudicationUpdate builder.
@@ -270,6 +271,12 @@ func (_u *LifeAdjudicationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAdjudicationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAdjudicationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAdjudicationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -343,6 +350,7 @@ func (_u *LifeAdjudicationUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.AppliedAtCleared() {
 		_spec.ClearField(lifeadjudication.FieldAppliedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeadjudication.Label}
@@ -358,9 +366,10 @@ func (_u *LifeAdjudicationUpdate) sqlSave(ctx context.Context) (_node int, err e
 // LifeAdjudicationUpdateOne is the builder for updating a single LifeAdjudication entity.
 type LifeAdjudicationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeAdjudicationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeAdjudicationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -617,6 +626,12 @@ func (_u *LifeAdjudicationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAdjudicationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAdjudicationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAdjudicationUpdateOne) sqlSave(ctx context.Context) (_node *LifeAdjudication, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -707,6 +722,7 @@ func (_u *LifeAdjudicationUpdateOne) sqlSave(ctx context.Context) (_node *LifeAd
 	if _u.mutation.AppliedAtCleared() {
 		_spec.ClearField(lifeadjudication.FieldAppliedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeAdjudication{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeAIContextUpdate is the builder for updating LifeAIContext entities.
 type LifeAIContextUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeAIContextMutation
+	hooks     []Hook
+	mutation  *LifeAIContextMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeAIContextUpdate builder.
@@ -143,6 +144,12 @@ func (_u *LifeAIContextUpdate) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAIContextUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAIContextUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAIContextUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeaicontext.Table, lifeaicontext.Columns, sqlgraph.NewFieldSpec(lifeaicontext.FieldID, field.TypeInt64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -176,6 +183,7 @@ func (_u *LifeAIContextUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeaicontext.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeaicontext.Label}
@@ -191,9 +199,10 @@ func (_u *LifeAIContextUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // LifeAIContextUpdateOne is the builder for updating a single LifeAIContext entity.
 type LifeAIContextUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeAIContextMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeAIContextMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetLifeProfileID sets the "life_profile_id" field.
@@ -324,6 +333,12 @@ func (_u *LifeAIContextUpdateOne) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeAIContextUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeAIContextUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeAIContextUpdateOne) sqlSave(ctx context.Context) (_node *LifeAIContext, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeaicontext.Table, lifeaicontext.Columns, sqlgraph.NewFieldSpec(lifeaicontext.FieldID, field.TypeInt64))
 	id, ok := _u.mutation.ID()
@@ -374,6 +389,7 @@ func (_u *LifeAIContextUpdateOne) sqlSave(ctx context.Context) (_node *LifeAICon
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeaicontext.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeAIContext{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
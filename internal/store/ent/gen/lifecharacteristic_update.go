@@ -18,8 +18,9 @@ import (
 // LifeCharacteristicUpdate is the builder for updating LifeCharacteristic entities.
 type LifeCharacteristicUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeCharacteristicMutation
+	hooks     []Hook
+	mutation  *LifeCharacteristicMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeCharacteristicUpdate builder.
@@ -200,6 +201,12 @@ func (_u *LifeCharacteristicUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeCharacteristicUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeCharacteristicUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeCharacteristicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -242,6 +249,7 @@ func (_u *LifeCharacteristicUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifecharacteristic.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifecharacteristic.Label}
@@ -257,9 +265,10 @@ func (_u *LifeCharacteristicUpdate) sqlSave(ctx context.Context) (_node int, err
 // LifeCharacteristicUpdateOne is the builder for updating a single LifeCharacteristic entity.
 type LifeCharacteristicUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeCharacteristicMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeCharacteristicMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -447,6 +456,12 @@ func (_u *LifeCharacteristicUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeCharacteristicUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeCharacteristicUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeCharacteristicUpdateOne) sqlSave(ctx context.Context) (_node *LifeCharacteristic, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -506,6 +521,7 @@ func (_u *LifeCharacteristicUpdateOne) sqlSave(ctx context.Context) (_node *Life
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifecharacteristic.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeCharacteristic{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
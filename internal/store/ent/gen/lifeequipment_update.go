@@ -17,8 +17,9 @@ import (
 // LifeEquipmentUpdate is the builder for updating LifeEquipment entities.
 type LifeEquipmentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeEquipmentMutation
+	hooks     []Hook
+	mutation  *LifeEquipmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeEquipmentUpdate builder.
@@ -173,6 +174,12 @@ func (_u *LifeEquipmentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeEquipmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeEquipmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeEquipmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -212,6 +219,7 @@ func (_u *LifeEquipmentUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AiLoreText(); ok {
 		_spec.SetField(lifeequipment.FieldAiLoreText, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeequipment.Label}
@@ -227,9 +235,10 @@ func (_u *LifeEquipmentUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // LifeEquipmentUpdateOne is the builder for updating a single LifeEquipment entity.
 type LifeEquipmentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeEquipmentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeEquipmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -391,6 +400,12 @@ func (_u *LifeEquipmentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeEquipmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeEquipmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeEquipmentUpdateOne) sqlSave(ctx context.Context) (_node *LifeEquipment, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -447,6 +462,7 @@ func (_u *LifeEquipmentUpdateOne) sqlSave(ctx context.Context) (_node *LifeEquip
 	if value, ok := _u.mutation.AiLoreText(); ok {
 		_spec.SetField(lifeequipment.FieldAiLoreText, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeEquipment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
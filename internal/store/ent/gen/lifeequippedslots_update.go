@@ -18,8 +18,9 @@ import (
 // LifeEquippedSlotsUpdate is the builder for updating LifeEquippedSlots entities.
 type LifeEquippedSlotsUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeEquippedSlotsMutation
+	hooks     []Hook
+	mutation  *LifeEquippedSlotsMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeEquippedSlotsUpdate builder.
@@ -278,6 +279,12 @@ func (_u *LifeEquippedSlotsUpdate) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeEquippedSlotsUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeEquippedSlotsUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeEquippedSlotsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeequippedslots.Table, lifeequippedslots.Columns, sqlgraph.NewFieldSpec(lifeequippedslots.FieldID, field.TypeInt64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -356,6 +363,7 @@ func (_u *LifeEquippedSlotsUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeequippedslots.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeequippedslots.Label}
@@ -371,9 +379,10 @@ func (_u *LifeEquippedSlotsUpdate) sqlSave(ctx context.Context) (_node int, err
 // LifeEquippedSlotsUpdateOne is the builder for updating a single LifeEquippedSlots entity.
 type LifeEquippedSlotsUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeEquippedSlotsMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeEquippedSlotsMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetLifeProfileID sets the "life_profile_id" field.
@@ -639,6 +648,12 @@ func (_u *LifeEquippedSlotsUpdateOne) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeEquippedSlotsUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeEquippedSlotsUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeEquippedSlotsUpdateOne) sqlSave(ctx context.Context) (_node *LifeEquippedSlots, err error) {
 	_spec := sqlgraph.NewUpdateSpec(lifeequippedslots.Table, lifeequippedslots.Columns, sqlgraph.NewFieldSpec(lifeequippedslots.FieldID, field.TypeInt64))
 	id, ok := _u.mutation.ID()
@@ -734,6 +749,7 @@ func (_u *LifeEquippedSlotsUpdateOne) sqlSave(ctx context.Context) (_node *LifeE
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeequippedslots.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeEquippedSlots{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
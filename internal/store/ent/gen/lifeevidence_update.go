@@ -17,8 +17,9 @@ import (
 // LifeEvidenceUpdate is the builder for updating LifeEvidence entities.
 type LifeEvidenceUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeEvidenceMutation
+	hooks     []Hook
+	mutation  *LifeEvidenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeEvidenceUpdate builder.
@@ -187,6 +188,12 @@ func (_u *LifeEvidenceUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeEvidenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeEvidenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeEvidenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -229,6 +236,7 @@ func (_u *LifeEvidenceUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.Summary(); ok {
 		_spec.SetField(lifeevidence.FieldSummary, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeevidence.Label}
@@ -244,9 +252,10 @@ func (_u *LifeEvidenceUpdate) sqlSave(ctx context.Context) (_node int, err error
 // LifeEvidenceUpdateOne is the builder for updating a single LifeEvidence entity.
 type LifeEvidenceUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeEvidenceMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeEvidenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -422,6 +431,12 @@ func (_u *LifeEvidenceUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeEvidenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeEvidenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeEvidenceUpdateOne) sqlSave(ctx context.Context) (_node *LifeEvidence, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -481,6 +496,7 @@ func (_u *LifeEvidenceUpdateOne) sqlSave(ctx context.Context) (_node *LifeEviden
 	if value, ok := _u.mutation.Summary(); ok {
 		_spec.SetField(lifeevidence.FieldSummary, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeEvidence{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
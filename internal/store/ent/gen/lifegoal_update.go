@@ -18,8 +18,9 @@ import (
 // LifeGoalUpdate is the builder for updating LifeGoal entities.
 type LifeGoalUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeGoalMutation
+	hooks     []Hook
+	mutation  *LifeGoalMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeGoalUpdate builder.
@@ -194,6 +195,12 @@ func (_u *LifeGoalUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeGoalUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeGoalUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeGoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -236,6 +243,7 @@ func (_u *LifeGoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifegoal.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifegoal.Label}
@@ -251,9 +259,10 @@ func (_u *LifeGoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // LifeGoalUpdateOne is the builder for updating a single LifeGoal entity.
 type LifeGoalUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeGoalMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeGoalMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -435,6 +444,12 @@ func (_u *LifeGoalUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeGoalUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeGoalUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeGoalUpdateOne) sqlSave(ctx context.Context) (_node *LifeGoal, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -494,6 +509,7 @@ func (_u *LifeGoalUpdateOne) sqlSave(ctx context.Context) (_node *LifeGoal, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifegoal.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeGoal{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeHabitCheckinUpdate is the builder for updating LifeHabitCheckin entities.
 type LifeHabitCheckinUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeHabitCheckinMutation
+	hooks     []Hook
+	mutation  *LifeHabitCheckinMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeHabitCheckinUpdate builder.
@@ -183,6 +184,12 @@ func (_u *LifeHabitCheckinUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeHabitCheckinUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeHabitCheckinUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeHabitCheckinUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -222,6 +229,7 @@ func (_u *LifeHabitCheckinUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifehabitcheckin.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifehabitcheckin.Label}
@@ -237,9 +245,10 @@ func (_u *LifeHabitCheckinUpdate) sqlSave(ctx context.Context) (_node int, err e
 // LifeHabitCheckinUpdateOne is the builder for updating a single LifeHabitCheckin entity.
 type LifeHabitCheckinUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeHabitCheckinMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeHabitCheckinMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -410,6 +419,12 @@ func (_u *LifeHabitCheckinUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeHabitCheckinUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeHabitCheckinUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeHabitCheckinUpdateOne) sqlSave(ctx context.Context) (_node *LifeHabitCheckin, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -466,6 +481,7 @@ func (_u *LifeHabitCheckinUpdateOne) sqlSave(ctx context.Context) (_node *LifeHa
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifehabitcheckin.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeHabitCheckin{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
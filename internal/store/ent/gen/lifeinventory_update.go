@@ -18,8 +18,9 @@ import (
 // LifeInventoryUpdate is the builder for updating LifeInventory entities.
 type LifeInventoryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeInventoryMutation
+	hooks     []Hook
+	mutation  *LifeInventoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeInventoryUpdate builder.
@@ -227,6 +228,12 @@ func (_u *LifeInventoryUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeInventoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeInventoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeInventoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -284,6 +291,7 @@ func (_u *LifeInventoryUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.TarnishedUntilCleared() {
 		_spec.ClearField(lifeinventory.FieldTarnishedUntil, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeinventory.Label}
@@ -299,9 +307,10 @@ func (_u *LifeInventoryUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // LifeInventoryUpdateOne is the builder for updating a single LifeInventory entity.
 type LifeInventoryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeInventoryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeInventoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -516,6 +525,12 @@ func (_u *LifeInventoryUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeInventoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeInventoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeInventoryUpdateOne) sqlSave(ctx context.Context) (_node *LifeInventory, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -590,6 +605,7 @@ func (_u *LifeInventoryUpdateOne) sqlSave(ctx context.Context) (_node *LifeInven
 	if _u.mutation.TarnishedUntilCleared() {
 		_spec.ClearField(lifeinventory.FieldTarnishedUntil, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeInventory{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
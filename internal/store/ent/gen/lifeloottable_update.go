@@ -19,8 +19,9 @@ import (
 // LifeLootTableUpdate is the builder for updating LifeLootTable entities.
 type LifeLootTableUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeLootTableMutation
+	hooks     []Hook
+	mutation  *LifeLootTableMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeLootTableUpdate builder.
@@ -139,6 +140,12 @@ func (_u *LifeLootTableUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeLootTableUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeLootTableUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeLootTableUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -174,6 +181,7 @@ func (_u *LifeLootTableUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeloottable.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeloottable.Label}
@@ -189,9 +197,10 @@ func (_u *LifeLootTableUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // LifeLootTableUpdateOne is the builder for updating a single LifeLootTable entity.
 type LifeLootTableUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeLootTableMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeLootTableMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetDropTier sets the "drop_tier" field.
@@ -317,6 +326,12 @@ func (_u *LifeLootTableUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeLootTableUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeLootTableUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeLootTableUpdateOne) sqlSave(ctx context.Context) (_node *LifeLootTable, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -369,6 +384,7 @@ func (_u *LifeLootTableUpdateOne) sqlSave(ctx context.Context) (_node *LifeLootT
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeloottable.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeLootTable{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
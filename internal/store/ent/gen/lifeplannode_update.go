@@ -18,8 +18,9 @@ import (
 // LifePlanNodeUpdate is the builder for updating LifePlanNode entities.
 type LifePlanNodeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifePlanNodeMutation
+	hooks     []Hook
+	mutation  *LifePlanNodeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifePlanNodeUpdate builder.
@@ -234,6 +235,12 @@ func (_u *LifePlanNodeUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifePlanNodeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifePlanNodeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifePlanNodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -285,6 +292,7 @@ func (_u *LifePlanNodeUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeplannode.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeplannode.Label}
@@ -300,9 +308,10 @@ func (_u *LifePlanNodeUpdate) sqlSave(ctx context.Context) (_node int, err error
 // LifePlanNodeUpdateOne is the builder for updating a single LifePlanNode entity.
 type LifePlanNodeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifePlanNodeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifePlanNodeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -524,6 +533,12 @@ func (_u *LifePlanNodeUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifePlanNodeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifePlanNodeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifePlanNodeUpdateOne) sqlSave(ctx context.Context) (_node *LifePlanNode, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -592,6 +607,7 @@ func (_u *LifePlanNodeUpdateOne) sqlSave(ctx context.Context) (_node *LifePlanNo
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeplannode.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifePlanNode{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeProfileUpdate is the builder for updating LifeProfile entities.
 type LifeProfileUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeProfileMutation
+	hooks     []Hook
+	mutation  *LifeProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeProfileUpdate builder.
@@ -242,6 +243,12 @@ func (_u *LifeProfileUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeProfileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeProfileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -299,6 +306,7 @@ func (_u *LifeProfileUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeprofile.Label}
@@ -314,9 +322,10 @@ func (_u *LifeProfileUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // LifeProfileUpdateOne is the builder for updating a single LifeProfile entity.
 type LifeProfileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeProfileMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -546,6 +555,12 @@ func (_u *LifeProfileUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeProfileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeProfileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeProfileUpdateOne) sqlSave(ctx context.Context) (_node *LifeProfile, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -620,6 +635,7 @@ func (_u *LifeProfileUpdateOne) sqlSave(ctx context.Context) (_node *LifeProfile
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeProfile{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeQuestUpdate is the builder for updating LifeQuest entities.
 type LifeQuestUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeQuestMutation
+	hooks     []Hook
+	mutation  *LifeQuestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeQuestUpdate builder.
@@ -340,6 +341,12 @@ func (_u *LifeQuestUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeQuestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeQuestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeQuestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -421,6 +428,7 @@ func (_u *LifeQuestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(lifequest.FieldCompletedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifequest.Label}
@@ -436,9 +444,10 @@ func (_u *LifeQuestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // LifeQuestUpdateOne is the builder for updating a single LifeQuest entity.
 type LifeQuestUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeQuestMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeQuestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -766,6 +775,12 @@ func (_u *LifeQuestUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeQuestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeQuestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeQuestUpdateOne) sqlSave(ctx context.Context) (_node *LifeQuest, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -864,6 +879,7 @@ func (_u *LifeQuestUpdateOne) sqlSave(ctx context.Context) (_node *LifeQuest, er
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(lifequest.FieldCompletedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeQuest{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeRewardUpdate is the builder for updating LifeReward entities.
 type LifeRewardUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeRewardMutation
+	hooks     []Hook
+	mutation  *LifeRewardMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeRewardUpdate builder.
@@ -229,6 +230,12 @@ func (_u *LifeRewardUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeRewardUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeRewardUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeRewardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -280,6 +287,7 @@ func (_u *LifeRewardUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifereward.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifereward.Label}
@@ -295,9 +303,10 @@ func (_u *LifeRewardUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // LifeRewardUpdateOne is the builder for updating a single LifeReward entity.
 type LifeRewardUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeRewardMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeRewardMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -514,6 +523,12 @@ func (_u *LifeRewardUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeRewardUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeRewardUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeRewardUpdateOne) sqlSave(ctx context.Context) (_node *LifeReward, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -582,6 +597,7 @@ func (_u *LifeRewardUpdateOne) sqlSave(ctx context.Context) (_node *LifeReward,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifereward.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeReward{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,8 +17,9 @@ import (
 // LifeRewardRedemptionUpdate is the builder for updating LifeRewardRedemption entities.
 type LifeRewardRedemptionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeRewardRedemptionMutation
+	hooks     []Hook
+	mutation  *LifeRewardRedemptionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeRewardRedemptionUpdate builder.
@@ -165,6 +166,12 @@ func (_u *LifeRewardRedemptionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeRewardRedemptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeRewardRedemptionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeRewardRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -201,6 +208,7 @@ func (_u *LifeRewardRedemptionUpdate) sqlSave(ctx context.Context) (_node int, e
 	if value, ok := _u.mutation.AddedPricePaid(); ok {
 		_spec.AddField(liferewardredemption.FieldPricePaid, field.TypeInt, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{liferewardredemption.Label}
@@ -216,9 +224,10 @@ func (_u *LifeRewardRedemptionUpdate) sqlSave(ctx context.Context) (_node int, e
 // LifeRewardRedemptionUpdateOne is the builder for updating a single LifeRewardRedemption entity.
 type LifeRewardRedemptionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeRewardRedemptionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeRewardRedemptionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -372,6 +381,12 @@ func (_u *LifeRewardRedemptionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeRewardRedemptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeRewardRedemptionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeRewardRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *LifeRewardRedemption, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -425,6 +440,7 @@ func (_u *LifeRewardRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *Li
 	if value, ok := _u.mutation.AddedPricePaid(); ok {
 		_spec.AddField(liferewardredemption.FieldPricePaid, field.TypeInt, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeRewardRedemption{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // LifeSkillUpdate is the builder for updating LifeSkill entities.
 type LifeSkillUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LifeSkillMutation
+	hooks     []Hook
+	mutation  *LifeSkillMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LifeSkillUpdate builder.
@@ -223,6 +224,12 @@ func (_u *LifeSkillUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeSkillUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeSkillUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeSkillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -274,6 +281,7 @@ func (_u *LifeSkillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeskill.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lifeskill.Label}
@@ -289,9 +297,10 @@ func (_u *LifeSkillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // LifeSkillUpdateOne is the builder for updating a single LifeSkill entity.
 type LifeSkillUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LifeSkillMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LifeSkillMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -502,6 +511,12 @@ func (_u *LifeSkillUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifeSkillUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifeSkillUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LifeSkillUpdateOne) sqlSave(ctx context.Context) (_node *LifeSkill, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -570,6 +585,7 @@ func (_u *LifeSkillUpdateOne) sqlSave(ctx context.Context) (_node *LifeSkill, er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lifeskill.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LifeSkill{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
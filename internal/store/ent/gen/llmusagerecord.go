@@ -23,6 +23,8 @@ type LLMUsageRecord struct {
 	SessionID string `json:"session_id,omitempty"`
 	// Model holds the value of the "model" field.
 	Model string `json:"model,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
 	// PromptTokens holds the value of the "prompt_tokens" field.
 	PromptTokens int `json:"prompt_tokens,omitempty"`
 	// CompletionTokens holds the value of the "completion_tokens" field.
@@ -35,6 +37,14 @@ type LLMUsageRecord struct {
 	CacheWrite int `json:"cache_write,omitempty"`
 	// Source holds the value of the "source" field.
 	Source string `json:"source,omitempty"`
+	// Agent holds the value of the "agent" field.
+	Agent string `json:"agent,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// PipelineStep holds the value of the "pipeline_step" field.
+	PipelineStep string `json:"pipeline_step,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost float64 `json:"cost,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -45,9 +55,11 @@ func (*LLMUsageRecord) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case llmusagerecord.FieldCost:
+			values[i] = new(sql.NullFloat64)
 		case llmusagerecord.FieldID, llmusagerecord.FieldPromptTokens, llmusagerecord.FieldCompletionTokens, llmusagerecord.FieldTotalTokens, llmusagerecord.FieldCacheRead, llmusagerecord.FieldCacheWrite:
 			values[i] = new(sql.NullInt64)
-		case llmusagerecord.FieldUID, llmusagerecord.FieldSessionID, llmusagerecord.FieldModel, llmusagerecord.FieldSource:
+		case llmusagerecord.FieldUID, llmusagerecord.FieldSessionID, llmusagerecord.FieldModel, llmusagerecord.FieldProvider, llmusagerecord.FieldSource, llmusagerecord.FieldAgent, llmusagerecord.FieldTaskID, llmusagerecord.FieldPipelineStep:
 			values[i] = new(sql.NullString)
 		case llmusagerecord.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -90,6 +102,12 @@ func (_m *LLMUsageRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Model = value.String
 			}
+		case llmusagerecord.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
 		case llmusagerecord.FieldPromptTokens:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
@@ -126,6 +144,30 @@ func (_m *LLMUsageRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Source = value.String
 			}
+		case llmusagerecord.FieldAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent", values[i])
+			} else if value.Valid {
+				_m.Agent = value.String
+			}
+		case llmusagerecord.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = value.String
+			}
+		case llmusagerecord.FieldPipelineStep:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_step", values[i])
+			} else if value.Valid {
+				_m.PipelineStep = value.String
+			}
+		case llmusagerecord.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				_m.Cost = value.Float64
+			}
 		case llmusagerecord.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -177,6 +219,9 @@ func (_m *LLMUsageRecord) String() string {
 	builder.WriteString("model=")
 	builder.WriteString(_m.Model)
 	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
 	builder.WriteString("prompt_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PromptTokens))
 	builder.WriteString(", ")
@@ -195,6 +240,18 @@ func (_m *LLMUsageRecord) String() string {
 	builder.WriteString("source=")
 	builder.WriteString(_m.Source)
 	builder.WriteString(", ")
+	builder.WriteString("agent=")
+	builder.WriteString(_m.Agent)
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(_m.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_step=")
+	builder.WriteString(_m.PipelineStep)
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cost))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
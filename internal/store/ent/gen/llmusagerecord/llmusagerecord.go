@@ -19,6 +19,8 @@ const (
 	FieldSessionID = "session_id"
 	// FieldModel holds the string denoting the model field in the database.
 	FieldModel = "model"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
 	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
 	FieldPromptTokens = "prompt_tokens"
 	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
@@ -31,6 +33,14 @@ const (
 	FieldCacheWrite = "cache_write"
 	// FieldSource holds the string denoting the source field in the database.
 	FieldSource = "source"
+	// FieldAgent holds the string denoting the agent field in the database.
+	FieldAgent = "agent"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldPipelineStep holds the string denoting the pipeline_step field in the database.
+	FieldPipelineStep = "pipeline_step"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the llmusagerecord in the database.
@@ -43,12 +53,17 @@ var Columns = []string{
 	FieldUID,
 	FieldSessionID,
 	FieldModel,
+	FieldProvider,
 	FieldPromptTokens,
 	FieldCompletionTokens,
 	FieldTotalTokens,
 	FieldCacheRead,
 	FieldCacheWrite,
 	FieldSource,
+	FieldAgent,
+	FieldTaskID,
+	FieldPipelineStep,
+	FieldCost,
 	FieldCreatedAt,
 }
 
@@ -69,6 +84,8 @@ var (
 	DefaultSessionID string
 	// DefaultModel holds the default value on creation for the "model" field.
 	DefaultModel string
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
 	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
 	DefaultPromptTokens int
 	// DefaultCompletionTokens holds the default value on creation for the "completion_tokens" field.
@@ -81,6 +98,14 @@ var (
 	DefaultCacheWrite int
 	// DefaultSource holds the default value on creation for the "source" field.
 	DefaultSource string
+	// DefaultAgent holds the default value on creation for the "agent" field.
+	DefaultAgent string
+	// DefaultTaskID holds the default value on creation for the "task_id" field.
+	DefaultTaskID string
+	// DefaultPipelineStep holds the default value on creation for the "pipeline_step" field.
+	DefaultPipelineStep string
+	// DefaultCost holds the default value on creation for the "cost" field.
+	DefaultCost float64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -108,6 +133,11 @@ func ByModel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModel, opts...).ToFunc()
 }
 
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
 // ByPromptTokens orders the results by the prompt_tokens field.
 func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
@@ -138,6 +168,26 @@ func BySource(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSource, opts...).ToFunc()
 }
 
+// ByAgent orders the results by the agent field.
+func ByAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgent, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByPipelineStep orders the results by the pipeline_step field.
+func ByPipelineStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineStep, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
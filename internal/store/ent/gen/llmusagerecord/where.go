@@ -69,6 +69,11 @@ func Model(v string) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldEQ(FieldModel, v))
 }
 
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldProvider, v))
+}
+
 // PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
 func PromptTokens(v int) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldEQ(FieldPromptTokens, v))
@@ -99,6 +104,26 @@ func Source(v string) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldEQ(FieldSource, v))
 }
 
+// Agent applies equality check predicate on the "agent" field. It's identical to AgentEQ.
+func Agent(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldAgent, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldTaskID, v))
+}
+
+// PipelineStep applies equality check predicate on the "pipeline_step" field. It's identical to PipelineStepEQ.
+func PipelineStep(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldPipelineStep, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldCost, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldEQ(FieldCreatedAt, v))
@@ -299,6 +324,71 @@ func ModelContainsFold(v string) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldContainsFold(FieldModel, v))
 }
 
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContainsFold(FieldProvider, v))
+}
+
 // PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
 func PromptTokensEQ(v int) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldEQ(FieldPromptTokens, v))
@@ -564,6 +654,241 @@ func SourceContainsFold(v string) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldContainsFold(FieldSource, v))
 }
 
+// AgentEQ applies the EQ predicate on the "agent" field.
+func AgentEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldAgent, v))
+}
+
+// AgentNEQ applies the NEQ predicate on the "agent" field.
+func AgentNEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNEQ(FieldAgent, v))
+}
+
+// AgentIn applies the In predicate on the "agent" field.
+func AgentIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldIn(FieldAgent, vs...))
+}
+
+// AgentNotIn applies the NotIn predicate on the "agent" field.
+func AgentNotIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNotIn(FieldAgent, vs...))
+}
+
+// AgentGT applies the GT predicate on the "agent" field.
+func AgentGT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGT(FieldAgent, v))
+}
+
+// AgentGTE applies the GTE predicate on the "agent" field.
+func AgentGTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGTE(FieldAgent, v))
+}
+
+// AgentLT applies the LT predicate on the "agent" field.
+func AgentLT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLT(FieldAgent, v))
+}
+
+// AgentLTE applies the LTE predicate on the "agent" field.
+func AgentLTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLTE(FieldAgent, v))
+}
+
+// AgentContains applies the Contains predicate on the "agent" field.
+func AgentContains(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContains(FieldAgent, v))
+}
+
+// AgentHasPrefix applies the HasPrefix predicate on the "agent" field.
+func AgentHasPrefix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasPrefix(FieldAgent, v))
+}
+
+// AgentHasSuffix applies the HasSuffix predicate on the "agent" field.
+func AgentHasSuffix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasSuffix(FieldAgent, v))
+}
+
+// AgentEqualFold applies the EqualFold predicate on the "agent" field.
+func AgentEqualFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEqualFold(FieldAgent, v))
+}
+
+// AgentContainsFold applies the ContainsFold predicate on the "agent" field.
+func AgentContainsFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContainsFold(FieldAgent, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// PipelineStepEQ applies the EQ predicate on the "pipeline_step" field.
+func PipelineStepEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldPipelineStep, v))
+}
+
+// PipelineStepNEQ applies the NEQ predicate on the "pipeline_step" field.
+func PipelineStepNEQ(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNEQ(FieldPipelineStep, v))
+}
+
+// PipelineStepIn applies the In predicate on the "pipeline_step" field.
+func PipelineStepIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldIn(FieldPipelineStep, vs...))
+}
+
+// PipelineStepNotIn applies the NotIn predicate on the "pipeline_step" field.
+func PipelineStepNotIn(vs ...string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNotIn(FieldPipelineStep, vs...))
+}
+
+// PipelineStepGT applies the GT predicate on the "pipeline_step" field.
+func PipelineStepGT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGT(FieldPipelineStep, v))
+}
+
+// PipelineStepGTE applies the GTE predicate on the "pipeline_step" field.
+func PipelineStepGTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGTE(FieldPipelineStep, v))
+}
+
+// PipelineStepLT applies the LT predicate on the "pipeline_step" field.
+func PipelineStepLT(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLT(FieldPipelineStep, v))
+}
+
+// PipelineStepLTE applies the LTE predicate on the "pipeline_step" field.
+func PipelineStepLTE(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLTE(FieldPipelineStep, v))
+}
+
+// PipelineStepContains applies the Contains predicate on the "pipeline_step" field.
+func PipelineStepContains(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContains(FieldPipelineStep, v))
+}
+
+// PipelineStepHasPrefix applies the HasPrefix predicate on the "pipeline_step" field.
+func PipelineStepHasPrefix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasPrefix(FieldPipelineStep, v))
+}
+
+// PipelineStepHasSuffix applies the HasSuffix predicate on the "pipeline_step" field.
+func PipelineStepHasSuffix(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldHasSuffix(FieldPipelineStep, v))
+}
+
+// PipelineStepEqualFold applies the EqualFold predicate on the "pipeline_step" field.
+func PipelineStepEqualFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEqualFold(FieldPipelineStep, v))
+}
+
+// PipelineStepContainsFold applies the ContainsFold predicate on the "pipeline_step" field.
+func PipelineStepContainsFold(v string) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldContainsFold(FieldPipelineStep, v))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.LLMUsageRecord {
+	return predicate.LLMUsageRecord(sql.FieldLTE(FieldCost, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LLMUsageRecord {
 	return predicate.LLMUsageRecord(sql.FieldEQ(FieldCreatedAt, v))
@@ -56,6 +56,20 @@ func (_c *LLMUsageRecordCreate) SetNillableModel(v *string) *LLMUsageRecordCreat
 	return _c
 }
 
+// SetProvider sets the "provider" field.
+func (_c *LLMUsageRecordCreate) SetProvider(v string) *LLMUsageRecordCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *LLMUsageRecordCreate) SetNillableProvider(v *string) *LLMUsageRecordCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
 // SetPromptTokens sets the "prompt_tokens" field.
 func (_c *LLMUsageRecordCreate) SetPromptTokens(v int) *LLMUsageRecordCreate {
 	_c.mutation.SetPromptTokens(v)
@@ -140,6 +154,62 @@ func (_c *LLMUsageRecordCreate) SetNillableSource(v *string) *LLMUsageRecordCrea
 	return _c
 }
 
+// SetAgent sets the "agent" field.
+func (_c *LLMUsageRecordCreate) SetAgent(v string) *LLMUsageRecordCreate {
+	_c.mutation.SetAgent(v)
+	return _c
+}
+
+// SetNillableAgent sets the "agent" field if the given value is not nil.
+func (_c *LLMUsageRecordCreate) SetNillableAgent(v *string) *LLMUsageRecordCreate {
+	if v != nil {
+		_c.SetAgent(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *LLMUsageRecordCreate) SetTaskID(v string) *LLMUsageRecordCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *LLMUsageRecordCreate) SetNillableTaskID(v *string) *LLMUsageRecordCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetPipelineStep sets the "pipeline_step" field.
+func (_c *LLMUsageRecordCreate) SetPipelineStep(v string) *LLMUsageRecordCreate {
+	_c.mutation.SetPipelineStep(v)
+	return _c
+}
+
+// SetNillablePipelineStep sets the "pipeline_step" field if the given value is not nil.
+func (_c *LLMUsageRecordCreate) SetNillablePipelineStep(v *string) *LLMUsageRecordCreate {
+	if v != nil {
+		_c.SetPipelineStep(*v)
+	}
+	return _c
+}
+
+// SetCost sets the "cost" field.
+func (_c *LLMUsageRecordCreate) SetCost(v float64) *LLMUsageRecordCreate {
+	_c.mutation.SetCost(v)
+	return _c
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_c *LLMUsageRecordCreate) SetNillableCost(v *float64) *LLMUsageRecordCreate {
+	if v != nil {
+		_c.SetCost(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LLMUsageRecordCreate) SetCreatedAt(v time.Time) *LLMUsageRecordCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -203,6 +273,10 @@ func (_c *LLMUsageRecordCreate) defaults() {
 		v := llmusagerecord.DefaultModel
 		_c.mutation.SetModel(v)
 	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := llmusagerecord.DefaultProvider
+		_c.mutation.SetProvider(v)
+	}
 	if _, ok := _c.mutation.PromptTokens(); !ok {
 		v := llmusagerecord.DefaultPromptTokens
 		_c.mutation.SetPromptTokens(v)
@@ -227,6 +301,22 @@ func (_c *LLMUsageRecordCreate) defaults() {
 		v := llmusagerecord.DefaultSource
 		_c.mutation.SetSource(v)
 	}
+	if _, ok := _c.mutation.Agent(); !ok {
+		v := llmusagerecord.DefaultAgent
+		_c.mutation.SetAgent(v)
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		v := llmusagerecord.DefaultTaskID
+		_c.mutation.SetTaskID(v)
+	}
+	if _, ok := _c.mutation.PipelineStep(); !ok {
+		v := llmusagerecord.DefaultPipelineStep
+		_c.mutation.SetPipelineStep(v)
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		v := llmusagerecord.DefaultCost
+		_c.mutation.SetCost(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := llmusagerecord.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -249,6 +339,9 @@ func (_c *LLMUsageRecordCreate) check() error {
 	if _, ok := _c.mutation.Model(); !ok {
 		return &ValidationError{Name: "model", err: errors.New(`gen: missing required field "LLMUsageRecord.model"`)}
 	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`gen: missing required field "LLMUsageRecord.provider"`)}
+	}
 	if _, ok := _c.mutation.PromptTokens(); !ok {
 		return &ValidationError{Name: "prompt_tokens", err: errors.New(`gen: missing required field "LLMUsageRecord.prompt_tokens"`)}
 	}
@@ -267,6 +360,18 @@ func (_c *LLMUsageRecordCreate) check() error {
 	if _, ok := _c.mutation.Source(); !ok {
 		return &ValidationError{Name: "source", err: errors.New(`gen: missing required field "LLMUsageRecord.source"`)}
 	}
+	if _, ok := _c.mutation.Agent(); !ok {
+		return &ValidationError{Name: "agent", err: errors.New(`gen: missing required field "LLMUsageRecord.agent"`)}
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`gen: missing required field "LLMUsageRecord.task_id"`)}
+	}
+	if _, ok := _c.mutation.PipelineStep(); !ok {
+		return &ValidationError{Name: "pipeline_step", err: errors.New(`gen: missing required field "LLMUsageRecord.pipeline_step"`)}
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`gen: missing required field "LLMUsageRecord.cost"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "LLMUsageRecord.created_at"`)}
 	}
@@ -315,6 +420,10 @@ func (_c *LLMUsageRecordCreate) createSpec() (*LLMUsageRecord, *sqlgraph.CreateS
 		_spec.SetField(llmusagerecord.FieldModel, field.TypeString, value)
 		_node.Model = value
 	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(llmusagerecord.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
 	if value, ok := _c.mutation.PromptTokens(); ok {
 		_spec.SetField(llmusagerecord.FieldPromptTokens, field.TypeInt, value)
 		_node.PromptTokens = value
@@ -339,6 +448,22 @@ func (_c *LLMUsageRecordCreate) createSpec() (*LLMUsageRecord, *sqlgraph.CreateS
 		_spec.SetField(llmusagerecord.FieldSource, field.TypeString, value)
 		_node.Source = value
 	}
+	if value, ok := _c.mutation.Agent(); ok {
+		_spec.SetField(llmusagerecord.FieldAgent, field.TypeString, value)
+		_node.Agent = value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(llmusagerecord.FieldTaskID, field.TypeString, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.PipelineStep(); ok {
+		_spec.SetField(llmusagerecord.FieldPipelineStep, field.TypeString, value)
+		_node.PipelineStep = value
+	}
+	if value, ok := _c.mutation.Cost(); ok {
+		_spec.SetField(llmusagerecord.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(llmusagerecord.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -431,6 +556,18 @@ func (u *LLMUsageRecordUpsert) UpdateModel() *LLMUsageRecordUpsert {
 	return u
 }
 
+// SetProvider sets the "provider" field.
+func (u *LLMUsageRecordUpsert) SetProvider(v string) *LLMUsageRecordUpsert {
+	u.Set(llmusagerecord.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsert) UpdateProvider() *LLMUsageRecordUpsert {
+	u.SetExcluded(llmusagerecord.FieldProvider)
+	return u
+}
+
 // SetPromptTokens sets the "prompt_tokens" field.
 func (u *LLMUsageRecordUpsert) SetPromptTokens(v int) *LLMUsageRecordUpsert {
 	u.Set(llmusagerecord.FieldPromptTokens, v)
@@ -533,6 +670,60 @@ func (u *LLMUsageRecordUpsert) UpdateSource() *LLMUsageRecordUpsert {
 	return u
 }
 
+// SetAgent sets the "agent" field.
+func (u *LLMUsageRecordUpsert) SetAgent(v string) *LLMUsageRecordUpsert {
+	u.Set(llmusagerecord.FieldAgent, v)
+	return u
+}
+
+// UpdateAgent sets the "agent" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsert) UpdateAgent() *LLMUsageRecordUpsert {
+	u.SetExcluded(llmusagerecord.FieldAgent)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *LLMUsageRecordUpsert) SetTaskID(v string) *LLMUsageRecordUpsert {
+	u.Set(llmusagerecord.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsert) UpdateTaskID() *LLMUsageRecordUpsert {
+	u.SetExcluded(llmusagerecord.FieldTaskID)
+	return u
+}
+
+// SetPipelineStep sets the "pipeline_step" field.
+func (u *LLMUsageRecordUpsert) SetPipelineStep(v string) *LLMUsageRecordUpsert {
+	u.Set(llmusagerecord.FieldPipelineStep, v)
+	return u
+}
+
+// UpdatePipelineStep sets the "pipeline_step" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsert) UpdatePipelineStep() *LLMUsageRecordUpsert {
+	u.SetExcluded(llmusagerecord.FieldPipelineStep)
+	return u
+}
+
+// SetCost sets the "cost" field.
+func (u *LLMUsageRecordUpsert) SetCost(v float64) *LLMUsageRecordUpsert {
+	u.Set(llmusagerecord.FieldCost, v)
+	return u
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsert) UpdateCost() *LLMUsageRecordUpsert {
+	u.SetExcluded(llmusagerecord.FieldCost)
+	return u
+}
+
+// AddCost adds v to the "cost" field.
+func (u *LLMUsageRecordUpsert) AddCost(v float64) *LLMUsageRecordUpsert {
+	u.Add(llmusagerecord.FieldCost, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -626,6 +817,20 @@ func (u *LLMUsageRecordUpsertOne) UpdateModel() *LLMUsageRecordUpsertOne {
 	})
 }
 
+// SetProvider sets the "provider" field.
+func (u *LLMUsageRecordUpsertOne) SetProvider(v string) *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertOne) UpdateProvider() *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateProvider()
+	})
+}
+
 // SetPromptTokens sets the "prompt_tokens" field.
 func (u *LLMUsageRecordUpsertOne) SetPromptTokens(v int) *LLMUsageRecordUpsertOne {
 	return u.Update(func(s *LLMUsageRecordUpsert) {
@@ -745,6 +950,69 @@ func (u *LLMUsageRecordUpsertOne) UpdateSource() *LLMUsageRecordUpsertOne {
 	})
 }
 
+// SetAgent sets the "agent" field.
+func (u *LLMUsageRecordUpsertOne) SetAgent(v string) *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetAgent(v)
+	})
+}
+
+// UpdateAgent sets the "agent" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertOne) UpdateAgent() *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateAgent()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *LLMUsageRecordUpsertOne) SetTaskID(v string) *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertOne) UpdateTaskID() *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetPipelineStep sets the "pipeline_step" field.
+func (u *LLMUsageRecordUpsertOne) SetPipelineStep(v string) *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetPipelineStep(v)
+	})
+}
+
+// UpdatePipelineStep sets the "pipeline_step" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertOne) UpdatePipelineStep() *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdatePipelineStep()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *LLMUsageRecordUpsertOne) SetCost(v float64) *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *LLMUsageRecordUpsertOne) AddCost(v float64) *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertOne) UpdateCost() *LLMUsageRecordUpsertOne {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateCost()
+	})
+}
+
 // Exec executes the query.
 func (u *LLMUsageRecordUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1004,6 +1272,20 @@ func (u *LLMUsageRecordUpsertBulk) UpdateModel() *LLMUsageRecordUpsertBulk {
 	})
 }
 
+// SetProvider sets the "provider" field.
+func (u *LLMUsageRecordUpsertBulk) SetProvider(v string) *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertBulk) UpdateProvider() *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateProvider()
+	})
+}
+
 // SetPromptTokens sets the "prompt_tokens" field.
 func (u *LLMUsageRecordUpsertBulk) SetPromptTokens(v int) *LLMUsageRecordUpsertBulk {
 	return u.Update(func(s *LLMUsageRecordUpsert) {
@@ -1123,6 +1405,69 @@ func (u *LLMUsageRecordUpsertBulk) UpdateSource() *LLMUsageRecordUpsertBulk {
 	})
 }
 
+// SetAgent sets the "agent" field.
+func (u *LLMUsageRecordUpsertBulk) SetAgent(v string) *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetAgent(v)
+	})
+}
+
+// UpdateAgent sets the "agent" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertBulk) UpdateAgent() *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateAgent()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *LLMUsageRecordUpsertBulk) SetTaskID(v string) *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertBulk) UpdateTaskID() *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetPipelineStep sets the "pipeline_step" field.
+func (u *LLMUsageRecordUpsertBulk) SetPipelineStep(v string) *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetPipelineStep(v)
+	})
+}
+
+// UpdatePipelineStep sets the "pipeline_step" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertBulk) UpdatePipelineStep() *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdatePipelineStep()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *LLMUsageRecordUpsertBulk) SetCost(v float64) *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *LLMUsageRecordUpsertBulk) AddCost(v float64) *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *LLMUsageRecordUpsertBulk) UpdateCost() *LLMUsageRecordUpsertBulk {
+	return u.Update(func(s *LLMUsageRecordUpsert) {
+		s.UpdateCost()
+	})
+}
+
 // Exec executes the query.
 func (u *LLMUsageRecordUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
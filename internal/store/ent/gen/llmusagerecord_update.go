@@ -17,8 +17,9 @@ import (
 // LLMUsageRecordUpdate is the builder for updating LLMUsageRecord entities.
 type LLMUsageRecordUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LLMUsageRecordMutation
+	hooks     []Hook
+	mutation  *LLMUsageRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LLMUsageRecordUpdate builder.
@@ -69,6 +70,20 @@ func (_u *LLMUsageRecordUpdate) SetNillableModel(v *string) *LLMUsageRecordUpdat
 	return _u
 }
 
+// SetProvider sets the "provider" field.
+func (_u *LLMUsageRecordUpdate) SetProvider(v string) *LLMUsageRecordUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdate) SetNillableProvider(v *string) *LLMUsageRecordUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
 // SetPromptTokens sets the "prompt_tokens" field.
 func (_u *LLMUsageRecordUpdate) SetPromptTokens(v int) *LLMUsageRecordUpdate {
 	_u.mutation.ResetPromptTokens()
@@ -188,6 +203,69 @@ func (_u *LLMUsageRecordUpdate) SetNillableSource(v *string) *LLMUsageRecordUpda
 	return _u
 }
 
+// SetAgent sets the "agent" field.
+func (_u *LLMUsageRecordUpdate) SetAgent(v string) *LLMUsageRecordUpdate {
+	_u.mutation.SetAgent(v)
+	return _u
+}
+
+// SetNillableAgent sets the "agent" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdate) SetNillableAgent(v *string) *LLMUsageRecordUpdate {
+	if v != nil {
+		_u.SetAgent(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *LLMUsageRecordUpdate) SetTaskID(v string) *LLMUsageRecordUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdate) SetNillableTaskID(v *string) *LLMUsageRecordUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetPipelineStep sets the "pipeline_step" field.
+func (_u *LLMUsageRecordUpdate) SetPipelineStep(v string) *LLMUsageRecordUpdate {
+	_u.mutation.SetPipelineStep(v)
+	return _u
+}
+
+// SetNillablePipelineStep sets the "pipeline_step" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdate) SetNillablePipelineStep(v *string) *LLMUsageRecordUpdate {
+	if v != nil {
+		_u.SetPipelineStep(*v)
+	}
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *LLMUsageRecordUpdate) SetCost(v float64) *LLMUsageRecordUpdate {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdate) SetNillableCost(v *float64) *LLMUsageRecordUpdate {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *LLMUsageRecordUpdate) AddCost(v float64) *LLMUsageRecordUpdate {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
 // Mutation returns the LLMUsageRecordMutation object of the builder.
 func (_u *LLMUsageRecordUpdate) Mutation() *LLMUsageRecordMutation {
 	return _u.mutation
@@ -230,6 +308,12 @@ func (_u *LLMUsageRecordUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LLMUsageRecordUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LLMUsageRecordUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LLMUsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -251,6 +335,9 @@ func (_u *LLMUsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.Model(); ok {
 		_spec.SetField(llmusagerecord.FieldModel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(llmusagerecord.FieldProvider, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PromptTokens(); ok {
 		_spec.SetField(llmusagerecord.FieldPromptTokens, field.TypeInt, value)
 	}
@@ -284,6 +371,22 @@ func (_u *LLMUsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(llmusagerecord.FieldSource, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Agent(); ok {
+		_spec.SetField(llmusagerecord.FieldAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(llmusagerecord.FieldTaskID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PipelineStep(); ok {
+		_spec.SetField(llmusagerecord.FieldPipelineStep, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(llmusagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(llmusagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{llmusagerecord.Label}
@@ -299,9 +402,10 @@ func (_u *LLMUsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err err
 // LLMUsageRecordUpdateOne is the builder for updating a single LLMUsageRecord entity.
 type LLMUsageRecordUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LLMUsageRecordMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LLMUsageRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -346,6 +450,20 @@ func (_u *LLMUsageRecordUpdateOne) SetNillableModel(v *string) *LLMUsageRecordUp
 	return _u
 }
 
+// SetProvider sets the "provider" field.
+func (_u *LLMUsageRecordUpdateOne) SetProvider(v string) *LLMUsageRecordUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdateOne) SetNillableProvider(v *string) *LLMUsageRecordUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
 // SetPromptTokens sets the "prompt_tokens" field.
 func (_u *LLMUsageRecordUpdateOne) SetPromptTokens(v int) *LLMUsageRecordUpdateOne {
 	_u.mutation.ResetPromptTokens()
@@ -465,6 +583,69 @@ func (_u *LLMUsageRecordUpdateOne) SetNillableSource(v *string) *LLMUsageRecordU
 	return _u
 }
 
+// SetAgent sets the "agent" field.
+func (_u *LLMUsageRecordUpdateOne) SetAgent(v string) *LLMUsageRecordUpdateOne {
+	_u.mutation.SetAgent(v)
+	return _u
+}
+
+// SetNillableAgent sets the "agent" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdateOne) SetNillableAgent(v *string) *LLMUsageRecordUpdateOne {
+	if v != nil {
+		_u.SetAgent(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *LLMUsageRecordUpdateOne) SetTaskID(v string) *LLMUsageRecordUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdateOne) SetNillableTaskID(v *string) *LLMUsageRecordUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetPipelineStep sets the "pipeline_step" field.
+func (_u *LLMUsageRecordUpdateOne) SetPipelineStep(v string) *LLMUsageRecordUpdateOne {
+	_u.mutation.SetPipelineStep(v)
+	return _u
+}
+
+// SetNillablePipelineStep sets the "pipeline_step" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdateOne) SetNillablePipelineStep(v *string) *LLMUsageRecordUpdateOne {
+	if v != nil {
+		_u.SetPipelineStep(*v)
+	}
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *LLMUsageRecordUpdateOne) SetCost(v float64) *LLMUsageRecordUpdateOne {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *LLMUsageRecordUpdateOne) SetNillableCost(v *float64) *LLMUsageRecordUpdateOne {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *LLMUsageRecordUpdateOne) AddCost(v float64) *LLMUsageRecordUpdateOne {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
 // Mutation returns the LLMUsageRecordMutation object of the builder.
 func (_u *LLMUsageRecordUpdateOne) Mutation() *LLMUsageRecordMutation {
 	return _u.mutation
@@ -520,6 +701,12 @@ func (_u *LLMUsageRecordUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LLMUsageRecordUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LLMUsageRecordUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LLMUsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *LLMUsageRecord, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -558,6 +745,9 @@ func (_u *LLMUsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *LLMUsage
 	if value, ok := _u.mutation.Model(); ok {
 		_spec.SetField(llmusagerecord.FieldModel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(llmusagerecord.FieldProvider, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PromptTokens(); ok {
 		_spec.SetField(llmusagerecord.FieldPromptTokens, field.TypeInt, value)
 	}
@@ -591,6 +781,22 @@ func (_u *LLMUsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *LLMUsage
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(llmusagerecord.FieldSource, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Agent(); ok {
+		_spec.SetField(llmusagerecord.FieldAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(llmusagerecord.FieldTaskID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PipelineStep(); ok {
+		_spec.SetField(llmusagerecord.FieldPipelineStep, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(llmusagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(llmusagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LLMUsageRecord{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
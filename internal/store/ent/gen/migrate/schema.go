@@ -27,6 +27,35 @@ var (
 		Columns:    AgentsColumns,
 		PrimaryKey: []*schema.Column{AgentsColumns[0]},
 	}
+	// AgentEmbeddingsColumns holds the columns for the "agent_embeddings" table.
+	AgentEmbeddingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "ref_id", Type: field.TypeInt64},
+		{Name: "scope", Type: field.TypeString, Default: ""},
+		{Name: "model", Type: field.TypeString},
+		{Name: "content_hash", Type: field.TypeString},
+		{Name: "vector", Type: field.TypeBytes},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AgentEmbeddingsTable holds the schema information for the "agent_embeddings" table.
+	AgentEmbeddingsTable = &schema.Table{
+		Name:       "agent_embeddings",
+		Columns:    AgentEmbeddingsColumns,
+		PrimaryKey: []*schema.Column{AgentEmbeddingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentembedding_kind_ref_id",
+				Unique:  true,
+				Columns: []*schema.Column{AgentEmbeddingsColumns[1], AgentEmbeddingsColumns[2]},
+			},
+			{
+				Name:    "agentembedding_kind_scope_model",
+				Unique:  false,
+				Columns: []*schema.Column{AgentEmbeddingsColumns[1], AgentEmbeddingsColumns[3], AgentEmbeddingsColumns[4]},
+			},
+		},
+	}
 	// AgentKnowledgeColumns holds the columns for the "agent_knowledge" table.
 	AgentKnowledgeColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -575,8 +604,18 @@ var (
 		{Name: "title", Type: field.TypeString, Default: ""},
 		{Name: "description", Type: field.TypeString, Default: ""},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "attachments", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "burn_after_read", Type: field.TypeBool, Default: false},
+		{Name: "max_views", Type: field.TypeInt, Default: 0},
+		{Name: "view_count", Type: field.TypeInt, Default: 0},
+		{Name: "password_hash", Type: field.TypeString, Default: ""},
+		{Name: "link_secret", Type: field.TypeString, Default: ""},
+		{Name: "burned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_by", Type: field.TypeString, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ClipsTable holds the schema information for the "clips" table.
 	ClipsTable = &schema.Table{
@@ -584,6 +623,31 @@ var (
 		Columns:    ClipsColumns,
 		PrimaryKey: []*schema.Column{ClipsColumns[0]},
 	}
+	// ClipVersionsColumns holds the columns for the "clip_versions" table.
+	ClipVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "clip_id", Type: field.TypeInt64},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString, Default: ""},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "attachments", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ClipVersionsTable holds the schema information for the "clip_versions" table.
+	ClipVersionsTable = &schema.Table{
+		Name:       "clip_versions",
+		Columns:    ClipVersionsColumns,
+		PrimaryKey: []*schema.Column{ClipVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clipversion_clip_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{ClipVersionsColumns[1], ClipVersionsColumns[2]},
+			},
+		},
+	}
 	// ConfigsColumns holds the columns for the "configs" table.
 	ConfigsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -924,6 +988,8 @@ var (
 		{Name: "error_text", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "truncated", Type: field.TypeBool, Default: false},
 		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "log_seq", Type: field.TypeInt64, Default: 0},
+		{Name: "log_bytes", Type: field.TypeInt64, Default: 0},
 		{Name: "worker_id", Type: field.TypeString, Default: ""},
 		{Name: "lease_until", Type: field.TypeTime, Nullable: true},
 		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
@@ -940,12 +1006,12 @@ var (
 			{
 				Name:    "gatewayjob_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{GatewayJobsColumns[6], GatewayJobsColumns[16]},
+				Columns: []*schema.Column{GatewayJobsColumns[6], GatewayJobsColumns[18]},
 			},
 			{
 				Name:    "gatewayjob_status_lease_until",
 				Unique:  false,
-				Columns: []*schema.Column{GatewayJobsColumns[6], GatewayJobsColumns[13]},
+				Columns: []*schema.Column{GatewayJobsColumns[6], GatewayJobsColumns[15]},
 			},
 			{
 				Name:    "gatewayjob_uid",
@@ -954,6 +1020,27 @@ var (
 			},
 		},
 	}
+	// GatewayJobLogsColumns holds the columns for the "gateway_job_logs" table.
+	GatewayJobLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "job_id", Type: field.TypeString},
+		{Name: "seq", Type: field.TypeInt64},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GatewayJobLogsTable holds the schema information for the "gateway_job_logs" table.
+	GatewayJobLogsTable = &schema.Table{
+		Name:       "gateway_job_logs",
+		Columns:    GatewayJobLogsColumns,
+		PrimaryKey: []*schema.Column{GatewayJobLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gatewayjoblog_job_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{GatewayJobLogsColumns[1], GatewayJobLogsColumns[2]},
+			},
+		},
+	}
 	// GatewayWorkersColumns holds the columns for the "gateway_workers" table.
 	GatewayWorkersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -1007,12 +1094,17 @@ var (
 		{Name: "uid", Type: field.TypeString},
 		{Name: "session_id", Type: field.TypeString, Default: ""},
 		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "provider", Type: field.TypeString, Default: ""},
 		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "cache_read", Type: field.TypeInt, Default: 0},
 		{Name: "cache_write", Type: field.TypeInt, Default: 0},
 		{Name: "source", Type: field.TypeString, Default: "agent"},
+		{Name: "agent", Type: field.TypeString, Default: ""},
+		{Name: "task_id", Type: field.TypeString, Default: ""},
+		{Name: "pipeline_step", Type: field.TypeString, Default: ""},
+		{Name: "cost", Type: field.TypeFloat64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// LlmUsageRecordsTable holds the schema information for the "llm_usage_records" table.
@@ -1024,12 +1116,27 @@ var (
 			{
 				Name:    "llmusagerecord_uid_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LlmUsageRecordsColumns[1], LlmUsageRecordsColumns[10]},
+				Columns: []*schema.Column{LlmUsageRecordsColumns[1], LlmUsageRecordsColumns[15]},
 			},
 			{
 				Name:    "llmusagerecord_uid_model_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LlmUsageRecordsColumns[1], LlmUsageRecordsColumns[3], LlmUsageRecordsColumns[10]},
+				Columns: []*schema.Column{LlmUsageRecordsColumns[1], LlmUsageRecordsColumns[3], LlmUsageRecordsColumns[15]},
+			},
+			{
+				Name:    "llmusagerecord_agent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LlmUsageRecordsColumns[11], LlmUsageRecordsColumns[15]},
+			},
+			{
+				Name:    "llmusagerecord_task_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LlmUsageRecordsColumns[12], LlmUsageRecordsColumns[15]},
+			},
+			{
+				Name:    "llmusagerecord_pipeline_step_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LlmUsageRecordsColumns[13], LlmUsageRecordsColumns[15]},
 			},
 		},
 	}
@@ -1931,6 +2038,7 @@ var (
 		{Name: "event_pattern", Type: field.TypeString, Default: "*"},
 		{Name: "channel_pattern", Type: field.TypeString, Default: "*"},
 		{Name: "condition", Type: field.TypeString, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Nullable: true},
 		{Name: "priority", Type: field.TypeInt, Default: 0},
 		{Name: "params", Type: field.TypeJSON},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
@@ -1946,12 +2054,12 @@ var (
 			{
 				Name:    "notifyrule_priority",
 				Unique:  false,
-				Columns: []*schema.Column{NotifyRulesColumns[7]},
+				Columns: []*schema.Column{NotifyRulesColumns[8]},
 			},
 			{
 				Name:    "notifyrule_enabled",
 				Unique:  false,
-				Columns: []*schema.Column{NotifyRulesColumns[9]},
+				Columns: []*schema.Column{NotifyRulesColumns[10]},
 			},
 		},
 	}
@@ -2135,7 +2243,7 @@ var (
 		{Name: "pipeline_name", Type: field.TypeString},
 		{Name: "event_id", Type: field.TypeString, Unique: true},
 		{Name: "event_type", Type: field.TypeString, Default: ""},
-		{Name: "trigger_source", Type: field.TypeEnum, Enums: []string{"event", "webhook", "cron", "manual"}, Default: "event"},
+		{Name: "trigger_source", Type: field.TypeEnum, Enums: []string{"event", "webhook", "cron", "manual", "call"}, Default: "event"},
 		{Name: "status", Type: field.TypeInt, Default: 0},
 		{Name: "error", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "checkpoint_data", Type: field.TypeJSON, Nullable: true},
@@ -2143,6 +2251,9 @@ var (
 		{Name: "started_at", Type: field.TypeTime},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "parent_kind", Type: field.TypeString, Default: ""},
+		{Name: "parent_run_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "call_depth", Type: field.TypeInt, Default: 0},
 	}
 	// PipelineRunsTable holds the schema information for the "pipeline_runs" table.
 	PipelineRunsTable = &schema.Table{
@@ -2155,6 +2266,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{PipelineRunsColumns[1]},
 			},
+			{
+				Name:    "pipelinerun_parent_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{PipelineRunsColumns[13]},
+			},
 		},
 	}
 	// PipelineStepRunsColumns holds the columns for the "pipeline_step_runs" table.
@@ -2384,6 +2500,42 @@ var (
 			},
 		},
 	}
+	// SearchDocumentsColumns holds the columns for the "search_documents" table.
+	SearchDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "source", Type: field.TypeString},
+		{Name: "doc_id", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Default: ""},
+		{Name: "title", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "url", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "tags", Type: field.TypeJSON},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "indexed_at", Type: field.TypeTime},
+	}
+	// SearchDocumentsTable holds the schema information for the "search_documents" table.
+	SearchDocumentsTable = &schema.Table{
+		Name:       "search_documents",
+		Columns:    SearchDocumentsColumns,
+		PrimaryKey: []*schema.Column{SearchDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchdocument_source_doc_id",
+				Unique:  true,
+				Columns: []*schema.Column{SearchDocumentsColumns[1], SearchDocumentsColumns[2]},
+			},
+			{
+				Name:    "searchdocument_uid",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentsColumns[3]},
+			},
+			{
+				Name:    "searchdocument_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentsColumns[8]},
+			},
+		},
+	}
 	// TopicsColumns holds the columns for the "topics" table.
 	TopicsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -2468,6 +2620,35 @@ var (
 			},
 		},
 	}
+	// WebAccountIdentitiesColumns holds the columns for the "web_account_identities" table.
+	WebAccountIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "uid", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "issuer", Type: field.TypeString, Default: ""},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime},
+	}
+	// WebAccountIdentitiesTable holds the schema information for the "web_account_identities" table.
+	WebAccountIdentitiesTable = &schema.Table{
+		Name:       "web_account_identities",
+		Columns:    WebAccountIdentitiesColumns,
+		PrimaryKey: []*schema.Column{WebAccountIdentitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webaccountidentity_provider_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{WebAccountIdentitiesColumns[2], WebAccountIdentitiesColumns[3], WebAccountIdentitiesColumns[4]},
+			},
+			{
+				Name:    "webaccountidentity_uid",
+				Unique:  false,
+				Columns: []*schema.Column{WebAccountIdentitiesColumns[1]},
+			},
+		},
+	}
 	// WorkflowsColumns holds the columns for the "workflows" table.
 	WorkflowsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -2503,6 +2684,9 @@ var (
 		{Name: "started_at", Type: field.TypeTime},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "parent_kind", Type: field.TypeString, Default: ""},
+		{Name: "parent_run_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "call_depth", Type: field.TypeInt, Default: 0},
 	}
 	// WorkflowRunsTable holds the schema information for the "workflow_runs" table.
 	WorkflowRunsTable = &schema.Table{
@@ -2520,6 +2704,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{WorkflowRunsColumns[1]},
 			},
+			{
+				Name:    "workflowrun_parent_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunsColumns[15]},
+			},
 		},
 	}
 	// WorkflowStepRunsColumns holds the columns for the "workflow_step_runs" table.
@@ -2563,6 +2752,7 @@ var (
 		{Name: "vars", Type: field.TypeJSON, Nullable: true},
 		{Name: "conn", Type: field.TypeJSON, Nullable: true},
 		{Name: "retry", Type: field.TypeJSON, Nullable: true},
+		{Name: "outputs", Type: field.TypeJSON, Nullable: true},
 	}
 	// WorkflowTasksTable holds the schema information for the "workflow_tasks" table.
 	WorkflowTasksTable = &schema.Table{
@@ -2606,6 +2796,7 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AgentsTable,
+		AgentEmbeddingsTable,
 		AgentKnowledgeTable,
 		AgentMemoryFactsTable,
 		AgentPlansTable,
@@ -2627,6 +2818,7 @@ var (
 		ChatSessionsTable,
 		ChatSessionEntriesTable,
 		ClipsTable,
+		ClipVersionsTable,
 		ConfigsTable,
 		ConnectionsTable,
 		CountersTable,
@@ -2641,6 +2833,7 @@ var (
 		FunctionDefinitionVersionsTable,
 		FunctionRunsTable,
 		GatewayJobsTable,
+		GatewayJobLogsTable,
 		GatewayWorkersTable,
 		InstructTable,
 		LlmUsageRecordsTable,
@@ -2687,10 +2880,12 @@ var (
 		PlatformUsersTable,
 		PollingStateTable,
 		ResourceLinksTable,
+		SearchDocumentsTable,
 		TopicsTable,
 		UrlsTable,
 		UsersTable,
 		WebAccountsTable,
+		WebAccountIdentitiesTable,
 		WorkflowsTable,
 		WorkflowRunsTable,
 		WorkflowStepRunsTable,
@@ -2703,6 +2898,9 @@ func init() {
 	AgentsTable.Annotation = &entsql.Annotation{
 		Table: "agents",
 	}
+	AgentEmbeddingsTable.Annotation = &entsql.Annotation{
+		Table: "agent_embeddings",
+	}
 	AgentKnowledgeTable.Annotation = &entsql.Annotation{
 		Table: "agent_knowledge",
 	}
@@ -2766,6 +2964,9 @@ func init() {
 	ClipsTable.Annotation = &entsql.Annotation{
 		Table: "clips",
 	}
+	ClipVersionsTable.Annotation = &entsql.Annotation{
+		Table: "clip_versions",
+	}
 	ConfigsTable.Annotation = &entsql.Annotation{
 		Table: "configs",
 	}
@@ -2808,6 +3009,9 @@ func init() {
 	GatewayJobsTable.Annotation = &entsql.Annotation{
 		Table: "gateway_jobs",
 	}
+	GatewayJobLogsTable.Annotation = &entsql.Annotation{
+		Table: "gateway_job_logs",
+	}
 	GatewayWorkersTable.Annotation = &entsql.Annotation{
 		Table: "gateway_workers",
 	}
@@ -2946,6 +3150,9 @@ func init() {
 	ResourceLinksTable.Annotation = &entsql.Annotation{
 		Table: "resource_links",
 	}
+	SearchDocumentsTable.Annotation = &entsql.Annotation{
+		Table: "search_documents",
+	}
 	TopicsTable.Annotation = &entsql.Annotation{
 		Table: "topics",
 	}
@@ -2958,6 +3165,9 @@ func init() {
 	WebAccountsTable.Annotation = &entsql.Annotation{
 		Table: "web_accounts",
 	}
+	WebAccountIdentitiesTable.Annotation = &entsql.Annotation{
+		Table: "web_account_identities",
+	}
 	WorkflowsTable.Annotation = &entsql.Annotation{
 		Table: "workflows",
 	}
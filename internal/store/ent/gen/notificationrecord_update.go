@@ -18,8 +18,9 @@ import (
 // NotificationRecordUpdate is the builder for updating NotificationRecord entities.
 type NotificationRecordUpdate struct {
 	config
-	hooks    []Hook
-	mutation *NotificationRecordMutation
+	hooks     []Hook
+	mutation  *NotificationRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the NotificationRecordUpdate builder.
@@ -249,6 +250,12 @@ func (_u *NotificationRecordUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationRecordUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationRecordUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotificationRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -303,6 +310,7 @@ func (_u *NotificationRecordUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.ReadAtCleared() {
 		_spec.ClearField(notificationrecord.FieldReadAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notificationrecord.Label}
@@ -318,9 +326,10 @@ func (_u *NotificationRecordUpdate) sqlSave(ctx context.Context) (_node int, err
 // NotificationRecordUpdateOne is the builder for updating a single NotificationRecord entity.
 type NotificationRecordUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NotificationRecordMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *NotificationRecordMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -557,6 +566,12 @@ func (_u *NotificationRecordUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationRecordUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationRecordUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotificationRecordUpdateOne) sqlSave(ctx context.Context) (_node *NotificationRecord, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -628,6 +643,7 @@ func (_u *NotificationRecordUpdateOne) sqlSave(ctx context.Context) (_node *Noti
 	if _u.mutation.ReadAtCleared() {
 		_spec.ClearField(notificationrecord.FieldReadAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &NotificationRecord{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // NotifyChannelUpdate is the builder for updating NotifyChannel entities.
 type NotifyChannelUpdate struct {
 	config
-	hooks    []Hook
-	mutation *NotifyChannelMutation
+	hooks     []Hook
+	mutation  *NotifyChannelMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the NotifyChannelUpdate builder.
@@ -165,6 +166,12 @@ func (_u *NotifyChannelUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotifyChannelUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotifyChannelUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotifyChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -195,6 +202,7 @@ func (_u *NotifyChannelUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notifychannel.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notifychannel.Label}
@@ -210,9 +218,10 @@ func (_u *NotifyChannelUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // NotifyChannelUpdateOne is the builder for updating a single NotifyChannel entity.
 type NotifyChannelUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NotifyChannelMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *NotifyChannelMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -365,6 +374,12 @@ func (_u *NotifyChannelUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotifyChannelUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotifyChannelUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotifyChannelUpdateOne) sqlSave(ctx context.Context) (_node *NotifyChannel, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -412,6 +427,7 @@ func (_u *NotifyChannelUpdateOne) sqlSave(ctx context.Context) (_node *NotifyCha
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notifychannel.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &NotifyChannel{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
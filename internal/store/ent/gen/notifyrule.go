@@ -30,6 +30,8 @@ type NotifyRule struct {
 	ChannelPattern string `json:"channel_pattern,omitempty"`
 	// Condition holds the value of the "condition" field.
 	Condition string `json:"condition,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
 	// Priority holds the value of the "priority" field.
 	Priority int `json:"priority,omitempty"`
 	// Params holds the value of the "params" field.
@@ -54,7 +56,7 @@ func (*NotifyRule) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case notifyrule.FieldID, notifyrule.FieldPriority:
 			values[i] = new(sql.NullInt64)
-		case notifyrule.FieldRuleID, notifyrule.FieldName, notifyrule.FieldAction, notifyrule.FieldEventPattern, notifyrule.FieldChannelPattern, notifyrule.FieldCondition:
+		case notifyrule.FieldRuleID, notifyrule.FieldName, notifyrule.FieldAction, notifyrule.FieldEventPattern, notifyrule.FieldChannelPattern, notifyrule.FieldCondition, notifyrule.FieldTimezone:
 			values[i] = new(sql.NullString)
 		case notifyrule.FieldCreatedAt, notifyrule.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -115,6 +117,12 @@ func (_m *NotifyRule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Condition = value.String
 			}
+		case notifyrule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
 		case notifyrule.FieldPriority:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field priority", values[i])
@@ -201,6 +209,9 @@ func (_m *NotifyRule) String() string {
 	builder.WriteString("condition=")
 	builder.WriteString(_m.Condition)
 	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
 	builder.WriteString(", ")
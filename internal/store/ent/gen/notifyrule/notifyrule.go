@@ -26,6 +26,8 @@ const (
 	FieldChannelPattern = "channel_pattern"
 	// FieldCondition holds the string denoting the condition field in the database.
 	FieldCondition = "condition"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
 	// FieldParams holds the string denoting the params field in the database.
@@ -49,6 +51,7 @@ var Columns = []string{
 	FieldEventPattern,
 	FieldChannelPattern,
 	FieldCondition,
+	FieldTimezone,
 	FieldPriority,
 	FieldParams,
 	FieldEnabled,
@@ -154,6 +157,11 @@ func ByCondition(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCondition, opts...).ToFunc()
 }
 
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByPriority orders the results by the priority field.
 func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
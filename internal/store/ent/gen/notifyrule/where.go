@@ -79,6 +79,11 @@ func Condition(v string) predicate.NotifyRule {
 	return predicate.NotifyRule(sql.FieldEQ(FieldCondition, v))
 }
 
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldEQ(FieldTimezone, v))
+}
+
 // Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
 func Priority(v int) predicate.NotifyRule {
 	return predicate.NotifyRule(sql.FieldEQ(FieldPriority, v))
@@ -454,6 +459,81 @@ func ConditionContainsFold(v string) predicate.NotifyRule {
 	return predicate.NotifyRule(sql.FieldContainsFold(FieldCondition, v))
 }
 
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.NotifyRule {
+	return predicate.NotifyRule(sql.FieldContainsFold(FieldTimezone, v))
+}
+
 // PriorityEQ applies the EQ predicate on the "priority" field.
 func PriorityEQ(v int) predicate.NotifyRule {
 	return predicate.NotifyRule(sql.FieldEQ(FieldPriority, v))
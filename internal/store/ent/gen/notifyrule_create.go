@@ -82,6 +82,20 @@ func (_c *NotifyRuleCreate) SetNillableCondition(v *string) *NotifyRuleCreate {
 	return _c
 }
 
+// SetTimezone sets the "timezone" field.
+func (_c *NotifyRuleCreate) SetTimezone(v string) *NotifyRuleCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *NotifyRuleCreate) SetNillableTimezone(v *string) *NotifyRuleCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
 // SetPriority sets the "priority" field.
 func (_c *NotifyRuleCreate) SetPriority(v int) *NotifyRuleCreate {
 	_c.mutation.SetPriority(v)
@@ -325,6 +339,10 @@ func (_c *NotifyRuleCreate) createSpec() (*NotifyRule, *sqlgraph.CreateSpec) {
 		_spec.SetField(notifyrule.FieldCondition, field.TypeString, value)
 		_node.Condition = value
 	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(notifyrule.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
 	if value, ok := _c.mutation.Priority(); ok {
 		_spec.SetField(notifyrule.FieldPriority, field.TypeInt, value)
 		_node.Priority = value
@@ -475,6 +493,24 @@ func (u *NotifyRuleUpsert) ClearCondition() *NotifyRuleUpsert {
 	return u
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *NotifyRuleUpsert) SetTimezone(v string) *NotifyRuleUpsert {
+	u.Set(notifyrule.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *NotifyRuleUpsert) UpdateTimezone() *NotifyRuleUpsert {
+	u.SetExcluded(notifyrule.FieldTimezone)
+	return u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *NotifyRuleUpsert) ClearTimezone() *NotifyRuleUpsert {
+	u.SetNull(notifyrule.FieldTimezone)
+	return u
+}
+
 // SetPriority sets the "priority" field.
 func (u *NotifyRuleUpsert) SetPriority(v int) *NotifyRuleUpsert {
 	u.Set(notifyrule.FieldPriority, v)
@@ -671,6 +707,27 @@ func (u *NotifyRuleUpsertOne) ClearCondition() *NotifyRuleUpsertOne {
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *NotifyRuleUpsertOne) SetTimezone(v string) *NotifyRuleUpsertOne {
+	return u.Update(func(s *NotifyRuleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *NotifyRuleUpsertOne) UpdateTimezone() *NotifyRuleUpsertOne {
+	return u.Update(func(s *NotifyRuleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *NotifyRuleUpsertOne) ClearTimezone() *NotifyRuleUpsertOne {
+	return u.Update(func(s *NotifyRuleUpsert) {
+		s.ClearTimezone()
+	})
+}
+
 // SetPriority sets the "priority" field.
 func (u *NotifyRuleUpsertOne) SetPriority(v int) *NotifyRuleUpsertOne {
 	return u.Update(func(s *NotifyRuleUpsert) {
@@ -1042,6 +1099,27 @@ func (u *NotifyRuleUpsertBulk) ClearCondition() *NotifyRuleUpsertBulk {
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *NotifyRuleUpsertBulk) SetTimezone(v string) *NotifyRuleUpsertBulk {
+	return u.Update(func(s *NotifyRuleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *NotifyRuleUpsertBulk) UpdateTimezone() *NotifyRuleUpsertBulk {
+	return u.Update(func(s *NotifyRuleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *NotifyRuleUpsertBulk) ClearTimezone() *NotifyRuleUpsertBulk {
+	return u.Update(func(s *NotifyRuleUpsert) {
+		s.ClearTimezone()
+	})
+}
+
 // SetPriority sets the "priority" field.
 func (u *NotifyRuleUpsertBulk) SetPriority(v int) *NotifyRuleUpsertBulk {
 	return u.Update(func(s *NotifyRuleUpsert) {
@@ -18,8 +18,9 @@ import (
 // NotifyRuleUpdate is the builder for updating NotifyRule entities.
 type NotifyRuleUpdate struct {
 	config
-	hooks    []Hook
-	mutation *NotifyRuleMutation
+	hooks     []Hook
+	mutation  *NotifyRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the NotifyRuleUpdate builder.
@@ -118,6 +119,26 @@ func (_u *NotifyRuleUpdate) ClearCondition() *NotifyRuleUpdate {
 	return _u
 }
 
+// SetTimezone sets the "timezone" field.
+func (_u *NotifyRuleUpdate) SetTimezone(v string) *NotifyRuleUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *NotifyRuleUpdate) SetNillableTimezone(v *string) *NotifyRuleUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *NotifyRuleUpdate) ClearTimezone() *NotifyRuleUpdate {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
 // SetPriority sets the "priority" field.
 func (_u *NotifyRuleUpdate) SetPriority(v int) *NotifyRuleUpdate {
 	_u.mutation.ResetPriority()
@@ -236,6 +257,12 @@ func (_u *NotifyRuleUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotifyRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotifyRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotifyRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -269,6 +296,12 @@ func (_u *NotifyRuleUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.ConditionCleared() {
 		_spec.ClearField(notifyrule.FieldCondition, field.TypeString)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(notifyrule.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(notifyrule.FieldTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.Priority(); ok {
 		_spec.SetField(notifyrule.FieldPriority, field.TypeInt, value)
 	}
@@ -284,6 +317,7 @@ func (_u *NotifyRuleUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notifyrule.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notifyrule.Label}
@@ -299,9 +333,10 @@ func (_u *NotifyRuleUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // NotifyRuleUpdateOne is the builder for updating a single NotifyRule entity.
 type NotifyRuleUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NotifyRuleMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *NotifyRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetRuleID sets the "rule_id" field.
@@ -394,6 +429,26 @@ func (_u *NotifyRuleUpdateOne) ClearCondition() *NotifyRuleUpdateOne {
 	return _u
 }
 
+// SetTimezone sets the "timezone" field.
+func (_u *NotifyRuleUpdateOne) SetTimezone(v string) *NotifyRuleUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *NotifyRuleUpdateOne) SetNillableTimezone(v *string) *NotifyRuleUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *NotifyRuleUpdateOne) ClearTimezone() *NotifyRuleUpdateOne {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
 // SetPriority sets the "priority" field.
 func (_u *NotifyRuleUpdateOne) SetPriority(v int) *NotifyRuleUpdateOne {
 	_u.mutation.ResetPriority()
@@ -525,6 +580,12 @@ func (_u *NotifyRuleUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotifyRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotifyRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotifyRuleUpdateOne) sqlSave(ctx context.Context) (_node *NotifyRule, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -575,6 +636,12 @@ func (_u *NotifyRuleUpdateOne) sqlSave(ctx context.Context) (_node *NotifyRule,
 	if _u.mutation.ConditionCleared() {
 		_spec.ClearField(notifyrule.FieldCondition, field.TypeString)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(notifyrule.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(notifyrule.FieldTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.Priority(); ok {
 		_spec.SetField(notifyrule.FieldPriority, field.TypeInt, value)
 	}
@@ -590,6 +657,7 @@ func (_u *NotifyRuleUpdateOne) sqlSave(ctx context.Context) (_node *NotifyRule,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notifyrule.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &NotifyRule{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -20,8 +20,9 @@ import (
 // NotifyTemplateUpdate is the builder for updating NotifyTemplate entities.
 type NotifyTemplateUpdate struct {
 	config
-	hooks    []Hook
-	mutation *NotifyTemplateMutation
+	hooks     []Hook
+	mutation  *NotifyTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the NotifyTemplateUpdate builder.
@@ -204,6 +205,12 @@ func (_u *NotifyTemplateUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotifyTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotifyTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotifyTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -248,6 +255,7 @@ func (_u *NotifyTemplateUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notifytemplate.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notifytemplate.Label}
@@ -263,9 +271,10 @@ func (_u *NotifyTemplateUpdate) sqlSave(ctx context.Context) (_node int, err err
 // NotifyTemplateUpdateOne is the builder for updating a single NotifyTemplate entity.
 type NotifyTemplateUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *NotifyTemplateMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *NotifyTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTemplateID sets the "template_id" field.
@@ -455,6 +464,12 @@ func (_u *NotifyTemplateUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotifyTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotifyTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *NotifyTemplateUpdateOne) sqlSave(ctx context.Context) (_node *NotifyTemplate, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -516,6 +531,7 @@ func (_u *NotifyTemplateUpdateOne) sqlSave(ctx context.Context) (_node *NotifyTe
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notifytemplate.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &NotifyTemplate{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // OAuthUpdate is the builder for updating OAuth entities.
 type OAuthUpdate struct {
 	config
-	hooks    []Hook
-	mutation *OAuthMutation
+	hooks     []Hook
+	mutation  *OAuthMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the OAuthUpdate builder.
@@ -261,6 +262,12 @@ func (_u *OAuthUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OAuthUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *OAuthUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -318,6 +325,7 @@ func (_u *OAuthUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(oauth.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{oauth.Label}
@@ -333,9 +341,10 @@ func (_u *OAuthUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // OAuthUpdateOne is the builder for updating a single OAuth entity.
 type OAuthUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *OAuthMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *OAuthMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUID sets the "uid" field.
@@ -584,6 +593,12 @@ func (_u *OAuthUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OAuthUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *OAuthUpdateOne) sqlSave(ctx context.Context) (_node *OAuth, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -658,6 +673,7 @@ func (_u *OAuthUpdateOne) sqlSave(ctx context.Context) (_node *OAuth, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(oauth.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &OAuth{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
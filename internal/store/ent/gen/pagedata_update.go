@@ -18,8 +18,9 @@ import (
 // PageDataUpdate is the builder for updating PageData entities.
 type PageDataUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PageDataMutation
+	hooks     []Hook
+	mutation  *PageDataMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PageDataUpdate builder.
@@ -163,6 +164,12 @@ func (_u *PageDataUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PageDataUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PageDataUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PageDataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -199,6 +206,7 @@ func (_u *PageDataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(pagedata.FieldExpiresAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pagedata.Label}
@@ -214,9 +222,10 @@ func (_u *PageDataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // PageDataUpdateOne is the builder for updating a single PageData entity.
 type PageDataUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PageDataMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PageDataMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetToken sets the "token" field.
@@ -367,6 +376,12 @@ func (_u *PageDataUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PageDataUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PageDataUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PageDataUpdateOne) sqlSave(ctx context.Context) (_node *PageData, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -420,6 +435,7 @@ func (_u *PageDataUpdateOne) sqlSave(ctx context.Context) (_node *PageData, err
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(pagedata.FieldExpiresAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PageData{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
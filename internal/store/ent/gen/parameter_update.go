@@ -18,8 +18,9 @@ import (
 // ParameterUpdate is the builder for updating Parameter entities.
 type ParameterUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ParameterMutation
+	hooks     []Hook
+	mutation  *ParameterMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ParameterUpdate builder.
@@ -125,6 +126,12 @@ func (_u *ParameterUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ParameterUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ParameterUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ParameterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -152,6 +159,7 @@ func (_u *ParameterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ExpiredAt(); ok {
 		_spec.SetField(parameter.FieldExpiredAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{parameter.Label}
@@ -167,9 +175,10 @@ func (_u *ParameterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ParameterUpdateOne is the builder for updating a single Parameter entity.
 type ParameterUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ParameterMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ParameterMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -282,6 +291,12 @@ func (_u *ParameterUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ParameterUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ParameterUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ParameterUpdateOne) sqlSave(ctx context.Context) (_node *Parameter, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -326,6 +341,7 @@ func (_u *ParameterUpdateOne) sqlSave(ctx context.Context) (_node *Parameter, er
 	if value, ok := _u.mutation.ExpiredAt(); ok {
 		_spec.SetField(parameter.FieldExpiredAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Parameter{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // PipelineDefinitionUpdate is the builder for updating PipelineDefinition entities.
 type PipelineDefinitionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PipelineDefinitionMutation
+	hooks     []Hook
+	mutation  *PipelineDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PipelineDefinitionUpdate builder.
@@ -207,6 +208,12 @@ func (_u *PipelineDefinitionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineDefinitionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineDefinitionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -252,6 +259,7 @@ func (_u *PipelineDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(pipelinedefinition.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pipelinedefinition.Label}
@@ -267,9 +275,10 @@ func (_u *PipelineDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err
 // PipelineDefinitionUpdateOne is the builder for updating a single PipelineDefinition entity.
 type PipelineDefinitionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PipelineDefinitionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PipelineDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -464,6 +473,12 @@ func (_u *PipelineDefinitionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineDefinitionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineDefinitionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *PipelineDefinition, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -526,6 +541,7 @@ func (_u *PipelineDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *Pipe
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(pipelinedefinition.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PipelineDefinition{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -22,6 +22,7 @@ type PipelineDefinitionVersionQuery struct {
 	order      []pipelinedefinitionversion.OrderOption
 	inters     []Interceptor
 	predicates []predicate.PipelineDefinitionVersion
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -251,8 +252,9 @@ func (_q *PipelineDefinitionVersionQuery) Clone() *PipelineDefinitionVersionQuer
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.PipelineDefinitionVersion{}, _q.predicates...),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -343,6 +345,9 @@ func (_q *PipelineDefinitionVersionQuery) sqlAll(ctx context.Context, hooks ...q
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (_q *PipelineDefinitionVersionQuery) sqlAll(ctx context.Context, hooks ...q
 
 func (_q *PipelineDefinitionVersionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -419,6 +427,9 @@ func (_q *PipelineDefinitionVersionQuery) sqlQuery(ctx context.Context) *sql.Sel
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -436,6 +447,12 @@ func (_q *PipelineDefinitionVersionQuery) sqlQuery(ctx context.Context) *sql.Sel
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *PipelineDefinitionVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *PipelineDefinitionVersionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // PipelineDefinitionVersionGroupBy is the group-by builder for PipelineDefinitionVersion entities.
 type PipelineDefinitionVersionGroupBy struct {
 	selector
@@ -525,3 +542,9 @@ func (_s *PipelineDefinitionVersionSelect) sqlScan(ctx context.Context, root *Pi
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *PipelineDefinitionVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *PipelineDefinitionVersionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -17,8 +17,9 @@ import (
 // PipelineDefinitionVersionUpdate is the builder for updating PipelineDefinitionVersion entities.
 type PipelineDefinitionVersionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PipelineDefinitionVersionMutation
+	hooks     []Hook
+	mutation  *PipelineDefinitionVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PipelineDefinitionVersionUpdate builder.
@@ -123,6 +124,12 @@ func (_u *PipelineDefinitionVersionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineDefinitionVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineDefinitionVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineDefinitionVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -147,6 +154,7 @@ func (_u *PipelineDefinitionVersionUpdate) sqlSave(ctx context.Context) (_node i
 	if value, ok := _u.mutation.Yaml(); ok {
 		_spec.SetField(pipelinedefinitionversion.FieldYaml, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pipelinedefinitionversion.Label}
@@ -162,9 +170,10 @@ func (_u *PipelineDefinitionVersionUpdate) sqlSave(ctx context.Context) (_node i
 // PipelineDefinitionVersionUpdateOne is the builder for updating a single PipelineDefinitionVersion entity.
 type PipelineDefinitionVersionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PipelineDefinitionVersionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PipelineDefinitionVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPipelineName sets the "pipeline_name" field.
@@ -276,6 +285,12 @@ func (_u *PipelineDefinitionVersionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineDefinitionVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineDefinitionVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineDefinitionVersionUpdateOne) sqlSave(ctx context.Context) (_node *PipelineDefinitionVersion, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -317,6 +332,7 @@ func (_u *PipelineDefinitionVersionUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := _u.mutation.Yaml(); ok {
 		_spec.SetField(pipelinedefinitionversion.FieldYaml, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PipelineDefinitionVersion{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -39,7 +39,13 @@ type PipelineRun struct {
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ParentKind holds the value of the "parent_kind" field.
+	ParentKind string `json:"parent_kind,omitempty"`
+	// ParentRunID holds the value of the "parent_run_id" field.
+	ParentRunID *int64 `json:"parent_run_id,omitempty"`
+	// CallDepth holds the value of the "call_depth" field.
+	CallDepth    int `json:"call_depth,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -50,9 +56,9 @@ func (*PipelineRun) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case pipelinerun.FieldCheckpointData:
 			values[i] = new([]byte)
-		case pipelinerun.FieldID, pipelinerun.FieldStatus:
+		case pipelinerun.FieldID, pipelinerun.FieldStatus, pipelinerun.FieldParentRunID, pipelinerun.FieldCallDepth:
 			values[i] = new(sql.NullInt64)
-		case pipelinerun.FieldPipelineName, pipelinerun.FieldEventID, pipelinerun.FieldEventType, pipelinerun.FieldTriggerSource, pipelinerun.FieldError:
+		case pipelinerun.FieldPipelineName, pipelinerun.FieldEventID, pipelinerun.FieldEventType, pipelinerun.FieldTriggerSource, pipelinerun.FieldError, pipelinerun.FieldParentKind:
 			values[i] = new(sql.NullString)
 		case pipelinerun.FieldLastHeartbeat, pipelinerun.FieldStartedAt, pipelinerun.FieldCompletedAt, pipelinerun.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -147,6 +153,25 @@ func (_m *PipelineRun) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case pipelinerun.FieldParentKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_kind", values[i])
+			} else if value.Valid {
+				_m.ParentKind = value.String
+			}
+		case pipelinerun.FieldParentRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_run_id", values[i])
+			} else if value.Valid {
+				_m.ParentRunID = new(int64)
+				*_m.ParentRunID = value.Int64
+			}
+		case pipelinerun.FieldCallDepth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field call_depth", values[i])
+			} else if value.Valid {
+				_m.CallDepth = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -219,6 +244,17 @@ func (_m *PipelineRun) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("parent_kind=")
+	builder.WriteString(_m.ParentKind)
+	builder.WriteString(", ")
+	if v := _m.ParentRunID; v != nil {
+		builder.WriteString("parent_run_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("call_depth=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CallDepth))
 	builder.WriteByte(')')
 	return builder.String()
 }
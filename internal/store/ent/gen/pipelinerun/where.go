@@ -99,6 +99,21 @@ func CreatedAt(v time.Time) predicate.PipelineRun {
 	return predicate.PipelineRun(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ParentKind applies equality check predicate on the "parent_kind" field. It's identical to ParentKindEQ.
+func ParentKind(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEQ(FieldParentKind, v))
+}
+
+// ParentRunID applies equality check predicate on the "parent_run_id" field. It's identical to ParentRunIDEQ.
+func ParentRunID(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEQ(FieldParentRunID, v))
+}
+
+// CallDepth applies equality check predicate on the "call_depth" field. It's identical to CallDepthEQ.
+func CallDepth(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEQ(FieldCallDepth, v))
+}
+
 // PipelineNameEQ applies the EQ predicate on the "pipeline_name" field.
 func PipelineNameEQ(v string) predicate.PipelineRun {
 	return predicate.PipelineRun(sql.FieldEQ(FieldPipelineName, v))
@@ -619,6 +634,161 @@ func CreatedAtLTE(v time.Time) predicate.PipelineRun {
 	return predicate.PipelineRun(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ParentKindEQ applies the EQ predicate on the "parent_kind" field.
+func ParentKindEQ(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEQ(FieldParentKind, v))
+}
+
+// ParentKindNEQ applies the NEQ predicate on the "parent_kind" field.
+func ParentKindNEQ(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNEQ(FieldParentKind, v))
+}
+
+// ParentKindIn applies the In predicate on the "parent_kind" field.
+func ParentKindIn(vs ...string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldIn(FieldParentKind, vs...))
+}
+
+// ParentKindNotIn applies the NotIn predicate on the "parent_kind" field.
+func ParentKindNotIn(vs ...string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNotIn(FieldParentKind, vs...))
+}
+
+// ParentKindGT applies the GT predicate on the "parent_kind" field.
+func ParentKindGT(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldGT(FieldParentKind, v))
+}
+
+// ParentKindGTE applies the GTE predicate on the "parent_kind" field.
+func ParentKindGTE(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldGTE(FieldParentKind, v))
+}
+
+// ParentKindLT applies the LT predicate on the "parent_kind" field.
+func ParentKindLT(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldLT(FieldParentKind, v))
+}
+
+// ParentKindLTE applies the LTE predicate on the "parent_kind" field.
+func ParentKindLTE(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldLTE(FieldParentKind, v))
+}
+
+// ParentKindContains applies the Contains predicate on the "parent_kind" field.
+func ParentKindContains(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldContains(FieldParentKind, v))
+}
+
+// ParentKindHasPrefix applies the HasPrefix predicate on the "parent_kind" field.
+func ParentKindHasPrefix(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldHasPrefix(FieldParentKind, v))
+}
+
+// ParentKindHasSuffix applies the HasSuffix predicate on the "parent_kind" field.
+func ParentKindHasSuffix(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldHasSuffix(FieldParentKind, v))
+}
+
+// ParentKindEqualFold applies the EqualFold predicate on the "parent_kind" field.
+func ParentKindEqualFold(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEqualFold(FieldParentKind, v))
+}
+
+// ParentKindContainsFold applies the ContainsFold predicate on the "parent_kind" field.
+func ParentKindContainsFold(v string) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldContainsFold(FieldParentKind, v))
+}
+
+// ParentRunIDEQ applies the EQ predicate on the "parent_run_id" field.
+func ParentRunIDEQ(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEQ(FieldParentRunID, v))
+}
+
+// ParentRunIDNEQ applies the NEQ predicate on the "parent_run_id" field.
+func ParentRunIDNEQ(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNEQ(FieldParentRunID, v))
+}
+
+// ParentRunIDIn applies the In predicate on the "parent_run_id" field.
+func ParentRunIDIn(vs ...int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldIn(FieldParentRunID, vs...))
+}
+
+// ParentRunIDNotIn applies the NotIn predicate on the "parent_run_id" field.
+func ParentRunIDNotIn(vs ...int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNotIn(FieldParentRunID, vs...))
+}
+
+// ParentRunIDGT applies the GT predicate on the "parent_run_id" field.
+func ParentRunIDGT(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldGT(FieldParentRunID, v))
+}
+
+// ParentRunIDGTE applies the GTE predicate on the "parent_run_id" field.
+func ParentRunIDGTE(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldGTE(FieldParentRunID, v))
+}
+
+// ParentRunIDLT applies the LT predicate on the "parent_run_id" field.
+func ParentRunIDLT(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldLT(FieldParentRunID, v))
+}
+
+// ParentRunIDLTE applies the LTE predicate on the "parent_run_id" field.
+func ParentRunIDLTE(v int64) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldLTE(FieldParentRunID, v))
+}
+
+// ParentRunIDIsNil applies the IsNil predicate on the "parent_run_id" field.
+func ParentRunIDIsNil() predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldIsNull(FieldParentRunID))
+}
+
+// ParentRunIDNotNil applies the NotNil predicate on the "parent_run_id" field.
+func ParentRunIDNotNil() predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNotNull(FieldParentRunID))
+}
+
+// CallDepthEQ applies the EQ predicate on the "call_depth" field.
+func CallDepthEQ(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldEQ(FieldCallDepth, v))
+}
+
+// CallDepthNEQ applies the NEQ predicate on the "call_depth" field.
+func CallDepthNEQ(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNEQ(FieldCallDepth, v))
+}
+
+// CallDepthIn applies the In predicate on the "call_depth" field.
+func CallDepthIn(vs ...int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldIn(FieldCallDepth, vs...))
+}
+
+// CallDepthNotIn applies the NotIn predicate on the "call_depth" field.
+func CallDepthNotIn(vs ...int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldNotIn(FieldCallDepth, vs...))
+}
+
+// CallDepthGT applies the GT predicate on the "call_depth" field.
+func CallDepthGT(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldGT(FieldCallDepth, v))
+}
+
+// CallDepthGTE applies the GTE predicate on the "call_depth" field.
+func CallDepthGTE(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldGTE(FieldCallDepth, v))
+}
+
+// CallDepthLT applies the LT predicate on the "call_depth" field.
+func CallDepthLT(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldLT(FieldCallDepth, v))
+}
+
+// CallDepthLTE applies the LTE predicate on the "call_depth" field.
+func CallDepthLTE(v int) predicate.PipelineRun {
+	return predicate.PipelineRun(sql.FieldLTE(FieldCallDepth, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PipelineRun) predicate.PipelineRun {
 	return predicate.PipelineRun(sql.AndPredicates(predicates...))
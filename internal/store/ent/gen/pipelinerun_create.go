@@ -144,6 +144,48 @@ func (_c *PipelineRunCreate) SetNillableCreatedAt(v *time.Time) *PipelineRunCrea
 	return _c
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (_c *PipelineRunCreate) SetParentKind(v string) *PipelineRunCreate {
+	_c.mutation.SetParentKind(v)
+	return _c
+}
+
+// SetNillableParentKind sets the "parent_kind" field if the given value is not nil.
+func (_c *PipelineRunCreate) SetNillableParentKind(v *string) *PipelineRunCreate {
+	if v != nil {
+		_c.SetParentKind(*v)
+	}
+	return _c
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (_c *PipelineRunCreate) SetParentRunID(v int64) *PipelineRunCreate {
+	_c.mutation.SetParentRunID(v)
+	return _c
+}
+
+// SetNillableParentRunID sets the "parent_run_id" field if the given value is not nil.
+func (_c *PipelineRunCreate) SetNillableParentRunID(v *int64) *PipelineRunCreate {
+	if v != nil {
+		_c.SetParentRunID(*v)
+	}
+	return _c
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (_c *PipelineRunCreate) SetCallDepth(v int) *PipelineRunCreate {
+	_c.mutation.SetCallDepth(v)
+	return _c
+}
+
+// SetNillableCallDepth sets the "call_depth" field if the given value is not nil.
+func (_c *PipelineRunCreate) SetNillableCallDepth(v *int) *PipelineRunCreate {
+	if v != nil {
+		_c.SetCallDepth(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PipelineRunCreate) SetID(v int64) *PipelineRunCreate {
 	_c.mutation.SetID(v)
@@ -205,6 +247,14 @@ func (_c *PipelineRunCreate) defaults() {
 		v := pipelinerun.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.ParentKind(); !ok {
+		v := pipelinerun.DefaultParentKind
+		_c.mutation.SetParentKind(v)
+	}
+	if _, ok := _c.mutation.CallDepth(); !ok {
+		v := pipelinerun.DefaultCallDepth
+		_c.mutation.SetCallDepth(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -245,6 +295,12 @@ func (_c *PipelineRunCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "PipelineRun.created_at"`)}
 	}
+	if _, ok := _c.mutation.ParentKind(); !ok {
+		return &ValidationError{Name: "parent_kind", err: errors.New(`gen: missing required field "PipelineRun.parent_kind"`)}
+	}
+	if _, ok := _c.mutation.CallDepth(); !ok {
+		return &ValidationError{Name: "call_depth", err: errors.New(`gen: missing required field "PipelineRun.call_depth"`)}
+	}
 	return nil
 }
 
@@ -322,6 +378,18 @@ func (_c *PipelineRunCreate) createSpec() (*PipelineRun, *sqlgraph.CreateSpec) {
 		_spec.SetField(pipelinerun.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.ParentKind(); ok {
+		_spec.SetField(pipelinerun.FieldParentKind, field.TypeString, value)
+		_node.ParentKind = value
+	}
+	if value, ok := _c.mutation.ParentRunID(); ok {
+		_spec.SetField(pipelinerun.FieldParentRunID, field.TypeInt64, value)
+		_node.ParentRunID = &value
+	}
+	if value, ok := _c.mutation.CallDepth(); ok {
+		_spec.SetField(pipelinerun.FieldCallDepth, field.TypeInt, value)
+		_node.CallDepth = value
+	}
 	return _node, _spec
 }
 
@@ -524,6 +592,60 @@ func (u *PipelineRunUpsert) ClearCompletedAt() *PipelineRunUpsert {
 	return u
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (u *PipelineRunUpsert) SetParentKind(v string) *PipelineRunUpsert {
+	u.Set(pipelinerun.FieldParentKind, v)
+	return u
+}
+
+// UpdateParentKind sets the "parent_kind" field to the value that was provided on create.
+func (u *PipelineRunUpsert) UpdateParentKind() *PipelineRunUpsert {
+	u.SetExcluded(pipelinerun.FieldParentKind)
+	return u
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (u *PipelineRunUpsert) SetParentRunID(v int64) *PipelineRunUpsert {
+	u.Set(pipelinerun.FieldParentRunID, v)
+	return u
+}
+
+// UpdateParentRunID sets the "parent_run_id" field to the value that was provided on create.
+func (u *PipelineRunUpsert) UpdateParentRunID() *PipelineRunUpsert {
+	u.SetExcluded(pipelinerun.FieldParentRunID)
+	return u
+}
+
+// AddParentRunID adds v to the "parent_run_id" field.
+func (u *PipelineRunUpsert) AddParentRunID(v int64) *PipelineRunUpsert {
+	u.Add(pipelinerun.FieldParentRunID, v)
+	return u
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (u *PipelineRunUpsert) ClearParentRunID() *PipelineRunUpsert {
+	u.SetNull(pipelinerun.FieldParentRunID)
+	return u
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (u *PipelineRunUpsert) SetCallDepth(v int) *PipelineRunUpsert {
+	u.Set(pipelinerun.FieldCallDepth, v)
+	return u
+}
+
+// UpdateCallDepth sets the "call_depth" field to the value that was provided on create.
+func (u *PipelineRunUpsert) UpdateCallDepth() *PipelineRunUpsert {
+	u.SetExcluded(pipelinerun.FieldCallDepth)
+	return u
+}
+
+// AddCallDepth adds v to the "call_depth" field.
+func (u *PipelineRunUpsert) AddCallDepth(v int) *PipelineRunUpsert {
+	u.Add(pipelinerun.FieldCallDepth, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -750,6 +872,69 @@ func (u *PipelineRunUpsertOne) ClearCompletedAt() *PipelineRunUpsertOne {
 	})
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (u *PipelineRunUpsertOne) SetParentKind(v string) *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.SetParentKind(v)
+	})
+}
+
+// UpdateParentKind sets the "parent_kind" field to the value that was provided on create.
+func (u *PipelineRunUpsertOne) UpdateParentKind() *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.UpdateParentKind()
+	})
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (u *PipelineRunUpsertOne) SetParentRunID(v int64) *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.SetParentRunID(v)
+	})
+}
+
+// AddParentRunID adds v to the "parent_run_id" field.
+func (u *PipelineRunUpsertOne) AddParentRunID(v int64) *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.AddParentRunID(v)
+	})
+}
+
+// UpdateParentRunID sets the "parent_run_id" field to the value that was provided on create.
+func (u *PipelineRunUpsertOne) UpdateParentRunID() *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.UpdateParentRunID()
+	})
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (u *PipelineRunUpsertOne) ClearParentRunID() *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.ClearParentRunID()
+	})
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (u *PipelineRunUpsertOne) SetCallDepth(v int) *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.SetCallDepth(v)
+	})
+}
+
+// AddCallDepth adds v to the "call_depth" field.
+func (u *PipelineRunUpsertOne) AddCallDepth(v int) *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.AddCallDepth(v)
+	})
+}
+
+// UpdateCallDepth sets the "call_depth" field to the value that was provided on create.
+func (u *PipelineRunUpsertOne) UpdateCallDepth() *PipelineRunUpsertOne {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.UpdateCallDepth()
+	})
+}
+
 // Exec executes the query.
 func (u *PipelineRunUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1142,6 +1327,69 @@ func (u *PipelineRunUpsertBulk) ClearCompletedAt() *PipelineRunUpsertBulk {
 	})
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (u *PipelineRunUpsertBulk) SetParentKind(v string) *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.SetParentKind(v)
+	})
+}
+
+// UpdateParentKind sets the "parent_kind" field to the value that was provided on create.
+func (u *PipelineRunUpsertBulk) UpdateParentKind() *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.UpdateParentKind()
+	})
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (u *PipelineRunUpsertBulk) SetParentRunID(v int64) *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.SetParentRunID(v)
+	})
+}
+
+// AddParentRunID adds v to the "parent_run_id" field.
+func (u *PipelineRunUpsertBulk) AddParentRunID(v int64) *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.AddParentRunID(v)
+	})
+}
+
+// UpdateParentRunID sets the "parent_run_id" field to the value that was provided on create.
+func (u *PipelineRunUpsertBulk) UpdateParentRunID() *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.UpdateParentRunID()
+	})
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (u *PipelineRunUpsertBulk) ClearParentRunID() *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.ClearParentRunID()
+	})
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (u *PipelineRunUpsertBulk) SetCallDepth(v int) *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.SetCallDepth(v)
+	})
+}
+
+// AddCallDepth adds v to the "call_depth" field.
+func (u *PipelineRunUpsertBulk) AddCallDepth(v int) *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.AddCallDepth(v)
+	})
+}
+
+// UpdateCallDepth sets the "call_depth" field to the value that was provided on create.
+func (u *PipelineRunUpsertBulk) UpdateCallDepth() *PipelineRunUpsertBulk {
+	return u.Update(func(s *PipelineRunUpsert) {
+		s.UpdateCallDepth()
+	})
+}
+
 // Exec executes the query.
 func (u *PipelineRunUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -18,8 +18,9 @@ import (
 // PipelineRunUpdate is the builder for updating PipelineRun entities.
 type PipelineRunUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PipelineRunMutation
+	hooks     []Hook
+	mutation  *PipelineRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PipelineRunUpdate builder.
@@ -191,6 +192,68 @@ func (_u *PipelineRunUpdate) ClearCompletedAt() *PipelineRunUpdate {
 	return _u
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (_u *PipelineRunUpdate) SetParentKind(v string) *PipelineRunUpdate {
+	_u.mutation.SetParentKind(v)
+	return _u
+}
+
+// SetNillableParentKind sets the "parent_kind" field if the given value is not nil.
+func (_u *PipelineRunUpdate) SetNillableParentKind(v *string) *PipelineRunUpdate {
+	if v != nil {
+		_u.SetParentKind(*v)
+	}
+	return _u
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (_u *PipelineRunUpdate) SetParentRunID(v int64) *PipelineRunUpdate {
+	_u.mutation.ResetParentRunID()
+	_u.mutation.SetParentRunID(v)
+	return _u
+}
+
+// SetNillableParentRunID sets the "parent_run_id" field if the given value is not nil.
+func (_u *PipelineRunUpdate) SetNillableParentRunID(v *int64) *PipelineRunUpdate {
+	if v != nil {
+		_u.SetParentRunID(*v)
+	}
+	return _u
+}
+
+// AddParentRunID adds value to the "parent_run_id" field.
+func (_u *PipelineRunUpdate) AddParentRunID(v int64) *PipelineRunUpdate {
+	_u.mutation.AddParentRunID(v)
+	return _u
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (_u *PipelineRunUpdate) ClearParentRunID() *PipelineRunUpdate {
+	_u.mutation.ClearParentRunID()
+	return _u
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (_u *PipelineRunUpdate) SetCallDepth(v int) *PipelineRunUpdate {
+	_u.mutation.ResetCallDepth()
+	_u.mutation.SetCallDepth(v)
+	return _u
+}
+
+// SetNillableCallDepth sets the "call_depth" field if the given value is not nil.
+func (_u *PipelineRunUpdate) SetNillableCallDepth(v *int) *PipelineRunUpdate {
+	if v != nil {
+		_u.SetCallDepth(*v)
+	}
+	return _u
+}
+
+// AddCallDepth adds value to the "call_depth" field.
+func (_u *PipelineRunUpdate) AddCallDepth(v int) *PipelineRunUpdate {
+	_u.mutation.AddCallDepth(v)
+	return _u
+}
+
 // Mutation returns the PipelineRunMutation object of the builder.
 func (_u *PipelineRunUpdate) Mutation() *PipelineRunMutation {
 	return _u.mutation
@@ -243,6 +306,12 @@ func (_u *PipelineRunUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -300,6 +369,25 @@ func (_u *PipelineRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(pipelinerun.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ParentKind(); ok {
+		_spec.SetField(pipelinerun.FieldParentKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParentRunID(); ok {
+		_spec.SetField(pipelinerun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedParentRunID(); ok {
+		_spec.AddField(pipelinerun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if _u.mutation.ParentRunIDCleared() {
+		_spec.ClearField(pipelinerun.FieldParentRunID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CallDepth(); ok {
+		_spec.SetField(pipelinerun.FieldCallDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallDepth(); ok {
+		_spec.AddField(pipelinerun.FieldCallDepth, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pipelinerun.Label}
@@ -315,9 +403,10 @@ func (_u *PipelineRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // PipelineRunUpdateOne is the builder for updating a single PipelineRun entity.
 type PipelineRunUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PipelineRunMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PipelineRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPipelineName sets the "pipeline_name" field.
@@ -483,6 +572,68 @@ func (_u *PipelineRunUpdateOne) ClearCompletedAt() *PipelineRunUpdateOne {
 	return _u
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (_u *PipelineRunUpdateOne) SetParentKind(v string) *PipelineRunUpdateOne {
+	_u.mutation.SetParentKind(v)
+	return _u
+}
+
+// SetNillableParentKind sets the "parent_kind" field if the given value is not nil.
+func (_u *PipelineRunUpdateOne) SetNillableParentKind(v *string) *PipelineRunUpdateOne {
+	if v != nil {
+		_u.SetParentKind(*v)
+	}
+	return _u
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (_u *PipelineRunUpdateOne) SetParentRunID(v int64) *PipelineRunUpdateOne {
+	_u.mutation.ResetParentRunID()
+	_u.mutation.SetParentRunID(v)
+	return _u
+}
+
+// SetNillableParentRunID sets the "parent_run_id" field if the given value is not nil.
+func (_u *PipelineRunUpdateOne) SetNillableParentRunID(v *int64) *PipelineRunUpdateOne {
+	if v != nil {
+		_u.SetParentRunID(*v)
+	}
+	return _u
+}
+
+// AddParentRunID adds value to the "parent_run_id" field.
+func (_u *PipelineRunUpdateOne) AddParentRunID(v int64) *PipelineRunUpdateOne {
+	_u.mutation.AddParentRunID(v)
+	return _u
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (_u *PipelineRunUpdateOne) ClearParentRunID() *PipelineRunUpdateOne {
+	_u.mutation.ClearParentRunID()
+	return _u
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (_u *PipelineRunUpdateOne) SetCallDepth(v int) *PipelineRunUpdateOne {
+	_u.mutation.ResetCallDepth()
+	_u.mutation.SetCallDepth(v)
+	return _u
+}
+
+// SetNillableCallDepth sets the "call_depth" field if the given value is not nil.
+func (_u *PipelineRunUpdateOne) SetNillableCallDepth(v *int) *PipelineRunUpdateOne {
+	if v != nil {
+		_u.SetCallDepth(*v)
+	}
+	return _u
+}
+
+// AddCallDepth adds value to the "call_depth" field.
+func (_u *PipelineRunUpdateOne) AddCallDepth(v int) *PipelineRunUpdateOne {
+	_u.mutation.AddCallDepth(v)
+	return _u
+}
+
 // Mutation returns the PipelineRunMutation object of the builder.
 func (_u *PipelineRunUpdateOne) Mutation() *PipelineRunMutation {
 	return _u.mutation
@@ -548,6 +699,12 @@ func (_u *PipelineRunUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineRunUpdateOne) sqlSave(ctx context.Context) (_node *PipelineRun, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -622,6 +779,25 @@ func (_u *PipelineRunUpdateOne) sqlSave(ctx context.Context) (_node *PipelineRun
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(pipelinerun.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ParentKind(); ok {
+		_spec.SetField(pipelinerun.FieldParentKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParentRunID(); ok {
+		_spec.SetField(pipelinerun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedParentRunID(); ok {
+		_spec.AddField(pipelinerun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if _u.mutation.ParentRunIDCleared() {
+		_spec.ClearField(pipelinerun.FieldParentRunID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CallDepth(); ok {
+		_spec.SetField(pipelinerun.FieldCallDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallDepth(); ok {
+		_spec.AddField(pipelinerun.FieldCallDepth, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PipelineRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
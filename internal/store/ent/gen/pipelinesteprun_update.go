@@ -18,8 +18,9 @@ import (
 // PipelineStepRunUpdate is the builder for updating PipelineStepRun entities.
 type PipelineStepRunUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PipelineStepRunMutation
+	hooks     []Hook
+	mutation  *PipelineStepRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PipelineStepRunUpdate builder.
@@ -265,6 +266,12 @@ func (_u *PipelineStepRunUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineStepRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineStepRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineStepRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -337,6 +344,7 @@ func (_u *PipelineStepRunUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(pipelinesteprun.FieldCompletedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pipelinesteprun.Label}
@@ -352,9 +360,10 @@ func (_u *PipelineStepRunUpdate) sqlSave(ctx context.Context) (_node int, err er
 // PipelineStepRunUpdateOne is the builder for updating a single PipelineStepRun entity.
 type PipelineStepRunUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PipelineStepRunMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PipelineStepRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPipelineRunID sets the "pipeline_run_id" field.
@@ -607,6 +616,12 @@ func (_u *PipelineStepRunUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PipelineStepRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PipelineStepRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PipelineStepRunUpdateOne) sqlSave(ctx context.Context) (_node *PipelineStepRun, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -696,6 +711,7 @@ func (_u *PipelineStepRunUpdateOne) sqlSave(ctx context.Context) (_node *Pipelin
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(pipelinesteprun.FieldCompletedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PipelineStepRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
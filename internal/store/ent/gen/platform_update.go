@@ -18,8 +18,9 @@ import (
 // PlatformUpdate is the builder for updating Platform entities.
 type PlatformUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PlatformMutation
+	hooks     []Hook
+	mutation  *PlatformMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PlatformUpdate builder.
@@ -99,6 +100,12 @@ func (_u *PlatformUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -117,6 +124,7 @@ func (_u *PlatformUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platform.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{platform.Label}
@@ -132,9 +140,10 @@ func (_u *PlatformUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // PlatformUpdateOne is the builder for updating a single Platform entity.
 type PlatformUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PlatformMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PlatformMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -221,6 +230,12 @@ func (_u *PlatformUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformUpdateOne) sqlSave(ctx context.Context) (_node *Platform, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -256,6 +271,7 @@ func (_u *PlatformUpdateOne) sqlSave(ctx context.Context) (_node *Platform, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platform.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Platform{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // PlatformBotUpdate is the builder for updating PlatformBot entities.
 type PlatformBotUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PlatformBotMutation
+	hooks     []Hook
+	mutation  *PlatformBotMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PlatformBotUpdate builder.
@@ -141,6 +142,12 @@ func (_u *PlatformBotUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformBotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformBotUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformBotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -171,6 +178,7 @@ func (_u *PlatformBotUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformbot.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{platformbot.Label}
@@ -186,9 +194,10 @@ func (_u *PlatformBotUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // PlatformBotUpdateOne is the builder for updating a single PlatformBot entity.
 type PlatformBotUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PlatformBotMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PlatformBotMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPlatformID sets the "platform_id" field.
@@ -317,6 +326,12 @@ func (_u *PlatformBotUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformBotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformBotUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformBotUpdateOne) sqlSave(ctx context.Context) (_node *PlatformBot, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -364,6 +379,7 @@ func (_u *PlatformBotUpdateOne) sqlSave(ctx context.Context) (_node *PlatformBot
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformbot.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PlatformBot{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // PlatformChannelUpdate is the builder for updating PlatformChannel entities.
 type PlatformChannelUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PlatformChannelMutation
+	hooks     []Hook
+	mutation  *PlatformChannelMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PlatformChannelUpdate builder.
@@ -141,6 +142,12 @@ func (_u *PlatformChannelUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformChannelUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformChannelUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -171,6 +178,7 @@ func (_u *PlatformChannelUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformchannel.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{platformchannel.Label}
@@ -186,9 +194,10 @@ func (_u *PlatformChannelUpdate) sqlSave(ctx context.Context) (_node int, err er
 // PlatformChannelUpdateOne is the builder for updating a single PlatformChannel entity.
 type PlatformChannelUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PlatformChannelMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PlatformChannelMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPlatformID sets the "platform_id" field.
@@ -317,6 +326,12 @@ func (_u *PlatformChannelUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformChannelUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformChannelUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformChannelUpdateOne) sqlSave(ctx context.Context) (_node *PlatformChannel, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -364,6 +379,7 @@ func (_u *PlatformChannelUpdateOne) sqlSave(ctx context.Context) (_node *Platfor
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformchannel.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PlatformChannel{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // PlatformChannelUserUpdate is the builder for updating PlatformChannelUser entities.
 type PlatformChannelUserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PlatformChannelUserMutation
+	hooks     []Hook
+	mutation  *PlatformChannelUserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PlatformChannelUserUpdate builder.
@@ -139,6 +140,12 @@ func (_u *PlatformChannelUserUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformChannelUserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformChannelUserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformChannelUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -166,6 +173,7 @@ func (_u *PlatformChannelUserUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformchanneluser.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{platformchanneluser.Label}
@@ -181,9 +189,10 @@ func (_u *PlatformChannelUserUpdate) sqlSave(ctx context.Context) (_node int, er
 // PlatformChannelUserUpdateOne is the builder for updating a single PlatformChannelUser entity.
 type PlatformChannelUserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PlatformChannelUserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PlatformChannelUserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPlatformID sets the "platform_id" field.
@@ -310,6 +319,12 @@ func (_u *PlatformChannelUserUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformChannelUserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformChannelUserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformChannelUserUpdateOne) sqlSave(ctx context.Context) (_node *PlatformChannelUser, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -354,6 +369,7 @@ func (_u *PlatformChannelUserUpdateOne) sqlSave(ctx context.Context) (_node *Pla
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformchanneluser.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PlatformChannelUser{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
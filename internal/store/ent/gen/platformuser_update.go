@@ -18,8 +18,9 @@ import (
 // PlatformUserUpdate is the builder for updating PlatformUser entities.
 type PlatformUserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PlatformUserMutation
+	hooks     []Hook
+	mutation  *PlatformUserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PlatformUserUpdate builder.
@@ -212,6 +213,12 @@ func (_u *PlatformUserUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformUserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformUserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -254,6 +261,7 @@ func (_u *PlatformUserUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformuser.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{platformuser.Label}
@@ -269,9 +277,10 @@ func (_u *PlatformUserUpdate) sqlSave(ctx context.Context) (_node int, err error
 // PlatformUserUpdateOne is the builder for updating a single PlatformUser entity.
 type PlatformUserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PlatformUserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PlatformUserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPlatformID sets the "platform_id" field.
@@ -471,6 +480,12 @@ func (_u *PlatformUserUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PlatformUserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlatformUserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PlatformUserUpdateOne) sqlSave(ctx context.Context) (_node *PlatformUser, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -530,6 +545,7 @@ func (_u *PlatformUserUpdateOne) sqlSave(ctx context.Context) (_node *PlatformUs
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(platformuser.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PlatformUser{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // PollingStateUpdate is the builder for updating PollingState entities.
 type PollingStateUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PollingStateMutation
+	hooks     []Hook
+	mutation  *PollingStateMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PollingStateUpdate builder.
@@ -119,6 +120,12 @@ func (_u *PollingStateUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PollingStateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PollingStateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PollingStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -143,6 +150,7 @@ func (_u *PollingStateUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(pollingstate.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pollingstate.Label}
@@ -158,9 +166,10 @@ func (_u *PollingStateUpdate) sqlSave(ctx context.Context) (_node int, err error
 // PollingStateUpdateOne is the builder for updating a single PollingState entity.
 type PollingStateUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PollingStateMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PollingStateMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetResourceName sets the "resource_name" field.
@@ -267,6 +276,12 @@ func (_u *PollingStateUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PollingStateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PollingStateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *PollingStateUpdateOne) sqlSave(ctx context.Context) (_node *PollingState, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -308,6 +323,7 @@ func (_u *PollingStateUpdateOne) sqlSave(ctx context.Context) (_node *PollingSta
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(pollingstate.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &PollingState{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,6 +9,9 @@ import (
 // Agent is the predicate function for agent builders.
 type Agent func(*sql.Selector)
 
+// AgentEmbedding is the predicate function for agentembedding builders.
+type AgentEmbedding func(*sql.Selector)
+
 // AgentKnowledge is the predicate function for agentknowledge builders.
 type AgentKnowledge func(*sql.Selector)
 
@@ -72,6 +75,9 @@ type ChatSessionEntry func(*sql.Selector)
 // Clip is the predicate function for clip builders.
 type Clip func(*sql.Selector)
 
+// ClipVersion is the predicate function for clipversion builders.
+type ClipVersion func(*sql.Selector)
+
 // ConfigData is the predicate function for configdata builders.
 type ConfigData func(*sql.Selector)
 
@@ -114,6 +120,9 @@ type FunctionRun func(*sql.Selector)
 // GatewayJob is the predicate function for gatewayjob builders.
 type GatewayJob func(*sql.Selector)
 
+// GatewayJobLog is the predicate function for gatewayjoblog builders.
+type GatewayJobLog func(*sql.Selector)
+
 // GatewayWorker is the predicate function for gatewayworker builders.
 type GatewayWorker func(*sql.Selector)
 
@@ -252,6 +261,9 @@ type PollingState func(*sql.Selector)
 // ResourceLink is the predicate function for resourcelink builders.
 type ResourceLink func(*sql.Selector)
 
+// SearchDocument is the predicate function for searchdocument builders.
+type SearchDocument func(*sql.Selector)
+
 // Topic is the predicate function for topic builders.
 type Topic func(*sql.Selector)
 
@@ -264,6 +276,9 @@ type User func(*sql.Selector)
 // WebAccount is the predicate function for webaccount builders.
 type WebAccount func(*sql.Selector)
 
+// WebAccountIdentity is the predicate function for webaccountidentity builders.
+type WebAccountIdentity func(*sql.Selector)
+
 // Workflow is the predicate function for workflow builders.
 type Workflow func(*sql.Selector)
 
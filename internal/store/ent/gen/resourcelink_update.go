@@ -17,8 +17,9 @@ import (
 // ResourceLinkUpdate is the builder for updating ResourceLink entities.
 type ResourceLinkUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ResourceLinkMutation
+	hooks     []Hook
+	mutation  *ResourceLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ResourceLinkUpdate builder.
@@ -227,6 +228,12 @@ func (_u *ResourceLinkUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ResourceLinkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceLinkUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ResourceLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -275,6 +282,7 @@ func (_u *ResourceLinkUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.PipelineName(); ok {
 		_spec.SetField(resourcelink.FieldPipelineName, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{resourcelink.Label}
@@ -290,9 +298,10 @@ func (_u *ResourceLinkUpdate) sqlSave(ctx context.Context) (_node int, err error
 // ResourceLinkUpdateOne is the builder for updating a single ResourceLink entity.
 type ResourceLinkUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ResourceLinkMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ResourceLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSourceEventID sets the "source_event_id" field.
@@ -508,6 +517,12 @@ func (_u *ResourceLinkUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ResourceLinkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceLinkUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ResourceLinkUpdateOne) sqlSave(ctx context.Context) (_node *ResourceLink, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -573,6 +588,7 @@ func (_u *ResourceLinkUpdateOne) sqlSave(ctx context.Context) (_node *ResourceLi
 	if value, ok := _u.mutation.PipelineName(); ok {
 		_spec.SetField(resourcelink.FieldPipelineName, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ResourceLink{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
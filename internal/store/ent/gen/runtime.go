@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agent"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentembedding"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentknowledge"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentmemoryfact"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/agentplan"
@@ -27,6 +28,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/chatsession"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/chatsessionentry"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/clip"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/clipversion"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/configdata"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/connection"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/counter"
@@ -41,6 +43,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/functiondefinitionversion"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/functionrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjob"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjoblog"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayworker"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/instruct"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/lifeachievement"
@@ -87,10 +90,12 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/platformuser"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/pollingstate"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/resourcelink"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/topic"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/url"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/user"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccount"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccountidentity"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflow"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowsteprun"
@@ -136,6 +141,30 @@ func init() {
 	agent.DefaultUpdatedAt = agentDescUpdatedAt.Default.(func() time.Time)
 	// agent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	agent.UpdateDefaultUpdatedAt = agentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	agentembeddingFields := schema.AgentEmbedding{}.Fields()
+	_ = agentembeddingFields
+	// agentembeddingDescKind is the schema descriptor for kind field.
+	agentembeddingDescKind := agentembeddingFields[1].Descriptor()
+	// agentembedding.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	agentembedding.KindValidator = agentembeddingDescKind.Validators[0].(func(string) error)
+	// agentembeddingDescScope is the schema descriptor for scope field.
+	agentembeddingDescScope := agentembeddingFields[3].Descriptor()
+	// agentembedding.DefaultScope holds the default value on creation for the scope field.
+	agentembedding.DefaultScope = agentembeddingDescScope.Default.(string)
+	// agentembeddingDescModel is the schema descriptor for model field.
+	agentembeddingDescModel := agentembeddingFields[4].Descriptor()
+	// agentembedding.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	agentembedding.ModelValidator = agentembeddingDescModel.Validators[0].(func(string) error)
+	// agentembeddingDescContentHash is the schema descriptor for content_hash field.
+	agentembeddingDescContentHash := agentembeddingFields[5].Descriptor()
+	// agentembedding.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	agentembedding.ContentHashValidator = agentembeddingDescContentHash.Validators[0].(func(string) error)
+	// agentembeddingDescUpdatedAt is the schema descriptor for updated_at field.
+	agentembeddingDescUpdatedAt := agentembeddingFields[7].Descriptor()
+	// agentembedding.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	agentembedding.DefaultUpdatedAt = agentembeddingDescUpdatedAt.Default.(func() time.Time)
+	// agentembedding.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	agentembedding.UpdateDefaultUpdatedAt = agentembeddingDescUpdatedAt.UpdateDefault.(func() time.Time)
 	agentknowledgeFields := schema.AgentKnowledge{}.Fields()
 	_ = agentknowledgeFields
 	// agentknowledgeDescPath is the schema descriptor for path field.
@@ -806,18 +835,56 @@ func init() {
 	clipDescDescription := clipFields[3].Descriptor()
 	// clip.DefaultDescription holds the default value on creation for the description field.
 	clip.DefaultDescription = clipDescDescription.Default.(string)
-	// clipDescContent is the schema descriptor for content field.
-	clipDescContent := clipFields[4].Descriptor()
-	// clip.ContentValidator is a validator for the "content" field. It is called by the builders before save.
-	clip.ContentValidator = clipDescContent.Validators[0].(func(string) error)
+	// clipDescVersion is the schema descriptor for version field.
+	clipDescVersion := clipFields[6].Descriptor()
+	// clip.DefaultVersion holds the default value on creation for the version field.
+	clip.DefaultVersion = clipDescVersion.Default.(int)
+	// clipDescBurnAfterRead is the schema descriptor for burn_after_read field.
+	clipDescBurnAfterRead := clipFields[8].Descriptor()
+	// clip.DefaultBurnAfterRead holds the default value on creation for the burn_after_read field.
+	clip.DefaultBurnAfterRead = clipDescBurnAfterRead.Default.(bool)
+	// clipDescMaxViews is the schema descriptor for max_views field.
+	clipDescMaxViews := clipFields[9].Descriptor()
+	// clip.DefaultMaxViews holds the default value on creation for the max_views field.
+	clip.DefaultMaxViews = clipDescMaxViews.Default.(int)
+	// clipDescViewCount is the schema descriptor for view_count field.
+	clipDescViewCount := clipFields[10].Descriptor()
+	// clip.DefaultViewCount holds the default value on creation for the view_count field.
+	clip.DefaultViewCount = clipDescViewCount.Default.(int)
+	// clipDescPasswordHash is the schema descriptor for password_hash field.
+	clipDescPasswordHash := clipFields[11].Descriptor()
+	// clip.DefaultPasswordHash holds the default value on creation for the password_hash field.
+	clip.DefaultPasswordHash = clipDescPasswordHash.Default.(string)
+	// clipDescLinkSecret is the schema descriptor for link_secret field.
+	clipDescLinkSecret := clipFields[12].Descriptor()
+	// clip.DefaultLinkSecret holds the default value on creation for the link_secret field.
+	clip.DefaultLinkSecret = clipDescLinkSecret.Default.(string)
 	// clipDescCreatedBy is the schema descriptor for created_by field.
-	clipDescCreatedBy := clipFields[5].Descriptor()
+	clipDescCreatedBy := clipFields[14].Descriptor()
 	// clip.DefaultCreatedBy holds the default value on creation for the created_by field.
 	clip.DefaultCreatedBy = clipDescCreatedBy.Default.(string)
 	// clipDescCreatedAt is the schema descriptor for created_at field.
-	clipDescCreatedAt := clipFields[6].Descriptor()
+	clipDescCreatedAt := clipFields[15].Descriptor()
 	// clip.DefaultCreatedAt holds the default value on creation for the created_at field.
 	clip.DefaultCreatedAt = clipDescCreatedAt.Default.(func() time.Time)
+	clipversionFields := schema.ClipVersion{}.Fields()
+	_ = clipversionFields
+	// clipversionDescTitle is the schema descriptor for title field.
+	clipversionDescTitle := clipversionFields[3].Descriptor()
+	// clipversion.DefaultTitle holds the default value on creation for the title field.
+	clipversion.DefaultTitle = clipversionDescTitle.Default.(string)
+	// clipversionDescDescription is the schema descriptor for description field.
+	clipversionDescDescription := clipversionFields[4].Descriptor()
+	// clipversion.DefaultDescription holds the default value on creation for the description field.
+	clipversion.DefaultDescription = clipversionDescDescription.Default.(string)
+	// clipversionDescCreatedBy is the schema descriptor for created_by field.
+	clipversionDescCreatedBy := clipversionFields[7].Descriptor()
+	// clipversion.DefaultCreatedBy holds the default value on creation for the created_by field.
+	clipversion.DefaultCreatedBy = clipversionDescCreatedBy.Default.(string)
+	// clipversionDescCreatedAt is the schema descriptor for created_at field.
+	clipversionDescCreatedAt := clipversionFields[8].Descriptor()
+	// clipversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clipversion.DefaultCreatedAt = clipversionDescCreatedAt.Default.(func() time.Time)
 	configdataFields := schema.ConfigData{}.Fields()
 	_ = configdataFields
 	// configdataDescUID is the schema descriptor for uid field.
@@ -1214,20 +1281,38 @@ func init() {
 	gatewayjobDescDurationMs := gatewayjobFields[11].Descriptor()
 	// gatewayjob.DefaultDurationMs holds the default value on creation for the duration_ms field.
 	gatewayjob.DefaultDurationMs = gatewayjobDescDurationMs.Default.(int64)
+	// gatewayjobDescLogSeq is the schema descriptor for log_seq field.
+	gatewayjobDescLogSeq := gatewayjobFields[12].Descriptor()
+	// gatewayjob.DefaultLogSeq holds the default value on creation for the log_seq field.
+	gatewayjob.DefaultLogSeq = gatewayjobDescLogSeq.Default.(int64)
+	// gatewayjobDescLogBytes is the schema descriptor for log_bytes field.
+	gatewayjobDescLogBytes := gatewayjobFields[13].Descriptor()
+	// gatewayjob.DefaultLogBytes holds the default value on creation for the log_bytes field.
+	gatewayjob.DefaultLogBytes = gatewayjobDescLogBytes.Default.(int64)
 	// gatewayjobDescWorkerID is the schema descriptor for worker_id field.
-	gatewayjobDescWorkerID := gatewayjobFields[12].Descriptor()
+	gatewayjobDescWorkerID := gatewayjobFields[14].Descriptor()
 	// gatewayjob.DefaultWorkerID holds the default value on creation for the worker_id field.
 	gatewayjob.DefaultWorkerID = gatewayjobDescWorkerID.Default.(string)
 	// gatewayjobDescCreatedAt is the schema descriptor for created_at field.
-	gatewayjobDescCreatedAt := gatewayjobFields[16].Descriptor()
+	gatewayjobDescCreatedAt := gatewayjobFields[18].Descriptor()
 	// gatewayjob.DefaultCreatedAt holds the default value on creation for the created_at field.
 	gatewayjob.DefaultCreatedAt = gatewayjobDescCreatedAt.Default.(func() time.Time)
 	// gatewayjobDescUpdatedAt is the schema descriptor for updated_at field.
-	gatewayjobDescUpdatedAt := gatewayjobFields[17].Descriptor()
+	gatewayjobDescUpdatedAt := gatewayjobFields[19].Descriptor()
 	// gatewayjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	gatewayjob.DefaultUpdatedAt = gatewayjobDescUpdatedAt.Default.(func() time.Time)
 	// gatewayjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	gatewayjob.UpdateDefaultUpdatedAt = gatewayjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	gatewayjoblogFields := schema.GatewayJobLog{}.Fields()
+	_ = gatewayjoblogFields
+	// gatewayjoblogDescJobID is the schema descriptor for job_id field.
+	gatewayjoblogDescJobID := gatewayjoblogFields[1].Descriptor()
+	// gatewayjoblog.JobIDValidator is a validator for the "job_id" field. It is called by the builders before save.
+	gatewayjoblog.JobIDValidator = gatewayjoblogDescJobID.Validators[0].(func(string) error)
+	// gatewayjoblogDescCreatedAt is the schema descriptor for created_at field.
+	gatewayjoblogDescCreatedAt := gatewayjoblogFields[4].Descriptor()
+	// gatewayjoblog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gatewayjoblog.DefaultCreatedAt = gatewayjoblogDescCreatedAt.Default.(func() time.Time)
 	gatewayworkerFields := schema.GatewayWorker{}.Fields()
 	_ = gatewayworkerFields
 	// gatewayworkerDescWorkerID is the schema descriptor for worker_id field.
@@ -1302,32 +1387,52 @@ func init() {
 	llmusagerecordDescModel := llmusagerecordFields[3].Descriptor()
 	// llmusagerecord.DefaultModel holds the default value on creation for the model field.
 	llmusagerecord.DefaultModel = llmusagerecordDescModel.Default.(string)
+	// llmusagerecordDescProvider is the schema descriptor for provider field.
+	llmusagerecordDescProvider := llmusagerecordFields[4].Descriptor()
+	// llmusagerecord.DefaultProvider holds the default value on creation for the provider field.
+	llmusagerecord.DefaultProvider = llmusagerecordDescProvider.Default.(string)
 	// llmusagerecordDescPromptTokens is the schema descriptor for prompt_tokens field.
-	llmusagerecordDescPromptTokens := llmusagerecordFields[4].Descriptor()
+	llmusagerecordDescPromptTokens := llmusagerecordFields[5].Descriptor()
 	// llmusagerecord.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
 	llmusagerecord.DefaultPromptTokens = llmusagerecordDescPromptTokens.Default.(int)
 	// llmusagerecordDescCompletionTokens is the schema descriptor for completion_tokens field.
-	llmusagerecordDescCompletionTokens := llmusagerecordFields[5].Descriptor()
+	llmusagerecordDescCompletionTokens := llmusagerecordFields[6].Descriptor()
 	// llmusagerecord.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
 	llmusagerecord.DefaultCompletionTokens = llmusagerecordDescCompletionTokens.Default.(int)
 	// llmusagerecordDescTotalTokens is the schema descriptor for total_tokens field.
-	llmusagerecordDescTotalTokens := llmusagerecordFields[6].Descriptor()
+	llmusagerecordDescTotalTokens := llmusagerecordFields[7].Descriptor()
 	// llmusagerecord.DefaultTotalTokens holds the default value on creation for the total_tokens field.
 	llmusagerecord.DefaultTotalTokens = llmusagerecordDescTotalTokens.Default.(int)
 	// llmusagerecordDescCacheRead is the schema descriptor for cache_read field.
-	llmusagerecordDescCacheRead := llmusagerecordFields[7].Descriptor()
+	llmusagerecordDescCacheRead := llmusagerecordFields[8].Descriptor()
 	// llmusagerecord.DefaultCacheRead holds the default value on creation for the cache_read field.
 	llmusagerecord.DefaultCacheRead = llmusagerecordDescCacheRead.Default.(int)
 	// llmusagerecordDescCacheWrite is the schema descriptor for cache_write field.
-	llmusagerecordDescCacheWrite := llmusagerecordFields[8].Descriptor()
+	llmusagerecordDescCacheWrite := llmusagerecordFields[9].Descriptor()
 	// llmusagerecord.DefaultCacheWrite holds the default value on creation for the cache_write field.
 	llmusagerecord.DefaultCacheWrite = llmusagerecordDescCacheWrite.Default.(int)
 	// llmusagerecordDescSource is the schema descriptor for source field.
-	llmusagerecordDescSource := llmusagerecordFields[9].Descriptor()
+	llmusagerecordDescSource := llmusagerecordFields[10].Descriptor()
 	// llmusagerecord.DefaultSource holds the default value on creation for the source field.
 	llmusagerecord.DefaultSource = llmusagerecordDescSource.Default.(string)
+	// llmusagerecordDescAgent is the schema descriptor for agent field.
+	llmusagerecordDescAgent := llmusagerecordFields[11].Descriptor()
+	// llmusagerecord.DefaultAgent holds the default value on creation for the agent field.
+	llmusagerecord.DefaultAgent = llmusagerecordDescAgent.Default.(string)
+	// llmusagerecordDescTaskID is the schema descriptor for task_id field.
+	llmusagerecordDescTaskID := llmusagerecordFields[12].Descriptor()
+	// llmusagerecord.DefaultTaskID holds the default value on creation for the task_id field.
+	llmusagerecord.DefaultTaskID = llmusagerecordDescTaskID.Default.(string)
+	// llmusagerecordDescPipelineStep is the schema descriptor for pipeline_step field.
+	llmusagerecordDescPipelineStep := llmusagerecordFields[13].Descriptor()
+	// llmusagerecord.DefaultPipelineStep holds the default value on creation for the pipeline_step field.
+	llmusagerecord.DefaultPipelineStep = llmusagerecordDescPipelineStep.Default.(string)
+	// llmusagerecordDescCost is the schema descriptor for cost field.
+	llmusagerecordDescCost := llmusagerecordFields[14].Descriptor()
+	// llmusagerecord.DefaultCost holds the default value on creation for the cost field.
+	llmusagerecord.DefaultCost = llmusagerecordDescCost.Default.(float64)
 	// llmusagerecordDescCreatedAt is the schema descriptor for created_at field.
-	llmusagerecordDescCreatedAt := llmusagerecordFields[10].Descriptor()
+	llmusagerecordDescCreatedAt := llmusagerecordFields[15].Descriptor()
 	// llmusagerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
 	llmusagerecord.DefaultCreatedAt = llmusagerecordDescCreatedAt.Default.(func() time.Time)
 	lifeaicontextFields := schema.LifeAIContext{}.Fields()
@@ -2103,19 +2208,19 @@ func init() {
 	// notifyrule.ChannelPatternValidator is a validator for the "channel_pattern" field. It is called by the builders before save.
 	notifyrule.ChannelPatternValidator = notifyruleDescChannelPattern.Validators[0].(func(string) error)
 	// notifyruleDescPriority is the schema descriptor for priority field.
-	notifyruleDescPriority := notifyruleFields[7].Descriptor()
+	notifyruleDescPriority := notifyruleFields[8].Descriptor()
 	// notifyrule.DefaultPriority holds the default value on creation for the priority field.
 	notifyrule.DefaultPriority = notifyruleDescPriority.Default.(int)
 	// notifyruleDescEnabled is the schema descriptor for enabled field.
-	notifyruleDescEnabled := notifyruleFields[9].Descriptor()
+	notifyruleDescEnabled := notifyruleFields[10].Descriptor()
 	// notifyrule.DefaultEnabled holds the default value on creation for the enabled field.
 	notifyrule.DefaultEnabled = notifyruleDescEnabled.Default.(bool)
 	// notifyruleDescCreatedAt is the schema descriptor for created_at field.
-	notifyruleDescCreatedAt := notifyruleFields[10].Descriptor()
+	notifyruleDescCreatedAt := notifyruleFields[11].Descriptor()
 	// notifyrule.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notifyrule.DefaultCreatedAt = notifyruleDescCreatedAt.Default.(func() time.Time)
 	// notifyruleDescUpdatedAt is the schema descriptor for updated_at field.
-	notifyruleDescUpdatedAt := notifyruleFields[11].Descriptor()
+	notifyruleDescUpdatedAt := notifyruleFields[12].Descriptor()
 	// notifyrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notifyrule.DefaultUpdatedAt = notifyruleDescUpdatedAt.Default.(func() time.Time)
 	// notifyrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -2350,6 +2455,14 @@ func init() {
 	pipelinerunDescCreatedAt := pipelinerunFields[11].Descriptor()
 	// pipelinerun.DefaultCreatedAt holds the default value on creation for the created_at field.
 	pipelinerun.DefaultCreatedAt = pipelinerunDescCreatedAt.Default.(func() time.Time)
+	// pipelinerunDescParentKind is the schema descriptor for parent_kind field.
+	pipelinerunDescParentKind := pipelinerunFields[12].Descriptor()
+	// pipelinerun.DefaultParentKind holds the default value on creation for the parent_kind field.
+	pipelinerun.DefaultParentKind = pipelinerunDescParentKind.Default.(string)
+	// pipelinerunDescCallDepth is the schema descriptor for call_depth field.
+	pipelinerunDescCallDepth := pipelinerunFields[14].Descriptor()
+	// pipelinerun.DefaultCallDepth holds the default value on creation for the call_depth field.
+	pipelinerun.DefaultCallDepth = pipelinerunDescCallDepth.Default.(int)
 	pipelinesteprunFields := schema.PipelineStepRun{}.Fields()
 	_ = pipelinesteprunFields
 	// pipelinesteprunDescStepName is the schema descriptor for step_name field.
@@ -2546,6 +2659,46 @@ func init() {
 	resourcelinkDescCreatedAt := resourcelinkFields[11].Descriptor()
 	// resourcelink.DefaultCreatedAt holds the default value on creation for the created_at field.
 	resourcelink.DefaultCreatedAt = resourcelinkDescCreatedAt.Default.(func() time.Time)
+	searchdocumentFields := schema.SearchDocument{}.Fields()
+	_ = searchdocumentFields
+	// searchdocumentDescSource is the schema descriptor for source field.
+	searchdocumentDescSource := searchdocumentFields[1].Descriptor()
+	// searchdocument.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	searchdocument.SourceValidator = searchdocumentDescSource.Validators[0].(func(string) error)
+	// searchdocumentDescDocID is the schema descriptor for doc_id field.
+	searchdocumentDescDocID := searchdocumentFields[2].Descriptor()
+	// searchdocument.DocIDValidator is a validator for the "doc_id" field. It is called by the builders before save.
+	searchdocument.DocIDValidator = searchdocumentDescDocID.Validators[0].(func(string) error)
+	// searchdocumentDescUID is the schema descriptor for uid field.
+	searchdocumentDescUID := searchdocumentFields[3].Descriptor()
+	// searchdocument.DefaultUID holds the default value on creation for the uid field.
+	searchdocument.DefaultUID = searchdocumentDescUID.Default.(string)
+	// searchdocumentDescTitle is the schema descriptor for title field.
+	searchdocumentDescTitle := searchdocumentFields[4].Descriptor()
+	// searchdocument.DefaultTitle holds the default value on creation for the title field.
+	searchdocument.DefaultTitle = searchdocumentDescTitle.Default.(string)
+	// searchdocumentDescContent is the schema descriptor for content field.
+	searchdocumentDescContent := searchdocumentFields[5].Descriptor()
+	// searchdocument.DefaultContent holds the default value on creation for the content field.
+	searchdocument.DefaultContent = searchdocumentDescContent.Default.(string)
+	// searchdocumentDescURL is the schema descriptor for url field.
+	searchdocumentDescURL := searchdocumentFields[6].Descriptor()
+	// searchdocument.DefaultURL holds the default value on creation for the url field.
+	searchdocument.DefaultURL = searchdocumentDescURL.Default.(string)
+	// searchdocumentDescTags is the schema descriptor for tags field.
+	searchdocumentDescTags := searchdocumentFields[7].Descriptor()
+	// searchdocument.DefaultTags holds the default value on creation for the tags field.
+	searchdocument.DefaultTags = searchdocumentDescTags.Default.([]string)
+	// searchdocumentDescUpdatedAt is the schema descriptor for updated_at field.
+	searchdocumentDescUpdatedAt := searchdocumentFields[8].Descriptor()
+	// searchdocument.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	searchdocument.DefaultUpdatedAt = searchdocumentDescUpdatedAt.Default.(func() time.Time)
+	// searchdocumentDescIndexedAt is the schema descriptor for indexed_at field.
+	searchdocumentDescIndexedAt := searchdocumentFields[9].Descriptor()
+	// searchdocument.DefaultIndexedAt holds the default value on creation for the indexed_at field.
+	searchdocument.DefaultIndexedAt = searchdocumentDescIndexedAt.Default.(func() time.Time)
+	// searchdocument.UpdateDefaultIndexedAt holds the default value on update for the indexed_at field.
+	searchdocument.UpdateDefaultIndexedAt = searchdocumentDescIndexedAt.UpdateDefault.(func() time.Time)
 	topicFields := schema.Topic{}.Fields()
 	_ = topicFields
 	// topicDescFlag is the schema descriptor for flag field.
@@ -2674,6 +2827,36 @@ func init() {
 	webaccount.DefaultUpdatedAt = webaccountDescUpdatedAt.Default.(func() time.Time)
 	// webaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	webaccount.UpdateDefaultUpdatedAt = webaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webaccountidentityFields := schema.WebAccountIdentity{}.Fields()
+	_ = webaccountidentityFields
+	// webaccountidentityDescUID is the schema descriptor for uid field.
+	webaccountidentityDescUID := webaccountidentityFields[1].Descriptor()
+	// webaccountidentity.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	webaccountidentity.UIDValidator = webaccountidentityDescUID.Validators[0].(func(string) error)
+	// webaccountidentityDescProvider is the schema descriptor for provider field.
+	webaccountidentityDescProvider := webaccountidentityFields[2].Descriptor()
+	// webaccountidentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	webaccountidentity.ProviderValidator = webaccountidentityDescProvider.Validators[0].(func(string) error)
+	// webaccountidentityDescIssuer is the schema descriptor for issuer field.
+	webaccountidentityDescIssuer := webaccountidentityFields[3].Descriptor()
+	// webaccountidentity.DefaultIssuer holds the default value on creation for the issuer field.
+	webaccountidentity.DefaultIssuer = webaccountidentityDescIssuer.Default.(string)
+	// webaccountidentityDescSubject is the schema descriptor for subject field.
+	webaccountidentityDescSubject := webaccountidentityFields[4].Descriptor()
+	// webaccountidentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	webaccountidentity.SubjectValidator = webaccountidentityDescSubject.Validators[0].(func(string) error)
+	// webaccountidentityDescEmail is the schema descriptor for email field.
+	webaccountidentityDescEmail := webaccountidentityFields[5].Descriptor()
+	// webaccountidentity.DefaultEmail holds the default value on creation for the email field.
+	webaccountidentity.DefaultEmail = webaccountidentityDescEmail.Default.(string)
+	// webaccountidentityDescCreatedAt is the schema descriptor for created_at field.
+	webaccountidentityDescCreatedAt := webaccountidentityFields[6].Descriptor()
+	// webaccountidentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webaccountidentity.DefaultCreatedAt = webaccountidentityDescCreatedAt.Default.(func() time.Time)
+	// webaccountidentityDescLastLoginAt is the schema descriptor for last_login_at field.
+	webaccountidentityDescLastLoginAt := webaccountidentityFields[7].Descriptor()
+	// webaccountidentity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	webaccountidentity.DefaultLastLoginAt = webaccountidentityDescLastLoginAt.Default.(func() time.Time)
 	workflowFields := schema.Workflow{}.Fields()
 	_ = workflowFields
 	// workflowDescName is the schema descriptor for name field.
@@ -2746,6 +2929,14 @@ func init() {
 	workflowrunDescCreatedAt := workflowrunFields[13].Descriptor()
 	// workflowrun.DefaultCreatedAt holds the default value on creation for the created_at field.
 	workflowrun.DefaultCreatedAt = workflowrunDescCreatedAt.Default.(func() time.Time)
+	// workflowrunDescParentKind is the schema descriptor for parent_kind field.
+	workflowrunDescParentKind := workflowrunFields[14].Descriptor()
+	// workflowrun.DefaultParentKind holds the default value on creation for the parent_kind field.
+	workflowrun.DefaultParentKind = workflowrunDescParentKind.Default.(string)
+	// workflowrunDescCallDepth is the schema descriptor for call_depth field.
+	workflowrunDescCallDepth := workflowrunFields[16].Descriptor()
+	// workflowrun.DefaultCallDepth holds the default value on creation for the call_depth field.
+	workflowrun.DefaultCallDepth = workflowrunDescCallDepth.Default.(int)
 	workflowsteprunFields := schema.WorkflowStepRun{}.Fields()
 	_ = workflowsteprunFields
 	// workflowsteprunDescStepID is the schema descriptor for step_id field.
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
+)
+
+// SearchDocumentDelete is the builder for deleting a SearchDocument entity.
+type SearchDocumentDelete struct {
+	config
+	hooks    []Hook
+	mutation *SearchDocumentMutation
+}
+
+// Where appends a list predicates to the SearchDocumentDelete builder.
+func (_d *SearchDocumentDelete) Where(ps ...predicate.SearchDocument) *SearchDocumentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SearchDocumentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SearchDocumentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SearchDocumentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(searchdocument.Table, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SearchDocumentDeleteOne is the builder for deleting a single SearchDocument entity.
+type SearchDocumentDeleteOne struct {
+	_d *SearchDocumentDelete
+}
+
+// Where appends a list predicates to the SearchDocumentDelete builder.
+func (_d *SearchDocumentDeleteOne) Where(ps ...predicate.SearchDocument) *SearchDocumentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SearchDocumentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{searchdocument.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SearchDocumentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
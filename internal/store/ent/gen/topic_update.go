@@ -18,8 +18,9 @@ import (
 // TopicUpdate is the builder for updating Topic entities.
 type TopicUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TopicMutation
+	hooks     []Hook
+	mutation  *TopicMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TopicUpdate builder.
@@ -238,6 +239,12 @@ func (_u *TopicUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TopicUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TopicUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TopicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -289,6 +296,7 @@ func (_u *TopicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(topic.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{topic.Label}
@@ -304,9 +312,10 @@ func (_u *TopicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // TopicUpdateOne is the builder for updating a single Topic entity.
 type TopicUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TopicMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TopicMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -532,6 +541,12 @@ func (_u *TopicUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TopicUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TopicUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TopicUpdateOne) sqlSave(ctx context.Context) (_node *Topic, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -600,6 +615,7 @@ func (_u *TopicUpdateOne) sqlSave(ctx context.Context) (_node *Topic, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(topic.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Topic{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
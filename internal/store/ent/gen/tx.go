@@ -4,6 +4,8 @@ package gen
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,6 +16,8 @@ type Tx struct {
 	config
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AgentEmbedding is the client for interacting with the AgentEmbedding builders.
+	AgentEmbedding *AgentEmbeddingClient
 	// AgentKnowledge is the client for interacting with the AgentKnowledge builders.
 	AgentKnowledge *AgentKnowledgeClient
 	// AgentMemoryFact is the client for interacting with the AgentMemoryFact builders.
@@ -56,6 +60,8 @@ type Tx struct {
 	ChatSessionEntry *ChatSessionEntryClient
 	// Clip is the client for interacting with the Clip builders.
 	Clip *ClipClient
+	// ClipVersion is the client for interacting with the ClipVersion builders.
+	ClipVersion *ClipVersionClient
 	// ConfigData is the client for interacting with the ConfigData builders.
 	ConfigData *ConfigDataClient
 	// Connection is the client for interacting with the Connection builders.
@@ -84,6 +90,8 @@ type Tx struct {
 	FunctionRun *FunctionRunClient
 	// GatewayJob is the client for interacting with the GatewayJob builders.
 	GatewayJob *GatewayJobClient
+	// GatewayJobLog is the client for interacting with the GatewayJobLog builders.
+	GatewayJobLog *GatewayJobLogClient
 	// GatewayWorker is the client for interacting with the GatewayWorker builders.
 	GatewayWorker *GatewayWorkerClient
 	// Instruct is the client for interacting with the Instruct builders.
@@ -176,6 +184,8 @@ type Tx struct {
 	PollingState *PollingStateClient
 	// ResourceLink is the client for interacting with the ResourceLink builders.
 	ResourceLink *ResourceLinkClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// Topic is the client for interacting with the Topic builders.
 	Topic *TopicClient
 	// Url is the client for interacting with the Url builders.
@@ -184,6 +194,8 @@ type Tx struct {
 	User *UserClient
 	// WebAccount is the client for interacting with the WebAccount builders.
 	WebAccount *WebAccountClient
+	// WebAccountIdentity is the client for interacting with the WebAccountIdentity builders.
+	WebAccountIdentity *WebAccountIdentityClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowRun is the client for interacting with the WorkflowRun builders.
@@ -326,6 +338,7 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Agent = NewAgentClient(tx.config)
+	tx.AgentEmbedding = NewAgentEmbeddingClient(tx.config)
 	tx.AgentKnowledge = NewAgentKnowledgeClient(tx.config)
 	tx.AgentMemoryFact = NewAgentMemoryFactClient(tx.config)
 	tx.AgentPlan = NewAgentPlanClient(tx.config)
@@ -347,6 +360,7 @@ func (tx *Tx) init() {
 	tx.ChatSession = NewChatSessionClient(tx.config)
 	tx.ChatSessionEntry = NewChatSessionEntryClient(tx.config)
 	tx.Clip = NewClipClient(tx.config)
+	tx.ClipVersion = NewClipVersionClient(tx.config)
 	tx.ConfigData = NewConfigDataClient(tx.config)
 	tx.Connection = NewConnectionClient(tx.config)
 	tx.Counter = NewCounterClient(tx.config)
@@ -361,6 +375,7 @@ func (tx *Tx) init() {
 	tx.FunctionDefinitionVersion = NewFunctionDefinitionVersionClient(tx.config)
 	tx.FunctionRun = NewFunctionRunClient(tx.config)
 	tx.GatewayJob = NewGatewayJobClient(tx.config)
+	tx.GatewayJobLog = NewGatewayJobLogClient(tx.config)
 	tx.GatewayWorker = NewGatewayWorkerClient(tx.config)
 	tx.Instruct = NewInstructClient(tx.config)
 	tx.LLMUsageRecord = NewLLMUsageRecordClient(tx.config)
@@ -407,10 +422,12 @@ func (tx *Tx) init() {
 	tx.PlatformUser = NewPlatformUserClient(tx.config)
 	tx.PollingState = NewPollingStateClient(tx.config)
 	tx.ResourceLink = NewResourceLinkClient(tx.config)
+	tx.SearchDocument = NewSearchDocumentClient(tx.config)
 	tx.Topic = NewTopicClient(tx.config)
 	tx.Url = NewURLClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.WebAccount = NewWebAccountClient(tx.config)
+	tx.WebAccountIdentity = NewWebAccountIdentityClient(tx.config)
 	tx.Workflow = NewWorkflowClient(tx.config)
 	tx.WorkflowRun = NewWorkflowRunClient(tx.config)
 	tx.WorkflowStepRun = NewWorkflowStepRunClient(tx.config)
@@ -478,3 +495,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -18,8 +18,9 @@ import (
 // URLUpdate is the builder for updating Url entities.
 type URLUpdate struct {
 	config
-	hooks    []Hook
-	mutation *URLMutation
+	hooks     []Hook
+	mutation  *URLMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the URLUpdate builder.
@@ -160,6 +161,12 @@ func (_u *URLUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *URLUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *URLUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *URLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -193,6 +200,7 @@ func (_u *URLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(url.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{url.Label}
@@ -208,9 +216,10 @@ func (_u *URLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // URLUpdateOne is the builder for updating a single Url entity.
 type URLUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *URLMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *URLMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFlag sets the "flag" field.
@@ -358,6 +367,12 @@ func (_u *URLUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *URLUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *URLUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *URLUpdateOne) sqlSave(ctx context.Context) (_node *Url, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -408,6 +423,7 @@ func (_u *URLUpdateOne) sqlSave(ctx context.Context) (_node *Url, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(url.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Url{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -19,8 +19,9 @@ import (
 // WebAccountUpdate is the builder for updating WebAccount entities.
 type WebAccountUpdate struct {
 	config
-	hooks    []Hook
-	mutation *WebAccountMutation
+	hooks     []Hook
+	mutation  *WebAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the WebAccountUpdate builder.
@@ -209,6 +210,12 @@ func (_u *WebAccountUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WebAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebAccountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WebAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -262,6 +269,7 @@ func (_u *WebAccountUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(webaccount.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{webaccount.Label}
@@ -277,9 +285,10 @@ func (_u *WebAccountUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // WebAccountUpdateOne is the builder for updating a single WebAccount entity.
 type WebAccountUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *WebAccountMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *WebAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUsername sets the "username" field.
@@ -475,6 +484,12 @@ func (_u *WebAccountUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WebAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebAccountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WebAccountUpdateOne) sqlSave(ctx context.Context) (_node *WebAccount, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -545,6 +560,7 @@ func (_u *WebAccountUpdateOne) sqlSave(ctx context.Context) (_node *WebAccount,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(webaccount.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &WebAccount{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccountidentity"
+)
+
+// WebAccountIdentityDelete is the builder for deleting a WebAccountIdentity entity.
+type WebAccountIdentityDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebAccountIdentityMutation
+}
+
+// Where appends a list predicates to the WebAccountIdentityDelete builder.
+func (_d *WebAccountIdentityDelete) Where(ps ...predicate.WebAccountIdentity) *WebAccountIdentityDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *WebAccountIdentityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WebAccountIdentityDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *WebAccountIdentityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webaccountidentity.Table, sqlgraph.NewFieldSpec(webaccountidentity.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// WebAccountIdentityDeleteOne is the builder for deleting a single WebAccountIdentity entity.
+type WebAccountIdentityDeleteOne struct {
+	_d *WebAccountIdentityDelete
+}
+
+// Where appends a list predicates to the WebAccountIdentityDelete builder.
+func (_d *WebAccountIdentityDeleteOne) Where(ps ...predicate.WebAccountIdentity) *WebAccountIdentityDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *WebAccountIdentityDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webaccountidentity.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WebAccountIdentityDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
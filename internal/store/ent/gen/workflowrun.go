@@ -43,7 +43,13 @@ type WorkflowRun struct {
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ParentKind holds the value of the "parent_kind" field.
+	ParentKind string `json:"parent_kind,omitempty"`
+	// ParentRunID holds the value of the "parent_run_id" field.
+	ParentRunID *int64 `json:"parent_run_id,omitempty"`
+	// CallDepth holds the value of the "call_depth" field.
+	CallDepth    int `json:"call_depth,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -54,9 +60,9 @@ func (*WorkflowRun) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case workflowrun.FieldTriggerInfo, workflowrun.FieldInputParams, workflowrun.FieldCheckpointData:
 			values[i] = new([]byte)
-		case workflowrun.FieldID, workflowrun.FieldWorkflowID, workflowrun.FieldStatus:
+		case workflowrun.FieldID, workflowrun.FieldWorkflowID, workflowrun.FieldStatus, workflowrun.FieldParentRunID, workflowrun.FieldCallDepth:
 			values[i] = new(sql.NullInt64)
-		case workflowrun.FieldWorkflowName, workflowrun.FieldWorkflowFile, workflowrun.FieldTriggerType, workflowrun.FieldError:
+		case workflowrun.FieldWorkflowName, workflowrun.FieldWorkflowFile, workflowrun.FieldTriggerType, workflowrun.FieldError, workflowrun.FieldParentKind:
 			values[i] = new(sql.NullString)
 		case workflowrun.FieldLastHeartbeat, workflowrun.FieldStartedAt, workflowrun.FieldCompletedAt, workflowrun.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -168,6 +174,25 @@ func (_m *WorkflowRun) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case workflowrun.FieldParentKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_kind", values[i])
+			} else if value.Valid {
+				_m.ParentKind = value.String
+			}
+		case workflowrun.FieldParentRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_run_id", values[i])
+			} else if value.Valid {
+				_m.ParentRunID = new(int64)
+				*_m.ParentRunID = value.Int64
+			}
+		case workflowrun.FieldCallDepth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field call_depth", values[i])
+			} else if value.Valid {
+				_m.CallDepth = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -248,6 +273,17 @@ func (_m *WorkflowRun) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("parent_kind=")
+	builder.WriteString(_m.ParentKind)
+	builder.WriteString(", ")
+	if v := _m.ParentRunID; v != nil {
+		builder.WriteString("parent_run_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("call_depth=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CallDepth))
 	builder.WriteByte(')')
 	return builder.String()
 }
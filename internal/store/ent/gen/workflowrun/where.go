@@ -104,6 +104,21 @@ func CreatedAt(v time.Time) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ParentKind applies equality check predicate on the "parent_kind" field. It's identical to ParentKindEQ.
+func ParentKind(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldParentKind, v))
+}
+
+// ParentRunID applies equality check predicate on the "parent_run_id" field. It's identical to ParentRunIDEQ.
+func ParentRunID(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldParentRunID, v))
+}
+
+// CallDepth applies equality check predicate on the "call_depth" field. It's identical to CallDepthEQ.
+func CallDepth(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldCallDepth, v))
+}
+
 // WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
 func WorkflowIDEQ(v int64) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.FieldEQ(FieldWorkflowID, v))
@@ -674,6 +689,161 @@ func CreatedAtLTE(v time.Time) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ParentKindEQ applies the EQ predicate on the "parent_kind" field.
+func ParentKindEQ(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldParentKind, v))
+}
+
+// ParentKindNEQ applies the NEQ predicate on the "parent_kind" field.
+func ParentKindNEQ(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNEQ(FieldParentKind, v))
+}
+
+// ParentKindIn applies the In predicate on the "parent_kind" field.
+func ParentKindIn(vs ...string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldIn(FieldParentKind, vs...))
+}
+
+// ParentKindNotIn applies the NotIn predicate on the "parent_kind" field.
+func ParentKindNotIn(vs ...string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNotIn(FieldParentKind, vs...))
+}
+
+// ParentKindGT applies the GT predicate on the "parent_kind" field.
+func ParentKindGT(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGT(FieldParentKind, v))
+}
+
+// ParentKindGTE applies the GTE predicate on the "parent_kind" field.
+func ParentKindGTE(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGTE(FieldParentKind, v))
+}
+
+// ParentKindLT applies the LT predicate on the "parent_kind" field.
+func ParentKindLT(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLT(FieldParentKind, v))
+}
+
+// ParentKindLTE applies the LTE predicate on the "parent_kind" field.
+func ParentKindLTE(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLTE(FieldParentKind, v))
+}
+
+// ParentKindContains applies the Contains predicate on the "parent_kind" field.
+func ParentKindContains(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldContains(FieldParentKind, v))
+}
+
+// ParentKindHasPrefix applies the HasPrefix predicate on the "parent_kind" field.
+func ParentKindHasPrefix(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldHasPrefix(FieldParentKind, v))
+}
+
+// ParentKindHasSuffix applies the HasSuffix predicate on the "parent_kind" field.
+func ParentKindHasSuffix(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldHasSuffix(FieldParentKind, v))
+}
+
+// ParentKindEqualFold applies the EqualFold predicate on the "parent_kind" field.
+func ParentKindEqualFold(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEqualFold(FieldParentKind, v))
+}
+
+// ParentKindContainsFold applies the ContainsFold predicate on the "parent_kind" field.
+func ParentKindContainsFold(v string) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldContainsFold(FieldParentKind, v))
+}
+
+// ParentRunIDEQ applies the EQ predicate on the "parent_run_id" field.
+func ParentRunIDEQ(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldParentRunID, v))
+}
+
+// ParentRunIDNEQ applies the NEQ predicate on the "parent_run_id" field.
+func ParentRunIDNEQ(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNEQ(FieldParentRunID, v))
+}
+
+// ParentRunIDIn applies the In predicate on the "parent_run_id" field.
+func ParentRunIDIn(vs ...int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldIn(FieldParentRunID, vs...))
+}
+
+// ParentRunIDNotIn applies the NotIn predicate on the "parent_run_id" field.
+func ParentRunIDNotIn(vs ...int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNotIn(FieldParentRunID, vs...))
+}
+
+// ParentRunIDGT applies the GT predicate on the "parent_run_id" field.
+func ParentRunIDGT(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGT(FieldParentRunID, v))
+}
+
+// ParentRunIDGTE applies the GTE predicate on the "parent_run_id" field.
+func ParentRunIDGTE(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGTE(FieldParentRunID, v))
+}
+
+// ParentRunIDLT applies the LT predicate on the "parent_run_id" field.
+func ParentRunIDLT(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLT(FieldParentRunID, v))
+}
+
+// ParentRunIDLTE applies the LTE predicate on the "parent_run_id" field.
+func ParentRunIDLTE(v int64) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLTE(FieldParentRunID, v))
+}
+
+// ParentRunIDIsNil applies the IsNil predicate on the "parent_run_id" field.
+func ParentRunIDIsNil() predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldIsNull(FieldParentRunID))
+}
+
+// ParentRunIDNotNil applies the NotNil predicate on the "parent_run_id" field.
+func ParentRunIDNotNil() predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNotNull(FieldParentRunID))
+}
+
+// CallDepthEQ applies the EQ predicate on the "call_depth" field.
+func CallDepthEQ(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldCallDepth, v))
+}
+
+// CallDepthNEQ applies the NEQ predicate on the "call_depth" field.
+func CallDepthNEQ(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNEQ(FieldCallDepth, v))
+}
+
+// CallDepthIn applies the In predicate on the "call_depth" field.
+func CallDepthIn(vs ...int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldIn(FieldCallDepth, vs...))
+}
+
+// CallDepthNotIn applies the NotIn predicate on the "call_depth" field.
+func CallDepthNotIn(vs ...int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNotIn(FieldCallDepth, vs...))
+}
+
+// CallDepthGT applies the GT predicate on the "call_depth" field.
+func CallDepthGT(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGT(FieldCallDepth, v))
+}
+
+// CallDepthGTE applies the GTE predicate on the "call_depth" field.
+func CallDepthGTE(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGTE(FieldCallDepth, v))
+}
+
+// CallDepthLT applies the LT predicate on the "call_depth" field.
+func CallDepthLT(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLT(FieldCallDepth, v))
+}
+
+// CallDepthLTE applies the LTE predicate on the "call_depth" field.
+func CallDepthLTE(v int) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLTE(FieldCallDepth, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.WorkflowRun) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.AndPredicates(predicates...))
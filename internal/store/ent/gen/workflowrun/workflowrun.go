@@ -39,6 +39,12 @@ const (
 	FieldCompletedAt = "completed_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldParentKind holds the string denoting the parent_kind field in the database.
+	FieldParentKind = "parent_kind"
+	// FieldParentRunID holds the string denoting the parent_run_id field in the database.
+	FieldParentRunID = "parent_run_id"
+	// FieldCallDepth holds the string denoting the call_depth field in the database.
+	FieldCallDepth = "call_depth"
 	// Table holds the table name of the workflowrun in the database.
 	Table = "workflow_runs"
 )
@@ -59,6 +65,9 @@ var Columns = []string{
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldCreatedAt,
+	FieldParentKind,
+	FieldParentRunID,
+	FieldCallDepth,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,6 +93,10 @@ var (
 	DefaultError string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultParentKind holds the default value on creation for the "parent_kind" field.
+	DefaultParentKind string
+	// DefaultCallDepth holds the default value on creation for the "call_depth" field.
+	DefaultCallDepth int
 )
 
 // OrderOption defines the ordering options for the WorkflowRun queries.
@@ -143,3 +156,18 @@ func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByParentKind orders the results by the parent_kind field.
+func ByParentKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentKind, opts...).ToFunc()
+}
+
+// ByParentRunID orders the results by the parent_run_id field.
+func ByParentRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentRunID, opts...).ToFunc()
+}
+
+// ByCallDepth orders the results by the call_depth field.
+func ByCallDepth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallDepth, opts...).ToFunc()
+}
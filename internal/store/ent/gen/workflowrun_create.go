@@ -164,6 +164,48 @@ func (_c *WorkflowRunCreate) SetNillableCreatedAt(v *time.Time) *WorkflowRunCrea
 	return _c
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (_c *WorkflowRunCreate) SetParentKind(v string) *WorkflowRunCreate {
+	_c.mutation.SetParentKind(v)
+	return _c
+}
+
+// SetNillableParentKind sets the "parent_kind" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableParentKind(v *string) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetParentKind(*v)
+	}
+	return _c
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (_c *WorkflowRunCreate) SetParentRunID(v int64) *WorkflowRunCreate {
+	_c.mutation.SetParentRunID(v)
+	return _c
+}
+
+// SetNillableParentRunID sets the "parent_run_id" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableParentRunID(v *int64) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetParentRunID(*v)
+	}
+	return _c
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (_c *WorkflowRunCreate) SetCallDepth(v int) *WorkflowRunCreate {
+	_c.mutation.SetCallDepth(v)
+	return _c
+}
+
+// SetNillableCallDepth sets the "call_depth" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableCallDepth(v *int) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetCallDepth(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *WorkflowRunCreate) SetID(v int64) *WorkflowRunCreate {
 	_c.mutation.SetID(v)
@@ -225,6 +267,14 @@ func (_c *WorkflowRunCreate) defaults() {
 		v := workflowrun.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.ParentKind(); !ok {
+		v := workflowrun.DefaultParentKind
+		_c.mutation.SetParentKind(v)
+	}
+	if _, ok := _c.mutation.CallDepth(); !ok {
+		v := workflowrun.DefaultCallDepth
+		_c.mutation.SetCallDepth(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -252,6 +302,12 @@ func (_c *WorkflowRunCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "WorkflowRun.created_at"`)}
 	}
+	if _, ok := _c.mutation.ParentKind(); !ok {
+		return &ValidationError{Name: "parent_kind", err: errors.New(`gen: missing required field "WorkflowRun.parent_kind"`)}
+	}
+	if _, ok := _c.mutation.CallDepth(); !ok {
+		return &ValidationError{Name: "call_depth", err: errors.New(`gen: missing required field "WorkflowRun.call_depth"`)}
+	}
 	return nil
 }
 
@@ -337,6 +393,18 @@ func (_c *WorkflowRunCreate) createSpec() (*WorkflowRun, *sqlgraph.CreateSpec) {
 		_spec.SetField(workflowrun.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.ParentKind(); ok {
+		_spec.SetField(workflowrun.FieldParentKind, field.TypeString, value)
+		_node.ParentKind = value
+	}
+	if value, ok := _c.mutation.ParentRunID(); ok {
+		_spec.SetField(workflowrun.FieldParentRunID, field.TypeInt64, value)
+		_node.ParentRunID = &value
+	}
+	if value, ok := _c.mutation.CallDepth(); ok {
+		_spec.SetField(workflowrun.FieldCallDepth, field.TypeInt, value)
+		_node.CallDepth = value
+	}
 	return _node, _spec
 }
 
@@ -587,6 +655,60 @@ func (u *WorkflowRunUpsert) ClearCompletedAt() *WorkflowRunUpsert {
 	return u
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (u *WorkflowRunUpsert) SetParentKind(v string) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldParentKind, v)
+	return u
+}
+
+// UpdateParentKind sets the "parent_kind" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateParentKind() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldParentKind)
+	return u
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (u *WorkflowRunUpsert) SetParentRunID(v int64) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldParentRunID, v)
+	return u
+}
+
+// UpdateParentRunID sets the "parent_run_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateParentRunID() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldParentRunID)
+	return u
+}
+
+// AddParentRunID adds v to the "parent_run_id" field.
+func (u *WorkflowRunUpsert) AddParentRunID(v int64) *WorkflowRunUpsert {
+	u.Add(workflowrun.FieldParentRunID, v)
+	return u
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (u *WorkflowRunUpsert) ClearParentRunID() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldParentRunID)
+	return u
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (u *WorkflowRunUpsert) SetCallDepth(v int) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldCallDepth, v)
+	return u
+}
+
+// UpdateCallDepth sets the "call_depth" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateCallDepth() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldCallDepth)
+	return u
+}
+
+// AddCallDepth adds v to the "call_depth" field.
+func (u *WorkflowRunUpsert) AddCallDepth(v int) *WorkflowRunUpsert {
+	u.Add(workflowrun.FieldCallDepth, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -869,6 +991,69 @@ func (u *WorkflowRunUpsertOne) ClearCompletedAt() *WorkflowRunUpsertOne {
 	})
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (u *WorkflowRunUpsertOne) SetParentKind(v string) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetParentKind(v)
+	})
+}
+
+// UpdateParentKind sets the "parent_kind" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateParentKind() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateParentKind()
+	})
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (u *WorkflowRunUpsertOne) SetParentRunID(v int64) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetParentRunID(v)
+	})
+}
+
+// AddParentRunID adds v to the "parent_run_id" field.
+func (u *WorkflowRunUpsertOne) AddParentRunID(v int64) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddParentRunID(v)
+	})
+}
+
+// UpdateParentRunID sets the "parent_run_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateParentRunID() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateParentRunID()
+	})
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (u *WorkflowRunUpsertOne) ClearParentRunID() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearParentRunID()
+	})
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (u *WorkflowRunUpsertOne) SetCallDepth(v int) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetCallDepth(v)
+	})
+}
+
+// AddCallDepth adds v to the "call_depth" field.
+func (u *WorkflowRunUpsertOne) AddCallDepth(v int) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddCallDepth(v)
+	})
+}
+
+// UpdateCallDepth sets the "call_depth" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateCallDepth() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateCallDepth()
+	})
+}
+
 // Exec executes the query.
 func (u *WorkflowRunUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1317,6 +1502,69 @@ func (u *WorkflowRunUpsertBulk) ClearCompletedAt() *WorkflowRunUpsertBulk {
 	})
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (u *WorkflowRunUpsertBulk) SetParentKind(v string) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetParentKind(v)
+	})
+}
+
+// UpdateParentKind sets the "parent_kind" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateParentKind() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateParentKind()
+	})
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (u *WorkflowRunUpsertBulk) SetParentRunID(v int64) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetParentRunID(v)
+	})
+}
+
+// AddParentRunID adds v to the "parent_run_id" field.
+func (u *WorkflowRunUpsertBulk) AddParentRunID(v int64) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddParentRunID(v)
+	})
+}
+
+// UpdateParentRunID sets the "parent_run_id" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateParentRunID() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateParentRunID()
+	})
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (u *WorkflowRunUpsertBulk) ClearParentRunID() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearParentRunID()
+	})
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (u *WorkflowRunUpsertBulk) SetCallDepth(v int) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetCallDepth(v)
+	})
+}
+
+// AddCallDepth adds v to the "call_depth" field.
+func (u *WorkflowRunUpsertBulk) AddCallDepth(v int) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.AddCallDepth(v)
+	})
+}
+
+// UpdateCallDepth sets the "call_depth" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateCallDepth() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateCallDepth()
+	})
+}
+
 // Exec executes the query.
 func (u *WorkflowRunUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -18,8 +18,9 @@ import (
 // WorkflowRunUpdate is the builder for updating WorkflowRun entities.
 type WorkflowRunUpdate struct {
 	config
-	hooks    []Hook
-	mutation *WorkflowRunMutation
+	hooks     []Hook
+	mutation  *WorkflowRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the WorkflowRunUpdate builder.
@@ -228,6 +229,68 @@ func (_u *WorkflowRunUpdate) ClearCompletedAt() *WorkflowRunUpdate {
 	return _u
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (_u *WorkflowRunUpdate) SetParentKind(v string) *WorkflowRunUpdate {
+	_u.mutation.SetParentKind(v)
+	return _u
+}
+
+// SetNillableParentKind sets the "parent_kind" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableParentKind(v *string) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetParentKind(*v)
+	}
+	return _u
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (_u *WorkflowRunUpdate) SetParentRunID(v int64) *WorkflowRunUpdate {
+	_u.mutation.ResetParentRunID()
+	_u.mutation.SetParentRunID(v)
+	return _u
+}
+
+// SetNillableParentRunID sets the "parent_run_id" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableParentRunID(v *int64) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetParentRunID(*v)
+	}
+	return _u
+}
+
+// AddParentRunID adds value to the "parent_run_id" field.
+func (_u *WorkflowRunUpdate) AddParentRunID(v int64) *WorkflowRunUpdate {
+	_u.mutation.AddParentRunID(v)
+	return _u
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (_u *WorkflowRunUpdate) ClearParentRunID() *WorkflowRunUpdate {
+	_u.mutation.ClearParentRunID()
+	return _u
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (_u *WorkflowRunUpdate) SetCallDepth(v int) *WorkflowRunUpdate {
+	_u.mutation.ResetCallDepth()
+	_u.mutation.SetCallDepth(v)
+	return _u
+}
+
+// SetNillableCallDepth sets the "call_depth" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableCallDepth(v *int) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetCallDepth(*v)
+	}
+	return _u
+}
+
+// AddCallDepth adds value to the "call_depth" field.
+func (_u *WorkflowRunUpdate) AddCallDepth(v int) *WorkflowRunUpdate {
+	_u.mutation.AddCallDepth(v)
+	return _u
+}
+
 // Mutation returns the WorkflowRunMutation object of the builder.
 func (_u *WorkflowRunUpdate) Mutation() *WorkflowRunMutation {
 	return _u.mutation
@@ -270,6 +333,12 @@ func (_u *WorkflowRunUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -345,6 +414,25 @@ func (_u *WorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(workflowrun.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ParentKind(); ok {
+		_spec.SetField(workflowrun.FieldParentKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParentRunID(); ok {
+		_spec.SetField(workflowrun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedParentRunID(); ok {
+		_spec.AddField(workflowrun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if _u.mutation.ParentRunIDCleared() {
+		_spec.ClearField(workflowrun.FieldParentRunID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CallDepth(); ok {
+		_spec.SetField(workflowrun.FieldCallDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallDepth(); ok {
+		_spec.AddField(workflowrun.FieldCallDepth, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{workflowrun.Label}
@@ -360,9 +448,10 @@ func (_u *WorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // WorkflowRunUpdateOne is the builder for updating a single WorkflowRun entity.
 type WorkflowRunUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *WorkflowRunMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetWorkflowID sets the "workflow_id" field.
@@ -565,6 +654,68 @@ func (_u *WorkflowRunUpdateOne) ClearCompletedAt() *WorkflowRunUpdateOne {
 	return _u
 }
 
+// SetParentKind sets the "parent_kind" field.
+func (_u *WorkflowRunUpdateOne) SetParentKind(v string) *WorkflowRunUpdateOne {
+	_u.mutation.SetParentKind(v)
+	return _u
+}
+
+// SetNillableParentKind sets the "parent_kind" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableParentKind(v *string) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetParentKind(*v)
+	}
+	return _u
+}
+
+// SetParentRunID sets the "parent_run_id" field.
+func (_u *WorkflowRunUpdateOne) SetParentRunID(v int64) *WorkflowRunUpdateOne {
+	_u.mutation.ResetParentRunID()
+	_u.mutation.SetParentRunID(v)
+	return _u
+}
+
+// SetNillableParentRunID sets the "parent_run_id" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableParentRunID(v *int64) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetParentRunID(*v)
+	}
+	return _u
+}
+
+// AddParentRunID adds value to the "parent_run_id" field.
+func (_u *WorkflowRunUpdateOne) AddParentRunID(v int64) *WorkflowRunUpdateOne {
+	_u.mutation.AddParentRunID(v)
+	return _u
+}
+
+// ClearParentRunID clears the value of the "parent_run_id" field.
+func (_u *WorkflowRunUpdateOne) ClearParentRunID() *WorkflowRunUpdateOne {
+	_u.mutation.ClearParentRunID()
+	return _u
+}
+
+// SetCallDepth sets the "call_depth" field.
+func (_u *WorkflowRunUpdateOne) SetCallDepth(v int) *WorkflowRunUpdateOne {
+	_u.mutation.ResetCallDepth()
+	_u.mutation.SetCallDepth(v)
+	return _u
+}
+
+// SetNillableCallDepth sets the "call_depth" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableCallDepth(v *int) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetCallDepth(*v)
+	}
+	return _u
+}
+
+// AddCallDepth adds value to the "call_depth" field.
+func (_u *WorkflowRunUpdateOne) AddCallDepth(v int) *WorkflowRunUpdateOne {
+	_u.mutation.AddCallDepth(v)
+	return _u
+}
+
 // Mutation returns the WorkflowRunMutation object of the builder.
 func (_u *WorkflowRunUpdateOne) Mutation() *WorkflowRunMutation {
 	return _u.mutation
@@ -620,6 +771,12 @@ func (_u *WorkflowRunUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowRunUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRun, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -712,6 +869,25 @@ func (_u *WorkflowRunUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRun
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(workflowrun.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ParentKind(); ok {
+		_spec.SetField(workflowrun.FieldParentKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParentRunID(); ok {
+		_spec.SetField(workflowrun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedParentRunID(); ok {
+		_spec.AddField(workflowrun.FieldParentRunID, field.TypeInt64, value)
+	}
+	if _u.mutation.ParentRunIDCleared() {
+		_spec.ClearField(workflowrun.FieldParentRunID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CallDepth(); ok {
+		_spec.SetField(workflowrun.FieldCallDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallDepth(); ok {
+		_spec.AddField(workflowrun.FieldCallDepth, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &WorkflowRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
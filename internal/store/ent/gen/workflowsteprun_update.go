@@ -18,8 +18,9 @@ import (
 // WorkflowStepRunUpdate is the builder for updating WorkflowStepRun entities.
 type WorkflowStepRunUpdate struct {
 	config
-	hooks    []Hook
-	mutation *WorkflowStepRunMutation
+	hooks     []Hook
+	mutation  *WorkflowStepRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the WorkflowStepRunUpdate builder.
@@ -277,6 +278,12 @@ func (_u *WorkflowStepRunUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowStepRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowStepRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowStepRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -346,6 +353,7 @@ func (_u *WorkflowStepRunUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(workflowsteprun.FieldCompletedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{workflowsteprun.Label}
@@ -361,9 +369,10 @@ func (_u *WorkflowStepRunUpdate) sqlSave(ctx context.Context) (_node int, err er
 // WorkflowStepRunUpdateOne is the builder for updating a single WorkflowStepRun entity.
 type WorkflowStepRunUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *WorkflowStepRunMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowStepRunMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetWorkflowRunID sets the "workflow_run_id" field.
@@ -628,6 +637,12 @@ func (_u *WorkflowStepRunUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowStepRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowStepRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowStepRunUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowStepRun, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -714,6 +729,7 @@ func (_u *WorkflowStepRunUpdateOne) sqlSave(ctx context.Context) (_node *Workflo
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(workflowsteprun.FieldCompletedAt, field.TypeTime)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &WorkflowStepRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
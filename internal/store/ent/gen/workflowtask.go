@@ -32,7 +32,9 @@ type WorkflowTask struct {
 	// Conn holds the value of the "conn" field.
 	Conn []string `json:"conn,omitempty"`
 	// Retry holds the value of the "retry" field.
-	Retry        map[string]interface{} `json:"retry,omitempty"`
+	Retry map[string]interface{} `json:"retry,omitempty"`
+	// Outputs holds the value of the "outputs" field.
+	Outputs      []map[string]interface{} `json:"outputs,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -41,7 +43,7 @@ func (*WorkflowTask) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case workflowtask.FieldParams, workflowtask.FieldVars, workflowtask.FieldConn, workflowtask.FieldRetry:
+		case workflowtask.FieldParams, workflowtask.FieldVars, workflowtask.FieldConn, workflowtask.FieldRetry, workflowtask.FieldOutputs:
 			values[i] = new([]byte)
 		case workflowtask.FieldID, workflowtask.FieldWorkflowID:
 			values[i] = new(sql.NullInt64)
@@ -124,6 +126,14 @@ func (_m *WorkflowTask) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field retry: %w", err)
 				}
 			}
+		case workflowtask.FieldOutputs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field outputs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Outputs); err != nil {
+					return fmt.Errorf("unmarshal field outputs: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -183,6 +193,9 @@ func (_m *WorkflowTask) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("retry=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Retry))
+	builder.WriteString(", ")
+	builder.WriteString("outputs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Outputs))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -357,6 +357,16 @@ func RetryNotNil() predicate.WorkflowTask {
 	return predicate.WorkflowTask(sql.FieldNotNull(FieldRetry))
 }
 
+// OutputsIsNil applies the IsNil predicate on the "outputs" field.
+func OutputsIsNil() predicate.WorkflowTask {
+	return predicate.WorkflowTask(sql.FieldIsNull(FieldOutputs))
+}
+
+// OutputsNotNil applies the NotNil predicate on the "outputs" field.
+func OutputsNotNil() predicate.WorkflowTask {
+	return predicate.WorkflowTask(sql.FieldNotNull(FieldOutputs))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.WorkflowTask) predicate.WorkflowTask {
 	return predicate.WorkflowTask(sql.AndPredicates(predicates...))
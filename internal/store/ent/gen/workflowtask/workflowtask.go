@@ -27,6 +27,8 @@ const (
 	FieldConn = "conn"
 	// FieldRetry holds the string denoting the retry field in the database.
 	FieldRetry = "retry"
+	// FieldOutputs holds the string denoting the outputs field in the database.
+	FieldOutputs = "outputs"
 	// Table holds the table name of the workflowtask in the database.
 	Table = "workflow_tasks"
 )
@@ -42,6 +44,7 @@ var Columns = []string{
 	FieldVars,
 	FieldConn,
 	FieldRetry,
+	FieldOutputs,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
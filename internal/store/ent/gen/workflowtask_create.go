@@ -77,6 +77,12 @@ func (_c *WorkflowTaskCreate) SetRetry(v map[string]interface{}) *WorkflowTaskCr
 	return _c
 }
 
+// SetOutputs sets the "outputs" field.
+func (_c *WorkflowTaskCreate) SetOutputs(v []map[string]interface{}) *WorkflowTaskCreate {
+	_c.mutation.SetOutputs(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *WorkflowTaskCreate) SetID(v int64) *WorkflowTaskCreate {
 	_c.mutation.SetID(v)
@@ -210,6 +216,10 @@ func (_c *WorkflowTaskCreate) createSpec() (*WorkflowTask, *sqlgraph.CreateSpec)
 		_spec.SetField(workflowtask.FieldRetry, field.TypeJSON, value)
 		_node.Retry = value
 	}
+	if value, ok := _c.mutation.Outputs(); ok {
+		_spec.SetField(workflowtask.FieldOutputs, field.TypeJSON, value)
+		_node.Outputs = value
+	}
 	return _node, _spec
 }
 
@@ -394,6 +404,24 @@ func (u *WorkflowTaskUpsert) ClearRetry() *WorkflowTaskUpsert {
 	return u
 }
 
+// SetOutputs sets the "outputs" field.
+func (u *WorkflowTaskUpsert) SetOutputs(v []map[string]interface{}) *WorkflowTaskUpsert {
+	u.Set(workflowtask.FieldOutputs, v)
+	return u
+}
+
+// UpdateOutputs sets the "outputs" field to the value that was provided on create.
+func (u *WorkflowTaskUpsert) UpdateOutputs() *WorkflowTaskUpsert {
+	u.SetExcluded(workflowtask.FieldOutputs)
+	return u
+}
+
+// ClearOutputs clears the value of the "outputs" field.
+func (u *WorkflowTaskUpsert) ClearOutputs() *WorkflowTaskUpsert {
+	u.SetNull(workflowtask.FieldOutputs)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -596,6 +624,27 @@ func (u *WorkflowTaskUpsertOne) ClearRetry() *WorkflowTaskUpsertOne {
 	})
 }
 
+// SetOutputs sets the "outputs" field.
+func (u *WorkflowTaskUpsertOne) SetOutputs(v []map[string]interface{}) *WorkflowTaskUpsertOne {
+	return u.Update(func(s *WorkflowTaskUpsert) {
+		s.SetOutputs(v)
+	})
+}
+
+// UpdateOutputs sets the "outputs" field to the value that was provided on create.
+func (u *WorkflowTaskUpsertOne) UpdateOutputs() *WorkflowTaskUpsertOne {
+	return u.Update(func(s *WorkflowTaskUpsert) {
+		s.UpdateOutputs()
+	})
+}
+
+// ClearOutputs clears the value of the "outputs" field.
+func (u *WorkflowTaskUpsertOne) ClearOutputs() *WorkflowTaskUpsertOne {
+	return u.Update(func(s *WorkflowTaskUpsert) {
+		s.ClearOutputs()
+	})
+}
+
 // Exec executes the query.
 func (u *WorkflowTaskUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -964,6 +1013,27 @@ func (u *WorkflowTaskUpsertBulk) ClearRetry() *WorkflowTaskUpsertBulk {
 	})
 }
 
+// SetOutputs sets the "outputs" field.
+func (u *WorkflowTaskUpsertBulk) SetOutputs(v []map[string]interface{}) *WorkflowTaskUpsertBulk {
+	return u.Update(func(s *WorkflowTaskUpsert) {
+		s.SetOutputs(v)
+	})
+}
+
+// UpdateOutputs sets the "outputs" field to the value that was provided on create.
+func (u *WorkflowTaskUpsertBulk) UpdateOutputs() *WorkflowTaskUpsertBulk {
+	return u.Update(func(s *WorkflowTaskUpsert) {
+		s.UpdateOutputs()
+	})
+}
+
+// ClearOutputs clears the value of the "outputs" field.
+func (u *WorkflowTaskUpsertBulk) ClearOutputs() *WorkflowTaskUpsertBulk {
+	return u.Update(func(s *WorkflowTaskUpsert) {
+		s.ClearOutputs()
+	})
+}
+
 // Exec executes the query.
 func (u *WorkflowTaskUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -18,8 +18,9 @@ import (
 // WorkflowTaskUpdate is the builder for updating WorkflowTask entities.
 type WorkflowTaskUpdate struct {
 	config
-	hooks    []Hook
-	mutation *WorkflowTaskMutation
+	hooks     []Hook
+	mutation  *WorkflowTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the WorkflowTaskUpdate builder.
@@ -157,6 +158,24 @@ func (_u *WorkflowTaskUpdate) ClearRetry() *WorkflowTaskUpdate {
 	return _u
 }
 
+// SetOutputs sets the "outputs" field.
+func (_u *WorkflowTaskUpdate) SetOutputs(v []map[string]interface{}) *WorkflowTaskUpdate {
+	_u.mutation.SetOutputs(v)
+	return _u
+}
+
+// AppendOutputs appends value to the "outputs" field.
+func (_u *WorkflowTaskUpdate) AppendOutputs(v []map[string]interface{}) *WorkflowTaskUpdate {
+	_u.mutation.AppendOutputs(v)
+	return _u
+}
+
+// ClearOutputs clears the value of the "outputs" field.
+func (_u *WorkflowTaskUpdate) ClearOutputs() *WorkflowTaskUpdate {
+	_u.mutation.ClearOutputs()
+	return _u
+}
+
 // Mutation returns the WorkflowTaskMutation object of the builder.
 func (_u *WorkflowTaskUpdate) Mutation() *WorkflowTaskMutation {
 	return _u.mutation
@@ -204,6 +223,12 @@ func (_u *WorkflowTaskUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowTaskUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -268,6 +293,18 @@ func (_u *WorkflowTaskUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.RetryCleared() {
 		_spec.ClearField(workflowtask.FieldRetry, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Outputs(); ok {
+		_spec.SetField(workflowtask.FieldOutputs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOutputs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowtask.FieldOutputs, value)
+		})
+	}
+	if _u.mutation.OutputsCleared() {
+		_spec.ClearField(workflowtask.FieldOutputs, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{workflowtask.Label}
@@ -283,9 +320,10 @@ func (_u *WorkflowTaskUpdate) sqlSave(ctx context.Context) (_node int, err error
 // WorkflowTaskUpdateOne is the builder for updating a single WorkflowTask entity.
 type WorkflowTaskUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *WorkflowTaskMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetWorkflowID sets the "workflow_id" field.
@@ -417,6 +455,24 @@ func (_u *WorkflowTaskUpdateOne) ClearRetry() *WorkflowTaskUpdateOne {
 	return _u
 }
 
+// SetOutputs sets the "outputs" field.
+func (_u *WorkflowTaskUpdateOne) SetOutputs(v []map[string]interface{}) *WorkflowTaskUpdateOne {
+	_u.mutation.SetOutputs(v)
+	return _u
+}
+
+// AppendOutputs appends value to the "outputs" field.
+func (_u *WorkflowTaskUpdateOne) AppendOutputs(v []map[string]interface{}) *WorkflowTaskUpdateOne {
+	_u.mutation.AppendOutputs(v)
+	return _u
+}
+
+// ClearOutputs clears the value of the "outputs" field.
+func (_u *WorkflowTaskUpdateOne) ClearOutputs() *WorkflowTaskUpdateOne {
+	_u.mutation.ClearOutputs()
+	return _u
+}
+
 // Mutation returns the WorkflowTaskMutation object of the builder.
 func (_u *WorkflowTaskUpdateOne) Mutation() *WorkflowTaskMutation {
 	return _u.mutation
@@ -477,6 +533,12 @@ func (_u *WorkflowTaskUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowTaskUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowTaskUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowTask, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -558,6 +620,18 @@ func (_u *WorkflowTaskUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowTa
 	if _u.mutation.RetryCleared() {
 		_spec.ClearField(workflowtask.FieldRetry, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Outputs(); ok {
+		_spec.SetField(workflowtask.FieldOutputs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOutputs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowtask.FieldOutputs, value)
+		})
+	}
+	if _u.mutation.OutputsCleared() {
+		_spec.ClearField(workflowtask.FieldOutputs, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &WorkflowTask{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -17,8 +17,9 @@ import (
 // WorkflowTriggerUpdate is the builder for updating WorkflowTrigger entities.
 type WorkflowTriggerUpdate struct {
 	config
-	hooks    []Hook
-	mutation *WorkflowTriggerMutation
+	hooks     []Hook
+	mutation  *WorkflowTriggerMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the WorkflowTriggerUpdate builder.
@@ -130,6 +131,12 @@ func (_u *WorkflowTriggerUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowTriggerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowTriggerUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowTriggerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -160,6 +167,7 @@ func (_u *WorkflowTriggerUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.RuleCleared() {
 		_spec.ClearField(workflowtrigger.FieldRule, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{workflowtrigger.Label}
@@ -175,9 +183,10 @@ func (_u *WorkflowTriggerUpdate) sqlSave(ctx context.Context) (_node int, err er
 // WorkflowTriggerUpdateOne is the builder for updating a single WorkflowTrigger entity.
 type WorkflowTriggerUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *WorkflowTriggerMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowTriggerMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetWorkflowID sets the "workflow_id" field.
@@ -296,6 +305,12 @@ func (_u *WorkflowTriggerUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowTriggerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowTriggerUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WorkflowTriggerUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowTrigger, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -343,6 +358,7 @@ func (_u *WorkflowTriggerUpdateOne) sqlSave(ctx context.Context) (_node *Workflo
 	if _u.mutation.RuleCleared() {
 		_spec.ClearField(workflowtrigger.FieldRule, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &WorkflowTrigger{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run entgo.io/ent/cmd/ent generate --feature sql/upsert ./schema --target ./gen
+//go:generate go run entgo.io/ent/cmd/ent generate --feature sql/upsert,sql/modifier,sql/execquery ./schema --target ./gen
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AgentEmbedding stores the embedding vector of one agent knowledge document,
+// memory fact or session summary for semantic retrieval.
+type AgentEmbedding struct {
+	ent.Schema
+}
+
+// Fields of the AgentEmbedding.
+func (AgentEmbedding) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Immutable(),
+		// kind is knowledge, memory_fact or session_summary.
+		field.String("kind").NotEmpty(),
+		// ref_id is the ID of the embedded row in its own table.
+		field.Int64("ref_id"),
+		field.String("scope").Default(""),
+		field.String("model").NotEmpty(),
+		// content_hash identifies the model, scope and text the vector was built from.
+		field.String("content_hash").NotEmpty(),
+		// vector holds little-endian float32 values.
+		field.Bytes("vector"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the AgentEmbedding.
+func (AgentEmbedding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "ref_id").Unique(),
+		index.Fields("kind", "scope", "model"),
+	}
+}
+
+// Annotations of the AgentEmbedding.
+func (AgentEmbedding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("agent_embeddings"),
+	}
+}
@@ -7,9 +7,13 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+
+	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 // Clip stores a shareable markdown clip addressed by a short slug.
+// Access limits (expiry, view limit, burn-after-read, password, signed link)
+// are optional; version counts edits, which are snapshotted in ClipVersion.
 type Clip struct {
 	ent.Schema
 }
@@ -21,9 +25,23 @@ func (Clip) Fields() []ent.Field {
 		field.String("slug").NotEmpty().Unique(),
 		field.String("title").Default(""),
 		field.String("description").Default(""),
-		field.Text("content").NotEmpty(),
+		// content is emptied when a burn-after-read clip is read or an expired clip is purged.
+		field.Text("content"),
+		field.JSON("attachments", []types.ClipAttachment{}).Optional(),
+		field.Int("version").Default(1),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Bool("burn_after_read").Default(false),
+		// max_views of 0 means unlimited.
+		field.Int("max_views").Default(0),
+		field.Int("view_count").Default(0),
+		// password_hash is a bcrypt hash; empty when no password is required.
+		field.String("password_hash").Default(""),
+		// link_secret signs share links; empty when no signed link is required.
+		field.String("link_secret").Default(""),
+		field.Time("burned_at").Optional().Nillable(),
 		field.String("created_by").Default(""),
 		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Optional().Nillable(),
 	}
 }
 
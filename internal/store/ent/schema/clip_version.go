@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// ClipVersion stores a snapshot of a clip each time it is created or edited.
+// (clip_id, version) is unique so each snapshot is immutable.
+type ClipVersion struct {
+	ent.Schema
+}
+
+// Fields of the ClipVersion.
+func (ClipVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Immutable(),
+		field.Int64("clip_id"),
+		field.Int("version"),
+		field.String("title").Default(""),
+		field.String("description").Default(""),
+		field.Text("content"),
+		field.JSON("attachments", []types.ClipAttachment{}).Optional(),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes of the ClipVersion.
+func (ClipVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clip_id", "version").Unique(),
+	}
+}
+
+// Annotations of the ClipVersion.
+func (ClipVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("clip_versions"),
+	}
+}
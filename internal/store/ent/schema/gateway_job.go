@@ -32,6 +32,8 @@ func (GatewayJob) Fields() []ent.Field {
 		field.Text("error_text").Default(""),
 		field.Bool("truncated").Default(false),
 		field.Int64("duration_ms").Default(0),
+		field.Int64("log_seq").Default(0).Comment("Last streamed log chunk seq"),
+		field.Int64("log_bytes").Default(0).Comment("Total streamed log bytes stored"),
 		field.String("worker_id").Default(""),
 		field.Time("lease_until").Optional().Nillable(),
 		field.Time("claimed_at").Optional().Nillable(),
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GatewayJobLog stores one streamed output chunk of a gateway job, in seq order.
+type GatewayJobLog struct {
+	ent.Schema
+}
+
+// Fields of the GatewayJobLog.
+func (GatewayJobLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Immutable(),
+		field.String("job_id").NotEmpty().Immutable(),
+		field.Int64("seq").Immutable(),
+		field.Text("content").Immutable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes keeps chunk order unique per job and supports after-seq reads.
+func (GatewayJobLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id", "seq").Unique(),
+	}
+}
+
+// Annotations pins the database table name.
+func (GatewayJobLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("gateway_job_logs"),
+	}
+}
@@ -21,12 +21,23 @@ func (LLMUsageRecord) Fields() []ent.Field {
 		field.String("uid").NotEmpty(),
 		field.String("session_id").Default(""),
 		field.String("model").Default(""),
+		// provider is the models[] provider that served the call; for a model
+		// alias, the provider of the chain model that answered.
+		field.String("provider").Default(""),
 		field.Int("prompt_tokens").Default(0),
 		field.Int("completion_tokens").Default(0),
 		field.Int("total_tokens").Default(0),
 		field.Int("cache_read").Default(0),
 		field.Int("cache_write").Default(0),
 		field.String("source").Default("agent"),
+		// agent is "chat" or the subagent name that made the call.
+		field.String("agent").Default(""),
+		// task_id is the scheduled task id when the call ran for one.
+		field.String("task_id").Default(""),
+		// pipeline_step is "pipeline/step" when the call ran for a pipeline agent_run step.
+		field.String("pipeline_step").Default(""),
+		// cost is the estimated USD cost from model pricing; zero when pricing is unknown.
+		field.Float("cost").Default(0),
 		field.Time("created_at").Immutable().Default(time.Now),
 	}
 }
@@ -35,6 +46,9 @@ func (LLMUsageRecord) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("uid", "created_at"),
 		index.Fields("uid", "model", "created_at"),
+		index.Fields("agent", "created_at"),
+		index.Fields("task_id", "created_at"),
+		index.Fields("pipeline_step", "created_at"),
 	}
 }
 
@@ -23,6 +23,7 @@ func (NotifyRule) Fields() []ent.Field {
 		field.String("event_pattern").Default("*").NotEmpty(),
 		field.String("channel_pattern").Default("*").NotEmpty(),
 		field.String("condition").Optional(),
+		field.String("timezone").Optional(),
 		field.Int("priority").Default(0),
 		field.JSON("params", map[string]any{}),
 		field.Bool("enabled").Default(true),
@@ -21,7 +21,7 @@ func (PipelineRun) Fields() []ent.Field {
 		field.String("event_id").NotEmpty().Unique(),
 		field.String("event_type").Default(""),
 		field.Enum("trigger_source").
-			Values("event", "webhook", "cron", "manual").
+			Values("event", "webhook", "cron", "manual", "call").
 			Default("event"),
 		field.Int("status").Default(0),
 		field.String("error").Optional().Default(""),
@@ -30,12 +30,17 @@ func (PipelineRun) Fields() []ent.Field {
 		field.Time("started_at"),
 		field.Time("completed_at").Optional().Nillable(),
 		field.Time("created_at").Immutable().Default(time.Now),
+		// parent_kind/parent_run_id link runs started by a call step to the calling run.
+		field.String("parent_kind").Default(""),
+		field.Int64("parent_run_id").Optional().Nillable(),
+		field.Int("call_depth").Default(0),
 	}
 }
 
 func (PipelineRun) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("pipeline_name"),
+		index.Fields("parent_run_id"),
 	}
 }
 
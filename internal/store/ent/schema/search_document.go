@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchDocument is one entry of the unified search index: a bookmark, feed
+// entry, memo, note, task, issue, clip or knowledge document, keyed by its
+// source and the ID it has there.
+type SearchDocument struct {
+	ent.Schema
+}
+
+// Fields of the SearchDocument.
+func (SearchDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Immutable(),
+		field.String("source").NotEmpty(),
+		field.String("doc_id").NotEmpty(),
+		// uid scopes the document to one user; empty means visible to all.
+		field.String("uid").Default(""),
+		field.Text("title").Default(""),
+		field.Text("content").Default(""),
+		field.Text("url").Default(""),
+		field.JSON("tags", []string{}).Default([]string{}),
+		field.Time("updated_at").Default(time.Now),
+		field.Time("indexed_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the SearchDocument.
+func (SearchDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source", "doc_id").Unique(),
+		index.Fields("uid"),
+		index.Fields("updated_at"),
+	}
+}
+
+// Annotations of the SearchDocument.
+func (SearchDocument) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("search_documents"),
+	}
+}
+
+// Full-text index objects ent cannot express. Postgres keeps a generated
+// tsvector column with a GIN index; SQLite keeps an external-content FTS5
+// table that triggers sync with search_documents. Both weight the title
+// highest, then tags, then the URL and content.
+const (
+	// SearchDocumentVector is the Postgres tsvector column.
+	SearchDocumentVector = "search_vector"
+	// SearchDocumentFTS is the SQLite FTS5 table; its rowid is the document ID.
+	SearchDocumentFTS = "search_documents_fts"
+)
+
+// SearchDocumentFullText returns the idempotent statements that create the
+// full-text index for the dialect, to run after the ent schema migration.
+func SearchDocumentFullText(dialectName string) []string {
+	switch dialectName {
+	case dialect.Postgres:
+		return []string{
+			`ALTER TABLE search_documents ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+				setweight(to_tsvector('simple', title), 'A') ||
+				setweight(to_tsvector('simple', tags::text), 'B') ||
+				setweight(to_tsvector('simple', url || ' ' || content), 'D')
+			) STORED`,
+			`CREATE INDEX IF NOT EXISTS searchdocument_search_vector ON search_documents USING GIN (search_vector)`,
+		}
+	case dialect.SQLite:
+		return []string{
+			`CREATE VIRTUAL TABLE IF NOT EXISTS search_documents_fts USING fts5(
+				title, tags, url, content,
+				content='search_documents', content_rowid='id', tokenize='unicode61 remove_diacritics 2'
+			)`,
+			`CREATE TRIGGER IF NOT EXISTS search_documents_fts_insert AFTER INSERT ON search_documents BEGIN
+				INSERT INTO search_documents_fts(rowid, title, tags, url, content) VALUES (new.id, new.title, new.tags, new.url, new.content);
+			END`,
+			`CREATE TRIGGER IF NOT EXISTS search_documents_fts_delete AFTER DELETE ON search_documents BEGIN
+				INSERT INTO search_documents_fts(search_documents_fts, rowid, title, tags, url, content) VALUES ('delete', old.id, old.title, old.tags, old.url, old.content);
+			END`,
+			`CREATE TRIGGER IF NOT EXISTS search_documents_fts_update AFTER UPDATE ON search_documents BEGIN
+				INSERT INTO search_documents_fts(search_documents_fts, rowid, title, tags, url, content) VALUES ('delete', old.id, old.title, old.tags, old.url, old.content);
+				INSERT INTO search_documents_fts(rowid, title, tags, url, content) VALUES (new.id, new.title, new.tags, new.url, new.content);
+			END`,
+		}
+	default:
+		return nil
+	}
+}
@@ -181,6 +181,9 @@ const (
 	PipelineDone         = types.PipelineDone
 	PipelineCancel       = types.PipelineCancel
 	PipelineFailed       = types.PipelineFailed
+	PipelineSkipped      = types.PipelineSkipped
+	PipelineCompensated  = types.PipelineCompensated
+	PipelineWaiting      = types.PipelineWaiting
 )
 
 // WorkflowRunState represents the execution state of a local workflow engine run.
@@ -191,6 +194,8 @@ const (
 	WorkflowRunRunning      = types.WorkflowRunRunning
 	WorkflowRunDone         = types.WorkflowRunDone
 	WorkflowRunFailed       = types.WorkflowRunFailed
+	WorkflowRunCancelled    = types.WorkflowRunCancelled
+	WorkflowRunWaiting      = types.WorkflowRunWaiting
 )
 
 type ValueModeType string
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebAccountIdentity links a web account to an external login: an OIDC
+// issuer and subject, or a username asserted by a trusted forward-auth proxy.
+type WebAccountIdentity struct {
+	ent.Schema
+}
+
+// Fields of the WebAccountIdentity.
+func (WebAccountIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Immutable(),
+		// uid is the web_accounts.uid this identity signs in as.
+		field.String("uid").NotEmpty(),
+		// provider is "oidc" or "forward_auth".
+		field.String("provider").NotEmpty(),
+		// issuer is the OIDC issuer URL; empty for forward auth.
+		field.String("issuer").Default(""),
+		field.String("subject").NotEmpty(),
+		field.String("email").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("last_login_at").Default(time.Now),
+	}
+}
+
+// Indexes of the WebAccountIdentity.
+func (WebAccountIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "issuer", "subject").Unique(),
+		index.Fields("uid"),
+	}
+}
+
+// Annotations of the WebAccountIdentity.
+func (WebAccountIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("web_account_identities"),
+	}
+}
@@ -31,6 +31,10 @@ func (WorkflowRun) Fields() []ent.Field {
 		field.Time("started_at"),
 		field.Time("completed_at").Optional().Nillable(),
 		field.Time("created_at").Immutable().Default(time.Now),
+		// parent_kind/parent_run_id link runs started by a call step to the calling run.
+		field.String("parent_kind").Default(""),
+		field.Int64("parent_run_id").Optional().Nillable(),
+		field.Int("call_depth").Default(0),
 	}
 }
 
@@ -38,6 +42,7 @@ func (WorkflowRun) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("workflow_name"),
 		index.Fields("workflow_id"),
+		index.Fields("parent_run_id"),
 	}
 }
 
@@ -25,6 +25,7 @@ func (WorkflowTask) Fields() []ent.Field {
 		field.JSON("vars", []string{}).Optional(),
 		field.JSON("conn", []string{}).Optional(),
 		field.JSON("retry", map[string]any{}).Optional(),
+		field.JSON("outputs", []map[string]any{}).Optional(),
 	}
 }
 
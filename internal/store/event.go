@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/dataevent"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/eventconsumption"
@@ -237,7 +238,7 @@ type ListDataEventsOptions struct {
 	Source       string     // filter by source, empty = all
 	EventType    string     // filter by event type, empty = all
 	Webhook      bool       // if true, only events where data->>'_webhook_method' IS NOT NULL
-	Search       string     // ILIKE match against source and data::text
+	Search       string     // case-insensitive match against source and data as text
 	PipelineName string     // filter events that triggered a specific pipeline
 	TimeStart    *time.Time // created_at >= TimeStart
 	TimeEnd      *time.Time // created_at <= TimeEnd
@@ -300,29 +301,34 @@ func applyDataEventFilters(client *gen.Client, opts ListDataEventsOptions) *gen.
 		q = q.Where(dataevent.EventType(opts.EventType))
 	}
 	if opts.Webhook {
-		q = q.Where(func(selector *sql.Selector) {
-			selector.Where(sql.ExprP("data->>'_webhook_method' IS NOT NULL"))
+		q = q.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueIsNotNull(s.C(dataevent.FieldData), sqljson.Path("_webhook_method")))
 		})
 	}
 	if opts.Search != "" {
 		q = q.Where(sql.OrPredicates(
-			func(s *sql.Selector) { s.Where(sql.ContainsFold("source", opts.Search)) },
+			func(s *sql.Selector) { s.Where(sql.ContainsFold(s.C(dataevent.FieldSource), opts.Search)) },
 			func(s *sql.Selector) {
-				switch s.Dialect() {
-				case dialect.Postgres:
-					s.Where(sql.ExprP("CAST(data AS TEXT) ILIKE '%' || $1 || '%'", opts.Search))
-				default:
-					s.Where(sql.ExprP("LOWER(CAST(data AS TEXT)) LIKE LOWER('%' || $1 || '%')", opts.Search))
-				}
+				// Builder args keep placeholders numbered correctly on every dialect.
+				s.Where(sql.P(func(b *sql.Builder) {
+					switch s.Dialect() {
+					case dialect.Postgres:
+						b.WriteString("CAST(").Ident(s.C(dataevent.FieldData)).WriteString(" AS TEXT) ILIKE ")
+						b.Arg("%" + opts.Search + "%")
+					default:
+						b.WriteString("LOWER(CAST(").Ident(s.C(dataevent.FieldData)).WriteString(" AS TEXT)) LIKE ")
+						b.Arg("%" + strings.ToLower(opts.Search) + "%")
+					}
+				}))
 			},
 		))
 	}
 	if opts.PipelineName != "" {
 		q = q.Where(func(s *sql.Selector) {
-			s.Where(sql.ExprP(
-				"event_id IN (SELECT event_id FROM pipeline_runs WHERE pipeline_name = $1)",
-				opts.PipelineName,
-			))
+			runs := sql.Select(pipelinerun.FieldEventID).
+				From(sql.Table(pipelinerun.Table)).
+				Where(sql.EQ(pipelinerun.FieldPipelineName, opts.PipelineName))
+			s.Where(sql.In(s.C(dataevent.FieldEventID), runs))
 		})
 	}
 	if opts.TimeStart != nil {
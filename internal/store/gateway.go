@@ -9,14 +9,22 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjob"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayjoblog"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/gatewayworker"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
 
 const maxClaimAttempts = 8
 
+const (
+	defaultGatewayLogPage = 200
+	maxGatewayLogPage     = 1000
+)
+
 // GatewayStore persists local-CLI gateway jobs and worker heartbeats.
 type GatewayStore struct {
 	client *gen.Client
@@ -45,8 +53,8 @@ func (s *GatewayStore) Create(ctx context.Context, in types.GatewayCreateJob) (*
 	if cli == "" {
 		cli = types.GatewayCLICursor
 	}
-	if cli != types.GatewayCLICursor {
-		return nil, types.Errorf(types.ErrInvalidArgument, "unsupported gateway cli %q", cli)
+	if !types.ValidGatewayCLI(cli) {
+		return nil, types.Errorf(types.ErrInvalidArgument, "invalid gateway cli %q", cli)
 	}
 	prompt := strings.TrimSpace(in.Prompt)
 	if prompt == "" {
@@ -87,7 +95,8 @@ func (s *GatewayStore) Get(ctx context.Context, jobID string) (*types.GatewayJob
 }
 
 // Claim atomically takes the oldest pending job for workerID with a lease.
-func (s *GatewayStore) Claim(ctx context.Context, workerID string, leaseTTL time.Duration) (*types.GatewayJob, error) {
+// Non-empty clis restricts the claim to jobs for those runners.
+func (s *GatewayStore) Claim(ctx context.Context, workerID string, clis []types.GatewayCLI, leaseTTL time.Duration) (*types.GatewayJob, error) {
 	if !s.ready() {
 		return nil, types.ErrUnavailable
 	}
@@ -104,12 +113,12 @@ func (s *GatewayStore) Claim(ctx context.Context, workerID string, leaseTTL time
 	if err := s.TouchWorker(ctx, workerID); err != nil {
 		return nil, err
 	}
-	return s.claimInTx(ctx, workerID, leaseTTL)
+	return s.claimInTx(ctx, workerID, clis, leaseTTL)
 }
 
-func (s *GatewayStore) claimInTx(ctx context.Context, workerID string, leaseTTL time.Duration) (*types.GatewayJob, error) {
+func (s *GatewayStore) claimInTx(ctx context.Context, workerID string, clis []types.GatewayCLI, leaseTTL time.Duration) (*types.GatewayJob, error) {
 	for range maxClaimAttempts {
-		job, lost, err := s.tryClaimOnce(ctx, workerID, leaseTTL)
+		job, lost, err := s.tryClaimOnce(ctx, workerID, clis, leaseTTL)
 		if err != nil {
 			return nil, err
 		}
@@ -120,7 +129,7 @@ func (s *GatewayStore) claimInTx(ctx context.Context, workerID string, leaseTTL
 	return nil, nil
 }
 
-func (s *GatewayStore) tryClaimOnce(ctx context.Context, workerID string, leaseTTL time.Duration) (*types.GatewayJob, bool, error) {
+func (s *GatewayStore) tryClaimOnce(ctx context.Context, workerID string, clis []types.GatewayCLI, leaseTTL time.Duration) (*types.GatewayJob, bool, error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return nil, false, fmt.Errorf("gateway: begin claim tx: %w", err)
@@ -132,8 +141,16 @@ func (s *GatewayStore) tryClaimOnce(ctx context.Context, workerID string, leaseT
 		}
 	}()
 
-	row, err := tx.GatewayJob.Query().
-		Where(gatewayjob.StatusEQ(string(types.GatewayJobPending))).
+	query := tx.GatewayJob.Query().
+		Where(gatewayjob.StatusEQ(string(types.GatewayJobPending)))
+	if len(clis) > 0 {
+		names := make([]string, len(clis))
+		for i, cli := range clis {
+			names[i] = string(cli)
+		}
+		query = query.Where(gatewayjob.CliIn(names...))
+	}
+	row, err := query.
 		Order(gen.Asc(gatewayjob.FieldCreatedAt)).
 		First(ctx)
 	if err != nil {
@@ -255,6 +272,136 @@ func (s *GatewayStore) applyComplete(ctx context.Context, row *gen.GatewayJob, i
 	return s.Get(ctx, row.JobID)
 }
 
+// AppendLog stores one streamed output chunk for a running job and returns it
+// with its seq. Once maxLogBytes is reached the chunk is dropped and nil is
+// returned; maxLogBytes <= 0 disables the cap.
+func (s *GatewayStore) AppendLog(ctx context.Context, jobID string, in types.GatewayLogAppendRequest, maxLogBytes int) (*types.GatewayLogChunk, error) {
+	if !s.ready() {
+		return nil, types.ErrUnavailable
+	}
+	jobID = strings.TrimSpace(jobID)
+	if jobID == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "job_id is required")
+	}
+	if in.Content == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "content is required")
+	}
+	row, err := s.client.GatewayJob.Query().Where(gatewayjob.JobIDEQ(jobID)).Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, types.Errorf(types.ErrNotFound, "job not found")
+		}
+		return nil, fmt.Errorf("gateway: get for log: %w", err)
+	}
+	if err := validateCompleteRow(row, in.WorkerID); err != nil {
+		if errors.Is(err, errAlreadyCanceled) {
+			return nil, types.Errorf(types.ErrConflict, "job is canceled")
+		}
+		return nil, err
+	}
+	content := in.Content
+	if maxLogBytes > 0 {
+		remaining := int64(maxLogBytes) - row.LogBytes
+		if remaining <= 0 {
+			return nil, nil
+		}
+		content, _ = truncateBytes(content, int(remaining))
+	}
+	return s.appendLogInTx(ctx, row.ID, jobID, content)
+}
+
+func (s *GatewayStore) appendLogInTx(ctx context.Context, id int64, jobID, content string) (*types.GatewayLogChunk, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: begin log tx: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+	updated, err := tx.GatewayJob.UpdateOneID(id).
+		Where(gatewayjob.StatusEQ(string(types.GatewayJobRunning))).
+		AddLogSeq(1).
+		AddLogBytes(int64(len(content))).
+		Save(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, types.Errorf(types.ErrConflict, "job no longer running")
+		}
+		return nil, fmt.Errorf("gateway: bump log seq: %w", err)
+	}
+	row, err := tx.GatewayJobLog.Create().
+		SetJobID(jobID).
+		SetSeq(updated.LogSeq).
+		SetContent(content).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: append log: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("gateway: commit log: %w", err)
+	}
+	committed = true
+	return gatewayLogFromEnt(row), nil
+}
+
+// Logs returns up to limit chunks of jobID with seq greater than afterSeq, oldest first.
+func (s *GatewayStore) Logs(ctx context.Context, jobID string, afterSeq int64, limit int) ([]types.GatewayLogChunk, error) {
+	if !s.ready() {
+		return nil, types.ErrUnavailable
+	}
+	jobID = strings.TrimSpace(jobID)
+	if jobID == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "job_id is required")
+	}
+	if limit <= 0 {
+		limit = defaultGatewayLogPage
+	}
+	limit = min(limit, maxGatewayLogPage)
+	rows, err := s.client.GatewayJobLog.Query().
+		Where(gatewayjoblog.JobIDEQ(jobID), gatewayjoblog.SeqGT(afterSeq)).
+		Order(gen.Asc(gatewayjoblog.FieldSeq)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: list logs: %w", err)
+	}
+	out := make([]types.GatewayLogChunk, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, *gatewayLogFromEnt(row))
+	}
+	return out, nil
+}
+
+// DeleteLogsFinishedBefore deletes the streamed log chunks of jobs that reached
+// a terminal status before cutoff. The job rows keep their saved output.
+// Returns the number of deleted chunks.
+func (s *GatewayStore) DeleteLogsFinishedBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	if !s.ready() {
+		return 0, types.ErrUnavailable
+	}
+	n, err := s.client.GatewayJobLog.Delete().
+		Where(func(sel *sql.Selector) {
+			finished := sql.Select(gatewayjob.FieldJobID).
+				From(sql.Table(gatewayjob.Table)).
+				Where(sql.And(
+					sql.In(gatewayjob.FieldStatus,
+						string(types.GatewayJobSucceeded),
+						string(types.GatewayJobFailed),
+						string(types.GatewayJobCanceled)),
+					sql.LT(gatewayjob.FieldFinishedAt, cutoff),
+				))
+			sel.Where(sql.In(sel.C(gatewayjoblog.FieldJobID), finished))
+		}).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("gateway: delete finished logs: %w", err)
+	}
+	return n, nil
+}
+
 // Cancel marks a non-terminal job as canceled.
 func (s *GatewayStore) Cancel(ctx context.Context, jobID string) (*types.GatewayJob, error) {
 	if !s.ready() {
@@ -397,6 +544,7 @@ func gatewayJobFromEnt(row *gen.GatewayJob) *types.GatewayJob {
 		Error:      row.ErrorText,
 		DurationMs: row.DurationMs,
 		WorkerID:   row.WorkerID,
+		LogSeq:     row.LogSeq,
 		CreatedAt:  row.CreatedAt,
 		ClaimedAt:  row.ClaimedAt,
 		FinishedAt: row.FinishedAt,
@@ -409,6 +557,15 @@ func gatewayJobFromEnt(row *gen.GatewayJob) *types.GatewayJob {
 	return j
 }
 
+func gatewayLogFromEnt(row *gen.GatewayJobLog) *types.GatewayLogChunk {
+	return &types.GatewayLogChunk{
+		JobID:     row.JobID,
+		Seq:       row.Seq,
+		Content:   row.Content,
+		CreatedAt: row.CreatedAt,
+	}
+}
+
 func truncateBytes(s string, maxBytes int) (string, bool) {
 	if maxBytes <= 0 || len(s) <= maxBytes {
 		return s, false
@@ -25,14 +25,14 @@ func TestGatewayStoreCreateClaimComplete(t *testing.T) {
 	require.NotNil(t, job)
 	assert.Equal(t, types.GatewayJobPending, job.Status)
 
-	claimed, err := s.Claim(ctx, "worker-a", time.Minute)
+	claimed, err := s.Claim(ctx, "worker-a", nil, time.Minute)
 	require.NoError(t, err)
 	require.NotNil(t, claimed)
 	assert.Equal(t, job.JobID, claimed.JobID)
 	assert.Equal(t, types.GatewayJobRunning, claimed.Status)
 	assert.Equal(t, "worker-a", claimed.WorkerID)
 
-	empty, err := s.Claim(ctx, "worker-b", time.Minute)
+	empty, err := s.Claim(ctx, "worker-b", nil, time.Minute)
 	require.NoError(t, err)
 	assert.Nil(t, empty)
 
@@ -47,15 +47,45 @@ func TestGatewayStoreCreateClaimComplete(t *testing.T) {
 	assert.Equal(t, int64(12), done.DurationMs)
 }
 
-func TestGatewayStoreRejectOpenCode(t *testing.T) {
+func TestGatewayStoreCreateValidatesCLI(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	s := NewGatewayStore(sqlitetest.OpenClient(t, "gateway_opencode"))
-	_, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLIOpenCode, Prompt: "x"})
+	s := NewGatewayStore(sqlitetest.OpenClient(t, "gateway_cli"))
+
+	job, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLIOpenCode, Prompt: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, types.GatewayCLIOpenCode, job.CLI)
+
+	_, err = s.Create(ctx, types.GatewayCreateJob{CLI: "Not A CLI", Prompt: "x"})
 	require.Error(t, err)
 	assert.ErrorIs(t, err, types.ErrInvalidArgument)
 }
 
+func TestGatewayStoreClaimFiltersByCLI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewGatewayStore(sqlitetest.OpenClient(t, "gateway_claim_cli"))
+
+	cursorJob, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLICursor, Prompt: "a"})
+	require.NoError(t, err)
+	genericJob, err := s.Create(ctx, types.GatewayCreateJob{CLI: "aider", Prompt: "b"})
+	require.NoError(t, err)
+
+	claimed, err := s.Claim(ctx, "w-aider", []types.GatewayCLI{"aider"}, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, genericJob.JobID, claimed.JobID, "older cursor job must be skipped")
+
+	none, err := s.Claim(ctx, "w-opencode", []types.GatewayCLI{types.GatewayCLIOpenCode}, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, none)
+
+	claimed, err = s.Claim(ctx, "w-any", nil, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, cursorJob.JobID, claimed.JobID)
+}
+
 func TestGatewayStoreCancelAndLeaseReclaim(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -63,7 +93,7 @@ func TestGatewayStoreCancelAndLeaseReclaim(t *testing.T) {
 
 	job, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLICursor, Prompt: "long"})
 	require.NoError(t, err)
-	claimed, err := s.Claim(ctx, "w1", time.Millisecond)
+	claimed, err := s.Claim(ctx, "w1", nil, time.Millisecond)
 	require.NoError(t, err)
 	require.NotNil(t, claimed)
 
@@ -75,7 +105,7 @@ func TestGatewayStoreCancelAndLeaseReclaim(t *testing.T) {
 	assert.Equal(t, types.GatewayJobPending, got.Status)
 	assert.Empty(t, got.WorkerID)
 
-	reclaimed, err := s.Claim(ctx, "w2", time.Minute)
+	reclaimed, err := s.Claim(ctx, "w2", nil, time.Minute)
 	require.NoError(t, err)
 	require.NotNil(t, reclaimed)
 	assert.Equal(t, job.JobID, reclaimed.JobID)
@@ -119,7 +149,7 @@ func TestGatewayStoreCompleteTruncated(t *testing.T) {
 	s := NewGatewayStore(sqlitetest.OpenClient(t, "gateway_trunc"))
 	job, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLICursor, Prompt: "p"})
 	require.NoError(t, err)
-	_, err = s.Claim(ctx, "w1", time.Minute)
+	_, err = s.Claim(ctx, "w1", nil, time.Minute)
 	require.NoError(t, err)
 	code := 0
 	done, err := s.Complete(ctx, job.JobID, types.GatewayCompleteRequest{
@@ -129,3 +159,92 @@ func TestGatewayStoreCompleteTruncated(t *testing.T) {
 	assert.True(t, done.Truncated)
 	assert.Equal(t, "ab...", done.Output)
 }
+
+func TestGatewayStoreAppendAndListLogs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewGatewayStore(sqlitetest.OpenClient(t, "gateway_logs"))
+
+	job, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLICursor, Prompt: "x"})
+	require.NoError(t, err)
+
+	_, err = s.AppendLog(ctx, job.JobID, types.GatewayLogAppendRequest{WorkerID: "w1", Content: "early"}, 0)
+	require.ErrorIs(t, err, types.ErrConflict, "pending jobs take no logs")
+
+	_, err = s.Claim(ctx, "w1", nil, time.Minute)
+	require.NoError(t, err)
+
+	_, err = s.AppendLog(ctx, job.JobID, types.GatewayLogAppendRequest{WorkerID: "w2", Content: "x"}, 0)
+	require.ErrorIs(t, err, types.ErrForbidden)
+
+	for _, content := range []string{"one\n", "two\n", "three\n"} {
+		_, err := s.AppendLog(ctx, job.JobID, types.GatewayLogAppendRequest{WorkerID: "w1", Content: content}, 12)
+		require.NoError(t, err)
+	}
+	dropped, err := s.AppendLog(ctx, job.JobID, types.GatewayLogAppendRequest{WorkerID: "w1", Content: "four\n"}, 12)
+	require.NoError(t, err)
+	assert.Nil(t, dropped)
+
+	all, err := s.Logs(ctx, job.JobID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, []int64{1, 2, 3}, []int64{all[0].Seq, all[1].Seq, all[2].Seq})
+	assert.Equal(t, "one\n", all[0].Content)
+	assert.Equal(t, "t...", all[2].Content, "third chunk is cut to the remaining budget")
+
+	tail, err := s.Logs(ctx, job.JobID, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, tail, 1)
+	assert.Equal(t, int64(3), tail[0].Seq)
+
+	cur, err := s.Get(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), cur.LogSeq)
+
+	_, err = s.Cancel(ctx, job.JobID)
+	require.NoError(t, err)
+	_, err = s.AppendLog(ctx, job.JobID, types.GatewayLogAppendRequest{WorkerID: "w1", Content: "late"}, 0)
+	require.ErrorIs(t, err, types.ErrConflict)
+}
+
+func TestGatewayStoreDeleteLogsFinishedBefore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewGatewayStore(sqlitetest.OpenClient(t, "gateway_log_purge"))
+
+	startWithLog := func(worker string) *types.GatewayJob {
+		job, err := s.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLICursor, Prompt: worker})
+		require.NoError(t, err)
+		claimed, err := s.Claim(ctx, worker, nil, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, job.JobID, claimed.JobID)
+		_, err = s.AppendLog(ctx, job.JobID, types.GatewayLogAppendRequest{WorkerID: worker, Content: "line\n"}, 0)
+		require.NoError(t, err)
+		return job
+	}
+	done := startWithLog("w1")
+	_, err := s.Complete(ctx, done.JobID, types.GatewayCompleteRequest{
+		WorkerID: "w1", Status: types.GatewayJobSucceeded, Output: "ok",
+	}, 100)
+	require.NoError(t, err)
+	running := startWithLog("w2")
+
+	n, err := s.DeleteLogsFinishedBefore(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, n, "a recently finished job keeps its logs")
+
+	n, err = s.DeleteLogsFinishedBefore(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	logs, err := s.Logs(ctx, done.JobID, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+	job, err := s.Get(ctx, done.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", job.Output, "the saved output outlives the logs")
+
+	logs, err = s.Logs(ctx, running.JobID, 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, logs, 1, "a running job keeps its logs")
+}
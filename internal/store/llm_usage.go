@@ -3,6 +3,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"slices"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/llmusagerecord"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/predicate"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
 
@@ -52,12 +54,17 @@ func (s *LLMUsageStore) RecordLLMUsage(ctx context.Context, record *types.LLMUsa
 		SetUID(record.UID).
 		SetSessionID(record.SessionID).
 		SetModel(record.Model).
+		SetProvider(record.Provider).
 		SetPromptTokens(record.PromptTokens).
 		SetCompletionTokens(record.CompletionTokens).
 		SetTotalTokens(record.TotalTokens).
 		SetCacheRead(record.CacheRead).
 		SetCacheWrite(record.CacheWrite).
 		SetSource(source).
+		SetAgent(record.Agent).
+		SetTaskID(record.TaskID).
+		SetPipelineStep(record.PipelineStep).
+		SetCost(record.Cost).
 		SetCreatedAt(time.Now().UTC()).
 		Save(ctx)
 	if err != nil {
@@ -66,6 +73,44 @@ func (s *LLMUsageStore) RecordLLMUsage(ctx context.Context, record *types.LLMUsa
 	return nil
 }
 
+// SumLLMUsage totals tokens and estimated cost of rows matching filter.
+func (s *LLMUsageStore) SumLLMUsage(ctx context.Context, filter types.LLMUsageFilter) (types.LLMUsageTotal, error) {
+	if s == nil || s.client == nil {
+		return types.LLMUsageTotal{}, errors.New("store: llm usage store unavailable")
+	}
+	preds := []predicate.LLMUsageRecord{llmusagerecord.CreatedAtGTE(filter.Since)}
+	if filter.UID != "" {
+		preds = append(preds, llmusagerecord.UIDEQ(filter.UID))
+	}
+	if filter.Agent != "" {
+		preds = append(preds, llmusagerecord.AgentEQ(filter.Agent))
+	}
+	if filter.TaskID != "" {
+		preds = append(preds, llmusagerecord.TaskIDEQ(filter.TaskID))
+	}
+	if filter.PipelineStep != "" {
+		preds = append(preds, llmusagerecord.PipelineStepEQ(filter.PipelineStep))
+	}
+	var sums []struct {
+		Tokens sql.NullFloat64 `json:"tokens"`
+		Cost   sql.NullFloat64 `json:"cost"`
+	}
+	err := s.client.LLMUsageRecord.Query().
+		Where(preds...).
+		Aggregate(
+			gen.As(gen.Sum(llmusagerecord.FieldTotalTokens), "tokens"),
+			gen.As(gen.Sum(llmusagerecord.FieldCost), "cost"),
+		).
+		Scan(ctx, &sums)
+	if err != nil {
+		return types.LLMUsageTotal{}, fmt.Errorf("store: sum llm usage: %w", err)
+	}
+	if len(sums) == 0 {
+		return types.LLMUsageTotal{}, nil
+	}
+	return types.LLMUsageTotal{Tokens: int64(sums[0].Tokens.Float64), Cost: sums[0].Cost.Float64}, nil
+}
+
 // TokenUsageStats aggregates usage for charts filtered by user and time range.
 func (s *LLMUsageStore) TokenUsageStats(ctx context.Context, uid string, since, until time.Time, groupBy string) (*types.TokenUsageStats, error) {
 	if s == nil || s.client == nil {
@@ -317,6 +317,7 @@ func (s *NotifyConfigStore) CreateNotifyRule(ctx context.Context, rule model.Not
 		SetEventPattern(rule.EventPattern).
 		SetChannelPattern(rule.ChannelPattern).
 		SetNillableCondition(nilString(rule.Condition)).
+		SetTimezone(rule.Timezone).
 		SetPriority(rule.Priority).
 		SetParams(params).
 		SetEnabled(rule.Enabled).
@@ -379,6 +380,7 @@ func (s *NotifyConfigStore) UpdateNotifyRule(ctx context.Context, id int64, rule
 		SetEventPattern(rule.EventPattern).
 		SetChannelPattern(rule.ChannelPattern).
 		SetNillableCondition(nilString(rule.Condition)).
+		SetTimezone(rule.Timezone).
 		SetPriority(rule.Priority).
 		SetParams(params).
 		SetEnabled(rule.Enabled).
@@ -415,6 +417,7 @@ func notifyRuleToModel(r *gen.NotifyRule) (model.NotifyRule, error) {
 		EventPattern:   r.EventPattern,
 		ChannelPattern: r.ChannelPattern,
 		Condition:      r.Condition,
+		Timezone:       r.Timezone,
 		Priority:       r.Priority,
 		ParamsJSON:     paramsJSON,
 		Enabled:        r.Enabled,
@@ -19,6 +19,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/resourcelink"
 	"github.com/flowline-io/flowbot/internal/store/ent/schema"
 	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 )
 
@@ -64,16 +65,46 @@ func (s *PipelineStore) UpdateRunStatus(ctx context.Context, runID int64, status
 	if s == nil || s.client == nil {
 		return nil
 	}
-	upd := s.client.PipelineRun.UpdateOneID(runID).
-		SetStatus(int(status)).
-		SetCompletedAt(time.Now())
-	if errMsg != "" {
-		upd = upd.SetError(errMsg)
-	}
+	upd := s.client.PipelineRun.UpdateOneID(runID)
+	setPipelineRunStatus(upd.Mutation(), status, errMsg)
 	_, err := upd.Save(ctx)
 	return err
 }
 
+// SwapRunStatus sets the run status only while it is one of from, so callers
+// racing on the same transition cannot both win. It reports whether the run
+// was updated.
+func (s *PipelineStore) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	if s == nil || s.client == nil {
+		return true, nil
+	}
+	upd := s.client.PipelineRun.Update().
+		Where(pipelinerun.ID(runID), pipelinerun.StatusIn(from...))
+	setPipelineRunStatus(upd.Mutation(), to, errMsg)
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func setPipelineRunStatus(m *gen.PipelineRunMutation, status int, errMsg string) {
+	m.SetStatus(status)
+	// A resumed or retried run goes back to started and must not keep the
+	// completion time and error of its previous attempt. Its heartbeat is
+	// renewed so the new attempt does not look abandoned.
+	if status == int(schema.PipelineStart) {
+		m.ClearCompletedAt()
+		m.ClearError()
+		m.SetLastHeartbeat(time.Now())
+	} else {
+		m.SetCompletedAt(time.Now())
+	}
+	if errMsg != "" {
+		m.SetError(errMsg)
+	}
+}
+
 func (s *PipelineStore) CreateStepRun(ctx context.Context, runID int64, stepName, capability, operation string, params map[string]any, attempt int) (*gen.PipelineStepRun, error) {
 	if s == nil || s.client == nil {
 		return nil, nil
@@ -103,7 +134,8 @@ func (s *PipelineStore) UpdateStepRun(ctx context.Context, stepRunID int64, stat
 	upd := s.client.PipelineStepRun.UpdateOneID(stepRunID).
 		SetStatus(int(status)).
 		SetAttempt(attempt)
-	if status == int(schema.PipelineDone) || status == int(schema.PipelineCancel) {
+	switch status {
+	case int(schema.PipelineDone), int(schema.PipelineCancel), int(schema.PipelineSkipped), int(schema.PipelineCompensated):
 		now := time.Now()
 		upd = upd.SetCompletedAt(now)
 	}
@@ -187,6 +219,29 @@ func (s *PipelineStore) GetIncompleteRuns(ctx context.Context) ([]*gen.PipelineR
 	return runs, nil
 }
 
+// SetRunParent links a run started by a call step to the calling run.
+func (s *PipelineStore) SetRunParent(ctx context.Context, runID int64, parent runcall.Parent) error {
+	if s == nil || s.client == nil {
+		return nil
+	}
+	return s.client.PipelineRun.UpdateOneID(runID).
+		SetParentKind(parent.Kind).
+		SetParentRunID(parent.RunID).
+		SetCallDepth(parent.Depth).
+		Exec(ctx)
+}
+
+// GetWaitingRuns returns pipeline runs suspended at an approval gate.
+func (s *PipelineStore) GetWaitingRuns(ctx context.Context) ([]*gen.PipelineRun, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	return s.client.PipelineRun.Query().
+		Where(pipelinerun.Status(int(schema.PipelineWaiting))).
+		Order(pipelinerun.ByCreatedAt()).
+		All(ctx)
+}
+
 // GetCheckpoint loads the checkpoint data for a pipeline run.
 func (s *PipelineStore) GetCheckpoint(ctx context.Context, runID int64, target any) error {
 	if s == nil || s.client == nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 )
 
@@ -35,6 +36,11 @@ func (a PipelineRunStoreAdapter) UpdateRunStatus(ctx context.Context, runID int6
 	return a.S.UpdateRunStatus(ctx, runID, status, errMsg)
 }
 
+// SwapRunStatus implements pipeline.RunStore.
+func (a PipelineRunStoreAdapter) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	return a.S.SwapRunStatus(ctx, runID, from, to, errMsg)
+}
+
 // CreateStepRun implements pipeline.RunStore.
 func (a PipelineRunStoreAdapter) CreateStepRun(ctx context.Context, runID int64, stepName, capName, operation string, params map[string]any, attempt int) (*model.PipelineStepRun, error) {
 	row, err := a.S.CreateStepRun(ctx, runID, stepName, capName, operation, params, attempt)
@@ -63,6 +69,20 @@ func (a PipelineRunStoreAdapter) GetIncompleteRuns(ctx context.Context) ([]*mode
 	return mapPipelineRunDTOs(rows), nil
 }
 
+// SetRunParent implements pipeline.RunStore.
+func (a PipelineRunStoreAdapter) SetRunParent(ctx context.Context, runID int64, parent runcall.Parent) error {
+	return a.S.SetRunParent(ctx, runID, parent)
+}
+
+// GetWaitingRuns implements pipeline.RunStore.
+func (a PipelineRunStoreAdapter) GetWaitingRuns(ctx context.Context) ([]*model.PipelineRun, error) {
+	rows, err := a.S.GetWaitingRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapPipelineRunDTOs(rows), nil
+}
+
 // GetCheckpoint implements pipeline.RunStore.
 func (a PipelineRunStoreAdapter) GetCheckpoint(ctx context.Context, runID int64, target any) error {
 	return a.S.GetCheckpoint(ctx, runID, target)
@@ -208,6 +228,10 @@ func mapPipelineRunDTO(row *gen.PipelineRun) *model.PipelineRun {
 		CreatedAt:     row.CreatedAt,
 		StartedAt:     row.StartedAt,
 		CompletedAt:   row.CompletedAt,
+		LastHeartbeat: row.LastHeartbeat,
+		ParentKind:    row.ParentKind,
+		ParentRunID:   row.ParentRunID,
+		CallDepth:     row.CallDepth,
 	}
 }
 
@@ -8,7 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/dataevent"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/resourcelink"
@@ -29,6 +31,22 @@ func NewResourceChainStore(client *gen.Client) *ResourceChainStore {
 	return &ResourceChainStore{client: client}
 }
 
+// dataEventTagEQ matches events whose tags map key to value. Postgres uses
+// jsonb containment so the GIN index on tags applies; other dialects compare
+// the extracted JSON value.
+func dataEventTagEQ(key, value string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if s.Dialect() == dialect.Postgres {
+			tagJSON := fmt.Sprintf(`{%q:%q}`, key, value)
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(s.C(dataevent.FieldTags)).WriteString(" @> ").Arg(tagJSON)
+			}))
+			return
+		}
+		s.Where(sqljson.ValueEQ(s.C(dataevent.FieldTags), value, sqljson.Path(key)))
+	}
+}
+
 // FindResourcesByTag returns DataEvents matching a tag key-value pair,
 // ordered by created_at descending. Supports limit + opaque cursor pagination.
 func (s *ResourceChainStore) FindResourcesByTag(ctx context.Context, key, value string, limit int, cursor string) ([]*gen.DataEvent, string, error) {
@@ -39,11 +57,8 @@ func (s *ResourceChainStore) FindResourcesByTag(ctx context.Context, key, value
 		limit = 20
 	}
 
-	tagJSON := fmt.Sprintf(`{%q:%q}`, key, value)
 	q := s.client.DataEvent.Query().
-		Where(func(selector *sql.Selector) {
-			selector.Where(sql.ExprP("tags @> $1", tagJSON))
-		}).
+		Where(dataEventTagEQ(key, value)).
 		Order(dataevent.ByCreatedAt(sql.OrderDesc())).
 		Limit(limit + 1)
 
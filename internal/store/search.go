@@ -0,0 +1,265 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
+	"github.com/flowline-io/flowbot/internal/store/ent/schema"
+	"github.com/flowline-io/flowbot/pkg/search"
+)
+
+// ---------------------------------------------------------------------------
+// SearchStore
+// ---------------------------------------------------------------------------
+
+// SearchStore persists the unified search index. It implements search.Store.
+type SearchStore struct {
+	client *gen.Client
+}
+
+// NewSearchStore creates a SearchStore with the given ent client.
+func NewSearchStore(client *gen.Client) *SearchStore {
+	return &SearchStore{client: client}
+}
+
+// SearchStoreFromDB returns a SearchStore using the global database client.
+func SearchStoreFromDB() *SearchStore {
+	return NewSearchStore(ClientFromDB())
+}
+
+var _ search.Store = (*SearchStore)(nil)
+
+// UpsertSearchDocuments inserts documents or replaces them by (source, doc_id).
+func (s *SearchStore) UpsertSearchDocuments(ctx context.Context, docs []search.Document) error {
+	if s == nil || s.client == nil || len(docs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	builders := make([]*gen.SearchDocumentCreate, 0, len(docs))
+	for _, d := range docs {
+		tags := d.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		updated := d.UpdatedAt
+		if updated.IsZero() {
+			updated = now
+		}
+		builders = append(builders, s.client.SearchDocument.Create().
+			SetSource(d.Source).
+			SetDocID(d.ID).
+			SetUID(d.UID).
+			SetTitle(d.Title).
+			SetContent(d.Content).
+			SetURL(d.URL).
+			SetTags(tags).
+			SetUpdatedAt(updated).
+			SetIndexedAt(now))
+	}
+	err := s.client.SearchDocument.CreateBulk(builders...).
+		OnConflictColumns(searchdocument.FieldSource, searchdocument.FieldDocID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres: upsert search documents: %w", err)
+	}
+	return nil
+}
+
+// DeleteSearchDocuments removes documents of source by ID.
+func (s *SearchStore) DeleteSearchDocuments(ctx context.Context, source string, ids []string) error {
+	if s == nil || s.client == nil || len(ids) == 0 {
+		return nil
+	}
+	_, err := s.client.SearchDocument.Delete().
+		Where(searchdocument.SourceEQ(source), searchdocument.DocIDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres: delete search documents: %w", err)
+	}
+	return nil
+}
+
+// PruneSearchDocuments deletes documents of source last indexed before t.
+func (s *SearchStore) PruneSearchDocuments(ctx context.Context, source string, before time.Time) (int, error) {
+	if s == nil || s.client == nil {
+		return 0, nil
+	}
+	n, err := s.client.SearchDocument.Delete().
+		Where(searchdocument.SourceEQ(source), searchdocument.IndexedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: prune search documents: %w", err)
+	}
+	return n, nil
+}
+
+// FindSearchDocuments returns documents matching every filter term, best
+// first, ranked by the database full-text index. Without terms it returns the
+// newest documents.
+func (s *SearchStore) FindSearchDocuments(ctx context.Context, f search.Filter) ([]search.Match, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	query := s.client.SearchDocument.Query().
+		Where(searchdocument.Or(searchdocument.UIDEQ(""), searchdocument.UIDEQ(f.UID)))
+	if len(f.Sources) > 0 {
+		query = query.Where(searchdocument.SourceIn(f.Sources...))
+	}
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+	var rows []searchMatchRow
+	err := query.
+		Select(
+			searchdocument.FieldSource, searchdocument.FieldDocID, searchdocument.FieldUID,
+			searchdocument.FieldTitle, searchdocument.FieldContent, searchdocument.FieldURL,
+			searchdocument.FieldTags, searchdocument.FieldUpdatedAt,
+		).
+		Modify(func(sel *entsql.Selector) { matchSearchDocuments(sel, f) }).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: find search documents: %w", err)
+	}
+	matches := make([]search.Match, 0, len(rows))
+	for _, row := range rows {
+		var tags []string
+		if row.Tags != "" {
+			if err := sonic.UnmarshalString(row.Tags, &tags); err != nil {
+				return nil, fmt.Errorf("postgres: decode search document tags: %w", err)
+			}
+		}
+		matches = append(matches, search.Match{
+			Document: search.Document{
+				Source:    row.Source,
+				ID:        row.DocID,
+				UID:       row.UID,
+				Title:     row.Title,
+				Content:   row.Content,
+				URL:       row.URL,
+				Tags:      tags,
+				UpdatedAt: row.UpdatedAt,
+			},
+			Rank: row.Rank,
+		})
+	}
+	return matches, nil
+}
+
+// searchMatchRow is a search_documents row with its full-text rank.
+type searchMatchRow struct {
+	Source    string    `sql:"source"`
+	DocID     string    `sql:"doc_id"`
+	UID       string    `sql:"uid"`
+	Title     string    `sql:"title"`
+	Content   string    `sql:"content"`
+	URL       string    `sql:"url"`
+	Tags      string    `sql:"tags"`
+	UpdatedAt time.Time `sql:"updated_at"`
+	Rank      float64   `sql:"rank"`
+}
+
+// matchSearchDocuments restricts sel to documents matching every filter term
+// as a word or word prefix, adds their rank and orders by it. Postgres ranks
+// with ts_rank_cd over the weighted search_vector; SQLite with bm25 over the
+// FTS5 table, negated so higher is better on both.
+func matchSearchDocuments(sel *entsql.Selector, f search.Filter) {
+	if len(f.Terms) == 0 {
+		sel.AppendSelectExprAs(entsql.Expr("0"), "rank")
+		sel.OrderBy(entsql.Desc(sel.C(searchdocument.FieldUpdatedAt)))
+		return
+	}
+	if sel.Dialect() == dialect.SQLite {
+		fts := entsql.Table(schema.SearchDocumentFTS)
+		sel.Join(fts).On(sel.C(searchdocument.FieldID), fts.C("rowid"))
+		// FTS5 exposes a hidden column named after the table for MATCH and
+		// its rank functions.
+		hidden := fts.C(schema.SearchDocumentFTS)
+		sel.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString(hidden + " MATCH ").Arg(ftsQuery(f))
+		}))
+		// Column weights follow the FTS5 column order: title, tags, url, content.
+		sel.AppendSelectExprAs(entsql.Expr("-bm25("+hidden+", 4.0, 3.0, 1.0, 1.0)"), "rank")
+	} else {
+		query := tsQuery(f)
+		vector := sel.C(schema.SearchDocumentVector)
+		sel.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString(vector + " @@ to_tsquery('simple', ").Arg(query).WriteString(")")
+		}))
+		sel.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+			b.WriteString("ts_rank_cd(" + vector + ", to_tsquery('simple', ").Arg(query).WriteString("))")
+		}), "rank")
+	}
+	sel.OrderExpr(entsql.Expr("rank DESC"))
+	sel.OrderBy(entsql.Desc(sel.C(searchdocument.FieldUpdatedAt)))
+}
+
+// tsQuery builds a Postgres tsquery requiring every term as a prefix;
+// weight A limits a title-only match to the title. Terms come from
+// search.Tokenize and hold only letters and digits.
+func tsQuery(f search.Filter) string {
+	suffix := ":*"
+	if f.TitleOnly {
+		suffix = ":*A"
+	}
+	parts := make([]string, 0, len(f.Terms))
+	for _, term := range f.Terms {
+		parts = append(parts, term+suffix)
+	}
+	return strings.Join(parts, " & ")
+}
+
+// ftsQuery builds an FTS5 query requiring every term as a prefix, limited to
+// the title column for a title-only match.
+func ftsQuery(f search.Filter) string {
+	parts := make([]string, 0, len(f.Terms))
+	for _, term := range f.Terms {
+		parts = append(parts, `"`+term+`"*`)
+	}
+	query := strings.Join(parts, " AND ")
+	if f.TitleOnly {
+		return "title : (" + query + ")"
+	}
+	return query
+}
+
+// MigrateSearchIndex creates the full-text index of search_documents for the
+// dialect after the ent schema migration. An FTS5 table created for existing
+// SQLite rows is rebuilt from them.
+func MigrateSearchIndex(ctx context.Context, client *gen.Client, dialectName string) error {
+	rebuild := false
+	if dialectName == dialect.SQLite {
+		rows, err := client.QueryContext(ctx, "SELECT count(*) FROM sqlite_master WHERE name = ?", schema.SearchDocumentFTS)
+		if err != nil {
+			return fmt.Errorf("store: inspect search index: %w", err)
+		}
+		var n int
+		if rows.Next() {
+			err = rows.Scan(&n)
+		}
+		_ = rows.Close()
+		if err != nil {
+			return fmt.Errorf("store: inspect search index: %w", err)
+		}
+		rebuild = n == 0
+	}
+	for _, stmt := range schema.SearchDocumentFullText(dialectName) {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("store: create search index: %w", err)
+		}
+	}
+	if rebuild {
+		if _, err := client.ExecContext(ctx, "INSERT INTO "+schema.SearchDocumentFTS+"("+schema.SearchDocumentFTS+") VALUES ('rebuild')"); err != nil {
+			return fmt.Errorf("store: rebuild search index: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store/sqlitetest"
+	"github.com/flowline-io/flowbot/pkg/search"
+)
+
+func searchDocIDs(docs []search.Match) []string {
+	ids := make([]string, 0, len(docs))
+	for _, d := range docs {
+		ids = append(ids, d.Source+":"+d.ID)
+	}
+	return ids
+}
+
+func TestSearchStoreFind(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewSearchStore(sqlitetest.OpenClient(t, "search_find"))
+	now := time.Now()
+	require.NoError(t, s.UpsertSearchDocuments(ctx, []search.Document{
+		{Source: search.SourceBookmark, ID: "b1", Title: "Go generics guide", URL: "https://go.dev/doc", UpdatedAt: now},
+		{Source: search.SourceMemo, ID: "memos/1", Content: "Read about GO channels", Tags: []string{"golang"}, UpdatedAt: now.Add(-time.Hour)},
+		{Source: search.SourceNote, ID: "n1", UID: "alice", Title: "Private go note", UpdatedAt: now.Add(-2 * time.Hour)},
+		{Source: search.SourceClip, ID: "c1", Title: "Rust notes", UpdatedAt: now},
+	}))
+
+	tests := []struct {
+		name   string
+		filter search.Filter
+		want   []string
+	}{
+		{name: "word prefix", filter: search.Filter{Terms: []string{"gener"}},
+			want: []string{"bookmark:b1"}},
+		{name: "uid sees its own documents", filter: search.Filter{Terms: []string{"private"}, UID: "alice"},
+			want: []string{"note:n1"}},
+		{name: "other uid does not", filter: search.Filter{Terms: []string{"private"}, UID: "bob"},
+			want: []string{}},
+		{name: "source filter", filter: search.Filter{Terms: []string{"go"}, Sources: []string{search.SourceMemo}},
+			want: []string{"memo:memos/1"}},
+		{name: "tags match", filter: search.Filter{Terms: []string{"golang"}},
+			want: []string{"memo:memos/1"}},
+		{name: "every term required", filter: search.Filter{Terms: []string{"go", "generics"}},
+			want: []string{"bookmark:b1"}},
+		{name: "title only", filter: search.Filter{Terms: []string{"channels"}, TitleOnly: true},
+			want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			docs, err := s.FindSearchDocuments(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, searchDocIDs(docs))
+		})
+	}
+}
+
+func TestSearchStoreFindRanks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewSearchStore(sqlitetest.OpenClient(t, "search_rank"))
+	now := time.Now()
+	require.NoError(t, s.UpsertSearchDocuments(ctx, []search.Document{
+		{Source: search.SourceNote, ID: "body", Title: "Weekly log", Content: "notes on kubernetes upgrades", UpdatedAt: now},
+		{Source: search.SourceNote, ID: "title", Title: "Kubernetes upgrades", UpdatedAt: now.AddDate(0, -3, 0)},
+		{Source: search.SourceNote, ID: "other", Title: "Cluster work", UpdatedAt: now},
+	}))
+
+	docs, err := s.FindSearchDocuments(ctx, search.Filter{Terms: []string{"kubernetes", "upgrades"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note:title", "note:body"}, searchDocIDs(docs))
+	assert.Greater(t, docs[0].Rank, docs[1].Rank)
+
+	docs, err = s.FindSearchDocuments(ctx, search.Filter{Terms: []string{"kubernetes"}, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note:title"}, searchDocIDs(docs))
+
+	docs, err = s.FindSearchDocuments(ctx, search.Filter{})
+	require.NoError(t, err)
+	require.Len(t, docs, 3)
+	assert.Equal(t, "title", docs[2].ID, "without terms, newest first")
+}
+
+func TestSearchStoreUpsertDeletePrune(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := NewSearchStore(sqlitetest.OpenClient(t, "search_upsert"))
+
+	require.NoError(t, s.UpsertSearchDocuments(ctx, []search.Document{
+		{Source: search.SourceClip, ID: "a", Title: "first"},
+		{Source: search.SourceClip, ID: "b", Title: "second"},
+		{Source: search.SourceMemo, ID: "a", Title: "memo"},
+	}))
+	require.NoError(t, s.UpsertSearchDocuments(ctx, []search.Document{
+		{Source: search.SourceClip, ID: "a", Title: "first edited", Tags: []string{"x"}},
+	}))
+	docs, err := s.FindSearchDocuments(ctx, search.Filter{Terms: []string{"edited"}})
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, []string{"x"}, docs[0].Tags)
+
+	require.NoError(t, s.DeleteSearchDocuments(ctx, search.SourceClip, []string{"b"}))
+	n, err := s.PruneSearchDocuments(ctx, search.SourceMemo, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	docs, err = s.FindSearchDocuments(ctx, search.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"clip:a"}, searchDocIDs(docs))
+}
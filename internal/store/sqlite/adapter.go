@@ -0,0 +1,192 @@
+// Package sqlite implements the SQLite storage adapter for single-binary
+// deployments. It uses the pure-Go modernc.org/sqlite driver.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/XSAM/otelsql"
+	"github.com/bytedance/sonic"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	_ "modernc.org/sqlite" //revive:disable:blank-imports sqlite driver registration
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/flog"
+)
+
+const (
+	adapterName = "sqlite"
+
+	defaultMaxResults        = 1024
+	defaultMaxMessageResults = 100
+
+	// SQLite serialises writers; a small pool keeps concurrent readers
+	// under WAL without piling up goroutines behind the write lock.
+	defaultMaxOpenConns = 4
+	defaultBusyTimeout  = 5000
+
+	pingTimeout = 10 * time.Second
+)
+
+type configType struct {
+	Path         string `json:"path,omitempty"`
+	MaxOpenConns int    `json:"max_open_conns,omitempty"`
+	BusyTimeout  int    `json:"busy_timeout,omitempty"`
+}
+
+// Init registers the sqlite adapter with the store layer.
+func Init() {
+	store.RegisterAdapter(&adapter{})
+}
+
+// DSN returns the modernc.org/sqlite data source name for a database file.
+// Every connection gets WAL journaling, synchronous=NORMAL, foreign keys and
+// the given busy timeout in milliseconds. Write transactions start with
+// BEGIN IMMEDIATE so a reader upgrading to a writer cannot deadlock, and
+// times are stored in UTC with a sortable layout so range queries compare
+// correctly as text.
+func DSN(path string, busyTimeout int) string {
+	if busyTimeout <= 0 {
+		busyTimeout = defaultBusyTimeout
+	}
+	q := url.Values{}
+	q.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout))
+	q.Add("_pragma", "journal_mode(WAL)")
+	q.Add("_pragma", "synchronous(NORMAL)")
+	q.Add("_pragma", "foreign_keys(1)")
+	q.Set("_txlock", "immediate")
+	q.Set("_time_format", "sqlite")
+	q.Set("_timezone", "UTC")
+	return "file:" + path + "?" + q.Encode()
+}
+
+type adapter struct {
+	client *gen.Client
+	db     *sql.DB
+
+	path       string
+	maxResults int
+	open       bool
+}
+
+func (a *adapter) Open(jsonConfig config.StoreType) error {
+	var conf configType
+	if c, ok := jsonConfig.Adapters[adapterName]; ok {
+		raw, err := sonic.Marshal(c)
+		if err != nil {
+			return fmt.Errorf("sqlite: marshal adapter config: %w", err)
+		}
+		if err := sonic.Unmarshal(raw, &conf); err != nil {
+			return fmt.Errorf("sqlite: unmarshal adapter config: %w", err)
+		}
+	}
+	if conf.Path == "" {
+		return fmt.Errorf("sqlite: path is required. Please set sqlite.path in flowbot.yaml")
+	}
+	if conf.MaxOpenConns <= 0 {
+		conf.MaxOpenConns = defaultMaxOpenConns
+	}
+
+	if dir := filepath.Dir(conf.Path); dir != "" {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("sqlite: create data directory: %w", err)
+		}
+	}
+
+	db, err := otelsql.Open("sqlite", DSN(conf.Path, conf.BusyTimeout),
+		otelsql.WithAttributes(semconv.DBSystemSqlite),
+	)
+	if err != nil {
+		return fmt.Errorf("sqlite: open db: %w", err)
+	}
+	db.SetMaxOpenConns(conf.MaxOpenConns)
+	db.SetMaxIdleConns(conf.MaxOpenConns)
+
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return fmt.Errorf("sqlite: ping db: %w", err)
+	}
+	var journalMode string
+	if err := db.QueryRowContext(ctx, "PRAGMA journal_mode").Scan(&journalMode); err != nil {
+		_ = db.Close()
+		return fmt.Errorf("sqlite: read journal mode: %w", err)
+	}
+	if !strings.EqualFold(journalMode, "wal") {
+		flog.Warn("sqlite: journal_mode is %q, not wal; concurrent readers will block on writes", journalMode)
+	}
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	a.client = gen.NewClient(gen.Driver(drv))
+
+	a.db = db
+	a.path = conf.Path
+	a.maxResults = jsonConfig.MaxResults
+	if a.maxResults <= 0 {
+		a.maxResults = defaultMaxResults
+	}
+	store.SetQueryLimits(a.maxResults, defaultMaxMessageResults)
+	a.open = true
+
+	flog.Info("sqlite: adapter opened with database '%s'", a.path)
+	return nil
+}
+
+func (a *adapter) Close() error {
+	if a.db != nil {
+		// Refresh query planner statistics before the last connection goes away.
+		if _, err := a.db.Exec("PRAGMA optimize"); err != nil {
+			flog.Warn("sqlite: optimize on close: %v", err)
+		}
+		if err := a.db.Close(); err != nil {
+			return fmt.Errorf("sqlite: close db: %w", err)
+		}
+	}
+	a.open = false
+	return nil
+}
+
+func (a *adapter) IsOpen() bool {
+	return a.open
+}
+
+func (*adapter) GetName() string {
+	return adapterName
+}
+
+func (a *adapter) Stats() any {
+	if a.db != nil {
+		return a.db.Stats()
+	}
+	return nil
+}
+
+func (a *adapter) GetClient() *gen.Client {
+	return a.client
+}
+
+// Ping checks that the SQLite file is reachable and returns the round-trip latency.
+func (a *adapter) Ping(ctx context.Context) (time.Duration, error) {
+	if a.db == nil {
+		return 0, fmt.Errorf("sqlite: database not initialized")
+	}
+	start := time.Now()
+	err := a.db.PingContext(ctx)
+	return time.Since(start), err
+}
+
+func (a *adapter) GetDB() any {
+	return a.client
+}
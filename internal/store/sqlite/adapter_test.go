@@ -0,0 +1,188 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/internal/store"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	_ "github.com/flowline-io/flowbot/internal/store/ent/gen/runtime"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/search"
+)
+
+// openTestAdapter opens path and migrates it the way store.Migrate does.
+func openTestAdapter(t *testing.T, path string) *adapter {
+	t.Helper()
+	a := openUnmigratedAdapter(t, path)
+	require.NoError(t, a.GetClient().Schema.Create(context.Background()))
+	require.NoError(t, store.MigrateSearchIndex(context.Background(), a.GetClient(), dialect.SQLite))
+	return a
+}
+
+func openUnmigratedAdapter(t *testing.T, path string) *adapter {
+	t.Helper()
+	a := &adapter{}
+	require.NoError(t, a.Open(config.StoreType{
+		UseAdapter: adapterName,
+		Adapters:   map[string]any{adapterName: map[string]any{"path": path}},
+	}))
+	t.Cleanup(func() { _ = a.Close() })
+	return a
+}
+
+func TestDSN(t *testing.T) {
+	t.Parallel()
+	dsn := DSN("/data/flowbot.db", 0)
+	assert.True(t, strings.HasPrefix(dsn, "file:/data/flowbot.db?"))
+	for _, want := range []string{
+		"busy_timeout%285000%29",
+		"journal_mode%28WAL%29",
+		"synchronous%28NORMAL%29",
+		"foreign_keys%281%29",
+		"_txlock=immediate",
+		"_time_format=sqlite",
+		"_timezone=UTC",
+	} {
+		assert.Contains(t, dsn, want)
+	}
+	assert.Contains(t, DSN("x.db", 250), "busy_timeout%28250%29")
+}
+
+func TestOpenRequiresPath(t *testing.T) {
+	t.Parallel()
+	err := (&adapter{}).Open(config.StoreType{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sqlite.path")
+}
+
+func TestAdapterOpenMigrate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "nested", "flowbot.db")
+	a := openTestAdapter(t, path)
+
+	assert.True(t, a.IsOpen())
+	assert.Equal(t, "sqlite", a.GetName())
+	_, err := a.Ping(ctx)
+	require.NoError(t, err)
+
+	db := a.db
+	var mode string
+	require.NoError(t, db.QueryRowContext(ctx, "PRAGMA journal_mode").Scan(&mode))
+	assert.Equal(t, "wal", strings.ToLower(mode))
+	var fk int
+	require.NoError(t, db.QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&fk))
+	assert.Equal(t, 1, fk)
+
+	_, err = a.GetClient().User.Query().Count(ctx)
+	require.NoError(t, err)
+
+	// Migrating an existing file again is a no-op.
+	require.NoError(t, a.Close())
+	openTestAdapter(t, path)
+}
+
+func TestSearchIndexOnSQLite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "flowbot.db")
+	a := openUnmigratedAdapter(t, path)
+	client := a.GetClient()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	// Documents written before the index exists are picked up by the rebuild.
+	docs := store.NewSearchStore(client)
+	require.NoError(t, docs.UpsertSearchDocuments(ctx, []search.Document{
+		{Source: search.SourceNote, ID: "old", Title: "Kubernetes upgrades"},
+	}))
+	require.NoError(t, store.MigrateSearchIndex(ctx, client, dialect.SQLite))
+	require.NoError(t, store.MigrateSearchIndex(ctx, client, dialect.SQLite))
+
+	require.NoError(t, docs.UpsertSearchDocuments(ctx, []search.Document{
+		{Source: search.SourceNote, ID: "new", Title: "Weekly log", Content: "kubernetes notes"},
+	}))
+	found, err := docs.FindSearchDocuments(ctx, search.Filter{Terms: []string{"kube"}})
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, "old", found[0].ID, "a title match outranks a content match")
+
+	// Reopening runs the ent migration over the FTS5 tables.
+	require.NoError(t, a.Close())
+	openTestAdapter(t, path)
+}
+
+// createEvents inserts n data events one minute apart, newest last.
+func createEvents(t *testing.T, client *gen.Client, base time.Time, n int) {
+	t.Helper()
+	for i := range n {
+		_, err := client.DataEvent.Create().
+			SetEventID(fmt.Sprintf("evt-%02d", i)).
+			SetEventType("bookmark.created").
+			SetSource("karakeep").
+			SetData(map[string]any{"title": fmt.Sprintf("Item %d", i)}).
+			SetTags(map[string]any{"parity": []string{"even", "odd"}[i%2]}).
+			// Local offsets must not break text ordering of stored times.
+			SetCreatedAt(base.Add(time.Duration(i) * time.Minute).In(time.FixedZone("UTC+9", 9*3600))).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func TestEventQueriesOnSQLite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	a := openTestAdapter(t, filepath.Join(t.TempDir(), "flowbot.db"))
+	client := a.GetClient()
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	createEvents(t, client, base, 5)
+
+	events := store.NewEventStore(client)
+
+	// Cursor pagination walks newest to oldest without gaps.
+	var seen []string
+	cursor := ""
+	for {
+		page, next, err := events.ListDataEvents(ctx, store.ListDataEventsOptions{Limit: 2, Cursor: cursor})
+		require.NoError(t, err)
+		for _, e := range page {
+			seen = append(seen, e.EventID)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	assert.Equal(t, []string{"evt-04", "evt-03", "evt-02", "evt-01", "evt-00"}, seen)
+
+	start := base.Add(time.Minute)
+	end := base.Add(3 * time.Minute)
+	ranged, _, err := events.ListDataEvents(ctx, store.ListDataEventsOptions{TimeStart: &start, TimeEnd: &end})
+	require.NoError(t, err)
+	assert.Len(t, ranged, 3)
+
+	found, _, err := events.ListDataEvents(ctx, store.ListDataEventsOptions{Search: "ITEM 3"})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "evt-03", found[0].EventID)
+
+	tagged, _, err := store.NewResourceChainStore(client).FindResourcesByTag(ctx, "parity", "odd", 10, "")
+	require.NoError(t, err)
+	assert.Len(t, tagged, 2)
+
+	// Retention purge removes only rows older than the cutoff.
+	deleted, err := events.DeleteDataEventsOlderThan(ctx, base.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	left, err := client.DataEvent.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, left)
+}
@@ -18,6 +18,7 @@ import (
 	"modernc.org/sqlite"
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/internal/store/ent/schema"
 	// required by schema hooks.
 	_ "github.com/flowline-io/flowbot/internal/store/ent/gen/runtime"
 )
@@ -121,6 +122,16 @@ func cachedSchemaTemplate() (string, error) {
 				return
 			}
 		}
+		// The full-text statements hold trigger bodies with semicolons, so
+		// they are applied whole rather than through splitSQLStatements.
+		for _, stmt := range schema.SearchDocumentFullText(dialect.SQLite) {
+			if _, err := sqlDB.Exec(stmt); err != nil {
+				_ = client.Close()
+				_ = sqlDB.Close()
+				schemaTemplateErr = fmt.Errorf("apply search index statement %q: %w", truncateSQL(stmt), err)
+				return
+			}
+		}
 		if err := client.Close(); err != nil {
 			_ = sqlDB.Close()
 			schemaTemplateErr = err
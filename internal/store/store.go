@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
 	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/flog"
@@ -21,13 +23,15 @@ var availableAdapters = make(map[string]Adapter)
 
 func openAdapter(jsonConfig config.StoreType) error {
 	if adp == nil {
-		if len(availableAdapters) >= 1 {
+		if a, ok := availableAdapters[jsonConfig.UseAdapter]; ok {
+			adp = a
+		} else if len(availableAdapters) == 1 {
 			// Default to the only entry in availableAdapters.
 			for _, v := range availableAdapters {
 				adp = v
 			}
 		} else {
-			return errors.New("store: db adapter is not specified. Please set postgres.dsn in flowbot.yaml")
+			return errors.New("store: db adapter is not specified. Please set postgres.dsn or sqlite.path in flowbot.yaml")
 		}
 	}
 
@@ -63,7 +67,11 @@ func Migrate() error {
 	if err != nil {
 		return fmt.Errorf("store: schema migration: %w", err)
 	}
-	return nil
+	dialectName := dialect.Postgres
+	if adp.GetName() == "sqlite" {
+		dialectName = dialect.SQLite
+	}
+	return MigrateSearchIndex(context.Background(), client, dialectName)
 }
 
 // PersistentStorageInterface defines methods used for interaction with persistent storage.
@@ -135,11 +143,17 @@ type Adapter interface {
 
 var Database Adapter
 
+// Init selects the adapter named by store.use_adapter, which Normalize sets
+// to "sqlite" when sqlite.path is configured and "postgres" otherwise.
 func Init() {
 	Store = storeObj{}
-	pgAdapter, ok := availableAdapters["postgres"]
+	name := config.App.Store.UseAdapter
+	if name == "" {
+		name = "postgres"
+	}
+	a, ok := availableAdapters[name]
 	if !ok {
-		flog.Fatal("postgres adapter not available - check build tags")
+		flog.Fatal("%s adapter not available - check build tags", name)
 	}
-	Database = pgAdapter
+	Database = a
 }
@@ -514,6 +514,55 @@ func TestPipelineStore_UpdateRunAndStepStatus(t *testing.T) {
 	assert.Equal(t, int(schema.PipelineDone), loaded.Status)
 }
 
+func TestPipelineStore_UpdateRunStatusReopen(t *testing.T) {
+	t.Parallel()
+	client := sqlitetest.OpenClient(t, t.Name())
+	store := NewPipelineStore(client)
+	ctx := context.Background()
+
+	run, err := store.CreateRun(ctx, "reopen-pipe", "evt-reopen", "test.event", "manual")
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateRunStatus(ctx, run.ID, int(schema.PipelineFailed), "boom"))
+
+	loaded, err := store.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, loaded.CompletedAt)
+	assert.Equal(t, "boom", loaded.Error)
+
+	require.NoError(t, store.UpdateRunStatus(ctx, run.ID, int(schema.PipelineStart), ""))
+	loaded, err = store.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int(schema.PipelineStart), loaded.Status)
+	assert.Nil(t, loaded.CompletedAt)
+	assert.Empty(t, loaded.Error)
+}
+
+func TestPipelineStore_SwapRunStatus(t *testing.T) {
+	t.Parallel()
+	client := sqlitetest.OpenClient(t, t.Name())
+	store := NewPipelineStore(client)
+	ctx := context.Background()
+
+	run, err := store.CreateRun(ctx, "swap-pipe", "evt-swap", "test.event", "manual")
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateRunStatus(ctx, run.ID, int(schema.PipelineFailed), "boom"))
+
+	retryable := []int{int(schema.PipelineFailed), int(schema.PipelineCancel)}
+	swapped, err := store.SwapRunStatus(ctx, run.ID, retryable, int(schema.PipelineStart), "")
+	require.NoError(t, err)
+	assert.True(t, swapped)
+	swapped, err = store.SwapRunStatus(ctx, run.ID, retryable, int(schema.PipelineStart), "")
+	require.NoError(t, err)
+	assert.False(t, swapped, "the second swap sees the run already started")
+
+	loaded, err := store.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int(schema.PipelineStart), loaded.Status)
+	assert.Nil(t, loaded.CompletedAt)
+	assert.Empty(t, loaded.Error)
+	assert.NotNil(t, loaded.LastHeartbeat)
+}
+
 func TestPipelineStore_GetIncompleteRuns(t *testing.T) {
 	t.Parallel()
 	client := sqlitetest.OpenClient(t, t.Name())
@@ -11,8 +11,10 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/notificationrecord"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/pipelinedefinition"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/resourcelink"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/searchdocument"
 	"github.com/flowline-io/flowbot/internal/store/sqlitetest"
 	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/search"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/audit"
 	"github.com/stretchr/testify/assert"
@@ -976,6 +978,196 @@ func TestClipStore_NilSafe(t *testing.T) {
 	}
 }
 
+func TestClipStore_UpdateKeepsVersionHistory(t *testing.T) {
+	client := getTestClient(t)
+	store := NewClipStore(client)
+	ctx := context.Background()
+
+	created, err := store.CreateClipFromInput(ctx, ClipCreateInput{
+		Slug: "verSlug1", Title: "v1", Content: "first body", CreatedBy: "alice",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Version)
+
+	attachment := types.ClipAttachment{Name: "notes.txt", FileID: "f1", Size: 3}
+	updated, err := store.UpdateClip(ctx, ClipUpdateInput{
+		Slug: "verSlug1", BaseVersion: 1, Title: "v2", Content: "second body",
+		Attachments: []types.ClipAttachment{attachment}, EditedBy: "bob",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+	assert.Equal(t, "second body", updated.Content)
+	require.NotNil(t, updated.UpdatedAt)
+	assert.Equal(t, []types.ClipAttachment{attachment}, updated.Attachments)
+
+	tests := []struct {
+		name    string
+		in      ClipUpdateInput
+		wantErr error
+	}{
+		{name: "stale base version", in: ClipUpdateInput{Slug: "verSlug1", BaseVersion: 1, Content: "x"}, wantErr: types.ErrConflict},
+		{name: "missing clip", in: ClipUpdateInput{Slug: "missing01", Content: "x"}, wantErr: types.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.UpdateClip(ctx, tt.in)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	versions, err := store.ListClipVersions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+	assert.Equal(t, "bob", versions[0].CreatedBy)
+	assert.Equal(t, "first body", versions[1].Content)
+	assert.Equal(t, "alice", versions[1].CreatedBy)
+
+	v1, err := store.GetClipVersion(ctx, created.ID, 1)
+	require.NoError(t, err)
+	require.NotNil(t, v1)
+	assert.Equal(t, "v1", v1.Title)
+	missing, err := store.GetClipVersion(ctx, created.ID, 9)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestClipStore_ConsumeClipView(t *testing.T) {
+	client := getTestClient(t)
+	store := NewClipStore(client)
+	searchStore := NewSearchStore(client)
+	ctx := context.Background()
+	now := time.Now()
+	past := now.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		in        ClipCreateInput
+		reads     int
+		wantOK    []bool
+		wantViews int
+		wantState types.ClipState
+		// wantIndexed reports whether the clip's search document survives the
+		// reads. Expired clips leave the index at the next sync instead.
+		wantIndexed bool
+	}{
+		{
+			name:        "unlimited clip counts every read",
+			in:          ClipCreateInput{Slug: "viewFree", Content: "body"},
+			reads:       3,
+			wantOK:      []bool{true, true, true},
+			wantViews:   3,
+			wantState:   types.ClipActive,
+			wantIndexed: true,
+		},
+		{
+			name:        "view limit stops at max_views",
+			in:          ClipCreateInput{Slug: "viewCap2", Content: "body", MaxViews: 2},
+			reads:       3,
+			wantOK:      []bool{true, true, false},
+			wantViews:   2,
+			wantState:   types.ClipExhausted,
+			wantIndexed: false,
+		},
+		{
+			name:        "burn after read serves one reader",
+			in:          ClipCreateInput{Slug: "viewBurn", Content: "secret", Description: "d", BurnAfterRead: true, MaxViews: 1},
+			reads:       2,
+			wantOK:      []bool{true, false},
+			wantViews:   1,
+			wantState:   types.ClipBurned,
+			wantIndexed: false,
+		},
+		{
+			name:        "expired clip is not served",
+			in:          ClipCreateInput{Slug: "viewOld1", Content: "body", ExpiresAt: &past},
+			reads:       1,
+			wantOK:      []bool{false},
+			wantViews:   0,
+			wantState:   types.ClipExpired,
+			wantIndexed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, err := store.CreateClipFromInput(ctx, tt.in)
+			require.NoError(t, err)
+			require.NoError(t, searchStore.UpsertSearchDocuments(ctx, []search.Document{
+				{Source: search.SourceClip, ID: tt.in.Slug, Title: "clip", UpdatedAt: now},
+			}))
+			for i := range tt.reads {
+				ok, err := store.ConsumeClipView(ctx, row, now)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantOK[i], ok, "read %d", i+1)
+			}
+			got, err := store.GetClipBySlug(ctx, tt.in.Slug)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantViews, got.ViewCount)
+			assert.Equal(t, tt.wantState, types.ClipStateAt(got.ExpiresAt, got.BurnedAt, got.MaxViews, got.ViewCount, now))
+			indexed, err := client.SearchDocument.Query().
+				Where(searchdocument.SourceEQ(search.SourceClip), searchdocument.DocIDEQ(tt.in.Slug)).
+				Exist(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIndexed, indexed)
+			if tt.wantState == types.ClipBurned {
+				assert.Empty(t, got.Content)
+				assert.Empty(t, got.Description)
+				versions, err := store.ListClipVersions(ctx, got.ID)
+				require.NoError(t, err)
+				assert.Empty(t, versions)
+			}
+		})
+	}
+}
+
+func TestClipStore_PurgeClips(t *testing.T) {
+	client := getTestClient(t)
+	store := NewClipStore(client)
+	ctx := context.Background()
+	now := time.Now()
+	longAgo := now.Add(-2 * time.Hour)
+	recently := now.Add(-time.Minute)
+	file := func(id string) []types.ClipAttachment {
+		return []types.ClipAttachment{{Name: id + ".txt", FileID: id, Location: "/files/" + id}}
+	}
+
+	_, err := store.CreateClipFromInput(ctx, ClipCreateInput{Slug: "purgeOld", Content: "old", ExpiresAt: &longAgo, Attachments: file("a")})
+	require.NoError(t, err)
+	_, err = store.CreateClipFromInput(ctx, ClipCreateInput{Slug: "purgeNew", Content: "new", ExpiresAt: &recently, Attachments: file("b")})
+	require.NoError(t, err)
+	burned, err := store.CreateClipFromInput(ctx, ClipCreateInput{Slug: "purgeBrn", Content: "once", BurnAfterRead: true, MaxViews: 1, Attachments: file("c")})
+	require.NoError(t, err)
+	ok, err := store.ConsumeClipView(ctx, burned, longAgo)
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, err = store.CreateClipFromInput(ctx, ClipCreateInput{Slug: "purgeLiv", Content: "live", Attachments: file("d")})
+	require.NoError(t, err)
+
+	detached, err := store.PurgeClips(ctx, now, time.Hour)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(detached))
+	for _, a := range detached {
+		ids = append(ids, a.FileID)
+	}
+	assert.ElementsMatch(t, []string{"a", "c"}, ids)
+
+	old, err := store.GetClipBySlug(ctx, "purgeOld")
+	require.NoError(t, err)
+	assert.Empty(t, old.Content)
+	assert.Empty(t, old.Attachments)
+	versions, err := store.ListClipVersions(ctx, old.ID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+
+	fresh, err := store.GetClipBySlug(ctx, "purgeNew")
+	require.NoError(t, err)
+	assert.Equal(t, "new", fresh.Content, "clips inside the grace period keep their body")
+
+	again, err := store.PurgeClips(ctx, now, time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+}
+
 // ---------------------------------------------------------------------------
 // PageDataStore tests
 // ---------------------------------------------------------------------------
@@ -1207,6 +1399,11 @@ func TestListDataEvents(t *testing.T) {
 			opts:      ListDataEventsOptions{Limit: 10, Search: "nomatchxyz"},
 			wantCount: 0,
 		},
+		{
+			name:      "search combined with pipeline name",
+			opts:      ListDataEventsOptions{Limit: 10, Search: "feed", PipelineName: "test-pipeline"},
+			wantCount: 0,
+		},
 		{
 			name:      "pipeline name filter returns matched events",
 			opts:      ListDataEventsOptions{Limit: 10, PipelineName: "test-pipeline"},
@@ -230,3 +230,40 @@ func TestLLMUsageStore_RecordValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestLLMUsageStore_SumLLMUsage(t *testing.T) {
+	client := getTestClient(t)
+	s := NewLLMUsageStore(client)
+	ctx := context.Background()
+	since := time.Now().UTC().Add(-time.Hour)
+
+	records := []types.LLMUsageRecordInput{
+		{UID: "u1", Model: "m", TotalTokens: 100, Agent: "chat", Cost: 0.5},
+		{UID: "u1", Model: "m", TotalTokens: 40, Agent: "reviewer", Cost: 0.25},
+		{UID: "u2", Model: "m", TotalTokens: 7, Agent: "chat", TaskID: "digest"},
+		{UID: "u2", Model: "m", TotalTokens: 9, Agent: "chat", PipelineStep: "daily/summarize"},
+	}
+	for i := range records {
+		require.NoError(t, s.RecordLLMUsage(ctx, &records[i]))
+	}
+
+	tests := []struct {
+		name   string
+		filter types.LLMUsageFilter
+		want   types.LLMUsageTotal
+	}{
+		{name: "per user", filter: types.LLMUsageFilter{UID: "u1", Since: since}, want: types.LLMUsageTotal{Tokens: 140, Cost: 0.75}},
+		{name: "per agent", filter: types.LLMUsageFilter{Agent: "chat", Since: since}, want: types.LLMUsageTotal{Tokens: 116, Cost: 0.5}},
+		{name: "per scheduled task", filter: types.LLMUsageFilter{TaskID: "digest", Since: since}, want: types.LLMUsageTotal{Tokens: 7}},
+		{name: "per pipeline step", filter: types.LLMUsageFilter{PipelineStep: "daily/summarize", Since: since}, want: types.LLMUsageTotal{Tokens: 9}},
+		{name: "window excludes older rows", filter: types.LLMUsageFilter{UID: "u1", Since: time.Now().UTC().Add(time.Hour)}, want: types.LLMUsageTotal{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.SumLLMUsage(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Tokens, got.Tokens)
+			assert.InDelta(t, tt.want.Cost, got.Cost, 1e-9)
+		})
+	}
+}
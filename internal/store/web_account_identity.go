@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccount"
+	"github.com/flowline-io/flowbot/internal/store/ent/gen/webaccountidentity"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/webauth"
+)
+
+// IdentityInput identifies an external login linked to a web account.
+type IdentityInput struct {
+	Provider string
+	Issuer   string
+	Subject  string
+	Email    string
+}
+
+// GetByIdentity returns the account linked to an external identity.
+func (s *WebAccountStore) GetByIdentity(ctx context.Context, provider, issuer, subject string) (*gen.WebAccount, error) {
+	if !s.ready() {
+		return nil, fmt.Errorf("web account store not available")
+	}
+	link, err := s.client.WebAccountIdentity.Query().
+		Where(
+			webaccountidentity.ProviderEQ(provider),
+			webaccountidentity.IssuerEQ(issuer),
+			webaccountidentity.SubjectEQ(subject),
+		).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, types.ErrNotFound
+		}
+		return nil, fmt.Errorf("web account: get identity: %w", err)
+	}
+	return s.GetByUID(ctx, link.UID)
+}
+
+// LinkIdentity links in to the account uid, or records a new sign-in when the
+// link already exists.
+func (s *WebAccountStore) LinkIdentity(ctx context.Context, uid string, in IdentityInput) error {
+	if !s.ready() {
+		return fmt.Errorf("web account store not available")
+	}
+	if uid == "" || in.Provider == "" || in.Subject == "" {
+		return fmt.Errorf("web account: uid, provider and subject required")
+	}
+	err := s.client.WebAccountIdentity.Create().
+		SetUID(uid).
+		SetProvider(in.Provider).
+		SetIssuer(in.Issuer).
+		SetSubject(in.Subject).
+		SetEmail(in.Email).
+		SetLastLoginAt(time.Now()).
+		OnConflictColumns(webaccountidentity.FieldProvider, webaccountidentity.FieldIssuer, webaccountidentity.FieldSubject).
+		UpdateEmail().
+		UpdateLastLoginAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("web account: link identity: %w", err)
+	}
+	return nil
+}
+
+// ListIdentities returns the external identities linked to uid, oldest first.
+func (s *WebAccountStore) ListIdentities(ctx context.Context, uid string) ([]*gen.WebAccountIdentity, error) {
+	if !s.ready() {
+		return nil, fmt.Errorf("web account store not available")
+	}
+	rows, err := s.client.WebAccountIdentity.Query().
+		Where(webaccountidentity.UIDEQ(uid)).
+		Order(gen.Asc(webaccountidentity.FieldCreatedAt), gen.Asc(webaccountidentity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("web account: list identities: %w", err)
+	}
+	return rows, nil
+}
+
+// CreateProvisionedAccount creates an account for a first single sign-on
+// login. The account gets an unusable password, so it can only sign in
+// through its linked identity. TOTP is left off: the identity provider or
+// proxy owns the second factor.
+func (s *WebAccountStore) CreateProvisionedAccount(ctx context.Context, username string, in IdentityInput) (*gen.WebAccount, error) {
+	if !s.ready() {
+		return nil, fmt.Errorf("web account store not available")
+	}
+	if username == "" {
+		return nil, fmt.Errorf("web account: username required")
+	}
+	hash, err := webauth.UnusablePasswordHash()
+	if err != nil {
+		return nil, fmt.Errorf("web account: provision: %w", err)
+	}
+	uid := webauth.UIDForUsername(username)
+	var created *gen.WebAccount
+	err = withTx(ctx, s.client, func(tx *gen.Tx) error {
+		exists, err := tx.WebAccount.Query().Where(webaccount.Or(webaccount.UsernameEQ(username), webaccount.UIDEQ(uid))).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("lookup: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("%w: web account %q already exists", types.ErrConflict, username)
+		}
+		row, err := tx.WebAccount.Create().
+			SetUsername(username).
+			SetUID(uid).
+			SetPasswordHash(hash).
+			SetTotpEnabled(false).
+			SetBackupCodeHashes([]string{}).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create web account: %w", err)
+		}
+		if err := tx.WebAccountIdentity.Create().
+			SetUID(uid).
+			SetProvider(in.Provider).
+			SetIssuer(in.Issuer).
+			SetSubject(in.Subject).
+			SetEmail(in.Email).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("link identity: %w", err)
+		}
+		if err := ensureUserTx(ctx, tx, uid, username); err != nil {
+			return err
+		}
+		created = row
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
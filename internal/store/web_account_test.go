@@ -113,3 +113,60 @@ func createTestWebAccount(t *testing.T, ws *store.WebAccountStore, username stri
 	require.NoError(t, err)
 	require.NoError(t, ws.EnsureUser(ctx, uid, username))
 }
+
+func TestWebAccountStoreIdentities(t *testing.T) {
+	ctx := context.Background()
+	client := sqlitetest.OpenClient(t, t.Name())
+	ws := store.NewWebAccountStore(client)
+	createTestWebAccount(t, ws, "admin", true)
+
+	oidcID := store.IdentityInput{Provider: webauth.ProviderOIDC, Issuer: "https://auth.example.com", Subject: "sub-1", Email: "a@example.com"}
+	_, err := ws.GetByIdentity(ctx, oidcID.Provider, oidcID.Issuer, oidcID.Subject)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	require.NoError(t, ws.LinkIdentity(ctx, "user-admin", oidcID))
+	row, err := ws.GetByIdentity(ctx, oidcID.Provider, oidcID.Issuer, oidcID.Subject)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", row.Username)
+
+	// Linking again records the sign-in instead of failing.
+	oidcID.Email = "new@example.com"
+	require.NoError(t, ws.LinkIdentity(ctx, "user-admin", oidcID))
+	require.NoError(t, ws.LinkIdentity(ctx, "user-admin", store.IdentityInput{Provider: webauth.ProviderForwardAuth, Subject: "admin"}))
+	links, err := ws.ListIdentities(ctx, "user-admin")
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, webauth.ProviderOIDC, links[0].Provider)
+	assert.Equal(t, "new@example.com", links[0].Email)
+	assert.Equal(t, webauth.ProviderForwardAuth, links[1].Provider)
+
+	// The same subject from another issuer is a different identity.
+	_, err = ws.GetByIdentity(ctx, webauth.ProviderOIDC, "https://other.example.com", "sub-1")
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	require.Error(t, ws.LinkIdentity(ctx, "", oidcID))
+}
+
+func TestWebAccountStoreCreateProvisionedAccount(t *testing.T) {
+	ctx := context.Background()
+	client := sqlitetest.OpenClient(t, t.Name())
+	ws := store.NewWebAccountStore(client)
+	createTestWebAccount(t, ws, "admin", true)
+
+	id := store.IdentityInput{Provider: webauth.ProviderOIDC, Issuer: "https://auth.example.com", Subject: "sub-2"}
+	row, err := ws.CreateProvisionedAccount(ctx, "alice", id)
+	require.NoError(t, err)
+	assert.Equal(t, "user-alice", row.UID)
+	assert.False(t, row.TotpEnabled)
+	assert.False(t, webauth.CheckPassword(row.PasswordHash, ""), "provisioned accounts have no usable password")
+
+	linked, err := ws.GetByIdentity(ctx, id.Provider, id.Issuer, id.Subject)
+	require.NoError(t, err)
+	assert.Equal(t, row.ID, linked.ID)
+
+	_, err = ws.CreateProvisionedAccount(ctx, "admin", store.IdentityInput{Provider: webauth.ProviderOIDC, Subject: "sub-3"})
+	require.ErrorIs(t, err, types.ErrConflict)
+	n, err := ws.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
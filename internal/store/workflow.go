@@ -151,6 +151,9 @@ func createWorkflowTasks(ctx context.Context, tx *gen.Tx, workflowID int64, task
 		if retry := pkgworkflow.RetryToMap(t.Retry); retry != nil {
 			builder = builder.SetRetry(retry)
 		}
+		if outputs := pkgworkflow.OutputsToMaps(t.Outputs); outputs != nil {
+			builder = builder.SetOutputs(outputs)
+		}
 		builders = append(builders, builder)
 	}
 	if _, err := tx.WorkflowTask.CreateBulk(builders...).Save(ctx); err != nil {
@@ -597,11 +600,12 @@ func (s *WorkflowStore) loadWorkflowTriggerSources(ctx context.Context, name str
 		return nil, err
 	}
 
-	result := map[string]int64{"cron": 0, "webhook": 0, "manual": 0}
+	result := map[string]int64{"event": 0, "cron": 0, "webhook": 0, "manual": 0}
 	for _, r := range rows {
 		result[r.Source] = r.Count
 	}
 	return []types.TriggerSourceCount{
+		{Source: "event", Count: result["event"]},
 		{Source: "cron", Count: result["cron"]},
 		{Source: "webhook", Count: result["webhook"]},
 		{Source: "manual", Count: result["manual"]},
@@ -612,7 +616,7 @@ func emptyWorkflowStats() *types.WorkflowStats {
 	return &types.WorkflowStats{
 		Summary: types.WorkflowStatsSummary{},
 		TriggerSourcePie: []types.TriggerSourceCount{
-			{Source: "cron"}, {Source: "webhook"}, {Source: "manual"},
+			{Source: "event"}, {Source: "cron"}, {Source: "webhook"}, {Source: "manual"},
 		},
 		DurationDistribution: types.WorkflowDurationDistribution{
 			Workflow: emptyDurationBuckets(),
@@ -5,6 +5,7 @@ import (
 	"maps"
 
 	"github.com/flowline-io/flowbot/internal/store/ent/gen"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 	"github.com/flowline-io/flowbot/pkg/workflow"
@@ -52,6 +53,7 @@ func workflowRowsFromGen(dto *WorkflowDefinitionDTO) workflow.WorkflowRows {
 			Vars:     append([]string(nil), t.Vars...),
 			Conn:     append([]string(nil), t.Conn...),
 			Retry:    cloneJSONMap(t.Retry),
+			Outputs:  t.Outputs,
 		})
 	}
 	triggers := make([]*model.WorkflowTrigger, 0, len(dto.Triggers))
@@ -170,6 +172,20 @@ func (a WorkflowRunStoreAdapter) GetIncompleteRuns(ctx context.Context) ([]*mode
 	return mapWorkflowRunDTOs(rows), nil
 }
 
+// SetRunParent implements workflow.WorkflowRunStore.
+func (a WorkflowRunStoreAdapter) SetRunParent(ctx context.Context, runID int64, parent runcall.Parent) error {
+	return a.S.SetRunParent(ctx, runID, parent)
+}
+
+// GetWaitingRuns implements workflow.WorkflowRunStore.
+func (a WorkflowRunStoreAdapter) GetWaitingRuns(ctx context.Context) ([]*model.WorkflowRun, error) {
+	rows, err := a.S.GetWaitingRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapWorkflowRunDTOs(rows), nil
+}
+
 // GetCheckpoint implements workflow.WorkflowRunStore.
 func (a WorkflowRunStoreAdapter) GetCheckpoint(ctx context.Context, runID int64, target any) error {
 	return a.S.GetCheckpoint(ctx, runID, target)
@@ -184,6 +200,11 @@ func (a WorkflowRunStoreAdapter) GetRun(ctx context.Context, runID int64) (*mode
 	return mapWorkflowRunDTO(row), nil
 }
 
+// SwapRunStatus implements workflow.WorkflowRunStore.
+func (a WorkflowRunStoreAdapter) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	return a.S.SwapRunStatus(ctx, runID, from, to, errMsg)
+}
+
 // UpdateRunHeartbeat implements workflow.WorkflowRunStore.
 func (a WorkflowRunStoreAdapter) UpdateRunHeartbeat(ctx context.Context, runID int64) error {
 	return a.S.UpdateRunHeartbeat(ctx, runID)
@@ -232,15 +253,20 @@ func mapWorkflowRunDTO(row *gen.WorkflowRun) *model.WorkflowRun {
 		return nil
 	}
 	return &model.WorkflowRun{
-		ID:           row.ID,
-		WorkflowID:   row.WorkflowID,
-		WorkflowName: row.WorkflowName,
-		Status:       row.Status,
-		TriggerType:  row.TriggerType,
-		StartedAt:    row.StartedAt,
-		CreatedAt:    row.CreatedAt,
-		CompletedAt:  row.CompletedAt,
-		Error:        row.Error,
+		ID:            row.ID,
+		WorkflowID:    row.WorkflowID,
+		WorkflowName:  row.WorkflowName,
+		Status:        row.Status,
+		TriggerType:   row.TriggerType,
+		StartedAt:     row.StartedAt,
+		LastHeartbeat: row.LastHeartbeat,
+		CreatedAt:     row.CreatedAt,
+		CompletedAt:   row.CompletedAt,
+		Error:         row.Error,
+		InputParams:   cloneJSONMap(row.InputParams),
+		ParentKind:    row.ParentKind,
+		ParentRunID:   row.ParentRunID,
+		CallDepth:     row.CallDepth,
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowrun"
 	"github.com/flowline-io/flowbot/internal/store/ent/gen/workflowsteprun"
 	"github.com/flowline-io/flowbot/internal/store/ent/schema"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 )
 
 // ---------------------------------------------------------------------------
@@ -56,19 +57,49 @@ func (s *WorkflowRunStore) CreateRun(ctx context.Context, workflowID int64, work
 }
 
 // UpdateRunStatus updates the status, error, and completed_at of a workflow run.
+// Moving a run back to running clears completed_at and the previous error.
 func (s *WorkflowRunStore) UpdateRunStatus(ctx context.Context, runID int64, status int, errMsg string) error {
 	if s == nil || s.client == nil {
 		return nil
 	}
-	now := time.Now()
 	u := s.client.WorkflowRun.Update().
-		Where(workflowrun.IDEQ(runID)).
-		SetStatus(int(status)).
-		SetCompletedAt(now)
+		Where(workflowrun.IDEQ(runID))
+	setWorkflowRunStatus(u.Mutation(), status, errMsg)
+	return u.Exec(ctx)
+}
+
+// SwapRunStatus sets the run status only while it is one of from, so callers
+// racing on the same transition cannot both win. It reports whether the run
+// was updated.
+func (s *WorkflowRunStore) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	if s == nil || s.client == nil {
+		return true, nil
+	}
+	u := s.client.WorkflowRun.Update().
+		Where(workflowrun.IDEQ(runID), workflowrun.StatusIn(from...))
+	setWorkflowRunStatus(u.Mutation(), to, errMsg)
+	n, err := u.Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func setWorkflowRunStatus(m *gen.WorkflowRunMutation, status int, errMsg string) {
+	m.SetStatus(status)
+	// A retried run goes back to running and must not keep the completion
+	// time and error of its previous attempt. Its heartbeat is renewed so the
+	// new attempt does not look abandoned.
+	if status == int(schema.WorkflowRunRunning) {
+		m.ClearCompletedAt()
+		m.ClearError()
+		m.SetLastHeartbeat(time.Now())
+	} else {
+		m.SetCompletedAt(time.Now())
+	}
 	if errMsg != "" {
-		u = u.SetError(errMsg)
+		m.SetError(errMsg)
 	}
-	return u.Exec(ctx)
 }
 
 // CreateStepRun inserts a new workflow step run record.
@@ -108,7 +139,7 @@ func (s *WorkflowRunStore) GetStepRunsByRunID(ctx context.Context, runID int64)
 }
 
 // UpdateStepRun updates the status, result, error, and attempt count of a workflow step run.
-// completed_at is only set for terminal states (Done, Failed).
+// completed_at is only set for terminal states (Done, Failed, Cancelled).
 func (s *WorkflowRunStore) UpdateStepRun(ctx context.Context, stepRunID int64, status int, result map[string]any, errMsg string, attempt int) error {
 	if s == nil || s.client == nil {
 		return nil
@@ -117,7 +148,7 @@ func (s *WorkflowRunStore) UpdateStepRun(ctx context.Context, stepRunID int64, s
 		Where(workflowsteprun.IDEQ(stepRunID)).
 		SetStatus(int(status)).
 		SetAttempt(attempt)
-	if status == int(schema.WorkflowRunDone) || status == int(schema.WorkflowRunFailed) {
+	if status == int(schema.WorkflowRunDone) || status == int(schema.WorkflowRunFailed) || status == int(schema.WorkflowRunCancelled) {
 		u = u.SetCompletedAt(time.Now())
 	}
 	if result != nil {
@@ -163,6 +194,29 @@ func (s *WorkflowRunStore) GetIncompleteRuns(ctx context.Context) ([]*gen.Workfl
 	return runs, nil
 }
 
+// SetRunParent links a run started by a call step to the calling run.
+func (s *WorkflowRunStore) SetRunParent(ctx context.Context, runID int64, parent runcall.Parent) error {
+	if s == nil || s.client == nil {
+		return nil
+	}
+	return s.client.WorkflowRun.UpdateOneID(runID).
+		SetParentKind(parent.Kind).
+		SetParentRunID(parent.RunID).
+		SetCallDepth(parent.Depth).
+		Exec(ctx)
+}
+
+// GetWaitingRuns returns workflow runs suspended at an approval gate.
+func (s *WorkflowRunStore) GetWaitingRuns(ctx context.Context) ([]*gen.WorkflowRun, error) {
+	if s == nil || s.client == nil {
+		return nil, nil
+	}
+	return s.client.WorkflowRun.Query().
+		Where(workflowrun.StatusEQ(int(schema.WorkflowRunWaiting))).
+		Order(gen.Asc(workflowrun.FieldCreatedAt)).
+		All(ctx)
+}
+
 // GetCheckpoint loads the checkpoint data for a workflow run.
 func (s *WorkflowRunStore) GetCheckpoint(ctx context.Context, runID int64, target any) error {
 	if s == nil || s.client == nil {
@@ -25,8 +25,8 @@ func TestWorkflowStats_SuccessRateTrend(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	triggers := []string{"cron", "cron", "webhook", "manual"}
-	statuses := []int{int(schema.WorkflowRunDone), int(schema.WorkflowRunDone), int(schema.WorkflowRunFailed), int(schema.WorkflowRunDone)}
+	triggers := []string{"cron", "cron", "webhook", "manual", "event"}
+	statuses := []int{int(schema.WorkflowRunDone), int(schema.WorkflowRunDone), int(schema.WorkflowRunFailed), int(schema.WorkflowRunDone), int(schema.WorkflowRunDone)}
 	for i, trigger := range triggers {
 		run, err := rs.CreateRun(ctx, 0, "wf-stats-trend", "db", trigger, nil, nil)
 		require.NoError(t, err)
@@ -55,7 +55,7 @@ func TestWorkflowStats_SuccessRateTrend(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, stats)
 			assert.GreaterOrEqual(t, len(stats.SuccessRateTrend), tt.minRows)
-			assert.Len(t, stats.TriggerSourcePie, 3)
+			assert.Len(t, stats.TriggerSourcePie, 4)
 			srcCount := make(map[string]bool)
 			for _, sc := range stats.TriggerSourcePie {
 				srcCount[sc.Source] = true
@@ -63,6 +63,7 @@ func TestWorkflowStats_SuccessRateTrend(t *testing.T) {
 			assert.True(t, srcCount["cron"])
 			assert.True(t, srcCount["webhook"])
 			assert.True(t, srcCount["manual"])
+			assert.True(t, srcCount["event"])
 			assert.Len(t, stats.DurationDistribution.Workflow, 4)
 		})
 	}
@@ -159,7 +160,7 @@ func TestWorkflowStats_NilSafe(t *testing.T) {
 			stats, err := tt.store.WorkflowStats(context.Background(), "", time.Time{}, "day")
 			require.NoError(t, err)
 			require.NotNil(t, stats)
-			assert.Len(t, stats.TriggerSourcePie, 3)
+			assert.Len(t, stats.TriggerSourcePie, 4)
 		})
 	}
 }
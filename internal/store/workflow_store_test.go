@@ -206,6 +206,7 @@ func sampleWorkflowMeta(name string) *types.WorkflowMetadata {
 					MaxAttempts: 2,
 					Backoff:     types.BackoffFixed,
 				},
+				Outputs: []types.WorkflowOutputDef{{Name: "report", Path: "out/report.json"}},
 			},
 		},
 	}
@@ -227,6 +228,7 @@ func TestWorkflowStore_MetadataRoundtrip(t *testing.T) {
 	require.Len(t, got.Tasks, 1)
 	require.NotNil(t, got.Tasks[0].Retry)
 	assert.Equal(t, 2, got.Tasks[0].Retry.MaxAttempts)
+	assert.Equal(t, meta.Tasks[0].Outputs, got.Tasks[0].Outputs)
 }
 
 func TestWorkflowStore_LatestRunStartedAtByNames(t *testing.T) {
@@ -447,3 +449,32 @@ func TestWorkflowRunStore_GetStepRunsByRunID(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflowRunStore_SwapRunStatus(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := sqlitetest.OpenClient(t, t.Name())
+	ws := store.NewWorkflowStore(client)
+	rs := store.NewWorkflowRunStore(client)
+	meta := sampleWorkflowMeta("swap-wf")
+	row, err := ws.ApplyDefinition(ctx, meta)
+	require.NoError(t, err)
+	run, err := rs.CreateRun(ctx, row.ID, meta.Name, "db", "manual", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, rs.UpdateRunStatus(ctx, run.ID, int(schema.WorkflowRunFailed), "boom"))
+
+	retryable := []int{int(schema.WorkflowRunFailed), int(schema.WorkflowRunCancelled)}
+	swapped, err := rs.SwapRunStatus(ctx, run.ID, retryable, int(schema.WorkflowRunRunning), "")
+	require.NoError(t, err)
+	assert.True(t, swapped)
+	swapped, err = rs.SwapRunStatus(ctx, run.ID, retryable, int(schema.WorkflowRunRunning), "")
+	require.NoError(t, err)
+	assert.False(t, swapped, "the second swap sees the run already running")
+
+	loaded, err := store.WorkflowRunStoreAdapter{S: rs}.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int(schema.WorkflowRunRunning), loaded.Status)
+	assert.Nil(t, loaded.CompletedAt)
+	assert.Empty(t, loaded.Error)
+	assert.NotNil(t, loaded.LastHeartbeat)
+}
@@ -0,0 +1,77 @@
+// Package embed turns text into embedding vectors and keeps a vector index
+// over agent knowledge, memory facts and session summaries for hybrid
+// keyword-plus-semantic retrieval.
+package embed
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
+	"github.com/flowline-io/flowbot/pkg/config"
+)
+
+// Embedder returns one vector per input text, in input order.
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model names the embedding model; vectors from different models are
+	// not comparable.
+	Model() string
+}
+
+// FromConfig builds the embedder for chat_agent.embedding_model. It returns
+// nil without error when no embedding model is configured.
+func FromConfig() (Embedder, error) {
+	modelName := strings.TrimSpace(config.App.ChatAgent.EmbeddingModel)
+	if modelName == "" {
+		return nil, nil
+	}
+	cfg := agentllm.ModelConfig(modelName)
+	switch cfg.Provider {
+	case agentllm.ProviderOpenAI, agentllm.ProviderOpenAICompatible, agentllm.ProviderGemini:
+		return newOpenAI(agentllm.OpenAICompatibleBaseURL(cfg), cfg.ApiKey, modelName)
+	case "":
+		return nil, fmt.Errorf("embed: unknown model %q", modelName)
+	default:
+		return nil, fmt.Errorf("embed: provider %q has no embeddings API", cfg.Provider)
+	}
+}
+
+// Cosine returns the cosine similarity of a and b, or 0 when their lengths
+// differ or either is zero.
+func Cosine(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// EncodeVector packs v as little-endian float32s for storage.
+func EncodeVector(v []float32) []byte {
+	out := make([]byte, 4*len(v))
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(out[4*i:], math.Float32bits(f))
+	}
+	return out
+}
+
+// DecodeVector unpacks a vector stored by EncodeVector.
+func DecodeVector(b []byte) []float32 {
+	out := make([]float32, len(b)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return out
+}
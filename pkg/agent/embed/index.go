@@ -0,0 +1,268 @@
+package embed
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// Kinds of indexed items.
+const (
+	KindKnowledge      = "knowledge"
+	KindMemoryFact     = "memory_fact"
+	KindSessionSummary = "session_summary"
+)
+
+const (
+	// maxTextRunes truncates item text before embedding; it stays under the
+	// input limit of common embedding models.
+	maxTextRunes = 8000
+	// reconcileBatch is how many items are embedded and stored at a time, so
+	// a failure keeps the progress made so far.
+	reconcileBatch = 64
+	// minSimilarity drops semantic matches too weak to be worth showing.
+	minSimilarity = 0.3
+	// rrfK is the reciprocal rank fusion constant.
+	rrfK = 60
+)
+
+// Vector is one stored embedding.
+type Vector struct {
+	Kind  string
+	RefID int64
+	Scope string
+	Model string
+	// Hash identifies the model, scope and text the vector was built from.
+	Hash   string
+	Vector []float32
+}
+
+// Store persists vectors.
+type Store interface {
+	// ListEmbeddingHashes returns the hash of every stored vector of kind by ref ID.
+	ListEmbeddingHashes(ctx context.Context, kind string) (map[int64]string, error)
+	UpsertEmbeddings(ctx context.Context, vectors []Vector) error
+	DeleteEmbeddings(ctx context.Context, kind string, refIDs []int64) error
+	// ListEmbeddings returns the vectors of kind built by model, limited to
+	// scope when it is non-empty.
+	ListEmbeddings(ctx context.Context, kind, scope, model string) ([]Vector, error)
+}
+
+// Item is one row to index.
+type Item struct {
+	RefID int64
+	Scope string
+	Text  string
+}
+
+// Source lists every current item of one kind.
+type Source func(ctx context.Context) ([]Item, error)
+
+// Match is a semantic search result.
+type Match struct {
+	RefID int64
+	Score float64
+}
+
+// Index keeps stored vectors in line with their sources and answers
+// similarity queries.
+type Index struct {
+	store    Store
+	embedder Embedder
+	sources  map[string]Source
+	kick     chan struct{}
+}
+
+// NewIndex creates an Index over store using embedder.
+func NewIndex(store Store, embedder Embedder) *Index {
+	return &Index{store: store, embedder: embedder, sources: map[string]Source{}, kick: make(chan struct{}, 1)}
+}
+
+// WithSource registers the source of one kind.
+func (ix *Index) WithSource(kind string, src Source) *Index {
+	ix.sources[kind] = src
+	return ix
+}
+
+// Kick asks the reindex loop to reconcile soon. It never blocks.
+func (ix *Index) Kick() {
+	select {
+	case ix.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Kicks delivers the signals sent by Kick.
+func (ix *Index) Kicks() <-chan struct{} {
+	return ix.kick
+}
+
+// Reconcile re-embeds items whose text, scope or model changed and deletes
+// vectors of items that no longer exist. A failing source leaves its kind as is.
+func (ix *Index) Reconcile(ctx context.Context) error {
+	kinds := make([]string, 0, len(ix.sources))
+	for kind := range ix.sources {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	var errs []error
+	for _, kind := range kinds {
+		items, err := ix.sources[kind](ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("list %s: %w", kind, err))
+			continue
+		}
+		if err := ix.reconcileKind(ctx, kind, items); err != nil {
+			errs = append(errs, fmt.Errorf("index %s: %w", kind, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("embed reconcile: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (ix *Index) reconcileKind(ctx context.Context, kind string, items []Item) error {
+	stored, err := ix.store.ListEmbeddingHashes(ctx, kind)
+	if err != nil {
+		return err
+	}
+	model := ix.embedder.Model()
+	var stale []Vector
+	var texts []string
+	seen := make(map[int64]bool, len(items))
+	for _, item := range items {
+		seen[item.RefID] = true
+		text := truncateRunes(item.Text, maxTextRunes)
+		hash := contentHash(model, item.Scope, text)
+		if stored[item.RefID] == hash {
+			continue
+		}
+		stale = append(stale, Vector{Kind: kind, RefID: item.RefID, Scope: item.Scope, Model: model, Hash: hash})
+		texts = append(texts, text)
+	}
+
+	for start := 0; start < len(stale); start += reconcileBatch {
+		end := min(start+reconcileBatch, len(stale))
+		vectors, err := ix.embedder.Embed(ctx, texts[start:end])
+		if err != nil {
+			return err
+		}
+		batch := stale[start:end]
+		for i := range batch {
+			batch[i].Vector = vectors[i]
+		}
+		if err := ix.store.UpsertEmbeddings(ctx, batch); err != nil {
+			return err
+		}
+	}
+
+	var gone []int64
+	for id := range stored {
+		if !seen[id] {
+			gone = append(gone, id)
+		}
+	}
+	if len(gone) > 0 {
+		return ix.store.DeleteEmbeddings(ctx, kind, gone)
+	}
+	return nil
+}
+
+// Similar returns up to k items of kind most similar in meaning to query.
+func (ix *Index) Similar(ctx context.Context, kind, scope, query string, k int) ([]Match, error) {
+	vectors, err := ix.embedder.Embed(ctx, []string{truncateRunes(query, maxTextRunes)})
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embed: expected 1 query vector, got %d", len(vectors))
+	}
+	stored, err := ix.store.ListEmbeddings(ctx, kind, scope, ix.embedder.Model())
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]Match, 0, len(stored))
+	for _, v := range stored {
+		if score := Cosine(vectors[0], v.Vector); score >= minSimilarity {
+			matches = append(matches, Match{RefID: v.RefID, Score: score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b Match) int { return cmp.Compare(b.Score, a.Score) })
+	if len(matches) > k {
+		matches = matches[:k]
+	}
+	return matches, nil
+}
+
+// Fuse merges a keyword ranking and semantic matches with reciprocal rank
+// fusion and returns up to limit ref IDs, best first. Items found both ways
+// rank highest; ties keep keyword order.
+func Fuse(keyword []int64, semantic []Match, limit int) []int64 {
+	scores := make(map[int64]float64, len(keyword)+len(semantic))
+	var order []int64
+	add := func(id int64, rank int) {
+		if _, ok := scores[id]; !ok {
+			order = append(order, id)
+		}
+		scores[id] += 1 / float64(rrfK+rank+1)
+	}
+	for i, id := range keyword {
+		add(id, i)
+	}
+	for i, m := range semantic {
+		add(m.RefID, i)
+	}
+	slices.SortStableFunc(order, func(a, b int64) int { return cmp.Compare(scores[b], scores[a]) })
+	if len(order) > limit {
+		order = order[:limit]
+	}
+	return order
+}
+
+func contentHash(model, scope, text string) string {
+	sum := sha256.Sum256([]byte(model + "\x00" + scope + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+func truncateRunes(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+var (
+	activeMu sync.RWMutex
+	active   *Index
+)
+
+// SetActive sets the index used by agent retrieval tools.
+func SetActive(ix *Index) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = ix
+}
+
+// Active returns the running index, or nil when no embedding model is
+// configured; callers then fall back to keyword search.
+func Active() *Index {
+	activeMu.RLock()
+	defer activeMu.RUnlock()
+	return active
+}
+
+// Kick asks the active index, if any, to reconcile soon. Writers of indexed
+// rows call it so changes are searchable without waiting for the next pass.
+func Kick() {
+	if ix := Active(); ix != nil {
+		ix.Kick()
+	}
+}
@@ -0,0 +1,232 @@
+package embed_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+)
+
+// memStore is an in-memory embed.Store.
+type memStore struct {
+	mu   sync.Mutex
+	rows map[string]embed.Vector
+}
+
+func newMemStore() *memStore { return &memStore{rows: map[string]embed.Vector{}} }
+
+func memKey(kind string, refID int64) string {
+	return fmt.Sprintf("%s/%d", kind, refID)
+}
+
+func (s *memStore) ListEmbeddingHashes(_ context.Context, kind string) (map[int64]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := map[int64]string{}
+	for _, v := range s.rows {
+		if v.Kind == kind {
+			out[v.RefID] = v.Hash
+		}
+	}
+	return out, nil
+}
+
+func (s *memStore) UpsertEmbeddings(_ context.Context, vectors []embed.Vector) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range vectors {
+		s.rows[memKey(v.Kind, v.RefID)] = v
+	}
+	return nil
+}
+
+func (s *memStore) DeleteEmbeddings(_ context.Context, kind string, refIDs []int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range refIDs {
+		delete(s.rows, memKey(kind, id))
+	}
+	return nil
+}
+
+func (s *memStore) ListEmbeddings(_ context.Context, kind, scope, model string) ([]embed.Vector, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []embed.Vector
+	for _, v := range s.rows {
+		if v.Kind == kind && v.Model == model && (scope == "" || v.Scope == scope) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// topicEmbedder maps text onto axes by keyword, so "cat" and "kitten" are close.
+type topicEmbedder struct {
+	model string
+	seen  []string
+	err   error
+}
+
+func (e *topicEmbedder) Model() string { return e.model }
+
+func (e *topicEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	e.seen = append(e.seen, texts...)
+	out := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		t := strings.ToLower(text)
+		v := []float32{0, 0, 0.01}
+		if strings.Contains(t, "cat") || strings.Contains(t, "kitten") {
+			v[0] = 1
+		}
+		if strings.Contains(t, "dog") || strings.Contains(t, "puppy") {
+			v[1] = 1
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func TestIndexReconcile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	st := newMemStore()
+	emb := &topicEmbedder{model: "m1"}
+	items := []embed.Item{
+		{RefID: 1, Text: "cats"},
+		{RefID: 2, Scope: "s", Text: "dogs"},
+	}
+	ix := embed.NewIndex(st, emb).WithSource(embed.KindKnowledge, func(context.Context) ([]embed.Item, error) {
+		return items, nil
+	})
+
+	require.NoError(t, ix.Reconcile(ctx))
+	assert.Equal(t, []string{"cats", "dogs"}, emb.seen)
+
+	emb.seen = nil
+	require.NoError(t, ix.Reconcile(ctx))
+	assert.Empty(t, emb.seen, "unchanged items are not re-embedded")
+
+	items = []embed.Item{{RefID: 1, Text: "kittens"}}
+	require.NoError(t, ix.Reconcile(ctx))
+	assert.Equal(t, []string{"kittens"}, emb.seen)
+	hashes, err := st.ListEmbeddingHashes(ctx, embed.KindKnowledge)
+	require.NoError(t, err)
+	assert.Len(t, hashes, 1, "vectors of removed items are deleted")
+
+	emb.seen = nil
+	emb.model = "m2"
+	require.NoError(t, ix.Reconcile(ctx))
+	assert.Equal(t, []string{"kittens"}, emb.seen, "a model change re-embeds everything")
+}
+
+func TestIndexReconcileErrors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	st := newMemStore()
+	emb := &topicEmbedder{model: "m"}
+	ix := embed.NewIndex(st, emb).
+		WithSource(embed.KindKnowledge, func(context.Context) ([]embed.Item, error) {
+			return nil, errors.New("db down")
+		}).
+		WithSource(embed.KindMemoryFact, func(context.Context) ([]embed.Item, error) {
+			return []embed.Item{{RefID: 1, Text: "cat"}}, nil
+		})
+
+	err := ix.Reconcile(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "db down")
+	hashes, err := st.ListEmbeddingHashes(ctx, embed.KindMemoryFact)
+	require.NoError(t, err)
+	assert.Len(t, hashes, 1, "a failing source does not stop the others")
+
+	emb.err = errors.New("rate limited")
+	_, err = ix.Similar(ctx, embed.KindMemoryFact, "", "cat", 5)
+	require.Error(t, err)
+}
+
+func TestIndexSimilar(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ix := embed.NewIndex(newMemStore(), &topicEmbedder{model: "m"}).
+		WithSource(embed.KindMemoryFact, func(context.Context) ([]embed.Item, error) {
+			return []embed.Item{
+				{RefID: 1, Scope: "a", Text: "pet: cat"},
+				{RefID: 2, Scope: "a", Text: "pet: dog"},
+				{RefID: 3, Scope: "b", Text: "pet: cat"},
+			}, nil
+		})
+	require.NoError(t, ix.Reconcile(ctx))
+
+	matches, err := ix.Similar(ctx, embed.KindMemoryFact, "a", "kitten", 5)
+	require.NoError(t, err)
+	require.Len(t, matches, 1, "dissimilar and out-of-scope rows are dropped")
+	assert.Equal(t, int64(1), matches[0].RefID)
+
+	matches, err = ix.Similar(ctx, embed.KindMemoryFact, "", "kitten", 1)
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+func TestFuse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		keyword  []int64
+		semantic []embed.Match
+		limit    int
+		want     []int64
+	}{
+		{name: "keyword only", keyword: []int64{3, 1, 2}, limit: 10, want: []int64{3, 1, 2}},
+		{name: "semantic only", semantic: []embed.Match{{RefID: 5}, {RefID: 4}}, limit: 10, want: []int64{5, 4}},
+		{
+			name:     "found both ways ranks first",
+			keyword:  []int64{1, 2},
+			semantic: []embed.Match{{RefID: 2}, {RefID: 9}},
+			limit:    10,
+			want:     []int64{2, 1, 9},
+		},
+		{name: "limit", keyword: []int64{1, 2, 3}, limit: 2, want: []int64{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, embed.Fuse(tt.keyword, tt.semantic, tt.limit))
+		})
+	}
+}
+
+func TestVectorHelpers(t *testing.T) {
+	t.Parallel()
+	v := []float32{0.5, -1.25, float32(math.Pi)}
+	assert.Equal(t, v, embed.DecodeVector(embed.EncodeVector(v)))
+	assert.Empty(t, embed.DecodeVector(nil))
+
+	assert.InDelta(t, 1.0, embed.Cosine([]float32{1, 2}, []float32{2, 4}), 1e-9)
+	assert.InDelta(t, 0.0, embed.Cosine([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	assert.Zero(t, embed.Cosine([]float32{1}, []float32{1, 2}))
+	assert.Zero(t, embed.Cosine([]float32{0, 0}, []float32{1, 1}))
+}
+
+func TestActiveKick(t *testing.T) {
+	embed.SetActive(nil)
+	embed.Kick() // no active index: no-op
+
+	ix := embed.NewIndex(newMemStore(), &topicEmbedder{model: "m"})
+	embed.SetActive(ix)
+	t.Cleanup(func() { embed.SetActive(nil) })
+	embed.Kick()
+	embed.Kick() // coalesced, never blocks
+	assert.Len(t, ix.Kicks(), 1)
+	assert.Same(t, ix, embed.Active())
+}
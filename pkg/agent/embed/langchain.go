@@ -0,0 +1,77 @@
+package embed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/llms/openai"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// batchSize bounds the inputs sent in one embeddings request.
+	batchSize      = 64
+	requestTimeout = time.Minute
+)
+
+// LangChain adapts a langchaingo embeddings client to Embedder.
+type LangChain struct {
+	embedder embeddings.Embedder
+	model    string
+}
+
+// NewLangChain wraps client, batching inputs and keeping newlines so stored
+// vectors stay comparable across releases.
+func NewLangChain(client embeddings.EmbedderClient, model string) (*LangChain, error) {
+	e, err := embeddings.NewEmbedder(client,
+		embeddings.WithBatchSize(batchSize),
+		embeddings.WithStripNewLines(false),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("embed: %s: %w", model, err)
+	}
+	return &LangChain{embedder: e, model: model}, nil
+}
+
+// newOpenAI creates an embedder for model on an OpenAI-compatible endpoint.
+// An empty baseURL uses the OpenAI API.
+func newOpenAI(baseURL, apiKey, model string) (*LangChain, error) {
+	opts := []openai.Option{
+		openai.WithToken(apiKey),
+		openai.WithModel(model),
+		openai.WithEmbeddingModel(model),
+		openai.WithHTTPClient(&http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+			Timeout:   requestTimeout,
+		}),
+	}
+	if baseURL != "" {
+		opts = append(opts, openai.WithBaseURL(baseURL))
+	}
+	llm, err := openai.New(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("embed: %s: %w", model, err)
+	}
+	return NewLangChain(llm, model)
+}
+
+// Model returns the embedding model name.
+func (l *LangChain) Model() string { return l.model }
+
+// Embed returns one vector per text.
+func (l *LangChain) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	vectors, err := l.embedder.EmbedDocuments(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("embed: %s: %w", l.model, err)
+	}
+	if len(vectors) != len(texts) {
+		return nil, fmt.Errorf("embed: %s returned %d vectors for %d inputs", l.model, len(vectors), len(texts))
+	}
+	return vectors, nil
+}
@@ -0,0 +1,114 @@
+package embed_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/embeddings"
+
+	"github.com/flowline-io/flowbot/pkg/agent/embed"
+	"github.com/flowline-io/flowbot/pkg/config"
+)
+
+func TestLangChainEmbed(t *testing.T) {
+	t.Parallel()
+	var batches []int
+	client := embeddings.EmbedderClientFunc(func(_ context.Context, texts []string) ([][]float32, error) {
+		batches = append(batches, len(texts))
+		out := make([][]float32, len(texts))
+		for i, s := range texts {
+			out[i] = []float32{float32(len(s)), float32(strings.Count(s, "\n"))}
+		}
+		return out, nil
+	})
+	e, err := embed.NewLangChain(client, "text-embedding-3-small")
+	require.NoError(t, err)
+
+	texts := make([]string, 70)
+	for i := range texts {
+		texts[i] = strings.Repeat("x", i)
+	}
+	texts[1] = "a\nb"
+	vectors, err := e.Embed(context.Background(), texts)
+	require.NoError(t, err)
+	require.Len(t, vectors, len(texts))
+	assert.Equal(t, []float32{0, 0}, vectors[0])
+	assert.Equal(t, []float32{3, 1}, vectors[1], "newlines are kept")
+	assert.Equal(t, []float32{69, 0}, vectors[69])
+	assert.Equal(t, []int{64, 6}, batches, "70 inputs are sent in two batches")
+	assert.Equal(t, "text-embedding-3-small", e.Model())
+
+	vectors, err = e.Embed(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, vectors)
+}
+
+func TestLangChainEmbedError(t *testing.T) {
+	t.Parallel()
+	client := embeddings.EmbedderClientFunc(func(context.Context, []string) ([][]float32, error) {
+		return nil, errors.New("model not found")
+	})
+	e, err := embed.NewLangChain(client, "broken")
+	require.NoError(t, err)
+	_, err = e.Embed(context.Background(), []string{"a"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "embed: broken")
+	assert.Contains(t, err.Error(), "model not found")
+}
+
+func TestLangChainEmbedCountMismatch(t *testing.T) {
+	t.Parallel()
+	client := embeddings.EmbedderClientFunc(func(context.Context, []string) ([][]float32, error) {
+		return [][]float32{{1}}, nil
+	})
+	e, err := embed.NewLangChain(client, "m")
+	require.NoError(t, err)
+	_, err = e.Embed(context.Background(), []string{"a", "b"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 vectors for 2 inputs")
+}
+
+func TestFromConfig(t *testing.T) {
+	prev := config.App
+	t.Cleanup(func() { config.App = prev })
+	config.App.Models = []config.Model{
+		{Provider: "openai_compatible", BaseUrl: "http://localhost:1/v1", ApiKey: "local", ModelNames: []string{"nomic-embed-text"}},
+		{Provider: "openai_compatible", BaseUrl: "http://localhost:1/v1", ModelNames: []string{"keyless-embed"}},
+		{Provider: "anthropic", ModelNames: []string{"claude-embed"}},
+	}
+
+	tests := []struct {
+		name    string
+		model   string
+		wantNil bool
+		wantErr string
+	}{
+		{name: "not configured", model: "", wantNil: true},
+		{name: "openai compatible", model: "nomic-embed-text"},
+		{name: "missing api key", model: "keyless-embed", wantErr: "keyless-embed"},
+		{name: "unknown model", model: "missing", wantErr: "unknown model"},
+		{name: "provider without embeddings", model: "claude-embed", wantErr: "no embeddings API"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.App.ChatAgent.EmbeddingModel = tt.model
+			e, err := embed.FromConfig()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, e)
+				return
+			}
+			require.NotNil(t, e)
+			assert.Equal(t, tt.model, e.Model())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package eval
+
+import (
+	"path/filepath"
+
+	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// WorkspacePlaceholder replaces the per-run scenario workspace path in cassettes.
+const WorkspacePlaceholder = "$WORKSPACE"
+
+// CassettePath returns the cassette file for one scenario under dir.
+func CassettePath(dir, caseName string) string {
+	return filepath.Join(dir, sanitizeFileName(caseName)+".json")
+}
+
+// OpenScenarioCassette wraps model in a cassette for one scenario. All trials
+// of the case share the file; identical requests replay in recorded order.
+// The workspace root is stored as WorkspacePlaceholder so a replay in a fresh
+// workspace matches. model may be nil in replay mode.
+func OpenScenarioCassette(model llms.Model, scenario Scenario, dir string, mode agentllm.CassetteMode) (*agentllm.CassetteModel, error) {
+	return agentllm.NewCassetteModel(model, CassettePath(dir, scenario.Name), agentllm.CassetteOptions{
+		Mode:         mode,
+		Replacements: workspaceReplacements(scenario.WorkspaceRoot),
+	})
+}
+
+// workspaceReplacements lists the absolute root before the configured one,
+// so tool output with either form maps to the same placeholder.
+func workspaceReplacements(root string) []agentllm.CassetteReplacement {
+	if root == "" {
+		return nil
+	}
+	var out []agentllm.CassetteReplacement
+	if abs, err := filepath.Abs(root); err == nil && abs != root {
+		out = append(out, agentllm.CassetteReplacement{Value: abs, Placeholder: WorkspacePlaceholder})
+	}
+	return append(out, agentllm.CassetteReplacement{Value: root, Placeholder: WorkspacePlaceholder})
+}
@@ -756,3 +756,45 @@ func TestRunWithModel_defaultsModelName(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "eval", captured.last)
 }
+
+func TestRunLiveScenarios_cassetteRecordReplay(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	sc := eval.Scenario{
+		Name:    "greet",
+		Prompt:  "hi",
+		Scripts: []agentllm.ResponseScript{eval.TextScript("Hello")},
+		Expect: eval.Expectation{
+			RequireCompletion: true,
+			Outcome:           eval.OutcomeAsserts{FinalTextContains: []string{"Hello"}},
+		},
+	}
+	recordModel := agentllm.NewFakeModel(append(sc.Scripts, sc.Scripts...)...)
+	report, err := eval.RunLiveScenarios(context.Background(), []eval.Scenario{sc}, recordModel, eval.LiveOptions{
+		Trials: 2, CassetteDir: dir, CassetteMode: agentllm.CassetteRecord,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Summary.Passed)
+	require.FileExists(t, eval.CassettePath(dir, sc.Name))
+
+	report, err = eval.RunLiveScenarios(context.Background(), []eval.Scenario{sc}, nil, eval.LiveOptions{
+		Trials: 2, CassetteDir: dir,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Summary.Passed)
+
+	_, err = eval.RunLiveScenarios(context.Background(), []eval.Scenario{sc}, nil, eval.LiveOptions{
+		Trials: 1, CassetteDir: dir,
+	})
+	require.ErrorIs(t, err, agentllm.ErrCassetteMismatch)
+	assert.Contains(t, err.Error(), "1 of 2 recorded request(s) were never sent")
+
+	drifted := sc
+	drifted.Prompt = "hi there"
+	_, err = eval.RunLiveScenarios(context.Background(), []eval.Scenario{drifted}, nil, eval.LiveOptions{
+		Trials: 2, CassetteDir: dir,
+	})
+	require.ErrorIs(t, err, agentllm.ErrCassetteMismatch)
+	assert.Contains(t, err.Error(), "case greet trial 1")
+	assert.Contains(t, err.Error(), "\n-  hi\n+  hi there")
+}
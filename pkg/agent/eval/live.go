@@ -2,9 +2,11 @@ package eval
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	agentllm "github.com/flowline-io/flowbot/pkg/agent/llm"
 	"github.com/tmc/langchaingo/llms"
 )
 
@@ -52,11 +54,23 @@ type LiveOptions struct {
 	LatencyBudgetMs int64
 	// TokenBudget overrides default L3 token budget when > 0.
 	TokenBudget int
+	// CassetteDir, when set, records or replays subject model traffic in one
+	// cassette per case (CassettePath). The judge model is not wrapped.
+	CassetteDir string
+	// CassetteMode is agentllm.CassetteReplay (default) or agentllm.CassetteRecord.
+	CassetteMode agentllm.CassetteMode
+}
+
+// replaying reports whether subject responses come from cassettes only.
+func (o LiveOptions) replaying() bool {
+	return o.CassetteDir != "" && o.CassetteMode != agentllm.CassetteRecord
 }
 
 // RunLiveScenarios runs each scenario k times with a real (or fake) model and aggregates pass@k / pass^k.
+// model may be nil when opts replays cassettes.
+// A cassette mismatch or an unplayed recording aborts the run with a diff.
 func RunLiveScenarios(ctx context.Context, scenarios []Scenario, model llms.Model, opts LiveOptions) (EvalReport, error) {
-	if model == nil {
+	if model == nil && !opts.replaying() {
 		return EvalReport{}, fmt.Errorf("eval: live model is required")
 	}
 	k := opts.Trials
@@ -133,6 +147,16 @@ func runLiveCase(
 		CaseIndex: idx + 1, CaseTotal: total, Trials: k,
 	})
 
+	var cassette *agentllm.CassetteModel
+	if opts.CassetteDir != "" {
+		var err error
+		cassette, err = OpenScenarioCassette(model, scenario, opts.CassetteDir, opts.CassetteMode)
+		if err != nil {
+			return CaseResult{}, nil, 0, 0, err
+		}
+		model = cassette
+	}
+
 	trialPasses := make([]bool, 0, k)
 	trialMetrics := make([]Metrics, 0, k)
 	var last RunResult
@@ -146,6 +170,9 @@ func runLiveCase(
 		if err != nil {
 			return CaseResult{}, nil, 0, 0, err
 		}
+		if errors.Is(run.Err, agentllm.ErrCassetteMismatch) {
+			return CaseResult{}, nil, 0, 0, fmt.Errorf("eval: case %s trial %d: %w", scenario.Name, i+1, run.Err)
+		}
 		last = run
 		totalDuration += run.Metrics.DurationMs
 		totalTokens += run.Metrics.TotalTokens
@@ -159,6 +186,11 @@ func runLiveCase(
 			Detail:   FailReason(run.Metrics, scenario.Expect),
 		})
 	}
+	if cassette != nil {
+		if err := cassette.Verify(); err != nil {
+			return CaseResult{}, nil, 0, 0, fmt.Errorf("eval: case %s: %w", scenario.Name, err)
+		}
+	}
 
 	cr := CaseResultFromRun(scenario.Name, last)
 	cr.Difficulty = NormalizeDifficulty(scenario.Difficulty)
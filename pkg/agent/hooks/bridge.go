@@ -61,6 +61,7 @@ func (r *Registry) beforeToolCallFn(runCtx context.Context) msg.BeforeToolCallFn
 			Block:     result.Block,
 			Reason:    result.Reason,
 			Terminate: result.Terminate,
+			Args:      result.Args,
 		}, nil
 	}
 }
@@ -20,6 +20,7 @@ func ChainTransformContext(inner, outer msg.TransformContextFn) msg.TransformCon
 }
 
 // ChainBeforeToolCall runs inner before outer and preserves the first block result.
+// Arguments rewritten by inner are passed to outer and kept unless outer rewrites them again.
 func ChainBeforeToolCall(inner, outer msg.BeforeToolCallFn) msg.BeforeToolCallFn {
 	if outer == nil {
 		return inner
@@ -28,16 +29,29 @@ func ChainBeforeToolCall(inner, outer msg.BeforeToolCallFn) msg.BeforeToolCallFn
 		return outer
 	}
 	return func(ctx msg.BeforeToolContext) (*msg.BeforeToolResult, error) {
-		if inner != nil {
-			result, err := inner(ctx)
-			if err != nil {
-				return nil, err
-			}
-			if result != nil && result.Block {
-				return result, nil
-			}
+		result, err := inner(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return outer(ctx)
+		if result != nil && result.Block {
+			return result, nil
+		}
+		var rewritten map[string]any
+		if result != nil && result.Args != nil {
+			rewritten = result.Args
+			ctx.Args = result.Args
+		}
+		outerResult, err := outer(ctx)
+		if err != nil || rewritten == nil {
+			return outerResult, err
+		}
+		if outerResult == nil {
+			return &msg.BeforeToolResult{Args: rewritten}, nil
+		}
+		if !outerResult.Block && outerResult.Args == nil {
+			outerResult.Args = rewritten
+		}
+		return outerResult, nil
 	}
 }
 
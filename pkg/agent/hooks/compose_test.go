@@ -98,6 +98,35 @@ func TestChainBeforeToolCall(t *testing.T) {
 	}
 }
 
+func TestChainBeforeToolCallCarriesArgRewrites(t *testing.T) {
+	t.Parallel()
+
+	rewrite := func(msg.BeforeToolContext) (*msg.BeforeToolResult, error) {
+		return &msg.BeforeToolResult{Args: map[string]any{"cmd": "ls -a"}}, nil
+	}
+	var outerSaw any
+	observe := func(ctx msg.BeforeToolContext) (*msg.BeforeToolResult, error) {
+		outerSaw = ctx.Args["cmd"]
+		return nil, nil
+	}
+
+	result, err := hooks.ChainBeforeToolCall(rewrite, observe)(msg.BeforeToolContext{Args: map[string]any{"cmd": "ls"}})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "ls -a", outerSaw)
+	assert.False(t, result.Block)
+	assert.Equal(t, map[string]any{"cmd": "ls -a"}, result.Args)
+
+	block := func(msg.BeforeToolContext) (*msg.BeforeToolResult, error) {
+		return &msg.BeforeToolResult{Block: true, Reason: "outer"}, nil
+	}
+	result, err = hooks.ChainBeforeToolCall(rewrite, block)(msg.BeforeToolContext{})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.Block)
+	assert.Nil(t, result.Args)
+}
+
 func TestChainAfterToolCall(t *testing.T) {
 	t.Parallel()
 
@@ -47,12 +47,15 @@ type ToolCallEvent struct {
 	Context   *msg.Context
 }
 
-// ToolCallResult can block tool execution.
+// ToolCallResult can block tool execution or rewrite its arguments.
 type ToolCallResult struct {
 	Block  bool
 	Reason string
 	// Terminate stops the agent loop after delivering the blocked tool result.
 	Terminate bool
+	// Args replaces the tool arguments when non-nil. Later handlers see the
+	// rewritten arguments.
+	Args map[string]any
 }
 
 // ToolResultEvent fires after a tool executes.
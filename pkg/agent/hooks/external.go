@@ -0,0 +1,368 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/agent/env"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+)
+
+// DefaultExternalTimeout bounds one external hook invocation when ExternalHook.Timeout is zero.
+const DefaultExternalTimeout = 10 * time.Second
+
+// maxExternalResponse caps how much hook output is read before decoding.
+const maxExternalResponse = 1 << 20
+
+// External hook decisions.
+const (
+	DecisionAllow = "allow"
+	DecisionBlock = "block"
+)
+
+// ExternalHook is an operator-declared hook that runs a shell command or posts to a URL.
+type ExternalHook struct {
+	// Name identifies the hook in payloads, logs, and block reasons.
+	Name string
+	// Events lists the hook event names this hook receives.
+	Events []string
+	// Tools filters tool_call and tool_result events by tool name glob; empty matches all tools.
+	Tools []string
+	// Command runs through the execution environment with the event JSON on stdin.
+	Command string
+	// URL receives the event JSON as a POST body when Command is empty.
+	URL string
+	// Headers are added to webhook requests.
+	Headers map[string]string
+	// Timeout bounds one invocation; zero uses DefaultExternalTimeout.
+	Timeout time.Duration
+	// FailClosed blocks the tool call or cancels the run when the hook errors or times out.
+	FailClosed bool
+}
+
+// ExternalDeps carries the runtime context shared by external hooks of one run.
+type ExternalDeps struct {
+	// Agent is the agent name reported in payloads ("chat" or a subagent name).
+	Agent     string
+	SessionID string
+	// Exec runs command hooks; nil uses env.Default.
+	Exec env.ExecutionEnv
+	// Dir is the working directory for command hooks.
+	Dir string
+	// HTTPClient posts webhook hooks; nil uses a client without a global timeout.
+	HTTPClient *http.Client
+	// Warn logs fail-open hook errors.
+	Warn func(string, ...any)
+}
+
+// ExternalPayload is the JSON document sent to external hooks.
+type ExternalPayload struct {
+	Event        string              `json:"event"`
+	Hook         string              `json:"hook"`
+	Agent        string              `json:"agent,omitempty"`
+	SessionID    string              `json:"session_id,omitempty"`
+	Model        string              `json:"model,omitempty"`
+	Tool         *ExternalToolCall   `json:"tool,omitempty"`
+	Result       *ExternalToolResult `json:"result,omitempty"`
+	ContextUsage *ContextUsageInfo   `json:"context_usage,omitempty"`
+	ActiveTools  []string            `json:"active_tools,omitempty"`
+	MessageCount int                 `json:"message_count,omitempty"`
+}
+
+// ExternalToolCall describes the tool call in an external hook payload.
+type ExternalToolCall struct {
+	ID   string         `json:"id"`
+	Name string         `json:"name"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// ExternalToolResult describes the tool output in a tool_result payload.
+type ExternalToolResult struct {
+	IsError bool   `json:"is_error"`
+	Text    string `json:"text"`
+}
+
+// ExternalDecision is the JSON document an external hook may return.
+type ExternalDecision struct {
+	// Decision is "allow" (default) or "block".
+	Decision string `json:"decision"`
+	Reason   string `json:"reason"`
+	// Terminate stops the agent loop after a blocked tool call.
+	Terminate bool `json:"terminate"`
+	// Args replaces the tool arguments of an allowed tool_call.
+	Args map[string]any `json:"args"`
+	// AppendContext is appended to the system prompt (before_agent_start) or the tool output (tool_result).
+	AppendContext string `json:"append_context"`
+}
+
+func (d ExternalDecision) blocked() bool {
+	return strings.EqualFold(d.Decision, DecisionBlock)
+}
+
+// RegisterExternal subscribes each external hook to its declared events on reg.
+func RegisterExternal(reg *Registry, specs []ExternalHook, deps ExternalDeps) {
+	if reg == nil {
+		return
+	}
+	for _, spec := range specs {
+		h := &externalHook{spec: spec, deps: deps}
+		for _, event := range spec.Events {
+			h.subscribe(reg, event)
+		}
+	}
+}
+
+type externalHook struct {
+	spec ExternalHook
+	deps ExternalDeps
+}
+
+func (h *externalHook) subscribe(reg *Registry, event string) {
+	switch event {
+	case EventBeforeAgentStart:
+		OnBeforeAgentStart(reg, h.beforeAgentStart)
+	case EventToolCall:
+		OnToolCall(reg, h.toolCall)
+	case EventToolResult:
+		OnToolResult(reg, h.toolResult)
+	case EventSavePoint, EventContextUsage, EventContextCompacted, EventModelUpdate, EventToolsUpdate:
+		OnObservation(reg, event, h.observe)
+	}
+}
+
+func (h *externalHook) beforeAgentStart(ctx context.Context, event BeforeAgentStartEvent) (*BeforeAgentStartResult, error) {
+	decision, err := h.invoke(ctx, ExternalPayload{
+		Event:        EventBeforeAgentStart,
+		Model:        event.ModelName,
+		MessageCount: len(event.Messages),
+	})
+	if err != nil {
+		if h.spec.FailClosed {
+			return &BeforeAgentStartResult{Cancel: true}, nil
+		}
+		h.warn(EventBeforeAgentStart, err)
+		return nil, nil
+	}
+	if decision.blocked() {
+		return &BeforeAgentStartResult{Cancel: true}, nil
+	}
+	if decision.AppendContext == "" {
+		return nil, nil
+	}
+	prompt := appendText(event.SystemPrompt, decision.AppendContext)
+	return &BeforeAgentStartResult{SystemPrompt: &prompt}, nil
+}
+
+func (h *externalHook) toolCall(ctx context.Context, event ToolCallEvent) (*ToolCallResult, error) {
+	if !h.matchesTool(event.ToolCall.Name) {
+		return nil, nil
+	}
+	decision, err := h.invoke(ctx, ExternalPayload{
+		Event: EventToolCall,
+		Tool:  &ExternalToolCall{ID: event.ToolCall.ID, Name: event.ToolCall.Name, Args: event.Args},
+	})
+	if err != nil {
+		if h.spec.FailClosed {
+			return &ToolCallResult{Block: true, Reason: fmt.Sprintf("hook %s failed: %v", h.spec.Name, err)}, nil
+		}
+		h.warn(EventToolCall, err)
+		return nil, nil
+	}
+	if decision.blocked() {
+		reason := decision.Reason
+		if reason == "" {
+			reason = "blocked by hook " + h.spec.Name
+		}
+		return &ToolCallResult{Block: true, Reason: reason, Terminate: decision.Terminate}, nil
+	}
+	if decision.Args != nil {
+		return &ToolCallResult{Args: decision.Args}, nil
+	}
+	return nil, nil
+}
+
+func (h *externalHook) toolResult(ctx context.Context, event ToolResultEvent) (*ToolResultResult, error) {
+	if !h.matchesTool(event.ToolCall.Name) {
+		return nil, nil
+	}
+	decision, err := h.invoke(ctx, ExternalPayload{
+		Event:  EventToolResult,
+		Tool:   &ExternalToolCall{ID: event.ToolCall.ID, Name: event.ToolCall.Name, Args: event.Args},
+		Result: &ExternalToolResult{IsError: event.Result.IsError, Text: partsText(event.Result.Parts)},
+	})
+	if err != nil {
+		h.warn(EventToolResult, err)
+		return nil, nil
+	}
+	if decision.AppendContext == "" {
+		return nil, nil
+	}
+	parts := append([]msg.ContentPart(nil), event.Result.Parts...)
+	parts = append(parts, msg.TextPart{Text: decision.AppendContext})
+	return &ToolResultResult{Parts: parts}, nil
+}
+
+func (h *externalHook) observe(ctx context.Context, event ObservationEvent) error {
+	_, err := h.invoke(ctx, ExternalPayload{
+		Event:        event.Type,
+		Model:        event.ModelName,
+		ContextUsage: event.ContextUsage,
+		ActiveTools:  event.ActiveTools,
+		MessageCount: len(event.Messages),
+	})
+	if err != nil {
+		return fmt.Errorf("hook %s: %w", h.spec.Name, err)
+	}
+	return nil
+}
+
+func (h *externalHook) matchesTool(name string) bool {
+	if len(h.spec.Tools) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(h.spec.Tools, func(pattern string) bool {
+		ok, err := path.Match(pattern, name)
+		return err == nil && ok
+	})
+}
+
+func (h *externalHook) warn(event string, err error) {
+	if h.deps.Warn != nil {
+		h.deps.Warn("agent hooks: external hook %s on %s: %v", h.spec.Name, event, err)
+	}
+}
+
+func (h *externalHook) invoke(ctx context.Context, payload ExternalPayload) (ExternalDecision, error) {
+	payload.Hook = h.spec.Name
+	payload.Agent = h.deps.Agent
+	payload.SessionID = h.deps.SessionID
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return ExternalDecision{}, fmt.Errorf("encode payload: %w", err)
+	}
+
+	timeout := h.spec.Timeout
+	if timeout <= 0 {
+		timeout = DefaultExternalTimeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if h.spec.Command != "" {
+		return h.runCommand(runCtx, payload.Event, body)
+	}
+	return h.postWebhook(runCtx, body)
+}
+
+// runCommand executes the hook command. Exit 0 allows (stdout may carry a
+// decision), exit 2 blocks with stderr as the reason, anything else fails.
+func (h *externalHook) runCommand(ctx context.Context, event string, body []byte) (ExternalDecision, error) {
+	execEnv := h.deps.Exec
+	if execEnv == nil {
+		execEnv = env.Default()
+	}
+	res := execEnv.Exec(ctx, env.ExecOptions{
+		Command: h.spec.Command,
+		Dir:     h.deps.Dir,
+		Stdin:   body,
+		Env:     []string{"FLOWBOT_HOOK_EVENT=" + event, "FLOWBOT_HOOK_NAME=" + h.spec.Name},
+		Timeout: ctx,
+	})
+	if !res.IsOk() {
+		return ExternalDecision{}, res.ErrorValue()
+	}
+	capture := res.Value()
+	switch capture.ExitCode {
+	case 0:
+		return decodeDecision([]byte(capture.Stdout))
+	case 2:
+		return ExternalDecision{Decision: DecisionBlock, Reason: strings.TrimSpace(capture.Stderr)}, nil
+	default:
+		return ExternalDecision{}, fmt.Errorf("command exited with status %d: %s",
+			capture.ExitCode, truncate(strings.TrimSpace(capture.Stderr), 200))
+	}
+}
+
+func (h *externalHook) postWebhook(ctx context.Context, body []byte) (ExternalDecision, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return ExternalDecision{}, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range h.spec.Headers {
+		req.Header.Set(key, value)
+	}
+	client := h.deps.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return ExternalDecision{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxExternalResponse))
+	if err != nil {
+		return ExternalDecision{}, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ExternalDecision{}, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return decodeDecision(data)
+}
+
+func decodeDecision(data []byte) (ExternalDecision, error) {
+	var decision ExternalDecision
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return decision, nil
+	}
+	if len(data) > maxExternalResponse {
+		return decision, errors.New("decision exceeds 1 MiB")
+	}
+	if err := json.Unmarshal(data, &decision); err != nil {
+		return ExternalDecision{}, fmt.Errorf("decode decision: %w", err)
+	}
+	switch strings.ToLower(decision.Decision) {
+	case "", DecisionAllow, DecisionBlock:
+		return decision, nil
+	default:
+		return ExternalDecision{}, fmt.Errorf("unknown decision %q", decision.Decision)
+	}
+}
+
+func partsText(parts []msg.ContentPart) string {
+	var b strings.Builder
+	for _, part := range parts {
+		if text, ok := part.(msg.TextPart); ok {
+			if b.Len() > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(text.Text)
+		}
+	}
+	return b.String()
+}
+
+func appendText(base, extra string) string {
+	if strings.TrimSpace(base) == "" {
+		return extra
+	}
+	return base + "\n\n" + extra
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit] + "…"
+}
@@ -0,0 +1,250 @@
+package hooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/agent/env"
+	"github.com/flowline-io/flowbot/pkg/agent/hooks"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedEnv answers Exec with a fixed capture and records the last call.
+type scriptedEnv struct {
+	env.ExecutionEnv
+	capture env.Capture
+	fail    bool
+	last    env.ExecOptions
+}
+
+func (s *scriptedEnv) Exec(_ context.Context, opts env.ExecOptions) result.Result[env.Capture, result.ExecutionError] {
+	s.last = opts
+	if s.fail {
+		return result.Err[env.Capture, result.ExecutionError](result.NewExecutionError("timeout", "deadline exceeded", nil))
+	}
+	return result.Ok[env.Capture, result.ExecutionError](s.capture)
+}
+
+func emitToolCall(t *testing.T, reg *hooks.Registry, name string) *hooks.ToolCallResult {
+	t.Helper()
+	res, err := reg.EmitToolCall(context.Background(), hooks.ToolCallEvent{
+		ToolCall: msg.ToolCallPart{ID: "c1", Name: name},
+		Args:     map[string]any{"command": "rm -rf build"},
+	})
+	require.NoError(t, err)
+	return res
+}
+
+func TestExternalCommandHookToolCall(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       hooks.ExternalHook
+		capture    env.Capture
+		fail       bool
+		tool       string
+		wantBlock  bool
+		wantReason string
+		wantArgs   map[string]any
+		wantCalled bool
+	}{
+		{
+			name:       "exit zero without output allows",
+			spec:       hooks.ExternalHook{Name: "audit", Events: []string{hooks.EventToolCall}, Command: "audit.sh"},
+			tool:       "bash",
+			wantCalled: true,
+		},
+		{
+			name:       "exit two blocks with stderr reason",
+			spec:       hooks.ExternalHook{Name: "guard", Events: []string{hooks.EventToolCall}, Command: "guard.sh"},
+			capture:    env.Capture{ExitCode: 2, Stderr: "no deletes\n"},
+			tool:       "bash",
+			wantBlock:  true,
+			wantReason: "no deletes",
+			wantCalled: true,
+		},
+		{
+			name:       "stdout decision rewrites args",
+			spec:       hooks.ExternalHook{Name: "rewrite", Events: []string{hooks.EventToolCall}, Command: "rewrite.sh"},
+			capture:    env.Capture{Stdout: `{"decision":"allow","args":{"command":"ls build"}}`},
+			tool:       "bash",
+			wantArgs:   map[string]any{"command": "ls build"},
+			wantCalled: true,
+		},
+		{
+			name:    "tool glob filters other tools",
+			spec:    hooks.ExternalHook{Name: "guard", Events: []string{hooks.EventToolCall}, Tools: []string{"bash*"}, Command: "guard.sh"},
+			capture: env.Capture{ExitCode: 2},
+			tool:    "read",
+		},
+		{
+			name:       "failure fails open by default",
+			spec:       hooks.ExternalHook{Name: "flaky", Events: []string{hooks.EventToolCall}, Command: "flaky.sh"},
+			capture:    env.Capture{ExitCode: 1, Stderr: "crash"},
+			tool:       "bash",
+			wantCalled: true,
+		},
+		{
+			name:       "failure blocks when fail closed",
+			spec:       hooks.ExternalHook{Name: "flaky", Events: []string{hooks.EventToolCall}, Command: "flaky.sh", FailClosed: true},
+			fail:       true,
+			tool:       "bash",
+			wantBlock:  true,
+			wantReason: "hook flaky failed: execution timeout: deadline exceeded",
+			wantCalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			execEnv := &scriptedEnv{capture: tt.capture, fail: tt.fail}
+			reg := hooks.NewRegistry()
+			hooks.RegisterExternal(reg, []hooks.ExternalHook{tt.spec}, hooks.ExternalDeps{
+				Agent: "chat", SessionID: "s1", Exec: execEnv, Dir: "/work",
+			})
+
+			res := emitToolCall(t, reg, tt.tool)
+			assert.Equal(t, tt.wantCalled, execEnv.last.Command != "")
+			if tt.wantCalled {
+				assert.Equal(t, "/work", execEnv.last.Dir)
+				assert.Contains(t, execEnv.last.Env, "FLOWBOT_HOOK_EVENT=tool_call")
+				var payload hooks.ExternalPayload
+				require.NoError(t, json.Unmarshal(execEnv.last.Stdin, &payload))
+				assert.Equal(t, tt.spec.Name, payload.Hook)
+				assert.Equal(t, "chat", payload.Agent)
+				require.NotNil(t, payload.Tool)
+				assert.Equal(t, "rm -rf build", payload.Tool.Args["command"])
+			}
+			switch {
+			case tt.wantBlock:
+				require.NotNil(t, res)
+				assert.True(t, res.Block)
+				assert.Equal(t, tt.wantReason, res.Reason)
+			case tt.wantArgs != nil:
+				require.NotNil(t, res)
+				assert.False(t, res.Block)
+				assert.Equal(t, tt.wantArgs, res.Args)
+			default:
+				assert.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestExternalWebhookHook(t *testing.T) {
+	t.Parallel()
+
+	var gotHeader string
+	var gotPayload hooks.ExternalPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &gotPayload)
+		switch gotPayload.Event {
+		case hooks.EventBeforeAgentStart:
+			_, _ = w.Write([]byte(`{"append_context":"Deploy freeze is active."}`))
+		case hooks.EventToolResult:
+			_, _ = w.Write([]byte(`{"append_context":"note: output audited"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	reg := hooks.NewRegistry()
+	hooks.RegisterExternal(reg, []hooks.ExternalHook{{
+		Name:       "policy",
+		Events:     []string{hooks.EventBeforeAgentStart, hooks.EventToolResult, hooks.EventToolCall},
+		URL:        srv.URL,
+		Headers:    map[string]string{"Authorization": "Bearer t"},
+		FailClosed: true,
+	}}, hooks.ExternalDeps{Agent: "chat", HTTPClient: srv.Client()})
+
+	start, err := reg.EmitBeforeAgentStart(context.Background(), hooks.BeforeAgentStartEvent{SystemPrompt: "base", ModelName: "m"})
+	require.NoError(t, err)
+	require.NotNil(t, start)
+	require.NotNil(t, start.SystemPrompt)
+	assert.Equal(t, "base\n\nDeploy freeze is active.", *start.SystemPrompt)
+	assert.Equal(t, "Bearer t", gotHeader)
+	assert.Equal(t, "m", gotPayload.Model)
+
+	patched, err := reg.EmitToolResult(context.Background(), hooks.ToolResultEvent{
+		ToolCall: msg.ToolCallPart{ID: "c1", Name: "bash"},
+		Result:   msg.ToolResultMessage{Parts: []msg.ContentPart{msg.TextPart{Text: "done"}}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, patched)
+	assert.Equal(t, []msg.ContentPart{msg.TextPart{Text: "done"}, msg.TextPart{Text: "note: output audited"}}, patched.Parts)
+	require.NotNil(t, gotPayload.Result)
+	assert.Equal(t, "done", gotPayload.Result.Text)
+
+	blocked := emitToolCall(t, reg, "bash")
+	require.NotNil(t, blocked)
+	assert.True(t, blocked.Block)
+	assert.Contains(t, blocked.Reason, "hook policy failed")
+}
+
+func TestExternalHookBlockCancelsRun(t *testing.T) {
+	t.Parallel()
+
+	reg := hooks.NewRegistry()
+	hooks.RegisterExternal(reg, []hooks.ExternalHook{{
+		Name: "gate", Events: []string{hooks.EventBeforeAgentStart}, Command: "gate.sh",
+	}}, hooks.ExternalDeps{Exec: &scriptedEnv{capture: env.Capture{Stdout: `{"decision":"block"}`}}})
+
+	res, err := reg.EmitBeforeAgentStart(context.Background(), hooks.BeforeAgentStartEvent{})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.True(t, res.Cancel)
+}
+
+func TestExternalCommandHookTimeout(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("requires /bin/sh")
+	}
+	t.Parallel()
+
+	reg := hooks.NewRegistry()
+	hooks.RegisterExternal(reg, []hooks.ExternalHook{{
+		Name: "slow", Events: []string{hooks.EventToolCall}, Command: "exec sleep 5",
+		Timeout: 50 * time.Millisecond, FailClosed: true,
+	}}, hooks.ExternalDeps{})
+
+	started := time.Now()
+	res := emitToolCall(t, reg, "bash")
+	assert.Less(t, time.Since(started), 3*time.Second)
+	require.NotNil(t, res)
+	assert.True(t, res.Block)
+}
+
+func TestExternalObservationHookReportsErrors(t *testing.T) {
+	t.Parallel()
+
+	execEnv := &scriptedEnv{capture: env.Capture{ExitCode: 1}}
+	reg := hooks.NewRegistry()
+	hooks.RegisterExternal(reg, []hooks.ExternalHook{{
+		Name: "notify", Events: []string{hooks.EventContextCompacted}, Command: "notify.sh",
+	}}, hooks.ExternalDeps{Exec: execEnv})
+
+	var warned []string
+	reg.EmitObservation(context.Background(), hooks.ObservationEvent{
+		Type:         hooks.EventContextCompacted,
+		ContextUsage: &hooks.ContextUsageInfo{Tokens: 10, ContextWindow: 100, Percent: 10},
+	}, func(format string, _ ...any) { warned = append(warned, format) })
+
+	require.Len(t, warned, 1)
+	var payload hooks.ExternalPayload
+	require.NoError(t, json.Unmarshal(execEnv.last.Stdin, &payload))
+	assert.Equal(t, hooks.EventContextCompacted, payload.Event)
+	require.NotNil(t, payload.ContextUsage)
+	assert.Equal(t, 10, payload.ContextUsage.Tokens)
+}
@@ -74,7 +74,7 @@ func OnContext(reg *Registry, handler contextHandler) {
 	reg.registerContext(handler)
 }
 
-// OnToolCall registers a handler that can block tool execution.
+// OnToolCall registers a handler that can block tool execution or rewrite its arguments.
 func OnToolCall(reg *Registry, handler toolCallHandler) {
 	if reg == nil {
 		return
@@ -252,21 +252,33 @@ func (r *Registry) EmitContext(ctx context.Context, messages []msg.AgentMessage)
 	return current, nil
 }
 
-// EmitToolCall runs tool_call handlers and stops on the first block.
+// EmitToolCall runs tool_call handlers and stops on the first block. Argument
+// rewrites chain: each handler sees the arguments left by the previous one.
 func (r *Registry) EmitToolCall(ctx context.Context, event ToolCallEvent) (*ToolCallResult, error) {
 	if r == nil {
 		return nil, nil
 	}
+	var rewritten map[string]any
 	for _, handler := range r.handlersToolCall() {
 		result, err := handler(ctx, event)
 		if err != nil {
 			return nil, err
 		}
-		if result != nil && result.Block {
+		if result == nil {
+			continue
+		}
+		if result.Block {
 			return result, nil
 		}
+		if result.Args != nil {
+			rewritten = result.Args
+			event.Args = result.Args
+		}
+	}
+	if rewritten == nil {
+		return nil, nil
 	}
-	return nil, nil
+	return &ToolCallResult{Args: rewritten}, nil
 }
 
 // EmitToolResult runs tool_result handlers and merges patches.
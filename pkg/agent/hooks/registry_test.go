@@ -120,6 +120,31 @@ func TestEmitToolCallStopsOnBlock(t *testing.T) {
 	}
 }
 
+func TestEmitToolCallChainsArgRewrites(t *testing.T) {
+	t.Parallel()
+
+	reg := hooks.NewRegistry()
+	hooks.OnToolCall(reg, func(_ context.Context, event hooks.ToolCallEvent) (*hooks.ToolCallResult, error) {
+		assert.Equal(t, "a", event.Args["path"])
+		return &hooks.ToolCallResult{Args: map[string]any{"path": "b"}}, nil
+	})
+	var seen any
+	hooks.OnToolCall(reg, func(_ context.Context, event hooks.ToolCallEvent) (*hooks.ToolCallResult, error) {
+		seen = event.Args["path"]
+		return nil, nil
+	})
+
+	result, err := reg.EmitToolCall(context.Background(), hooks.ToolCallEvent{
+		ToolCall: msg.ToolCallPart{ID: "1", Name: "read"},
+		Args:     map[string]any{"path": "a"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.False(t, result.Block)
+	assert.Equal(t, "b", seen)
+	assert.Equal(t, map[string]any{"path": "b"}, result.Args)
+}
+
 func TestEmitObservationContinuesOnHandlerError(t *testing.T) {
 	tests := []struct {
 		name      string
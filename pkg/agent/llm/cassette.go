@@ -0,0 +1,633 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// CassetteMode selects whether a CassetteModel records or replays traffic.
+type CassetteMode string
+
+const (
+	// CassetteReplay serves recorded responses and never calls a provider.
+	CassetteReplay CassetteMode = "replay"
+	// CassetteRecord forwards requests to the wrapped model and writes them to the cassette.
+	CassetteRecord CassetteMode = "record"
+)
+
+// CassetteEnv is read by CassetteModeFromEnv; set it to "record" to re-record test cassettes.
+const CassetteEnv = "FLOWBOT_LLM_CASSETTE"
+
+// cassetteVersion is bumped when the file layout or request fingerprint changes.
+const cassetteVersion = 1
+
+// ErrCassetteMismatch is wrapped by CassetteMismatchError. Mismatches are never retried.
+var ErrCassetteMismatch = errors.New("agent llm: cassette mismatch")
+
+// ParseCassetteMode validates a mode name; empty defaults to replay.
+func ParseCassetteMode(raw string) (CassetteMode, error) {
+	switch CassetteMode(strings.ToLower(strings.TrimSpace(raw))) {
+	case "", CassetteReplay:
+		return CassetteReplay, nil
+	case CassetteRecord:
+		return CassetteRecord, nil
+	default:
+		return "", fmt.Errorf("agent llm: invalid cassette mode %q (use replay|record)", raw)
+	}
+}
+
+// CassetteModeFromEnv returns CassetteRecord when FLOWBOT_LLM_CASSETTE=record, else CassetteReplay.
+func CassetteModeFromEnv() CassetteMode {
+	mode, err := ParseCassetteMode(os.Getenv(CassetteEnv))
+	if err != nil {
+		return CassetteReplay
+	}
+	return mode
+}
+
+// CassetteReplacement swaps a run-specific string (workspace root, temp dir)
+// for a stable placeholder in recorded requests and responses.
+type CassetteReplacement struct {
+	// Value is the string seen in live traffic.
+	Value string
+	// Placeholder is written to the cassette instead, e.g. "$WORKSPACE".
+	Placeholder string
+}
+
+// CassetteOptions configures NewCassetteModel.
+type CassetteOptions struct {
+	// Mode is CassetteReplay (default) or CassetteRecord.
+	Mode CassetteMode
+	// Replacements are applied before fingerprinting and restored on replay.
+	Replacements []CassetteReplacement
+}
+
+// CassetteMismatchError reports a replayed request that matches no unplayed recording.
+type CassetteMismatchError struct {
+	// Path is the cassette file.
+	Path string
+	// Request is the 1-based index of the request within this replay.
+	Request int
+	// Diff compares the next unplayed recording with the actual request
+	// ("-" recorded, "+" actual). Empty when every recording was already played.
+	Diff string
+}
+
+func (e *CassetteMismatchError) Error() string {
+	if e.Diff == "" {
+		return fmt.Sprintf("agent llm: cassette %s: request %d has no unplayed recording left; re-record with %s=record",
+			e.Path, e.Request, CassetteEnv)
+	}
+	return fmt.Sprintf("agent llm: cassette %s: request %d does not match the recording (prompt drift?); re-record with %s=record\n--- recorded\n+++ actual\n%s",
+		e.Path, e.Request, CassetteEnv, e.Diff)
+}
+
+// Unwrap lets errors.Is match ErrCassetteMismatch.
+func (e *CassetteMismatchError) Unwrap() error { return ErrCassetteMismatch }
+
+// CassetteModel wraps an llms.Model and records request/response pairs to a
+// JSON file, or replays them by request fingerprint without a provider.
+// Streamed text, reasoning chunks, tool calls and errors are all preserved.
+type CassetteModel struct {
+	mu           sync.Mutex
+	path         string
+	opts         CassetteOptions
+	inner        llms.Model
+	interactions []cassetteInteraction
+	played       []bool
+	requests     int
+}
+
+var _ llms.Model = (*CassetteModel)(nil)
+
+type cassetteFile struct {
+	Version      int                   `json:"version"`
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+type cassetteInteraction struct {
+	Fingerprint string           `json:"fingerprint"`
+	Request     cassetteRequest  `json:"request"`
+	Response    cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Model       string            `json:"model,omitempty"`
+	MaxTokens   int               `json:"max_tokens,omitempty"`
+	Temperature float64           `json:"temperature,omitempty"`
+	JSONMode    bool              `json:"json_mode,omitempty"`
+	ToolChoice  string            `json:"tool_choice,omitempty"`
+	Tools       []cassetteTool    `json:"tools,omitempty"`
+	Messages    []cassetteMessage `json:"messages"`
+}
+
+type cassetteTool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+}
+
+type cassetteMessage struct {
+	Role  string         `json:"role"`
+	Parts []cassettePart `json:"parts"`
+}
+
+type cassettePart struct {
+	Type      string `json:"type"`
+	Text      string `json:"text,omitempty"`
+	URL       string `json:"url,omitempty"`
+	MIMEType  string `json:"mime_type,omitempty"`
+	SHA256    string `json:"sha256,omitempty"`
+	Size      int    `json:"size,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments,omitempty"`
+}
+
+type cassetteResponse struct {
+	Chunks           []cassetteChunk    `json:"chunks,omitempty"`
+	Content          string             `json:"content,omitempty"`
+	ReasoningContent string             `json:"reasoning_content,omitempty"`
+	StopReason       string             `json:"stop_reason,omitempty"`
+	ToolCalls        []cassetteToolCall `json:"tool_calls,omitempty"`
+	GenerationInfo   map[string]any     `json:"generation_info,omitempty"`
+	Error            string             `json:"error,omitempty"`
+}
+
+// cassetteToolCall is a flat llms.ToolCall; the upstream JSON form does not round-trip.
+type cassetteToolCall struct {
+	ID        string `json:"id"`
+	Type      string `json:"type,omitempty"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// cassetteChunk is one streaming callback invocation.
+type cassetteChunk struct {
+	Reasoning string `json:"reasoning,omitempty"`
+	Text      string `json:"text,omitempty"`
+}
+
+// NewCassetteModel opens a cassette at path. Replay loads the file and needs
+// no inner model; record requires inner and rewrites the file after every request.
+func NewCassetteModel(inner llms.Model, path string, opts CassetteOptions) (*CassetteModel, error) {
+	mode, err := ParseCassetteMode(string(opts.Mode))
+	if err != nil {
+		return nil, err
+	}
+	opts.Mode = mode
+	m := &CassetteModel{path: path, opts: opts, inner: inner}
+	if mode == CassetteRecord {
+		if inner == nil {
+			return nil, fmt.Errorf("agent llm: cassette %s: record mode needs a model", path)
+		}
+		return m, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("agent llm: cassette %s: %w (record it with %s=record)", path, err, CassetteEnv)
+	}
+	var file cassetteFile
+	if err := sonic.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("agent llm: cassette %s: %w", path, err)
+	}
+	if file.Version != cassetteVersion {
+		return nil, fmt.Errorf("agent llm: cassette %s: version %d, want %d (re-record it)", path, file.Version, cassetteVersion)
+	}
+	m.interactions = file.Interactions
+	m.played = make([]bool, len(file.Interactions))
+	return m, nil
+}
+
+// Path returns the cassette file path.
+func (m *CassetteModel) Path() string {
+	return m.path
+}
+
+// Mode returns whether the cassette records or replays.
+func (m *CassetteModel) Mode() CassetteMode {
+	return m.opts.Mode
+}
+
+// Unplayed returns how many recordings a replay has not served yet.
+func (m *CassetteModel) Unplayed() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, ok := range m.played {
+		if !ok {
+			n++
+		}
+	}
+	return n
+}
+
+// Verify reports an error when a replay left recordings unplayed, which
+// means the run made fewer requests than when it was recorded.
+func (m *CassetteModel) Verify() error {
+	if m.opts.Mode != CassetteReplay {
+		return nil
+	}
+	if n := m.Unplayed(); n > 0 {
+		return fmt.Errorf("%w: cassette %s: %d of %d recorded request(s) were never sent",
+			ErrCassetteMismatch, m.path, n, len(m.interactions))
+	}
+	return nil
+}
+
+// GenerateContent implements llms.Model.
+func (m *CassetteModel) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	var opts llms.CallOptions
+	for _, opt := range options {
+		opt(&opts)
+	}
+	req := newCassetteRequest(messages, opts, m.redact)
+	if m.opts.Mode == CassetteRecord {
+		return m.record(ctx, req, messages, options, opts)
+	}
+	return m.replay(ctx, req, opts)
+}
+
+// Call implements llms.Model.
+func (m *CassetteModel) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, m, prompt, options...)
+}
+
+func (m *CassetteModel) record(
+	ctx context.Context,
+	req cassetteRequest,
+	messages []llms.MessageContent,
+	options []llms.CallOption,
+	opts llms.CallOptions,
+) (*llms.ContentResponse, error) {
+	var chunksMu sync.Mutex
+	var chunks []cassetteChunk
+	capture := func(reasoning, text []byte) {
+		chunksMu.Lock()
+		defer chunksMu.Unlock()
+		chunks = append(chunks, cassetteChunk{Reasoning: m.redact(string(reasoning)), Text: m.redact(string(text))})
+	}
+	wrapped := append([]llms.CallOption(nil), options...)
+	if opts.StreamingFunc != nil {
+		inner := opts.StreamingFunc
+		wrapped = append(wrapped, llms.WithStreamingFunc(func(streamCtx context.Context, chunk []byte) error {
+			capture(nil, chunk)
+			return inner(streamCtx, chunk)
+		}))
+	}
+	if opts.StreamingReasoningFunc != nil {
+		inner := opts.StreamingReasoningFunc
+		wrapped = append(wrapped, llms.WithStreamingReasoningFunc(func(streamCtx context.Context, reasoningChunk, chunk []byte) error {
+			capture(reasoningChunk, chunk)
+			return inner(streamCtx, reasoningChunk, chunk)
+		}))
+	}
+
+	resp, err := m.inner.GenerateContent(ctx, messages, wrapped...)
+	if ctx.Err() != nil {
+		// A cancelled run says nothing about the provider; keep it out of the cassette.
+		return resp, err
+	}
+	chunksMu.Lock()
+	rec := cassetteResponse{Chunks: chunks}
+	chunksMu.Unlock()
+	if err != nil {
+		rec.Error = m.redact(err.Error())
+	} else if resp != nil && len(resp.Choices) > 0 {
+		choice := resp.Choices[0]
+		rec.Content = m.redact(choice.Content)
+		rec.ReasoningContent = m.redact(choice.ReasoningContent)
+		rec.StopReason = choice.StopReason
+		rec.ToolCalls = newCassetteToolCalls(choice.ToolCalls, m.redact)
+		rec.GenerationInfo = cassetteGenerationInfo(choice.GenerationInfo)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.interactions = append(m.interactions, cassetteInteraction{
+		Fingerprint: req.fingerprint(),
+		Request:     req,
+		Response:    rec,
+	})
+	if saveErr := m.saveLocked(); saveErr != nil {
+		return nil, saveErr
+	}
+	return resp, err
+}
+
+func (m *CassetteModel) replay(ctx context.Context, req cassetteRequest, opts llms.CallOptions) (*llms.ContentResponse, error) {
+	fingerprint := req.fingerprint()
+	m.mu.Lock()
+	m.requests++
+	idx := -1
+	next := -1
+	for i, it := range m.interactions {
+		if m.played[i] {
+			continue
+		}
+		if next < 0 {
+			next = i
+		}
+		if it.Fingerprint == fingerprint {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		mismatch := &CassetteMismatchError{Path: m.path, Request: m.requests}
+		if next >= 0 {
+			mismatch.Diff = lineDiff(m.interactions[next].Request.lines(), req.lines())
+		}
+		m.mu.Unlock()
+		return nil, mismatch
+	}
+	m.played[idx] = true
+	rec := m.interactions[idx].Response
+	m.mu.Unlock()
+
+	if err := m.emit(ctx, rec, opts); err != nil {
+		return nil, err
+	}
+	if rec.Error != "" {
+		return nil, errors.New(m.restore(rec.Error))
+	}
+	return &llms.ContentResponse{Choices: []*llms.ContentChoice{{
+		Content:          m.restore(rec.Content),
+		ReasoningContent: m.restore(rec.ReasoningContent),
+		StopReason:       rec.StopReason,
+		ToolCalls:        restoreToolCalls(rec.ToolCalls, m.restore),
+		GenerationInfo:   rec.GenerationInfo,
+	}}}, nil
+}
+
+// emit replays recorded streaming callbacks. Reasoning chunks are dropped
+// when the caller only set a text StreamingFunc, like a real provider would.
+func (m *CassetteModel) emit(ctx context.Context, rec cassetteResponse, opts llms.CallOptions) error {
+	chunks := rec.Chunks
+	if len(chunks) == 0 && rec.Content != "" {
+		chunks = []cassetteChunk{{Text: rec.Content}}
+	}
+	for _, chunk := range chunks {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		text := m.restore(chunk.Text)
+		switch {
+		case opts.StreamingReasoningFunc != nil:
+			if err := opts.StreamingReasoningFunc(ctx, bytesOrNil(m.restore(chunk.Reasoning)), bytesOrNil(text)); err != nil {
+				return err
+			}
+		case opts.StreamingFunc != nil && text != "":
+			if err := opts.StreamingFunc(ctx, []byte(text)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *CassetteModel) saveLocked() error {
+	data, err := sonic.ConfigStd.MarshalIndent(cassetteFile{
+		Version:      cassetteVersion,
+		Interactions: m.interactions,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("agent llm: cassette %s: %w", m.path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(m.path), 0o750); err != nil {
+		return fmt.Errorf("agent llm: cassette %s: %w", m.path, err)
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("agent llm: cassette %s: %w", m.path, err)
+	}
+	if err := os.Rename(tmp, m.path); err != nil {
+		return fmt.Errorf("agent llm: cassette %s: %w", m.path, err)
+	}
+	return nil
+}
+
+func (m *CassetteModel) redact(s string) string {
+	for _, r := range m.opts.Replacements {
+		if r.Value != "" {
+			s = strings.ReplaceAll(s, r.Value, r.Placeholder)
+		}
+	}
+	return s
+}
+
+func (m *CassetteModel) restore(s string) string {
+	for _, r := range m.opts.Replacements {
+		if r.Placeholder != "" {
+			s = strings.ReplaceAll(s, r.Placeholder, r.Value)
+		}
+	}
+	return s
+}
+
+func newCassetteRequest(messages []llms.MessageContent, opts llms.CallOptions, redact func(string) string) cassetteRequest {
+	req := cassetteRequest{
+		Model:       opts.Model,
+		MaxTokens:   opts.MaxTokens,
+		Temperature: opts.Temperature,
+		JSONMode:    opts.JSONMode,
+		Messages:    make([]cassetteMessage, 0, len(messages)),
+	}
+	if opts.ToolChoice != nil {
+		req.ToolChoice = stableJSON(opts.ToolChoice)
+	}
+	for _, t := range opts.Tools {
+		tool := cassetteTool{Name: t.Type}
+		if t.Function != nil {
+			tool.Name = t.Function.Name
+			tool.Description = redact(t.Function.Description)
+			if t.Function.Parameters != nil {
+				tool.Parameters = json.RawMessage(redact(stableJSON(t.Function.Parameters)))
+			}
+		}
+		req.Tools = append(req.Tools, tool)
+	}
+	for _, mc := range messages {
+		out := cassetteMessage{Role: string(mc.Role), Parts: make([]cassettePart, 0, len(mc.Parts))}
+		for _, part := range mc.Parts {
+			out.Parts = append(out.Parts, newCassettePart(part, redact))
+		}
+		req.Messages = append(req.Messages, out)
+	}
+	return req
+}
+
+func newCassettePart(part llms.ContentPart, redact func(string) string) cassettePart {
+	switch p := part.(type) {
+	case llms.TextContent:
+		return cassettePart{Type: "text", Text: redact(p.Text)}
+	case llms.ImageURLContent:
+		if strings.HasPrefix(p.URL, "data:") {
+			// Inline images are fingerprinted by hash to keep cassettes small.
+			return cassettePart{Type: "image_url", SHA256: sha256Hex([]byte(p.URL)), Size: len(p.URL)}
+		}
+		return cassettePart{Type: "image_url", URL: redact(p.URL)}
+	case llms.BinaryContent:
+		return cassettePart{Type: "binary", MIMEType: p.MIMEType, SHA256: sha256Hex(p.Data), Size: len(p.Data)}
+	case llms.ToolCall:
+		out := cassettePart{Type: "tool_call", ID: p.ID}
+		if p.FunctionCall != nil {
+			out.Name = p.FunctionCall.Name
+			out.Arguments = redact(p.FunctionCall.Arguments)
+		}
+		return out
+	case llms.ToolCallResponse:
+		return cassettePart{Type: "tool_result", ID: p.ToolCallID, Name: p.Name, Text: redact(p.Content)}
+	default:
+		return cassettePart{Type: fmt.Sprintf("%T", part), Text: redact(fmt.Sprintf("%+v", part))}
+	}
+}
+
+// lines renders the request for fingerprinting and mismatch diffs. Every
+// field that reaches the provider appears on its own line, so a diff points
+// at the message and line that drifted.
+func (r cassetteRequest) lines() []string {
+	out := []string{"model: " + r.Model}
+	if r.MaxTokens > 0 {
+		out = append(out, "max_tokens: "+strconv.Itoa(r.MaxTokens))
+	}
+	if r.Temperature != 0 {
+		out = append(out, "temperature: "+strconv.FormatFloat(r.Temperature, 'g', -1, 64))
+	}
+	if r.JSONMode {
+		out = append(out, "json_mode: true")
+	}
+	if r.ToolChoice != "" {
+		out = append(out, "tool_choice: "+r.ToolChoice)
+	}
+	for _, t := range r.Tools {
+		out = append(out, "tool "+t.Name+":")
+		out = appendIndented(out, t.Description, "  ")
+		if len(t.Parameters) > 0 {
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, t.Parameters); err != nil {
+				compact.Reset()
+				_, _ = compact.Write(t.Parameters)
+			}
+			out = append(out, "  parameters: "+compact.String())
+		}
+	}
+	for i, mc := range r.Messages {
+		out = append(out, fmt.Sprintf("message %d %s:", i+1, mc.Role))
+		for _, p := range mc.Parts {
+			switch p.Type {
+			case "text":
+				out = appendIndented(out, p.Text, "  ")
+			case "image_url", "binary":
+				ref := p.URL
+				if p.SHA256 != "" {
+					ref = fmt.Sprintf("%s sha256=%s size=%d", p.MIMEType, p.SHA256, p.Size)
+				}
+				out = append(out, "  ["+p.Type+" "+strings.TrimSpace(ref)+"]")
+			case "tool_call":
+				out = append(out, "  [tool_call "+p.ID+" "+p.Name+"] "+p.Arguments)
+			case "tool_result":
+				out = append(out, "  [tool_result "+p.ID+" "+p.Name+"]")
+				out = appendIndented(out, p.Text, "    ")
+			default:
+				out = append(out, "  ["+p.Type+"]")
+				out = appendIndented(out, p.Text, "    ")
+			}
+		}
+	}
+	return out
+}
+
+func (r cassetteRequest) fingerprint() string {
+	return sha256Hex([]byte(strings.Join(r.lines(), "\n")))
+}
+
+func appendIndented(out []string, text, indent string) []string {
+	if text == "" {
+		return out
+	}
+	for line := range strings.SplitSeq(text, "\n") {
+		out = append(out, indent+line)
+	}
+	return out
+}
+
+func newCassetteToolCalls(calls []llms.ToolCall, redact func(string) string) []cassetteToolCall {
+	out := make([]cassetteToolCall, 0, len(calls))
+	for _, call := range calls {
+		tc := cassetteToolCall{ID: call.ID, Type: call.Type}
+		if call.FunctionCall != nil {
+			tc.Name = call.FunctionCall.Name
+			tc.Arguments = redact(call.FunctionCall.Arguments)
+		}
+		out = append(out, tc)
+	}
+	return out
+}
+
+func restoreToolCalls(calls []cassetteToolCall, restore func(string) string) []llms.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]llms.ToolCall, 0, len(calls))
+	for _, call := range calls {
+		out = append(out, llms.ToolCall{
+			ID:           call.ID,
+			Type:         call.Type,
+			FunctionCall: &llms.FunctionCall{Name: call.Name, Arguments: restore(call.Arguments)},
+		})
+	}
+	return out
+}
+
+// cassetteGenerationInfo keeps scalar provider metadata such as token usage;
+// provider-specific structs are dropped so cassettes stay portable.
+func cassetteGenerationInfo(info map[string]any) map[string]any {
+	out := make(map[string]any, len(info))
+	for k, v := range info {
+		switch v.(type) {
+		case string, bool, int, int32, int64, float32, float64:
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func stableJSON(v any) string {
+	data, err := sonic.ConfigStd.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func bytesOrNil(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	return []byte(s)
+}
@@ -0,0 +1,146 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContext is the number of unchanged lines kept around each change.
+	diffContext = 3
+	// diffMaxLines caps mismatch output; long prompts usually drift in one place.
+	diffMaxLines = 200
+	// diffMaxCells bounds the LCS table; larger inputs fall back to a block replace.
+	diffMaxCells = 4_000_000
+)
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	text string
+	// a and b are the 0-based line positions in each input before this op.
+	a, b int
+}
+
+// lineDiff renders a unified diff of recorded (a) against actual (b) lines.
+func lineDiff(a, b []string) string {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	kinds := make([]byte, 0, len(a)+len(b))
+	texts := make([]string, 0, len(a)+len(b))
+	for i := 0; i < prefix; i++ {
+		kinds, texts = append(kinds, ' '), append(texts, a[i])
+	}
+	midKinds, midTexts := diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	kinds, texts = append(kinds, midKinds...), append(texts, midTexts...)
+	for i := len(a) - suffix; i < len(a); i++ {
+		kinds, texts = append(kinds, ' '), append(texts, a[i])
+	}
+
+	ops := make([]diffOp, 0, len(kinds))
+	var ai, bi int
+	for i, kind := range kinds {
+		ops = append(ops, diffOp{kind: kind, text: texts[i], a: ai, b: bi})
+		if kind != '+' {
+			ai++
+		}
+		if kind != '-' {
+			bi++
+		}
+	}
+	return formatHunks(ops)
+}
+
+// diffMiddle computes an LCS edit script for the lines between the common prefix and suffix.
+func diffMiddle(a, b []string) ([]byte, []string) {
+	kinds := make([]byte, 0, len(a)+len(b))
+	texts := make([]string, 0, len(a)+len(b))
+	if len(a)*len(b) > diffMaxCells {
+		for _, line := range a {
+			kinds, texts = append(kinds, '-'), append(texts, line)
+		}
+		for _, line := range b {
+			kinds, texts = append(kinds, '+'), append(texts, line)
+		}
+		return kinds, texts
+	}
+	width := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else {
+				lcs[i*width+j] = max(lcs[(i+1)*width+j], lcs[i*width+j+1])
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			kinds, texts = append(kinds, ' '), append(texts, a[i])
+			i++
+			j++
+		case lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+			kinds, texts = append(kinds, '-'), append(texts, a[i])
+			i++
+		default:
+			kinds, texts = append(kinds, '+'), append(texts, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		kinds, texts = append(kinds, '-'), append(texts, a[i])
+	}
+	for ; j < len(b); j++ {
+		kinds, texts = append(kinds, '+'), append(texts, b[j])
+	}
+	return kinds, texts
+}
+
+func formatHunks(ops []diffOp) string {
+	var changes []int
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	var lines []string
+	for k := 0; k < len(changes); {
+		start := max(0, changes[k]-diffContext)
+		last := changes[k]
+		for k+1 < len(changes) && changes[k+1]-last <= 2*diffContext {
+			k++
+			last = changes[k]
+		}
+		k++
+		end := min(len(ops), last+diffContext+1)
+		var aCount, bCount int
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				aCount++
+			}
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+		lines = append(lines, fmt.Sprintf("@@ -%d,%d +%d,%d @@", ops[start].a+1, aCount, ops[start].b+1, bCount))
+		for _, op := range ops[start:end] {
+			lines = append(lines, string(op.kind)+op.text)
+		}
+	}
+	if len(lines) > diffMaxLines {
+		more := len(lines) - diffMaxLines
+		lines = append(lines[:diffMaxLines], fmt.Sprintf("... (%d more diff lines)", more))
+	}
+	return strings.Join(lines, "\n")
+}
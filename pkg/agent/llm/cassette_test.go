@@ -0,0 +1,161 @@
+package llm_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/agent/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+)
+
+func cassetteTurn(t *testing.T, model llms.Model, prompt string) (llm.AssistantResult, string, string, error) {
+	t.Helper()
+	var text, reasoning strings.Builder
+	result, err := llm.StreamAssistant(context.Background(), model, "You are terse.", []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeHuman, prompt),
+	}, llm.StreamOptions{
+		ModelName: "gpt-5.5",
+		OnTextDelta: func(delta string) error {
+			text.WriteString(delta)
+			return nil
+		},
+		OnReasoningDelta: func(delta string) error {
+			reasoning.WriteString(delta)
+			return nil
+		},
+		Retry: llm.RetryConfig{MaxAttempts: 1},
+	})
+	return result, text.String(), reasoning.String(), err
+}
+
+func TestCassetteRecordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "turn.json")
+	toolCall := llms.ToolCall{ID: "call_1", Type: "function", FunctionCall: &llms.FunctionCall{
+		Name: "read_file", Arguments: `{"path":"/tmp/ws-123/notes.md"}`,
+	}}
+	inner := llm.NewFakeModel(
+		llm.ResponseScript{
+			ReasoningChunks: []string{"think ", "hard"},
+			Chunks:          []string{"Hel", "lo"},
+		},
+		llm.ResponseScript{Content: "reading", ToolCalls: []llms.ToolCall{toolCall}},
+	)
+	opts := llm.CassetteOptions{
+		Mode:         llm.CassetteRecord,
+		Replacements: []llm.CassetteReplacement{{Value: "/tmp/ws-123", Placeholder: "$WORKSPACE"}},
+	}
+	recorder, err := llm.NewCassetteModel(inner, path, opts)
+	require.NoError(t, err)
+
+	first, firstText, firstReasoning, err := cassetteTurn(t, recorder, "greet")
+	require.NoError(t, err)
+	second, _, _, err := cassetteTurn(t, recorder, "open /tmp/ws-123/notes.md")
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "$WORKSPACE/notes.md")
+	assert.NotContains(t, string(raw), "/tmp/ws-123")
+
+	opts.Mode = llm.CassetteReplay
+	player, err := llm.NewCassetteModel(nil, path, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 2, player.Unplayed())
+
+	// Replay is keyed by fingerprint, so request order does not matter.
+	replaySecond, _, _, err := cassetteTurn(t, player, "open /tmp/ws-123/notes.md")
+	require.NoError(t, err)
+	replayFirst, text, reasoning, err := cassetteTurn(t, player, "greet")
+	require.NoError(t, err)
+
+	assert.Equal(t, first.Content, replayFirst.Content)
+	assert.Equal(t, firstText, text)
+	assert.Equal(t, firstReasoning, reasoning)
+	assert.Equal(t, "think hard", reasoning)
+	assert.Equal(t, second.ToolCalls, replaySecond.ToolCalls)
+	assert.Equal(t, "tool_calls", replaySecond.StopReason)
+	assert.Equal(t, 2, inner.Calls(), "replay must not reach the wrapped model")
+	require.NoError(t, player.Verify())
+}
+
+func TestCassetteReplayMismatchShowsDiff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drift.json")
+	recorder, err := llm.NewCassetteModel(llm.NewFakeModel(llm.ResponseScript{Content: "ok"}), path,
+		llm.CassetteOptions{Mode: llm.CassetteRecord})
+	require.NoError(t, err)
+	_, _, _, err = cassetteTurn(t, recorder, "summarize the report\nin three bullets")
+	require.NoError(t, err)
+
+	player, err := llm.NewCassetteModel(nil, path, llm.CassetteOptions{})
+	require.NoError(t, err)
+	_, _, _, err = cassetteTurn(t, player, "summarize the report\nin five bullets")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, llm.ErrCassetteMismatch))
+	assert.False(t, llm.IsRetryableLLMError(err))
+
+	var mismatch *llm.CassetteMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, 1, mismatch.Request)
+	assert.Contains(t, mismatch.Diff, "-  in three bullets")
+	assert.Contains(t, mismatch.Diff, "+  in five bullets")
+	assert.Contains(t, mismatch.Diff, "   summarize the report")
+	assert.NotContains(t, mismatch.Diff, "model: gpt-5.5", "unchanged lines outside the context window are omitted")
+
+	err = player.Verify()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 1 recorded request(s) were never sent")
+}
+
+func TestCassetteReplayIdenticalRequestsInOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trials.json")
+	recorder, err := llm.NewCassetteModel(llm.NewFakeModel(
+		llm.ResponseScript{Content: "first"},
+		llm.ResponseScript{Err: errors.New("429 Too Many Requests")},
+	), path, llm.CassetteOptions{Mode: llm.CassetteRecord})
+	require.NoError(t, err)
+	_, _, _, err = cassetteTurn(t, recorder, "same")
+	require.NoError(t, err)
+	_, _, _, err = cassetteTurn(t, recorder, "same")
+	require.Error(t, err)
+
+	player, err := llm.NewCassetteModel(nil, path, llm.CassetteOptions{})
+	require.NoError(t, err)
+	got, _, _, err := cassetteTurn(t, player, "same")
+	require.NoError(t, err)
+	assert.Equal(t, "first", got.Content)
+	_, _, _, err = cassetteTurn(t, player, "same")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "429 Too Many Requests")
+
+	_, _, _, err = cassetteTurn(t, player, "same")
+	var mismatch *llm.CassetteMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Empty(t, mismatch.Diff)
+	assert.Contains(t, err.Error(), "no unplayed recording left")
+}
+
+func TestNewCassetteModelErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		inner   llms.Model
+		opts    llm.CassetteOptions
+		wantErr string
+	}{
+		{name: "replay missing file", opts: llm.CassetteOptions{Mode: llm.CassetteReplay}, wantErr: "record it with FLOWBOT_LLM_CASSETTE=record"},
+		{name: "record without model", opts: llm.CassetteOptions{Mode: llm.CassetteRecord}, wantErr: "record mode needs a model"},
+		{name: "invalid mode", opts: llm.CassetteOptions{Mode: "rewind"}, wantErr: "invalid cassette mode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := llm.NewCassetteModel(tt.inner, filepath.Join(t.TempDir(), "missing.json"), tt.opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
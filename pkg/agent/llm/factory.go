@@ -50,6 +50,7 @@ func ResetModelPoolForTest() {
 }
 
 // GetOrCreateModel returns a cached langchaingo model for the given model name.
+// A model_aliases name returns a *FailoverModel over its chain.
 func GetOrCreateModel(ctx context.Context, modelName string) (llms.Model, string, error) {
 	if cached, ok := modelPool.Load(modelName); ok {
 		if entry, ok := cached.(pooledModel); ok {
@@ -57,14 +58,24 @@ func GetOrCreateModel(ctx context.Context, modelName string) (llms.Model, string
 		}
 		modelPool.Delete(modelName)
 	}
-	modelCreatorMu.RLock()
-	creator := modelCreator
-	modelCreatorMu.RUnlock()
-	model, resolvedName, err := creator(ctx, modelName)
+	var (
+		model        llms.Model
+		resolvedName string
+		err          error
+	)
+	if alias, ok := config.ModelAliasFor(modelName); ok {
+		model, err = newFailoverModel(ctx, alias)
+		resolvedName = modelName
+	} else {
+		modelCreatorMu.RLock()
+		creator := modelCreator
+		modelCreatorMu.RUnlock()
+		model, resolvedName, err = creator(ctx, modelName)
+	}
 	if err != nil {
 		return nil, "", err
 	}
-	provider := resolveModel(modelName).Provider
+	provider := config.ModelProviderFor(modelName)
 	actual, loaded := modelPool.LoadOrStore(modelName, pooledModel{model: model, name: resolvedName, provider: provider})
 	if loaded {
 		if entry, ok := actual.(pooledModel); ok {
@@ -133,13 +144,26 @@ func OpenAICompatibleBaseURLForTest(cfg config.Model) string {
 }
 
 // ProviderForModel returns the configured provider name for a model.
+// An alias reports the provider of the first model in its chain.
 func ProviderForModel(modelName string) string {
 	if cached, ok := modelPool.Load(modelName); ok {
 		if entry, ok := cached.(pooledModel); ok && entry.provider != "" {
 			return entry.provider
 		}
 	}
-	return resolveModel(modelName).Provider
+	return config.ModelProviderFor(modelName)
+}
+
+// ModelConfig returns the configured provider entry serving modelName, or a
+// zero value when no entry lists it.
+func ModelConfig(modelName string) config.Model {
+	return resolveModel(modelName)
+}
+
+// OpenAICompatibleBaseURL returns the API base URL for an OpenAI-family
+// provider entry, or "" for the provider's default.
+func OpenAICompatibleBaseURL(cfg config.Model) string {
+	return openaiCompatibleBaseURL(cfg)
 }
 
 func resolveModel(modelName string) config.Model {
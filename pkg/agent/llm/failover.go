@@ -0,0 +1,200 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/bulkhead"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/sony/gobreaker"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// Defaults for the zero values of config.ModelCircuit.
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitCooldown         = 30 * time.Second
+)
+
+// failoverEntry is one model in an alias chain with the circuit of its provider endpoint.
+type failoverEntry struct {
+	name     string
+	provider string
+	model    llms.Model
+	circuit  *gobreaker.TwoStepCircuitBreaker
+}
+
+// FailoverModel serves a model_aliases entry. Each request goes to the first
+// chain model whose provider circuit is closed and moves on when that model
+// fails with a retryable error, a rate limit or a context overflow before
+// any output was streamed.
+type FailoverModel struct {
+	alias   string
+	entries []failoverEntry
+}
+
+var _ llms.Model = (*FailoverModel)(nil)
+
+func newFailoverModel(ctx context.Context, alias config.ModelAlias) (*FailoverModel, error) {
+	entries := make([]failoverEntry, 0, len(alias.Chain))
+	for _, name := range alias.Chain {
+		model, resolvedName, err := GetOrCreateModel(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("agent llm: alias %q: %w", alias.Name, err)
+		}
+		cfg := resolveModel(name)
+		entries = append(entries, failoverEntry{
+			name:     resolvedName,
+			provider: cfg.Provider,
+			model:    model,
+			circuit:  providerCircuit(cfg),
+		})
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("agent llm: alias %q has an empty chain", alias.Name)
+	}
+	return &FailoverModel{alias: alias.Name, entries: entries}, nil
+}
+
+// providerCircuit returns the shared circuit for a provider endpoint, so every
+// alias routing through the same API trips and recovers together. An open
+// circuit lets one probe through after the cooldown.
+func providerCircuit(cfg config.Model) *gobreaker.TwoStepCircuitBreaker {
+	name := "llm:" + cfg.Provider
+	if cfg.BaseUrl != "" {
+		name += "@" + cfg.BaseUrl
+	}
+	threshold := uint32(defaultCircuitFailureThreshold)
+	if n := config.App.ModelCircuit.FailureThreshold; n > 0 {
+		threshold = uint32(n)
+	}
+	cooldown := config.App.ModelCircuit.Cooldown
+	if cooldown <= 0 {
+		cooldown = defaultCircuitCooldown
+	}
+	return bulkhead.GetCircuit(gobreaker.Settings{
+		Name:        name,
+		MaxRequests: 1,
+		Timeout:     cooldown,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= threshold
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			flog.Warn("[agent-llm] circuit %s %s -> %s", name, from, to)
+		},
+	})
+}
+
+// shouldFailover reports whether err from one chain model lets the next one try.
+func shouldFailover(err error) bool {
+	if errors.Is(err, ErrStreamStarted) || errors.Is(err, ErrAborted) || errors.Is(err, ErrCassetteMismatch) {
+		return false
+	}
+	return IsRetryableLLMError(err) || matchesOverflow(err.Error())
+}
+
+// GenerateContent implements llms.Model.
+func (m *FailoverModel) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	var base llms.CallOptions
+	for _, opt := range options {
+		opt(&base)
+	}
+	var started atomic.Bool
+	var lastErr, circuitErr error
+	for i, entry := range m.entries {
+		done, err := entry.circuit.Allow()
+		if err != nil {
+			circuitErr = fmt.Errorf("agent llm: alias %q: %s: %w", m.alias, entry.circuit.Name(), err)
+			continue
+		}
+		resp, err := entry.model.GenerateContent(ctx, messages, m.entryOptions(ctx, entry, base, options, &started)...)
+		if err == nil {
+			done(true)
+			recordServedModel(ctx, entry.name, entry.provider)
+			return resp, nil
+		}
+		// Only transient provider errors count against the circuit. gobreaker
+		// has no neutral outcome, so a rejected or cancelled call reports
+		// success, which also releases a half-open probe.
+		done(ctx.Err() != nil || !IsRetryableLLMError(err))
+		if ctx.Err() != nil || started.Load() || !shouldFailover(err) {
+			return nil, err
+		}
+		lastErr = err
+		if i < len(m.entries)-1 {
+			flog.Warn("[agent-llm] alias %s: %s (%s) failed, trying next model: %v", m.alias, entry.name, entry.provider, err)
+		}
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, circuitErr
+}
+
+// entryOptions pins the request to entry's model, enables its provider
+// reasoning options and marks started once any chunk reaches the caller.
+func (m *FailoverModel) entryOptions(ctx context.Context, entry failoverEntry, base llms.CallOptions, options []llms.CallOption, started *atomic.Bool) []llms.CallOption {
+	out := append([]llms.CallOption(nil), options...)
+	out = append(out, llms.WithModel(entry.name))
+	if base.StreamingFunc != nil {
+		inner := base.StreamingFunc
+		out = append(out, llms.WithStreamingFunc(func(streamCtx context.Context, chunk []byte) error {
+			if len(chunk) > 0 {
+				started.Store(true)
+			}
+			return inner(streamCtx, chunk)
+		}))
+	}
+	if base.StreamingReasoningFunc != nil {
+		out = append(out, ReasoningCallOptions(entry.name, base.MaxTokens, ThinkingLevelFromContext(ctx))...)
+		inner := base.StreamingReasoningFunc
+		out = append(out, llms.WithStreamingReasoningFunc(func(streamCtx context.Context, reasoningChunk, chunk []byte) error {
+			if len(reasoningChunk) > 0 || len(chunk) > 0 {
+				started.Store(true)
+			}
+			return inner(streamCtx, reasoningChunk, chunk)
+		}))
+	}
+	return out
+}
+
+// Call implements llms.Model.
+func (m *FailoverModel) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, m, prompt, options...)
+}
+
+// servedModel records which chain model answered a request.
+type servedModel struct {
+	mu       sync.Mutex
+	name     string
+	provider string
+}
+
+type servedModelContextKey struct{}
+
+func withServedModel(ctx context.Context) (context.Context, *servedModel) {
+	served := &servedModel{}
+	return context.WithValue(ctx, servedModelContextKey{}, served), served
+}
+
+func recordServedModel(ctx context.Context, name, provider string) {
+	served, ok := ctx.Value(servedModelContextKey{}).(*servedModel)
+	if !ok {
+		return
+	}
+	served.mu.Lock()
+	defer served.mu.Unlock()
+	served.name = name
+	served.provider = provider
+}
+
+func (s *servedModel) get() (name, provider string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.name, s.provider
+}
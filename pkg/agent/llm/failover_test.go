@@ -0,0 +1,152 @@
+package llm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/agent/llm"
+	"github.com/flowline-io/flowbot/pkg/bulkhead"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// setupFailoverAlias registers alias "smart" over gpt-5.5 (openai) then
+// claude-opus-4.7 (anthropic), backed by the given fake models.
+func setupFailoverAlias(t *testing.T, circuit config.ModelCircuit, primary, fallback *llm.FakeModel) {
+	t.Helper()
+	prevAliases, prevCircuit := config.App.ModelAliases, config.App.ModelCircuit
+	config.App.ModelAliases = []config.ModelAlias{{Name: "smart", Chain: []string{"gpt-5.5", "claude-opus-4.7"}}}
+	config.App.ModelCircuit = circuit
+	llm.ResetModelPoolForTest()
+	bulkhead.Reset()
+	llm.SetModelCreatorForTest(func(_ context.Context, modelName string) (llms.Model, string, error) {
+		if modelName == "gpt-5.5" {
+			return primary, modelName, nil
+		}
+		return fallback, modelName, nil
+	})
+	t.Cleanup(func() {
+		config.App.ModelAliases, config.App.ModelCircuit = prevAliases, prevCircuit
+		llm.ResetModelPoolForTest()
+		bulkhead.Reset()
+	})
+}
+
+func streamAlias(t *testing.T) (llm.AssistantResult, error) {
+	t.Helper()
+	model, name, err := llm.GetOrCreateModel(context.Background(), "smart")
+	require.NoError(t, err)
+	assert.Equal(t, "smart", name)
+	return llm.StreamAssistant(context.Background(), model, "", []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeHuman, "hi"),
+	}, llm.StreamOptions{
+		ModelName:   "smart",
+		OnTextDelta: func(string) error { return nil },
+		Retry:       llm.RetryConfig{MaxAttempts: 1},
+	})
+}
+
+func TestFailoverModelChain(t *testing.T) {
+	tests := []struct {
+		name          string
+		primary       llm.ResponseScript
+		wantModel     string
+		wantProvider  string
+		wantErr       string
+		wantFallbacks int
+	}{
+		{
+			name:         "primary serves",
+			primary:      llm.ResponseScript{Content: "from openai"},
+			wantModel:    "gpt-5.5",
+			wantProvider: "openai",
+		},
+		{
+			name:          "rate limit fails over",
+			primary:       llm.ResponseScript{Err: errors.New("429 Too Many Requests: rate limit reached")},
+			wantModel:     "claude-opus-4.7",
+			wantProvider:  "anthropic",
+			wantFallbacks: 1,
+		},
+		{
+			name:          "context overflow fails over",
+			primary:       llm.ResponseScript{Err: errors.New("This model's maximum context length is 128000 tokens")},
+			wantModel:     "claude-opus-4.7",
+			wantProvider:  "anthropic",
+			wantFallbacks: 1,
+		},
+		{
+			name:    "auth error does not fail over",
+			primary: llm.ResponseScript{Err: errors.New("401 unauthorized: invalid api key")},
+			wantErr: "invalid api key",
+		},
+		{
+			name:    "error after streaming does not fail over",
+			primary: llm.ResponseScript{Chunks: []string{"partial"}, ErrAfterStream: errors.New("503 service unavailable")},
+			wantErr: "stream already started",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := llm.NewFakeModel(tt.primary)
+			fallback := llm.NewFakeModel(llm.ResponseScript{Content: "from anthropic"})
+			setupFailoverAlias(t, config.ModelCircuit{}, primary, fallback)
+
+			result, err := streamAlias(t)
+			assert.Equal(t, tt.wantFallbacks, fallback.Calls())
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantModel, result.ModelName)
+			assert.Equal(t, tt.wantProvider, result.Provider)
+		})
+	}
+}
+
+func TestFailoverModelLastErrorWhenChainExhausted(t *testing.T) {
+	primary := llm.NewFakeModel(llm.ResponseScript{Err: errors.New("503 service unavailable")})
+	fallback := llm.NewFakeModel(llm.ResponseScript{Err: errors.New("prompt is too long: 250000 tokens")})
+	setupFailoverAlias(t, config.ModelCircuit{}, primary, fallback)
+
+	_, err := streamAlias(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "prompt is too long")
+}
+
+func TestFailoverModelCircuitSkipsTrippedProvider(t *testing.T) {
+	primary := llm.NewFakeModel(
+		llm.ResponseScript{Err: errors.New("502 bad gateway")},
+		llm.ResponseScript{Content: "recovered"},
+	)
+	fallback := llm.NewFakeModel(
+		llm.ResponseScript{Content: "first fallback"},
+		llm.ResponseScript{Content: "second fallback"},
+	)
+	setupFailoverAlias(t, config.ModelCircuit{FailureThreshold: 1, Cooldown: time.Hour}, primary, fallback)
+
+	result, err := streamAlias(t)
+	require.NoError(t, err)
+	assert.Equal(t, "claude-opus-4.7", result.ModelName)
+	assert.Equal(t, gobreaker.StateOpen, bulkhead.GetCircuit(gobreaker.Settings{Name: "llm:openai"}).State())
+
+	result, err = streamAlias(t)
+	require.NoError(t, err)
+	assert.Equal(t, "claude-opus-4.7", result.ModelName)
+	assert.Equal(t, 1, primary.Calls(), "open circuit must skip the primary provider")
+	assert.Equal(t, 2, fallback.Calls())
+}
+
+func TestAliasProviderAndReasoning(t *testing.T) {
+	setupFailoverAlias(t, config.ModelCircuit{}, llm.NewFakeModel(), llm.NewFakeModel())
+	assert.Equal(t, "openai", llm.ProviderForModel("smart"))
+	want := llm.SupportsReasoningStream("gpt-5.5") || llm.SupportsReasoningStream("claude-opus-4.7")
+	assert.Equal(t, want, llm.SupportsReasoningStream("smart"))
+}
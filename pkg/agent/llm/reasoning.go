@@ -1,13 +1,19 @@
 package llm
 
 import (
+	"slices"
 	"strings"
 
+	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/tmc/langchaingo/llms"
 )
 
 // SupportsReasoningStream reports whether a model should use reasoning stream callbacks.
+// An alias supports them when any model in its chain does.
 func SupportsReasoningStream(modelName string) bool {
+	if alias, ok := config.ModelAliasFor(modelName); ok {
+		return slices.ContainsFunc(alias.Chain, SupportsReasoningStream)
+	}
 	if llms.IsReasoningModel(modelName) {
 		return true
 	}
@@ -92,9 +92,9 @@ func RetryConfigFromChatAgent(cfg config.LLMRetryConfig) RetryConfig {
 }
 
 // IsRetryableLLMError reports whether an LLM error should be retried.
-// Overflow, auth failures, and aborts are never retried.
+// Overflow, auth failures, aborts and cassette mismatches are never retried.
 func IsRetryableLLMError(err error) bool {
-	if err == nil || errors.Is(err, ErrAborted) || errors.Is(err, ErrStreamStarted) {
+	if err == nil || errors.Is(err, ErrAborted) || errors.Is(err, ErrStreamStarted) || errors.Is(err, ErrCassetteMismatch) {
 		return false
 	}
 	if errors.Is(err, ErrStreamIdle) {
@@ -38,9 +38,13 @@ type StreamOptions struct {
 
 // AssistantResult is the normalized output of a streaming assistant request.
 type AssistantResult struct {
-	Content    string
-	ToolCalls  []llms.ToolCall
-	ModelName  string
+	Content   string
+	ToolCalls []llms.ToolCall
+	// ModelName is the model that served the request; for an alias it is the
+	// chain model that answered.
+	ModelName string
+	// Provider is the configured provider of ModelName.
+	Provider   string
 	StopReason string
 	Usage      *Usage
 }
@@ -116,6 +120,7 @@ func streamAssistantOnce(
 
 	streamCtx = WithThinkingLevel(streamCtx, opts.ThinkingLevel)
 	streamCtx = WithAssistantToolReasoning(streamCtx, opts.AssistantToolReasoning)
+	streamCtx, served := withServedModel(streamCtx)
 
 	start := time.Now()
 	tracker.start = start
@@ -135,8 +140,14 @@ func streamAssistantOnce(
 			opts.ModelName, duration, assembleErr)
 		return AssistantResult{}, assembleErr
 	}
-	flog.Info("[agent-llm] generate done model=%s duration=%s content_len=%d tool_calls=%d stream_started=%t",
-		opts.ModelName, duration, len(result.Content), len(result.ToolCalls), tracker.hasStarted())
+	if name, provider := served.get(); name != "" {
+		result.ModelName = name
+		result.Provider = provider
+	} else {
+		result.Provider = ProviderForModel(opts.ModelName)
+	}
+	flog.Info("[agent-llm] generate done model=%s served=%s provider=%s duration=%s content_len=%d tool_calls=%d stream_started=%t",
+		opts.ModelName, result.ModelName, result.Provider, duration, len(result.Content), len(result.ToolCalls), tracker.hasStarted())
 	return result, nil
 }
 
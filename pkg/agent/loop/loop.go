@@ -359,6 +359,7 @@ func assistantFromStreamResult(result agentllm.AssistantResult, capture reasonin
 	assistant := msg.AssistantMessage{
 		Parts:      parts,
 		Model:      result.ModelName,
+		Provider:   result.Provider,
 		StopReason: result.StopReason,
 		Usage:      usageToMsg(result.Usage),
 	}
@@ -1,5 +1,6 @@
 // Package model provides built-in LLM metadata and dual-model routing.
 //
-// The catalog map holds known model limits (context length, max output, features).
+// The catalog map holds known model limits (context length, max output, features)
+// and optional list pricing used to estimate spend for budgets.
 // Chat agent and context management resolve token budgets from this catalog.
 package model
@@ -14,4 +14,6 @@ type Metadata struct {
 	MaxOutput int
 	// Features lists supported capabilities and modalities.
 	Features []Feature
+	// Pricing is the list price used to estimate spend; zero means unknown.
+	Pricing Pricing
 }
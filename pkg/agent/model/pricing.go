@@ -0,0 +1,35 @@
+package model
+
+import "github.com/flowline-io/flowbot/pkg/agent/msg"
+
+// Pricing holds USD prices per million tokens for one model.
+type Pricing struct {
+	// Input is the price of prompt tokens.
+	Input float64
+	// Output is the price of completion tokens.
+	Output float64
+	// CacheRead is the price of cached prompt tokens read.
+	CacheRead float64
+	// CacheWrite is the price of prompt tokens written to the cache.
+	CacheWrite float64
+}
+
+// IsZero reports whether no price is known.
+func (p Pricing) IsZero() bool {
+	return p == Pricing{}
+}
+
+// Cost estimates the USD cost of usage. Cache tokens are counted separately
+// from prompt tokens, matching how providers report them in msg.Usage.
+func (p Pricing) Cost(usage msg.Usage) float64 {
+	return (float64(usage.PromptTokens)*p.Input +
+		float64(usage.CompletionTokens)*p.Output +
+		float64(usage.CacheRead)*p.CacheRead +
+		float64(usage.CacheWrite)*p.CacheWrite) / 1_000_000
+}
+
+// PricingFor returns catalog pricing for a model name; zero when unknown.
+func PricingFor(modelName string) Pricing {
+	meta, _ := Lookup(modelName)
+	return meta.Pricing
+}
@@ -0,0 +1,50 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/agent/model"
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingCost(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing model.Pricing
+		usage   msg.Usage
+		want    float64
+	}{
+		{
+			name:    "zero pricing",
+			pricing: model.Pricing{},
+			usage:   msg.Usage{PromptTokens: 1000, CompletionTokens: 500},
+			want:    0,
+		},
+		{
+			name:    "prompt and completion",
+			pricing: model.Pricing{Input: 3, Output: 15},
+			usage:   msg.Usage{PromptTokens: 1_000_000, CompletionTokens: 100_000},
+			want:    4.5,
+		},
+		{
+			name:    "cache tokens priced separately",
+			pricing: model.Pricing{Input: 2, Output: 8, CacheRead: 0.5, CacheWrite: 2.5},
+			usage:   msg.Usage{PromptTokens: 500_000, CacheRead: 2_000_000, CacheWrite: 400_000},
+			want:    3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tt.want, tt.pricing.Cost(tt.usage), 1e-9)
+		})
+	}
+}
+
+func TestPricingFor(t *testing.T) {
+	model.RegisterTestMetadata(t, model.Metadata{ID: "priced-model", Pricing: model.Pricing{Input: 1, Output: 2}})
+
+	assert.Equal(t, model.Pricing{Input: 1, Output: 2}, model.PricingFor("priced-model"))
+	assert.True(t, model.PricingFor("unknown-model").IsZero())
+}
@@ -47,12 +47,14 @@ type BeforeToolContext struct {
 	Context   *Context
 }
 
-// BeforeToolResult can block a tool call before execution.
+// BeforeToolResult can block or rewrite a tool call before execution.
 type BeforeToolResult struct {
 	Block  bool
 	Reason string
 	// Terminate stops the agent loop after delivering the blocked tool result.
 	Terminate bool
+	// Args replaces the tool arguments when non-nil and the call is not blocked.
+	Args map[string]any
 }
 
 // AfterToolContext is passed to after-tool hooks.
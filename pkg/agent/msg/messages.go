@@ -113,8 +113,11 @@ type Usage struct {
 
 // AssistantMessage is a model turn with optional text and tool calls.
 type AssistantMessage struct {
-	Parts      []ContentPart
-	Model      string
+	Parts []ContentPart
+	// Model is the model that served the turn; for an alias, the chain model that answered.
+	Model string
+	// Provider is the configured provider of Model.
+	Provider   string
 	StopReason string
 	Usage      *Usage
 	Timestamp  time.Time
@@ -23,7 +23,7 @@ const KeyScheduleRead = "schedule_read"
 // KeyMemory is the permission key for memory fact and session-summary tools.
 const KeyMemory = "memory"
 
-// KeyKnowledge is the permission key for knowledge base search/read tools.
+// KeyKnowledge is the permission key for knowledge base and unified search/read tools.
 const KeyKnowledge = "knowledge"
 
 // KeyTodo is the permission key for session todo checklist tools.
@@ -32,6 +32,10 @@ const KeyTodo = "todo"
 // KeyGateway is the permission key for local CLI gateway tools (run_cursor).
 const KeyGateway = "gateway"
 
+// KeyMCP is the permission key for tools discovered from external MCP servers.
+// Patterns match "<server>/<tool>".
+const KeyMCP = "mcp"
+
 // gatewayDefaultAction stores Action; initialized to ActionAsk.
 var gatewayDefaultAction atomic.Value
 
@@ -97,6 +101,7 @@ func DefaultConfig() Config {
 		},
 		KeyTodo:     {Default: ActionAllow},
 		KeyGateway:  {Default: loadGatewayDefaultAction()},
+		KeyMCP:      {Default: ActionAsk},
 		KeyDoomLoop: {Default: ActionAsk},
 		KeyExternalDirectory: {
 			Patterns: []PatternRule{
@@ -23,6 +23,7 @@ const (
 	ToolReadSkill              = "read_skill"
 	ToolSearchKnowledge        = "search_knowledge"
 	ToolGetKnowledge           = "get_knowledge"
+	ToolUnifiedSearch          = "unified_search"
 	ToolDelegateSubagent       = "delegate_subagent"
 	ToolScheduleTask           = "schedule_task"
 	ToolUpdateScheduledTask    = "update_scheduled_task"
@@ -38,6 +39,23 @@ const (
 	ToolRunCursor              = "run_cursor"
 )
 
+// MCPToolPrefix starts the name of every tool discovered from an MCP server:
+// mcp__<server>__<tool>.
+const MCPToolPrefix = "mcp__"
+
+// SplitMCPToolName returns the server and tool of a namespaced MCP tool name.
+func SplitMCPToolName(name string) (server, tool string, ok bool) {
+	rest, ok := strings.CutPrefix(name, MCPToolPrefix)
+	if !ok {
+		return "", "", false
+	}
+	server, tool, ok = strings.Cut(rest, "__")
+	if !ok || server == "" || tool == "" {
+		return "", "", false
+	}
+	return server, tool, true
+}
+
 // PermissionKeyForTool maps a tool name to its OpenCode permission key.
 func PermissionKeyForTool(tool string) string {
 	switch tool {
@@ -51,7 +69,7 @@ func PermissionKeyForTool(tool string) string {
 		return "websearch"
 	case ToolReadSkill:
 		return "skill"
-	case ToolSearchKnowledge, ToolGetKnowledge:
+	case ToolSearchKnowledge, ToolGetKnowledge, ToolUnifiedSearch:
 		return KeyKnowledge
 	case ToolDelegateSubagent:
 		return KeyDelegate
@@ -66,6 +84,9 @@ func PermissionKeyForTool(tool string) string {
 	case ToolRunCursor:
 		return KeyGateway
 	default:
+		if _, _, ok := SplitMCPToolName(tool); ok {
+			return KeyMCP
+		}
 		return KeyWildcard
 	}
 }
@@ -145,6 +166,8 @@ func extractProductToolPrimary(req Request) (string, ParseBashCommand, []string)
 		return q, ParseBashCommand{}, nil
 	case ToolGetKnowledge:
 		return strings.TrimSpace(fmt.Sprint(req.Args["path"])), ParseBashCommand{}, nil
+	case ToolUnifiedSearch:
+		return strings.TrimSpace(fmt.Sprint(req.Args["query"])), ParseBashCommand{}, nil
 	case ToolDelegateSubagent:
 		return strings.TrimSpace(fmt.Sprint(req.Args["subagent_type"])), ParseBashCommand{}, nil
 	case ToolScheduleTask:
@@ -160,6 +183,9 @@ func extractProductToolPrimary(req Request) (string, ParseBashCommand, []string)
 	case ToolMemoryList:
 		return "list", ParseBashCommand{}, nil
 	default:
+		if server, tool, ok := SplitMCPToolName(req.Tool); ok {
+			return server + "/" + tool, ParseBashCommand{}, nil
+		}
 		return req.Tool, ParseBashCommand{}, nil
 	}
 }
@@ -17,6 +17,7 @@ func TestPermissionKeyForKnowledgeTools(t *testing.T) {
 	}{
 		{name: "search_knowledge", tool: permission.ToolSearchKnowledge, want: permission.KeyKnowledge},
 		{name: "get_knowledge", tool: permission.ToolGetKnowledge, want: permission.KeyKnowledge},
+		{name: "unified_search", tool: permission.ToolUnifiedSearch, want: permission.KeyKnowledge},
 		{name: "read_skill unchanged", tool: permission.ToolReadSkill, want: "skill"},
 	}
 	for _, tt := range tests {
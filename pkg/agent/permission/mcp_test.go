@@ -0,0 +1,57 @@
+package permission_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flowline-io/flowbot/pkg/agent/permission"
+)
+
+func TestSplitMCPToolName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		tool       string
+		wantServer string
+		wantTool   string
+		wantOK     bool
+	}{
+		{name: "namespaced tool", tool: "mcp__github__create_issue", wantServer: "github", wantTool: "create_issue", wantOK: true},
+		{name: "tool name keeps later separators", tool: "mcp__docs__search__v2", wantServer: "docs", wantTool: "search__v2", wantOK: true},
+		{name: "builtin tool", tool: "read_file", wantOK: false},
+		{name: "missing tool part", tool: "mcp__github", wantOK: false},
+		{name: "empty server", tool: "mcp____x", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server, tool, ok := permission.SplitMCPToolName(tt.tool)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantServer, server)
+			assert.Equal(t, tt.wantTool, tool)
+		})
+	}
+}
+
+func TestEvaluateMCPTool(t *testing.T) {
+	t.Parallel()
+	req := permission.Request{Tool: "mcp__github__list_issues", Args: map[string]any{"repo": "x"}}
+	assert.Equal(t, permission.ExtractedInputs{PermissionKey: permission.KeyMCP, Primary: "github/list_issues"}, permission.ExtractInputs(req))
+
+	def := permission.NewEvaluator(permission.DefaultConfig()).Evaluate(req, nil)
+	assert.Equal(t, permission.ActionAsk, def.Action)
+	assert.Equal(t, "github/list_issues", def.SuggestedPattern)
+	assert.True(t, def.SuggestAlways)
+
+	cfg := permission.EffectiveConfig(permission.Config{
+		permission.KeyMCP: {Patterns: []permission.PatternRule{
+			{Pattern: "github/*", Action: permission.ActionAllow},
+			{Pattern: "github/delete_*", Action: permission.ActionDeny},
+		}},
+	})
+	ev := permission.NewEvaluator(cfg)
+	assert.Equal(t, permission.ActionAllow, ev.Evaluate(req, nil).Action)
+	assert.Equal(t, permission.ActionDeny, ev.Evaluate(permission.Request{Tool: "mcp__github__delete_repo"}, nil).Action)
+	assert.Equal(t, permission.ActionAsk, ev.Evaluate(permission.Request{Tool: "mcp__jira__search"}, nil).Action)
+}
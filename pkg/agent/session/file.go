@@ -0,0 +1,88 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileStorage is a Storage backed by one append-only JSONL file, so a session
+// can be resumed by a later process. Entries are also kept in memory for
+// branch lookups. The leaf pointer is not written separately: after a reload it
+// is the last entry in the file, so a MoveTo without a summary is not kept.
+type FileStorage struct {
+	mu   sync.Mutex
+	path string
+	mem  *MemoryStorage
+}
+
+// OpenFileStorage loads the entries in path, when it exists, and appends new
+// entries to it.
+func OpenFileStorage(path string) (*FileStorage, error) {
+	mem := NewMemoryStorage()
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, fmt.Errorf("session file: read %s: %w", path, err)
+	default:
+		entries, err := DeserializeSession(data)
+		if err != nil {
+			return nil, fmt.Errorf("session file: %s: %w", path, err)
+		}
+		mem.entries = entries
+		if len(entries) > 0 {
+			mem.leafID = entries[len(entries)-1].ID
+		}
+	}
+	return &FileStorage{path: path, mem: mem}, nil
+}
+
+// Path returns the backing JSONL file path.
+func (f *FileStorage) Path() string {
+	return f.path
+}
+
+// Append writes entry as one JSONL line, then stores it in memory.
+func (f *FileStorage) Append(ctx context.Context, entry TreeEntry) error {
+	line, err := MarshalEntry(entry)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("session file: open %s: %w", f.path, err)
+	}
+	if _, err := file.Write(append(bytes.TrimSpace(line), '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("session file: write %s: %w", f.path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("session file: close %s: %w", f.path, err)
+	}
+	return f.mem.Append(ctx, entry)
+}
+
+// GetBranch returns the ordered path to the requested leaf.
+func (f *FileStorage) GetBranch(ctx context.Context, leafID string) ([]TreeEntry, error) {
+	return f.mem.GetBranch(ctx, leafID)
+}
+
+// GetLeafID returns the current leaf pointer.
+func (f *FileStorage) GetLeafID(ctx context.Context) (string, error) {
+	return f.mem.GetLeafID(ctx)
+}
+
+// SetLeafID updates the in-memory leaf pointer.
+func (f *FileStorage) SetLeafID(ctx context.Context, id string) error {
+	return f.mem.SetLeafID(ctx, id)
+}
+
+// ListEntries returns all stored entries in append order.
+func (f *FileStorage) ListEntries(ctx context.Context) ([]TreeEntry, error) {
+	return f.mem.ListEntries(ctx)
+}
@@ -0,0 +1,43 @@
+package session_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorage_ResumeFromDisk(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "s1.jsonl")
+
+	store, err := session.OpenFileStorage(path)
+	require.NoError(t, err)
+	s := session.New(store)
+	require.NoError(t, s.Append(ctx, session.TreeEntry{ID: "root", Type: session.EntryMessage, Message: msg.NewUserMessage("hello")}))
+	require.NoError(t, s.Append(ctx, session.TreeEntry{ID: "leaf", ParentID: "root", Type: session.EntryMessage, Message: msg.NewUserMessage("again")}))
+
+	reopened, err := session.OpenFileStorage(path)
+	require.NoError(t, err)
+	leaf, err := reopened.GetLeafID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "leaf", leaf)
+
+	branch, err := session.New(reopened).GetBranch(ctx, "")
+	require.NoError(t, err)
+	built := session.BuildContext(branch)
+	require.Len(t, built.Messages, 2)
+	assert.Equal(t, "hello", session.MessageJSON(built.Messages[0])["text"])
+}
+
+func TestFileStorage_MissingFileStartsEmpty(t *testing.T) {
+	store, err := session.OpenFileStorage(filepath.Join(t.TempDir(), "new.jsonl"))
+	require.NoError(t, err)
+	entries, err := store.ListEntries(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -79,6 +79,11 @@ func LoadJSONL(r io.Reader) ([]TreeEntry, error) {
 	return DeserializeSession(data)
 }
 
+// MessageJSON returns the JSON object used for message in session JSONL lines.
+func MessageJSON(message msg.AgentMessage) map[string]any {
+	return messageToRaw(message)
+}
+
 func messageToRaw(message msg.AgentMessage) map[string]any {
 	switch m := message.(type) {
 	case msg.UserMessage:
@@ -89,6 +94,9 @@ func messageToRaw(message msg.AgentMessage) map[string]any {
 		return raw
 	case msg.AssistantMessage:
 		raw := map[string]any{"role": "assistant", "text": textFromParts(m.Parts), "model": m.Model, "stop_reason": m.StopReason}
+		if m.Provider != "" {
+			raw["provider"] = m.Provider
+		}
 		if m.TurnDurationMs > 0 {
 			raw["turn_duration_ms"] = m.TurnDurationMs
 		}
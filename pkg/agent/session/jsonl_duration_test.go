@@ -59,6 +59,49 @@ func TestMarshalEntryDurationFields(t *testing.T) {
 	}
 }
 
+func TestMarshalEntryAssistantProvider(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		line         string
+		wantModel    string
+		wantProvider string
+	}{
+		{
+			name:         "served by failover model",
+			line:         `{"id":"1","type":"message","message":{"role":"assistant","text":"hi","model":"claude-sonnet-4-6","provider":"anthropic"}}`,
+			wantModel:    "claude-sonnet-4-6",
+			wantProvider: "anthropic",
+		},
+		{
+			name:      "legacy without provider",
+			line:      `{"id":"2","type":"message","message":{"role":"assistant","text":"hi","model":"gpt-5.5"}}`,
+			wantModel: "gpt-5.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			entry, err := session.UnmarshalEntry([]byte(tt.line))
+			require.NoError(t, err)
+			roundTrip, err := session.MarshalEntry(entry)
+			require.NoError(t, err)
+			entry2, err := session.UnmarshalEntry(roundTrip)
+			require.NoError(t, err)
+
+			message, ok := entry2.Message.(msg.AssistantMessage)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantModel, message.Model)
+			assert.Equal(t, tt.wantProvider, message.Provider)
+			if tt.wantProvider == "" {
+				assert.NotContains(t, string(roundTrip), `"provider"`)
+			}
+		})
+	}
+}
+
 func TestMarshalEntryTurnTrace(t *testing.T) {
 	t.Parallel()
 
@@ -44,7 +44,7 @@ func assistantFromPayload(payload map[string]any, text string) msg.AgentMessage
 			})
 		}
 	}
-	assistant := msg.AssistantMessage{Parts: parts, Model: modelName, StopReason: stopReason}
+	assistant := msg.AssistantMessage{Parts: parts, Model: modelName, Provider: optionalStringField(payload, "provider"), StopReason: stopReason}
 	if rawUsage, ok := payload["usage"].(map[string]any); ok {
 		assistant.Usage = usageFromRaw(rawUsage)
 	}
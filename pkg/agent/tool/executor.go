@@ -154,6 +154,18 @@ func prepareCall(_ context.Context, req BatchRequest, call msg.ToolCallPart) (pr
 				terminate: before.Terminate,
 			}, nil
 		}
+		if before != nil && before.Args != nil {
+			args = before.Args
+			if err := ValidateArgs(t.Parameters(), args); err != nil {
+				return preparedCall{
+					call:    call,
+					args:    args,
+					tool:    t,
+					blocked: true,
+					reason:  "rewritten arguments: " + err.Error(),
+				}, nil
+			}
+		}
 	}
 
 	return preparedCall{call: call, args: args, tool: t}, nil
@@ -196,6 +196,72 @@ func TestExecuteBatch_BlockedTerminate(t *testing.T) {
 	assert.Equal(t, int32(0), stub.called.Load())
 }
 
+type pathTool struct {
+	stubTool
+	path atomic.Value
+}
+
+func (*pathTool) Parameters() map[string]any {
+	return map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"path": map[string]any{"type": "string"}},
+		"required":   []any{"path"},
+	}
+}
+
+func (p *pathTool) Execute(ctx context.Context, id string, args map[string]any, update tool.UpdateHandler) (msg.ToolResultMessage, error) {
+	p.path.Store(args["path"])
+	return p.stubTool.Execute(ctx, id, args, update)
+}
+
+func TestExecuteBatch_BeforeRewritesArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		rewrite   map[string]any
+		wantPath  any
+		wantError bool
+	}{
+		{name: "rewritten args reach tool", rewrite: map[string]any{"path": "/safe"}, wantPath: "/safe"},
+		{name: "invalid rewrite blocks", rewrite: map[string]any{"other": 1}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			reg := tool.NewRegistry()
+			pt := &pathTool{stubTool: stubTool{name: "read", result: "ok"}}
+			require.NoError(t, reg.Register(pt))
+
+			assistant := msg.AssistantMessage{Parts: []msg.ContentPart{
+				msg.ToolCallPart{ID: "1", Name: "read", Arguments: `{"path":"/etc/passwd"}`},
+			}}
+			var afterArgs map[string]any
+			result, err := tool.ExecuteBatch(context.Background(), tool.BatchRequest{
+				Assistant: assistant,
+				Context:   &msg.Context{},
+				Registry:  reg,
+				Before: func(msg.BeforeToolContext) (*msg.BeforeToolResult, error) {
+					return &msg.BeforeToolResult{Args: tt.rewrite}, nil
+				},
+				After: func(ctx msg.AfterToolContext) (*msg.AfterToolResult, error) {
+					afterArgs = ctx.Args
+					return nil, nil
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Messages, 1)
+			if tt.wantError {
+				assert.True(t, result.Messages[0].IsError)
+				assert.Equal(t, int32(0), pt.called.Load())
+				return
+			}
+			assert.False(t, result.Messages[0].IsError)
+			assert.Equal(t, tt.wantPath, pt.path.Load())
+			assert.Equal(t, tt.rewrite, afterArgs)
+		})
+	}
+}
+
 func TestExecuteBatch_MissingTool(t *testing.T) {
 	tests := []struct {
 		name string
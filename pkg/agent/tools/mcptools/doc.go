@@ -0,0 +1,3 @@
+// Package mcptools connects to external MCP servers and exposes their tools as
+// agent tools named mcp__<server>__<tool>.
+package mcptools
@@ -0,0 +1,508 @@
+package mcptools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/flowline-io/flowbot/pkg/agent/tool"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/flog"
+)
+
+const (
+	// DefaultRefreshInterval is how often tool lists are refreshed when
+	// chat_agent.mcp_refresh_interval is unset.
+	DefaultRefreshInterval = 5 * time.Minute
+	// DefaultCallTimeout limits one tool call when the server sets no timeout.
+	DefaultCallTimeout = 60 * time.Second
+	// connectTimeout limits connecting, initializing, and listing tools.
+	connectTimeout = 30 * time.Second
+)
+
+// State is the connection state of one MCP server.
+type State string
+
+// Connection states reported by Status.
+const (
+	StateConnecting State = "connecting"
+	StateReady      State = "ready"
+	StateFailed     State = "failed"
+)
+
+// Status is a health snapshot of one MCP server.
+type Status struct {
+	Name      string
+	Transport string
+	Agents    []string
+	State     State
+	Error     string
+	Tools     []string
+	// Dropped lists MCP tool names left out because their agent tool name
+	// collided with another tool's.
+	Dropped     []string
+	RefreshedAt time.Time
+}
+
+// DialFunc returns the transport used to connect to a server.
+type DialFunc func(cfg config.ChatAgentMCPServerConfig) (sdk.Transport, error)
+
+// Options configures a Manager.
+type Options struct {
+	// Info identifies Flowbot to servers.
+	Info *sdk.Implementation
+	// DefaultAgent receives servers that list no agents.
+	DefaultAgent string
+	// RefreshInterval defaults to DefaultRefreshInterval.
+	RefreshInterval time.Duration
+	// MaxOutput truncates tool result text; zero keeps it whole.
+	MaxOutput int
+	// Dial defaults to stdio for command servers and HTTP for url servers.
+	Dial DialFunc
+}
+
+// Manager keeps one client per configured MCP server, caches their tool lists,
+// and refreshes them periodically or when a server announces a change.
+type Manager struct {
+	opts    Options
+	servers []*server
+	refresh chan string
+	stop    chan struct{}
+	wg      sync.WaitGroup
+	once    sync.Once
+}
+
+// namedTool is an MCP tool with its agent tool name.
+type namedTool struct {
+	name string
+	def  *sdk.Tool
+}
+
+type server struct {
+	cfg config.ChatAgentMCPServerConfig
+
+	// refreshMu serializes connect and list for this server.
+	refreshMu sync.Mutex
+
+	mu          sync.RWMutex
+	session     *sdk.ClientSession
+	transport   sdk.Transport
+	tools       []namedTool
+	dropped     []string
+	state       State
+	lastErr     string
+	refreshedAt time.Time
+}
+
+// NewManager returns a Manager for servers. Call Start to connect.
+func NewManager(servers []config.ChatAgentMCPServerConfig, opts Options) *Manager {
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = DefaultRefreshInterval
+	}
+	if opts.Dial == nil {
+		opts.Dial = Dial
+	}
+	m := &Manager{
+		opts:    opts,
+		refresh: make(chan string, len(servers)+1),
+		stop:    make(chan struct{}),
+	}
+	for _, cfg := range servers {
+		m.servers = append(m.servers, &server{cfg: cfg, state: StateConnecting})
+	}
+	return m
+}
+
+// Dial returns a command transport for command servers and a streamable HTTP
+// transport for url servers.
+func Dial(cfg config.ChatAgentMCPServerConfig) (sdk.Transport, error) {
+	if strings.TrimSpace(cfg.URL) != "" {
+		client := &http.Client{Transport: headerTransport{headers: cfg.Headers, base: http.DefaultTransport}}
+		return &sdk.StreamableClientTransport{Endpoint: cfg.URL, HTTPClient: client}, nil
+	}
+	env := make([]string, 0, len(cfg.Env))
+	for k, v := range cfg.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	cmd := exec.Command(cfg.Command, cfg.Args...)
+	cmd.Env = append(os.Environ(), env...)
+	stderr := &tailBuffer{limit: 4096}
+	cmd.Stderr = stderr
+	return &commandTransport{CommandTransport: &sdk.CommandTransport{Command: cmd}, stderr: stderr}, nil
+}
+
+// Start connects every server and begins the refresh loop. Servers that fail
+// to connect are retried on each refresh tick.
+func (m *Manager) Start(ctx context.Context) {
+	m.RefreshAll(ctx)
+	m.wg.Add(1)
+	go m.loop()
+}
+
+// Stop ends the refresh loop and closes every connection.
+func (m *Manager) Stop() {
+	m.once.Do(func() {
+		close(m.stop)
+		m.wg.Wait()
+		for _, s := range m.servers {
+			s.mu.Lock()
+			s.closeLocked()
+			s.mu.Unlock()
+		}
+	})
+}
+
+func (m *Manager) loop() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.opts.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			m.RefreshAll(context.Background())
+		case name := <-m.refresh:
+			if err := m.Refresh(context.Background(), name); err != nil {
+				flog.Warn("[mcp] refresh server=%s: %v", name, err)
+			}
+		}
+	}
+}
+
+// RefreshAll refreshes every server concurrently.
+func (m *Manager) RefreshAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, s := range m.servers {
+		wg.Go(func() {
+			if err := m.refreshServer(ctx, s); err != nil {
+				flog.Warn("[mcp] refresh server=%s: %v", s.cfg.Name, err)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// Refresh reconnects the named server if needed and re-lists its tools.
+func (m *Manager) Refresh(ctx context.Context, name string) error {
+	s := m.server(name)
+	if s == nil {
+		return fmt.Errorf("mcp server %q not configured", name)
+	}
+	return m.refreshServer(ctx, s)
+}
+
+func (m *Manager) refreshServer(ctx context.Context, s *server) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+
+	s.mu.RLock()
+	session := s.session
+	s.mu.RUnlock()
+	if session == nil {
+		var err error
+		session, err = m.connect(ctx, s)
+		if err != nil {
+			s.fail(err)
+			return err
+		}
+	}
+	var defs []*sdk.Tool
+	for t, err := range session.Tools(ctx, nil) {
+		if err != nil {
+			s.fail(err)
+			return err
+		}
+		defs = append(defs, t)
+	}
+	tools, dropped := nameTools(s.cfg.Name, defs)
+	if len(dropped) > 0 {
+		flog.Warn("[mcp] server=%s dropped tools with colliding names: %s", s.cfg.Name, strings.Join(dropped, ", "))
+	}
+	s.mu.Lock()
+	s.tools = tools
+	s.dropped = dropped
+	s.state = StateReady
+	s.lastErr = ""
+	s.refreshedAt = time.Now()
+	s.mu.Unlock()
+	return nil
+}
+
+func (m *Manager) connect(ctx context.Context, s *server) (*sdk.ClientSession, error) {
+	name := s.cfg.Name
+	transport, err := m.opts.Dial(s.cfg)
+	if err != nil {
+		return nil, err
+	}
+	info := m.opts.Info
+	if info == nil {
+		info = &sdk.Implementation{Name: "flowbot"}
+	}
+	client := sdk.NewClient(info, &sdk.ClientOptions{
+		ToolListChangedHandler: func(context.Context, *sdk.ToolListChangedRequest) {
+			m.requestRefresh(name)
+		},
+	})
+	session, err := client.Connect(ctx, transport, nil)
+	if err != nil {
+		return nil, transportError(transport, err)
+	}
+	s.mu.Lock()
+	s.session = session
+	s.transport = transport
+	s.mu.Unlock()
+	go m.watch(s, session)
+	flog.Info("[mcp] connected server=%s", name)
+	return session, nil
+}
+
+// watch marks a server failed when its connection ends, such as a stdio
+// server exiting, so the next tick reconnects it.
+func (m *Manager) watch(s *server, session *sdk.ClientSession) {
+	err := session.Wait()
+	s.mu.Lock()
+	current := s.session == session
+	transport := s.transport
+	s.mu.Unlock()
+	if !current {
+		return
+	}
+	if err == nil {
+		err = errors.New("server exited")
+	}
+	s.fail(transportError(transport, err))
+}
+
+func (m *Manager) requestRefresh(name string) {
+	select {
+	case m.refresh <- name:
+	default:
+	}
+}
+
+func (m *Manager) server(name string) *server {
+	for _, s := range m.servers {
+		if s.cfg.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func (m *Manager) callFunc(s *server) callFunc {
+	return func(ctx context.Context, name string, args map[string]any) (*sdk.CallToolResult, error) {
+		s.mu.RLock()
+		session := s.session
+		s.mu.RUnlock()
+		if session == nil {
+			return nil, fmt.Errorf("mcp server %s is not connected", s.cfg.Name)
+		}
+		res, err := session.CallTool(ctx, &sdk.CallToolParams{Name: name, Arguments: args})
+		if err != nil && !isRPCError(err) && ctx.Err() == nil {
+			s.fail(err)
+			m.requestRefresh(s.cfg.Name)
+		}
+		return res, err
+	}
+}
+
+// nameTools assigns agent tool names. A tool whose name collides with an
+// earlier one is dropped rather than shadowing it, and reported in dropped.
+func nameTools(server string, defs []*sdk.Tool) (tools []namedTool, dropped []string) {
+	seen := make(map[string]bool, len(defs))
+	for _, def := range defs {
+		name := ToolName(server, def.Name)
+		if seen[name] {
+			dropped = append(dropped, def.Name)
+			continue
+		}
+		seen[name] = true
+		tools = append(tools, namedTool{name: name, def: def})
+	}
+	return tools, dropped
+}
+
+// Tools returns agent tools from ready servers available to agent.
+func (m *Manager) Tools(agent string) []tool.Tool {
+	var out []tool.Tool
+	for _, s := range m.servers {
+		if !m.serves(s, agent) {
+			continue
+		}
+		timeout := s.cfg.Timeout
+		if timeout <= 0 {
+			timeout = DefaultCallTimeout
+		}
+		s.mu.RLock()
+		ready := s.state == StateReady
+		defs := s.tools
+		s.mu.RUnlock()
+		if !ready {
+			continue
+		}
+		for _, t := range defs {
+			out = append(out, &Tool{
+				name:      t.name,
+				server:    s.cfg.Name,
+				def:       t.def,
+				call:      m.callFunc(s),
+				timeout:   timeout,
+				maxOutput: m.opts.MaxOutput,
+			})
+		}
+	}
+	return out
+}
+
+// ToolNames returns the names of Tools(agent).
+func (m *Manager) ToolNames(agent string) []string {
+	tools := m.Tools(agent)
+	names := make([]string, 0, len(tools))
+	for _, t := range tools {
+		names = append(names, t.Name())
+	}
+	return names
+}
+
+// Fingerprint changes whenever the tools available to agent change, so
+// callers caching a tool registry know to rebuild it.
+func (m *Manager) Fingerprint(agent string) string {
+	h := sha256.New()
+	for _, t := range m.Tools(agent) {
+		schema, _ := sonic.Marshal(t.Parameters())
+		_, _ = fmt.Fprintf(h, "%s\x1f%s\x1f%s\x1e", t.Name(), t.Description(), schema)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// Statuses returns a health snapshot of every configured server.
+func (m *Manager) Statuses() []Status {
+	out := make([]Status, 0, len(m.servers))
+	for _, s := range m.servers {
+		transport := "stdio"
+		if strings.TrimSpace(s.cfg.URL) != "" {
+			transport = "http"
+		}
+		s.mu.RLock()
+		st := Status{
+			Name:        s.cfg.Name,
+			Transport:   transport,
+			Agents:      m.agents(s),
+			State:       s.state,
+			Error:       s.lastErr,
+			Dropped:     s.dropped,
+			RefreshedAt: s.refreshedAt,
+		}
+		for _, t := range s.tools {
+			st.Tools = append(st.Tools, t.name)
+		}
+		s.mu.RUnlock()
+		out = append(out, st)
+	}
+	return out
+}
+
+func (m *Manager) serves(s *server, agent string) bool {
+	return slices.Contains(m.agents(s), agent)
+}
+
+func (m *Manager) agents(s *server) []string {
+	if len(s.cfg.Agents) == 0 {
+		return []string{m.opts.DefaultAgent}
+	}
+	return s.cfg.Agents
+}
+
+// fail records err and drops the connection so the next refresh reconnects.
+func (s *server) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeLocked()
+	s.state = StateFailed
+	s.lastErr = err.Error()
+	s.tools = nil
+	s.dropped = nil
+}
+
+func (s *server) closeLocked() {
+	session := s.session
+	s.session = nil
+	s.transport = nil
+	if session != nil {
+		_ = session.Close()
+	}
+}
+
+// transportError adds the tail of a stdio server's stderr, which usually
+// says why it failed to start or exited.
+func transportError(transport sdk.Transport, err error) error {
+	if t, ok := transport.(*commandTransport); ok {
+		if tail := strings.TrimSpace(t.stderr.String()); tail != "" {
+			return fmt.Errorf("%w: %s", err, tail)
+		}
+	}
+	return err
+}
+
+// commandTransport is a command transport that keeps the server's stderr.
+type commandTransport struct {
+	*sdk.CommandTransport
+	stderr *tailBuffer
+}
+
+// tailBuffer keeps the last limit bytes written to it.
+type tailBuffer struct {
+	mu    sync.Mutex
+	limit int
+	buf   []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - b.limit; over > 0 {
+		b.buf = b.buf[over:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return string(b.buf)
+}
+
+// headerTransport sets the configured headers, typically authorization, on
+// every request to an HTTP server.
+type headerTransport struct {
+	headers map[string]string
+	base    http.RoundTripper
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.headers) > 0 {
+		req = req.Clone(req.Context())
+		for k, v := range t.headers {
+			req.Header.Set(k, v)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
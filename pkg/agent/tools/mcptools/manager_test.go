@@ -0,0 +1,254 @@
+package mcptools
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/config"
+)
+
+type fixture struct {
+	server  *sdk.Server
+	manager *Manager
+
+	mu       sync.Mutex
+	down     bool
+	dials    int
+	sessions []*sdk.ServerSession
+}
+
+func newFixture(t *testing.T, servers []config.ChatAgentMCPServerConfig) *fixture {
+	t.Helper()
+	f := &fixture{server: sdk.NewServer(&sdk.Implementation{Name: "fake"}, nil)}
+	f.addTool("kanboard_list_tasks", "List tasks", map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"project_id": map[string]any{"type": "integer"}},
+		"required":   []any{"project_id"},
+	})
+	f.manager = NewManager(servers, Options{
+		DefaultAgent: "chat",
+		Dial: func(config.ChatAgentMCPServerConfig) (sdk.Transport, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.dials++
+			if f.down {
+				return nil, errors.New("connection refused")
+			}
+			clientT, serverT := sdk.NewInMemoryTransports()
+			ss, err := f.server.Connect(context.Background(), serverT, nil)
+			if err != nil {
+				return nil, err
+			}
+			f.sessions = append(f.sessions, ss)
+			return clientT, nil
+		},
+	})
+	t.Cleanup(f.manager.Stop)
+	return f
+}
+
+func (f *fixture) addTool(name, description string, schema map[string]any) {
+	f.server.AddTool(&sdk.Tool{Name: name, Description: description, InputSchema: schema},
+		func(_ context.Context, req *sdk.CallToolRequest) (*sdk.CallToolResult, error) {
+			if strings.HasSuffix(req.Params.Name, "_fail") {
+				return &sdk.CallToolResult{IsError: true, Content: []sdk.Content{&sdk.TextContent{Text: "upstream down"}}}, nil
+			}
+			op := strings.TrimPrefix(req.Params.Name, "kanboard_")
+			return &sdk.CallToolResult{Content: []sdk.Content{&sdk.TextContent{Text: "ok " + op}}}, nil
+		})
+}
+
+// setDown makes later dials fail and, when down, drops live connections.
+func (f *fixture) setDown(down bool) {
+	f.mu.Lock()
+	f.down = down
+	sessions := f.sessions
+	if down {
+		f.sessions = nil
+	}
+	f.mu.Unlock()
+	if down {
+		for _, ss := range sessions {
+			_ = ss.Close()
+		}
+	}
+}
+
+func TestToolName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "mcp__github__create_issue", ToolName("github", "create_issue"))
+
+	sanitized := ToolName("docs", "search.v2/beta")
+	assert.Regexp(t, `^mcp__docs__search_v2_beta_[0-9a-f]{8}$`, sanitized)
+	assert.NotEqual(t, sanitized, ToolName("docs", "search_v2_beta"), "replaced characters do not collide with the literal name")
+	assert.NotEqual(t, sanitized, ToolName("docs", "search.v2.beta"))
+
+	long := ToolName("docs", strings.Repeat("x", 100))
+	assert.Len(t, long, maxToolNameLen)
+	assert.NotEqual(t, long, ToolName("docs", strings.Repeat("x", 101)), "truncated names keep a distinguishing suffix")
+	assert.Equal(t, long, ToolName("docs", strings.Repeat("x", 100)))
+}
+
+func TestNameToolsDropsCollisions(t *testing.T) {
+	t.Parallel()
+	tools, dropped := nameTools("kb", []*sdk.Tool{{Name: "a"}, {Name: "b"}, {Name: "a"}})
+	require.Len(t, tools, 2)
+	assert.Equal(t, "mcp__kb__a", tools[0].name)
+	assert.Equal(t, "mcp__kb__b", tools[1].name)
+	assert.Equal(t, []string{"a"}, dropped)
+}
+
+func TestManager_DiscoversAndCallsTools(t *testing.T) {
+	t.Parallel()
+	f := newFixture(t, []config.ChatAgentMCPServerConfig{{Name: "kb", Command: "kb-mcp"}})
+	require.NoError(t, f.manager.Refresh(context.Background(), "kb"))
+
+	tools := f.manager.Tools("chat")
+	require.Len(t, tools, 1)
+	kb := tools[0]
+	assert.Equal(t, "mcp__kb__kanboard_list_tasks", kb.Name())
+	assert.Equal(t, "List tasks (MCP server kb)", kb.Description())
+	assert.Equal(t, []any{"project_id"}, kb.Parameters()["required"])
+
+	res, err := kb.Execute(context.Background(), "call-1", map[string]any{"project_id": 1}, nil)
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.Equal(t, "mcp__kb__kanboard_list_tasks", res.Name)
+	assert.Equal(t, []msg.ContentPart{msg.TextPart{Text: "ok list_tasks"}}, res.Parts)
+
+	assert.Empty(t, f.manager.Tools("reviewer"), "servers without agents serve the default agent only")
+}
+
+func TestManager_AgentScoping(t *testing.T) {
+	t.Parallel()
+	f := newFixture(t, []config.ChatAgentMCPServerConfig{{Name: "kb", URL: "http://mcp.test", Agents: []string{"reviewer"}}})
+	require.NoError(t, f.manager.Refresh(context.Background(), "kb"))
+	assert.Empty(t, f.manager.ToolNames("chat"))
+	assert.Equal(t, []string{"mcp__kb__kanboard_list_tasks"}, f.manager.ToolNames("reviewer"))
+
+	st := f.manager.Statuses()
+	require.Len(t, st, 1)
+	assert.Equal(t, "http", st[0].Transport)
+	assert.Equal(t, []string{"reviewer"}, st[0].Agents)
+	assert.Empty(t, st[0].Dropped)
+}
+
+func TestManager_RefreshPicksUpToolChanges(t *testing.T) {
+	t.Parallel()
+	f := newFixture(t, []config.ChatAgentMCPServerConfig{{Name: "kb", Command: "kb-mcp"}})
+	require.NoError(t, f.manager.Refresh(context.Background(), "kb"))
+	before := f.manager.Fingerprint("chat")
+
+	f.addTool("kanboard_fail", "Always fails", map[string]any{"type": "object"})
+
+	assert.Equal(t, before, f.manager.Fingerprint("chat"), "cached list is used until refreshed")
+	require.NoError(t, f.manager.Refresh(context.Background(), "kb"))
+	assert.NotEqual(t, before, f.manager.Fingerprint("chat"))
+	assert.Equal(t, []string{"mcp__kb__kanboard_fail", "mcp__kb__kanboard_list_tasks"}, f.manager.ToolNames("chat"))
+	assert.Equal(t, 1, f.dials, "refresh reuses the live connection")
+
+	tools := f.manager.Tools("chat")
+	res, err := tools[0].Execute(context.Background(), "call-2", nil, nil)
+	require.NoError(t, err)
+	assert.True(t, res.IsError, "tool-level failures are surfaced to the model")
+}
+
+func TestManager_FailureAndReconnect(t *testing.T) {
+	t.Parallel()
+	f := newFixture(t, []config.ChatAgentMCPServerConfig{{Name: "kb", Command: "kb-mcp"}})
+	f.setDown(true)
+	require.Error(t, f.manager.Refresh(context.Background(), "kb"))
+	st := f.manager.Statuses()[0]
+	assert.Equal(t, StateFailed, st.State)
+	assert.Contains(t, st.Error, "connection refused")
+	assert.Empty(t, f.manager.Tools("chat"), "failed servers contribute no tools")
+
+	f.setDown(false)
+	require.NoError(t, f.manager.Refresh(context.Background(), "kb"))
+	st = f.manager.Statuses()[0]
+	assert.Equal(t, StateReady, st.State)
+	assert.Empty(t, st.Error)
+	assert.Equal(t, []string{"mcp__kb__kanboard_list_tasks"}, st.Tools)
+
+	tool := f.manager.Tools("chat")[0]
+	f.setDown(true)
+	res, err := tool.Execute(context.Background(), "call-3", map[string]any{"project_id": 1}, nil)
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.Contains(t, res.Parts[0].(msg.TextPart).Text, "[mcp_error]")
+	assert.Equal(t, StateFailed, f.manager.Statuses()[0].State, "transport errors during calls mark the server failed")
+}
+
+func TestManager_UnknownServer(t *testing.T) {
+	t.Parallel()
+	f := newFixture(t, nil)
+	require.Error(t, f.manager.Refresh(context.Background(), "missing"))
+}
+
+func TestManager_RPCErrorKeepsServerReady(t *testing.T) {
+	t.Parallel()
+	f := newFixture(t, []config.ChatAgentMCPServerConfig{{Name: "kb", Command: "kb-mcp"}})
+	require.NoError(t, f.manager.Refresh(context.Background(), "kb"))
+	tool := f.manager.Tools("chat")[0]
+	f.server.RemoveTools("kanboard_list_tasks")
+
+	res, err := tool.Execute(context.Background(), "call-4", map[string]any{"project_id": 1}, nil)
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.Contains(t, res.Parts[0].(msg.TextPart).Text, "check the arguments")
+	assert.Equal(t, StateReady, f.manager.Statuses()[0].State, "errors the server answered with keep the connection")
+}
+
+func TestDial_StdioReportsStderr(t *testing.T) {
+	t.Parallel()
+	m := NewManager([]config.ChatAgentMCPServerConfig{
+		{Name: "broken", Command: "sh", Args: []string{"-c", "echo missing token >&2; exit 1"}},
+	}, Options{DefaultAgent: "chat"})
+	t.Cleanup(m.Stop)
+	err := m.Refresh(context.Background(), "broken")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing token")
+}
+
+func TestDial_HTTPSendsHeaders(t *testing.T) {
+	t.Parallel()
+	srv := sdk.NewServer(&sdk.Implementation{Name: "fake"}, nil)
+	srv.AddTool(&sdk.Tool{Name: "echo", InputSchema: map[string]any{"type": "object"}},
+		func(context.Context, *sdk.CallToolRequest) (*sdk.CallToolResult, error) {
+			return &sdk.CallToolResult{Content: []sdk.Content{&sdk.TextContent{Text: "hi"}}}, nil
+		})
+	handler := sdk.NewStreamableHTTPHandler(func(*http.Request) *sdk.Server { return srv }, nil)
+	var mu sync.Mutex
+	var auth []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = append(auth, r.Header.Get("Authorization"))
+		mu.Unlock()
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+
+	m := NewManager([]config.ChatAgentMCPServerConfig{
+		{Name: "remote", URL: ts.URL, Headers: map[string]string{"Authorization": "Bearer t0k"}},
+	}, Options{DefaultAgent: "chat"})
+	t.Cleanup(m.Stop)
+	require.NoError(t, m.Refresh(context.Background(), "remote"))
+	assert.Equal(t, []string{"mcp__remote__echo"}, m.ToolNames("chat"))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, auth)
+	for _, got := range auth {
+		assert.Equal(t, "Bearer t0k", got)
+	}
+}
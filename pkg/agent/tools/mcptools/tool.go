@@ -0,0 +1,172 @@
+package mcptools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/flowline-io/flowbot/pkg/agent/msg"
+	"github.com/flowline-io/flowbot/pkg/agent/permission"
+	"github.com/flowline-io/flowbot/pkg/agent/tool"
+)
+
+const (
+	// maxToolNameLen is the longest function name LLM providers accept.
+	maxToolNameLen = 64
+	// toolNameHashLen is the number of hex digits ToolName appends to names it
+	// had to change.
+	toolNameHashLen = 8
+)
+
+// ToolName returns the namespaced agent tool name for an MCP tool. Characters
+// providers reject are replaced with '_' and the result is capped at 64 bytes.
+// A name that had to be changed ends in '_' and a short hash of the original,
+// so tools that differ only in replaced or truncated characters stay distinct.
+func ToolName(server, name string) string {
+	var b strings.Builder
+	b.WriteString(permission.MCPToolPrefix)
+	b.WriteString(server)
+	b.WriteString("__")
+	changed := false
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+			changed = true
+		}
+	}
+	out := b.String()
+	if !changed && len(out) <= maxToolNameLen {
+		return out
+	}
+	sum := sha256.Sum256([]byte(name))
+	suffix := "_" + hex.EncodeToString(sum[:])[:toolNameHashLen]
+	if len(out) > maxToolNameLen-len(suffix) {
+		out = out[:maxToolNameLen-len(suffix)]
+	}
+	return out + suffix
+}
+
+// callFunc calls a tool by its original MCP name on one server.
+type callFunc func(ctx context.Context, name string, args map[string]any) (*sdk.CallToolResult, error)
+
+// Tool adapts one MCP tool to tool.Tool.
+type Tool struct {
+	name      string
+	server    string
+	def       *sdk.Tool
+	call      callFunc
+	timeout   time.Duration
+	maxOutput int
+}
+
+// Name returns the namespaced tool identifier.
+func (t *Tool) Name() string { return t.name }
+
+// Server returns the MCP server the tool belongs to.
+func (t *Tool) Server() string { return t.server }
+
+// ReadOnly reports whether the server marked the tool read-only.
+func (t *Tool) ReadOnly() bool {
+	return t.def.Annotations != nil && t.def.Annotations.ReadOnlyHint
+}
+
+// Description explains the tool to the model.
+func (t *Tool) Description() string {
+	desc := strings.TrimSpace(t.def.Description)
+	if desc == "" {
+		desc = strings.TrimSpace(t.def.Title)
+	}
+	if desc == "" {
+		desc = t.def.Name
+	}
+	return desc + " (MCP server " + t.server + ")"
+}
+
+// Parameters returns the server-provided JSON schema.
+func (t *Tool) Parameters() map[string]any {
+	if schema, ok := t.def.InputSchema.(map[string]any); ok && len(schema) > 0 {
+		return schema
+	}
+	return map[string]any{"type": "object", "properties": map[string]any{}}
+}
+
+// Execute calls the tool on its server.
+func (t *Tool) Execute(ctx context.Context, id string, args map[string]any, _ tool.UpdateHandler) (msg.ToolResultMessage, error) {
+	if t.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+		defer cancel()
+	}
+	res, err := t.call(ctx, t.def.Name, args)
+	if err != nil {
+		hint := "the MCP server may be unavailable; check its status on the agents page"
+		if isRPCError(err) {
+			hint = "check the arguments against the tool schema"
+		}
+		return tool.ErrorResult(id, t.name, "mcp_error", err.Error(), hint), nil
+	}
+	text := resultText(res)
+	if t.maxOutput > 0 && len(text) > t.maxOutput {
+		text = text[:t.maxOutput] + "\n...(output truncated)"
+	}
+	return msg.ToolResultMessage{
+		ToolCallID: id,
+		Name:       t.name,
+		IsError:    res.IsError,
+		Parts:      []msg.ContentPart{msg.TextPart{Text: text}},
+	}, nil
+}
+
+// isRPCError reports whether the server answered with a JSON-RPC error, as
+// opposed to the call failing to reach it.
+func isRPCError(err error) bool {
+	var rpcErr *jsonrpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+func resultText(res *sdk.CallToolResult) string {
+	parts := make([]string, 0, len(res.Content))
+	for _, c := range res.Content {
+		if text, ok := c.(*sdk.TextContent); ok {
+			parts = append(parts, text.Text)
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("[%s content omitted]", contentType(c)))
+	}
+	text := strings.Join(parts, "\n")
+	if strings.TrimSpace(text) == "" && res.StructuredContent != nil {
+		if b, err := sonic.MarshalString(res.StructuredContent); err == nil {
+			text = b
+		}
+	}
+	if strings.TrimSpace(text) == "" {
+		text = "(no output)"
+	}
+	return text
+}
+
+func contentType(c sdk.Content) string {
+	switch c.(type) {
+	case *sdk.ImageContent:
+		return "image"
+	case *sdk.AudioContent:
+		return "audio"
+	case *sdk.ResourceLink:
+		return "resource_link"
+	case *sdk.EmbeddedResource:
+		return "resource"
+	default:
+		return "unknown"
+	}
+}
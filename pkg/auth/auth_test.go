@@ -93,6 +93,33 @@ func TestHasMinimumServiceScope(t *testing.T) {
 	}
 }
 
+func TestHasOperationScope(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		scopes     []string
+		capability string
+		declared   []string
+		mutation   bool
+		want       bool
+	}{
+		{name: "declared read scope held", scopes: []string{ScopeServiceKanboardRead}, capability: "kanboard", declared: []string{ScopeServiceKanboardRead}, want: true},
+		{name: "write satisfies declared read", scopes: []string{ScopeServiceKanboardWrite}, capability: "kanboard", declared: []string{ScopeServiceKanboardRead}, want: true},
+		{name: "read does not satisfy declared write", scopes: []string{ScopeServiceKanboardRead}, capability: "kanboard", declared: []string{ScopeServiceKanboardWrite}, mutation: true, want: false},
+		{name: "legacy scope satisfies declared provider scope", scopes: []string{"service:kanban:read"}, capability: "kanboard", declared: []string{ScopeServiceKanboardRead}, want: true},
+		{name: "every declared scope required", scopes: []string{ScopeServiceKanboardRead}, capability: "kanboard", declared: []string{ScopeServiceKanboardRead, ScopeServiceGiteaRead}, want: false},
+		{name: "undeclared read falls back to service read", scopes: []string{ScopeServiceExampleRead}, capability: "example", want: true},
+		{name: "undeclared mutation needs service write", scopes: []string{ScopeServiceExampleRead}, capability: "example", mutation: true, want: false},
+		{name: "admin satisfies everything", scopes: []string{ScopeAdmin}, capability: "core", declared: []string{"service:core:write"}, mutation: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, HasOperationScope(tt.scopes, tt.capability, tt.declared, tt.mutation))
+		})
+	}
+}
+
 func TestMinimumServiceScope(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -47,6 +47,14 @@ const (
 	ScopeServiceGithubWrite       = "service:github:write"
 	ScopeServiceDevopsRead        = "service:devops:read"
 	ScopeServiceDevopsWrite       = "service:devops:write" // reserved for future mutations
+	ScopeServiceAdguardRead       = "service:adguard:read"
+	ScopeServiceAdguardWrite      = "service:adguard:write"
+	ScopeServiceArchiveboxRead    = "service:archivebox:read"
+	ScopeServiceArchiveboxWrite   = "service:archivebox:write"
+	ScopeServiceN8nRead           = "service:n8n:read"
+	ScopeServiceN8nWrite          = "service:n8n:write"
+	ScopeServiceSlashRead         = "service:slash:read"
+	ScopeServiceSlashWrite        = "service:slash:write"
 	ScopeServiceExampleRead       = "service:example:read"
 	ScopeServiceExampleWrite      = "service:example:write"
 
@@ -206,6 +214,30 @@ func HasMinimumServiceScope(scopes []string, group, method string) bool {
 	return false
 }
 
+// HasOperationScope reports whether scopes allow invoking a capability operation.
+// Every declared scope must be held; a service write scope satisfies the matching
+// read scope. Operations that declare no scopes fall back to the /service/{capability}
+// gate, using the write scope for mutations.
+func HasOperationScope(scopes []string, capability string, declared []string, mutation bool) bool {
+	if len(declared) == 0 {
+		method := "GET"
+		if mutation {
+			method = "POST"
+		}
+		return HasMinimumServiceScope(scopes, capability, method)
+	}
+	for _, required := range declared {
+		if HasScope(scopes, required) {
+			continue
+		}
+		if group, ok := strings.CutSuffix(canonicalScope(required), ":read"); ok && HasScope(scopes, group+":write") {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
 // AllScopes returns all scopes available for CLI token creation.
 func AllScopes() []ScopeInfo {
 	return []ScopeInfo{
@@ -245,6 +277,14 @@ func AllScopes() []ScopeInfo {
 		{Value: ScopeServiceGithubWrite, Description: "write github"},
 		{Value: ScopeServiceDevopsRead, Description: "read devops"},
 		{Value: ScopeServiceDevopsWrite, Description: "write devops"},
+		{Value: ScopeServiceAdguardRead, Description: "read adguard"},
+		{Value: ScopeServiceAdguardWrite, Description: "write adguard"},
+		{Value: ScopeServiceArchiveboxRead, Description: "read archivebox"},
+		{Value: ScopeServiceArchiveboxWrite, Description: "write archivebox"},
+		{Value: ScopeServiceN8nRead, Description: "read n8n"},
+		{Value: ScopeServiceN8nWrite, Description: "write n8n"},
+		{Value: ScopeServiceSlashRead, Description: "read slash"},
+		{Value: ScopeServiceSlashWrite, Description: "write slash"},
 		{Value: ScopeServiceExampleRead, Description: "read example"},
 		{Value: ScopeServiceExampleWrite, Description: "write example"},
 		{Value: ScopePipelineRead, Description: "read pipelines"},
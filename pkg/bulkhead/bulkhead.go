@@ -1,4 +1,5 @@
-// Package bulkhead provides per-capability semaphore isolation for ability invocations.
+// Package bulkhead provides per-capability semaphore isolation for ability invocations
+// and per-dependency circuit breakers.
 package bulkhead
 
 import (
@@ -93,6 +94,11 @@ func New(name string, opts ...Option) *Bulkhead {
 	}
 }
 
+// MaxConcurrent returns the number of slots that may run at once.
+func (b *Bulkhead) MaxConcurrent() int {
+	return b.config.maxConcurrent
+}
+
 // releaseQueue drains one slot from the wait queue if queueing is enabled.
 func (b *Bulkhead) releaseQueue() {
 	if b.config.maxQueue > 0 {
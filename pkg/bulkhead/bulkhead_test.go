@@ -331,6 +331,25 @@ func TestBulkheadNewDefaults(t *testing.T) {
 	}
 }
 
+func TestBulkheadMaxConcurrent(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want int
+	}{
+		{name: "default", want: 1},
+		{name: "configured", opts: []Option{WithMaxConcurrent(7)}, want: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New("max-"+tt.name, tt.opts...)
+			if got := b.MaxConcurrent(); got != tt.want {
+				t.Errorf("MaxConcurrent: want %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
 func TestBulkheadDoRace(t *testing.T) {
 	const n = 50
 	// Queue must admit all concurrent callers; MaxQueue is an admission cap, not a wait buffer.
@@ -4,6 +4,8 @@ import (
 	"runtime"
 	"sync"
 	"time"
+
+	"github.com/sony/gobreaker"
 )
 
 // manager holds named Bulkhead instances created lazily via Get.
@@ -12,9 +14,10 @@ type manager struct {
 	instances map[string]*Bulkhead
 	defaults  config
 	extraOpts []Option
+	circuits  map[string]*gobreaker.TwoStepCircuitBreaker
 }
 
-var defaultManager = &manager{instances: make(map[string]*Bulkhead)}
+var defaultManager = &manager{instances: make(map[string]*Bulkhead), circuits: make(map[string]*gobreaker.TwoStepCircuitBreaker)}
 
 func init() {
 	n := runtime.GOMAXPROCS(0)
@@ -60,6 +63,20 @@ func Get(name string) *Bulkhead {
 	return b
 }
 
+// GetCircuit returns the circuit breaker named st.Name, creating one from st if needed.
+// Settings only apply when the breaker is first created.
+func GetCircuit(st gobreaker.Settings) *gobreaker.TwoStepCircuitBreaker {
+	defaultManager.mu.Lock()
+	defer defaultManager.mu.Unlock()
+
+	if c, ok := defaultManager.circuits[st.Name]; ok {
+		return c
+	}
+	c := gobreaker.NewTwoStepCircuitBreaker(st)
+	defaultManager.circuits[st.Name] = c
+	return c
+}
+
 // SetDefaults sets default options applied to all Bulkhead instances created via Get.
 // Must be called before any Get calls for the settings to take effect.
 func SetDefaults(opts ...Option) {
@@ -71,11 +88,12 @@ func SetDefaults(opts ...Option) {
 	}
 }
 
-// Reset clears all cached Bulkhead instances and extra default options.
+// Reset clears all cached Bulkhead and circuit breaker instances and extra default options.
 // Intended for use in tests to isolate state between cases.
 func Reset() {
 	defaultManager.mu.Lock()
 	defer defaultManager.mu.Unlock()
 	defaultManager.instances = make(map[string]*Bulkhead)
+	defaultManager.circuits = make(map[string]*gobreaker.TwoStepCircuitBreaker)
 	defaultManager.extraOpts = nil
 }
@@ -3,6 +3,9 @@ package bulkhead
 import (
 	"runtime"
 	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
 )
 
 func TestGetReturnsSingleton(t *testing.T) {
@@ -105,3 +108,21 @@ func TestSetDefaultsAppliesToNewInstances(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCircuitReturnsSingleton(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	a := GetCircuit(gobreaker.Settings{Name: "dep", Timeout: time.Minute})
+	b := GetCircuit(gobreaker.Settings{Name: "dep"})
+	if a != b {
+		t.Fatal("expected the same circuit for the same name")
+	}
+	if c := GetCircuit(gobreaker.Settings{Name: "other"}); c == a {
+		t.Fatal("expected a different circuit for a different name")
+	}
+	Reset()
+	if c := GetCircuit(gobreaker.Settings{Name: "dep"}); c == a {
+		t.Fatal("expected Reset to drop cached circuits")
+	}
+}
@@ -7,24 +7,34 @@ import (
 	"time"
 
 	"github.com/dgraph-io/ristretto/v2"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/flowline-io/flowbot/pkg/config"
 )
 
 var Instance *Cache
 
-var defaultRedisStore *RedisStore
+var defaultStore Store
 
-// SetDefaultRedisStore sets the global Redis store for health checks and other
+// SetDefaultStore sets the global cache store for health checks and other
 // cross-package access. Called once during server initialization.
-func SetDefaultRedisStore(s *RedisStore) {
-	defaultRedisStore = s
+func SetDefaultStore(s Store) {
+	defaultStore = s
 }
 
-// DefaultRedisStore returns the global Redis store. May return nil before
+// DefaultStore returns the global cache store. May return nil before
 // initialization.
-func DefaultRedisStore() *RedisStore {
-	return defaultRedisStore
+func DefaultStore() Store {
+	return defaultStore
+}
+
+// NewStore returns the shared cache Store. rdb.NewClient yields a nil client
+// when redis.backend is "memory"; the store then lives in the process.
+func NewStore(client *redis.Client) Store {
+	if client == nil {
+		return NewMemoryStore()
+	}
+	return NewRedisStore(client)
 }
 
 type Cache struct {
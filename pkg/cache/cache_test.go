@@ -657,32 +657,32 @@ func TestCacheTTLExpiration(t *testing.T) {
 	}
 }
 
-// TestDefaultRedisStore tests the global Redis store accessor.
-func TestDefaultRedisStore(t *testing.T) {
+// TestDefaultStore tests the global cache store accessor.
+func TestDefaultStore(t *testing.T) {
 	t.Run("returns nil before set", func(t *testing.T) {
-		prev := DefaultRedisStore()
-		SetDefaultRedisStore(nil)
-		t.Cleanup(func() { SetDefaultRedisStore(prev) })
+		prev := DefaultStore()
+		SetDefaultStore(nil)
+		t.Cleanup(func() { SetDefaultStore(prev) })
 
-		require.Nil(t, DefaultRedisStore())
+		require.Nil(t, DefaultStore())
 	})
 
 	t.Run("returns store after set", func(t *testing.T) {
-		prev := DefaultRedisStore()
-		t.Cleanup(func() { SetDefaultRedisStore(prev) })
+		prev := DefaultStore()
+		t.Cleanup(func() { SetDefaultStore(prev) })
 
 		mr := miniredis.RunT(t)
 		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
 		t.Cleanup(func() { _ = client.Close() })
 		store := NewRedisStore(client)
 
-		SetDefaultRedisStore(store)
-		require.Same(t, store, DefaultRedisStore())
+		SetDefaultStore(store)
+		require.Same(t, store, DefaultStore())
 	})
 
 	t.Run("replace with different store", func(t *testing.T) {
-		prev := DefaultRedisStore()
-		t.Cleanup(func() { SetDefaultRedisStore(prev) })
+		prev := DefaultStore()
+		t.Cleanup(func() { SetDefaultStore(prev) })
 
 		mr := miniredis.RunT(t)
 		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
@@ -690,11 +690,11 @@ func TestDefaultRedisStore(t *testing.T) {
 		store1 := NewRedisStore(client)
 		store2 := NewRedisStore(client)
 
-		SetDefaultRedisStore(store1)
-		require.Same(t, store1, DefaultRedisStore())
+		SetDefaultStore(store1)
+		require.Same(t, store1, DefaultStore())
 
-		SetDefaultRedisStore(store2)
-		require.Same(t, store2, DefaultRedisStore())
-		require.NotSame(t, store1, DefaultRedisStore())
+		SetDefaultStore(store2)
+		require.Same(t, store2, DefaultStore())
+		require.NotSame(t, store1, DefaultStore())
 	})
 }
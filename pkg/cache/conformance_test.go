@@ -0,0 +1,300 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeBackend builds a fresh Store together with a function that moves its
+// clock forward, so TTL behaviour can be checked without sleeping.
+type storeBackend struct {
+	name string
+	open func(t *testing.T) (Store, func(time.Duration))
+}
+
+var storeBackends = []storeBackend{
+	{
+		name: "redis",
+		open: func(t *testing.T) (Store, func(time.Duration)) {
+			mr := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			t.Cleanup(func() { _ = client.Close() })
+			return NewRedisStore(client), mr.FastForward
+		},
+	},
+	{
+		name: "memory",
+		open: func(*testing.T) (Store, func(time.Duration)) {
+			s := NewMemoryStore()
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			s.now = func() time.Time { return now }
+			return s, func(d time.Duration) { now = now.Add(d) }
+		},
+	},
+}
+
+func TestStoreConformance(t *testing.T) {
+	t.Parallel()
+	for _, backend := range storeBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			t.Parallel()
+			t.Run("string", func(t *testing.T) {
+				s, advance := backend.open(t)
+				testStringConformance(t, s, advance)
+			})
+			t.Run("int", func(t *testing.T) {
+				s, advance := backend.open(t)
+				testIntConformance(t, s, advance)
+			})
+			t.Run("set", func(t *testing.T) {
+				s, advance := backend.open(t)
+				testSetConformance(t, s, advance)
+			})
+			t.Run("list", func(t *testing.T) {
+				s, _ := backend.open(t)
+				testListConformance(t, s)
+			})
+			t.Run("sorted set", func(t *testing.T) {
+				s, _ := backend.open(t)
+				testSortedSetConformance(t, s)
+			})
+		})
+	}
+}
+
+func testStringConformance(t *testing.T, s Store, advance func(time.Duration)) {
+	ctx := context.Background()
+	key := NewKey("conf", "string", "a")
+
+	_, ok, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, s.Set(ctx, key, "v1", TTLMinute))
+	got, ok, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "v1", got)
+
+	set, err := s.SetNX(ctx, key, "v2", TTLMinute)
+	require.NoError(t, err)
+	assert.False(t, set, "SetNX must not overwrite a live key")
+
+	advance(2 * time.Minute)
+	exists, err := s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists, "key expires after its TTL")
+
+	set, err = s.SetNX(ctx, key, "v2", TTLNone)
+	require.NoError(t, err)
+	assert.True(t, set)
+	advance(TTLMonth.Duration())
+	got, _, err = s.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", got, "TTLNone never expires")
+
+	require.NoError(t, s.Expire(ctx, key, TTLMinute))
+	advance(30 * time.Second)
+	exists, err = s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	advance(time.Minute)
+	exists, err = s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, s.Set(ctx, key, "v3", TTLNone))
+	require.NoError(t, s.Del(ctx, key))
+	exists, err = s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	require.NoError(t, s.Del(ctx, key), "deleting a missing key is not an error")
+}
+
+func testIntConformance(t *testing.T, s Store, advance func(time.Duration)) {
+	ctx := context.Background()
+	key := NewKey("conf", "int", "a")
+
+	n, err := s.GetInt64(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, n, "missing counters read as zero")
+
+	require.NoError(t, s.SetInt64(ctx, key, 41, TTLNone))
+	n, err = s.Incr(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), n)
+	str, _, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(42), str, "counters are stored as decimal strings")
+
+	window := NewKey("conf", "int", "window")
+	for want := int64(1); want <= 3; want++ {
+		n, err = s.IncrWithTTL(ctx, window, TTLMinute)
+		require.NoError(t, err)
+		assert.Equal(t, want, n)
+		advance(15 * time.Second)
+	}
+	// Only the first increment sets the TTL, so the window closes on time.
+	advance(30 * time.Second)
+	n, err = s.GetInt64(ctx, window)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	require.NoError(t, s.Set(ctx, key, "not a number", TTLNone))
+	_, err = s.Incr(ctx, key)
+	assert.Error(t, err)
+}
+
+func testSetConformance(t *testing.T, s Store, advance func(time.Duration)) {
+	ctx := context.Background()
+	key := NewKey("conf", "set", "a")
+
+	added, err := s.Add(ctx, key, TTLMinute, "b", "a", "b")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), added)
+	added, err = s.Add(ctx, key, TTLMinute, "a", "c")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), added)
+
+	members, err := s.Members(ctx, key)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, members)
+	ok, err := s.IsMember(ctx, key, "c")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	removed, err := s.Remove(ctx, key, "c", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), removed)
+	ok, err = s.IsMember(ctx, key, "c")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// Each Add refreshes the TTL.
+	advance(45 * time.Second)
+	_, err = s.Add(ctx, key, TTLMinute, "d")
+	require.NoError(t, err)
+	advance(45 * time.Second)
+	members, err = s.Members(ctx, key)
+	require.NoError(t, err)
+	assert.Len(t, members, 3)
+	advance(time.Minute)
+	members, err = s.Members(ctx, key)
+	require.NoError(t, err)
+	assert.Empty(t, members)
+
+	_, err = s.Add(ctx, key, TTLNone, "x")
+	require.NoError(t, err)
+	require.NoError(t, s.Clear(ctx, key))
+	exists, err := s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Removing the last member deletes the key.
+	_, err = s.Add(ctx, key, TTLNone, "x")
+	require.NoError(t, err)
+	_, err = s.Remove(ctx, key, "x")
+	require.NoError(t, err)
+	exists, err = s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func testListConformance(t *testing.T, s Store) {
+	ctx := context.Background()
+	key := NewKey("conf", "list", "a")
+
+	n, err := s.Push(ctx, key, "1", "2", "3")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	n, err = s.Push(ctx, key, "4", "5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+
+	all, err := s.Range(ctx, key, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, all)
+	tail, err := s.Range(ctx, key, -2, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4", "5"}, tail)
+	past, err := s.Range(ctx, key, 10, 20)
+	require.NoError(t, err)
+	assert.Empty(t, past)
+
+	// Keep the newest three, as bounded history lists do.
+	require.NoError(t, s.Trim(ctx, key, -3, -1))
+	all, err = s.Range(ctx, key, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5"}, all)
+	length, err := s.Len(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), length)
+
+	// An empty range removes the key.
+	require.NoError(t, s.Trim(ctx, key, 5, 1))
+	length, err = s.Len(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, length)
+	exists, err := s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = s.Push(ctx, key, "x")
+	require.NoError(t, err)
+	require.NoError(t, s.Clear(ctx, key))
+	length, err = s.Len(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, length)
+
+	require.NoError(t, s.Set(ctx, key, "scalar", TTLNone))
+	_, err = s.Push(ctx, key, "x")
+	assert.Error(t, err, "pushing onto a string key fails")
+}
+
+func testSortedSetConformance(t *testing.T, s Store) {
+	ctx := context.Background()
+	key := NewKey("conf", "zset", "a")
+
+	empty, err := s.ZRangeByScore(ctx, key, 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	require.NoError(t, s.ZAdd(ctx, key, 30, "late"))
+	require.NoError(t, s.ZAdd(ctx, key, 10, "early"))
+	require.NoError(t, s.ZAdd(ctx, key, 20, "b"))
+	require.NoError(t, s.ZAdd(ctx, key, 20, "a"))
+
+	all, err := s.ZRangeByScore(ctx, key, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"early", "a", "b", "late"}, all, "ordered by score, then member")
+
+	due, err := s.ZRangeByScore(ctx, key, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"early", "a", "b"}, due, "bounds are inclusive")
+
+	// Re-adding a member updates its score.
+	require.NoError(t, s.ZAdd(ctx, key, 5, "late"))
+	due, err = s.ZRangeByScore(ctx, key, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"late", "early"}, due)
+
+	removed, err := s.ZRem(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+	removed, err = s.ZRem(ctx, key, "late", "early", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), removed)
+	removed, err = s.ZRem(ctx, key, "a", "b")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), removed)
+	exists, err := s.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists, "removing the last member deletes the key")
+}
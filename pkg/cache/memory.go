@@ -0,0 +1,487 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval bounds how often writes scan for expired keys that were
+// never read again (e.g. login attempt counters for one-off IPs).
+const memorySweepInterval = time.Minute
+
+var errMemoryWrongType = errors.New("memory: operation against a key holding the wrong kind of value")
+
+type memoryKind int
+
+const (
+	memoryString memoryKind = iota
+	memorySet
+	memoryList
+	memorySortedSet
+)
+
+type memoryItem struct {
+	kind     memoryKind
+	str      string
+	set      map[string]struct{}
+	list     []string
+	zset     map[string]float64
+	expireAt time.Time // zero means no expiry
+}
+
+// MemoryStore is an in-process Store for single-node installs that run
+// without Redis (redis.backend: memory). It follows the Redis semantics the
+// callers rely on: per-key TTLs, atomic SetNX and Incr, sets, lists with
+// negative indexes, and score-ordered sorted sets. All state is lost on
+// restart.
+type MemoryStore struct {
+	mu        sync.Mutex
+	items     map[string]*memoryItem
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{items: make(map[string]*memoryItem), now: time.Now}
+}
+
+// lookup returns the live item for key, dropping it if it has expired.
+// Callers must hold s.mu.
+func (s *MemoryStore) lookup(key string) *memoryItem {
+	it, ok := s.items[key]
+	if !ok {
+		return nil
+	}
+	if !it.expireAt.IsZero() && !s.now().Before(it.expireAt) {
+		delete(s.items, key)
+		recordEviction("memory")
+		return nil
+	}
+	return it
+}
+
+// typed returns the live item for key when it holds kind, nil when the key
+// is absent, and errMemoryWrongType otherwise. Callers must hold s.mu.
+func (s *MemoryStore) typed(key string, kind memoryKind) (*memoryItem, error) {
+	it := s.lookup(key)
+	if it == nil {
+		return nil, nil
+	}
+	if it.kind != kind {
+		return nil, fmt.Errorf("%w: %s", errMemoryWrongType, key)
+	}
+	return it, nil
+}
+
+// writable returns the item for key, creating an empty one of kind when
+// absent. It also sweeps expired keys at most once per memorySweepInterval.
+// Callers must hold s.mu.
+func (s *MemoryStore) writable(key string, kind memoryKind) (*memoryItem, error) {
+	s.sweep()
+	it, err := s.typed(key, kind)
+	if err != nil || it != nil {
+		return it, err
+	}
+	it = &memoryItem{kind: kind}
+	switch kind {
+	case memorySet:
+		it.set = make(map[string]struct{})
+	case memorySortedSet:
+		it.zset = make(map[string]float64)
+	}
+	s.items[key] = it
+	return it, nil
+}
+
+func (s *MemoryStore) sweep() {
+	now := s.now()
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, it := range s.items {
+		if !it.expireAt.IsZero() && !now.Before(it.expireAt) {
+			delete(s.items, key)
+			recordEviction("memory")
+		}
+	}
+}
+
+// expire applies ttl to an existing item. Like Redis EXPIRE, a non-positive
+// ttl deletes the key. Callers must hold s.mu.
+func (s *MemoryStore) expire(key string, it *memoryItem, ttl TTL) {
+	if ttl.Duration() <= 0 {
+		delete(s.items, key)
+		return
+	}
+	it.expireAt = s.now().Add(ttl.Duration())
+}
+
+func (s *MemoryStore) setString(key, value string, ttl TTL) {
+	s.sweep()
+	it := &memoryItem{kind: memoryString, str: value}
+	if ttl.Duration() > 0 {
+		it.expireAt = s.now().Add(ttl.Duration())
+	}
+	s.items[key] = it
+}
+
+// Get retrieves a string value by key. Returns false if the key is not found.
+func (s *MemoryStore) Get(_ context.Context, key Key) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memoryString)
+	if err != nil {
+		return "", false, err
+	}
+	if it == nil {
+		recordMiss("memory")
+		return "", false, nil
+	}
+	recordHit("memory")
+	return it.str, true, nil
+}
+
+// Set stores a string value with the given TTL.
+func (s *MemoryStore) Set(_ context.Context, key Key, value string, ttl TTL) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setString(key.String(), value, ttl)
+	return nil
+}
+
+// SetNX sets a key only if it does not already exist. Returns true if set.
+func (s *MemoryStore) SetNX(_ context.Context, key Key, value string, ttl TTL) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lookup(key.String()) != nil {
+		return false, nil
+	}
+	s.setString(key.String(), value, ttl)
+	return true, nil
+}
+
+// Del removes a key of any kind.
+func (s *MemoryStore) Del(_ context.Context, key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.items, key.String())
+	recordEviction("memory")
+	return nil
+}
+
+// Exists checks whether a key of any kind is present.
+func (s *MemoryStore) Exists(_ context.Context, key Key) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lookup(key.String()) == nil {
+		recordMiss("memory")
+		return false, nil
+	}
+	recordHit("memory")
+	return true, nil
+}
+
+// Expire refreshes the TTL on an existing key.
+func (s *MemoryStore) Expire(_ context.Context, key Key, ttl TTL) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if it := s.lookup(key.String()); it != nil {
+		s.expire(key.String(), it, ttl)
+	}
+	return nil
+}
+
+// GetInt64 retrieves an int64 value. Returns 0 if the key is not found.
+func (s *MemoryStore) GetInt64(_ context.Context, key Key) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memoryString)
+	if err != nil {
+		return 0, err
+	}
+	if it == nil {
+		recordMiss("memory")
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(it.str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("memory get int64 %s: %w", key.String(), err)
+	}
+	recordHit("memory")
+	return n, nil
+}
+
+// SetInt64 stores an int64 value with the given TTL.
+func (s *MemoryStore) SetInt64(_ context.Context, key Key, value int64, ttl TTL) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setString(key.String(), strconv.FormatInt(value, 10), ttl)
+	return nil
+}
+
+// incr adds one to the counter at key, keeping its TTL. Callers must hold s.mu.
+func (s *MemoryStore) incr(key string) (*memoryItem, int64, error) {
+	it, err := s.writable(key, memoryString)
+	if err != nil {
+		return nil, 0, err
+	}
+	var n int64
+	if it.str != "" {
+		n, err = strconv.ParseInt(it.str, 10, 64)
+		if err != nil {
+			return nil, 0, fmt.Errorf("memory incr %s: %w", key, err)
+		}
+	}
+	n++
+	it.str = strconv.FormatInt(n, 10)
+	return it, n, nil
+}
+
+// Incr atomically increments the integer at key by 1 and returns the new value.
+func (s *MemoryStore) Incr(_ context.Context, key Key) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, n, err := s.incr(key.String())
+	return n, err
+}
+
+// IncrWithTTL atomically increments the integer at key by 1 and sets the TTL
+// if the key was newly created (i.e., the new count is 1).
+func (s *MemoryStore) IncrWithTTL(_ context.Context, key Key, ttl TTL) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, n, err := s.incr(key.String())
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		s.expire(key.String(), it, ttl)
+	}
+	return n, nil
+}
+
+// Add adds members to a set and, when ttl is positive, sets its TTL.
+func (s *MemoryStore) Add(_ context.Context, key Key, ttl TTL, members ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.writable(key.String(), memorySet)
+	if err != nil {
+		return 0, err
+	}
+	var added int64
+	for _, m := range members {
+		if _, ok := it.set[m]; !ok {
+			it.set[m] = struct{}{}
+			added++
+		}
+	}
+	if ttl.Duration() > 0 {
+		s.expire(key.String(), it, ttl)
+	}
+	return added, nil
+}
+
+// IsMember checks whether a member exists in the set.
+func (s *MemoryStore) IsMember(_ context.Context, key Key, member string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memorySet)
+	if err != nil || it == nil {
+		return false, err
+	}
+	_, ok := it.set[member]
+	return ok, nil
+}
+
+// Members returns all members of the set in lexical order.
+func (s *MemoryStore) Members(_ context.Context, key Key) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memorySet)
+	if err != nil || it == nil {
+		return []string{}, err
+	}
+	out := make([]string, 0, len(it.set))
+	for m := range it.set {
+		out = append(out, m)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// Remove removes members from a set. An emptied set is deleted, as in Redis.
+func (s *MemoryStore) Remove(_ context.Context, key Key, members ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memorySet)
+	if err != nil || it == nil {
+		return 0, err
+	}
+	var removed int64
+	for _, m := range members {
+		if _, ok := it.set[m]; ok {
+			delete(it.set, m)
+			removed++
+		}
+	}
+	if len(it.set) == 0 {
+		delete(s.items, key.String())
+	}
+	return removed, nil
+}
+
+// Clear removes the entire set or list key.
+func (s *MemoryStore) Clear(_ context.Context, key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.items, key.String())
+	return nil
+}
+
+// Push appends values to the tail of a list. Returns the list length after the push.
+func (s *MemoryStore) Push(_ context.Context, key Key, values ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.writable(key.String(), memoryList)
+	if err != nil {
+		return 0, err
+	}
+	it.list = append(it.list, values...)
+	return int64(len(it.list)), nil
+}
+
+// listBounds converts Redis-style inclusive indexes, where negative values
+// count from the tail, into a half-open slice range. ok is false when the
+// range is empty.
+func listBounds(n int, start, stop int64) (lo, hi int, ok bool) {
+	size := int64(n)
+	if start < 0 {
+		start += size
+	}
+	if stop < 0 {
+		stop += size
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= size {
+		stop = size - 1
+	}
+	if start > stop || start >= size {
+		return 0, 0, false
+	}
+	return int(start), int(stop) + 1, true
+}
+
+// Range returns a range of elements from the list. Use 0, -1 for all elements.
+func (s *MemoryStore) Range(_ context.Context, key Key, start, stop int64) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memoryList)
+	if err != nil || it == nil {
+		return []string{}, err
+	}
+	lo, hi, ok := listBounds(len(it.list), start, stop)
+	if !ok {
+		return []string{}, nil
+	}
+	return append([]string(nil), it.list[lo:hi]...), nil
+}
+
+// Len returns the length of the list.
+func (s *MemoryStore) Len(_ context.Context, key Key) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memoryList)
+	if err != nil || it == nil {
+		return 0, err
+	}
+	return int64(len(it.list)), nil
+}
+
+// Trim keeps only the list elements in the inclusive range [start, stop].
+func (s *MemoryStore) Trim(_ context.Context, key Key, start, stop int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memoryList)
+	if err != nil || it == nil {
+		return err
+	}
+	lo, hi, ok := listBounds(len(it.list), start, stop)
+	if !ok {
+		delete(s.items, key.String())
+		return nil
+	}
+	it.list = append([]string(nil), it.list[lo:hi]...)
+	return nil
+}
+
+// ZAdd adds a member with the given score to a sorted set.
+func (s *MemoryStore) ZAdd(_ context.Context, key Key, score float64, member string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.writable(key.String(), memorySortedSet)
+	if err != nil {
+		return err
+	}
+	it.zset[member] = score
+	return nil
+}
+
+// ZRangeByScore returns sorted-set members with scores in [minScore, maxScore]
+// inclusive, ordered by score and then member.
+func (s *MemoryStore) ZRangeByScore(_ context.Context, key Key, minScore, maxScore float64) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memorySortedSet)
+	if err != nil || it == nil {
+		return []string{}, err
+	}
+	out := make([]string, 0, len(it.zset))
+	for m, score := range it.zset {
+		if score >= minScore && score <= maxScore {
+			out = append(out, m)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		si, sj := it.zset[out[i]], it.zset[out[j]]
+		if si != sj {
+			return si < sj
+		}
+		return out[i] < out[j]
+	})
+	return out, nil
+}
+
+// ZRem removes members from a sorted set.
+func (s *MemoryStore) ZRem(_ context.Context, key Key, members ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	it, err := s.typed(key.String(), memorySortedSet)
+	if err != nil || it == nil {
+		return 0, err
+	}
+	var removed int64
+	for _, m := range members {
+		if _, ok := it.zset[m]; ok {
+			delete(it.zset, m)
+			removed++
+		}
+	}
+	if len(it.zset) == 0 {
+		delete(s.items, key.String())
+	}
+	return removed, nil
+}
+
+// Ping always succeeds; the store lives in the process.
+func (*MemoryStore) Ping(context.Context) (time.Duration, error) {
+	return 0, nil
+}
@@ -1,8 +1,11 @@
 package cache
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
-// StringCache covers raw string KV operations, backed by either Ristretto or Redis.
+// StringCache covers raw string KV operations, backed by Ristretto, Redis or MemoryStore.
 // It is the foundational interface that every backend must satisfy.
 type StringCache interface {
 	// Get retrieves the string value for key. Returns false if not present.
@@ -21,7 +24,7 @@ type StringCache interface {
 	Expire(ctx context.Context, key Key, ttl TTL) error
 }
 
-// IntCache covers integer counters, backed by Redis.
+// IntCache covers integer counters, backed by Redis or MemoryStore.
 // It provides atomic increment operations suitable for rate limiting, statistics, and gauges.
 type IntCache interface {
 	// GetInt64 retrieves the integer value for key.
@@ -35,7 +38,7 @@ type IntCache interface {
 	IncrWithTTL(ctx context.Context, key Key, ttl TTL) (int64, error)
 }
 
-// SetCache covers set-based deduplication, backed by Redis.
+// SetCache covers set-based deduplication, backed by Redis or MemoryStore.
 // It manages unordered collections of unique members with O(1) membership tests.
 type SetCache interface {
 	// Add adds one or more members to the set. Returns the number of members added.
@@ -50,7 +53,7 @@ type SetCache interface {
 	Clear(ctx context.Context, key Key) error
 }
 
-// ListCache covers list-based aggregation buffers, backed by Redis.
+// ListCache covers list-based aggregation buffers, backed by Redis or MemoryStore.
 // It provides ordered insertion and ranged retrieval suitable for message queues
 // and event buffers.
 type ListCache interface {
@@ -66,3 +69,31 @@ type ListCache interface {
 	// Clear removes all elements from the list.
 	Clear(ctx context.Context, key Key) error
 }
+
+// SortedSetCache covers score-ordered indexes such as notify aggregation due times.
+type SortedSetCache interface {
+	// ZAdd adds member with score, replacing the score of an existing member.
+	ZAdd(ctx context.Context, key Key, score float64, member string) error
+	// ZRangeByScore returns members with scores in [minScore, maxScore], lowest first.
+	ZRangeByScore(ctx context.Context, key Key, minScore, maxScore float64) ([]string, error)
+	// ZRem removes members. Returns the number removed.
+	ZRem(ctx context.Context, key Key, members ...string) (int64, error)
+}
+
+// Store is the shared cache backend: RedisStore by default, or MemoryStore
+// when redis.backend is "memory". Callers depend on this interface so both
+// backends are interchangeable.
+type Store interface {
+	StringCache
+	IntCache
+	SetCache
+	ListCache
+	SortedSetCache
+	// Ping checks backend connectivity and returns the round-trip latency.
+	Ping(ctx context.Context) (time.Duration, error)
+}
+
+var (
+	_ Store = (*RedisStore)(nil)
+	_ Store = (*MemoryStore)(nil)
+)
@@ -21,11 +21,13 @@ type Bookmark struct {
 
 // ArchiveItem represents a saved web page or document in an archive store.
 type ArchiveItem struct {
-	ID        string    `json:"id"`
-	URL       string    `json:"url"`
-	Title     string    `json:"title,omitzero"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	Title      string    `json:"title,omitzero"`
+	Status     string    `json:"status"`
+	Tags       []string  `json:"tags,omitzero"`
+	CreatedAt  time.Time `json:"created_at"`
+	ArchivedAt time.Time `json:"archived_at,omitzero"`
 }
 
 // Feed represents an RSS/Atom feed subscription in a reader service.
@@ -252,6 +254,75 @@ type NocoRecord struct {
 	Fields map[string]any `json:"fields,omitzero"`
 }
 
+// DNSStatus reports the runtime state of a DNS filter such as AdGuard Home.
+type DNSStatus struct {
+	Running           bool     `json:"running"`
+	ProtectionEnabled bool     `json:"protection_enabled"`
+	Version           string   `json:"version,omitzero"`
+	DNSAddresses      []string `json:"dns_addresses,omitzero"`
+	DNSPort           int      `json:"dns_port,omitzero"`
+}
+
+// DNSStats summarises DNS filter query counters for the stats window.
+type DNSStats struct {
+	Queries              int64   `json:"queries"`
+	BlockedFiltering     int64   `json:"blocked_filtering"`
+	ReplacedSafebrowsing int64   `json:"replaced_safebrowsing"`
+	ReplacedParental     int64   `json:"replaced_parental"`
+	AvgProcessingMs      float64 `json:"avg_processing_ms"`
+}
+
+// DNSQuery is one entry of a DNS filter query log.
+type DNSQuery struct {
+	Time       time.Time `json:"time"`
+	Client     string    `json:"client"`
+	ClientName string    `json:"client_name,omitzero"`
+	Domain     string    `json:"domain"`
+	Type       string    `json:"type,omitzero"`
+	Status     string    `json:"status,omitzero"`
+	Reason     string    `json:"reason,omitzero"`
+	Blocked    bool      `json:"blocked"`
+	Rule       string    `json:"rule,omitzero"`
+	Upstream   string    `json:"upstream,omitzero"`
+	ElapsedMs  float64   `json:"elapsed_ms,omitzero"`
+	Cached     bool      `json:"cached"`
+}
+
+// AutomationWorkflow is a workflow in an external automation service such as n8n.
+type AutomationWorkflow struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Active    bool      `json:"active"`
+	Tags      []string  `json:"tags,omitzero"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+	UpdatedAt time.Time `json:"updated_at,omitzero"`
+}
+
+// AutomationExecution is one run of an AutomationWorkflow.
+type AutomationExecution struct {
+	ID         string    `json:"id"`
+	WorkflowID string    `json:"workflow_id"`
+	Status     string    `json:"status,omitzero"`
+	Mode       string    `json:"mode,omitzero"`
+	Finished   bool      `json:"finished"`
+	StartedAt  time.Time `json:"started_at,omitzero"`
+	StoppedAt  time.Time `json:"stopped_at,omitzero"`
+}
+
+// Shortcut is a named short link in a link shortener such as Slash.
+type Shortcut struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Link        string    `json:"link"`
+	Title       string    `json:"title,omitzero"`
+	Description string    `json:"description,omitzero"`
+	Tags        []string  `json:"tags,omitzero"`
+	Visibility  string    `json:"visibility,omitzero"`
+	ViewCount   int64     `json:"view_count,omitzero"`
+	CreatedAt   time.Time `json:"created_at,omitzero"`
+	UpdatedAt   time.Time `json:"updated_at,omitzero"`
+}
+
 // DevopsStatus reports which devops backends are configured.
 type DevopsStatus struct {
 	Backends map[string]bool `json:"backends"`
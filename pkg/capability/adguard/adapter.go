@@ -0,0 +1,219 @@
+// Package adguard implements the AdGuard Home adapter for the DNS filter capability.
+package adguard
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/adguard"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	defaultQueryLogLimit = 50
+	maxQueryLogLimit     = 500
+)
+
+// queryLogStatuses lists the response_status filters accepted by AdGuard Home.
+var queryLogStatuses = []string{
+	"all", "filtered", "blocked", "blocked_safebrowsing", "blocked_parental",
+	"whitelisted", "rewritten", "safe_search", "processed",
+}
+
+// client defines the subset of provider.AdGuardHome methods used by this adapter.
+type client interface {
+	GetStatus() (*provider.ServerStatus, error)
+	GetStats() (*provider.Stats, error)
+	QueryLog(params provider.QueryLogParams) (*provider.QueryLog, error)
+	SetProtection(enabled bool, duration time.Duration) error
+	GetFilteringStatus() (*provider.FilteringStatus, error)
+	SetFilteringConfig(enabled bool, interval int) error
+}
+
+// Adapter implements Service using the AdGuard Home provider client.
+type Adapter struct {
+	client client
+}
+
+// New creates an Adapter using the default provider client (reads config from YAML).
+// Returns nil when the provider is not configured.
+func New() Service {
+	c := provider.GetClient()
+	if c == nil {
+		return nil
+	}
+	return NewWithClient(c)
+}
+
+// NewWithClient creates an Adapter with a specific client, useful for testing.
+func NewWithClient(c client) Service {
+	return &Adapter{client: c}
+}
+
+// Status returns the running state and protection flag of the DNS server.
+func (a *Adapter) Status(ctx context.Context) (*capability.DNSStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	status, err := a.client.GetStatus()
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "adguard status failed", err)
+	}
+	return &capability.DNSStatus{
+		Running:           status.Running,
+		ProtectionEnabled: status.ProtectionEnabled,
+		Version:           status.Version,
+		DNSAddresses:      status.DnsAddresses,
+		DNSPort:           int(status.DnsPort),
+	}, nil
+}
+
+// Stats returns query counters for the configured statistics window.
+func (a *Adapter) Stats(ctx context.Context) (*capability.DNSStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	stats, err := a.client.GetStats()
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "adguard stats failed", err)
+	}
+	out := &capability.DNSStats{}
+	if stats.NumDnsQueries != nil {
+		out.Queries = int64(*stats.NumDnsQueries)
+	}
+	if stats.NumBlockedFiltering != nil {
+		out.BlockedFiltering = int64(*stats.NumBlockedFiltering)
+	}
+	if stats.NumReplacedSafebrowsing != nil {
+		out.ReplacedSafebrowsing = int64(*stats.NumReplacedSafebrowsing)
+	}
+	if stats.NumReplacedParental != nil {
+		out.ReplacedParental = int64(*stats.NumReplacedParental)
+	}
+	if stats.AvgProcessingTime != nil {
+		// AdGuard Home reports the average in seconds.
+		out.AvgProcessingMs = float64(*stats.AvgProcessingTime) * 1000
+	}
+	return out, nil
+}
+
+// QueryLog returns a page of the DNS query log, newest first.
+// The cursor is the provider's "older_than" timestamp from the previous page.
+func (a *Adapter) QueryLog(ctx context.Context, q *QueryLogQuery) (*capability.ListResult[capability.DNSQuery], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if q == nil {
+		q = &QueryLogQuery{}
+	}
+	if q.Status != "" && !slices.Contains(queryLogStatuses, q.Status) {
+		return nil, types.Errorf(types.ErrInvalidArgument, "invalid status %q, want one of %s", q.Status, strings.Join(queryLogStatuses, ", "))
+	}
+	limit := normalizedLimit(q.Page.Limit)
+	log, err := a.client.QueryLog(provider.QueryLogParams{
+		OlderThan:      q.Page.Cursor,
+		Limit:          limit,
+		Search:         q.Search,
+		ResponseStatus: q.Status,
+	})
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "adguard query log failed", err)
+	}
+	items := make([]*capability.DNSQuery, 0, len(log.Data))
+	for i := range log.Data {
+		items = append(items, toDNSQuery(&log.Data[i]))
+	}
+	page := &capability.PageInfo{Limit: limit}
+	if log.Oldest != "" && len(items) > 0 {
+		page.HasMore = true
+		page.NextCursor = log.Oldest
+	}
+	return &capability.ListResult[capability.DNSQuery]{Items: items, Page: page}, nil
+}
+
+// SetProtection enables or disables DNS protection. A positive duration
+// re-enables protection automatically once it elapses.
+func (a *Adapter) SetProtection(ctx context.Context, enabled bool, duration time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if duration < 0 {
+		return types.Errorf(types.ErrInvalidArgument, "duration must not be negative")
+	}
+	if err := a.client.SetProtection(enabled, duration); err != nil {
+		return types.WrapError(types.ErrProvider, "adguard set protection failed", err)
+	}
+	return nil
+}
+
+// SetFiltering enables or disables filter-list blocking, keeping the current
+// filter update interval.
+func (a *Adapter) SetFiltering(ctx context.Context, enabled bool) error {
+	if err := ctx.Err(); err != nil {
+		return types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	current, err := a.client.GetFilteringStatus()
+	if err != nil {
+		return types.WrapError(types.ErrProvider, "adguard filtering status failed", err)
+	}
+	if err := a.client.SetFilteringConfig(enabled, current.Interval); err != nil {
+		return types.WrapError(types.ErrProvider, "adguard set filtering failed", err)
+	}
+	return nil
+}
+
+// HealthCheck reports whether AdGuard Home is reachable and its DNS server is running.
+func (a *Adapter) HealthCheck(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	status, err := a.client.GetStatus()
+	if err != nil {
+		return false, types.WrapError(types.ErrProvider, "adguard health check failed", err)
+	}
+	return status.Running, nil
+}
+
+// normalizedLimit clamps the provided limit to a valid range.
+func normalizedLimit(limit int) int {
+	if limit <= 0 {
+		return defaultQueryLogLimit
+	}
+	return min(limit, maxQueryLogLimit)
+}
+
+func toDNSQuery(item *provider.QueryLogItem) *capability.DNSQuery {
+	out := &capability.DNSQuery{
+		Client:   item.Client,
+		Domain:   item.Question.Name,
+		Type:     item.Question.Type,
+		Status:   item.Status,
+		Reason:   item.Reason,
+		Blocked:  strings.HasPrefix(item.Reason, "Filtered"),
+		Upstream: item.Upstream,
+		Cached:   item.Cached,
+	}
+	if item.Question.UnicodeName != "" {
+		out.Domain = item.Question.UnicodeName
+	}
+	if item.ClientInfo != nil {
+		out.ClientName = item.ClientInfo.Name
+	}
+	if len(item.Rules) > 0 {
+		out.Rule = item.Rules[0].Text
+	}
+	if t, err := time.Parse(time.RFC3339Nano, item.Time); err == nil {
+		out.Time = t
+	}
+	if ms, err := strconv.ParseFloat(item.ElapsedMs, 64); err == nil {
+		out.ElapsedMs = ms
+	}
+	return out
+}
+
+// Compile-time interface check.
+var _ Service = (*Adapter)(nil)
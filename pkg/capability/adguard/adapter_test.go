@@ -0,0 +1,219 @@
+package adguard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/adguard"
+)
+
+type fakeClient struct {
+	statusResp      *provider.ServerStatus
+	statusErr       error
+	statsResp       *provider.Stats
+	statsErr        error
+	queryLogResp    *provider.QueryLog
+	queryLogErr     error
+	protectionErr   error
+	filteringResp   *provider.FilteringStatus
+	filteringErr    error
+	lastQueryLog    provider.QueryLogParams
+	lastProtection  *bool
+	lastDuration    time.Duration
+	lastFiltering   *bool
+	lastFilterEvery int
+}
+
+func (f *fakeClient) GetStatus() (*provider.ServerStatus, error) {
+	if f.statusErr != nil {
+		return nil, f.statusErr
+	}
+	if f.statusResp == nil {
+		return &provider.ServerStatus{}, nil
+	}
+	return f.statusResp, nil
+}
+
+func (f *fakeClient) GetStats() (*provider.Stats, error) {
+	if f.statsErr != nil {
+		return nil, f.statsErr
+	}
+	if f.statsResp == nil {
+		return &provider.Stats{}, nil
+	}
+	return f.statsResp, nil
+}
+
+func (f *fakeClient) QueryLog(params provider.QueryLogParams) (*provider.QueryLog, error) {
+	f.lastQueryLog = params
+	if f.queryLogErr != nil {
+		return nil, f.queryLogErr
+	}
+	if f.queryLogResp == nil {
+		return &provider.QueryLog{}, nil
+	}
+	return f.queryLogResp, nil
+}
+
+func (f *fakeClient) SetProtection(enabled bool, duration time.Duration) error {
+	f.lastProtection = &enabled
+	f.lastDuration = duration
+	return f.protectionErr
+}
+
+func (f *fakeClient) GetFilteringStatus() (*provider.FilteringStatus, error) {
+	if f.filteringErr != nil {
+		return nil, f.filteringErr
+	}
+	if f.filteringResp == nil {
+		return &provider.FilteringStatus{Enabled: true, Interval: 24}, nil
+	}
+	return f.filteringResp, nil
+}
+
+func (f *fakeClient) SetFilteringConfig(enabled bool, interval int) error {
+	f.lastFiltering = &enabled
+	f.lastFilterEvery = interval
+	return f.filteringErr
+}
+
+var _ client = (*fakeClient)(nil)
+
+func capabilityPage(limit int, cursor string) capability.PageRequest {
+	return capability.PageRequest{Limit: limit, Cursor: cursor}
+}
+
+func TestAdapter_Stats(t *testing.T) {
+	t.Parallel()
+	queries, blocked := int32(100), int32(7)
+	avg := float32(0.025)
+	fc := &fakeClient{statsResp: &provider.Stats{NumDnsQueries: &queries, NumBlockedFiltering: &blocked, AvgProcessingTime: &avg}}
+	stats, err := NewWithClient(fc).Stats(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), stats.Queries)
+	assert.Equal(t, int64(7), stats.BlockedFiltering)
+	assert.InDelta(t, 25.0, stats.AvgProcessingMs, 0.01)
+}
+
+func TestAdapter_QueryLog(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		query     *QueryLogQuery
+		resp      *provider.QueryLog
+		wantLimit int
+		wantMore  bool
+		check     func(t *testing.T, fc *fakeClient)
+	}{
+		{
+			name: "maps items and cursor",
+			query: &QueryLogQuery{
+				Page:   capabilityPage(10, "2026-01-02T00:00:00Z"),
+				Search: "ads",
+				Status: "blocked",
+			},
+			resp: &provider.QueryLog{
+				Oldest: "2026-01-01T00:00:00Z",
+				Data: []provider.QueryLogItem{{
+					Client:     "10.0.0.2",
+					ClientInfo: &provider.QueryLogClient{Name: "laptop"},
+					Question:   provider.QueryLogQuestion{Name: "ads.example.com", Type: "A"},
+					Reason:     "FilteredBlackList",
+					Rules:      []provider.QueryLogRule{{Text: "||ads.example.com^"}},
+					Time:       "2026-01-01T00:00:00.5Z",
+					ElapsedMs:  "1.5",
+				}},
+			},
+			wantLimit: 10,
+			wantMore:  true,
+			check: func(t *testing.T, fc *fakeClient) {
+				assert.Equal(t, "2026-01-02T00:00:00Z", fc.lastQueryLog.OlderThan)
+				assert.Equal(t, "ads", fc.lastQueryLog.Search)
+				assert.Equal(t, "blocked", fc.lastQueryLog.ResponseStatus)
+			},
+		},
+		{
+			name:      "default limit and no more pages",
+			query:     nil,
+			resp:      &provider.QueryLog{},
+			wantLimit: defaultQueryLogLimit,
+			wantMore:  false,
+		},
+		{
+			name:      "limit capped",
+			query:     &QueryLogQuery{Page: capabilityPage(10000, "")},
+			resp:      &provider.QueryLog{},
+			wantLimit: maxQueryLogLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &fakeClient{queryLogResp: tt.resp}
+			result, err := NewWithClient(fc).QueryLog(context.Background(), tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLimit, result.Page.Limit)
+			assert.Equal(t, tt.wantLimit, fc.lastQueryLog.Limit)
+			assert.Equal(t, tt.wantMore, result.Page.HasMore)
+			if tt.check != nil {
+				tt.check(t, fc)
+			}
+		})
+	}
+}
+
+func TestAdapter_QueryLogItemMapping(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{queryLogResp: &provider.QueryLog{Data: []provider.QueryLogItem{{
+		Client:     "10.0.0.2",
+		ClientInfo: &provider.QueryLogClient{Name: "laptop"},
+		Question:   provider.QueryLogQuestion{Name: "xn--e1afmkfd.example", UnicodeName: "пример.example", Type: "AAAA"},
+		Reason:     "FilteredBlackList",
+		Rules:      []provider.QueryLogRule{{Text: "||пример.example^"}},
+		Time:       "2026-01-01T00:00:00.5Z",
+		ElapsedMs:  "1.5",
+		Cached:     true,
+	}}}}
+	result, err := NewWithClient(fc).QueryLog(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	item := result.Items[0]
+	assert.Equal(t, "пример.example", item.Domain)
+	assert.Equal(t, "laptop", item.ClientName)
+	assert.True(t, item.Blocked)
+	assert.Equal(t, "||пример.example^", item.Rule)
+	assert.InDelta(t, 1.5, item.ElapsedMs, 0.001)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 5e8, time.UTC), item.Time)
+	assert.True(t, item.Cached)
+}
+
+func TestAdapter_SetProtection(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{}
+	require.NoError(t, NewWithClient(fc).SetProtection(context.Background(), false, 10*time.Minute))
+	require.NotNil(t, fc.lastProtection)
+	assert.False(t, *fc.lastProtection)
+	assert.Equal(t, 10*time.Minute, fc.lastDuration)
+}
+
+func TestAdapter_SetFilteringKeepsInterval(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{filteringResp: &provider.FilteringStatus{Enabled: true, Interval: 72}}
+	require.NoError(t, NewWithClient(fc).SetFiltering(context.Background(), false))
+	require.NotNil(t, fc.lastFiltering)
+	assert.False(t, *fc.lastFiltering)
+	assert.Equal(t, 72, fc.lastFilterEvery)
+}
+
+func TestAdapter_HealthCheckNotRunning(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{statusResp: &provider.ServerStatus{Running: false}}
+	ok, err := NewWithClient(fc).HealthCheck(context.Background())
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,57 @@
+package adguard
+
+import (
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/capability/conformance"
+	provider "github.com/flowline-io/flowbot/pkg/providers/adguard"
+)
+
+// fakeClientFromDNSConfig maps the conformance DNSConfig onto a fakeClient.
+func fakeClientFromDNSConfig(cfg conformance.DNSConfig) *fakeClient {
+	c := &fakeClient{
+		statusErr:     cfg.StatusErr,
+		statsErr:      cfg.StatsErr,
+		queryLogErr:   cfg.QueryLogErr,
+		protectionErr: cfg.ProtectionErr,
+		filteringErr:  cfg.FilteringErr,
+	}
+	if cfg.Status != nil {
+		c.statusResp = &provider.ServerStatus{
+			Running:           cfg.Status.Running,
+			ProtectionEnabled: cfg.Status.ProtectionEnabled,
+			Version:           cfg.Status.Version,
+		}
+	}
+	if cfg.QueryLogItems != nil {
+		log := &provider.QueryLog{Oldest: cfg.QueryLogNextCursor}
+		for _, item := range cfg.QueryLogItems {
+			log.Data = append(log.Data, providerQueryLogItem(item))
+		}
+		c.queryLogResp = log
+	}
+	return c
+}
+
+func providerQueryLogItem(item *capability.DNSQuery) provider.QueryLogItem {
+	out := provider.QueryLogItem{
+		Client:   item.Client,
+		Question: provider.QueryLogQuestion{Name: item.Domain, Type: item.Type},
+		Status:   item.Status,
+	}
+	if item.Blocked {
+		out.Reason = "FilteredBlackList"
+	}
+	return out
+}
+
+func TestAdGuardDNSConformance(t *testing.T) {
+	conformance.RunDNSConformance(t, func(t *testing.T, cfg conformance.DNSConfig) conformance.DNSService {
+		t.Helper()
+		return NewWithClient(fakeClientFromDNSConfig(cfg))
+	})
+}
+
+// Compile-time check: Adapter satisfies the conformance DNS contract.
+var _ conformance.DNSService = (*Adapter)(nil)
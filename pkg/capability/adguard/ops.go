@@ -0,0 +1,11 @@
+package adguard
+
+// Operation name constants for the AdGuard Home capability.
+const (
+	OpStatus        = "status"
+	OpStats         = "stats"
+	OpQueryLog      = "query_log"
+	OpSetProtection = "set_protection"
+	OpSetFiltering  = "set_filtering"
+	OpHealth        = "health"
+)
@@ -0,0 +1,155 @@
+package adguard
+
+import (
+	"context"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// Register registers the AdGuard Home capability with hub and invoker registry.
+// When svc is nil the provider is not configured and registration is skipped.
+func Register(app string, svc Service) error {
+	return capability.Register(buildSpec(app, svc))
+}
+
+// CatalogSpec returns capability metadata for documentation (handlers may close over a nil service and must not be invoked).
+func CatalogSpec() capability.Spec {
+	return buildSpec("", nil)
+}
+
+func buildSpec(app string, svc Service) capability.Spec {
+	return capability.Spec{
+		Type:        hub.CapAdguard,
+		App:         app,
+		Description: "DNS filter capability for AdGuard Home",
+		Instance:    svc,
+		Ops: []capability.OpDef{
+			{
+				Name: OpStatus, Description: "Get DNS server status", Scopes: []string{auth.ScopeServiceAdguardRead},
+				Handler: invokeStatus(svc),
+			},
+			{
+				Name: OpStats, Description: "Get DNS query statistics", Scopes: []string{auth.ScopeServiceAdguardRead},
+				Handler: invokeStats(svc),
+			},
+			{
+				Name: OpQueryLog, Description: "Search the DNS query log", Scopes: []string{auth.ScopeServiceAdguardRead},
+				Input: []hub.ParamDef{
+					{Name: "limit", Type: "int", Required: false, Description: "Maximum items per page"},
+					{Name: "cursor", Type: "string", Required: false, Description: "Pagination cursor"},
+					{Name: "search", Type: "string", Required: false, Description: "Domain or client substring"},
+					{Name: "status", Type: "string", Required: false, Description: "Response status filter (all, filtered, blocked, blocked_safebrowsing, blocked_parental, whitelisted, rewritten, safe_search, processed)"},
+				},
+				Handler: invokeQueryLog(svc),
+			},
+			{
+				Name: OpSetProtection, Description: "Enable or disable DNS protection", Scopes: []string{auth.ScopeServiceAdguardWrite}, Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "enabled", Type: "bool", Required: true, Description: "Whether protection is enabled"},
+					{Name: "duration", Type: "string", Required: false, Description: "Go duration after which disabled protection turns back on (e.g. 10m)"},
+				},
+				Handler: invokeSetProtection(svc),
+			},
+			{
+				Name: OpSetFiltering, Description: "Enable or disable filter-list blocking", Scopes: []string{auth.ScopeServiceAdguardWrite}, Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "enabled", Type: "bool", Required: true, Description: "Whether filtering is enabled"},
+				},
+				Handler: invokeSetFiltering(svc),
+			},
+			{
+				Name: OpHealth, Description: "Health check", Scopes: []string{auth.ScopeServiceAdguardRead},
+				Handler: invokeHealth(svc),
+			},
+		},
+	}
+}
+
+func invokeStatus(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		status, err := svc.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: status}, nil
+	}
+}
+
+func invokeStats(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		stats, err := svc.Stats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: stats}, nil
+	}
+}
+
+func invokeQueryLog(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		q := &QueryLogQuery{Page: capability.PageRequestFromParams(params)}
+		q.Search, _ = capability.StringParam(params, "search")
+		q.Status, _ = capability.StringParam(params, "status")
+		result, err := svc.QueryLog(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: result.Items, Page: result.Page}, nil
+	}
+}
+
+func invokeSetProtection(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		enabled, err := requiredBool(params, "enabled")
+		if err != nil {
+			return nil, err
+		}
+		var duration time.Duration
+		if raw, ok := capability.StringParam(params, "duration"); ok && raw != "" {
+			duration, err = time.ParseDuration(raw)
+			if err != nil {
+				return nil, types.WrapError(types.ErrInvalidArgument, "invalid duration", err)
+			}
+		}
+		if err := svc.SetProtection(ctx, enabled, duration); err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: map[string]any{"protection_enabled": enabled}}, nil
+	}
+}
+
+func invokeSetFiltering(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		enabled, err := requiredBool(params, "enabled")
+		if err != nil {
+			return nil, err
+		}
+		if err := svc.SetFiltering(ctx, enabled); err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: map[string]any{"filtering_enabled": enabled}}, nil
+	}
+}
+
+func invokeHealth(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		ok, err := svc.HealthCheck(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: ok}, nil
+	}
+}
+
+// requiredBool extracts a required boolean from invoke params.
+func requiredBool(params map[string]any, key string) (bool, error) {
+	value, ok := capability.BoolParam(params, key)
+	if !ok {
+		return false, types.Errorf(types.ErrInvalidArgument, "%s is required", key)
+	}
+	return value, nil
+}
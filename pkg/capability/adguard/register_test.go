@@ -0,0 +1,112 @@
+package adguard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type mockService struct {
+	lastEnabled  bool
+	lastDuration time.Duration
+	lastQuery    *QueryLogQuery
+}
+
+func (*mockService) Status(_ context.Context) (*capability.DNSStatus, error) {
+	return &capability.DNSStatus{Running: true}, nil
+}
+func (*mockService) Stats(_ context.Context) (*capability.DNSStats, error) {
+	return &capability.DNSStats{}, nil
+}
+func (m *mockService) QueryLog(_ context.Context, q *QueryLogQuery) (*capability.ListResult[capability.DNSQuery], error) {
+	m.lastQuery = q
+	return &capability.ListResult[capability.DNSQuery]{Items: []*capability.DNSQuery{}, Page: &capability.PageInfo{}}, nil
+}
+func (m *mockService) SetProtection(_ context.Context, enabled bool, duration time.Duration) error {
+	m.lastEnabled = enabled
+	m.lastDuration = duration
+	return nil
+}
+func (m *mockService) SetFiltering(_ context.Context, enabled bool) error {
+	m.lastEnabled = enabled
+	return nil
+}
+func (*mockService) HealthCheck(_ context.Context) (bool, error) { return true, nil }
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  Service
+	}{
+		{name: "nil service skips registration", svc: nil},
+		{name: "valid service", svc: &mockService{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, Register("adguard_home", tt.svc))
+		})
+	}
+}
+
+func TestRegister_Operations(t *testing.T) {
+	require.NoError(t, Register("adguard_home", &mockService{}))
+	desc, ok := hub.Default.Get(hub.CapAdguard)
+	require.True(t, ok)
+	assert.Equal(t, "adguard_home", desc.App)
+	assert.Len(t, desc.Operations, 6)
+
+	opNames := make([]string, len(desc.Operations))
+	for i, op := range desc.Operations {
+		opNames[i] = op.Name
+	}
+	for _, op := range []string{OpStatus, OpStats, OpQueryLog, OpSetProtection, OpSetFiltering, OpHealth} {
+		assert.Contains(t, opNames, op)
+	}
+}
+
+func TestInvokeSetProtection(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       map[string]any
+		wantEnabled  bool
+		wantDuration time.Duration
+		wantErr      bool
+	}{
+		{name: "disable for ten minutes", params: map[string]any{"enabled": false, "duration": "10m"}, wantDuration: 10 * time.Minute},
+		{name: "enable from string flag", params: map[string]any{"enabled": "true"}, wantEnabled: true},
+		{name: "missing enabled", params: map[string]any{}, wantErr: true},
+		{name: "bad duration", params: map[string]any{"enabled": false, "duration": "soon"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockService{}
+			_, err := invokeSetProtection(svc)(context.Background(), tt.params)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, types.ErrInvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEnabled, svc.lastEnabled)
+			assert.Equal(t, tt.wantDuration, svc.lastDuration)
+		})
+	}
+}
+
+func TestInvokeQueryLog(t *testing.T) {
+	svc := &mockService{}
+	res, err := invokeQueryLog(svc)(context.Background(), map[string]any{"limit": 5, "search": "ads", "status": "blocked"})
+	require.NoError(t, err)
+	require.NotNil(t, res.Page)
+	require.NotNil(t, svc.lastQuery)
+	assert.Equal(t, 5, svc.lastQuery.Page.Limit)
+	assert.Equal(t, "ads", svc.lastQuery.Search)
+	assert.Equal(t, "blocked", svc.lastQuery.Status)
+}
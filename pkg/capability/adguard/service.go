@@ -0,0 +1,21 @@
+package adguard
+
+import (
+	"context"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// QueryLogQuery wraps pagination and filtering for the DNS query log.
+type QueryLogQuery = capability.DNSQueryLogQuery
+
+// Service defines the AdGuard Home DNS filter capability contract.
+type Service interface {
+	Status(ctx context.Context) (*capability.DNSStatus, error)
+	Stats(ctx context.Context) (*capability.DNSStats, error)
+	QueryLog(ctx context.Context, q *QueryLogQuery) (*capability.ListResult[capability.DNSQuery], error)
+	SetProtection(ctx context.Context, enabled bool, duration time.Duration) error
+	SetFiltering(ctx context.Context, enabled bool) error
+	HealthCheck(ctx context.Context) (bool, error)
+}
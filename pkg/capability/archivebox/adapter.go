@@ -0,0 +1,220 @@
+// Package archivebox implements the ArchiveBox adapter for the web archive capability.
+package archivebox
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/archivebox"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+
+	statusArchived = "archived"
+	statusPending  = "pending"
+)
+
+// client defines the subset of provider.ArchiveBox methods used by this adapter.
+type client interface {
+	Add(data provider.Data) (*provider.Response, error)
+	ListSnapshots(q provider.SnapshotQuery) (*provider.SnapshotPage, error)
+	GetSnapshot(id string) (*provider.Snapshot, error)
+}
+
+// Adapter implements Service using the ArchiveBox provider client.
+type Adapter struct {
+	client client
+}
+
+// New creates an Adapter using the default provider client (reads config from YAML).
+// Returns nil when the provider is not configured.
+func New() Service {
+	c := provider.GetClient()
+	if c == nil {
+		return nil
+	}
+	return NewWithClient(c)
+}
+
+// NewWithClient creates an Adapter with a specific client, useful for testing.
+func NewWithClient(c client) Service {
+	return &Adapter{client: c}
+}
+
+// Add submits URLs for archiving and returns the URLs ArchiveBox accepted.
+func (a *Adapter) Add(ctx context.Context, urls, tags []string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if len(urls) == 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "urls is required")
+	}
+	resp, err := a.client.Add(provider.Data{
+		Urls: urls,
+		Tag:  strings.Join(tags, ","),
+	})
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "archivebox add failed", err)
+	}
+	if !resp.Success {
+		return nil, types.Errorf(types.ErrProvider, "archivebox add failed: %s", strings.Join(resp.Errors, "; "))
+	}
+	if len(resp.Result) == 0 {
+		return urls, nil
+	}
+	return resp.Result, nil
+}
+
+// List returns a page of snapshots, newest first. The cursor is the offset of
+// the next page.
+func (a *Adapter) List(ctx context.Context, q *ListQuery) (*capability.ListResult[capability.ArchiveItem], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if q == nil {
+		q = &ListQuery{}
+	}
+	offset, err := decodeOffset(q.Page.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizedLimit(q.Page.Limit)
+	page, err := a.client.ListSnapshots(provider.SnapshotQuery{
+		Limit:  limit,
+		Offset: offset,
+		Search: q.Search,
+		Tag:    q.Tag,
+	})
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "archivebox list snapshots failed", err)
+	}
+	items := make([]*capability.ArchiveItem, 0, len(page.Items))
+	for i := range page.Items {
+		items = append(items, toArchiveItem(&page.Items[i]))
+	}
+	total := int64(page.TotalItems)
+	info := &capability.PageInfo{Limit: limit, Total: &total}
+	if next := offset + len(items); len(items) > 0 && next < page.TotalItems {
+		info.HasMore = true
+		info.NextCursor = strconv.Itoa(next)
+	}
+	return &capability.ListResult[capability.ArchiveItem]{Items: items, Page: info}, nil
+}
+
+// Get returns a single snapshot by ID.
+func (a *Adapter) Get(ctx context.Context, id string) (*capability.ArchiveItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if id == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	snapshot, err := a.client.GetSnapshot(id)
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "archivebox get snapshot failed", err)
+	}
+	return toArchiveItem(snapshot), nil
+}
+
+// HealthCheck reports whether the ArchiveBox API is reachable.
+func (a *Adapter) HealthCheck(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if _, err := a.client.ListSnapshots(provider.SnapshotQuery{Limit: 1}); err != nil {
+		return false, types.WrapError(types.ErrProvider, "archivebox health check failed", err)
+	}
+	return true, nil
+}
+
+// ListRawEvents returns the newest page of snapshots as raw events for polling.
+// The returned cursor is always empty: the snapshot feed is newest-first, so the
+// poller re-reads the head every tick and relies on DiffKey/ContentHash to spot
+// new and finished snapshots.
+func (a *Adapter) ListRawEvents(ctx context.Context, _ string) ([]any, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	page, err := a.client.ListSnapshots(provider.SnapshotQuery{Limit: defaultListLimit})
+	if err != nil {
+		return nil, "", types.WrapError(types.ErrProvider, "archivebox list raw events failed", err)
+	}
+	items := make([]any, 0, len(page.Items))
+	for i := range page.Items {
+		item := toArchiveItem(&page.Items[i])
+		items = append(items, map[string]any{
+			"id":     item.ID,
+			"url":    item.URL,
+			"title":  item.Title,
+			"status": item.Status,
+			"tags":   item.Tags,
+		})
+	}
+	return items, "", nil
+}
+
+// decodeOffset parses an offset cursor. An empty cursor is the first page.
+func decodeOffset(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(cursor)
+	if err != nil || offset < 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid cursor")
+	}
+	return offset, nil
+}
+
+// normalizedLimit clamps the provided limit to a valid range.
+func normalizedLimit(limit int) int {
+	if limit <= 0 {
+		return defaultListLimit
+	}
+	return min(limit, maxListLimit)
+}
+
+func toArchiveItem(s *provider.Snapshot) *capability.ArchiveItem {
+	if s == nil {
+		return nil
+	}
+	out := &capability.ArchiveItem{
+		ID:     s.ID,
+		URL:    s.URL,
+		Title:  s.Title,
+		Status: statusPending,
+		Tags:   splitTags(s.Tags),
+	}
+	switch {
+	case s.CreatedAt != nil:
+		out.CreatedAt = *s.CreatedAt
+	case s.BookmarkedAt != nil:
+		out.CreatedAt = *s.BookmarkedAt
+	}
+	if s.DownloadedAt != nil {
+		out.Status = statusArchived
+		out.ArchivedAt = *s.DownloadedAt
+	}
+	return out
+}
+
+func splitTags(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	tags := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			tags = append(tags, p)
+		}
+	}
+	return tags
+}
+
+// Compile-time interface check.
+var _ Service = (*Adapter)(nil)
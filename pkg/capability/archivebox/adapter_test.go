@@ -0,0 +1,161 @@
+package archivebox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/archivebox"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type fakeClient struct {
+	addResp   *provider.Response
+	addErr    error
+	listResp  *provider.SnapshotPage
+	listErr   error
+	getResp   *provider.Snapshot
+	getErr    error
+	lastAdd   provider.Data
+	lastQuery provider.SnapshotQuery
+	lastGetID string
+}
+
+func (f *fakeClient) Add(data provider.Data) (*provider.Response, error) {
+	f.lastAdd = data
+	if f.addErr != nil {
+		return nil, f.addErr
+	}
+	if f.addResp == nil {
+		return &provider.Response{Success: true}, nil
+	}
+	return f.addResp, nil
+}
+
+func (f *fakeClient) ListSnapshots(q provider.SnapshotQuery) (*provider.SnapshotPage, error) {
+	f.lastQuery = q
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	if f.listResp == nil {
+		return &provider.SnapshotPage{}, nil
+	}
+	return f.listResp, nil
+}
+
+func (f *fakeClient) GetSnapshot(id string) (*provider.Snapshot, error) {
+	f.lastGetID = id
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	if f.getResp == nil {
+		return &provider.Snapshot{ID: id}, nil
+	}
+	return f.getResp, nil
+}
+
+var _ client = (*fakeClient)(nil)
+
+func TestAdapter_Add(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		resp     *provider.Response
+		wantURLs []string
+		wantCode error
+	}{
+		{name: "returns provider result", resp: &provider.Response{Success: true, Result: []string{"https://a.example"}}, wantURLs: []string{"https://a.example"}},
+		{name: "falls back to requested urls", resp: &provider.Response{Success: true}, wantURLs: []string{"https://a.example", "https://b.example"}},
+		{name: "unsuccessful response", resp: &provider.Response{Errors: []string{"bad url"}}, wantCode: types.ErrProvider},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &fakeClient{addResp: tt.resp}
+			urls, err := NewWithClient(fc).Add(context.Background(), []string{"https://a.example", "https://b.example"}, []string{"news", "go"})
+			if tt.wantCode != nil {
+				assert.ErrorIs(t, err, tt.wantCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURLs, urls)
+			assert.Equal(t, "news,go", fc.lastAdd.Tag)
+		})
+	}
+}
+
+func TestAdapter_ListPagination(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{listResp: &provider.SnapshotPage{
+		TotalItems: 3,
+		Items:      []provider.Snapshot{{ID: "a"}, {ID: "b"}},
+	}}
+	result, err := NewWithClient(fc).List(context.Background(), &ListQuery{
+		Page:   capability.PageRequest{Limit: 2, Cursor: "0"},
+		Search: "golang",
+		Tag:    "news",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, provider.SnapshotQuery{Limit: 2, Offset: 0, Search: "golang", Tag: "news"}, fc.lastQuery)
+	assert.True(t, result.Page.HasMore)
+	assert.Equal(t, "2", result.Page.NextCursor)
+	require.NotNil(t, result.Page.Total)
+	assert.Equal(t, int64(3), *result.Page.Total)
+
+	fc.listResp = &provider.SnapshotPage{TotalItems: 3, Items: []provider.Snapshot{{ID: "c"}}}
+	result, err = NewWithClient(fc).List(context.Background(), &ListQuery{Page: capability.PageRequest{Limit: 2, Cursor: result.Page.NextCursor}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, fc.lastQuery.Offset)
+	assert.False(t, result.Page.HasMore)
+	assert.Empty(t, result.Page.NextCursor)
+}
+
+func TestAdapter_ListInvalidCursor(t *testing.T) {
+	t.Parallel()
+	for _, cursor := range []string{"abc", "-1"} {
+		_, err := NewWithClient(&fakeClient{}).List(context.Background(), &ListQuery{Page: capability.PageRequest{Cursor: cursor}})
+		assert.ErrorIs(t, err, types.ErrInvalidArgument, cursor)
+	}
+}
+
+func TestToArchiveItem(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	downloaded := created.Add(time.Minute)
+	tests := []struct {
+		name       string
+		in         *provider.Snapshot
+		wantStatus string
+		wantTags   []string
+	}{
+		{name: "pending snapshot", in: &provider.Snapshot{ID: "1", CreatedAt: &created}, wantStatus: statusPending},
+		{name: "archived snapshot with tags", in: &provider.Snapshot{ID: "2", Tags: "news, go,,", CreatedAt: &created, DownloadedAt: &downloaded}, wantStatus: statusArchived, wantTags: []string{"news", "go"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			item := toArchiveItem(tt.in)
+			assert.Equal(t, tt.wantStatus, item.Status)
+			assert.Equal(t, tt.wantTags, item.Tags)
+			assert.Equal(t, created, item.CreatedAt)
+		})
+	}
+}
+
+func TestAdapter_ListRawEvents(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{listResp: &provider.SnapshotPage{TotalItems: 100, Items: []provider.Snapshot{{ID: "a", URL: "https://a.example"}}}}
+	items, next, err := NewWithClient(fc).ListRawEvents(context.Background(), "ignored")
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Equal(t, 0, fc.lastQuery.Offset)
+	require.Len(t, items, 1)
+	m, ok := items[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "a", m["id"])
+	assert.Equal(t, statusPending, m["status"])
+}
@@ -0,0 +1,55 @@
+package archivebox
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/capability/conformance"
+	provider "github.com/flowline-io/flowbot/pkg/providers/archivebox"
+)
+
+// fakeClientFromArchiveConfig maps the conformance ArchiveConfig onto a fakeClient.
+func fakeClientFromArchiveConfig(cfg conformance.ArchiveConfig) *fakeClient {
+	c := &fakeClient{
+		addErr:  cfg.AddErr,
+		listErr: cfg.ListErr,
+		getErr:  cfg.GetErr,
+		addResp: &provider.Response{
+			Success: len(cfg.AddFailure) == 0,
+			Errors:  cfg.AddFailure,
+			Result:  cfg.AddedURLs,
+		},
+	}
+	if cfg.ListItems != nil {
+		page := &provider.SnapshotPage{TotalItems: max(cfg.ListTotal, len(cfg.ListItems))}
+		for _, item := range cfg.ListItems {
+			page.Items = append(page.Items, providerSnapshot(item))
+		}
+		c.listResp = page
+	}
+	if cfg.GetItem != nil {
+		s := providerSnapshot(cfg.GetItem)
+		c.getResp = &s
+	}
+	return c
+}
+
+func providerSnapshot(item *capability.ArchiveItem) provider.Snapshot {
+	return provider.Snapshot{
+		ID:    item.ID,
+		URL:   item.URL,
+		Title: item.Title,
+		Tags:  strings.Join(item.Tags, ","),
+	}
+}
+
+func TestArchiveBoxArchiveConformance(t *testing.T) {
+	conformance.RunArchiveConformance(t, func(t *testing.T, cfg conformance.ArchiveConfig) conformance.ArchiveService {
+		t.Helper()
+		return NewWithClient(fakeClientFromArchiveConfig(cfg))
+	})
+}
+
+// Compile-time check: Adapter satisfies the conformance archive contract.
+var _ conformance.ArchiveService = (*Adapter)(nil)
@@ -0,0 +1,9 @@
+package archivebox
+
+// Operation name constants for the ArchiveBox capability.
+const (
+	OpAdd    = "add"
+	OpList   = "list"
+	OpGet    = "get"
+	OpHealth = "health"
+)
@@ -0,0 +1,75 @@
+package archivebox
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// SnapshotPoller polls ArchiveBox for new snapshots and snapshots that finish archiving.
+type SnapshotPoller struct {
+	svc Service
+}
+
+// NewPoller creates a SnapshotPoller. Returns nil when the provider is not configured.
+func NewPoller() capability.PollingResource {
+	svc := New()
+	if svc == nil {
+		return nil
+	}
+	return NewPollerWithService(svc)
+}
+
+// NewPollerWithService creates a SnapshotPoller with a specific service.
+func NewPollerWithService(svc Service) *SnapshotPoller {
+	return &SnapshotPoller{svc: svc}
+}
+
+func (*SnapshotPoller) ResourceName() string { return "archivebox/snapshots" }
+
+func (*SnapshotPoller) DefaultInterval() time.Duration { return 5 * time.Minute }
+
+func (*SnapshotPoller) Capability() string { return string(hub.CapArchivebox) }
+
+func (*SnapshotPoller) DiffKey(item any) string {
+	if m, ok := item.(map[string]any); ok {
+		if id, ok := m["id"].(string); ok {
+			return id
+		}
+	}
+	return fmt.Sprintf("%v", item)
+}
+
+func (*SnapshotPoller) ContentHash(item any) string {
+	data := fmt.Sprintf("%v", item)
+	h := sha256.New()
+	_, _ = h.Write([]byte(data))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func (*SnapshotPoller) CursorField() string { return "cursor" }
+
+func (p *SnapshotPoller) List(ctx context.Context, cursor string) (capability.PollResult, error) {
+	if err := ctx.Err(); err != nil {
+		return capability.PollResult{}, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	items, nextCursor, err := p.svc.ListRawEvents(ctx, cursor)
+	if err != nil {
+		return capability.PollResult{}, err
+	}
+	return capability.PollResult{
+		Items:      items,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+	}, nil
+}
+
+var (
+	_ capability.PollingResource    = (*SnapshotPoller)(nil)
+	_ capability.CapabilityProvider = (*SnapshotPoller)(nil)
+)
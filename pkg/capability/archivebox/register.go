@@ -0,0 +1,146 @@
+package archivebox
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// Register registers the ArchiveBox capability with hub and invoker registry.
+// When svc is nil the provider is not configured and registration is skipped.
+func Register(app string, svc Service) error {
+	return capability.Register(buildSpec(app, svc))
+}
+
+// CatalogSpec returns capability metadata for documentation (handlers may close over a nil service and must not be invoked).
+func CatalogSpec() capability.Spec {
+	return buildSpec("", nil)
+}
+
+func buildSpec(app string, svc Service) capability.Spec {
+	return capability.Spec{
+		Type:        hub.CapArchivebox,
+		App:         app,
+		Description: "Web archive capability for ArchiveBox",
+		Instance:    svc,
+		Events: []hub.EventDef{
+			{Name: "archivebox/snapshots.created", Description: "Fires when the poller observes a new snapshot"},
+			{Name: "archivebox/snapshots.updated", Description: "Fires when the poller observes a changed snapshot, e.g. archiving finished"},
+		},
+		Ops: []capability.OpDef{
+			{
+				Name: OpAdd, Description: "Archive one or more URLs", Scopes: []string{auth.ScopeServiceArchiveboxWrite}, Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "urls", Type: "[]string", Required: true, Description: "URLs to archive"},
+					{Name: "tags", Type: "[]string", Required: false, Description: "Tags to apply to the snapshots"},
+				},
+				Handler: invokeAdd(svc, app),
+			},
+			{
+				Name: OpList, Description: "List snapshots", Scopes: []string{auth.ScopeServiceArchiveboxRead},
+				Input: []hub.ParamDef{
+					{Name: "limit", Type: "int", Required: false, Description: "Maximum items per page"},
+					{Name: "cursor", Type: "string", Required: false, Description: "Pagination cursor"},
+					{Name: "search", Type: "string", Required: false, Description: "Full-text search query"},
+					{Name: "tag", Type: "string", Required: false, Description: "Only snapshots with this tag"},
+				},
+				Handler: invokeList(svc),
+			},
+			{
+				Name: OpGet, Description: "Get a snapshot", Scopes: []string{auth.ScopeServiceArchiveboxRead},
+				Input:   []hub.ParamDef{{Name: "id", Type: "string", Required: true, Description: "Snapshot ID"}},
+				Handler: invokeGet(svc),
+			},
+			{
+				Name: OpHealth, Description: "Health check", Scopes: []string{auth.ScopeServiceArchiveboxRead},
+				Handler: invokeHealth(svc),
+			},
+		},
+	}
+}
+
+func invokeAdd(svc Service, app string) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		urls := stringSlice(params["urls"])
+		if len(urls) == 0 {
+			return nil, types.Errorf(types.ErrInvalidArgument, "urls is required")
+		}
+		added, err := svc.Add(ctx, urls, stringSlice(params["tags"]))
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{
+			Data: map[string]any{"urls": added},
+			Resource: &capability.ResourceMeta{
+				EntityID: strings.Join(added, ","),
+				App:      app,
+			},
+		}, nil
+	}
+}
+
+func invokeList(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		q := &ListQuery{Page: capability.PageRequestFromParams(params)}
+		q.Search, _ = capability.StringParam(params, "search")
+		q.Tag, _ = capability.StringParam(params, "tag")
+		result, err := svc.List(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: result.Items, Page: result.Page}, nil
+	}
+}
+
+func invokeGet(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredString(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		item, err := svc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: item}, nil
+	}
+}
+
+func invokeHealth(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		ok, err := svc.HealthCheck(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: ok}, nil
+	}
+}
+
+// stringSlice accepts a []string, a []any of strings, or a single
+// comma-separated string.
+func stringSlice(value any) []string {
+	var raw []string
+	switch v := value.(type) {
+	case []string:
+		raw = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	case string:
+		raw = strings.Split(v, ",")
+	}
+	out := make([]string, 0, len(raw))
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+package archivebox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type mockService struct {
+	lastURLs []string
+	lastTags []string
+}
+
+func (m *mockService) Add(_ context.Context, urls, tags []string) ([]string, error) {
+	m.lastURLs = urls
+	m.lastTags = tags
+	return urls, nil
+}
+func (*mockService) List(_ context.Context, _ *ListQuery) (*capability.ListResult[capability.ArchiveItem], error) {
+	return &capability.ListResult[capability.ArchiveItem]{Items: []*capability.ArchiveItem{}, Page: &capability.PageInfo{}}, nil
+}
+func (*mockService) Get(_ context.Context, id string) (*capability.ArchiveItem, error) {
+	return &capability.ArchiveItem{ID: id}, nil
+}
+func (*mockService) HealthCheck(_ context.Context) (bool, error) { return true, nil }
+func (*mockService) ListRawEvents(_ context.Context, _ string) ([]any, string, error) {
+	return nil, "", nil
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  Service
+	}{
+		{name: "nil service skips registration", svc: nil},
+		{name: "valid service", svc: &mockService{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, Register("archivebox", tt.svc))
+		})
+	}
+}
+
+func TestRegister_Operations(t *testing.T) {
+	require.NoError(t, Register("archivebox", &mockService{}))
+	desc, ok := hub.Default.Get(hub.CapArchivebox)
+	require.True(t, ok)
+	assert.Equal(t, "archivebox", desc.App)
+	assert.Len(t, desc.Operations, 4)
+
+	opNames := make([]string, len(desc.Operations))
+	for i, op := range desc.Operations {
+		opNames[i] = op.Name
+	}
+	for _, op := range []string{OpAdd, OpList, OpGet, OpHealth} {
+		assert.Contains(t, opNames, op)
+	}
+}
+
+func TestInvokeAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]any
+		wantURLs []string
+		wantTags []string
+		wantErr  bool
+	}{
+		{name: "string slices", params: map[string]any{"urls": []string{"https://a.example"}, "tags": []string{"news"}}, wantURLs: []string{"https://a.example"}, wantTags: []string{"news"}},
+		{name: "json arrays", params: map[string]any{"urls": []any{"https://a.example", "https://b.example"}}, wantURLs: []string{"https://a.example", "https://b.example"}, wantTags: []string{}},
+		{name: "comma separated", params: map[string]any{"urls": "https://a.example, https://b.example", "tags": "a,b"}, wantURLs: []string{"https://a.example", "https://b.example"}, wantTags: []string{"a", "b"}},
+		{name: "missing urls", params: map[string]any{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockService{}
+			res, err := invokeAdd(svc, "archivebox")(context.Background(), tt.params)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, types.ErrInvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURLs, svc.lastURLs)
+			assert.Equal(t, tt.wantTags, svc.lastTags)
+			assert.Equal(t, "archivebox", res.Resource.App)
+		})
+	}
+}
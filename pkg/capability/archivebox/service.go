@@ -0,0 +1,19 @@
+package archivebox
+
+import (
+	"context"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// ListQuery wraps pagination and filtering for listing snapshots.
+type ListQuery = capability.ArchiveListQuery
+
+// Service defines the ArchiveBox web archive capability contract.
+type Service interface {
+	Add(ctx context.Context, urls, tags []string) ([]string, error)
+	List(ctx context.Context, q *ListQuery) (*capability.ListResult[capability.ArchiveItem], error)
+	Get(ctx context.Context, id string) (*capability.ArchiveItem, error)
+	HealthCheck(ctx context.Context) (bool, error)
+	ListRawEvents(ctx context.Context, cursor string) ([]any, string, error)
+}
@@ -0,0 +1,186 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// ArchiveListQuery wraps pagination and filtering for listing archived items.
+type ArchiveListQuery = capability.ArchiveListQuery
+
+// ArchiveService is the web archive capability contract used by conformance tests.
+type ArchiveService interface {
+	Add(ctx context.Context, urls, tags []string) ([]string, error)
+	List(ctx context.Context, q *ArchiveListQuery) (*capability.ListResult[capability.ArchiveItem], error)
+	Get(ctx context.Context, id string) (*capability.ArchiveItem, error)
+	HealthCheck(ctx context.Context) (bool, error)
+	ListRawEvents(ctx context.Context, cursor string) ([]any, string, error)
+}
+
+// ArchiveConfig configures the fake backend for each archive conformance subtest.
+// Fields set to non-nil/non-zero values become the fake responses; zero values
+// produce empty/default success responses.
+// Raw events are read from the same backend listing as List, so ListItems and
+// ListErr also drive the ListRawEvents subtests.
+type ArchiveConfig struct {
+	AddedURLs  []string
+	AddFailure []string
+	AddErr     error
+	ListItems  []*capability.ArchiveItem
+	ListTotal  int
+	ListErr    error
+	GetItem    *capability.ArchiveItem
+	GetErr     error
+}
+
+// ArchiveServiceFactory creates a fresh archive Service wired to a fake backend
+// whose behavior is determined by the config parameter.
+type ArchiveServiceFactory func(t *testing.T, cfg ArchiveConfig) ArchiveService
+
+// RunArchiveConformance runs the standard web archive capability conformance suite.
+// The factory must wire cfg into a fresh adapter and fake client for each subtest.
+func RunArchiveConformance(t *testing.T, factory ArchiveServiceFactory) {
+	t.Run("add success", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{AddedURLs: []string{"https://example.com"}})
+		urls, err := svc.Add(t.Context(), []string{"https://example.com"}, []string{"read-later"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://example.com"}, urls)
+	})
+
+	t.Run("add empty urls", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, err := svc.Add(t.Context(), nil, nil)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("add timeout", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, err := svc.Add(CanceledContext(), []string{"https://example.com"}, nil)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("add provider error", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{AddErr: assert.AnError})
+		_, err := svc.Add(t.Context(), []string{"https://example.com"}, nil)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("add rejected by provider", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{AddFailure: []string{"invalid url"}})
+		_, err := svc.Add(t.Context(), []string{"nope"}, nil)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("list pagination", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{
+			ListItems: []*capability.ArchiveItem{{ID: "1", URL: "https://example.com"}},
+			ListTotal: 50,
+		})
+		result, err := svc.List(t.Context(), &ArchiveListQuery{Page: capability.PageRequest{Limit: 1}})
+		require.NoError(t, err)
+		RequireListResult(t, result, 1, true)
+		assert.NotEmpty(t, result.Page.NextCursor)
+		assert.Len(t, result.Items, 1)
+	})
+
+	t.Run("list empty", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		result, err := svc.List(t.Context(), &ArchiveListQuery{})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.NotNil(t, result.Items)
+		require.NotNil(t, result.Page)
+		assert.Empty(t, result.Items)
+		assert.False(t, result.Page.HasMore)
+	})
+
+	t.Run("list nil query", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		result, err := svc.List(t.Context(), nil)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+	})
+
+	t.Run("list invalid cursor", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, err := svc.List(t.Context(), &ArchiveListQuery{Page: capability.PageRequest{Cursor: "not-a-cursor"}})
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("list timeout", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, err := svc.List(CanceledContext(), nil)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("list provider error", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{ListErr: assert.AnError})
+		_, err := svc.List(t.Context(), &ArchiveListQuery{})
+		RequireProviderError(t, err)
+	})
+
+	t.Run("get success", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{GetItem: &capability.ArchiveItem{ID: "1", URL: "https://example.com", Title: "Example"}})
+		item, err := svc.Get(t.Context(), "1")
+		require.NoError(t, err)
+		require.NotNil(t, item)
+		assert.Equal(t, "1", item.ID)
+	})
+
+	t.Run("get empty id", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, err := svc.Get(t.Context(), "")
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("get timeout", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, err := svc.Get(CanceledContext(), "1")
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("get provider error", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{GetErr: assert.AnError})
+		_, err := svc.Get(t.Context(), "1")
+		RequireProviderError(t, err)
+	})
+
+	t.Run("health success", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		ok, err := svc.HealthCheck(t.Context())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("health provider error", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{ListErr: assert.AnError})
+		ok, err := svc.HealthCheck(t.Context())
+		RequireProviderError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("raw events success", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{ListItems: []*capability.ArchiveItem{{ID: "1", URL: "https://example.com"}}})
+		items, next, err := svc.ListRawEvents(t.Context(), "")
+		require.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Empty(t, next, "raw events always re-read the newest page")
+	})
+
+	t.Run("raw events timeout", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{})
+		_, _, err := svc.ListRawEvents(CanceledContext(), "")
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("raw events provider error", func(t *testing.T) {
+		svc := factory(t, ArchiveConfig{ListErr: assert.AnError})
+		_, _, err := svc.ListRawEvents(t.Context(), "")
+		RequireProviderError(t, err)
+	})
+}
@@ -0,0 +1,236 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// AutomationExecutionQuery wraps pagination and filtering for listing workflow executions.
+type AutomationExecutionQuery = capability.AutomationExecutionQuery
+
+// AutomationService is the workflow automation capability contract used by conformance tests.
+type AutomationService interface {
+	ListWorkflows(ctx context.Context) ([]*capability.AutomationWorkflow, error)
+	GetWorkflow(ctx context.Context, id string) (*capability.AutomationWorkflow, error)
+	SetWorkflowActive(ctx context.Context, id string, active bool) error
+	TriggerWorkflow(ctx context.Context, id string, data map[string]any) error
+	ListExecutions(ctx context.Context, q *AutomationExecutionQuery) (*capability.ListResult[capability.AutomationExecution], error)
+	HealthCheck(ctx context.Context) (bool, error)
+	ListRawEvents(ctx context.Context, cursor string) ([]any, string, error)
+}
+
+// AutomationConfig configures the fake backend for each automation conformance subtest.
+// Fields set to non-nil/non-zero values become the fake responses; zero values
+// produce empty/default success responses. Raw events are read from the same
+// backend listing as ListExecutions, so ExecutionItems and ExecutionErr also
+// drive the ListRawEvents subtests.
+type AutomationConfig struct {
+	Workflows           []*capability.AutomationWorkflow
+	ListErr             error
+	GetItem             *capability.AutomationWorkflow
+	GetErr              error
+	ActivateErr         error
+	TriggerErr          error
+	ExecutionItems      []*capability.AutomationExecution
+	ExecutionNextCursor string
+	ExecutionErr        error
+}
+
+// AutomationServiceFactory creates a fresh automation Service wired to a fake backend
+// whose behavior is determined by the config parameter.
+type AutomationServiceFactory func(t *testing.T, cfg AutomationConfig) AutomationService
+
+// RunAutomationConformance runs the standard workflow automation capability conformance suite.
+// The factory must wire cfg into a fresh adapter and fake client for each subtest.
+func RunAutomationConformance(t *testing.T, factory AutomationServiceFactory) {
+	t.Run("list workflows success", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{Workflows: []*capability.AutomationWorkflow{{ID: "1", Name: "Backup", Active: true}}})
+		items, err := svc.ListWorkflows(t.Context())
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Backup", items[0].Name)
+	})
+
+	t.Run("list workflows empty", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		items, err := svc.ListWorkflows(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, items)
+		assert.Empty(t, items)
+	})
+
+	t.Run("list workflows timeout", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		_, err := svc.ListWorkflows(CanceledContext())
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("list workflows provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{ListErr: assert.AnError})
+		_, err := svc.ListWorkflows(t.Context())
+		RequireProviderError(t, err)
+	})
+
+	t.Run("get workflow success", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{GetItem: &capability.AutomationWorkflow{ID: "1", Name: "Backup"}})
+		item, err := svc.GetWorkflow(t.Context(), "1")
+		require.NoError(t, err)
+		require.NotNil(t, item)
+		assert.Equal(t, "1", item.ID)
+	})
+
+	t.Run("get workflow empty id", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		_, err := svc.GetWorkflow(t.Context(), "")
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("get workflow timeout", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		_, err := svc.GetWorkflow(CanceledContext(), "1")
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("get workflow provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{GetErr: assert.AnError})
+		_, err := svc.GetWorkflow(t.Context(), "1")
+		RequireProviderError(t, err)
+	})
+
+	t.Run("set active success", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		require.NoError(t, svc.SetWorkflowActive(t.Context(), "1", true))
+		require.NoError(t, svc.SetWorkflowActive(t.Context(), "1", false))
+	})
+
+	t.Run("set active empty id", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		err := svc.SetWorkflowActive(t.Context(), "", true)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("set active timeout", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		err := svc.SetWorkflowActive(CanceledContext(), "1", true)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("set active provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{ActivateErr: assert.AnError})
+		err := svc.SetWorkflowActive(t.Context(), "1", true)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("trigger success", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		require.NoError(t, svc.TriggerWorkflow(t.Context(), "1", map[string]any{"k": "v"}))
+	})
+
+	t.Run("trigger empty id", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		err := svc.TriggerWorkflow(t.Context(), "", nil)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("trigger timeout", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		err := svc.TriggerWorkflow(CanceledContext(), "1", nil)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("trigger provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{TriggerErr: assert.AnError})
+		err := svc.TriggerWorkflow(t.Context(), "1", nil)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("list executions pagination", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{
+			ExecutionItems:      []*capability.AutomationExecution{{ID: "10", WorkflowID: "1", Status: "error"}},
+			ExecutionNextCursor: "provider-next",
+		})
+		result, err := svc.ListExecutions(t.Context(), &AutomationExecutionQuery{Page: capability.PageRequest{Limit: 20}})
+		require.NoError(t, err)
+		RequireListResult(t, result, 20, true)
+		assert.NotEmpty(t, result.Page.NextCursor)
+		assert.Len(t, result.Items, 1)
+	})
+
+	t.Run("list executions empty", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		result, err := svc.ListExecutions(t.Context(), &AutomationExecutionQuery{})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.NotNil(t, result.Items)
+		require.NotNil(t, result.Page)
+		assert.Empty(t, result.Items)
+		assert.False(t, result.Page.HasMore)
+	})
+
+	t.Run("list executions nil query", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		result, err := svc.ListExecutions(t.Context(), nil)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+	})
+
+	t.Run("list executions invalid status", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		_, err := svc.ListExecutions(t.Context(), &AutomationExecutionQuery{Status: "bogus"})
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("list executions timeout", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		_, err := svc.ListExecutions(CanceledContext(), nil)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("list executions provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{ExecutionErr: assert.AnError})
+		_, err := svc.ListExecutions(t.Context(), nil)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("health success", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		ok, err := svc.HealthCheck(t.Context())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("health provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{ListErr: assert.AnError})
+		ok, err := svc.HealthCheck(t.Context())
+		RequireProviderError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("raw events success", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{
+			ExecutionItems:      []*capability.AutomationExecution{{ID: "10", WorkflowID: "1", Status: "success"}},
+			ExecutionNextCursor: "provider-next",
+		})
+		items, next, err := svc.ListRawEvents(t.Context(), "")
+		require.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Empty(t, next, "raw events always re-read the newest page")
+	})
+
+	t.Run("raw events timeout", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{})
+		_, _, err := svc.ListRawEvents(CanceledContext(), "")
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("raw events provider error", func(t *testing.T) {
+		svc := factory(t, AutomationConfig{ExecutionErr: assert.AnError})
+		_, _, err := svc.ListRawEvents(t.Context(), "")
+		RequireProviderError(t, err)
+	})
+}
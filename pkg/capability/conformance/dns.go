@@ -0,0 +1,190 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// DNSQueryLogQuery wraps pagination and filtering for a DNS query log.
+type DNSQueryLogQuery = capability.DNSQueryLogQuery
+
+// DNSService is the DNS filter capability contract used by conformance tests.
+type DNSService interface {
+	Status(ctx context.Context) (*capability.DNSStatus, error)
+	Stats(ctx context.Context) (*capability.DNSStats, error)
+	QueryLog(ctx context.Context, q *DNSQueryLogQuery) (*capability.ListResult[capability.DNSQuery], error)
+	SetProtection(ctx context.Context, enabled bool, duration time.Duration) error
+	SetFiltering(ctx context.Context, enabled bool) error
+	HealthCheck(ctx context.Context) (bool, error)
+}
+
+// DNSConfig configures the fake backend for each DNS conformance subtest.
+// Fields set to non-nil/non-zero values become the fake responses; zero values
+// produce empty/default success responses.
+type DNSConfig struct {
+	Status             *capability.DNSStatus
+	StatusErr          error
+	StatsErr           error
+	QueryLogItems      []*capability.DNSQuery
+	QueryLogNextCursor string
+	QueryLogErr        error
+	ProtectionErr      error
+	FilteringErr       error
+}
+
+// DNSServiceFactory creates a fresh DNS Service wired to a fake backend
+// whose behavior is determined by the config parameter.
+type DNSServiceFactory func(t *testing.T, cfg DNSConfig) DNSService
+
+// RunDNSConformance runs the standard DNS filter capability conformance suite.
+// The factory must wire cfg into a fresh adapter and fake client for each subtest.
+func RunDNSConformance(t *testing.T, factory DNSServiceFactory) {
+	t.Run("status success", func(t *testing.T) {
+		svc := factory(t, DNSConfig{Status: &capability.DNSStatus{Running: true, ProtectionEnabled: true, Version: "v0.107.0"}})
+		status, err := svc.Status(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.True(t, status.Running)
+		assert.Equal(t, "v0.107.0", status.Version)
+	})
+
+	t.Run("status timeout", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		_, err := svc.Status(CanceledContext())
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("status provider error", func(t *testing.T) {
+		svc := factory(t, DNSConfig{StatusErr: assert.AnError})
+		_, err := svc.Status(t.Context())
+		RequireProviderError(t, err)
+	})
+
+	t.Run("stats success", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		stats, err := svc.Stats(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, stats)
+	})
+
+	t.Run("stats provider error", func(t *testing.T) {
+		svc := factory(t, DNSConfig{StatsErr: assert.AnError})
+		_, err := svc.Stats(t.Context())
+		RequireProviderError(t, err)
+	})
+
+	t.Run("query log pagination", func(t *testing.T) {
+		svc := factory(t, DNSConfig{
+			QueryLogItems:      []*capability.DNSQuery{{Domain: "ads.example.com", Client: "10.0.0.2", Blocked: true}},
+			QueryLogNextCursor: "2026-01-01T00:00:00Z",
+		})
+		result, err := svc.QueryLog(t.Context(), &DNSQueryLogQuery{Page: capability.PageRequest{Limit: 20}})
+		require.NoError(t, err)
+		RequireListResult(t, result, 20, true)
+		assert.NotEmpty(t, result.Page.NextCursor)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "ads.example.com", result.Items[0].Domain)
+	})
+
+	t.Run("query log empty", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		result, err := svc.QueryLog(t.Context(), &DNSQueryLogQuery{})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.NotNil(t, result.Items)
+		require.NotNil(t, result.Page)
+		assert.Empty(t, result.Items)
+		assert.False(t, result.Page.HasMore)
+	})
+
+	t.Run("query log nil query", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		result, err := svc.QueryLog(t.Context(), nil)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+	})
+
+	t.Run("query log invalid status", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		_, err := svc.QueryLog(t.Context(), &DNSQueryLogQuery{Status: "bogus"})
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("query log timeout", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		_, err := svc.QueryLog(CanceledContext(), nil)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("query log provider error", func(t *testing.T) {
+		svc := factory(t, DNSConfig{QueryLogErr: assert.AnError})
+		_, err := svc.QueryLog(t.Context(), &DNSQueryLogQuery{})
+		RequireProviderError(t, err)
+	})
+
+	t.Run("set protection success", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		require.NoError(t, svc.SetProtection(t.Context(), false, time.Minute))
+	})
+
+	t.Run("set protection negative duration", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		err := svc.SetProtection(t.Context(), false, -time.Minute)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("set protection timeout", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		err := svc.SetProtection(CanceledContext(), true, 0)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("set protection provider error", func(t *testing.T) {
+		svc := factory(t, DNSConfig{ProtectionErr: assert.AnError})
+		err := svc.SetProtection(t.Context(), true, 0)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("set filtering success", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		require.NoError(t, svc.SetFiltering(t.Context(), false))
+	})
+
+	t.Run("set filtering timeout", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		err := svc.SetFiltering(CanceledContext(), true)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("set filtering provider error", func(t *testing.T) {
+		svc := factory(t, DNSConfig{FilteringErr: assert.AnError})
+		err := svc.SetFiltering(t.Context(), true)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("health success", func(t *testing.T) {
+		svc := factory(t, DNSConfig{Status: &capability.DNSStatus{Running: true}})
+		ok, err := svc.HealthCheck(t.Context())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("health timeout", func(t *testing.T) {
+		svc := factory(t, DNSConfig{})
+		_, err := svc.HealthCheck(CanceledContext())
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("health provider error", func(t *testing.T) {
+		svc := factory(t, DNSConfig{StatusErr: assert.AnError})
+		ok, err := svc.HealthCheck(t.Context())
+		RequireProviderError(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,189 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// ShortcutService is the link shortener capability contract used by conformance tests.
+type ShortcutService interface {
+	List(ctx context.Context) ([]*capability.Shortcut, error)
+	Get(ctx context.Context, id int64) (*capability.Shortcut, error)
+	Create(ctx context.Context, in *capability.Shortcut) (*capability.Shortcut, error)
+	Update(ctx context.Context, id int64, data map[string]any) (*capability.Shortcut, error)
+	Delete(ctx context.Context, id int64) error
+	HealthCheck(ctx context.Context) (bool, error)
+}
+
+// ShortcutConfig configures the fake backend for each shortcut conformance subtest.
+// Fields set to non-nil/non-zero values become the fake responses; zero values
+// produce empty/default success responses.
+type ShortcutConfig struct {
+	ListItems []*capability.Shortcut
+	ListErr   error
+	GetItem   *capability.Shortcut
+	GetErr    error
+	CreateErr error
+	UpdateErr error
+	DeleteErr error
+}
+
+// ShortcutServiceFactory creates a fresh shortcut Service wired to a fake backend
+// whose behavior is determined by the config parameter.
+type ShortcutServiceFactory func(t *testing.T, cfg ShortcutConfig) ShortcutService
+
+// RunShortcutConformance runs the standard link shortener capability conformance suite.
+// The factory must wire cfg into a fresh adapter and fake client for each subtest.
+func RunShortcutConformance(t *testing.T, factory ShortcutServiceFactory) {
+	t.Run("list success", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{ListItems: []*capability.Shortcut{{ID: 1, Name: "go", Link: "https://go.dev"}}})
+		items, err := svc.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "go", items[0].Name)
+	})
+
+	t.Run("list empty", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		items, err := svc.List(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, items)
+		assert.Empty(t, items)
+	})
+
+	t.Run("list timeout", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.List(CanceledContext())
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("list provider error", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{ListErr: assert.AnError})
+		_, err := svc.List(t.Context())
+		RequireProviderError(t, err)
+	})
+
+	t.Run("get success", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{GetItem: &capability.Shortcut{ID: 1, Name: "go", Link: "https://go.dev"}})
+		item, err := svc.Get(t.Context(), 1)
+		require.NoError(t, err)
+		require.NotNil(t, item)
+		assert.Equal(t, int64(1), item.ID)
+	})
+
+	t.Run("get invalid id", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.Get(t.Context(), 0)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("get timeout", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.Get(CanceledContext(), 1)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("get provider error", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{GetErr: assert.AnError})
+		_, err := svc.Get(t.Context(), 1)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("create success", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		item, err := svc.Create(t.Context(), &capability.Shortcut{Name: "go", Link: "https://go.dev"})
+		require.NoError(t, err)
+		require.NotNil(t, item)
+		assert.Equal(t, "go", item.Name)
+		assert.Equal(t, "https://go.dev", item.Link)
+	})
+
+	t.Run("create missing fields", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.Create(t.Context(), &capability.Shortcut{Name: "go"})
+		RequireInvalidArgError(t, err)
+		_, err = svc.Create(t.Context(), nil)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("create timeout", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.Create(CanceledContext(), &capability.Shortcut{Name: "go", Link: "https://go.dev"})
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("create provider error", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{CreateErr: assert.AnError})
+		_, err := svc.Create(t.Context(), &capability.Shortcut{Name: "go", Link: "https://go.dev"})
+		RequireProviderError(t, err)
+	})
+
+	t.Run("update success", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{GetItem: &capability.Shortcut{ID: 1, Name: "go", Link: "https://go.dev"}})
+		item, err := svc.Update(t.Context(), 1, map[string]any{"link": "https://go.dev/doc"})
+		require.NoError(t, err)
+		require.NotNil(t, item)
+		assert.Equal(t, "https://go.dev/doc", item.Link)
+		assert.Equal(t, "go", item.Name)
+	})
+
+	t.Run("update invalid id", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.Update(t.Context(), 0, map[string]any{"link": "https://go.dev"})
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("update timeout", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		_, err := svc.Update(CanceledContext(), 1, nil)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("update provider error", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{GetItem: &capability.Shortcut{ID: 1, Name: "go", Link: "https://go.dev"}, UpdateErr: assert.AnError})
+		_, err := svc.Update(t.Context(), 1, map[string]any{"title": "Go"})
+		RequireProviderError(t, err)
+	})
+
+	t.Run("delete success", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		require.NoError(t, svc.Delete(t.Context(), 1))
+	})
+
+	t.Run("delete invalid id", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		err := svc.Delete(t.Context(), 0)
+		RequireInvalidArgError(t, err)
+	})
+
+	t.Run("delete timeout", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		err := svc.Delete(CanceledContext(), 1)
+		RequireTimeoutError(t, err)
+	})
+
+	t.Run("delete provider error", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{DeleteErr: assert.AnError})
+		err := svc.Delete(t.Context(), 1)
+		RequireProviderError(t, err)
+	})
+
+	t.Run("health success", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{})
+		ok, err := svc.HealthCheck(t.Context())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("health provider error", func(t *testing.T) {
+		svc := factory(t, ShortcutConfig{ListErr: assert.AnError})
+		ok, err := svc.HealthCheck(t.Context())
+		RequireProviderError(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -2,7 +2,9 @@ package conformance
 
 import (
 	"context"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -782,6 +784,365 @@ func stubReaderFactory(_ *testing.T, cfg ReaderConfig) ReaderService {
 	return &stubReaderService{cfg: cfg}
 }
 
+type stubDNSService struct{ cfg DNSConfig }
+
+func (s *stubDNSService) Status(ctx context.Context) (*capability.DNSStatus, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if s.cfg.StatusErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "status failed", s.cfg.StatusErr)
+	}
+	if s.cfg.Status != nil {
+		return s.cfg.Status, nil
+	}
+	return &capability.DNSStatus{}, nil
+}
+
+func (s *stubDNSService) Stats(ctx context.Context) (*capability.DNSStats, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if s.cfg.StatsErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "stats failed", s.cfg.StatsErr)
+	}
+	return &capability.DNSStats{}, nil
+}
+
+func (s *stubDNSService) QueryLog(ctx context.Context, q *DNSQueryLogQuery) (*capability.ListResult[capability.DNSQuery], error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if q != nil && q.Status == "bogus" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "invalid status")
+	}
+	if s.cfg.QueryLogErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "query log failed", s.cfg.QueryLogErr)
+	}
+	items := s.cfg.QueryLogItems
+	if items == nil {
+		items = []*capability.DNSQuery{}
+	}
+	page := &capability.PageInfo{NextCursor: s.cfg.QueryLogNextCursor, HasMore: s.cfg.QueryLogNextCursor != ""}
+	if q != nil {
+		page.Limit = q.Page.Limit
+	}
+	return &capability.ListResult[capability.DNSQuery]{Items: items, Page: page}, nil
+}
+
+func (s *stubDNSService) SetProtection(ctx context.Context, _ bool, duration time.Duration) error {
+	if err := stubCheckCtx(ctx); err != nil {
+		return err
+	}
+	if duration < 0 {
+		return types.Errorf(types.ErrInvalidArgument, "duration must not be negative")
+	}
+	if s.cfg.ProtectionErr != nil {
+		return types.WrapError(types.ErrProvider, "set protection failed", s.cfg.ProtectionErr)
+	}
+	return nil
+}
+
+func (s *stubDNSService) SetFiltering(ctx context.Context, _ bool) error {
+	if err := stubCheckCtx(ctx); err != nil {
+		return err
+	}
+	if s.cfg.FilteringErr != nil {
+		return types.WrapError(types.ErrProvider, "set filtering failed", s.cfg.FilteringErr)
+	}
+	return nil
+}
+
+func (s *stubDNSService) HealthCheck(ctx context.Context) (bool, error) {
+	if _, err := s.Status(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func stubDNSFactory(_ *testing.T, cfg DNSConfig) DNSService {
+	return &stubDNSService{cfg: cfg}
+}
+
+type stubArchiveService struct{ cfg ArchiveConfig }
+
+func (s *stubArchiveService) Add(ctx context.Context, urls, _ []string) ([]string, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "urls required")
+	}
+	if s.cfg.AddErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "add failed", s.cfg.AddErr)
+	}
+	if len(s.cfg.AddFailure) > 0 {
+		return nil, types.Errorf(types.ErrProvider, "add failed: %v", s.cfg.AddFailure)
+	}
+	return s.cfg.AddedURLs, nil
+}
+
+func (s *stubArchiveService) List(ctx context.Context, q *ArchiveListQuery) (*capability.ListResult[capability.ArchiveItem], error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if q == nil {
+		q = &ArchiveListQuery{}
+	}
+	offset := 0
+	if q.Page.Cursor != "" {
+		n, err := strconv.Atoi(q.Page.Cursor)
+		if err != nil {
+			return nil, types.Errorf(types.ErrInvalidArgument, "invalid cursor")
+		}
+		offset = n
+	}
+	if s.cfg.ListErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "list failed", s.cfg.ListErr)
+	}
+	items := s.cfg.ListItems
+	if items == nil {
+		items = []*capability.ArchiveItem{}
+	}
+	page := &capability.PageInfo{Limit: q.Page.Limit}
+	if next := offset + len(items); next < s.cfg.ListTotal {
+		page.HasMore = true
+		page.NextCursor = strconv.Itoa(next)
+	}
+	return &capability.ListResult[capability.ArchiveItem]{Items: items, Page: page}, nil
+}
+
+func (s *stubArchiveService) Get(ctx context.Context, id string) (*capability.ArchiveItem, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	if s.cfg.GetErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "get failed", s.cfg.GetErr)
+	}
+	if s.cfg.GetItem != nil {
+		return s.cfg.GetItem, nil
+	}
+	return &capability.ArchiveItem{ID: id}, nil
+}
+
+func (s *stubArchiveService) HealthCheck(ctx context.Context) (bool, error) {
+	if _, err := s.List(ctx, &ArchiveListQuery{Page: capability.PageRequest{Limit: 1}}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *stubArchiveService) ListRawEvents(ctx context.Context, _ string) ([]any, string, error) {
+	result, err := s.List(ctx, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	items := make([]any, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, map[string]any{"id": item.ID})
+	}
+	return items, "", nil
+}
+
+func stubArchiveFactory(_ *testing.T, cfg ArchiveConfig) ArchiveService {
+	return &stubArchiveService{cfg: cfg}
+}
+
+type stubAutomationService struct{ cfg AutomationConfig }
+
+func (s *stubAutomationService) ListWorkflows(ctx context.Context) ([]*capability.AutomationWorkflow, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if s.cfg.ListErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "list failed", s.cfg.ListErr)
+	}
+	if s.cfg.Workflows == nil {
+		return []*capability.AutomationWorkflow{}, nil
+	}
+	return s.cfg.Workflows, nil
+}
+
+func (s *stubAutomationService) GetWorkflow(ctx context.Context, id string) (*capability.AutomationWorkflow, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	if s.cfg.GetErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "get failed", s.cfg.GetErr)
+	}
+	if s.cfg.GetItem != nil {
+		return s.cfg.GetItem, nil
+	}
+	return &capability.AutomationWorkflow{ID: id}, nil
+}
+
+func (s *stubAutomationService) SetWorkflowActive(ctx context.Context, id string, _ bool) error {
+	if err := stubCheckCtx(ctx); err != nil {
+		return err
+	}
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	if s.cfg.ActivateErr != nil {
+		return types.WrapError(types.ErrProvider, "activate failed", s.cfg.ActivateErr)
+	}
+	return nil
+}
+
+func (s *stubAutomationService) TriggerWorkflow(ctx context.Context, id string, _ map[string]any) error {
+	if err := stubCheckCtx(ctx); err != nil {
+		return err
+	}
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	if s.cfg.TriggerErr != nil {
+		return types.WrapError(types.ErrProvider, "trigger failed", s.cfg.TriggerErr)
+	}
+	return nil
+}
+
+func (s *stubAutomationService) ListExecutions(ctx context.Context, q *AutomationExecutionQuery) (*capability.ListResult[capability.AutomationExecution], error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if q != nil && q.Status == "bogus" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "invalid status")
+	}
+	if s.cfg.ExecutionErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "list executions failed", s.cfg.ExecutionErr)
+	}
+	items := s.cfg.ExecutionItems
+	if items == nil {
+		items = []*capability.AutomationExecution{}
+	}
+	page := &capability.PageInfo{NextCursor: s.cfg.ExecutionNextCursor, HasMore: s.cfg.ExecutionNextCursor != ""}
+	if q != nil {
+		page.Limit = q.Page.Limit
+	}
+	return &capability.ListResult[capability.AutomationExecution]{Items: items, Page: page}, nil
+}
+
+func (s *stubAutomationService) HealthCheck(ctx context.Context) (bool, error) {
+	if _, err := s.ListWorkflows(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *stubAutomationService) ListRawEvents(ctx context.Context, _ string) ([]any, string, error) {
+	result, err := s.ListExecutions(ctx, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	items := make([]any, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, map[string]any{"id": item.ID})
+	}
+	return items, "", nil
+}
+
+func stubAutomationFactory(_ *testing.T, cfg AutomationConfig) AutomationService {
+	return &stubAutomationService{cfg: cfg}
+}
+
+type stubShortcutService struct{ cfg ShortcutConfig }
+
+func (s *stubShortcutService) List(ctx context.Context) ([]*capability.Shortcut, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if s.cfg.ListErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "list failed", s.cfg.ListErr)
+	}
+	if s.cfg.ListItems == nil {
+		return []*capability.Shortcut{}, nil
+	}
+	return s.cfg.ListItems, nil
+}
+
+func (s *stubShortcutService) Get(ctx context.Context, id int64) (*capability.Shortcut, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if id <= 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	if s.cfg.GetErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "get failed", s.cfg.GetErr)
+	}
+	if s.cfg.GetItem != nil {
+		item := *s.cfg.GetItem
+		return &item, nil
+	}
+	return &capability.Shortcut{ID: id}, nil
+}
+
+func (s *stubShortcutService) Create(ctx context.Context, in *capability.Shortcut) (*capability.Shortcut, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if in == nil || in.Name == "" || in.Link == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "name and link required")
+	}
+	if s.cfg.CreateErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "create failed", s.cfg.CreateErr)
+	}
+	out := *in
+	out.ID = 1
+	return &out, nil
+}
+
+func (s *stubShortcutService) Update(ctx context.Context, id int64, data map[string]any) (*capability.Shortcut, error) {
+	if err := stubCheckCtx(ctx); err != nil {
+		return nil, err
+	}
+	if id <= 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	item, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.cfg.UpdateErr != nil {
+		return nil, types.WrapError(types.ErrProvider, "update failed", s.cfg.UpdateErr)
+	}
+	if link, ok := data["link"].(string); ok {
+		item.Link = link
+	}
+	return item, nil
+}
+
+func (s *stubShortcutService) Delete(ctx context.Context, id int64) error {
+	if err := stubCheckCtx(ctx); err != nil {
+		return err
+	}
+	if id <= 0 {
+		return types.Errorf(types.ErrInvalidArgument, "id required")
+	}
+	if s.cfg.DeleteErr != nil {
+		return types.WrapError(types.ErrProvider, "delete failed", s.cfg.DeleteErr)
+	}
+	return nil
+}
+
+func (s *stubShortcutService) HealthCheck(ctx context.Context) (bool, error) {
+	if _, err := s.List(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func stubShortcutFactory(_ *testing.T, cfg ShortcutConfig) ShortcutService {
+	return &stubShortcutService{cfg: cfg}
+}
+
 func TestRunConformanceSuitesWithStubs(t *testing.T) {
 	tests := []struct {
 		name string
@@ -793,6 +1154,10 @@ func TestRunConformanceSuitesWithStubs(t *testing.T) {
 		{name: "forge suite with stub", run: func(t *testing.T) { RunForgeConformance(t, stubForgeFactory) }},
 		{name: "github suite with stub", run: func(t *testing.T) { RunGithubConformance(t, stubGithubFactory) }},
 		{name: "reader suite with stub", run: func(t *testing.T) { RunReaderConformance(t, stubReaderFactory) }},
+		{name: "dns suite with stub", run: func(t *testing.T) { RunDNSConformance(t, stubDNSFactory) }},
+		{name: "archive suite with stub", run: func(t *testing.T) { RunArchiveConformance(t, stubArchiveFactory) }},
+		{name: "automation suite with stub", run: func(t *testing.T) { RunAutomationConformance(t, stubAutomationFactory) }},
+		{name: "shortcut suite with stub", run: func(t *testing.T) { RunShortcutConformance(t, stubShortcutFactory) }},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,6 +17,8 @@ type RunParams struct {
 	Tools       []string
 	Skills      []string
 	MemoryScope string
+	// PipelineStep is "<pipeline>/<step>" for LLM budget attribution; empty outside pipelines.
+	PipelineStep string
 }
 
 // RunResult holds the outcome of one agent_run invocation.
@@ -76,6 +78,7 @@ func agentRunInvoker(ctx context.Context, params map[string]any) (*capability.In
 		return nil, err
 	}
 	memoryScope, _ := capability.StringParam(params, "memory_scope")
+	pipelineStep, _ := capability.StringParam(params, "pipeline_step")
 
 	r := getRunner()
 	if r == nil {
@@ -83,11 +86,12 @@ func agentRunInvoker(ctx context.Context, params map[string]any) (*capability.In
 	}
 
 	result, err := r.Run(ctx, RunParams{
-		Prompt:      prompt,
-		UID:         uid,
-		Tools:       tools,
-		Skills:      skills,
-		MemoryScope: memoryScope,
+		Prompt:       prompt,
+		UID:          uid,
+		Tools:        tools,
+		Skills:       skills,
+		MemoryScope:  memoryScope,
+		PipelineStep: pipelineStep,
 	})
 	if err != nil {
 		return nil, err
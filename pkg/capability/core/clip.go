@@ -3,8 +3,10 @@ package core
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -42,10 +44,53 @@ description: one or two sentences, under 160 characters, plain text.`
 
 // Persister stores and loads clips.
 type Persister interface {
-	// CreateClip inserts a clip row keyed by slug.
-	CreateClip(ctx context.Context, slug, title, description, content, createdBy string) error
+	// CreateClip inserts a clip row keyed by slug and its version 1 snapshot.
+	CreateClip(ctx context.Context, in CreateInput) error
 	// GetClipBySlug returns a clip by slug, or nil when not found.
 	GetClipBySlug(ctx context.Context, slug string) (*Record, error)
+	// UpdateClip replaces the body of a clip and snapshots the new version.
+	// It returns types.ErrConflict when BaseVersion is stale.
+	UpdateClip(ctx context.Context, in UpdateInput) (*Record, error)
+	// ListClipVersions returns the version snapshots of a clip, newest first.
+	ListClipVersions(ctx context.Context, slug string) ([]Version, error)
+}
+
+// Limits are the optional access rules of a clip. Zero values mean none.
+type Limits struct {
+	// ExpiresAt is when the clip stops being served.
+	ExpiresAt *time.Time
+	// BurnAfterRead deletes the body after the first read.
+	BurnAfterRead bool
+	// MaxViews caps the number of reads; 0 is unlimited.
+	MaxViews int
+	// PasswordHash is a bcrypt hash readers must match.
+	PasswordHash string
+	// LinkSecret signs share links readers must present.
+	LinkSecret string
+}
+
+// CreateInput carries a new clip to the persister.
+type CreateInput struct {
+	Slug        string
+	Title       string
+	Description string
+	Content     string
+	CreatedBy   string
+	Attachments []types.ClipAttachment
+	Limits
+}
+
+// UpdateInput carries an edit to the persister.
+type UpdateInput struct {
+	Slug string
+	// BaseVersion is the version the edit was made against; 0 edits the current one.
+	BaseVersion int
+	Title       string
+	Description string
+	Content     string
+	// Attachments is the full list after the edit.
+	Attachments []types.ClipAttachment
+	EditedBy    string
 }
 
 // Record is a persisted clip returned by get operations.
@@ -56,12 +101,39 @@ type Record struct {
 	Title string
 	// Description is the short preview text.
 	Description string
-	// Content is the markdown body.
+	// Content is the markdown body; empty once burned or purged.
 	Content string
 	// CreatedBy is the optional creator identifier.
 	CreatedBy string
 	// CreatedAt is when the clip was created.
 	CreatedAt time.Time
+	// UpdatedAt is when the clip was last edited; nil before the first edit.
+	UpdatedAt *time.Time
+	// Version counts edits, starting at 1.
+	Version int
+	// Attachments are files stored through the media handler.
+	Attachments []types.ClipAttachment
+	// ViewCount is the number of reads served.
+	ViewCount int
+	// BurnedAt is when a burn-after-read clip was read.
+	BurnedAt *time.Time
+	Limits
+}
+
+// State returns the lifecycle state of the clip at now.
+func (r *Record) State(now time.Time) types.ClipState {
+	return types.ClipStateAt(r.ExpiresAt, r.BurnedAt, r.MaxViews, r.ViewCount, now)
+}
+
+// Version is one snapshot from the edit history of a clip.
+type Version struct {
+	Version     int
+	Title       string
+	Description string
+	Content     string
+	Attachments []types.ClipAttachment
+	CreatedBy   string
+	CreatedAt   time.Time
 }
 
 // Meta holds LLM-generated (or fallback) title and description.
@@ -120,6 +192,15 @@ func clipCreateInvoker(ctx context.Context, params map[string]any) (*capability.
 		return nil, types.Errorf(types.ErrInvalidArgument, "content exceeds %d bytes", MaxContentBytes)
 	}
 	createdBy, _ := capability.StringParam(params, "created_by")
+	now := time.Now().UTC()
+	limits, err := limitsFromParams(params, now)
+	if err != nil {
+		return nil, err
+	}
+	linkTTL, err := durationParam(params, "link_ttl")
+	if err != nil {
+		return nil, err
+	}
 
 	p := getPersister()
 	if p == nil {
@@ -148,68 +229,277 @@ func clipCreateInvoker(ctx context.Context, params map[string]any) (*capability.
 		}
 	}
 
+	attachments, err := storeAttachments(ctx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	var slug string
 	for range slugMaxAttempts {
 		slug, err = newSlug(slugLen)
 		if err != nil {
+			DeleteAttachments(attachments)
 			return nil, types.Errorf(types.ErrInternal, "generate slug: %v", err)
 		}
-		err = p.CreateClip(ctx, slug, meta.Title, meta.Description, content, createdBy)
+		err = p.CreateClip(ctx, CreateInput{
+			Slug:        slug,
+			Title:       meta.Title,
+			Description: meta.Description,
+			Content:     content,
+			CreatedBy:   createdBy,
+			Attachments: attachments,
+			Limits:      limits,
+		})
 		if err == nil {
-			url := "/c/" + slug
+			rec := &Record{
+				Slug:        slug,
+				Title:       meta.Title,
+				Description: meta.Description,
+				CreatedBy:   createdBy,
+				CreatedAt:   now,
+				Version:     1,
+				Attachments: attachments,
+				Limits:      limits,
+			}
+			data := clipData(rec, linkTTL, now)
 			return &capability.InvokeResult{
-				Data: map[string]any{
-					"slug":        slug,
-					"title":       meta.Title,
-					"description": meta.Description,
-					"url":         url,
-				},
-				Text: fmt.Sprintf("clip created: %s", url),
+				Data: data,
+				Text: fmt.Sprintf("clip created: %s", data["url"]),
 			}, nil
 		}
 		if !isUniqueViolation(err) {
+			DeleteAttachments(attachments)
 			return nil, fmt.Errorf("create clip: %w", err)
 		}
 	}
+	DeleteAttachments(attachments)
 	return nil, types.Errorf(types.ErrInternal, "create clip: slug collision after %d attempts", slugMaxAttempts)
 }
 
 func clipGetInvoker(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
-	slug, err := capability.RequiredString(params, "slug")
+	p, row, err := loadClip(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	data := clipData(row, 0, now)
+	data["content"] = row.Content
+	text := fmt.Sprintf("clip %s: %s", row.Slug, row.Title)
+
+	if version, ok := capability.IntParam(params, "version"); ok && version != row.Version {
+		snapshot, err := findVersion(ctx, p, row.Slug, version)
+		if err != nil {
+			return nil, err
+		}
+		data["title"] = snapshot.Title
+		data["description"] = snapshot.Description
+		data["content"] = snapshot.Content
+		data["attachments"] = attachmentsData(snapshot.Attachments)
+		data["version"] = snapshot.Version
+		data["latest_version"] = row.Version
+		text = fmt.Sprintf("clip %s v%d: %s", row.Slug, snapshot.Version, snapshot.Title)
+	}
+	return &capability.InvokeResult{Data: data, Text: text}, nil
+}
+
+func clipUpdateInvoker(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+	content, err := capability.RequiredString(params, "content")
+	if err != nil {
+		return nil, err
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "content is required")
+	}
+	if len(content) > MaxContentBytes {
+		return nil, types.Errorf(types.ErrInvalidArgument, "content exceeds %d bytes", MaxContentBytes)
+	}
+	p, row, err := loadClip(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	switch state := row.State(now); state {
+	case types.ClipBurned, types.ClipExpired:
+		return nil, types.Errorf(types.ErrConflict, "clip %q is %s and can no longer be edited", row.Slug, state)
+	}
+	baseVersion, _ := capability.IntParam(params, "base_version")
+	editedBy, _ := capability.StringParam(params, "edited_by")
+
+	title := row.Title
+	if v, ok := capability.StringParam(params, "title"); ok && strings.TrimSpace(v) != "" {
+		title = sanitizeLine(v, titleMaxLen)
+	}
+	description := row.Description
+	if v, ok := capability.StringParam(params, "description"); ok && strings.TrimSpace(v) != "" {
+		description = sanitizeLine(v, descriptionMaxLen)
+	}
+
+	added, err := storeAttachments(ctx, params, row.Attachments)
 	if err != nil {
 		return nil, err
 	}
+	updated, err := p.UpdateClip(ctx, UpdateInput{
+		Slug:        row.Slug,
+		BaseVersion: baseVersion,
+		Title:       title,
+		Description: description,
+		Content:     content,
+		Attachments: append(slices.Clone(row.Attachments), added...),
+		EditedBy:    editedBy,
+	})
+	if err != nil {
+		DeleteAttachments(added)
+		if errors.Is(err, types.ErrConflict) {
+			if baseVersion == 0 {
+				baseVersion = row.Version
+			}
+			return nil, types.Errorf(types.ErrConflict, "clip %q changed since version %d; reload it and retry", row.Slug, baseVersion)
+		}
+		return nil, fmt.Errorf("update clip: %w", err)
+	}
+	data := clipData(updated, 0, now)
+	return &capability.InvokeResult{
+		Data: data,
+		Text: fmt.Sprintf("clip %s updated to v%d", updated.Slug, updated.Version),
+	}, nil
+}
+
+func clipHistoryInvoker(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+	p, row, err := loadClip(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := p.ListClipVersions(ctx, row.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("list clip versions: %w", err)
+	}
+	items := make([]map[string]any, 0, len(versions))
+	for _, v := range versions {
+		items = append(items, map[string]any{
+			"version":     v.Version,
+			"title":       v.Title,
+			"created_by":  v.CreatedBy,
+			"created_at":  v.CreatedAt.UTC().Format(time.RFC3339),
+			"words":       WordCount(v.Content),
+			"attachments": len(v.Attachments),
+		})
+	}
+	return &capability.InvokeResult{
+		Data: map[string]any{"slug": row.Slug, "version": row.Version, "versions": items},
+		Text: fmt.Sprintf("clip %s: %d version(s)", row.Slug, len(items)),
+	}, nil
+}
+
+func clipSignInvoker(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+	_, row, err := loadClip(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if row.LinkSecret == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "clip %q does not require a signed link; share /c/%s", row.Slug, row.Slug)
+	}
+	ttl, err := durationParam(params, "link_ttl")
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	exp := linkExpiry(row, ttl, now)
+	url := SignedLink(row.Slug, row.LinkSecret, exp)
+	return &capability.InvokeResult{
+		Data: map[string]any{
+			"slug":            row.Slug,
+			"url":             url,
+			"link_expires_at": exp.Format(time.RFC3339),
+		},
+		Text: fmt.Sprintf("signed link: %s", url),
+	}, nil
+}
+
+// loadClip resolves the slug param to a stored clip.
+func loadClip(ctx context.Context, params map[string]any) (Persister, *Record, error) {
+	slug, err := capability.RequiredString(params, "slug")
+	if err != nil {
+		return nil, nil, err
+	}
 	slug = strings.TrimSpace(slug)
 	if slug == "" {
-		return nil, types.Errorf(types.ErrInvalidArgument, "slug is required")
+		return nil, nil, types.Errorf(types.ErrInvalidArgument, "slug is required")
 	}
 
 	p := getPersister()
 	if p == nil {
-		return nil, types.Errorf(types.ErrUnavailable, "clip persister is not configured")
+		return nil, nil, types.Errorf(types.ErrUnavailable, "clip persister is not configured")
 	}
 
 	row, err := p.GetClipBySlug(ctx, slug)
 	if err != nil {
-		return nil, fmt.Errorf("get clip: %w", err)
+		return nil, nil, fmt.Errorf("get clip: %w", err)
 	}
 	if row == nil {
-		return nil, types.Errorf(types.ErrNotFound, "clip %q not found", slug)
+		return nil, nil, types.Errorf(types.ErrNotFound, "clip %q not found", slug)
 	}
+	return p, row, nil
+}
 
-	url := "/c/" + row.Slug
-	return &capability.InvokeResult{
-		Data: map[string]any{
-			"slug":        row.Slug,
-			"title":       row.Title,
-			"description": row.Description,
-			"content":     row.Content,
-			"created_by":  row.CreatedBy,
-			"created_at":  row.CreatedAt.UTC().Format(time.RFC3339),
-			"url":         url,
-		},
-		Text: fmt.Sprintf("clip %s: %s", row.Slug, row.Title),
-	}, nil
+func findVersion(ctx context.Context, p Persister, slug string, version int) (*Version, error) {
+	versions, err := p.ListClipVersions(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("list clip versions: %w", err)
+	}
+	for i := range versions {
+		if versions[i].Version == version {
+			return &versions[i], nil
+		}
+	}
+	return nil, types.Errorf(types.ErrNotFound, "clip %q has no version %d", slug, version)
+}
+
+// clipData is the result payload shared by the clip ops. The url carries a
+// fresh signature when the clip requires a signed link.
+func clipData(rec *Record, linkTTL time.Duration, now time.Time) map[string]any {
+	url := "/c/" + rec.Slug
+	data := map[string]any{
+		"slug":               rec.Slug,
+		"title":              rec.Title,
+		"description":        rec.Description,
+		"created_by":         rec.CreatedBy,
+		"created_at":         rec.CreatedAt.UTC().Format(time.RFC3339),
+		"version":            rec.Version,
+		"state":              string(rec.State(now)),
+		"views":              rec.ViewCount,
+		"max_views":          rec.MaxViews,
+		"burn_after_read":    rec.BurnAfterRead,
+		"password_protected": rec.PasswordHash != "",
+		"signed_link":        rec.LinkSecret != "",
+		"attachments":        attachmentsData(rec.Attachments),
+	}
+	if rec.ExpiresAt != nil {
+		data["expires_at"] = rec.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	if rec.UpdatedAt != nil {
+		data["updated_at"] = rec.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	if rec.LinkSecret != "" {
+		exp := linkExpiry(rec, linkTTL, now)
+		url = SignedLink(rec.Slug, rec.LinkSecret, exp)
+		data["link_expires_at"] = exp.Format(time.RFC3339)
+	}
+	data["url"] = url
+	return data
+}
+
+func attachmentsData(list []types.ClipAttachment) []map[string]any {
+	out := make([]map[string]any, 0, len(list))
+	for _, a := range list {
+		out = append(out, map[string]any{
+			"name":      a.Name,
+			"file_id":   a.FileID,
+			"mime_type": a.MimeType,
+			"size":      a.Size,
+		})
+	}
+	return out
 }
 
 func clipHealthInvoker(_ context.Context, _ map[string]any) (*capability.InvokeResult, error) {
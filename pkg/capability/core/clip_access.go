@@ -0,0 +1,140 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/webauth"
+)
+
+const (
+	// DefaultLinkTTL is the lifetime of a signed share link for a clip without expiry.
+	DefaultLinkTTL = 7 * 24 * time.Hour
+	// MinPasswordLen is the shortest accepted clip password.
+	MinPasswordLen = 4
+
+	linkSecretBytes = 32
+)
+
+// limitsFromParams reads the optional access rules of clip_create.
+func limitsFromParams(params map[string]any, now time.Time) (Limits, error) {
+	var limits Limits
+
+	expiresIn, err := durationParam(params, "expires_in")
+	if err != nil {
+		return Limits{}, err
+	}
+	if raw, ok := capability.StringParam(params, "expires_at"); ok && strings.TrimSpace(raw) != "" {
+		if expiresIn > 0 {
+			return Limits{}, types.Errorf(types.ErrInvalidArgument, "set either expires_in or expires_at, not both")
+		}
+		at, err := time.Parse(time.RFC3339, strings.TrimSpace(raw))
+		if err != nil {
+			return Limits{}, types.Errorf(types.ErrInvalidArgument, "expires_at must be RFC3339 (e.g. 2026-01-02T15:04:05Z): %v", err)
+		}
+		if !at.After(now) {
+			return Limits{}, types.Errorf(types.ErrInvalidArgument, "expires_at must be in the future")
+		}
+		at = at.UTC()
+		limits.ExpiresAt = &at
+	}
+	if expiresIn > 0 {
+		at := now.Add(expiresIn)
+		limits.ExpiresAt = &at
+	}
+
+	limits.BurnAfterRead, _ = capability.BoolParam(params, "burn_after_read")
+	if v, ok := capability.IntParam(params, "max_views"); ok {
+		if v < 0 {
+			return Limits{}, types.Errorf(types.ErrInvalidArgument, "max_views must be 0 (unlimited) or more")
+		}
+		limits.MaxViews = v
+	}
+	if limits.BurnAfterRead {
+		if limits.MaxViews > 1 {
+			return Limits{}, types.Errorf(types.ErrInvalidArgument, "burn_after_read allows a single view; drop max_views")
+		}
+		// One view keeps the conditional view update from serving two racing readers.
+		limits.MaxViews = 1
+	}
+
+	if password, ok := capability.StringParam(params, "password"); ok && password != "" {
+		if len([]rune(password)) < MinPasswordLen {
+			return Limits{}, types.Errorf(types.ErrInvalidArgument, "password must be at least %d characters", MinPasswordLen)
+		}
+		hash, err := webauth.HashPassword(password)
+		if err != nil {
+			return Limits{}, types.Errorf(types.ErrInternal, "hash clip password: %v", err)
+		}
+		limits.PasswordHash = hash
+	}
+
+	if signed, _ := capability.BoolParam(params, "signed_link"); signed {
+		secret, err := newLinkSecret()
+		if err != nil {
+			return Limits{}, types.Errorf(types.ErrInternal, "generate link secret: %v", err)
+		}
+		limits.LinkSecret = secret
+	}
+	return limits, nil
+}
+
+// durationParam parses an optional Go duration string such as "24h" or "90m".
+func durationParam(params map[string]any, key string) (time.Duration, error) {
+	raw, ok := capability.StringParam(params, key)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(raw))
+	if err != nil || d <= 0 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "%s must be a positive duration such as 24h or 90m", key)
+	}
+	return d, nil
+}
+
+func newLinkSecret() (string, error) {
+	buf := make([]byte, linkSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// linkExpiry picks the expiry of a signed share link: ttl from now when set,
+// else DefaultLinkTTL, capped at the clip expiry so a link never outlives it.
+func linkExpiry(rec *Record, ttl time.Duration, now time.Time) time.Time {
+	if ttl <= 0 {
+		ttl = DefaultLinkTTL
+	}
+	exp := now.Add(ttl).UTC()
+	if rec.ExpiresAt != nil && rec.ExpiresAt.Before(exp) {
+		return rec.ExpiresAt.UTC()
+	}
+	return exp
+}
+
+// SignedLink returns the share path /c/{slug}?exp=...&sig=... signed with the
+// clip link secret, using the same HMAC scheme as signed media URLs.
+func SignedLink(slug, secret string, exp time.Time) string {
+	unix := exp.Unix()
+	q := url.Values{}
+	q.Set("exp", strconv.FormatInt(unix, 10))
+	q.Set("sig", media.SignFile(secret, slug, unix))
+	return "/c/" + slug + "?" + q.Encode()
+}
+
+// VerifyLink checks the exp and sig query values of a share link.
+func VerifyLink(slug, secret, expRaw, sig string, now time.Time) error {
+	if expRaw == "" || sig == "" {
+		return fmt.Errorf("clip: signed link required")
+	}
+	return media.VerifySignedRequest(secret, slug, expRaw, sig, now)
+}
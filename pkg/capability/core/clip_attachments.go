@@ -0,0 +1,217 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	// AttachmentURLTTL is the lifetime of signed attachment links shown to a clip reader.
+	AttachmentURLTTL = 15 * time.Minute
+	// MaxAttachments caps the files on one clip across all of its versions.
+	MaxAttachments = 10
+
+	attachmentOwner    = "system:clip"
+	attachmentLocation = "clip-attachments"
+)
+
+var (
+	mediaMu      sync.RWMutex
+	mediaHandler media.Handler
+)
+
+// SetMedia sets the media handler used to store clip attachments.
+// Without it the active media.FileSystem handler is used.
+func SetMedia(h media.Handler) {
+	mediaMu.Lock()
+	defer mediaMu.Unlock()
+	mediaHandler = h
+}
+
+func getMedia() media.Handler {
+	mediaMu.RLock()
+	defer mediaMu.RUnlock()
+	if mediaHandler != nil {
+		return mediaHandler
+	}
+	return media.FileSystem
+}
+
+// storeAttachments uploads the attachments param through the media handler and
+// returns the stored files. Each item needs a name and one of content (text),
+// content_base64, or file_id (an existing media file, copied so the clip owns
+// its bytes). existing lists the files already on the clip; edits only add.
+func storeAttachments(ctx context.Context, params map[string]any, existing []types.ClipAttachment) ([]types.ClipAttachment, error) {
+	items, err := attachmentItems(params["attachments"])
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	if len(existing)+len(items) > MaxAttachments {
+		return nil, types.Errorf(types.ErrInvalidArgument, "a clip holds at most %d attachments", MaxAttachments)
+	}
+	h := getMedia()
+	if h == nil {
+		return nil, types.Errorf(types.ErrUnavailable, "attachments need a configured media handler")
+	}
+	names := make(map[string]struct{}, len(existing)+len(items))
+	for _, a := range existing {
+		names[a.Name] = struct{}{}
+	}
+
+	stored := make([]types.ClipAttachment, 0, len(items))
+	fail := func(err error) ([]types.ClipAttachment, error) {
+		DeleteAttachments(stored)
+		return nil, err
+	}
+	for i, item := range items {
+		name, data, mimeType, err := attachmentBytes(ctx, h, item)
+		if err != nil {
+			return fail(types.Errorf(types.ErrInvalidArgument, "attachments[%d]: %v", i, err))
+		}
+		if _, dup := names[name]; dup {
+			return fail(types.Errorf(types.ErrInvalidArgument, "attachments[%d]: %q is already attached", i, name))
+		}
+		names[name] = struct{}{}
+		fdef := &types.FileDef{
+			ObjHeader: types.ObjHeader{Id: types.Id()},
+			Name:      name,
+			User:      attachmentOwner,
+			MimeType:  mimeType,
+			Size:      int64(len(data)),
+			Location:  attachmentLocation,
+		}
+		if _, _, err := h.Upload(fdef, bytes.NewReader(data)); err != nil {
+			return fail(fmt.Errorf("store attachment %s: %w", name, err))
+		}
+		stored = append(stored, types.ClipAttachment{
+			Name:     name,
+			FileID:   fdef.Id,
+			MimeType: fdef.MimeType,
+			Size:     fdef.Size,
+			Location: fdef.Location,
+		})
+	}
+	return stored, nil
+}
+
+func attachmentItems(raw any) ([]map[string]any, error) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, nil
+	case []map[string]any:
+		return v, nil
+	case []any:
+		out := make([]map[string]any, 0, len(v))
+		for i, item := range v {
+			m, ok := item.(map[string]any)
+			if !ok {
+				return nil, types.Errorf(types.ErrInvalidArgument, "attachments[%d] must be an object", i)
+			}
+			out = append(out, m)
+		}
+		return out, nil
+	default:
+		return nil, types.Errorf(types.ErrInvalidArgument, "attachments must be a list of objects")
+	}
+}
+
+// attachmentBytes resolves one attachments item to its name, bytes and MIME type.
+func attachmentBytes(ctx context.Context, h media.Handler, item map[string]any) (string, []byte, string, error) {
+	name := attachmentString(item, "name")
+	mimeType := attachmentString(item, "mime_type")
+	var data []byte
+	sources := 0
+	if v, ok := item["content"].(string); ok {
+		data = []byte(v)
+		sources++
+	}
+	if v := attachmentString(item, "content_base64"); v != "" {
+		decoded, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return "", nil, "", fmt.Errorf("content_base64 is not valid base64")
+		}
+		data = decoded
+		sources++
+	}
+	if id := attachmentString(item, "file_id"); id != "" {
+		accessor, ok := media.AsAccessor(h)
+		if !ok || accessor == nil {
+			return "", nil, "", fmt.Errorf("media handler cannot open files by id")
+		}
+		fd, content, err := media.ReadAll(ctx, accessor, id)
+		if err != nil {
+			return "", nil, "", err
+		}
+		if name == "" && fd != nil {
+			name = fd.Name
+		}
+		if mimeType == "" && fd != nil {
+			mimeType = fd.MimeType
+		}
+		data = content
+		sources++
+	}
+	if sources != 1 {
+		return "", nil, "", fmt.Errorf("set exactly one of content, content_base64 or file_id")
+	}
+	name = path.Base(strings.TrimSpace(name))
+	if name == "" || name == "." || name == "/" || name == ".." {
+		return "", nil, "", fmt.Errorf("name is required")
+	}
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(path.Ext(name))
+	}
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	return name, data, mimeType, nil
+}
+
+func attachmentString(item map[string]any, key string) string {
+	v, _ := item[key].(string)
+	return strings.TrimSpace(v)
+}
+
+// AttachmentURL signs a download link for a, valid for AttachmentURLTTL.
+func AttachmentURL(ctx context.Context, a types.ClipAttachment) (string, error) {
+	accessor, ok := media.AsAccessor(getMedia())
+	if !ok || accessor == nil {
+		return "", types.Errorf(types.ErrUnavailable, "media handler cannot sign download links")
+	}
+	return accessor.SignGetURL(ctx, a.FileID, AttachmentURLTTL)
+}
+
+// DeleteAttachments removes stored attachment files. Failures are logged:
+// the clip row no longer references them either way.
+func DeleteAttachments(list []types.ClipAttachment) {
+	if len(list) == 0 {
+		return
+	}
+	h := getMedia()
+	if h == nil {
+		return
+	}
+	locations := make([]string, 0, len(list))
+	for _, a := range list {
+		if a.Location != "" {
+			locations = append(locations, a.Location)
+		}
+	}
+	if len(locations) == 0 {
+		return
+	}
+	if err := h.Delete(locations); err != nil {
+		flog.Warn("clip: delete attachment files: %v", err)
+	}
+}
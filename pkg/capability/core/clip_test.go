@@ -0,0 +1,370 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/capability/core"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/webauth"
+)
+
+// memClips is an in-memory core.Persister that keeps version snapshots.
+type memClips struct {
+	mu       sync.Mutex
+	bySlug   map[string]*core.Record
+	versions map[string][]core.Version
+}
+
+func (m *memClips) CreateClip(_ context.Context, in core.CreateInput) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.bySlug[in.Slug]; ok {
+		return types.ErrAlreadyExists
+	}
+	now := time.Now().UTC()
+	m.bySlug[in.Slug] = &core.Record{
+		Slug: in.Slug, Title: in.Title, Description: in.Description, Content: in.Content,
+		CreatedBy: in.CreatedBy, CreatedAt: now, Version: 1, Attachments: in.Attachments, Limits: in.Limits,
+	}
+	m.versions[in.Slug] = []core.Version{{
+		Version: 1, Title: in.Title, Description: in.Description, Content: in.Content,
+		Attachments: in.Attachments, CreatedBy: in.CreatedBy, CreatedAt: now,
+	}}
+	return nil
+}
+
+func (m *memClips) GetClipBySlug(_ context.Context, slug string) (*core.Record, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.bySlug[slug]
+	if !ok {
+		return nil, nil
+	}
+	out := *rec
+	return &out, nil
+}
+
+func (m *memClips) UpdateClip(_ context.Context, in core.UpdateInput) (*core.Record, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.bySlug[in.Slug]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	if in.BaseVersion > 0 && in.BaseVersion != rec.Version {
+		return nil, types.ErrConflict
+	}
+	now := time.Now().UTC()
+	rec.Version++
+	rec.Title, rec.Description, rec.Content = in.Title, in.Description, in.Content
+	rec.Attachments = in.Attachments
+	rec.UpdatedAt = &now
+	m.versions[in.Slug] = append([]core.Version{{
+		Version: rec.Version, Title: in.Title, Description: in.Description, Content: in.Content,
+		Attachments: in.Attachments, CreatedBy: in.EditedBy, CreatedAt: now,
+	}}, m.versions[in.Slug]...)
+	out := *rec
+	return &out, nil
+}
+
+func (m *memClips) ListClipVersions(_ context.Context, slug string) ([]core.Version, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.versions[slug], nil
+}
+
+// clipMedia is an in-memory media.Accessor for attachment tests.
+type clipMedia struct {
+	mu      sync.Mutex
+	files   map[string]*types.FileDef
+	data    map[string]string
+	deleted []string
+}
+
+func (*clipMedia) Init(string) error { return nil }
+
+func (*clipMedia) Headers(*http.Request, bool) (http.Header, int, error) { return nil, 0, nil }
+
+func (m *clipMedia) Upload(fdef *types.FileDef, file io.ReadSeeker) (string, int64, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", 0, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fdef.Location = "mem/" + fdef.Id
+	m.files[fdef.Id] = fdef
+	m.data[fdef.Id] = string(data)
+	return "/files/" + fdef.Id, int64(len(data)), nil
+}
+
+func (*clipMedia) Download(string) (*types.FileDef, media.ReadSeekCloser, error) {
+	return nil, nil, fmt.Errorf("not implemented")
+}
+
+func (m *clipMedia) Delete(locations []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleted = append(m.deleted, locations...)
+	return nil
+}
+
+func (*clipMedia) GetIdFromUrl(string) types.Uid { return "" }
+
+func (*clipMedia) SignGetURL(_ context.Context, fileID string, _ time.Duration) (string, error) {
+	return "https://files.example/" + fileID + "?sig=x", nil
+}
+
+type clipNopCloser struct{ *strings.Reader }
+
+func (clipNopCloser) Close() error { return nil }
+
+func (m *clipMedia) OpenByID(_ context.Context, fileID string) (*types.FileDef, media.ReadSeekCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fd, ok := m.files[fileID]
+	if !ok {
+		return nil, nil, types.Errorf(types.ErrNotFound, "file %s", fileID)
+	}
+	return fd, clipNopCloser{strings.NewReader(m.data[fileID])}, nil
+}
+
+func setupClips(t *testing.T) (*memClips, *clipMedia) {
+	t.Helper()
+	resetCore(t)
+	clips := &memClips{bySlug: map[string]*core.Record{}, versions: map[string][]core.Version{}}
+	files := &clipMedia{files: map[string]*types.FileDef{}, data: map[string]string{}}
+	core.SetPersister(clips)
+	core.SetMedia(files)
+	prevModel := config.App.ChatAgent.ChatModel
+	config.App.ChatAgent.ChatModel = ""
+	t.Cleanup(func() {
+		core.SetPersister(nil)
+		core.SetMedia(nil)
+		config.App.ChatAgent.ChatModel = prevModel
+	})
+	return clips, files
+}
+
+func invokeClip(t *testing.T, op string, params map[string]any) (map[string]any, error) {
+	t.Helper()
+	res, err := capability.Invoke(context.Background(), hub.CapCore, op, params)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := res.Data.(map[string]any)
+	require.True(t, ok)
+	return data, nil
+}
+
+func TestClipCreateLimits(t *testing.T) {
+	clips, _ := setupClips(t)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name    string
+		params  map[string]any
+		wantErr string
+		check   func(t *testing.T, data map[string]any, rec *core.Record)
+	}{
+		{
+			name:   "burn after read allows one view",
+			params: map[string]any{"burn_after_read": true},
+			check: func(t *testing.T, data map[string]any, rec *core.Record) {
+				assert.Equal(t, 1, rec.MaxViews)
+				assert.True(t, rec.BurnAfterRead)
+				assert.Equal(t, "active", data["state"])
+			},
+		},
+		{
+			name:   "password is stored hashed",
+			params: map[string]any{"password": "open sesame"},
+			check: func(t *testing.T, data map[string]any, rec *core.Record) {
+				assert.Equal(t, true, data["password_protected"])
+				assert.NotEqual(t, "open sesame", rec.PasswordHash)
+				assert.True(t, webauth.CheckPassword(rec.PasswordHash, "open sesame"))
+			},
+		},
+		{
+			name:   "signed link returns a verifiable url",
+			params: map[string]any{"signed_link": true, "link_ttl": "1h"},
+			check: func(t *testing.T, data map[string]any, rec *core.Record) {
+				require.NotEmpty(t, rec.LinkSecret)
+				raw, ok := data["url"].(string)
+				require.True(t, ok)
+				u, err := url.Parse(raw)
+				require.NoError(t, err)
+				assert.Equal(t, "/c/"+rec.Slug, u.Path)
+				exp, sig := u.Query().Get("exp"), u.Query().Get("sig")
+				assert.NoError(t, core.VerifyLink(rec.Slug, rec.LinkSecret, exp, sig, time.Now()))
+				assert.Error(t, core.VerifyLink(rec.Slug, rec.LinkSecret, exp, sig+"0", time.Now()))
+				assert.Error(t, core.VerifyLink("other", rec.LinkSecret, exp, sig, time.Now()))
+			},
+		},
+		{
+			name:   "expires in sets expiry",
+			params: map[string]any{"expires_in": "30m", "max_views": 3},
+			check: func(t *testing.T, data map[string]any, rec *core.Record) {
+				require.NotNil(t, rec.ExpiresAt)
+				assert.WithinDuration(t, time.Now().Add(30*time.Minute), *rec.ExpiresAt, time.Minute)
+				assert.Equal(t, 3, data["max_views"])
+			},
+		},
+		{name: "both expiry forms", params: map[string]any{"expires_in": "1h", "expires_at": future}, wantErr: "not both"},
+		{name: "expiry in the past", params: map[string]any{"expires_at": past}, wantErr: "future"},
+		{name: "negative lifetime", params: map[string]any{"expires_in": "-1h"}, wantErr: "positive"},
+		{name: "burn with a view limit", params: map[string]any{"burn_after_read": true, "max_views": 3}, wantErr: "burn_after_read"},
+		{name: "negative view limit", params: map[string]any{"max_views": -1}, wantErr: "max_views"},
+		{name: "short password", params: map[string]any{"password": "abc"}, wantErr: "password"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]any{"content": "# Note\n\nbody for " + tt.name}
+			for k, v := range tt.params {
+				params[k] = v
+			}
+			data, err := invokeClip(t, core.OpClipCreate, params)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, types.ErrInvalidArgument)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			rec, err := clips.GetClipBySlug(context.Background(), data["slug"].(string))
+			require.NoError(t, err)
+			require.NotNil(t, rec)
+			tt.check(t, data, rec)
+		})
+	}
+}
+
+func TestClipUpdateHistoryAndVersions(t *testing.T) {
+	setupClips(t)
+	created, err := invokeClip(t, core.OpClipCreate, map[string]any{"content": "first body"})
+	require.NoError(t, err)
+	slug := created["slug"].(string)
+
+	updated, err := invokeClip(t, core.OpClipUpdate, map[string]any{
+		"slug": slug, "content": "second body", "base_version": 1, "edited_by": "bob",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated["version"])
+	assert.NotEmpty(t, updated["updated_at"])
+
+	_, err = invokeClip(t, core.OpClipUpdate, map[string]any{"slug": slug, "content": "stale edit", "base_version": 1})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, types.ErrConflict)
+	assert.Contains(t, err.Error(), "changed since version 1")
+
+	history, err := invokeClip(t, core.OpClipHistory, map[string]any{"slug": slug})
+	require.NoError(t, err)
+	versions, ok := history["versions"].([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0]["version"])
+	assert.Equal(t, "bob", versions[0]["created_by"])
+
+	current, err := invokeClip(t, core.OpClipGet, map[string]any{"slug": slug})
+	require.NoError(t, err)
+	assert.Equal(t, "second body", current["content"])
+
+	old, err := invokeClip(t, core.OpClipGet, map[string]any{"slug": slug, "version": 1})
+	require.NoError(t, err)
+	assert.Equal(t, "first body", old["content"])
+	assert.Equal(t, 2, old["latest_version"])
+
+	_, err = invokeClip(t, core.OpClipGet, map[string]any{"slug": slug, "version": 9})
+	assert.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestClipAttachments(t *testing.T) {
+	_, files := setupClips(t)
+	files.files["f-existing"] = &types.FileDef{ObjHeader: types.ObjHeader{Id: "f-existing"}, Name: "report.pdf", MimeType: "application/pdf"}
+	files.data["f-existing"] = "%PDF"
+
+	created, err := invokeClip(t, core.OpClipCreate, map[string]any{
+		"content": "see attached",
+		"attachments": []any{
+			map[string]any{"name": "notes.txt", "content": "hello"},
+			map[string]any{"file_id": "f-existing"},
+		},
+	})
+	require.NoError(t, err)
+	list, ok := created["attachments"].([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, list, 2)
+	assert.Equal(t, "notes.txt", list[0]["name"])
+	assert.Equal(t, int64(5), list[0]["size"])
+	assert.Equal(t, "report.pdf", list[1]["name"])
+	assert.Equal(t, "application/pdf", list[1]["mime_type"])
+
+	slug := created["slug"].(string)
+	updated, err := invokeClip(t, core.OpClipUpdate, map[string]any{
+		"slug": slug, "content": "see attached v2",
+		"attachments": []any{map[string]any{"name": "data.bin", "content_base64": "AAEC"}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, updated["attachments"], 3)
+
+	tests := []struct {
+		name    string
+		item    map[string]any
+		wantErr string
+	}{
+		{name: "duplicate name", item: map[string]any{"name": "notes.txt", "content": "again"}, wantErr: "already attached"},
+		{name: "two sources", item: map[string]any{"name": "x.txt", "content": "a", "file_id": "f-existing"}, wantErr: "exactly one"},
+		{name: "bad base64", item: map[string]any{"name": "x.bin", "content_base64": "!!"}, wantErr: "base64"},
+		{name: "missing name", item: map[string]any{"content": "a"}, wantErr: "name is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := invokeClip(t, core.OpClipUpdate, map[string]any{
+				"slug": slug, "content": "edit", "attachments": []any{tt.item},
+			})
+			require.Error(t, err)
+			assert.ErrorIs(t, err, types.ErrInvalidArgument)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	u, err := core.AttachmentURL(context.Background(), types.ClipAttachment{FileID: "f-existing"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://files.example/f-existing?sig=x", u)
+
+	core.DeleteAttachments([]types.ClipAttachment{{Location: "mem/a"}, {Location: ""}})
+	assert.Equal(t, []string{"mem/a"}, files.deleted)
+}
+
+func TestClipSign(t *testing.T) {
+	setupClips(t)
+	plain, err := invokeClip(t, core.OpClipCreate, map[string]any{"content": "plain"})
+	require.NoError(t, err)
+	_, err = invokeClip(t, core.OpClipSign, map[string]any{"slug": plain["slug"]})
+	assert.ErrorIs(t, err, types.ErrInvalidArgument)
+
+	signed, err := invokeClip(t, core.OpClipCreate, map[string]any{"content": "signed", "signed_link": true, "expires_in": "2h"})
+	require.NoError(t, err)
+	data, err := invokeClip(t, core.OpClipSign, map[string]any{"slug": signed["slug"], "link_ttl": "24h"})
+	require.NoError(t, err)
+	exp, err := time.Parse(time.RFC3339, data["link_expires_at"].(string))
+	require.NoError(t, err)
+	// The link never outlives the clip.
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), exp, time.Minute)
+}
@@ -6,9 +6,12 @@ const (
 	OpNotifySend   = "notify_send"
 	OpNotifyHealth = "notify_health"
 
-	OpClipCreate = "clip_create"
-	OpClipGet    = "clip_get"
-	OpClipHealth = "clip_health"
+	OpClipCreate  = "clip_create"
+	OpClipGet     = "clip_get"
+	OpClipUpdate  = "clip_update"
+	OpClipHistory = "clip_history"
+	OpClipSign    = "clip_sign"
+	OpClipHealth  = "clip_health"
 
 	OpAgentRun    = "agent_run"
 	OpAgentHealth = "agent_health"
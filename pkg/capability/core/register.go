@@ -40,14 +40,51 @@ func buildSpec() capability.Spec {
 				Input: []hub.ParamDef{
 					{Name: "content", Type: "string", Required: true, Description: "Markdown body"},
 					{Name: "created_by", Type: "string", Required: false, Description: "Optional creator identifier"},
+					{Name: "expires_in", Type: "string", Required: false, Description: "Lifetime as a duration (e.g. 24h); the clip is no longer served afterwards"},
+					{Name: "expires_at", Type: "string", Required: false, Description: "Expiry as RFC3339; alternative to expires_in"},
+					{Name: "burn_after_read", Type: "bool", Required: false, Description: "Delete the body after the first read"},
+					{Name: "max_views", Type: "int", Required: false, Description: "Maximum number of reads (0 = unlimited)"},
+					{Name: "password", Type: "string", Required: false, Description: "Password readers must enter"},
+					{Name: "signed_link", Type: "bool", Required: false, Description: "Require a signed share link; the returned url is signed"},
+					{Name: "link_ttl", Type: "string", Required: false, Description: "Signed link lifetime (default 7 days, capped at the clip expiry)"},
+					{Name: "attachments", Type: "array", Required: false, Description: "Files to attach: name plus one of content, content_base64 or file_id"},
 				},
 				Handler: clipCreateInvoker,
 			},
 			{
-				Name: OpClipGet, Description: "Get a markdown clip by slug",
-				Input:   []hub.ParamDef{{Name: "slug", Type: "string", Required: true, Description: "Clip slug"}},
+				Name: OpClipGet, Description: "Get a markdown clip by slug with its state and views",
+				Input: []hub.ParamDef{
+					{Name: "slug", Type: "string", Required: true, Description: "Clip slug"},
+					{Name: "version", Type: "int", Required: false, Description: "Earlier version to read (default: latest)"},
+				},
 				Handler: clipGetInvoker,
 			},
+			{
+				Name: OpClipUpdate, Description: "Edit a clip; the previous body stays in its version history", Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "slug", Type: "string", Required: true, Description: "Clip slug"},
+					{Name: "content", Type: "string", Required: true, Description: "New markdown body"},
+					{Name: "title", Type: "string", Required: false, Description: "New title (default: keep)"},
+					{Name: "description", Type: "string", Required: false, Description: "New description (default: keep)"},
+					{Name: "base_version", Type: "int", Required: false, Description: "Version the edit is based on; a newer stored version fails with conflict"},
+					{Name: "attachments", Type: "array", Required: false, Description: "Files to add: name plus one of content, content_base64 or file_id"},
+					{Name: "edited_by", Type: "string", Required: false, Description: "Optional editor identifier"},
+				},
+				Handler: clipUpdateInvoker,
+			},
+			{
+				Name: OpClipHistory, Description: "List the version history of a clip",
+				Input:   []hub.ParamDef{{Name: "slug", Type: "string", Required: true, Description: "Clip slug"}},
+				Handler: clipHistoryInvoker,
+			},
+			{
+				Name: OpClipSign, Description: "Mint a signed share link for a clip that requires one",
+				Input: []hub.ParamDef{
+					{Name: "slug", Type: "string", Required: true, Description: "Clip slug"},
+					{Name: "link_ttl", Type: "string", Required: false, Description: "Link lifetime (default 7 days, capped at the clip expiry)"},
+				},
+				Handler: clipSignInvoker,
+			},
 			{Name: OpClipHealth, Description: "Clip subsystem health", Handler: clipHealthInvoker},
 			{
 				Name: OpAgentRun, Description: "Execute one autonomous agent turn with a prompt", Mutation: true,
@@ -57,6 +94,7 @@ func buildSpec() capability.Spec {
 					{Name: "tools", Type: "[]string", Required: false, Description: "Tool allowlist"},
 					{Name: "skills", Type: "[]string", Required: false, Description: "Skill allowlist"},
 					{Name: "memory_scope", Type: "string", Required: false, Description: "Memory scope; defaults to pipeline name"},
+					{Name: "pipeline_step", Type: "string", Required: false, Description: "LLM budget attribution; the pipeline engine sets pipeline-name/step-name"},
 				},
 				Handler: agentRunInvoker,
 			},
@@ -0,0 +1,42 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// LogHandler receives streamed output chunks, in seq order, while run waits for a job.
+type LogHandler func(chunk types.GatewayLogChunk)
+
+type logHandlerKey struct{}
+
+// WithLogHandler returns a context whose run invocations forward new job log
+// chunks to fn. Chat tools use it to surface progress before the job ends.
+func WithLogHandler(ctx context.Context, fn LogHandler) context.Context {
+	return context.WithValue(ctx, logHandlerKey{}, fn)
+}
+
+func logHandlerFrom(ctx context.Context) LogHandler {
+	fn, _ := ctx.Value(logHandlerKey{}).(LogHandler)
+	return fn
+}
+
+// forwardLogs pages chunks newer than *lastSeq up to upTo into fn and advances *lastSeq.
+func forwardLogs(ctx context.Context, store JobStore, jobID string, upTo int64, lastSeq *int64, fn LogHandler) {
+	for *lastSeq < upTo {
+		chunks, err := store.Logs(ctx, jobID, *lastSeq, 0)
+		if err != nil {
+			flog.Warn("gateway: read logs job_id=%s: %v", jobID, err)
+			return
+		}
+		if len(chunks) == 0 {
+			return
+		}
+		for _, c := range chunks {
+			fn(c)
+			*lastSeq = c.Seq
+		}
+	}
+}
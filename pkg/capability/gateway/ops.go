@@ -5,4 +5,5 @@ const (
 	OpRun    = "run"
 	OpHealth = "health"
 	OpCancel = "cancel"
+	OpLogs   = "logs"
 )
@@ -41,7 +41,7 @@ func buildSpec() capability.Spec {
 					{Name: "prompt", Type: "string", Required: true, Description: "Prompt for the local Cursor CLI"},
 					{Name: "cwd", Type: "string", Required: false, Description: "Optional workspace path on the worker machine"},
 					{Name: "uid", Type: "string", Required: false, Description: "Owner UID for audit"},
-					{Name: "cli", Type: "string", Required: false, Description: "CLI id; only cursor is supported in v1"},
+					{Name: "cli", Type: "string", Required: false, Description: "Runner name on the worker: cursor (default), opencode, or a generic runner from gateway.yaml"},
 				},
 				Handler: runInvoker,
 			},
@@ -51,6 +51,15 @@ func buildSpec() capability.Spec {
 				Input:   []hub.ParamDef{{Name: "job_id", Type: "string", Required: true, Description: "Job id"}},
 				Handler: cancelInvoker,
 			},
+			{
+				Name: OpLogs, Description: "Read streamed output chunks of a gateway job after a seq",
+				Input: []hub.ParamDef{
+					{Name: "job_id", Type: "string", Required: true, Description: "Job id"},
+					{Name: "after", Type: "number", Required: false, Description: "Return chunks with seq greater than this (default 0)"},
+					{Name: "limit", Type: "number", Required: false, Description: "Max chunks to return (default 200)"},
+				},
+				Handler: logsInvoker,
+			},
 		},
 	}
 }
@@ -110,8 +119,8 @@ func parseRunParams(params map[string]any) (types.GatewayCreateJob, error) {
 	if raw, ok := capability.StringParam(params, "cli"); ok && strings.TrimSpace(raw) != "" {
 		cli = types.GatewayCLI(strings.TrimSpace(raw))
 	}
-	if cli != types.GatewayCLICursor {
-		return types.GatewayCreateJob{}, types.Errorf(types.ErrInvalidArgument, "unsupported gateway cli %q", cli)
+	if !types.ValidGatewayCLI(cli) {
+		return types.GatewayCreateJob{}, types.Errorf(types.ErrInvalidArgument, "invalid gateway cli %q", cli)
 	}
 	cwd, _ := capability.StringParam(params, "cwd")
 	uid, _ := capability.StringParam(params, "uid")
@@ -121,6 +130,8 @@ func parseRunParams(params map[string]any) (types.GatewayCreateJob, error) {
 func waitForJob(ctx context.Context, store JobStore, jobID string) (*capability.InvokeResult, error) {
 	waitCtx, cancel := context.WithTimeout(ctx, runTimeout())
 	defer cancel()
+	onLog := logHandlerFrom(ctx)
+	var lastSeq int64
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 	for {
@@ -144,6 +155,9 @@ func waitForJob(ctx context.Context, store JobStore, jobID string) (*capability.
 			if cur == nil {
 				return nil, types.Errorf(types.ErrNotFound, "gateway job disappeared")
 			}
+			if onLog != nil {
+				forwardLogs(waitCtx, store, jobID, cur.LogSeq, &lastSeq, onLog)
+			}
 			if types.GatewayJobTerminal(cur.Status) {
 				return &capability.InvokeResult{Data: cur}, nil
 			}
@@ -178,3 +192,28 @@ func cancelInvoker(ctx context.Context, params map[string]any) (*capability.Invo
 	}
 	return &capability.InvokeResult{Data: job}, nil
 }
+
+func logsInvoker(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+	store := jobStore()
+	if store == nil {
+		return nil, types.Errorf(types.ErrUnavailable, "gateway job store not configured")
+	}
+	jobID, err := capability.RequiredString(params, "job_id")
+	if err != nil {
+		return nil, err
+	}
+	after, _ := capability.Int64Param(params, "after")
+	limit, _ := capability.IntParam(params, "limit")
+	job, err := store.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, types.Errorf(types.ErrNotFound, "job not found")
+	}
+	chunks, err := store.Logs(ctx, jobID, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &capability.InvokeResult{Data: types.GatewayLogsResponse{Chunks: chunks, Status: job.Status}}, nil
+}
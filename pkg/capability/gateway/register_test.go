@@ -19,12 +19,13 @@ import (
 type memStore struct {
 	mu      sync.Mutex
 	jobs    map[string]*types.GatewayJob
+	logs    map[string][]types.GatewayLogChunk
 	fresh   bool
 	created int
 }
 
 func newMemStore(fresh bool) *memStore {
-	return &memStore{jobs: map[string]*types.GatewayJob{}, fresh: fresh}
+	return &memStore{jobs: map[string]*types.GatewayJob{}, logs: map[string][]types.GatewayLogChunk{}, fresh: fresh}
 }
 
 func (m *memStore) Create(_ context.Context, in types.GatewayCreateJob) (*types.GatewayJob, error) {
@@ -42,6 +43,10 @@ func (m *memStore) Create(_ context.Context, in types.GatewayCreateJob) (*types.
 		m.mu.Lock()
 		defer m.mu.Unlock()
 		if cur, ok := m.jobs[id]; ok && cur.Status == types.GatewayJobPending {
+			for _, line := range []string{"step 1\n", "step 2\n"} {
+				cur.LogSeq++
+				m.logs[id] = append(m.logs[id], types.GatewayLogChunk{JobID: id, Seq: cur.LogSeq, Content: line})
+			}
 			cur.Status = types.GatewayJobSucceeded
 			cur.Output = "done"
 		}
@@ -72,6 +77,18 @@ func (m *memStore) Cancel(_ context.Context, jobID string) (*types.GatewayJob, e
 	return &cp, nil
 }
 
+func (m *memStore) Logs(_ context.Context, jobID string, afterSeq int64, _ int) ([]types.GatewayLogChunk, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []types.GatewayLogChunk
+	for _, c := range m.logs[jobID] {
+		if c.Seq > afterSeq {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
 func (m *memStore) HasFreshWorker(context.Context, time.Duration) (bool, error) {
 	return m.fresh, nil
 }
@@ -113,7 +130,36 @@ func TestRunWaitsForTerminal(t *testing.T) {
 	assert.Equal(t, "done", job.Output)
 }
 
-func TestRunRejectsOpenCode(t *testing.T) {
+func TestRunForwardsLogs(t *testing.T) {
+	hub.Default.Unregister(hub.CapGateway)
+	t.Cleanup(func() { hub.Default.Unregister(hub.CapGateway) })
+
+	config.App.Gateway = config.GatewayConfig{Enabled: true, RunTimeout: time.Minute, WorkerStaleAfter: time.Minute}
+	store := newMemStore(true)
+	capgw.SetJobStore(store)
+	require.NoError(t, capgw.Register())
+
+	var got []string
+	ctx := capgw.WithLogHandler(context.Background(), func(c types.GatewayLogChunk) {
+		got = append(got, c.Content)
+	})
+	res, err := capability.Invoke(ctx, hub.CapGateway, capgw.OpRun, map[string]any{"prompt": "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"step 1\n", "step 2\n"}, got)
+
+	job := res.Data.(*types.GatewayJob)
+	logs, err := capability.Invoke(context.Background(), hub.CapGateway, capgw.OpLogs, map[string]any{
+		"job_id": job.JobID, "after": 1,
+	})
+	require.NoError(t, err)
+	out, ok := logs.Data.(types.GatewayLogsResponse)
+	require.True(t, ok)
+	require.Len(t, out.Chunks, 1)
+	assert.Equal(t, int64(2), out.Chunks[0].Seq)
+	assert.Equal(t, types.GatewayJobSucceeded, out.Status)
+}
+
+func TestRunRejectsInvalidCLI(t *testing.T) {
 	hub.Default.Unregister(hub.CapGateway)
 	t.Cleanup(func() { hub.Default.Unregister(hub.CapGateway) })
 
@@ -122,7 +168,7 @@ func TestRunRejectsOpenCode(t *testing.T) {
 	require.NoError(t, capgw.Register())
 
 	_, err := capability.Invoke(context.Background(), hub.CapGateway, capgw.OpRun, map[string]any{
-		"prompt": "x", "cli": "opencode",
+		"prompt": "x", "cli": "../bin/sh",
 	})
 	require.Error(t, err)
 	assert.ErrorIs(t, err, types.ErrInvalidArgument)
@@ -13,6 +13,7 @@ type JobStore interface {
 	Create(ctx context.Context, in types.GatewayCreateJob) (*types.GatewayJob, error)
 	Get(ctx context.Context, jobID string) (*types.GatewayJob, error)
 	Cancel(ctx context.Context, jobID string) (*types.GatewayJob, error)
+	Logs(ctx context.Context, jobID string, afterSeq int64, limit int) ([]types.GatewayLogChunk, error)
 	HasFreshWorker(ctx context.Context, staleAfter time.Duration) (bool, error)
 	ReclaimExpired(ctx context.Context) error
 }
@@ -265,6 +265,10 @@ func (r *Registry) Invoke(ctx context.Context, capability hub.CapabilityType, op
 	if !ok {
 		return nil, types.Errorf(types.ErrNotImplemented, "operation %s.%s not implemented", capability, operation)
 	}
+	// A cancelled pipeline or workflow run must not start new provider calls.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	isMut := IsMutation(operation)
 	skipCache := isMut || hasCursorParam(params)
@@ -0,0 +1,236 @@
+// Package n8n implements the n8n adapter for the workflow automation capability.
+package n8n
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/n8n"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	defaultExecutionLimit = 50
+	maxExecutionLimit     = 250
+)
+
+// executionStatuses lists the status filters accepted by the n8n executions API.
+var executionStatuses = []string{"success", "error", "waiting", "running", "canceled"}
+
+// client defines the subset of provider.N8N methods used by this adapter.
+type client interface {
+	ListWorkflows() ([]*provider.Workflow, error)
+	GetWorkflow(id string) (*provider.Workflow, error)
+	ActivateWorkflow(id string) error
+	DeactivateWorkflow(id string) error
+	ExecuteWorkflow(id string, data map[string]any) error
+	ListExecutions(q provider.ExecutionQuery) (*provider.ExecutionList, error)
+}
+
+// Adapter implements Service using the n8n provider client.
+type Adapter struct {
+	client client
+}
+
+// New creates an Adapter using the default provider client (reads config from YAML).
+// Returns nil when the provider is not configured.
+func New() Service {
+	c, err := provider.GetClient()
+	if err != nil || c == nil {
+		return nil
+	}
+	return NewWithClient(c)
+}
+
+// NewWithClient creates an Adapter with a specific client, useful for testing.
+func NewWithClient(c client) Service {
+	return &Adapter{client: c}
+}
+
+// ListWorkflows returns all workflows.
+func (a *Adapter) ListWorkflows(ctx context.Context) ([]*capability.AutomationWorkflow, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	list, err := a.client.ListWorkflows()
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "n8n list workflows failed", err)
+	}
+	out := make([]*capability.AutomationWorkflow, 0, len(list))
+	for _, w := range list {
+		if w != nil {
+			out = append(out, toWorkflow(w))
+		}
+	}
+	return out, nil
+}
+
+// GetWorkflow returns a single workflow by ID.
+func (a *Adapter) GetWorkflow(ctx context.Context, id string) (*capability.AutomationWorkflow, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if id == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	w, err := a.client.GetWorkflow(id)
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "n8n get workflow failed", err)
+	}
+	return toWorkflow(w), nil
+}
+
+// SetWorkflowActive activates or deactivates a workflow.
+func (a *Adapter) SetWorkflowActive(ctx context.Context, id string, active bool) error {
+	if err := ctx.Err(); err != nil {
+		return types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	if active {
+		if err := a.client.ActivateWorkflow(id); err != nil {
+			return types.WrapError(types.ErrProvider, "n8n activate workflow failed", err)
+		}
+		return nil
+	}
+	if err := a.client.DeactivateWorkflow(id); err != nil {
+		return types.WrapError(types.ErrProvider, "n8n deactivate workflow failed", err)
+	}
+	return nil
+}
+
+// TriggerWorkflow runs a workflow through its webhook trigger node with data as the body.
+func (a *Adapter) TriggerWorkflow(ctx context.Context, id string, data map[string]any) error {
+	if err := ctx.Err(); err != nil {
+		return types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if id == "" {
+		return types.Errorf(types.ErrInvalidArgument, "id is required")
+	}
+	if err := a.client.ExecuteWorkflow(id, data); err != nil {
+		return types.WrapError(types.ErrProvider, "n8n trigger workflow failed", err)
+	}
+	return nil
+}
+
+// ListExecutions returns a page of executions, newest first.
+// The cursor is passed through to n8n unchanged.
+func (a *Adapter) ListExecutions(ctx context.Context, q *ExecutionQuery) (*capability.ListResult[capability.AutomationExecution], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if q == nil {
+		q = &ExecutionQuery{}
+	}
+	if q.Status != "" && !slices.Contains(executionStatuses, q.Status) {
+		return nil, types.Errorf(types.ErrInvalidArgument, "invalid status %q, want one of %s", q.Status, strings.Join(executionStatuses, ", "))
+	}
+	limit := normalizedLimit(q.Page.Limit)
+	list, err := a.client.ListExecutions(provider.ExecutionQuery{
+		WorkflowID: q.WorkflowID,
+		Status:     q.Status,
+		Limit:      limit,
+		Cursor:     q.Page.Cursor,
+	})
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "n8n list executions failed", err)
+	}
+	items := make([]*capability.AutomationExecution, 0, len(list.Data))
+	for i := range list.Data {
+		items = append(items, toExecution(&list.Data[i]))
+	}
+	return &capability.ListResult[capability.AutomationExecution]{
+		Items: items,
+		Page: &capability.PageInfo{
+			Limit:      limit,
+			HasMore:    list.NextCursor != "",
+			NextCursor: list.NextCursor,
+		},
+	}, nil
+}
+
+// HealthCheck reports whether the n8n API is reachable.
+func (a *Adapter) HealthCheck(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if _, err := a.client.ListWorkflows(); err != nil {
+		return false, types.WrapError(types.ErrProvider, "n8n health check failed", err)
+	}
+	return true, nil
+}
+
+// ListRawEvents returns the newest page of executions as raw events for polling.
+// The returned cursor is always empty: executions are listed newest-first, so the
+// poller re-reads the head every tick and relies on DiffKey/ContentHash to spot
+// new and finished executions.
+func (a *Adapter) ListRawEvents(ctx context.Context, _ string) ([]any, string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, "", types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	list, err := a.client.ListExecutions(provider.ExecutionQuery{Limit: defaultExecutionLimit})
+	if err != nil {
+		return nil, "", types.WrapError(types.ErrProvider, "n8n list raw events failed", err)
+	}
+	items := make([]any, 0, len(list.Data))
+	for i := range list.Data {
+		e := toExecution(&list.Data[i])
+		items = append(items, map[string]any{
+			"id":          e.ID,
+			"workflow_id": e.WorkflowID,
+			"status":      e.Status,
+			"mode":        e.Mode,
+			"finished":    e.Finished,
+		})
+	}
+	return items, "", nil
+}
+
+// normalizedLimit clamps the provided limit to a valid range.
+func normalizedLimit(limit int) int {
+	if limit <= 0 {
+		return defaultExecutionLimit
+	}
+	return min(limit, maxExecutionLimit)
+}
+
+func toWorkflow(w *provider.Workflow) *capability.AutomationWorkflow {
+	out := &capability.AutomationWorkflow{
+		ID:     w.ID,
+		Name:   w.Name,
+		Active: w.Active,
+	}
+	for _, tag := range w.Tags {
+		out.Tags = append(out.Tags, tag.Name)
+	}
+	if w.CreatedAt != nil {
+		out.CreatedAt = *w.CreatedAt
+	}
+	if w.UpdatedAt != nil {
+		out.UpdatedAt = *w.UpdatedAt
+	}
+	return out
+}
+
+func toExecution(e *provider.Execution) *capability.AutomationExecution {
+	out := &capability.AutomationExecution{
+		ID:         string(e.ID),
+		WorkflowID: string(e.WorkflowID),
+		Status:     e.Status,
+		Mode:       e.Mode,
+		Finished:   e.Finished,
+	}
+	if e.StartedAt != nil {
+		out.StartedAt = *e.StartedAt
+	}
+	if e.StoppedAt != nil {
+		out.StoppedAt = *e.StoppedAt
+	}
+	return out
+}
+
+// Compile-time interface check.
+var _ Service = (*Adapter)(nil)
@@ -0,0 +1,171 @@
+package n8n
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/n8n"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type fakeClient struct {
+	listResp       []*provider.Workflow
+	listErr        error
+	getResp        *provider.Workflow
+	getErr         error
+	activateErr    error
+	executeErr     error
+	executionsResp *provider.ExecutionList
+	executionsErr  error
+	activated      []string
+	deactivated    []string
+	lastExecuteID  string
+	lastExecute    map[string]any
+	lastExecQuery  provider.ExecutionQuery
+}
+
+func (f *fakeClient) ListWorkflows() ([]*provider.Workflow, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	return f.listResp, nil
+}
+
+func (f *fakeClient) GetWorkflow(id string) (*provider.Workflow, error) {
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	if f.getResp == nil {
+		return &provider.Workflow{ID: id}, nil
+	}
+	return f.getResp, nil
+}
+
+func (f *fakeClient) ActivateWorkflow(id string) error {
+	f.activated = append(f.activated, id)
+	return f.activateErr
+}
+
+func (f *fakeClient) DeactivateWorkflow(id string) error {
+	f.deactivated = append(f.deactivated, id)
+	return f.activateErr
+}
+
+func (f *fakeClient) ExecuteWorkflow(id string, data map[string]any) error {
+	f.lastExecuteID = id
+	f.lastExecute = data
+	return f.executeErr
+}
+
+func (f *fakeClient) ListExecutions(q provider.ExecutionQuery) (*provider.ExecutionList, error) {
+	f.lastExecQuery = q
+	if f.executionsErr != nil {
+		return nil, f.executionsErr
+	}
+	if f.executionsResp == nil {
+		return &provider.ExecutionList{}, nil
+	}
+	return f.executionsResp, nil
+}
+
+var _ client = (*fakeClient)(nil)
+
+func TestAdapter_ListWorkflows(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := &fakeClient{listResp: []*provider.Workflow{
+		{ID: "1", Name: "Backup", Active: true, CreatedAt: &created, Tags: []provider.Tag{{Name: "ops"}}},
+		nil,
+	}}
+	items, err := NewWithClient(fc).ListWorkflows(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Backup", items[0].Name)
+	assert.True(t, items[0].Active)
+	assert.Equal(t, []string{"ops"}, items[0].Tags)
+	assert.Equal(t, created, items[0].CreatedAt)
+}
+
+func TestAdapter_SetWorkflowActive(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{}
+	svc := NewWithClient(fc)
+	require.NoError(t, svc.SetWorkflowActive(context.Background(), "1", true))
+	require.NoError(t, svc.SetWorkflowActive(context.Background(), "2", false))
+	assert.Equal(t, []string{"1"}, fc.activated)
+	assert.Equal(t, []string{"2"}, fc.deactivated)
+}
+
+func TestAdapter_TriggerWorkflow(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{}
+	require.NoError(t, NewWithClient(fc).TriggerWorkflow(context.Background(), "1", map[string]any{"k": "v"}))
+	assert.Equal(t, "1", fc.lastExecuteID)
+	assert.Equal(t, map[string]any{"k": "v"}, fc.lastExecute)
+}
+
+func TestAdapter_ListExecutions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		query     *ExecutionQuery
+		resp      *provider.ExecutionList
+		wantQuery provider.ExecutionQuery
+		wantMore  bool
+		wantErr   error
+	}{
+		{
+			name:      "passes filters and cursor",
+			query:     &ExecutionQuery{Page: capability.PageRequest{Limit: 10, Cursor: "abc"}, WorkflowID: "1", Status: "error"},
+			resp:      &provider.ExecutionList{Data: []provider.Execution{{ID: "7", WorkflowID: "1", Status: "error"}}, NextCursor: "def"},
+			wantQuery: provider.ExecutionQuery{WorkflowID: "1", Status: "error", Limit: 10, Cursor: "abc"},
+			wantMore:  true,
+		},
+		{
+			name:      "defaults and caps limit",
+			query:     &ExecutionQuery{Page: capability.PageRequest{Limit: 10000}},
+			wantQuery: provider.ExecutionQuery{Limit: maxExecutionLimit},
+		},
+		{
+			name:    "rejects unknown status",
+			query:   &ExecutionQuery{Status: "bogus"},
+			wantErr: types.ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &fakeClient{executionsResp: tt.resp}
+			result, err := NewWithClient(fc).ListExecutions(context.Background(), tt.query)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, fc.lastExecQuery)
+			assert.Equal(t, tt.wantMore, result.Page.HasMore)
+		})
+	}
+}
+
+func TestAdapter_ListRawEvents(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{executionsResp: &provider.ExecutionList{
+		Data:       []provider.Execution{{ID: "7", WorkflowID: "1", Status: "running"}},
+		NextCursor: "more",
+	}}
+	items, next, err := NewWithClient(fc).ListRawEvents(context.Background(), "ignored")
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Empty(t, fc.lastExecQuery.Cursor)
+	require.Len(t, items, 1)
+	m, ok := items[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "7", m["id"])
+	assert.Equal(t, "running", m["status"])
+}
@@ -0,0 +1,47 @@
+package n8n
+
+import (
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/capability/conformance"
+	provider "github.com/flowline-io/flowbot/pkg/providers/n8n"
+)
+
+// fakeClientFromAutomationConfig maps the conformance AutomationConfig onto a fakeClient.
+func fakeClientFromAutomationConfig(cfg conformance.AutomationConfig) *fakeClient {
+	c := &fakeClient{
+		listErr:       cfg.ListErr,
+		getErr:        cfg.GetErr,
+		activateErr:   cfg.ActivateErr,
+		executeErr:    cfg.TriggerErr,
+		executionsErr: cfg.ExecutionErr,
+	}
+	for _, w := range cfg.Workflows {
+		c.listResp = append(c.listResp, &provider.Workflow{ID: w.ID, Name: w.Name, Active: w.Active})
+	}
+	if cfg.GetItem != nil {
+		c.getResp = &provider.Workflow{ID: cfg.GetItem.ID, Name: cfg.GetItem.Name, Active: cfg.GetItem.Active}
+	}
+	if cfg.ExecutionItems != nil {
+		list := &provider.ExecutionList{NextCursor: cfg.ExecutionNextCursor}
+		for _, e := range cfg.ExecutionItems {
+			list.Data = append(list.Data, provider.Execution{
+				ID:         provider.FlexID(e.ID),
+				WorkflowID: provider.FlexID(e.WorkflowID),
+				Status:     e.Status,
+			})
+		}
+		c.executionsResp = list
+	}
+	return c
+}
+
+func TestN8NAutomationConformance(t *testing.T) {
+	conformance.RunAutomationConformance(t, func(t *testing.T, cfg conformance.AutomationConfig) conformance.AutomationService {
+		t.Helper()
+		return NewWithClient(fakeClientFromAutomationConfig(cfg))
+	})
+}
+
+// Compile-time check: Adapter satisfies the conformance automation contract.
+var _ conformance.AutomationService = (*Adapter)(nil)
@@ -0,0 +1,12 @@
+package n8n
+
+// Operation name constants for the n8n capability.
+const (
+	OpListWorkflows      = "list_workflows"
+	OpGetWorkflow        = "get_workflow"
+	OpActivateWorkflow   = "activate_workflow"
+	OpDeactivateWorkflow = "deactivate_workflow"
+	OpTriggerWorkflow    = "trigger_workflow"
+	OpListExecutions     = "list_executions"
+	OpHealth             = "health"
+)
@@ -0,0 +1,75 @@
+package n8n
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// ExecutionPoller polls n8n for new executions and executions that change status.
+type ExecutionPoller struct {
+	svc Service
+}
+
+// NewPoller creates a ExecutionPoller. Returns nil when the provider is not configured.
+func NewPoller() capability.PollingResource {
+	svc := New()
+	if svc == nil {
+		return nil
+	}
+	return NewPollerWithService(svc)
+}
+
+// NewPollerWithService creates a ExecutionPoller with a specific service.
+func NewPollerWithService(svc Service) *ExecutionPoller {
+	return &ExecutionPoller{svc: svc}
+}
+
+func (*ExecutionPoller) ResourceName() string { return "n8n/executions" }
+
+func (*ExecutionPoller) DefaultInterval() time.Duration { return 2 * time.Minute }
+
+func (*ExecutionPoller) Capability() string { return string(hub.CapN8n) }
+
+func (*ExecutionPoller) DiffKey(item any) string {
+	if m, ok := item.(map[string]any); ok {
+		if id, ok := m["id"].(string); ok {
+			return id
+		}
+	}
+	return fmt.Sprintf("%v", item)
+}
+
+func (*ExecutionPoller) ContentHash(item any) string {
+	data := fmt.Sprintf("%v", item)
+	h := sha256.New()
+	_, _ = h.Write([]byte(data))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func (*ExecutionPoller) CursorField() string { return "cursor" }
+
+func (p *ExecutionPoller) List(ctx context.Context, cursor string) (capability.PollResult, error) {
+	if err := ctx.Err(); err != nil {
+		return capability.PollResult{}, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	items, nextCursor, err := p.svc.ListRawEvents(ctx, cursor)
+	if err != nil {
+		return capability.PollResult{}, err
+	}
+	return capability.PollResult{
+		Items:      items,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+	}, nil
+}
+
+var (
+	_ capability.PollingResource    = (*ExecutionPoller)(nil)
+	_ capability.CapabilityProvider = (*ExecutionPoller)(nil)
+)
@@ -0,0 +1,68 @@
+package n8n
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/hub"
+)
+
+type fakePollerService struct {
+	mockService
+	items  []any
+	cursor string
+	err    error
+}
+
+func (f *fakePollerService) ListRawEvents(_ context.Context, _ string) ([]any, string, error) {
+	return f.items, f.cursor, f.err
+}
+
+func TestExecutionPoller_Metadata(t *testing.T) {
+	t.Parallel()
+	p := NewPollerWithService(&fakePollerService{})
+	assert.Equal(t, "n8n/executions", p.ResourceName())
+	assert.Equal(t, 2*time.Minute, p.DefaultInterval())
+	assert.Equal(t, string(hub.CapN8n), p.Capability())
+	assert.Equal(t, "cursor", p.CursorField())
+}
+
+func TestExecutionPoller_DiffKeyAndHash(t *testing.T) {
+	t.Parallel()
+	p := NewPollerWithService(&fakePollerService{})
+	running := map[string]any{"id": "a", "status": "running"}
+	succeeded := map[string]any{"id": "a", "status": "success"}
+	assert.Equal(t, "a", p.DiffKey(running))
+	assert.Equal(t, "plain", p.DiffKey("plain"))
+	assert.NotEqual(t, p.ContentHash(running), p.ContentHash(succeeded))
+}
+
+func TestExecutionPoller_List(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		svc       *fakePollerService
+		wantItems int
+		wantErr   bool
+	}{
+		{name: "returns items", svc: &fakePollerService{items: []any{map[string]any{"id": "a"}}}, wantItems: 1},
+		{name: "service error", svc: &fakePollerService{err: assert.AnError}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := NewPollerWithService(tt.svc).List(context.Background(), "")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.Items, tt.wantItems)
+			assert.False(t, result.HasMore)
+		})
+	}
+}
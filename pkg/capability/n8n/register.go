@@ -0,0 +1,163 @@
+package n8n
+
+import (
+	"context"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// Register registers the n8n capability with hub and invoker registry.
+// When svc is nil the provider is not configured and registration is skipped.
+func Register(app string, svc Service) error {
+	return capability.Register(buildSpec(app, svc))
+}
+
+// CatalogSpec returns capability metadata for documentation (handlers may close over a nil service and must not be invoked).
+func CatalogSpec() capability.Spec {
+	return buildSpec("", nil)
+}
+
+func buildSpec(app string, svc Service) capability.Spec {
+	return capability.Spec{
+		Type:        hub.CapN8n,
+		App:         app,
+		Description: "Workflow automation capability for n8n",
+		Instance:    svc,
+		Events: []hub.EventDef{
+			{Name: "n8n/executions.created", Description: "Fires when the poller observes a new execution"},
+			{Name: "n8n/executions.updated", Description: "Fires when the poller observes an execution change status"},
+		},
+		Ops: []capability.OpDef{
+			{
+				Name: OpListWorkflows, Description: "List workflows", Scopes: []string{auth.ScopeServiceN8nRead},
+				Handler: invokeListWorkflows(svc),
+			},
+			{
+				Name: OpGetWorkflow, Description: "Get a workflow", Scopes: []string{auth.ScopeServiceN8nRead},
+				Input:   []hub.ParamDef{{Name: "id", Type: "string", Required: true, Description: "Workflow ID"}},
+				Handler: invokeGetWorkflow(svc),
+			},
+			{
+				Name: OpActivateWorkflow, Description: "Activate a workflow", Scopes: []string{auth.ScopeServiceN8nWrite}, Mutation: true,
+				Input:   []hub.ParamDef{{Name: "id", Type: "string", Required: true, Description: "Workflow ID"}},
+				Handler: invokeSetActive(svc, app, true),
+			},
+			{
+				Name: OpDeactivateWorkflow, Description: "Deactivate a workflow", Scopes: []string{auth.ScopeServiceN8nWrite}, Mutation: true,
+				Input:   []hub.ParamDef{{Name: "id", Type: "string", Required: true, Description: "Workflow ID"}},
+				Handler: invokeSetActive(svc, app, false),
+			},
+			{
+				Name: OpTriggerWorkflow, Description: "Trigger a workflow through its webhook node", Scopes: []string{auth.ScopeServiceN8nWrite}, Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "id", Type: "string", Required: true, Description: "Workflow ID"},
+					{Name: "data", Type: "map[string]any", Required: false, Description: "JSON body sent to the webhook"},
+				},
+				Handler: invokeTrigger(svc, app),
+			},
+			{
+				Name: OpListExecutions, Description: "List workflow executions", Scopes: []string{auth.ScopeServiceN8nRead},
+				Input: []hub.ParamDef{
+					{Name: "limit", Type: "int", Required: false, Description: "Maximum items per page"},
+					{Name: "cursor", Type: "string", Required: false, Description: "Pagination cursor"},
+					{Name: "workflow_id", Type: "string", Required: false, Description: "Only executions of this workflow"},
+					{Name: "status", Type: "string", Required: false, Description: "Status filter (success, error, waiting, running, canceled)"},
+				},
+				Handler: invokeListExecutions(svc),
+			},
+			{
+				Name: OpHealth, Description: "Health check", Scopes: []string{auth.ScopeServiceN8nRead},
+				Handler: invokeHealth(svc),
+			},
+		},
+	}
+}
+
+func invokeListWorkflows(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		items, err := svc.ListWorkflows(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: items}, nil
+	}
+}
+
+func invokeGetWorkflow(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredString(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		item, err := svc.GetWorkflow(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: item}, nil
+	}
+}
+
+func invokeSetActive(svc Service, app string, active bool) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredString(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		if err := svc.SetWorkflowActive(ctx, id, active); err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{
+			Data:     map[string]any{"id": id, "active": active},
+			Resource: &capability.ResourceMeta{EntityID: id, App: app},
+		}, nil
+	}
+}
+
+func invokeTrigger(svc Service, app string) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredString(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		var data map[string]any
+		if raw, ok := params["data"]; ok && raw != nil {
+			data, ok = raw.(map[string]any)
+			if !ok {
+				return nil, types.Errorf(types.ErrInvalidArgument, "data must be an object")
+			}
+		}
+		if err := svc.TriggerWorkflow(ctx, id, data); err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{
+			Data:     map[string]any{"id": id, "triggered": true},
+			Resource: &capability.ResourceMeta{EntityID: id, App: app},
+		}, nil
+	}
+}
+
+func invokeListExecutions(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		q := &ExecutionQuery{Page: capability.PageRequestFromParams(params)}
+		q.WorkflowID, _ = capability.StringParam(params, "workflow_id")
+		q.Status, _ = capability.StringParam(params, "status")
+		result, err := svc.ListExecutions(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: result.Items, Page: result.Page}, nil
+	}
+}
+
+func invokeHealth(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		ok, err := svc.HealthCheck(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: ok}, nil
+	}
+}
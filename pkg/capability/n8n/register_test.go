@@ -0,0 +1,112 @@
+package n8n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type mockService struct {
+	lastID     string
+	lastActive *bool
+	lastData   map[string]any
+}
+
+func (*mockService) ListWorkflows(_ context.Context) ([]*capability.AutomationWorkflow, error) {
+	return []*capability.AutomationWorkflow{}, nil
+}
+func (*mockService) GetWorkflow(_ context.Context, id string) (*capability.AutomationWorkflow, error) {
+	return &capability.AutomationWorkflow{ID: id}, nil
+}
+func (m *mockService) SetWorkflowActive(_ context.Context, id string, active bool) error {
+	m.lastID = id
+	m.lastActive = &active
+	return nil
+}
+func (m *mockService) TriggerWorkflow(_ context.Context, id string, data map[string]any) error {
+	m.lastID = id
+	m.lastData = data
+	return nil
+}
+func (*mockService) ListExecutions(_ context.Context, _ *ExecutionQuery) (*capability.ListResult[capability.AutomationExecution], error) {
+	return &capability.ListResult[capability.AutomationExecution]{Items: []*capability.AutomationExecution{}, Page: &capability.PageInfo{}}, nil
+}
+func (*mockService) HealthCheck(_ context.Context) (bool, error) { return true, nil }
+func (*mockService) ListRawEvents(_ context.Context, _ string) ([]any, string, error) {
+	return nil, "", nil
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  Service
+	}{
+		{name: "nil service skips registration", svc: nil},
+		{name: "valid service", svc: &mockService{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, Register("n8n", tt.svc))
+		})
+	}
+}
+
+func TestRegister_Operations(t *testing.T) {
+	require.NoError(t, Register("n8n", &mockService{}))
+	desc, ok := hub.Default.Get(hub.CapN8n)
+	require.True(t, ok)
+	assert.Equal(t, "n8n", desc.App)
+	assert.Len(t, desc.Operations, 7)
+
+	opNames := make([]string, len(desc.Operations))
+	for i, op := range desc.Operations {
+		opNames[i] = op.Name
+	}
+	for _, op := range []string{OpListWorkflows, OpGetWorkflow, OpActivateWorkflow, OpDeactivateWorkflow, OpTriggerWorkflow, OpListExecutions, OpHealth} {
+		assert.Contains(t, opNames, op)
+	}
+}
+
+func TestInvokeSetActive(t *testing.T) {
+	for _, active := range []bool{true, false} {
+		svc := &mockService{}
+		res, err := invokeSetActive(svc, "n8n", active)(context.Background(), map[string]any{"id": "1"})
+		require.NoError(t, err)
+		require.NotNil(t, svc.lastActive)
+		assert.Equal(t, active, *svc.lastActive)
+		assert.Equal(t, "1", res.Resource.EntityID)
+	}
+}
+
+func TestInvokeTrigger(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]any
+		wantData map[string]any
+		wantErr  bool
+	}{
+		{name: "with data", params: map[string]any{"id": "1", "data": map[string]any{"k": "v"}}, wantData: map[string]any{"k": "v"}},
+		{name: "without data", params: map[string]any{"id": "1"}},
+		{name: "missing id", params: map[string]any{}, wantErr: true},
+		{name: "data not an object", params: map[string]any{"id": "1", "data": "text"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockService{}
+			_, err := invokeTrigger(svc, "n8n")(context.Background(), tt.params)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, types.ErrInvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "1", svc.lastID)
+			assert.Equal(t, tt.wantData, svc.lastData)
+		})
+	}
+}
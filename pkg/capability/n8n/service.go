@@ -0,0 +1,21 @@
+package n8n
+
+import (
+	"context"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// ExecutionQuery wraps pagination and filtering for listing executions.
+type ExecutionQuery = capability.AutomationExecutionQuery
+
+// Service defines the n8n workflow automation capability contract.
+type Service interface {
+	ListWorkflows(ctx context.Context) ([]*capability.AutomationWorkflow, error)
+	GetWorkflow(ctx context.Context, id string) (*capability.AutomationWorkflow, error)
+	SetWorkflowActive(ctx context.Context, id string, active bool) error
+	TriggerWorkflow(ctx context.Context, id string, data map[string]any) error
+	ListExecutions(ctx context.Context, q *ExecutionQuery) (*capability.ListResult[capability.AutomationExecution], error)
+	HealthCheck(ctx context.Context) (bool, error)
+	ListRawEvents(ctx context.Context, cursor string) ([]any, string, error)
+}
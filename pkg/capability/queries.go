@@ -93,6 +93,27 @@ type GithubPageQuery struct {
 	Page PageRequest
 }
 
+// DNSQueryLogQuery wraps pagination and filtering for a DNS query log.
+type DNSQueryLogQuery struct {
+	Page   PageRequest
+	Search string
+	Status string
+}
+
+// ArchiveListQuery wraps pagination and filtering for listing archived items.
+type ArchiveListQuery struct {
+	Page   PageRequest
+	Search string
+	Tag    string
+}
+
+// AutomationExecutionQuery wraps pagination and filtering for listing workflow executions.
+type AutomationExecutionQuery struct {
+	Page       PageRequest
+	WorkflowID string
+	Status     string
+}
+
 // ExampleListQuery wraps pagination for listing example items.
 type ExampleListQuery struct {
 	Page PageRequest
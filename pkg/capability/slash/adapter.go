@@ -0,0 +1,265 @@
+// Package slash implements the Slash adapter for the link shortener capability.
+package slash
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/slash"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// visibilities lists the shortcut visibility values accepted by Slash.
+var visibilities = []string{"PRIVATE", "WORKSPACE", "PUBLIC"}
+
+// client defines the subset of provider.Slash methods used by this adapter.
+type client interface {
+	ListShortcuts() ([]*provider.Shortcut, error)
+	GetShortcut(id int32) (*provider.Shortcut, error)
+	CreateShortcut(item provider.Shortcut) error
+	UpdateShortcut(item provider.Shortcut) error
+	DeleteShortcut(id int32) error
+}
+
+// Adapter implements Service using the Slash provider client.
+type Adapter struct {
+	client client
+}
+
+// New creates an Adapter using the default provider client (reads config from YAML).
+// Returns nil when the provider is not configured.
+func New() Service {
+	c := provider.GetClient()
+	if c == nil {
+		return nil
+	}
+	return NewWithClient(c)
+}
+
+// NewWithClient creates an Adapter with a specific client, useful for testing.
+func NewWithClient(c client) Service {
+	return &Adapter{client: c}
+}
+
+// List returns all shortcuts visible to the configured token.
+func (a *Adapter) List(ctx context.Context) ([]*capability.Shortcut, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	list, err := a.client.ListShortcuts()
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "slash list shortcuts failed", err)
+	}
+	out := make([]*capability.Shortcut, 0, len(list))
+	for _, s := range list {
+		if s != nil {
+			out = append(out, toShortcut(s))
+		}
+	}
+	return out, nil
+}
+
+// Get returns a single shortcut by ID.
+func (a *Adapter) Get(ctx context.Context, id int64) (*capability.Shortcut, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	pid, err := shortcutID(id)
+	if err != nil {
+		return nil, err
+	}
+	s, err := a.client.GetShortcut(pid)
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "slash get shortcut failed", err)
+	}
+	return toShortcut(s), nil
+}
+
+// Create creates a shortcut. Slash does not echo the created shortcut, so the
+// adapter looks it up by name afterwards to return its ID.
+func (a *Adapter) Create(ctx context.Context, in *capability.Shortcut) (*capability.Shortcut, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if in == nil || in.Name == "" || in.Link == "" {
+		return nil, types.Errorf(types.ErrInvalidArgument, "name and link are required")
+	}
+	item := provider.Shortcut{
+		Name:        in.Name,
+		Link:        in.Link,
+		Title:       in.Title,
+		Description: in.Description,
+		Tags:        in.Tags,
+	}
+	if in.Visibility != "" {
+		visibility, err := normalizeVisibility(in.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		item.Visibility = visibility
+	}
+	if err := a.client.CreateShortcut(item); err != nil {
+		return nil, types.WrapError(types.ErrProvider, "slash create shortcut failed", err)
+	}
+	list, err := a.client.ListShortcuts()
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "slash list shortcuts failed", err)
+	}
+	for _, s := range list {
+		if s != nil && s.Name == in.Name {
+			return toShortcut(s), nil
+		}
+	}
+	return toShortcut(&item), nil
+}
+
+// Update applies the given fields to an existing shortcut. Supported keys are
+// name, link, title, description, tags and visibility.
+func (a *Adapter) Update(ctx context.Context, id int64, data map[string]any) (*capability.Shortcut, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	pid, err := shortcutID(id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := a.client.GetShortcut(pid)
+	if err != nil {
+		return nil, types.WrapError(types.ErrProvider, "slash get shortcut failed", err)
+	}
+	item := *current
+	item.Id = pid
+	if err := applyUpdate(&item, data); err != nil {
+		return nil, err
+	}
+	if err := a.client.UpdateShortcut(item); err != nil {
+		return nil, types.WrapError(types.ErrProvider, "slash update shortcut failed", err)
+	}
+	return toShortcut(&item), nil
+}
+
+// Delete removes a shortcut by ID.
+func (a *Adapter) Delete(ctx context.Context, id int64) error {
+	if err := ctx.Err(); err != nil {
+		return types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	pid, err := shortcutID(id)
+	if err != nil {
+		return err
+	}
+	if err := a.client.DeleteShortcut(pid); err != nil {
+		return types.WrapError(types.ErrProvider, "slash delete shortcut failed", err)
+	}
+	return nil
+}
+
+// HealthCheck reports whether the Slash API is reachable.
+func (a *Adapter) HealthCheck(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, types.WrapError(types.ErrTimeout, "context canceled", err)
+	}
+	if _, err := a.client.ListShortcuts(); err != nil {
+		return false, types.WrapError(types.ErrProvider, "slash health check failed", err)
+	}
+	return true, nil
+}
+
+func applyUpdate(item *provider.Shortcut, data map[string]any) error {
+	for key, value := range data {
+		var err error
+		switch key {
+		case "name":
+			item.Name, err = stringField(key, value)
+		case "link":
+			item.Link, err = stringField(key, value)
+		case "title":
+			item.Title, err = stringField(key, value)
+		case "description":
+			item.Description, err = stringField(key, value)
+		case "tags":
+			item.Tags, err = toStrings(value)
+		case "visibility":
+			var v string
+			if v, err = stringField(key, value); err == nil {
+				item.Visibility, err = normalizeVisibility(v)
+			}
+		default:
+			err = types.Errorf(types.ErrInvalidArgument, "unsupported field %q", key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if item.Name == "" || item.Link == "" {
+		return types.Errorf(types.ErrInvalidArgument, "name and link must not be empty")
+	}
+	return nil
+}
+
+func stringField(key string, value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", types.Errorf(types.ErrInvalidArgument, "%s must be a string", key)
+	}
+	return s, nil
+}
+
+func toStrings(value any) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, types.Errorf(types.ErrInvalidArgument, "tags must be strings")
+			}
+			out = append(out, s)
+		}
+		return out, nil
+	default:
+		return nil, types.Errorf(types.ErrInvalidArgument, "tags must be a list of strings")
+	}
+}
+
+func normalizeVisibility(v string) (string, error) {
+	upper := strings.ToUpper(v)
+	if !slices.Contains(visibilities, upper) {
+		return "", types.Errorf(types.ErrInvalidArgument, "invalid visibility %q, want one of %s", v, strings.Join(visibilities, ", "))
+	}
+	return upper, nil
+}
+
+// shortcutID validates an ID and narrows it to the provider's int32.
+func shortcutID(id int64) (int32, error) {
+	if id <= 0 || id > math.MaxInt32 {
+		return 0, types.Errorf(types.ErrInvalidArgument, "invalid id %d", id)
+	}
+	return int32(id), nil
+}
+
+func toShortcut(s *provider.Shortcut) *capability.Shortcut {
+	out := &capability.Shortcut{
+		ID:          int64(s.Id),
+		Name:        s.Name,
+		Link:        s.Link,
+		Title:       s.Title,
+		Description: s.Description,
+		Tags:        s.Tags,
+		ViewCount:   int64(s.ViewCount),
+		CreatedAt:   s.CreatedTime,
+		UpdatedAt:   s.UpdatedTime,
+	}
+	if s.Visibility != nil {
+		out.Visibility = fmt.Sprint(s.Visibility)
+	}
+	return out
+}
+
+// Compile-time interface check.
+var _ Service = (*Adapter)(nil)
@@ -0,0 +1,132 @@
+package slash
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	provider "github.com/flowline-io/flowbot/pkg/providers/slash"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+type fakeClient struct {
+	listResp   []*provider.Shortcut
+	listErr    error
+	getResp    *provider.Shortcut
+	getErr     error
+	createErr  error
+	updateErr  error
+	deleteErr  error
+	lastCreate provider.Shortcut
+	lastUpdate provider.Shortcut
+	lastDelete int32
+}
+
+func (f *fakeClient) ListShortcuts() ([]*provider.Shortcut, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	return f.listResp, nil
+}
+
+func (f *fakeClient) GetShortcut(id int32) (*provider.Shortcut, error) {
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	if f.getResp == nil {
+		return &provider.Shortcut{Id: id}, nil
+	}
+	return f.getResp, nil
+}
+
+func (f *fakeClient) CreateShortcut(item provider.Shortcut) error {
+	f.lastCreate = item
+	return f.createErr
+}
+
+func (f *fakeClient) UpdateShortcut(item provider.Shortcut) error {
+	f.lastUpdate = item
+	return f.updateErr
+}
+
+func (f *fakeClient) DeleteShortcut(id int32) error {
+	f.lastDelete = id
+	return f.deleteErr
+}
+
+var _ client = (*fakeClient)(nil)
+
+func TestAdapter_CreateLooksUpID(t *testing.T) {
+	t.Parallel()
+	fc := &fakeClient{listResp: []*provider.Shortcut{
+		{Id: 3, Name: "other", Link: "https://other.example"},
+		{Id: 9, Name: "go", Link: "https://go.dev", Visibility: "PUBLIC"},
+	}}
+	item, err := NewWithClient(fc).Create(context.Background(), &capability.Shortcut{
+		Name: "go", Link: "https://go.dev", Visibility: "public", Tags: []string{"lang"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), item.ID)
+	assert.Equal(t, "PUBLIC", item.Visibility)
+	assert.Equal(t, "PUBLIC", fc.lastCreate.Visibility)
+	assert.Equal(t, []string{"lang"}, fc.lastCreate.Tags)
+}
+
+func TestAdapter_CreateInvalidVisibility(t *testing.T) {
+	t.Parallel()
+	_, err := NewWithClient(&fakeClient{}).Create(context.Background(), &capability.Shortcut{
+		Name: "go", Link: "https://go.dev", Visibility: "secret",
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidArgument)
+}
+
+func TestAdapter_Update(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		data    map[string]any
+		check   func(t *testing.T, got provider.Shortcut)
+		wantErr bool
+	}{
+		{
+			name: "merges fields",
+			data: map[string]any{"title": "Go", "tags": []any{"lang", "google"}, "visibility": "workspace"},
+			check: func(t *testing.T, got provider.Shortcut) {
+				assert.Equal(t, int32(1), got.Id)
+				assert.Equal(t, "go", got.Name)
+				assert.Equal(t, "https://go.dev", got.Link)
+				assert.Equal(t, "Go", got.Title)
+				assert.Equal(t, []string{"lang", "google"}, got.Tags)
+				assert.Equal(t, "WORKSPACE", got.Visibility)
+			},
+		},
+		{name: "unknown field", data: map[string]any{"owner": "me"}, wantErr: true},
+		{name: "wrong type", data: map[string]any{"link": 42}, wantErr: true},
+		{name: "clearing link", data: map[string]any{"link": ""}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &fakeClient{getResp: &provider.Shortcut{Id: 1, Name: "go", Link: "https://go.dev"}}
+			_, err := NewWithClient(fc).Update(context.Background(), 1, tt.data)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, types.ErrInvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, fc.lastUpdate)
+		})
+	}
+}
+
+func TestAdapter_IDRange(t *testing.T) {
+	t.Parallel()
+	svc := NewWithClient(&fakeClient{})
+	_, err := svc.Get(context.Background(), math.MaxInt32+1)
+	assert.ErrorIs(t, err, types.ErrInvalidArgument)
+	require.NoError(t, svc.Delete(context.Background(), math.MaxInt32))
+}
@@ -0,0 +1,47 @@
+package slash
+
+import (
+	"testing"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/capability/conformance"
+	provider "github.com/flowline-io/flowbot/pkg/providers/slash"
+)
+
+// fakeClientFromShortcutConfig maps the conformance ShortcutConfig onto a fakeClient.
+func fakeClientFromShortcutConfig(cfg conformance.ShortcutConfig) *fakeClient {
+	c := &fakeClient{
+		listErr:   cfg.ListErr,
+		getErr:    cfg.GetErr,
+		createErr: cfg.CreateErr,
+		updateErr: cfg.UpdateErr,
+		deleteErr: cfg.DeleteErr,
+	}
+	for _, item := range cfg.ListItems {
+		c.listResp = append(c.listResp, providerShortcut(item))
+	}
+	if cfg.GetItem != nil {
+		c.getResp = providerShortcut(cfg.GetItem)
+	}
+	return c
+}
+
+func providerShortcut(item *capability.Shortcut) *provider.Shortcut {
+	return &provider.Shortcut{
+		Id:    int32(item.ID),
+		Name:  item.Name,
+		Link:  item.Link,
+		Title: item.Title,
+		Tags:  item.Tags,
+	}
+}
+
+func TestSlashShortcutConformance(t *testing.T) {
+	conformance.RunShortcutConformance(t, func(t *testing.T, cfg conformance.ShortcutConfig) conformance.ShortcutService {
+		t.Helper()
+		return NewWithClient(fakeClientFromShortcutConfig(cfg))
+	})
+}
+
+// Compile-time check: Adapter satisfies the conformance shortcut contract.
+var _ conformance.ShortcutService = (*Adapter)(nil)
@@ -0,0 +1,11 @@
+package slash
+
+// Operation name constants for the Slash capability.
+const (
+	OpList   = "list"
+	OpGet    = "get"
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpDelete = "delete"
+	OpHealth = "health"
+)
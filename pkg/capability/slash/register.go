@@ -0,0 +1,211 @@
+package slash
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+)
+
+// Register registers the Slash capability with hub and invoker registry.
+// When svc is nil the provider is not configured and registration is skipped.
+func Register(app string, svc Service) error {
+	return capability.Register(buildSpec(app, svc))
+}
+
+// CatalogSpec returns capability metadata for documentation (handlers may close over a nil service and must not be invoked).
+func CatalogSpec() capability.Spec {
+	return buildSpec("", nil)
+}
+
+func buildSpec(app string, svc Service) capability.Spec {
+	return capability.Spec{
+		Type:        hub.CapSlash,
+		App:         app,
+		Description: "Link shortener capability for Slash",
+		Instance:    svc,
+		Ops: []capability.OpDef{
+			{
+				Name: OpList, Description: "List shortcuts", Scopes: []string{auth.ScopeServiceSlashRead},
+				Handler: invokeList(svc),
+			},
+			{
+				Name: OpGet, Description: "Get a shortcut", Scopes: []string{auth.ScopeServiceSlashRead},
+				Input:   []hub.ParamDef{{Name: "id", Type: "int64", Required: true, Description: "Shortcut ID"}},
+				Handler: invokeGet(svc),
+			},
+			{
+				Name: OpCreate, Description: "Create a shortcut", Scopes: []string{auth.ScopeServiceSlashWrite}, Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "name", Type: "string", Required: true, Description: "Short name used in the s/<name> URL"},
+					{Name: "link", Type: "string", Required: true, Description: "Target URL"},
+					{Name: "title", Type: "string", Required: false, Description: "Display title"},
+					{Name: "description", Type: "string", Required: false, Description: "Description"},
+					{Name: "tags", Type: "[]string", Required: false, Description: "Tags"},
+					{Name: "visibility", Type: "string", Required: false, Description: "PRIVATE, WORKSPACE or PUBLIC"},
+				},
+				Handler: invokeCreate(svc, app),
+			},
+			{
+				Name: OpUpdate, Description: "Update a shortcut", Scopes: []string{auth.ScopeServiceSlashWrite}, Mutation: true,
+				Input: []hub.ParamDef{
+					{Name: "id", Type: "int64", Required: true, Description: "Shortcut ID"},
+					{Name: "name", Type: "string", Required: false, Description: "New short name"},
+					{Name: "link", Type: "string", Required: false, Description: "New target URL"},
+					{Name: "title", Type: "string", Required: false, Description: "New title"},
+					{Name: "description", Type: "string", Required: false, Description: "New description"},
+					{Name: "tags", Type: "[]string", Required: false, Description: "Replacement tags"},
+					{Name: "visibility", Type: "string", Required: false, Description: "PRIVATE, WORKSPACE or PUBLIC"},
+				},
+				Handler: invokeUpdate(svc, app),
+			},
+			{
+				Name: OpDelete, Description: "Delete a shortcut", Scopes: []string{auth.ScopeServiceSlashWrite}, Mutation: true,
+				Input:   []hub.ParamDef{{Name: "id", Type: "int64", Required: true, Description: "Shortcut ID"}},
+				Handler: invokeDelete(svc, app),
+			},
+			{
+				Name: OpHealth, Description: "Health check", Scopes: []string{auth.ScopeServiceSlashRead},
+				Handler: invokeHealth(svc),
+			},
+		},
+	}
+}
+
+func invokeList(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		items, err := svc.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: items}, nil
+	}
+}
+
+func invokeGet(svc Service) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredInt64(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		item, err := svc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: item}, nil
+	}
+}
+
+func invokeCreate(svc Service, app string) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		name, err := capability.RequiredString(params, "name")
+		if err != nil {
+			return nil, err
+		}
+		link, err := capability.RequiredString(params, "link")
+		if err != nil {
+			return nil, err
+		}
+		in := &capability.Shortcut{Name: name, Link: link, Tags: stringSlice(params["tags"])}
+		in.Title, _ = capability.StringParam(params, "title")
+		in.Description, _ = capability.StringParam(params, "description")
+		in.Visibility, _ = capability.StringParam(params, "visibility")
+		item, err := svc.Create(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{
+			Data: item,
+			Resource: &capability.ResourceMeta{
+				EntityID: strconv.FormatInt(item.ID, 10),
+				App:      app,
+			},
+		}, nil
+	}
+}
+
+func invokeUpdate(svc Service, app string) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredInt64(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		data := make(map[string]any)
+		for _, key := range []string{"name", "link", "title", "description", "visibility"} {
+			if value, ok := capability.StringParam(params, key); ok {
+				data[key] = value
+			}
+		}
+		if _, ok := params["tags"]; ok {
+			data["tags"] = stringSlice(params["tags"])
+		}
+		item, err := svc.Update(ctx, id, data)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{
+			Data: item,
+			Resource: &capability.ResourceMeta{
+				EntityID: strconv.FormatInt(id, 10),
+				App:      app,
+			},
+		}, nil
+	}
+}
+
+func invokeDelete(svc Service, app string) capability.Invoker {
+	return func(ctx context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		id, err := capability.RequiredInt64(params, "id")
+		if err != nil {
+			return nil, err
+		}
+		if err := svc.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{
+			Data: map[string]any{"deleted": id},
+			Resource: &capability.ResourceMeta{
+				EntityID: strconv.FormatInt(id, 10),
+				App:      app,
+			},
+		}, nil
+	}
+}
+
+func invokeHealth(svc Service) capability.Invoker {
+	return func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		ok, err := svc.HealthCheck(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &capability.InvokeResult{Data: ok}, nil
+	}
+}
+
+// stringSlice accepts a []string, a []any of strings, or a single
+// comma-separated string.
+func stringSlice(value any) []string {
+	var raw []string
+	switch v := value.(type) {
+	case []string:
+		raw = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	case string:
+		raw = strings.Split(v, ",")
+	}
+	out := make([]string, 0, len(raw))
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package slash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+)
+
+type mockService struct {
+	lastCreate *capability.Shortcut
+	lastData   map[string]any
+}
+
+func (*mockService) List(_ context.Context) ([]*capability.Shortcut, error) {
+	return []*capability.Shortcut{}, nil
+}
+func (*mockService) Get(_ context.Context, id int64) (*capability.Shortcut, error) {
+	return &capability.Shortcut{ID: id}, nil
+}
+func (m *mockService) Create(_ context.Context, in *capability.Shortcut) (*capability.Shortcut, error) {
+	m.lastCreate = in
+	out := *in
+	out.ID = 5
+	return &out, nil
+}
+func (m *mockService) Update(_ context.Context, id int64, data map[string]any) (*capability.Shortcut, error) {
+	m.lastData = data
+	return &capability.Shortcut{ID: id}, nil
+}
+func (*mockService) Delete(_ context.Context, _ int64) error     { return nil }
+func (*mockService) HealthCheck(_ context.Context) (bool, error) { return true, nil }
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  Service
+	}{
+		{name: "nil service skips registration", svc: nil},
+		{name: "valid service", svc: &mockService{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, Register("slash", tt.svc))
+		})
+	}
+}
+
+func TestRegister_Operations(t *testing.T) {
+	require.NoError(t, Register("slash", &mockService{}))
+	desc, ok := hub.Default.Get(hub.CapSlash)
+	require.True(t, ok)
+	assert.Equal(t, "slash", desc.App)
+	assert.Len(t, desc.Operations, 6)
+
+	opNames := make([]string, len(desc.Operations))
+	for i, op := range desc.Operations {
+		opNames[i] = op.Name
+	}
+	for _, op := range []string{OpList, OpGet, OpCreate, OpUpdate, OpDelete, OpHealth} {
+		assert.Contains(t, opNames, op)
+	}
+}
+
+func TestInvokeCreate(t *testing.T) {
+	svc := &mockService{}
+	res, err := invokeCreate(svc, "slash")(context.Background(), map[string]any{
+		"name": "go", "link": "https://go.dev", "tags": []any{"lang"}, "visibility": "PUBLIC",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, svc.lastCreate)
+	assert.Equal(t, []string{"lang"}, svc.lastCreate.Tags)
+	assert.Equal(t, "PUBLIC", svc.lastCreate.Visibility)
+	assert.Equal(t, "5", res.Resource.EntityID)
+
+	_, err = invokeCreate(svc, "slash")(context.Background(), map[string]any{"name": "go"})
+	assert.Error(t, err)
+}
+
+func TestInvokeUpdate_OnlyProvidedFields(t *testing.T) {
+	svc := &mockService{}
+	_, err := invokeUpdate(svc, "slash")(context.Background(), map[string]any{"id": float64(3), "link": "https://go.dev/doc"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"link": "https://go.dev/doc"}, svc.lastData)
+}
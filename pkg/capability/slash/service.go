@@ -0,0 +1,17 @@
+package slash
+
+import (
+	"context"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// Service defines the Slash link shortener capability contract.
+type Service interface {
+	List(ctx context.Context) ([]*capability.Shortcut, error)
+	Get(ctx context.Context, id int64) (*capability.Shortcut, error)
+	Create(ctx context.Context, in *capability.Shortcut) (*capability.Shortcut, error)
+	Update(ctx context.Context, id int64, data map[string]any) (*capability.Shortcut, error)
+	Delete(ctx context.Context, id int64) error
+	HealthCheck(ctx context.Context) (bool, error)
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// AdguardClient provides access to the AdGuard Home capability API.
+type AdguardClient struct {
+	c *Client
+}
+
+// AdguardQueryLogQuery holds optional query parameters for searching the query log.
+type AdguardQueryLogQuery struct {
+	Limit  int
+	Cursor string
+	Search string
+	Status string
+}
+
+// AdguardProtectionRequest is the request body for toggling DNS protection.
+type AdguardProtectionRequest struct {
+	Enabled  bool   `json:"enabled"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// AdguardFilteringRequest is the request body for toggling filter lists.
+type AdguardFilteringRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// AdguardStatusResult holds the server status extracted from InvokeResult.
+type AdguardStatusResult struct {
+	Item capability.DNSStatus `json:"data"`
+}
+
+// AdguardStatsResult holds query counters extracted from InvokeResult.
+type AdguardStatsResult struct {
+	Item capability.DNSStats `json:"data"`
+}
+
+// AdguardQueryLogResult holds query log entries extracted from InvokeResult.
+type AdguardQueryLogResult struct {
+	Items []*capability.DNSQuery `json:"data"`
+	Page  *capability.PageInfo   `json:"page"`
+}
+
+// AdguardToggleResult holds the toggle confirmation extracted from InvokeResult.
+type AdguardToggleResult struct {
+	Data map[string]any `json:"data"`
+}
+
+// AdguardHealthResult holds the health check result extracted from InvokeResult.
+type AdguardHealthResult struct {
+	Healthy bool `json:"data"`
+}
+
+// Status returns whether the DNS server is running and protection is enabled.
+func (a *AdguardClient) Status(ctx context.Context) (*capability.DNSStatus, error) {
+	var result AdguardStatusResult
+	if err := a.c.Get(ctx, "/service/adguard/status", &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// Stats returns DNS query counters for the configured stats window.
+func (a *AdguardClient) Stats(ctx context.Context) (*capability.DNSStats, error) {
+	var result AdguardStatsResult
+	if err := a.c.Get(ctx, "/service/adguard/stats", &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// QueryLog searches the DNS query log, newest first.
+func (a *AdguardClient) QueryLog(ctx context.Context, q AdguardQueryLogQuery) (*AdguardQueryLogResult, error) {
+	values := url.Values{}
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Cursor != "" {
+		values.Set("cursor", q.Cursor)
+	}
+	if q.Search != "" {
+		values.Set("search", q.Search)
+	}
+	if q.Status != "" {
+		values.Set("status", q.Status)
+	}
+	path := "/service/adguard/querylog"
+	if encoded := values.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+	var result AdguardQueryLogResult
+	if err := a.c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetProtection enables or disables DNS protection. A non-empty duration
+// (Go duration syntax) only applies when disabling.
+func (a *AdguardClient) SetProtection(ctx context.Context, enabled bool, duration string) error {
+	var result AdguardToggleResult
+	return a.c.Post(ctx, "/service/adguard/protection", &AdguardProtectionRequest{Enabled: enabled, Duration: duration}, &result)
+}
+
+// SetFiltering enables or disables filter lists.
+func (a *AdguardClient) SetFiltering(ctx context.Context, enabled bool) error {
+	var result AdguardToggleResult
+	return a.c.Post(ctx, "/service/adguard/filtering", &AdguardFilteringRequest{Enabled: enabled}, &result)
+}
+
+// Health checks whether the AdGuard Home backend is reachable.
+func (a *AdguardClient) Health(ctx context.Context) (bool, error) {
+	var result AdguardHealthResult
+	if err := a.c.Get(ctx, "/service/adguard/health", &result); err != nil {
+		return false, err
+	}
+	return result.Healthy, nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdguardClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		fn         func(*Client) error
+		handler    http.HandlerFunc
+		wantErr    bool
+		errContain string
+	}{
+		{
+			name: "status success",
+			fn: func(c *Client) error {
+				st, err := c.Adguard.Status(context.Background())
+				if err != nil {
+					return err
+				}
+				assert.True(t, st.ProtectionEnabled)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/adguard/status", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"running":true,"protection_enabled":true}}}`))
+			},
+		},
+		{
+			name: "query log with filters",
+			fn: func(c *Client) error {
+				got, err := c.Adguard.QueryLog(context.Background(), AdguardQueryLogQuery{Limit: 10, Search: "ads", Status: "blocked"})
+				if err != nil {
+					return err
+				}
+				require.Len(t, got.Items, 1)
+				assert.True(t, got.Items[0].Blocked)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/adguard/querylog", r.URL.Path)
+				assert.Equal(t, "10", r.URL.Query().Get("limit"))
+				assert.Equal(t, "ads", r.URL.Query().Get("search"))
+				assert.Equal(t, "blocked", r.URL.Query().Get("status"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":[{"domain":"ads.example","client":"10.0.0.2","blocked":true}],"page":{"limit":10,"has_more":false}}}`))
+			},
+		},
+		{
+			name: "set protection",
+			fn: func(c *Client) error {
+				return c.Adguard.SetProtection(context.Background(), false, "10m")
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/service/adguard/protection", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"protection_enabled":false}}}`))
+			},
+		},
+		{
+			name: "api error",
+			fn: func(c *Client) error {
+				_, err := c.Adguard.Stats(context.Background())
+				return err
+			},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"status":"failed","message":"server error"}`))
+			},
+			wantErr:    true,
+			errContain: "server error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.handler != nil {
+					tt.handler(w, r)
+				}
+			}))
+			defer server.Close()
+			c := NewClient(server.URL, "token")
+			err := tt.fn(c)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContain != "" {
+					assert.Contains(t, err.Error(), tt.errContain)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
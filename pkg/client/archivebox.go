@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// ArchiveboxClient provides access to the ArchiveBox capability API.
+type ArchiveboxClient struct {
+	c *Client
+}
+
+// ArchiveboxAddRequest is the request body for archiving URLs.
+type ArchiveboxAddRequest struct {
+	URLs []string `json:"urls"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ArchiveboxListQuery holds optional query parameters for listing snapshots.
+type ArchiveboxListQuery struct {
+	Limit  int
+	Cursor string
+	Search string
+	Tag    string
+}
+
+// ArchiveboxAddResult holds the queued URLs extracted from InvokeResult.
+type ArchiveboxAddResult struct {
+	Data struct {
+		URLs []string `json:"urls"`
+	} `json:"data"`
+}
+
+// ArchiveboxSnapshotsResult holds snapshots extracted from InvokeResult.
+type ArchiveboxSnapshotsResult struct {
+	Items []*capability.ArchiveItem `json:"data"`
+	Page  *capability.PageInfo      `json:"page"`
+}
+
+// ArchiveboxSnapshotResult holds a single snapshot extracted from InvokeResult.
+type ArchiveboxSnapshotResult struct {
+	Item capability.ArchiveItem `json:"data"`
+}
+
+// ArchiveboxHealthResult holds the health check result extracted from InvokeResult.
+type ArchiveboxHealthResult struct {
+	Healthy bool `json:"data"`
+}
+
+// Add queues URLs for archiving and returns the URLs ArchiveBox accepted.
+func (a *ArchiveboxClient) Add(ctx context.Context, urls, tags []string) ([]string, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("urls are required")
+	}
+	var result ArchiveboxAddResult
+	if err := a.c.Post(ctx, "/service/archivebox/snapshots", &ArchiveboxAddRequest{URLs: urls, Tags: tags}, &result); err != nil {
+		return nil, err
+	}
+	return result.Data.URLs, nil
+}
+
+// List returns archived snapshots, newest first.
+func (a *ArchiveboxClient) List(ctx context.Context, q ArchiveboxListQuery) (*ArchiveboxSnapshotsResult, error) {
+	if q.Limit < 0 {
+		return nil, fmt.Errorf("limit must be non-negative")
+	}
+	values := url.Values{}
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Cursor != "" {
+		values.Set("cursor", q.Cursor)
+	}
+	if q.Search != "" {
+		values.Set("search", q.Search)
+	}
+	if q.Tag != "" {
+		values.Set("tag", q.Tag)
+	}
+	path := "/service/archivebox/snapshots"
+	if encoded := values.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+	var result ArchiveboxSnapshotsResult
+	if err := a.c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Get returns a single snapshot by its ArchiveBox ID.
+func (a *ArchiveboxClient) Get(ctx context.Context, id string) (*capability.ArchiveItem, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	var result ArchiveboxSnapshotResult
+	if err := a.c.Get(ctx, "/service/archivebox/snapshots/"+url.PathEscape(id), &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// Health checks whether the ArchiveBox backend is reachable.
+func (a *ArchiveboxClient) Health(ctx context.Context) (bool, error) {
+	var result ArchiveboxHealthResult
+	if err := a.c.Get(ctx, "/service/archivebox/health", &result); err != nil {
+		return false, err
+	}
+	return result.Healthy, nil
+}
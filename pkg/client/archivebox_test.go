@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveboxClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		fn         func(*Client) error
+		handler    http.HandlerFunc
+		wantErr    bool
+		errContain string
+	}{
+		{
+			name: "add success",
+			fn: func(c *Client) error {
+				urls, err := c.Archivebox.Add(context.Background(), []string{"https://go.dev"}, []string{"go"})
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, []string{"https://go.dev"}, urls)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/service/archivebox/snapshots", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"urls":["https://go.dev"]}}}`))
+			},
+		},
+		{
+			name: "add missing urls",
+			fn: func(c *Client) error {
+				_, err := c.Archivebox.Add(context.Background(), nil, nil)
+				return err
+			},
+			wantErr:    true,
+			errContain: "urls are required",
+		},
+		{
+			name: "list with query",
+			fn: func(c *Client) error {
+				got, err := c.Archivebox.List(context.Background(), ArchiveboxListQuery{Limit: 5, Tag: "go"})
+				if err != nil {
+					return err
+				}
+				assert.Len(t, got.Items, 1)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "5", r.URL.Query().Get("limit"))
+				assert.Equal(t, "go", r.URL.Query().Get("tag"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":[{"id":"1700000000.0","url":"https://go.dev","status":"archived"}],"page":{"limit":5,"has_more":false}}}`))
+			},
+		},
+		{
+			name: "get success",
+			fn: func(c *Client) error {
+				item, err := c.Archivebox.Get(context.Background(), "1700000000.0")
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, "https://go.dev", item.URL)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/archivebox/snapshots/1700000000.0", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"id":"1700000000.0","url":"https://go.dev","status":"archived"}}}`))
+			},
+		},
+		{
+			name: "get missing id",
+			fn: func(c *Client) error {
+				_, err := c.Archivebox.Get(context.Background(), "")
+				return err
+			},
+			wantErr:    true,
+			errContain: "id is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.handler != nil {
+					tt.handler(w, r)
+				}
+			}))
+			defer server.Close()
+			c := NewClient(server.URL, "token")
+			err := tt.fn(c)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContain != "" {
+					assert.Contains(t, err.Error(), tt.errContain)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
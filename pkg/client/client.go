@@ -56,6 +56,11 @@ type Client struct {
 	Email        *EmailClient
 	Nocodb       *NocodbClient
 	Devops       *DevopsClient
+	Adguard      *AdguardClient
+	Archivebox   *ArchiveboxClient
+	N8n          *N8nClient
+	Slash        *SlashClient
+	MCP          *MCPClient
 }
 
 // NewClient creates a new client with the given server URL and access token.
@@ -96,6 +101,11 @@ func NewClient(serverURL, token string) *Client {
 	c.Email = &EmailClient{c: c}
 	c.Nocodb = &NocodbClient{c: c}
 	c.Devops = &DevopsClient{c: c}
+	c.Adguard = &AdguardClient{c: c}
+	c.Archivebox = &ArchiveboxClient{c: c}
+	c.N8n = &N8nClient{c: c}
+	c.Slash = &SlashClient{c: c}
+	c.MCP = &MCPClient{c: c}
 
 	return c
 }
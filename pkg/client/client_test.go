@@ -67,6 +67,10 @@ func TestNewClient(t *testing.T) {
 			assert.NotNil(t, c.Transmission)
 			assert.NotNil(t, c.Nocodb)
 			assert.NotNil(t, c.Devops)
+			assert.NotNil(t, c.Adguard)
+			assert.NotNil(t, c.Archivebox)
+			assert.NotNil(t, c.N8n)
+			assert.NotNil(t, c.Slash)
 		})
 	}
 }
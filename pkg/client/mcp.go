@@ -0,0 +1,41 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MCPClient connects to the server's /mcp endpoint.
+type MCPClient struct {
+	c *Client
+}
+
+// Transport returns an MCP streamable HTTP transport for the server's /mcp
+// endpoint that authenticates with the client's token. timeout bounds each
+// HTTP request; zero means no limit.
+func (m *MCPClient) Transport(timeout time.Duration) sdk.Transport {
+	return &sdk.StreamableClientTransport{
+		Endpoint: strings.TrimRight(m.c.baseURL, "/") + "/mcp",
+		HTTPClient: &http.Client{
+			Timeout:   timeout,
+			Transport: tokenTransport{token: m.c.token, base: http.DefaultTransport},
+		},
+		// The server never initiates messages, so there is no stream to open.
+		DisableStandaloneSSE: true,
+	}
+}
+
+// tokenTransport sets the access token header on every request.
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("X-AccessToken", t.token)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMCPTransport(t *testing.T) {
+	t.Parallel()
+	srv := sdk.NewServer(&sdk.Implementation{Name: "flowbot"}, &sdk.ServerOptions{HasTools: true})
+	handler := sdk.NewStreamableHTTPHandler(func(*http.Request) *sdk.Server { return srv }, &sdk.StreamableHTTPOptions{Stateless: true, JSONResponse: true})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mcp", r.URL.Path)
+		if r.Header.Get("X-AccessToken") != "tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	session, err := sdk.NewClient(&sdk.Implementation{Name: "test"}, nil).
+		Connect(context.Background(), NewClient(ts.URL, "tok").MCP.Transport(0), nil)
+	require.NoError(t, err)
+	defer func() { _ = session.Close() }()
+	res, err := session.ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, res.Tools)
+
+	_, err = sdk.NewClient(&sdk.Implementation{Name: "test"}, nil).
+		Connect(context.Background(), NewClient(ts.URL, "wrong").MCP.Transport(0), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// N8nClient provides access to the n8n capability API.
+type N8nClient struct {
+	c *Client
+}
+
+// N8nTriggerRequest is the request body for triggering a workflow.
+type N8nTriggerRequest struct {
+	Data map[string]any `json:"data,omitempty"`
+}
+
+// N8nExecutionsQuery holds optional query parameters for listing executions.
+type N8nExecutionsQuery struct {
+	Limit      int
+	Cursor     string
+	WorkflowID string
+	Status     string
+}
+
+// N8nWorkflowsResult holds workflows extracted from InvokeResult.
+type N8nWorkflowsResult struct {
+	Items []*capability.AutomationWorkflow `json:"data"`
+}
+
+// N8nWorkflowResult holds a single workflow extracted from InvokeResult.
+type N8nWorkflowResult struct {
+	Item capability.AutomationWorkflow `json:"data"`
+}
+
+// N8nExecutionsResult holds executions extracted from InvokeResult.
+type N8nExecutionsResult struct {
+	Items []*capability.AutomationExecution `json:"data"`
+	Page  *capability.PageInfo              `json:"page"`
+}
+
+// N8nActionResult holds an action confirmation extracted from InvokeResult.
+type N8nActionResult struct {
+	Data map[string]any `json:"data"`
+}
+
+// N8nHealthResult holds the health check result extracted from InvokeResult.
+type N8nHealthResult struct {
+	Healthy bool `json:"data"`
+}
+
+// ListWorkflows returns all n8n workflows.
+func (n *N8nClient) ListWorkflows(ctx context.Context) ([]*capability.AutomationWorkflow, error) {
+	var result N8nWorkflowsResult
+	if err := n.c.Get(ctx, "/service/n8n/workflows", &result); err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+// GetWorkflow returns a single workflow.
+func (n *N8nClient) GetWorkflow(ctx context.Context, id string) (*capability.AutomationWorkflow, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	var result N8nWorkflowResult
+	if err := n.c.Get(ctx, "/service/n8n/workflows/"+url.PathEscape(id), &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// SetWorkflowActive activates or deactivates a workflow.
+func (n *N8nClient) SetWorkflowActive(ctx context.Context, id string, active bool) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+	action := "deactivate"
+	if active {
+		action = "activate"
+	}
+	var result N8nActionResult
+	return n.c.Post(ctx, "/service/n8n/workflows/"+url.PathEscape(id)+"/"+action, nil, &result)
+}
+
+// TriggerWorkflow starts a workflow run with optional input data.
+func (n *N8nClient) TriggerWorkflow(ctx context.Context, id string, data map[string]any) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+	var result N8nActionResult
+	return n.c.Post(ctx, "/service/n8n/workflows/"+url.PathEscape(id)+"/trigger", &N8nTriggerRequest{Data: data}, &result)
+}
+
+// ListExecutions returns workflow executions, newest first.
+func (n *N8nClient) ListExecutions(ctx context.Context, q N8nExecutionsQuery) (*N8nExecutionsResult, error) {
+	if q.Limit < 0 {
+		return nil, fmt.Errorf("limit must be non-negative")
+	}
+	values := url.Values{}
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Cursor != "" {
+		values.Set("cursor", q.Cursor)
+	}
+	if q.WorkflowID != "" {
+		values.Set("workflow_id", q.WorkflowID)
+	}
+	if q.Status != "" {
+		values.Set("status", q.Status)
+	}
+	path := "/service/n8n/executions"
+	if encoded := values.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+	var result N8nExecutionsResult
+	if err := n.c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Health checks whether the n8n backend is reachable.
+func (n *N8nClient) Health(ctx context.Context) (bool, error) {
+	var result N8nHealthResult
+	if err := n.c.Get(ctx, "/service/n8n/health", &result); err != nil {
+		return false, err
+	}
+	return result.Healthy, nil
+}
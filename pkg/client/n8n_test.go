@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestN8nClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		fn         func(*Client) error
+		handler    http.HandlerFunc
+		wantErr    bool
+		errContain string
+	}{
+		{
+			name: "list workflows",
+			fn: func(c *Client) error {
+				items, err := c.N8n.ListWorkflows(context.Background())
+				if err != nil {
+					return err
+				}
+				require.Len(t, items, 1)
+				assert.Equal(t, "Backup", items[0].Name)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/n8n/workflows", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":[{"id":"1","name":"Backup","active":true}]}}`))
+			},
+		},
+		{
+			name: "activate workflow",
+			fn: func(c *Client) error {
+				return c.N8n.SetWorkflowActive(context.Background(), "1", true)
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/service/n8n/workflows/1/activate", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"id":"1","active":true}}}`))
+			},
+		},
+		{
+			name: "deactivate workflow",
+			fn: func(c *Client) error {
+				return c.N8n.SetWorkflowActive(context.Background(), "1", false)
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/n8n/workflows/1/deactivate", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"id":"1","active":false}}}`))
+			},
+		},
+		{
+			name: "trigger workflow",
+			fn: func(c *Client) error {
+				return c.N8n.TriggerWorkflow(context.Background(), "1", map[string]any{"k": "v"})
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/n8n/workflows/1/trigger", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"id":"1","triggered":true}}}`))
+			},
+		},
+		{
+			name: "trigger missing id",
+			fn: func(c *Client) error {
+				return c.N8n.TriggerWorkflow(context.Background(), "", nil)
+			},
+			wantErr:    true,
+			errContain: "id is required",
+		},
+		{
+			name: "list executions with filters",
+			fn: func(c *Client) error {
+				got, err := c.N8n.ListExecutions(context.Background(), N8nExecutionsQuery{WorkflowID: "1", Status: "error"})
+				if err != nil {
+					return err
+				}
+				assert.Len(t, got.Items, 1)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "1", r.URL.Query().Get("workflow_id"))
+				assert.Equal(t, "error", r.URL.Query().Get("status"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":[{"id":"10","workflow_id":"1","status":"error"}],"page":{"limit":50,"has_more":false}}}`))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.handler != nil {
+					tt.handler(w, r)
+				}
+			}))
+			defer server.Close()
+			c := NewClient(server.URL, "token")
+			err := tt.fn(c)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContain != "" {
+					assert.Contains(t, err.Error(), tt.errContain)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -39,8 +39,10 @@ type PipelineExportResult struct {
 }
 
 // PipelineRunResult is returned when starting an asynchronous run.
+// SourceRunID is set when the run re-runs an earlier one.
 type PipelineRunResult struct {
-	RunID int64 `json:"run_id"`
+	RunID       int64 `json:"run_id"`
+	SourceRunID int64 `json:"source_run_id,omitempty"`
 }
 
 // PipelineRunInfo is a single run history entry.
@@ -116,3 +118,25 @@ func (p *PipelineClient) Runs(ctx context.Context, name string) (*PipelineRunsRe
 	err := p.c.Get(ctx, path, &result)
 	return &result, err
 }
+
+// Cancel stops a running pipeline run.
+func (p *PipelineClient) Cancel(ctx context.Context, runID int64) error {
+	var result map[string]any
+	return p.c.Post(ctx, fmt.Sprintf("/service/pipeline/cancel/%d", runID), nil, &result)
+}
+
+// Retry resumes a failed or cancelled run of a resumable pipeline from the
+// step that stopped it. Completed steps are not executed again.
+func (p *PipelineClient) Retry(ctx context.Context, runID int64) (*PipelineRunResult, error) {
+	var result PipelineRunResult
+	err := p.c.Post(ctx, fmt.Sprintf("/service/pipeline/retry/%d", runID), nil, &result)
+	return &result, err
+}
+
+// Rerun starts a new run of the same pipeline with the event runID was
+// triggered by.
+func (p *PipelineClient) Rerun(ctx context.Context, runID int64) (*PipelineRunResult, error) {
+	var result PipelineRunResult
+	err := p.c.Post(ctx, fmt.Sprintf("/service/pipeline/rerun/%d", runID), nil, &result)
+	return &result, err
+}
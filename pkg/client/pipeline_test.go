@@ -157,3 +157,74 @@ func TestPipelineApply(t *testing.T) {
 	assert.Equal(t, "demo", result.Name)
 	assert.Equal(t, 3, result.Version)
 }
+
+func TestPipelineRunControl(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		wantPath string
+		status   int
+		body     string
+		call     func(t *testing.T, c *Client)
+	}{
+		{
+			name:     "cancel",
+			wantPath: "/service/pipeline/cancel/42",
+			status:   http.StatusOK,
+			body:     `{"status":"ok","data":{"run_id":42,"cancelled":true}}`,
+			call: func(t *testing.T, c *Client) {
+				require.NoError(t, c.Pipeline.Cancel(context.Background(), 42))
+			},
+		},
+		{
+			name:     "retry",
+			wantPath: "/service/pipeline/retry/42",
+			status:   http.StatusAccepted,
+			body:     `{"status":"ok","data":{"run_id":42}}`,
+			call: func(t *testing.T, c *Client) {
+				res, err := c.Pipeline.Retry(context.Background(), 42)
+				require.NoError(t, err)
+				assert.Equal(t, int64(42), res.RunID)
+			},
+		},
+		{
+			name:     "rerun",
+			wantPath: "/service/pipeline/rerun/42",
+			status:   http.StatusAccepted,
+			body:     `{"status":"ok","data":{"run_id":43,"source_run_id":42}}`,
+			call: func(t *testing.T, c *Client) {
+				res, err := c.Pipeline.Rerun(context.Background(), 42)
+				require.NoError(t, err)
+				assert.Equal(t, int64(43), res.RunID)
+				assert.Equal(t, int64(42), res.SourceRunID)
+			},
+		},
+		{
+			name:     "retry not resumable",
+			wantPath: "/service/pipeline/retry/42",
+			status:   http.StatusBadRequest,
+			body:     `{"status":"failed","message":"pipeline demo is not resumable; re-run it instead"}`,
+			call: func(t *testing.T, c *Client) {
+				_, err := c.Pipeline.Retry(context.Background(), 42)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "not resumable")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			tt.call(t, NewClient(server.URL, "token"))
+		})
+	}
+}
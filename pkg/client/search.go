@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/validate"
@@ -29,10 +30,7 @@ func (s *SearchClient) Search(ctx context.Context, query, source string) ([]Sear
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/service/search/query?q=%s", query)
-	if source != "" {
-		path += fmt.Sprintf("&source=%s", source)
-	}
+	path := searchPath("query", query, source)
 
 	var result types.KV
 	err := s.c.Get(ctx, path, &result)
@@ -43,6 +41,15 @@ func (s *SearchClient) Search(ctx context.Context, query, source string) ([]Sear
 	return extractSearchResults(result), nil
 }
 
+// searchPath builds a search endpoint path with escaped query parameters.
+func searchPath(endpoint, query, source string) string {
+	v := url.Values{"q": {query}}
+	if source != "" {
+		v.Set("source", source)
+	}
+	return "/service/search/" + endpoint + "?" + v.Encode()
+}
+
 func validateSearchQuery(query string) error {
 	if query == "" {
 		return fmt.Errorf("query is required")
@@ -60,10 +67,7 @@ func (s *SearchClient) Autocomplete(ctx context.Context, query, source string) (
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/service/search/autocomplete?q=%s", query)
-	if source != "" {
-		path += fmt.Sprintf("&source=%s", source)
-	}
+	path := searchPath("autocomplete", query, source)
 
 	var result types.KV
 	err := s.c.Get(ctx, path, &result)
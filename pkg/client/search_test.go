@@ -52,6 +52,21 @@ func TestSearchSearch(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name:   "query parameters are escaped",
+			query:  "go & rust #1",
+			source: "memo,clip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("q") != "go & rust #1" || r.URL.Query().Get("source") != "memo,clip" {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"status":"failed","message":"bad query ` + r.URL.RawQuery + `"}`))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"hits":[{"id":"1","title":"result1","source":"memo"}]}}`))
+			},
+			wantCount: 1,
+		},
 		{
 			name:   "search no results",
 			query:  "no-match",
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+)
+
+// SlashClient provides access to the Slash link shortener capability API.
+type SlashClient struct {
+	c *Client
+}
+
+// SlashShortcutRequest is the request body for creating or updating a shortcut.
+// Nil fields are left unchanged on update.
+type SlashShortcutRequest struct {
+	Name        *string  `json:"name,omitempty"`
+	Link        *string  `json:"link,omitempty"`
+	Title       *string  `json:"title,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Visibility  *string  `json:"visibility,omitempty"`
+}
+
+// SlashShortcutsResult holds shortcuts extracted from InvokeResult.
+type SlashShortcutsResult struct {
+	Items []*capability.Shortcut `json:"data"`
+}
+
+// SlashShortcutResult holds a single shortcut extracted from InvokeResult.
+type SlashShortcutResult struct {
+	Item capability.Shortcut `json:"data"`
+}
+
+// SlashDeleteResult holds delete confirmation extracted from InvokeResult.
+type SlashDeleteResult struct {
+	Data map[string]any `json:"data"`
+}
+
+// SlashHealthResult holds the health check result extracted from InvokeResult.
+type SlashHealthResult struct {
+	Healthy bool `json:"data"`
+}
+
+// List returns all shortcuts visible to the configured Slash account.
+func (s *SlashClient) List(ctx context.Context) ([]*capability.Shortcut, error) {
+	var result SlashShortcutsResult
+	if err := s.c.Get(ctx, "/service/slash/shortcuts", &result); err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+// Get returns a single shortcut.
+func (s *SlashClient) Get(ctx context.Context, id int64) (*capability.Shortcut, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("id must be positive")
+	}
+	var result SlashShortcutResult
+	if err := s.c.Get(ctx, "/service/slash/shortcuts/"+strconv.FormatInt(id, 10), &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// Create creates a shortcut. Name and Link are required.
+func (s *SlashClient) Create(ctx context.Context, req SlashShortcutRequest) (*capability.Shortcut, error) {
+	if req.Name == nil || *req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.Link == nil || *req.Link == "" {
+		return nil, fmt.Errorf("link is required")
+	}
+	var result SlashShortcutResult
+	if err := s.c.Post(ctx, "/service/slash/shortcuts", &req, &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// Update changes the non-nil fields of a shortcut.
+func (s *SlashClient) Update(ctx context.Context, id int64, req SlashShortcutRequest) (*capability.Shortcut, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("id must be positive")
+	}
+	var result SlashShortcutResult
+	if err := s.c.Patch(ctx, "/service/slash/shortcuts/"+strconv.FormatInt(id, 10), &req, &result); err != nil {
+		return nil, err
+	}
+	return &result.Item, nil
+}
+
+// Delete removes a shortcut.
+func (s *SlashClient) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("id must be positive")
+	}
+	var result SlashDeleteResult
+	return s.c.Delete(ctx, "/service/slash/shortcuts/"+strconv.FormatInt(id, 10), nil, &result)
+}
+
+// Health checks whether the Slash backend is reachable.
+func (s *SlashClient) Health(ctx context.Context) (bool, error) {
+	var result SlashHealthResult
+	if err := s.c.Get(ctx, "/service/slash/health", &result); err != nil {
+		return false, err
+	}
+	return result.Healthy, nil
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		fn         func(*Client) error
+		handler    http.HandlerFunc
+		wantErr    bool
+		errContain string
+	}{
+		{
+			name: "list success",
+			fn: func(c *Client) error {
+				items, err := c.Slash.List(context.Background())
+				if err != nil {
+					return err
+				}
+				assert.Len(t, items, 1)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/slash/shortcuts", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":[{"id":1,"name":"go","link":"https://go.dev"}]}}`))
+			},
+		},
+		{
+			name: "create success",
+			fn: func(c *Client) error {
+				name, link := "go", "https://go.dev"
+				item, err := c.Slash.Create(context.Background(), SlashShortcutRequest{Name: &name, Link: &link})
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, int64(1), item.ID)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"id":1,"name":"go","link":"https://go.dev"}}}`))
+			},
+		},
+		{
+			name: "create missing link",
+			fn: func(c *Client) error {
+				name := "go"
+				_, err := c.Slash.Create(context.Background(), SlashShortcutRequest{Name: &name})
+				return err
+			},
+			wantErr:    true,
+			errContain: "link is required",
+		},
+		{
+			name: "update success",
+			fn: func(c *Client) error {
+				title := "Go"
+				item, err := c.Slash.Update(context.Background(), 1, SlashShortcutRequest{Title: &title})
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, "Go", item.Title)
+				return nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "/service/slash/shortcuts/1", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"id":1,"name":"go","link":"https://go.dev","title":"Go"}}}`))
+			},
+		},
+		{
+			name: "delete success",
+			fn: func(c *Client) error {
+				return c.Slash.Delete(context.Background(), 1)
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"data":{"deleted":1}}}`))
+			},
+		},
+		{
+			name: "delete invalid id",
+			fn: func(c *Client) error {
+				return c.Slash.Delete(context.Background(), 0)
+			},
+			wantErr:    true,
+			errContain: "id must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.handler != nil {
+					tt.handler(w, r)
+				}
+			}))
+			defer server.Close()
+			c := NewClient(server.URL, "token")
+			err := tt.fn(c)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContain != "" {
+					assert.Contains(t, err.Error(), tt.errContain)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
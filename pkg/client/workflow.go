@@ -39,8 +39,10 @@ type WorkflowExportResult struct {
 }
 
 // WorkflowRunResult is returned when starting an asynchronous run.
+// SourceRunID is set when the run re-runs an earlier one.
 type WorkflowRunResult struct {
-	RunID int64 `json:"run_id"`
+	RunID       int64 `json:"run_id"`
+	SourceRunID int64 `json:"source_run_id,omitempty"`
 }
 
 // WorkflowRunInfo is a single run history entry.
@@ -117,3 +119,25 @@ func (w *WorkflowClient) Runs(ctx context.Context, name string) (*WorkflowRunsRe
 	err := w.c.Get(ctx, path, &result)
 	return &result, err
 }
+
+// Cancel stops a running workflow run.
+func (w *WorkflowClient) Cancel(ctx context.Context, runID int64) error {
+	var result map[string]any
+	return w.c.Post(ctx, fmt.Sprintf("/service/workflow/cancel/%d", runID), nil, &result)
+}
+
+// Retry resumes a failed or cancelled run of a resumable workflow from the
+// task that stopped it. Completed tasks are not executed again.
+func (w *WorkflowClient) Retry(ctx context.Context, runID int64) (*WorkflowRunResult, error) {
+	var result WorkflowRunResult
+	err := w.c.Post(ctx, fmt.Sprintf("/service/workflow/retry/%d", runID), nil, &result)
+	return &result, err
+}
+
+// Rerun starts a new run of the same workflow with the inputs runID was
+// started with.
+func (w *WorkflowClient) Rerun(ctx context.Context, runID int64) (*WorkflowRunResult, error) {
+	var result WorkflowRunResult
+	err := w.c.Post(ctx, fmt.Sprintf("/service/workflow/rerun/%d", runID), nil, &result)
+	return &result, err
+}
@@ -120,6 +120,49 @@ func TestWorkflowClient_ApplyListGetExportDeleteRunRuns(t *testing.T) {
 				assert.Equal(t, "demo", res["name"])
 			},
 		},
+		{
+			name:       "cancel run",
+			method:     http.MethodPost,
+			pathPrefix: "/service/workflow/cancel/7",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"run_id":7,"cancelled":true}}`))
+			},
+			call: func(t *testing.T, c *Client) {
+				require.NoError(t, c.Workflow.Cancel(context.Background(), 7))
+			},
+		},
+		{
+			name:       "retry run",
+			method:     http.MethodPost,
+			pathPrefix: "/service/workflow/retry/7",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"run_id":7}}`))
+			},
+			call: func(t *testing.T, c *Client) {
+				res, err := c.Workflow.Retry(context.Background(), 7)
+				require.NoError(t, err)
+				assert.Equal(t, int64(7), res.RunID)
+			},
+		},
+		{
+			name:       "rerun returns new run_id",
+			method:     http.MethodPost,
+			pathPrefix: "/service/workflow/rerun/7",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"status":"ok","data":{"run_id":8,"source_run_id":7}}`))
+			},
+			call: func(t *testing.T, c *Client) {
+				res, err := c.Workflow.Rerun(context.Background(), 7)
+				require.NoError(t, err)
+				assert.Equal(t, int64(8), res.RunID)
+				assert.Equal(t, int64(7), res.SourceRunID)
+			},
+		},
 		{
 			name:       "run validation error",
 			method:     http.MethodPost,
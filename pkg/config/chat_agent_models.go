@@ -22,8 +22,42 @@ func ModelRegistered(modelName string) bool {
 }
 
 // ModelProviderFor returns the provider for a registered model name, or "" if unknown.
+// An alias reports the provider of the first entry in its chain.
 func ModelProviderFor(modelName string) string {
-	return providerForModelInList(App.Models, modelName)
+	return providerForModel(App.Models, App.ModelAliases, modelName)
+}
+
+// ModelAliasFor returns the model_aliases entry named name.
+func ModelAliasFor(name string) (ModelAlias, bool) {
+	return modelAliasInList(App.ModelAliases, name)
+}
+
+// CatalogModelName returns the model whose catalog metadata describes
+// modelName: the first chain entry for an alias, otherwise modelName itself.
+func CatalogModelName(modelName string) string {
+	if alias, ok := ModelAliasFor(modelName); ok && len(alias.Chain) > 0 {
+		return alias.Chain[0]
+	}
+	return modelName
+}
+
+func modelAliasInList(aliases []ModelAlias, name string) (ModelAlias, bool) {
+	for _, alias := range aliases {
+		if alias.Name == name {
+			return alias, true
+		}
+	}
+	return ModelAlias{}, false
+}
+
+func providerForModel(models []Model, aliases []ModelAlias, modelName string) string {
+	if alias, ok := modelAliasInList(aliases, modelName); ok {
+		if len(alias.Chain) == 0 {
+			return ""
+		}
+		return providerForModelInList(models, alias.Chain[0])
+	}
+	return providerForModelInList(models, modelName)
 }
 
 func providerForModelInList(models []Model, modelName string) string {
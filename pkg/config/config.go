@@ -44,7 +44,9 @@ type Type struct {
 
 	// Postgres is the primary database configuration (YAML key: postgres).
 	Postgres PostgresConfig `json:"postgres" yaml:"postgres" mapstructure:"postgres"`
-	// Store is the normalized internal store view populated by Normalize from Postgres.
+	// SQLite is the embedded single-file database; setting sqlite.path selects it instead of Postgres.
+	SQLite SQLiteConfig `json:"sqlite" yaml:"sqlite" mapstructure:"sqlite"`
+	// Store is the normalized internal store view populated by Normalize from Postgres or SQLite.
 	// It is not read from YAML.
 	Store StoreType `json:"-" yaml:"-" mapstructure:"-"`
 	// Media large-file storage configuration (optional; omit to disable uploads).
@@ -80,6 +82,12 @@ type Type struct {
 	// Models
 	Models []Model `json:"models" yaml:"models" mapstructure:"models"`
 
+	// ModelAliases declares model names that fail over across an ordered chain of models entries.
+	ModelAliases []ModelAlias `json:"model_aliases" yaml:"model_aliases" mapstructure:"model_aliases"`
+
+	// ModelCircuit tunes the per-provider circuits used by model alias failover.
+	ModelCircuit ModelCircuit `json:"model_circuit" yaml:"model_circuit" mapstructure:"model_circuit"`
+
 	// ChatAgent configures the direct-message chat assistant agent.
 	ChatAgent ChatAgentConfig `json:"chat_agent" yaml:"chat_agent" mapstructure:"chat_agent"`
 
@@ -186,8 +194,20 @@ type PostgresConfig struct {
 	HealthCheckTimeout int `json:"pool_health_check_timeout,omitempty" yaml:"pool_health_check_timeout,omitempty" mapstructure:"pool_health_check_timeout"`
 }
 
+// SQLiteConfig configures the embedded SQLite store (YAML key: sqlite).
+type SQLiteConfig struct {
+	// Path is the database file; setting it selects SQLite instead of Postgres.
+	Path string `json:"path" yaml:"path" mapstructure:"path"`
+	// MaxResults caps query result sets (0 = adapter default).
+	MaxResults int `json:"max_results,omitempty" yaml:"max_results,omitempty" mapstructure:"max_results"`
+	// MaxOpenConns is the maximum open connections (0 = 4). WAL mode allows concurrent readers and one writer.
+	MaxOpenConns int `json:"max_open_conns,omitempty" yaml:"max_open_conns,omitempty" mapstructure:"max_open_conns"`
+	// BusyTimeout is how long a writer waits for the database lock, in milliseconds (0 = 5000).
+	BusyTimeout int `json:"busy_timeout,omitempty" yaml:"busy_timeout,omitempty" mapstructure:"busy_timeout"`
+}
+
 // StoreType is the normalized store adapter view used by internal/store.
-// It is not loaded from YAML; call Normalize after unmarshaling Postgres.
+// It is not loaded from YAML; call Normalize after unmarshaling Postgres or SQLite.
 type StoreType struct {
 	// Maximum number of results to return from adapter.
 	MaxResults int `json:"max_results" yaml:"max_results" mapstructure:"max_results"`
@@ -238,8 +258,16 @@ type LogRotation struct {
 	Compress bool `json:"compress" yaml:"compress" mapstructure:"compress"`
 }
 
+// RedisBackendMemory is the redis.backend value that keeps cache, pub/sub and
+// notify rule state in the process instead of a Redis server.
+const RedisBackendMemory = "memory"
+
 // Redis stores connection and pool configuration for the Redis client.
 type Redis struct {
+	// Backend is "redis" (default) or "memory". With memory, cache entries,
+	// pub/sub messages and notify rule state live in the process so a
+	// single-node install runs with only a database; url and pool fields are ignored.
+	Backend string `json:"backend" yaml:"backend" mapstructure:"backend" validate:"omitempty,oneof=redis memory"`
 	// URL is the Redis connection URI, e.g. redis://:password@127.0.0.1:6379/0.
 	// Password must be non-empty (validated separately from struct tags).
 	URL string `json:"url" yaml:"url" mapstructure:"url" sensitive:"true" validate:"required,min=1"`
@@ -269,6 +297,11 @@ type Redis struct {
 	PoolFIFO bool `json:"pool_fifo" yaml:"pool_fifo" mapstructure:"pool_fifo"`
 }
 
+// InMemory reports whether redis.backend selects the in-process backend.
+func (r Redis) InMemory() bool {
+	return r.Backend == RedisBackendMemory
+}
+
 type platform struct {
 	// Slack platform configuration
 	Slack Slack `json:"slack" yaml:"slack" mapstructure:"slack"`
@@ -517,6 +550,10 @@ type GatewayConfig struct {
 	Permission string `json:"permission" yaml:"permission" mapstructure:"permission"`
 	// MaxOutputBytes truncates job output on complete (0 uses chat_agent.max_tool_output).
 	MaxOutputBytes int `json:"max_output_bytes" yaml:"max_output_bytes" mapstructure:"max_output_bytes"`
+	// MaxLogBytes caps streamed log bytes stored per job (0 uses 4 MiB); later chunks are dropped.
+	MaxLogBytes int `json:"max_log_bytes" yaml:"max_log_bytes" mapstructure:"max_log_bytes"`
+	// LogTTL deletes streamed logs of jobs finished longer ago than this (0 uses 7 days).
+	LogTTL time.Duration `json:"log_ttl" yaml:"log_ttl" mapstructure:"log_ttl"`
 }
 
 // CoreHTTPConfig controls CapCore http_request outbound access.
@@ -542,6 +579,40 @@ type PipelineStep struct {
 	Operation  string             `json:"operation" yaml:"operation" mapstructure:"operation"`
 	Params     map[string]any     `json:"params" yaml:"params" mapstructure:"params"`
 	Retry      *PipelineStepRetry `json:"retry" yaml:"retry" mapstructure:"retry"`
+	// When is a template condition rendered before the step; a falsy result skips the step.
+	When string `json:"when" yaml:"when" mapstructure:"when"`
+	// OnFailure lists compensating steps run in order when this step fails.
+	OnFailure []PipelineStep `json:"on_failure" yaml:"on_failure" mapstructure:"on_failure"`
+	// Foreach runs the step once per element of a templated list.
+	Foreach *PipelineStepForeach `json:"foreach" yaml:"foreach" mapstructure:"foreach"`
+	// Approval pauses the run until a person approves or rejects it.
+	Approval *PipelineStepApproval `json:"approval" yaml:"approval" mapstructure:"approval"`
+	// Call starts a child workflow or pipeline run with the step params as input.
+	Call *PipelineStepCall `json:"call" yaml:"call" mapstructure:"call"`
+}
+
+// PipelineStepCall configures a step that calls a workflow or another pipeline.
+type PipelineStepCall struct {
+	Workflow string `json:"workflow" yaml:"workflow" mapstructure:"workflow"`
+	Pipeline string `json:"pipeline" yaml:"pipeline" mapstructure:"pipeline"`
+	// Wait blocks until the child run finishes; nil means true.
+	Wait *bool `json:"wait" yaml:"wait" mapstructure:"wait"`
+}
+
+// PipelineStepApproval configures a human approval gate step.
+type PipelineStepApproval struct {
+	Message  string   `json:"message" yaml:"message" mapstructure:"message"`
+	Timeout  string   `json:"timeout" yaml:"timeout" mapstructure:"timeout"`
+	Default  string   `json:"default" yaml:"default" mapstructure:"default"`
+	Channels []string `json:"channels" yaml:"channels" mapstructure:"channels"`
+	UID      string   `json:"uid" yaml:"uid" mapstructure:"uid"`
+}
+
+// PipelineStepForeach configures fan-out of a pipeline step over a list.
+type PipelineStepForeach struct {
+	Items           string `json:"items" yaml:"items" mapstructure:"items"`
+	Concurrency     int    `json:"concurrency" yaml:"concurrency" mapstructure:"concurrency"`
+	ContinueOnError bool   `json:"continue_on_error" yaml:"continue_on_error" mapstructure:"continue_on_error"`
 }
 
 // PipelineStepRetry mirrors types.RetryConfig for config parsing.
@@ -559,6 +630,16 @@ type PipelineTrigger struct {
 	Cron        string          `json:"cron" yaml:"cron" mapstructure:"cron"`
 	CronTimeout string          `json:"cron_timeout" yaml:"cron_timeout" mapstructure:"cron_timeout"`
 	Webhook     *WebhookTrigger `json:"webhook" yaml:"webhook" mapstructure:"webhook"`
+	// Filter narrows an event trigger to events whose data, tags, app or source match.
+	Filter *PipelineTriggerFilter `json:"filter" yaml:"filter" mapstructure:"filter"`
+}
+
+// PipelineTriggerFilter holds event trigger predicates; all set predicates must match.
+type PipelineTriggerFilter struct {
+	App    []string       `json:"app" yaml:"app" mapstructure:"app"`
+	Source []string       `json:"source" yaml:"source" mapstructure:"source"`
+	Tags   map[string]any `json:"tags" yaml:"tags" mapstructure:"tags"`
+	Data   map[string]any `json:"data" yaml:"data" mapstructure:"data"`
 }
 
 // WebhookPayloadMode specifies how incoming webhook payloads are handled.
@@ -617,6 +698,10 @@ type ChatAgentConfig struct {
 	// ApprovalModel selects the auxiliary security reviewer model for auto approval mode.
 	// Empty falls back to tool_model, then chat_model.
 	ApprovalModel string `json:"approval_model" yaml:"approval_model" mapstructure:"approval_model"`
+	// EmbeddingModel selects an OpenAI-compatible embedding model for semantic
+	// retrieval over knowledge, memory facts and session summaries; empty keeps
+	// keyword search only.
+	EmbeddingModel string `json:"embedding_model" yaml:"embedding_model" mapstructure:"embedding_model"`
 	// ApprovalMode is the server default approval mode (manual|auto|off) when the user has no override.
 	ApprovalMode string `json:"approval_mode" yaml:"approval_mode" mapstructure:"approval_mode"`
 	// ApprovalTimeout limits one aux reviewer call; zero defaults to 10s.
@@ -641,6 +726,90 @@ type ChatAgentConfig struct {
 	WebSearch ChatAgentWebSearchConfig `json:"web_search" yaml:"web_search" mapstructure:"web_search"`
 	// Media configures multimodal attachment signing and public fetch URLs.
 	Media ChatAgentMediaConfig `json:"media" yaml:"media" mapstructure:"media"`
+	// MCPServers registers external MCP servers whose tools agents may call as mcp__<server>__<tool>.
+	MCPServers []ChatAgentMCPServerConfig `json:"mcp_servers" yaml:"mcp_servers" mapstructure:"mcp_servers"`
+	// MCPRefreshInterval re-lists MCP tools and reconnects failed servers; zero defaults to 5m.
+	MCPRefreshInterval time.Duration `json:"mcp_refresh_interval" yaml:"mcp_refresh_interval" mapstructure:"mcp_refresh_interval"`
+	// Hooks run operator commands or webhooks on agent hook events; they can block or rewrite tool calls and append context.
+	Hooks []ChatAgentHookConfig `json:"hooks" yaml:"hooks" mapstructure:"hooks"`
+	// ModelPricing sets or overrides per-model prices (USD per million tokens) used to estimate cost for budgets.
+	ModelPricing map[string]ChatAgentModelPricing `json:"model_pricing" yaml:"model_pricing" mapstructure:"model_pricing"`
+	// Budgets cap LLM tokens or estimated cost per user, agent, scheduled task, or pipeline agent_run step.
+	Budgets []ChatAgentBudgetConfig `json:"budgets" yaml:"budgets" mapstructure:"budgets"`
+}
+
+// ChatAgentModelPricing holds USD prices per million tokens for one model.
+type ChatAgentModelPricing struct {
+	// Input is the price of prompt tokens.
+	Input float64 `json:"input" yaml:"input" mapstructure:"input"`
+	// Output is the price of completion tokens.
+	Output float64 `json:"output" yaml:"output" mapstructure:"output"`
+	// CacheRead is the price of cached prompt tokens read.
+	CacheRead float64 `json:"cache_read" yaml:"cache_read" mapstructure:"cache_read"`
+	// CacheWrite is the price of prompt tokens written to the cache.
+	CacheWrite float64 `json:"cache_write" yaml:"cache_write" mapstructure:"cache_write"`
+}
+
+// ChatAgentBudgetConfig declares one LLM spend budget. Set token limits, cost limits, or both.
+type ChatAgentBudgetConfig struct {
+	// Name identifies the budget in alerts and block messages.
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+	// Scope is what the budget is counted per: user, agent, scheduled_task, or pipeline_step.
+	Scope string `json:"scope" yaml:"scope" mapstructure:"scope"`
+	// Match limits the budget to these subjects (user uids, agent names, task ids, or "pipeline/step"). Empty applies it to each subject separately.
+	Match []string `json:"match" yaml:"match" mapstructure:"match"`
+	// Window is the reset period in UTC: daily (default) or monthly.
+	Window string `json:"window" yaml:"window" mapstructure:"window"`
+	// SoftTokens sends a notify alert once per window when reached; zero disables.
+	SoftTokens int64 `json:"soft_tokens" yaml:"soft_tokens" mapstructure:"soft_tokens"`
+	// HardTokens blocks new runs when reached; zero disables.
+	HardTokens int64 `json:"hard_tokens" yaml:"hard_tokens" mapstructure:"hard_tokens"`
+	// SoftCost sends a notify alert once per window when estimated USD cost is reached; zero disables.
+	SoftCost float64 `json:"soft_cost" yaml:"soft_cost" mapstructure:"soft_cost"`
+	// HardCost blocks new runs when estimated USD cost is reached; zero disables.
+	HardCost float64 `json:"hard_cost" yaml:"hard_cost" mapstructure:"hard_cost"`
+}
+
+// ChatAgentHookConfig declares one external agent hook. Set either Command (run through the sandbox when enabled) or URL (POST).
+type ChatAgentHookConfig struct {
+	// Name identifies the hook in payloads, logs, and block reasons.
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+	// Events lists hook events: before_agent_start, tool_call, tool_result, context_compacted, context_usage, save_point, model_update, tools_update.
+	Events []string `json:"events" yaml:"events" mapstructure:"events"`
+	// Tools limits tool_call and tool_result events to tool names matching these globs. Empty matches all tools.
+	Tools []string `json:"tools" yaml:"tools" mapstructure:"tools"`
+	// Agents limits the hook to these agents: "chat" for the main assistant, otherwise subagent names, "*" for all. Empty means chat only.
+	Agents []string `json:"agents" yaml:"agents" mapstructure:"agents"`
+	// Command is a shell command that receives the event JSON on stdin; exit 2 blocks with stderr as the reason.
+	Command string `json:"command" yaml:"command" mapstructure:"command"`
+	// URL receives the event JSON as a POST body; a 2xx JSON response is the decision.
+	URL string `json:"url" yaml:"url" mapstructure:"url"`
+	// Headers are sent with webhook requests, e.g. Authorization.
+	Headers map[string]string `json:"headers" yaml:"headers" mapstructure:"headers" sensitive:"true"`
+	// Timeout limits one invocation; zero defaults to 10s.
+	Timeout time.Duration `json:"timeout" yaml:"timeout" mapstructure:"timeout"`
+	// OnError is the failure policy: "allow" (default) continues, "block" blocks the tool call or cancels the run.
+	OnError string `json:"on_error" yaml:"on_error" mapstructure:"on_error"`
+}
+
+// ChatAgentMCPServerConfig registers one external MCP server. Set either Command (stdio) or URL (streamable HTTP).
+type ChatAgentMCPServerConfig struct {
+	// Name identifies the server in tool names and permission patterns; lowercase letters, digits, '-' and single '_' not at the end.
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+	// Command starts a stdio MCP server as a subprocess.
+	Command string `json:"command" yaml:"command" mapstructure:"command"`
+	// Args are passed to Command.
+	Args []string `json:"args" yaml:"args" mapstructure:"args"`
+	// Env adds environment variables for Command.
+	Env map[string]string `json:"env" yaml:"env" mapstructure:"env" sensitive:"true"`
+	// URL is the streamable HTTP endpoint of a remote MCP server.
+	URL string `json:"url" yaml:"url" mapstructure:"url"`
+	// Headers are sent with every HTTP request, e.g. Authorization.
+	Headers map[string]string `json:"headers" yaml:"headers" mapstructure:"headers" sensitive:"true"`
+	// Agents limits the server to these agents: "chat" for the main assistant, otherwise subagent names. Empty means chat only.
+	Agents []string `json:"agents" yaml:"agents" mapstructure:"agents"`
+	// Timeout limits one tool call; zero defaults to 60s.
+	Timeout time.Duration `json:"timeout" yaml:"timeout" mapstructure:"timeout"`
 }
 
 // ChatAgentMediaConfig configures multimodal media delivery for the chat agent.
@@ -734,6 +903,22 @@ type Model struct {
 	ModelNames []string `json:"model_names" yaml:"model_names" mapstructure:"model_names"`
 }
 
+// ModelAlias is a model name served by the first healthy entry of Chain.
+type ModelAlias struct {
+	// Alias name used in chat_model, tool_model, subagent and session model settings
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+	// Model names from models, tried in order on rate limits, transient errors and context overflow
+	Chain []string `json:"chain" yaml:"chain" mapstructure:"chain"`
+}
+
+// ModelCircuit configures the circuit tripped per provider endpoint during alias failover.
+type ModelCircuit struct {
+	// Consecutive transient failures that open a provider circuit (default 5)
+	FailureThreshold int `json:"failure_threshold" yaml:"failure_threshold" mapstructure:"failure_threshold"`
+	// How long an open circuit skips the provider before a probe request (default 30s)
+	Cooldown time.Duration `json:"cooldown" yaml:"cooldown" mapstructure:"cooldown"`
+}
+
 func Load(path ...string) error {
 	err := viper.BindPFlags(pflag.CommandLine)
 	if err != nil {
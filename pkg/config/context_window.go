@@ -84,13 +84,18 @@ func ContextWindowForModels(_ []Model, modelName string) int {
 }
 
 // ContextWindowForModel returns the catalog context window for a model name.
+// An alias uses the window of the first entry in its chain.
 func ContextWindowForModel(modelName string) int {
-	return model.ContextWindowFor(modelName)
+	return model.ContextWindowFor(CatalogModelName(modelName))
 }
 
 // MaxContextWindow returns the largest catalog context window among the given model names.
 func MaxContextWindow(modelNames ...string) int {
-	return model.MaxContextWindow(modelNames...)
+	resolved := make([]string, len(modelNames))
+	for i, name := range modelNames {
+		resolved[i] = CatalogModelName(name)
+	}
+	return model.MaxContextWindow(resolved...)
 }
 
 // ChatAgentContextWindow returns the effective input budget for the configured chat agent models.
@@ -15,6 +15,15 @@ var FieldDocs = map[string]string{
 	"chat_agent.approval_mode":                            "ApprovalMode is the server default approval mode (manual|auto|off) when the user has no override.",
 	"chat_agent.approval_model":                           "ApprovalModel selects the auxiliary security reviewer model for auto approval mode. Empty falls back to tool_model, then chat_model.",
 	"chat_agent.approval_timeout":                         "ApprovalTimeout limits one aux reviewer call; zero defaults to 10s.",
+	"chat_agent.budgets":                                  "Budgets cap LLM tokens or estimated cost per user, agent, scheduled task, or pipeline agent_run step.",
+	"chat_agent.budgets.hard_cost":                        "HardCost blocks new runs when estimated USD cost is reached; zero disables.",
+	"chat_agent.budgets.hard_tokens":                      "HardTokens blocks new runs when reached; zero disables.",
+	"chat_agent.budgets.match":                            "Match limits the budget to these subjects (user uids, agent names, task ids, or \"pipeline/step\"). Empty applies it to each subject separately.",
+	"chat_agent.budgets.name":                             "Name identifies the budget in alerts and block messages.",
+	"chat_agent.budgets.scope":                            "Scope is what the budget is counted per: user, agent, scheduled_task, or pipeline_step.",
+	"chat_agent.budgets.soft_cost":                        "SoftCost sends a notify alert once per window when estimated USD cost is reached; zero disables.",
+	"chat_agent.budgets.soft_tokens":                      "SoftTokens sends a notify alert once per window when reached; zero disables.",
+	"chat_agent.budgets.window":                           "Window is the reset period in UTC: daily (default) or monthly.",
 	"chat_agent.chat_model":                               "ChatModel selects the chat agent model; non-empty enables the chat agent.",
 	"chat_agent.compaction":                               "Compaction configures automatic history compaction for long chat sessions.",
 	"chat_agent.compaction.auto":                          "Auto turns on threshold-based compaction before agent runs.",
@@ -24,6 +33,17 @@ var FieldDocs = map[string]string{
 	"chat_agent.compaction.reserve_tokens":                "ReserveTokens preserves compatibility with older configs that used reserve_tokens.",
 	"chat_agent.compaction.reserved":                      "Reserved is headroom reserved below the model context window.",
 	"chat_agent.context_files":                            "ContextFiles lists project instruction files relative to workspace; defaults to AGENTS.md and README.md.",
+	"chat_agent.embedding_model":                          "EmbeddingModel selects an OpenAI-compatible embedding model for semantic retrieval over knowledge, memory facts and session summaries; empty keeps keyword search only.",
+	"chat_agent.hooks":                                    "Hooks run operator commands or webhooks on agent hook events; they can block or rewrite tool calls and append context.",
+	"chat_agent.hooks.agents":                             "Agents limits the hook to these agents: \"chat\" for the main assistant, otherwise subagent names, \"*\" for all. Empty means chat only.",
+	"chat_agent.hooks.command":                            "Command is a shell command that receives the event JSON on stdin; exit 2 blocks with stderr as the reason.",
+	"chat_agent.hooks.events":                             "Events lists hook events: before_agent_start, tool_call, tool_result, context_compacted, context_usage, save_point, model_update, tools_update.",
+	"chat_agent.hooks.headers":                            "Headers are sent with webhook requests, e.g. Authorization.",
+	"chat_agent.hooks.name":                               "Name identifies the hook in payloads, logs, and block reasons.",
+	"chat_agent.hooks.on_error":                           "OnError is the failure policy: \"allow\" (default) continues, \"block\" blocks the tool call or cancels the run.",
+	"chat_agent.hooks.timeout":                            "Timeout limits one invocation; zero defaults to 10s.",
+	"chat_agent.hooks.tools":                              "Tools limits tool_call and tool_result events to tool names matching these globs. Empty matches all tools.",
+	"chat_agent.hooks.url":                                "URL receives the event JSON as a POST body; a 2xx JSON response is the decision.",
 	"chat_agent.llm_retry":                                "LLMRetry configures transient LLM call retries for the chat agent.",
 	"chat_agent.llm_retry.initial_interval":               "InitialInterval is the delay before the first retry. Zero uses 1s.",
 	"chat_agent.llm_retry.max_attempts":                   "MaxAttempts is the total number of execution attempts. Zero uses package defaults (3).",
@@ -42,10 +62,21 @@ var FieldDocs = map[string]string{
 	"chat_agent.loop_detection.window":                    "Window is the sliding tool-call history size.",
 	"chat_agent.max_steps":                                "MaxSteps limits agent Observe-Think-Act iterations per user turn.",
 	"chat_agent.max_tool_output":                          "MaxToolOutput truncates tool stdout beyond this size in bytes.",
+	"chat_agent.mcp_refresh_interval":                     "MCPRefreshInterval re-lists MCP tools and reconnects failed servers; zero defaults to 5m.",
+	"chat_agent.mcp_servers":                              "MCPServers registers external MCP servers whose tools agents may call as mcp__<server>__<tool>.",
+	"chat_agent.mcp_servers.agents":                       "Agents limits the server to these agents: \"chat\" for the main assistant, otherwise subagent names. Empty means chat only.",
+	"chat_agent.mcp_servers.args":                         "Args are passed to Command.",
+	"chat_agent.mcp_servers.command":                      "Command starts a stdio MCP server as a subprocess.",
+	"chat_agent.mcp_servers.env":                          "Env adds environment variables for Command.",
+	"chat_agent.mcp_servers.headers":                      "Headers are sent with every HTTP request, e.g. Authorization.",
+	"chat_agent.mcp_servers.name":                         "Name identifies the server in tool names and permission patterns; lowercase letters, digits, '-' and single '_' not at the end.",
+	"chat_agent.mcp_servers.timeout":                      "Timeout limits one tool call; zero defaults to 60s.",
+	"chat_agent.mcp_servers.url":                          "URL is the streamable HTTP endpoint of a remote MCP server.",
 	"chat_agent.media":                                    "Media configures multimodal attachment signing and public fetch URLs.",
 	"chat_agent.media.public_base_url":                    "PublicBaseURL is the absolute origin LLM providers use to fetch FS-signed media (e.g. https://bot.example.com).",
 	"chat_agent.media.sign_secret":                        "SignSecret is the HMAC secret for FS-signed media URLs; falls back to media.sign_secret when empty.",
 	"chat_agent.media.signed_url_ttl":                     "SignedURLTTL is the lifetime of signed media URLs; zero defaults to 60m.",
+	"chat_agent.model_pricing":                            "ModelPricing sets or overrides per-model prices (USD per million tokens) used to estimate cost for budgets.",
 	"chat_agent.prompt_guidelines":                        "PromptGuidelines adds extra guideline bullets to the default system prompt.",
 	"chat_agent.run_timeout":                              "RunTimeout limits total duration for one assistant turn in direct chat.",
 	"chat_agent.sandbox":                                  "Sandbox configures optional Docker isolation for shell and code tools.",
@@ -96,6 +127,8 @@ var FieldDocs = map[string]string{
 	"gateway":                                             "Gateway configures CapGateway (local CLI worker jobs). Not the notification gateway.",
 	"gateway.enabled":                                     "Enabled registers CapGateway when true.",
 	"gateway.lease_ttl":                                   "LeaseTTL is the running-job lease duration renewed by heartbeats.",
+	"gateway.log_ttl":                                     "LogTTL deletes streamed logs of jobs finished longer ago than this (0 uses 7 days).",
+	"gateway.max_log_bytes":                               "MaxLogBytes caps streamed log bytes stored per job (0 uses 4 MiB); later chunks are dropped.",
 	"gateway.max_output_bytes":                            "MaxOutputBytes truncates job output on complete (0 uses chat_agent.max_tool_output).",
 	"gateway.permission":                                  "Permission merges into DefaultConfig[\"gateway\"]: \"ask\" (default) or \"allow\" only.",
 	"gateway.run_timeout":                                 "RunTimeout is how long capability run waits for a terminal job status.",
@@ -137,6 +170,12 @@ var FieldDocs = map[string]string{
 	"metrics.bearer_token":                                "BearerToken is an optional dedicated scrape secret for GET /metrics. When set, Authorization: Bearer <token> (or X-AccessToken) matching this value is accepted. Otherwise a valid access token with admin:metrics (or admin:*) scope is required.",
 	"metrics.enabled":                                     "Enabled controls push to the remote metrics endpoint (VictoriaMetrics / Prometheus push).",
 	"metrics.endpoint":                                    "Endpoint is the remote push URL when Enabled is true.",
+	"model_aliases":                                       "ModelAliases declares model names that fail over across an ordered chain of models entries.",
+	"model_aliases.chain":                                 "Model names from models, tried in order on rate limits, transient errors and context overflow",
+	"model_aliases.name":                                  "Alias name used in chat_model, tool_model, subagent and session model settings",
+	"model_circuit":                                       "ModelCircuit tunes the per-provider circuits used by model alias failover.",
+	"model_circuit.cooldown":                              "How long an open circuit skips the provider before a probe request (default 30s)",
+	"model_circuit.failure_threshold":                     "Consecutive transient failures that open a provider circuit (default 5)",
 	"models":                                              "Models",
 	"models.api_key":                                      "API key",
 	"models.base_url":                                     "Base URL",
@@ -183,6 +222,7 @@ var FieldDocs = map[string]string{
 	"profiling.server_address":                            "ServerAddress is the Pyroscope server URL (e.g. http://localhost:4040)",
 	"profiling.service_name":                              "ServiceName identifies this service in profiles",
 	"redis":                                               "Redis connection configuration.",
+	"redis.backend":                                       "Backend is \"redis\" (default) or \"memory\". With memory, cache entries, pub/sub messages and notify rule state live in the process so a single-node install runs with only a database; url and pool fields are ignored.",
 	"redis.conn_max_idle_time":                            "Maximum idle time for a connection before closing (0 = default: 30min)",
 	"redis.conn_max_lifetime":                             "Maximum lifetime of a connection (0 = default: no limit)",
 	"redis.dial_timeout":                                  "Dial timeout for establishing new connections (0 = default: 5s)",
@@ -200,7 +240,12 @@ var FieldDocs = map[string]string{
 	"retention.data_events_days":                          "DataEventsDays deletes data_events older than this many days when > 0. Zero disables.",
 	"search":                                              "Search",
 	"search.url_base_map":                                 "Search URL base map",
-	"store":                                               "Store is the normalized internal store view populated by Normalize from Postgres. It is not read from YAML.",
+	"sqlite":                                              "SQLite is the embedded single-file database; setting sqlite.path selects it instead of Postgres.",
+	"sqlite.busy_timeout":                                 "BusyTimeout is how long a writer waits for the database lock, in milliseconds (0 = 5000).",
+	"sqlite.max_open_conns":                               "MaxOpenConns is the maximum open connections (0 = 4). WAL mode allows concurrent readers and one writer.",
+	"sqlite.max_results":                                  "MaxResults caps query result sets (0 = adapter default).",
+	"sqlite.path":                                         "Path is the database file; setting it selects SQLite instead of Postgres.",
+	"store":                                               "Store is the normalized internal store view populated by Normalize from Postgres or SQLite. It is not read from YAML.",
 	"store.adapters":                                      "Configurations for individual adapters.",
 	"store.max_results":                                   "Maximum number of results to return from adapter.",
 	"store.use_adapter":                                   "DB adapter name to use. Should be one of those specified in `Adapters`.",
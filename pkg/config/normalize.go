@@ -5,11 +5,42 @@ import "time"
 // Normalize applies defaults and builds internal views from the public YAML surface.
 // Call after unmarshaling and before Validate / opening subsystems.
 func (t *Type) Normalize() {
-	t.normalizePostgres()
+	t.normalizeStore()
 	t.normalizeMedia()
 	t.normalizeChatAgentMedia()
 }
 
+// normalizeStore selects SQLite when sqlite.path is set and Postgres otherwise.
+func (t *Type) normalizeStore() {
+	if t.SQLite.Path != "" {
+		t.normalizeSQLite()
+		return
+	}
+	t.normalizePostgres()
+}
+
+// normalizeSQLite maps SQLiteConfig into the internal StoreType view used by store.Open.
+func (t *Type) normalizeSQLite() {
+	lite := t.SQLite
+	adapter := map[string]any{
+		"path": lite.Path,
+	}
+	if lite.MaxOpenConns != 0 {
+		adapter["max_open_conns"] = lite.MaxOpenConns
+	}
+	if lite.BusyTimeout != 0 {
+		adapter["busy_timeout"] = lite.BusyTimeout
+	}
+
+	t.Store = StoreType{
+		MaxResults: lite.MaxResults,
+		UseAdapter: "sqlite",
+		Adapters: map[string]any{
+			"sqlite": adapter,
+		},
+	}
+}
+
 // normalizePostgres maps PostgresConfig into the internal StoreType view used by store.Open.
 func (t *Type) normalizePostgres() {
 	pg := t.Postgres
@@ -72,6 +72,27 @@ func TestNormalize_Postgres(t *testing.T) {
 	}
 }
 
+func TestNormalize_SQLite(t *testing.T) {
+	t.Parallel()
+
+	cfg := Type{
+		Postgres: PostgresConfig{DSN: "postgres://u:p@localhost/db"},
+		SQLite:   SQLiteConfig{Path: "/data/flowbot.db", MaxResults: 200, BusyTimeout: 2000},
+	}
+	cfg.Normalize()
+
+	assert.Equal(t, "sqlite", cfg.Store.UseAdapter)
+	assert.Equal(t, 200, cfg.Store.MaxResults)
+	_, hasPG := cfg.Store.Adapters["postgres"]
+	assert.False(t, hasPG)
+	adapter, ok := cfg.Store.Adapters["sqlite"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "/data/flowbot.db", adapter["path"])
+	assert.Equal(t, 2000, adapter["busy_timeout"])
+	_, has := adapter["max_open_conns"]
+	assert.False(t, has)
+}
+
 func TestNormalize_Media(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -53,7 +54,11 @@ func (t *Type) Validate() error {
 
 	modelNames := make(map[string]bool)
 	errs, modelNames = t.validateModels(errs, modelNames)
+	errs, modelNames = t.validateModelAliases(errs, modelNames)
 	errs = t.validateChatAgent(errs, modelNames)
+	errs = t.validateChatAgentMCP(errs)
+	errs = t.validateChatAgentHooks(errs)
+	errs = t.validateChatAgentBudgets(errs)
 
 	if len(errs) > 0 {
 		return errs
@@ -63,11 +68,18 @@ func (t *Type) Validate() error {
 
 // validateStructTags runs playground-validator struct tag checks on sub-structs.
 func (t *Type) validateStructTags(errs ValidationErrors) ValidationErrors {
-	if err := validate.Validate.Struct(t.Redis); err != nil {
-		errs = appendTagErrors(errs, err, "redis")
+	if !t.Redis.InMemory() {
+		if err := validate.Validate.Struct(t.Redis); err != nil {
+			errs = appendTagErrors(errs, err, "redis")
+		}
 	}
-	if err := validate.Validate.Struct(t.Postgres); err != nil {
-		errs = appendTagErrors(errs, err, "postgres")
+	switch {
+	case t.SQLite.Path != "" && t.Postgres.DSN != "":
+		errs = append(errs, fmt.Errorf("sqlite.path: cannot be combined with postgres.dsn. Fix: remove one of them from flowbot.yaml"))
+	case t.SQLite.Path == "":
+		if err := validate.Validate.Struct(t.Postgres); err != nil {
+			errs = appendTagErrors(errs, err, "postgres")
+		}
 	}
 	if err := validate.Validate.Struct(t.Log); err != nil {
 		errs = appendTagErrors(errs, err, "log")
@@ -103,7 +115,7 @@ func (t *Type) validateStructTags(errs ValidationErrors) ValidationErrors {
 
 // validateRedisURL checks that redis.url parses and includes a non-empty password.
 func (t *Type) validateRedisURL(errs ValidationErrors) ValidationErrors {
-	if t.Redis.URL == "" {
+	if t.Redis.URL == "" || t.Redis.InMemory() {
 		// Struct tag already reports required; avoid duplicate empty-password noise.
 		return errs
 	}
@@ -176,6 +188,54 @@ func (t *Type) validateModels(errs ValidationErrors, modelNames map[string]bool)
 	return errs, modelNames
 }
 
+// validateModelAliases checks each alias has a unique name that does not shadow
+// a models entry and a duplicate-free chain of registered model names, then
+// adds the alias names to modelNames.
+func (t *Type) validateModelAliases(errs ValidationErrors, modelNames map[string]bool) (ValidationErrors, map[string]bool) {
+	concrete := make(map[string]bool, len(modelNames))
+	for name := range modelNames {
+		concrete[name] = true
+	}
+	seen := make(map[string]bool, len(t.ModelAliases))
+	for i, alias := range t.ModelAliases {
+		prefix := fmt.Sprintf("model_aliases[%d]", i)
+		switch {
+		case alias.Name == "":
+			errs = append(errs, fmt.Errorf("%s.name: must not be empty. Fix: set %s.name in flowbot.yaml", prefix, prefix))
+		case concrete[alias.Name]:
+			errs = append(errs, fmt.Errorf("%s.name: %q is already a model name in models. Fix: rename the alias in flowbot.yaml", prefix, alias.Name))
+		case seen[alias.Name]:
+			errs = append(errs, fmt.Errorf("%s.name: duplicate alias %q. Fix: use unique alias names in flowbot.yaml", prefix, alias.Name))
+		default:
+			seen[alias.Name] = true
+			modelNames[alias.Name] = true
+		}
+		if len(alias.Chain) == 0 {
+			errs = append(errs, fmt.Errorf("%s.chain: must list at least one model. Fix: set %s.chain in flowbot.yaml", prefix, prefix))
+		}
+		inChain := make(map[string]bool, len(alias.Chain))
+		for j, name := range alias.Chain {
+			if !concrete[name] {
+				errs = append(errs, fmt.Errorf(
+					"%s.chain[%d]: %q not found in models. Fix: reference a model name from models (aliases cannot nest) in flowbot.yaml",
+					prefix, j, name,
+				))
+			}
+			if inChain[name] {
+				errs = append(errs, fmt.Errorf("%s.chain[%d]: duplicate model %q. Fix: list each model once in flowbot.yaml", prefix, j, name))
+			}
+			inChain[name] = true
+		}
+	}
+	if t.ModelCircuit.FailureThreshold < 0 {
+		errs = append(errs, fmt.Errorf("model_circuit.failure_threshold: must be >= 0. Fix: set model_circuit.failure_threshold in flowbot.yaml"))
+	}
+	if t.ModelCircuit.Cooldown < 0 {
+		errs = append(errs, fmt.Errorf("model_circuit.cooldown: must be >= 0. Fix: set model_circuit.cooldown in flowbot.yaml"))
+	}
+	return errs, modelNames
+}
+
 // validateChatAgent validates chat agent model configuration when chat_model is set.
 func (t *Type) validateChatAgent(errs ValidationErrors, modelNames map[string]bool) ValidationErrors {
 	chat := t.ChatAgent.ChatModel
@@ -197,8 +257,8 @@ func (t *Type) validateChatAgent(errs ValidationErrors, modelNames map[string]bo
 			))
 		}
 		if len(modelNames) > 0 && modelNames[chat] && modelNames[tool] {
-			chatProvider := providerForModelInList(t.Models, chat)
-			toolProvider := providerForModelInList(t.Models, tool)
+			chatProvider := providerForModel(t.Models, t.ModelAliases, chat)
+			toolProvider := providerForModel(t.Models, t.ModelAliases, tool)
 			if chatProvider != toolProvider {
 				errs = append(errs, fmt.Errorf(
 					"chat_agent: chat_model %q (provider %q) and tool_model %q (provider %q) must use the same provider. Fix: align providers in flowbot.yaml",
@@ -210,6 +270,137 @@ func (t *Type) validateChatAgent(errs ValidationErrors, modelNames map[string]bo
 	return errs
 }
 
+// mcpServerNamePattern allows '_' only between other characters, so a name
+// never contains "__" or ends in '_' and the "__" separator in
+// mcp__<server>__<tool> stays unambiguous.
+var mcpServerNamePattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]|_[a-z0-9-])*$`)
+
+// validateChatAgentMCP checks MCP server names are unique and usable in tool
+// names, and that each server has exactly one transport.
+func (t *Type) validateChatAgentMCP(errs ValidationErrors) ValidationErrors {
+	seen := make(map[string]bool, len(t.ChatAgent.MCPServers))
+	for i, srv := range t.ChatAgent.MCPServers {
+		prefix := fmt.Sprintf("chat_agent.mcp_servers[%d]", i)
+		switch {
+		case !mcpServerNamePattern.MatchString(srv.Name):
+			errs = append(errs, fmt.Errorf("%s.name: %q must use lowercase letters, digits, '-' and single '_' that do not end the name. Fix: rename the server in flowbot.yaml", prefix, srv.Name))
+		case seen[srv.Name]:
+			errs = append(errs, fmt.Errorf("%s.name: duplicate server %q. Fix: give each MCP server a unique name in flowbot.yaml", prefix, srv.Name))
+		}
+		seen[srv.Name] = true
+		hasCommand := strings.TrimSpace(srv.Command) != ""
+		hasURL := strings.TrimSpace(srv.URL) != ""
+		if hasCommand == hasURL {
+			errs = append(errs, fmt.Errorf("%s: set exactly one of command or url. Fix: choose stdio (command) or HTTP (url) in flowbot.yaml", prefix))
+			continue
+		}
+		if hasURL {
+			if u, err := url.Parse(srv.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errs = append(errs, fmt.Errorf("%s.url: %q is not an http(s) URL. Fix: set chat_agent.mcp_servers[%d].url in flowbot.yaml", prefix, srv.URL, i))
+			}
+		}
+	}
+	return errs
+}
+
+// chatAgentHookEvents lists the agent hook events external hooks may subscribe to.
+var chatAgentHookEvents = map[string]bool{
+	"before_agent_start": true,
+	"tool_call":          true,
+	"tool_result":        true,
+	"context_compacted":  true,
+	"context_usage":      true,
+	"save_point":         true,
+	"model_update":       true,
+	"tools_update":       true,
+}
+
+// validateChatAgentHooks checks external hook names are unique, each hook has
+// exactly one handler, and events and failure policies are known.
+func (t *Type) validateChatAgentHooks(errs ValidationErrors) ValidationErrors {
+	seen := make(map[string]bool, len(t.ChatAgent.Hooks))
+	for i, hook := range t.ChatAgent.Hooks {
+		prefix := fmt.Sprintf("chat_agent.hooks[%d]", i)
+		switch {
+		case strings.TrimSpace(hook.Name) == "":
+			errs = append(errs, fmt.Errorf("%s.name: required. Fix: name the hook in flowbot.yaml", prefix))
+		case seen[hook.Name]:
+			errs = append(errs, fmt.Errorf("%s.name: duplicate hook %q. Fix: give each hook a unique name in flowbot.yaml", prefix, hook.Name))
+		}
+		seen[hook.Name] = true
+		if len(hook.Events) == 0 {
+			errs = append(errs, fmt.Errorf("%s.events: required. Fix: list at least one event (e.g. tool_call) in flowbot.yaml", prefix))
+		}
+		for _, event := range hook.Events {
+			if !chatAgentHookEvents[event] {
+				errs = append(errs, fmt.Errorf("%s.events: unknown event %q. Fix: use before_agent_start, tool_call, tool_result, context_compacted, context_usage, save_point, model_update or tools_update in flowbot.yaml", prefix, event))
+			}
+		}
+		switch hook.OnError {
+		case "", "allow", "block":
+		default:
+			errs = append(errs, fmt.Errorf("%s.on_error: %q is not allow or block. Fix: set chat_agent.hooks[%d].on_error in flowbot.yaml", prefix, hook.OnError, i))
+		}
+		hasCommand := strings.TrimSpace(hook.Command) != ""
+		hasURL := strings.TrimSpace(hook.URL) != ""
+		if hasCommand == hasURL {
+			errs = append(errs, fmt.Errorf("%s: set exactly one of command or url. Fix: choose a shell command or a webhook url in flowbot.yaml", prefix))
+			continue
+		}
+		if hasURL {
+			if u, err := url.Parse(hook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errs = append(errs, fmt.Errorf("%s.url: %q is not an http(s) URL. Fix: set chat_agent.hooks[%d].url in flowbot.yaml", prefix, hook.URL, i))
+			}
+		}
+	}
+	return errs
+}
+
+// validateChatAgentBudgets checks budget names are unique, scopes and windows
+// are known, limits are non-negative, and soft limits stay below hard limits.
+func (t *Type) validateChatAgentBudgets(errs ValidationErrors) ValidationErrors {
+	for name, price := range t.ChatAgent.ModelPricing {
+		if price.Input < 0 || price.Output < 0 || price.CacheRead < 0 || price.CacheWrite < 0 {
+			errs = append(errs, fmt.Errorf("chat_agent.model_pricing.%s: prices must be >= 0. Fix: set chat_agent.model_pricing.%s in flowbot.yaml", name, name))
+		}
+	}
+	seen := make(map[string]bool, len(t.ChatAgent.Budgets))
+	for i, budget := range t.ChatAgent.Budgets {
+		prefix := fmt.Sprintf("chat_agent.budgets[%d]", i)
+		switch {
+		case strings.TrimSpace(budget.Name) == "":
+			errs = append(errs, fmt.Errorf("%s.name: required. Fix: name the budget in flowbot.yaml", prefix))
+		case seen[budget.Name]:
+			errs = append(errs, fmt.Errorf("%s.name: duplicate budget %q. Fix: give each budget a unique name in flowbot.yaml", prefix, budget.Name))
+		}
+		seen[budget.Name] = true
+		switch budget.Scope {
+		case "user", "agent", "scheduled_task", "pipeline_step":
+		default:
+			errs = append(errs, fmt.Errorf("%s.scope: %q is not user, agent, scheduled_task or pipeline_step. Fix: set chat_agent.budgets[%d].scope in flowbot.yaml", prefix, budget.Scope, i))
+		}
+		switch budget.Window {
+		case "", "daily", "monthly":
+		default:
+			errs = append(errs, fmt.Errorf("%s.window: %q is not daily or monthly. Fix: set chat_agent.budgets[%d].window in flowbot.yaml", prefix, budget.Window, i))
+		}
+		if budget.SoftTokens < 0 || budget.HardTokens < 0 || budget.SoftCost < 0 || budget.HardCost < 0 {
+			errs = append(errs, fmt.Errorf("%s: limits must be >= 0. Fix: set chat_agent.budgets[%d] limits in flowbot.yaml", prefix, i))
+			continue
+		}
+		if budget.SoftTokens == 0 && budget.HardTokens == 0 && budget.SoftCost == 0 && budget.HardCost == 0 {
+			errs = append(errs, fmt.Errorf("%s: no limit set. Fix: set soft_tokens, hard_tokens, soft_cost or hard_cost in flowbot.yaml", prefix))
+		}
+		if budget.HardTokens > 0 && budget.SoftTokens >= budget.HardTokens {
+			errs = append(errs, fmt.Errorf("%s.soft_tokens: must be below hard_tokens. Fix: lower chat_agent.budgets[%d].soft_tokens in flowbot.yaml", prefix, i))
+		}
+		if budget.HardCost > 0 && budget.SoftCost >= budget.HardCost {
+			errs = append(errs, fmt.Errorf("%s.soft_cost: must be below hard_cost. Fix: lower chat_agent.budgets[%d].soft_cost in flowbot.yaml", prefix, i))
+		}
+	}
+	return errs
+}
+
 // appendTagErrors converts go-playground validator errors into ValidationErrors
 // with a field path prefix and fix suggestion.
 func appendTagErrors(errs ValidationErrors, err error, prefix string) ValidationErrors {
@@ -224,7 +415,8 @@ func appendTagErrors(errs ValidationErrors, err error, prefix string) Validation
 }
 
 // ReachabilityCheck attempts PostgreSQL and Redis connections with short
-// timeouts to verify that dependencies are reachable. Only call this after
+// timeouts to verify that dependencies are reachable. Redis is skipped when
+// redis.backend is "memory". Only call this after
 // Validate() passes, since it assumes required fields are non-empty.
 func (t *Type) ReachabilityCheck(ctx context.Context) error {
 	var errs ValidationErrors
@@ -243,25 +435,27 @@ func (t *Type) ReachabilityCheck(ctx context.Context) error {
 		cancel()
 	}
 
-	opts, err := redis.ParseURL(t.Redis.URL)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("redis: invalid url: %w. Fix: set redis.url in flowbot.yaml", err))
-	} else {
-		opts.DialTimeout = 3 * time.Second
-		opts.ReadTimeout = 3 * time.Second
-		opts.WriteTimeout = 3 * time.Second
-		rdb := redis.NewClient(opts)
-		redisCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
-		if err := rdb.Ping(redisCtx).Err(); err != nil {
-			u, parseErr := url.Parse(t.Redis.URL)
-			addr := t.Redis.URL
-			if parseErr == nil {
-				addr = u.Host
+	if !t.Redis.InMemory() {
+		opts, err := redis.ParseURL(t.Redis.URL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("redis: invalid url: %w. Fix: set redis.url in flowbot.yaml", err))
+		} else {
+			opts.DialTimeout = 3 * time.Second
+			opts.ReadTimeout = 3 * time.Second
+			opts.WriteTimeout = 3 * time.Second
+			rdb := redis.NewClient(opts)
+			redisCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			if err := rdb.Ping(redisCtx).Err(); err != nil {
+				u, parseErr := url.Parse(t.Redis.URL)
+				addr := t.Redis.URL
+				if parseErr == nil {
+					addr = u.Host
+				}
+				errs = append(errs, fmt.Errorf("redis: ping failed: %w. Fix: verify Redis is running at %s", err, addr))
 			}
-			errs = append(errs, fmt.Errorf("redis: ping failed: %w. Fix: verify Redis is running at %s", err, addr))
+			_ = rdb.Close()
+			cancel()
 		}
-		_ = rdb.Close()
-		cancel()
 	}
 
 	if len(errs) > 0 {
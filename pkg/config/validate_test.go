@@ -125,6 +125,31 @@ func TestValidate_Format(t *testing.T) {
 			},
 			wantErr: "tracing.SampleRate",
 		},
+		{
+			name: "memory backend needs no redis url",
+			mutate: func(c *Type) {
+				c.Redis = Redis{Backend: RedisBackendMemory}
+			},
+			noErr: true,
+		},
+		{
+			name:    "unknown redis backend",
+			mutate:  func(c *Type) { c.Redis.Backend = "etcd" },
+			wantErr: "redis.Backend",
+		},
+		{
+			name: "sqlite path replaces postgres dsn",
+			mutate: func(c *Type) {
+				c.Postgres.DSN = ""
+				c.SQLite.Path = "/data/flowbot.db"
+			},
+			noErr: true,
+		},
+		{
+			name:    "sqlite path with postgres dsn",
+			mutate:  func(c *Type) { c.SQLite.Path = "/data/flowbot.db" },
+			wantErr: "sqlite.path: cannot be combined with postgres.dsn",
+		},
 		{
 			name:    "invalid listen address",
 			mutate:  func(c *Type) { c.Listen = "::99999" },
@@ -228,6 +253,166 @@ func TestValidate_Conditional(t *testing.T) {
 			},
 			wantErr: "chat_agent.chat_model",
 		},
+		{
+			name: "mcp server with command and url",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{{Name: "github", Command: "gh-mcp", URL: "https://mcp.example.com"}}
+			},
+			wantErr: "chat_agent.mcp_servers[0]: set exactly one of command or url",
+		},
+		{
+			name: "mcp server name with double underscore",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{{Name: "git__hub", Command: "gh-mcp"}}
+			},
+			wantErr: "chat_agent.mcp_servers[0].name",
+		},
+		{
+			name: "mcp server name with trailing underscore",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{{Name: "github_", Command: "gh-mcp"}}
+			},
+			wantErr: "chat_agent.mcp_servers[0].name",
+		},
+		{
+			name: "mcp server name with leading underscore",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{{Name: "_github", Command: "gh-mcp"}}
+			},
+			wantErr: "chat_agent.mcp_servers[0].name",
+		},
+		{
+			name: "mcp server name with triple underscore",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{{Name: "git___hub", Command: "gh-mcp"}}
+			},
+			wantErr: "chat_agent.mcp_servers[0].name",
+		},
+		{
+			name: "mcp server duplicate name",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{
+					{Name: "docs", URL: "https://mcp.example.com/mcp"},
+					{Name: "docs", Command: "docs-mcp"},
+				}
+			},
+			wantErr: "chat_agent.mcp_servers[1].name: duplicate",
+		},
+		{
+			name: "mcp server invalid url",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{{Name: "docs", URL: "ftp://mcp.example.com"}}
+			},
+			wantErr: "chat_agent.mcp_servers[0].url",
+		},
+		{
+			name: "mcp servers valid",
+			mutate: func(c *Type) {
+				c.ChatAgent.MCPServers = []ChatAgentMCPServerConfig{
+					{Name: "docs", URL: "https://mcp.example.com/mcp"},
+					{Name: "git_hub", Command: "gh-mcp", Agents: []string{"chat", "reviewer"}},
+					{Name: "a_-b-", Command: "ab-mcp"},
+				}
+			},
+			noErr: true,
+		},
+		{
+			name: "hook with command and url",
+			mutate: func(c *Type) {
+				c.ChatAgent.Hooks = []ChatAgentHookConfig{{Name: "guard", Events: []string{"tool_call"}, Command: "guard.sh", URL: "https://hooks.example.com"}}
+			},
+			wantErr: "chat_agent.hooks[0]: set exactly one of command or url",
+		},
+		{
+			name: "hook unknown event",
+			mutate: func(c *Type) {
+				c.ChatAgent.Hooks = []ChatAgentHookConfig{{Name: "guard", Events: []string{"tool_called"}, Command: "guard.sh"}}
+			},
+			wantErr: "chat_agent.hooks[0].events: unknown event",
+		},
+		{
+			name: "hook duplicate name",
+			mutate: func(c *Type) {
+				c.ChatAgent.Hooks = []ChatAgentHookConfig{
+					{Name: "guard", Events: []string{"tool_call"}, Command: "guard.sh"},
+					{Name: "guard", Events: []string{"tool_result"}, URL: "https://hooks.example.com"},
+				}
+			},
+			wantErr: "chat_agent.hooks[1].name: duplicate",
+		},
+		{
+			name: "hook invalid failure policy",
+			mutate: func(c *Type) {
+				c.ChatAgent.Hooks = []ChatAgentHookConfig{{Name: "guard", Events: []string{"tool_call"}, Command: "guard.sh", OnError: "deny"}}
+			},
+			wantErr: "chat_agent.hooks[0].on_error",
+		},
+		{
+			name: "hooks valid",
+			mutate: func(c *Type) {
+				c.ChatAgent.Hooks = []ChatAgentHookConfig{
+					{Name: "guard", Events: []string{"tool_call"}, Tools: []string{"bash"}, Command: "guard.sh", OnError: "block"},
+					{Name: "audit", Events: []string{"tool_result", "context_compacted"}, URL: "https://hooks.example.com/flowbot", Agents: []string{"*"}},
+				}
+			},
+			noErr: true,
+		},
+		{
+			name: "budget unknown scope",
+			mutate: func(c *Type) {
+				c.ChatAgent.Budgets = []ChatAgentBudgetConfig{{Name: "daily", Scope: "team", HardTokens: 1000}}
+			},
+			wantErr: "chat_agent.budgets[0].scope",
+		},
+		{
+			name: "budget unknown window",
+			mutate: func(c *Type) {
+				c.ChatAgent.Budgets = []ChatAgentBudgetConfig{{Name: "daily", Scope: "user", Window: "weekly", HardTokens: 1000}}
+			},
+			wantErr: "chat_agent.budgets[0].window",
+		},
+		{
+			name: "budget without limits",
+			mutate: func(c *Type) {
+				c.ChatAgent.Budgets = []ChatAgentBudgetConfig{{Name: "daily", Scope: "user"}}
+			},
+			wantErr: "chat_agent.budgets[0]: no limit set",
+		},
+		{
+			name: "budget soft limit above hard limit",
+			mutate: func(c *Type) {
+				c.ChatAgent.Budgets = []ChatAgentBudgetConfig{{Name: "daily", Scope: "agent", SoftCost: 5, HardCost: 2}}
+			},
+			wantErr: "chat_agent.budgets[0].soft_cost: must be below hard_cost",
+		},
+		{
+			name: "budget duplicate name",
+			mutate: func(c *Type) {
+				c.ChatAgent.Budgets = []ChatAgentBudgetConfig{
+					{Name: "daily", Scope: "user", HardTokens: 1000},
+					{Name: "daily", Scope: "agent", HardTokens: 1000},
+				}
+			},
+			wantErr: "chat_agent.budgets[1].name: duplicate",
+		},
+		{
+			name: "model pricing negative",
+			mutate: func(c *Type) {
+				c.ChatAgent.ModelPricing = map[string]ChatAgentModelPricing{"gpt-5.3-codex": {Input: -1}}
+			},
+			wantErr: "chat_agent.model_pricing.gpt-5.3-codex",
+		},
+		{
+			name: "budgets valid",
+			mutate: func(c *Type) {
+				c.ChatAgent.ModelPricing = map[string]ChatAgentModelPricing{"gpt-5.3-codex": {Input: 1.25, Output: 10}}
+				c.ChatAgent.Budgets = []ChatAgentBudgetConfig{
+					{Name: "per-user", Scope: "user", SoftTokens: 800_000, HardTokens: 1_000_000},
+					{Name: "nightly", Scope: "scheduled_task", Match: []string{"digest"}, Window: "monthly", SoftCost: 8, HardCost: 10},
+				}
+			},
+			noErr: true,
+		},
 		{
 			name: "model missing provider",
 			mutate: func(c *Type) {
@@ -264,6 +449,49 @@ func TestValidate_Conditional(t *testing.T) {
 			},
 			wantErr: "models[0].base_url",
 		},
+		{
+			name: "model alias chain references unknown model",
+			mutate: func(c *Type) {
+				c.Models = []Model{{Provider: "openai", ModelNames: []string{"gpt-5.5"}}}
+				c.ModelAliases = []ModelAlias{{Name: "smart", Chain: []string{"gpt-5.5", "claude-sonnet-4-6"}}}
+			},
+			wantErr: `model_aliases[0].chain[1]: "claude-sonnet-4-6" not found in models`,
+		},
+		{
+			name: "model alias shadows model name",
+			mutate: func(c *Type) {
+				c.Models = []Model{{Provider: "openai", ModelNames: []string{"gpt-5.5"}}}
+				c.ModelAliases = []ModelAlias{{Name: "gpt-5.5", Chain: []string{"gpt-5.5"}}}
+			},
+			wantErr: "model_aliases[0].name",
+		},
+		{
+			name: "model alias empty chain",
+			mutate: func(c *Type) {
+				c.ModelAliases = []ModelAlias{{Name: "smart"}}
+			},
+			wantErr: "model_aliases[0].chain: must list at least one model",
+		},
+		{
+			name: "model circuit negative threshold",
+			mutate: func(c *Type) {
+				c.ModelCircuit.FailureThreshold = -1
+			},
+			wantErr: "model_circuit.failure_threshold",
+		},
+		{
+			name: "model alias as chat model across providers",
+			mutate: func(c *Type) {
+				c.Models = []Model{
+					{Provider: "openai", ModelNames: []string{"gpt-5.5"}},
+					{Provider: "anthropic", ModelNames: []string{"claude-sonnet-4-6"}},
+				}
+				c.ModelAliases = []ModelAlias{{Name: "smart", Chain: []string{"gpt-5.5", "claude-sonnet-4-6"}}}
+				c.ChatAgent.ChatModel = "smart"
+				c.ChatAgent.ToolModel = "gpt-5.5"
+			},
+			noErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
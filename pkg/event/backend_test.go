@@ -0,0 +1,93 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+)
+
+// pubSubBackends lists the clients NewPublisher and NewSubscriber accept: a
+// Redis client for Redis Streams and nil for the in-process channel pub/sub.
+var pubSubBackends = []struct {
+	name   string
+	client func(t *testing.T) *redis.Client
+}{
+	{
+		name: "redis",
+		client: func(t *testing.T) *redis.Client {
+			mr := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			t.Cleanup(func() { _ = client.Close() })
+			return client
+		},
+	},
+	{
+		name:   "memory",
+		client: func(*testing.T) *redis.Client { return nil },
+	},
+}
+
+func TestPubSubConformance(t *testing.T) {
+	for _, backend := range pubSubBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			prev := Publisher
+			t.Cleanup(func() { Publisher = prev })
+
+			lc := fxtest.NewLifecycle(t)
+			client := backend.client(t)
+			sub, err := NewSubscriber(lc, client)
+			require.NoError(t, err)
+			pub, err := NewPublisher(lc, client)
+			require.NoError(t, err)
+			assert.Same(t, pub, Publisher)
+			lc.RequireStart()
+			t.Cleanup(func() { lc.RequireStop() })
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			msgs, err := sub.Subscribe(ctx, "conformance")
+			require.NoError(t, err)
+
+			// Redis fan-out reads from "$", so probe until the subscription is live.
+			for live := false; !live; {
+				require.NoError(t, publishWith(ctx, pub, "conformance", map[string]any{"probe": true}))
+				select {
+				case m := <-msgs:
+					m.Ack()
+					live = true
+				case <-time.After(100 * time.Millisecond):
+				case <-ctx.Done():
+					t.Fatal("subscription never became live")
+				}
+			}
+
+			for i := range 3 {
+				require.NoError(t, publishWith(ctx, pub, "conformance", map[string]any{"n": i}))
+			}
+			var got []float64
+			for len(got) < 3 {
+				select {
+				case m := <-msgs:
+					var payload map[string]any
+					require.NoError(t, sonic.Unmarshal(m.Payload, &payload))
+					m.Ack()
+					if n, ok := payload["n"].(float64); ok {
+						got = append(got, n)
+						assert.Equal(t, "conformance", m.Metadata.Get("x-otel-topic"))
+					}
+				case <-ctx.Done():
+					t.Fatalf("received %v before timeout", got)
+				}
+			}
+			// The channel pub/sub delivers concurrently, so only delivery is portable.
+			assert.ElementsMatch(t, []float64{0, 1, 2}, got)
+		})
+	}
+}
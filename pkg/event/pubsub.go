@@ -1,15 +1,18 @@
-// Package event provides Watermill-based publish/subscribe infrastructure backed by Redis Streams.
+// Package event provides Watermill-based publish/subscribe infrastructure backed by Redis Streams,
+// or by in-process Go channels when redis.backend is "memory".
 package event
 
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill-redisstream/pkg/redisstream"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/bytedance/sonic"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel"
@@ -37,8 +40,55 @@ func (sharedRedisWithoutClose) Close() error {
 	return nil
 }
 
+// memoryOutputBuffer is the per-subscriber channel buffer of the in-process pub/sub.
+const memoryOutputBuffer = 256
+
+// memoryPubSub is the process-wide channel pub/sub used when rdb.NewClient
+// yields no Redis client. Publisher and subscriber must share one instance.
+// Messages are not persisted, are dropped when a topic has no subscriber and
+// may be delivered out of publish order.
+var memoryPubSub struct {
+	mu sync.Mutex
+	ps *gochannel.GoChannel
+}
+
+// sharedGoChannel returns the in-process pub/sub, creating it on first use.
+func sharedGoChannel() *gochannel.GoChannel {
+	memoryPubSub.mu.Lock()
+	defer memoryPubSub.mu.Unlock()
+	if memoryPubSub.ps == nil {
+		memoryPubSub.ps = gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: memoryOutputBuffer}, logger)
+	}
+	return memoryPubSub.ps
+}
+
+// closeGoChannel closes the in-process pub/sub. Both the publisher and the
+// subscriber hooks call it; the second call is a no-op.
+func closeGoChannel() error {
+	memoryPubSub.mu.Lock()
+	defer memoryPubSub.mu.Unlock()
+	if memoryPubSub.ps == nil {
+		return nil
+	}
+	err := memoryPubSub.ps.Close()
+	memoryPubSub.ps = nil
+	return err
+}
+
 // NewSubscriber creates a Watermill Redis Stream subscriber using the shared Redis client.
+// A nil client (redis.backend: memory) selects the in-process channel pub/sub, which
+// delivers only to handlers subscribed at publish time and keeps nothing across restarts.
 func NewSubscriber(lc fx.Lifecycle, client *redis.Client) (message.Subscriber, error) {
+	if client == nil {
+		sub := sharedGoChannel()
+		lc.Append(fx.Hook{
+			OnStop: func(_ context.Context) error {
+				return closeGoChannel()
+			},
+		})
+		return sub, nil
+	}
+
 	subscriber, err := redisstream.NewSubscriber(
 		redisstream.SubscriberConfig{
 			Client:       sharedRedisWithoutClose{UniversalClient: client},
@@ -66,7 +116,19 @@ func NewSubscriber(lc fx.Lifecycle, client *redis.Client) (message.Subscriber, e
 var Publisher message.Publisher
 
 // NewPublisher creates a Watermill Redis Stream publisher using the shared Redis client.
+// A nil client selects the in-process channel pub/sub shared with NewSubscriber.
 func NewPublisher(lc fx.Lifecycle, client *redis.Client) (message.Publisher, error) {
+	if client == nil {
+		pub := sharedGoChannel()
+		Publisher = pub
+		lc.Append(fx.Hook{
+			OnStop: func(_ context.Context) error {
+				return closeGoChannel()
+			},
+		})
+		return pub, nil
+	}
+
 	pub, err := redisstream.NewPublisher(
 		redisstream.PublisherConfig{
 			Client:     sharedRedisWithoutClose{UniversalClient: client},
@@ -3,6 +3,7 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync/atomic"
 	"time"
@@ -81,6 +82,9 @@ func (e *Engine) Close() error {
 // Run executes the given task, enforcing that only one task runs at a time.
 // It returns an error if the engine is already running or has been closed.
 func (e *Engine) Run(ctx context.Context, t *types.Task) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	if !e.state.CompareAndSwap(stateIdle, stateRunning) {
 		return fmt.Errorf("engine is not idle, current state: %s", e.State())
 	}
@@ -184,6 +188,11 @@ func (e *Engine) doRunTask(ctx context.Context, t *types.Task) error {
 	// run the task
 	rtTask := t.Clone()
 	if err := e.runtime.Run(rctx, rtTask); err != nil {
+		// Surface caller cancellation even when the runtime reports it as a
+		// plain failure, so a stopped run is not recorded as failed.
+		if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+			err = fmt.Errorf("%w: %w", ctxErr, err)
+		}
 		finished := time.Now().UTC()
 		t.FailedAt = &finished
 		t.State = types.TaskStateFailed
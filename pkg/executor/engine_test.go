@@ -126,3 +126,13 @@ func TestEngineUnknownRuntime(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unknown runtime type")
 }
+
+func TestEngineRunCancelledContext(t *testing.T) {
+	t.Parallel()
+	e := New(runtime.Capability)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := e.Run(ctx, &types.Task{Run: "capability:example.list"})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "IDLE", e.State())
+}
@@ -266,7 +266,10 @@ func (d *Runtime) doRun(ctx context.Context, t *types.Task) error {
 		return fmt.Errorf("error reading the std out, %w", err)
 	}
 
-	return d.waitForCompletion(ctx, t, resp.ID)
+	if err := d.waitForCompletion(ctx, t, resp.ID); err != nil {
+		return err
+	}
+	return d.readArtifacts(ctx, resp.ID, t)
 }
 
 func buildEnvVars(t *types.Task) []string {
@@ -352,7 +355,7 @@ func buildContainerConfig(t *types.Task, workdirTarget string, env []string) con
 			"flowbot.component": "executor-docker",
 		},
 	}
-	if len(t.Files) > 0 {
+	if len(t.Files) > 0 || len(t.Outputs) > 0 {
 		cc.WorkingDir = workdirTarget
 	}
 	return cc
@@ -446,6 +449,58 @@ func (d *Runtime) readOutput(ctx context.Context, containerID string) (string, e
 	return buf.String(), nil
 }
 
+// readArtifacts copies the task's declared output files out of the workdir into t.Artifacts.
+func (d *Runtime) readArtifacts(ctx context.Context, containerID string, t *types.Task) error {
+	if len(t.Outputs) == 0 {
+		return nil
+	}
+	artifacts := make(map[string]string, len(t.Outputs))
+	for _, p := range t.Outputs {
+		clean, err := runtime.CleanOutputPath(p)
+		if err != nil {
+			return err
+		}
+		data, err := d.readWorkdirFile(ctx, containerID, clean)
+		if err != nil {
+			return fmt.Errorf("error reading output %s, %w", clean, err)
+		}
+		artifacts[clean] = data
+	}
+	t.Artifacts = artifacts
+	return nil
+}
+
+// readWorkdirFile returns the contents of a single regular file under /flowbot.
+func (d *Runtime) readWorkdirFile(ctx context.Context, containerID, name string) (string, error) {
+	res, err := d.client.CopyFromContainer(ctx, containerID, client.CopyFromContainerOptions{
+		SourcePath: "/flowbot/" + name,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := res.Content.Close(); err != nil {
+			flog.Error(fmt.Errorf("error closing /flowbot/%s reader, %w", name, err))
+		}
+	}()
+	tr := tar.NewReader(res.Content)
+	hdr, err := tr.Next()
+	if err != nil {
+		return "", err
+	}
+	if hdr.Typeflag != tar.TypeReg {
+		return "", fmt.Errorf("not a regular file")
+	}
+	if hdr.Size > runtime.MaxOutputFileSize {
+		return "", fmt.Errorf("exceeds %d bytes", runtime.MaxOutputFileSize)
+	}
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, tr, hdr.Size); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func (d *Runtime) initWorkdir(ctx context.Context, containerID string, t *types.Task) error {
 	flog.Info("initialize the workdir for container %s", containerID)
 	// create the archive
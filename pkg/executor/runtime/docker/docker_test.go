@@ -454,6 +454,27 @@ func TestRunTaskInitWorkdir(t *testing.T) {
 	})
 }
 
+func TestRunTaskOutputs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("collect declared output files", func(t *testing.T) {
+		t.Parallel()
+		skipIfNoDocker(t)
+		rt, err := NewRuntime()
+		require.NoError(t, err)
+		t1 := &types.Task{
+			ID:      utils.NewUUID(),
+			Image:   testImage,
+			Run:     "mkdir -p out && cat artifacts/build/in.txt > out/report.txt",
+			Files:   map[string]string{"artifacts/build/in.txt": "report"},
+			Outputs: []string{"out/report.txt"},
+		}
+		err = rt.Run(context.Background(), t1)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"out/report.txt": "report"}, t1.Artifacts)
+	})
+}
+
 func Test_imagePull(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,27 @@
+package runtime
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// MaxOutputFileSize caps the size of a single task output file collected into Task.Artifacts.
+const MaxOutputFileSize = 32 * 1024 * 1024
+
+// CleanOutputPath validates a workdir-relative task path and returns it in clean slash form.
+// Absolute paths and paths escaping the workdir are rejected.
+func CleanOutputPath(p string) (string, error) {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return "", fmt.Errorf("output path is required")
+	}
+	if strings.HasPrefix(p, "/") || strings.Contains(p, `\`) {
+		return "", fmt.Errorf("output path %q must be relative to the workdir", p)
+	}
+	clean := path.Clean(p)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("output path %q escapes the workdir", p)
+	}
+	return clean, nil
+}
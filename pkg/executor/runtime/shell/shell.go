@@ -9,8 +9,10 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
+	"github.com/flowline-io/flowbot/pkg/executor/runtime"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/utils"
@@ -148,6 +150,38 @@ func (r *Runtime) doRun(ctx context.Context, t *types.Task) error {
 
 	t.Result = string(output)
 
+	return collectOutputs(workdir, t)
+}
+
+// collectOutputs reads the task's declared output files from the workdir into t.Artifacts.
+func collectOutputs(workdir string, t *types.Task) error {
+	if len(t.Outputs) == 0 {
+		return nil
+	}
+	artifacts := make(map[string]string, len(t.Outputs))
+	for _, p := range t.Outputs {
+		clean, err := runtime.CleanOutputPath(p)
+		if err != nil {
+			return err
+		}
+		filename := filepath.Join(workdir, filepath.FromSlash(clean))
+		info, err := os.Stat(filename)
+		if err != nil {
+			return fmt.Errorf("error reading output %s, %w", clean, err)
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("output %s is not a regular file", clean)
+		}
+		if info.Size() > runtime.MaxOutputFileSize {
+			return fmt.Errorf("output %s exceeds %d bytes", clean, runtime.MaxOutputFileSize)
+		}
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("error reading output %s, %w", clean, err)
+		}
+		artifacts[clean] = string(data)
+	}
+	t.Artifacts = artifacts
 	return nil
 }
 
@@ -193,6 +227,9 @@ func (*Runtime) setupWorkdir(t *types.Task) (string, error) {
 
 	for filename, contents := range t.Files {
 		filename = fmt.Sprintf("%s/%s", workdir, filename)
+		if err := os.MkdirAll(filepath.Dir(filename), 0o750); err != nil {
+			return "", fmt.Errorf("error creating directory for file: %s, %w", filename, err)
+		}
 		if err := os.WriteFile(filename, []byte(contents), 0444); err != nil {
 			return "", fmt.Errorf("error writing file: %s, %w", filename, err)
 		}
@@ -108,6 +108,50 @@ func TestShellRuntimeRunFile(t *testing.T) {
 	})
 }
 
+func TestShellRuntimeRunOutputs(t *testing.T) {
+	t.Parallel()
+	skipIfWindows(t)
+
+	tests := []struct {
+		name    string
+		run     string
+		outputs []string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:    "collects declared files",
+			run:     "mkdir -p out && echo -n report > out/report.txt && cat artifacts/build/in.txt > copy.txt",
+			outputs: []string{"out/report.txt", "./copy.txt"},
+			want:    map[string]string{"out/report.txt": "report", "copy.txt": "input"},
+		},
+		{name: "missing file", run: "true", outputs: []string{"missing.txt"}, wantErr: "missing.txt"},
+		{name: "escaping path", run: "true", outputs: []string{"../etc/passwd"}, wantErr: "escapes the workdir"},
+		{name: "directory", run: "mkdir out", outputs: []string{"out"}, wantErr: "not a regular file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rt := NewShellRuntime(Config{Rexec: mockReexec})
+			tk := &types.Task{
+				ID:      utils.NewUUID(),
+				Run:     tt.run,
+				Files:   map[string]string{"artifacts/build/in.txt": "input"},
+				Outputs: tt.outputs,
+			}
+
+			err := rt.Run(context.Background(), tk)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tk.Artifacts)
+		})
+	}
+}
+
 func TestShellRuntimeRunNotSupported(t *testing.T) {
 	t.Parallel()
 
@@ -115,6 +115,8 @@ type Config struct {
 	ModuleLevel map[string]string
 	Sampling    *SamplingConfig
 	Rotation    *RotationConfig
+	// Output receives console and JSON logs; nil means os.Stdout.
+	Output io.Writer
 }
 
 // SamplingConfig controls log sampling to reduce noise from high-frequency log points.
@@ -144,11 +146,15 @@ func Init(cfg Config) {
 	var writers []io.Writer
 
 	// stdout
+	out := cfg.Output
+	if out == nil {
+		out = os.Stdout
+	}
 	if cfg.JSONOutput {
-		writers = append(writers, os.Stdout)
+		writers = append(writers, out)
 	} else {
 		console := zerolog.ConsoleWriter{
-			Out:        os.Stdout,
+			Out:        out,
 			TimeFormat: time.DateTime,
 			NoColor:    true,
 			FormatLevel: func(i any) string {
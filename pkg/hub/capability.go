@@ -17,6 +17,10 @@ const (
 	CapGitea        CapabilityType = "gitea"
 	CapGithub       CapabilityType = "github"
 	CapDevops       CapabilityType = "devops"
+	CapAdguard      CapabilityType = "adguard"
+	CapArchivebox   CapabilityType = "archivebox"
+	CapN8n          CapabilityType = "n8n"
+	CapSlash        CapabilityType = "slash"
 	CapExample      CapabilityType = "example"
 	// CapCore is the internal multi-op capability (notify, clip, agent, http, run_*, kv_*).
 	CapCore CapabilityType = "core"
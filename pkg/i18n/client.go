@@ -37,6 +37,7 @@ var clientMessageIDs = []string{
 	"client.pipeline.webhook_path_required",
 	"client.pipeline.auth_required",
 	"client.pipeline.cron_required",
+	"client.pipeline.filter_invalid",
 	"client.pipeline.step_name_required",
 	"client.pipeline.capability_required",
 	"client.pipeline.operation_required",
@@ -145,6 +146,7 @@ var clientMessageIDs = []string{
 	"client.pipeline.relative_days",
 	"client.pipeline.trigger_event_prefix",
 	"client.pipeline.trigger_cron_prefix",
+	"client.pipeline.trigger_filter_prefix",
 	"client.pipeline.unnamed_step",
 	"client.pipeline.placeholder_ellipsis",
 	"client.pipeline.custom_event_suffix",
@@ -0,0 +1,25 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// NewProxy returns an SDK server offering the tools of remote and forwarding
+// calls to it. `flowbot mcp serve` runs it over stdio so local MCP clients
+// reach the Flowbot server with the user's token. The tool list is read once,
+// which matches the remote server: a token's tools only change with a restart.
+func NewProxy(ctx context.Context, info *sdk.Implementation, remote *sdk.ClientSession) (*sdk.Server, error) {
+	srv := sdk.NewServer(info, &sdk.ServerOptions{Instructions: instructions, HasTools: true})
+	for tool, err := range remote.Tools(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("mcp proxy: list tools: %w", err)
+		}
+		srv.AddTool(tool, func(ctx context.Context, req *sdk.CallToolRequest) (*sdk.CallToolResult, error) {
+			return remote.CallTool(ctx, &sdk.CallToolParams{Name: req.Params.Name, Arguments: req.Params.Arguments})
+		})
+	}
+	return srv, nil
+}
@@ -0,0 +1,47 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+)
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+	s, calls := newTestServer(t)
+	remote := connect(t, s, []string{auth.ScopeServiceKanboardRead})
+	ctx := context.Background()
+	proxy, err := NewProxy(ctx, &sdk.Implementation{Name: "flowbot-cli"}, remote)
+	require.NoError(t, err)
+
+	clientT, serverT := sdk.NewInMemoryTransports()
+	ss, err := proxy.Connect(ctx, serverT, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ss.Close() })
+	cs, err := sdk.NewClient(&sdk.Implementation{Name: "editor"}, nil).Connect(ctx, clientT, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cs.Close() })
+
+	tools, err := cs.ListTools(ctx, nil)
+	require.NoError(t, err)
+	names := make([]string, 0, len(tools.Tools))
+	for _, tool := range tools.Tools {
+		names = append(names, tool.Name)
+	}
+	assert.Equal(t, []string{"kanboard_fail", "kanboard_list_tasks"}, names)
+
+	res, err := cs.CallTool(ctx, &sdk.CallToolParams{Name: "kanboard_list_tasks", Arguments: map[string]any{"project_id": 3}})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.Equal(t, `{"data":{"params":{"project_id":3}}}`, resultText(res))
+	assert.Equal(t, []string{"kanboard_list_tasks"}, *calls)
+
+	res, err = cs.CallTool(ctx, &sdk.CallToolParams{Name: "kanboard_list_tasks"})
+	require.NoError(t, err)
+	assert.True(t, res.IsError, "tool errors from the server pass through")
+}
@@ -0,0 +1,218 @@
+// Package mcp serves Flowbot capability operations as Model Context Protocol
+// tools, and proxies them over stdio for the CLI.
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+)
+
+// InvokeFunc invokes a capability operation. capability.Invoke satisfies it.
+type InvokeFunc func(ctx context.Context, capType hub.CapabilityType, operation string, params map[string]any) (*capability.InvokeResult, error)
+
+// instructions tells clients how tool names map to capabilities.
+const instructions = "Tools are Flowbot capability operations named <capability>_<operation>."
+
+// Server exposes hub capability operations as MCP tools. The protocol is
+// handled by github.com/modelcontextprotocol/go-sdk; Server builds an SDK
+// server per caller holding only the tools that caller's scopes allow.
+type Server struct {
+	info     *sdk.Implementation
+	registry *hub.Registry
+	invoke   InvokeFunc
+}
+
+// NewServer returns a Server that lists tools from registry and calls them
+// through invoke.
+func NewServer(info *sdk.Implementation, registry *hub.Registry, invoke InvokeFunc) *Server {
+	return &Server{info: info, registry: registry, invoke: invoke}
+}
+
+// ToolName returns the MCP tool name for a capability operation.
+func ToolName(capType hub.CapabilityType, operation string) string {
+	return string(capType) + "_" + operation
+}
+
+// Handler serves the streamable HTTP transport. scopes returns the caller's
+// scopes for each request. Every request is authenticated by its token, so
+// the handler is stateless and answers with plain JSON. Localhost protection
+// is off because requests reach it through the authenticating router, often
+// behind a reverse proxy on loopback, which the SDK would otherwise reject.
+func (s *Server) Handler(scopes func(*http.Request) []string) http.Handler {
+	return sdk.NewStreamableHTTPHandler(func(r *http.Request) *sdk.Server {
+		return s.ForScopes(scopes(r))
+	}, &sdk.StreamableHTTPOptions{Stateless: true, JSONResponse: true, DisableLocalhostProtection: true})
+}
+
+// ForScopes returns an SDK server offering the tools a caller holding scopes
+// may call. Tools outside the scopes are neither listed nor callable.
+func (s *Server) ForScopes(scopes []string) *sdk.Server {
+	srv := sdk.NewServer(s.info, &sdk.ServerOptions{Instructions: instructions, HasTools: true})
+	for _, tool := range s.Tools(scopes) {
+		srv.AddTool(tool, s.handler(tool.Name))
+	}
+	return srv
+}
+
+// Tools returns the tools visible to a caller holding scopes.
+func (s *Server) Tools(scopes []string) []*sdk.Tool {
+	var tools []*sdk.Tool
+	for _, desc := range s.registry.List() {
+		for _, op := range desc.Operations {
+			if !operationAllowed(scopes, desc.Type, op) {
+				continue
+			}
+			tools = append(tools, toolFromOperation(desc, op))
+		}
+	}
+	return tools
+}
+
+func (s *Server) handler(name string) sdk.ToolHandler {
+	return func(ctx context.Context, req *sdk.CallToolRequest) (*sdk.CallToolResult, error) {
+		desc, op, ok := s.lookup(name)
+		if !ok {
+			return textResult("unknown tool: "+name, true), nil
+		}
+		var args map[string]any
+		if raw := req.Params.Arguments; len(raw) > 0 {
+			if err := sonic.Unmarshal(raw, &args); err != nil {
+				return textResult("invalid arguments: "+err.Error(), true), nil
+			}
+		}
+		if args == nil {
+			args = map[string]any{}
+		}
+		for _, p := range op.Input {
+			if _, present := args[p.Name]; p.Required && !present {
+				return textResult(fmt.Sprintf("missing required argument %q", p.Name), true), nil
+			}
+		}
+		res, err := s.invoke(ctx, desc.Type, op.Name, args)
+		if err != nil {
+			return textResult(err.Error(), true), nil
+		}
+		return resultFromInvoke(res), nil
+	}
+}
+
+func (s *Server) lookup(name string) (hub.Descriptor, hub.Operation, bool) {
+	capName, opName, ok := strings.Cut(name, "_")
+	if !ok {
+		return hub.Descriptor{}, hub.Operation{}, false
+	}
+	desc, ok := s.registry.Get(hub.CapabilityType(capName))
+	if !ok {
+		return hub.Descriptor{}, hub.Operation{}, false
+	}
+	for _, op := range desc.Operations {
+		if op.Name == opName {
+			return desc, op, true
+		}
+	}
+	return hub.Descriptor{}, hub.Operation{}, false
+}
+
+func operationAllowed(scopes []string, capType hub.CapabilityType, op hub.Operation) bool {
+	return auth.HasOperationScope(scopes, string(capType), op.Scopes, capability.IsMutation(op.Name))
+}
+
+func toolFromOperation(desc hub.Descriptor, op hub.Operation) *sdk.Tool {
+	mutation := capability.IsMutation(op.Name)
+	description := op.Description
+	if desc.App != "" {
+		description = strings.TrimSpace(description + " (" + desc.App + ")")
+	}
+	return &sdk.Tool{
+		Name:        ToolName(desc.Type, op.Name),
+		Title:       op.Description,
+		Description: description,
+		InputSchema: InputSchema(op.Input),
+		Annotations: &sdk.ToolAnnotations{ReadOnlyHint: !mutation, DestructiveHint: &mutation},
+	}
+}
+
+// InputSchema converts operation parameters to a JSON Schema object.
+func InputSchema(params []hub.ParamDef) map[string]any {
+	props := make(map[string]any, len(params))
+	var required []string
+	for _, p := range params {
+		prop := schemaForType(p.Type)
+		if p.Description != "" {
+			prop["description"] = p.Description
+		}
+		props[p.Name] = prop
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+	schema := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func schemaForType(t string) map[string]any {
+	switch strings.ToLower(strings.TrimSpace(t)) {
+	case "string", "text":
+		return map[string]any{"type": "string"}
+	case "int", "int64", "integer":
+		return map[string]any{"type": "integer"}
+	case "number", "float", "float64":
+		return map[string]any{"type": "number"}
+	case "bool", "boolean":
+		return map[string]any{"type": "boolean"}
+	case "[]string":
+		return map[string]any{"type": "array", "items": map[string]any{"type": "string"}}
+	case "[]int", "[]int64":
+		return map[string]any{"type": "array", "items": map[string]any{"type": "integer"}}
+	case "array":
+		return map[string]any{"type": "array"}
+	case "object", "map[string]any":
+		return map[string]any{"type": "object"}
+	default:
+		return map[string]any{}
+	}
+}
+
+func resultFromInvoke(res *capability.InvokeResult) *sdk.CallToolResult {
+	if res == nil {
+		return textResult("", false)
+	}
+	payload := map[string]any{}
+	if res.Data != nil {
+		payload["data"] = res.Data
+	}
+	if res.Page != nil {
+		payload["page"] = res.Page
+	}
+	if len(payload) == 0 && res.Text != "" {
+		return textResult(res.Text, false)
+	}
+	text, err := sonic.MarshalString(payload)
+	if err != nil {
+		return textResult(fmt.Sprintf("encode result: %v", err), true)
+	}
+	if res.Text != "" {
+		text = res.Text + "\n" + text
+	}
+	out := textResult(text, false)
+	out.StructuredContent = payload
+	return out
+}
+
+// textResult returns a result with a single text block. Tool failures are
+// reported with IsError rather than as JSON-RPC errors so the model sees them.
+func textResult(text string, isError bool) *sdk.CallToolResult {
+	return &sdk.CallToolResult{Content: []sdk.Content{&sdk.TextContent{Text: text}}, IsError: isError}
+}
@@ -0,0 +1,229 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/auth"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+)
+
+func newTestServer(t *testing.T) (*Server, *[]string) {
+	t.Helper()
+	registry := hub.NewRegistry()
+	require.NoError(t, registry.Register(hub.Descriptor{
+		Type: hub.CapKanboard,
+		App:  "kanboard",
+		Operations: []hub.Operation{
+			{
+				Name: "list_tasks", Description: "List tasks", Scopes: []string{auth.ScopeServiceKanboardRead},
+				Input: []hub.ParamDef{{Name: "project_id", Type: "int", Required: true, Description: "Project"}},
+			},
+			{Name: "delete", Description: "Delete a task", Scopes: []string{auth.ScopeServiceKanboardWrite}},
+			{Name: "fail", Description: "Always fails", Scopes: []string{auth.ScopeServiceKanboardRead}},
+		},
+	}))
+	var calls []string
+	invoke := func(_ context.Context, capType hub.CapabilityType, op string, params map[string]any) (*capability.InvokeResult, error) {
+		calls = append(calls, ToolName(capType, op))
+		if op == "fail" {
+			return nil, errors.New("upstream down")
+		}
+		return &capability.InvokeResult{Data: map[string]any{"params": params}}, nil
+	}
+	return NewServer(&sdk.Implementation{Name: "flowbot", Version: "test"}, registry, invoke), &calls
+}
+
+// connect opens a client session to s on behalf of a caller holding scopes.
+func connect(t *testing.T, s *Server, scopes []string) *sdk.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	clientT, serverT := sdk.NewInMemoryTransports()
+	ss, err := s.ForScopes(scopes).Connect(ctx, serverT, nil)
+	require.NoError(t, err)
+	cs, err := sdk.NewClient(&sdk.Implementation{Name: "test"}, nil).Connect(ctx, clientT, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = cs.Close()
+		_ = ss.Close()
+	})
+	return cs
+}
+
+func resultText(res *sdk.CallToolResult) string {
+	parts := make([]string, 0, len(res.Content))
+	for _, c := range res.Content {
+		if text, ok := c.(*sdk.TextContent); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func TestServer_Initialize(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestServer(t)
+	cs := connect(t, s, nil)
+	init := cs.InitializeResult()
+	assert.Equal(t, "flowbot", init.ServerInfo.Name)
+	assert.NotNil(t, init.Capabilities.Tools, "tools are advertised even when the caller may call none")
+	assert.Contains(t, init.Instructions, "<capability>_<operation>")
+	require.NoError(t, cs.Ping(context.Background(), nil))
+}
+
+func TestServer_ToolsListFiltersByScope(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestServer(t)
+	tests := []struct {
+		name   string
+		scopes []string
+		want   []string
+	}{
+		{name: "no scopes lists nothing", scopes: nil, want: []string{}},
+		{name: "read scope hides mutations", scopes: []string{auth.ScopeServiceKanboardRead}, want: []string{"kanboard_fail", "kanboard_list_tasks"}},
+		{name: "write scope lists everything", scopes: []string{auth.ScopeServiceKanboardWrite}, want: []string{"kanboard_delete", "kanboard_fail", "kanboard_list_tasks"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := connect(t, s, tt.scopes).ListTools(context.Background(), nil)
+			require.NoError(t, err)
+			names := make([]string, 0, len(res.Tools))
+			for _, tool := range res.Tools {
+				names = append(names, tool.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestServer_ToolSchemaAndAnnotations(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestServer(t)
+	tools := s.Tools([]string{auth.ScopeAdmin})
+	require.Len(t, tools, 3)
+
+	list := tools[0]
+	assert.Equal(t, map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"project_id": map[string]any{"type": "integer", "description": "Project"}},
+		"required":   []string{"project_id"},
+	}, list.InputSchema)
+	require.NotNil(t, list.Annotations)
+	assert.True(t, list.Annotations.ReadOnlyHint)
+	assert.False(t, *list.Annotations.DestructiveHint)
+
+	del := tools[1]
+	assert.True(t, *del.Annotations.DestructiveHint)
+	assert.False(t, del.Annotations.ReadOnlyHint)
+}
+
+func TestServer_ToolsCall(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		scopes    []string
+		tool      string
+		args      any
+		wantRPC   bool
+		wantError bool
+		wantText  string
+		wantCalls int
+	}{
+		{
+			name:      "invokes the capability",
+			scopes:    []string{auth.ScopeServiceKanboardRead},
+			tool:      "kanboard_list_tasks",
+			args:      map[string]any{"project_id": 3},
+			wantText:  `{"data":{"params":{"project_id":3}}}`,
+			wantCalls: 1,
+		},
+		{
+			name:     "missing required argument",
+			scopes:   []string{auth.ScopeServiceKanboardRead},
+			tool:     "kanboard_list_tasks",
+			wantText: `missing required argument "project_id"`, wantError: true,
+		},
+		{
+			name:    "insufficient scope",
+			scopes:  []string{auth.ScopeServiceKanboardRead},
+			tool:    "kanboard_delete",
+			wantRPC: true,
+		},
+		{
+			name:    "unknown tool",
+			scopes:  []string{auth.ScopeAdmin},
+			tool:    "kanboard_nope",
+			wantRPC: true,
+		},
+		{
+			name:      "invoke error is a tool error",
+			scopes:    []string{auth.ScopeAdmin},
+			tool:      "kanboard_fail",
+			wantText:  "upstream down",
+			wantError: true, wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, calls := newTestServer(t)
+			res, err := connect(t, s, tt.scopes).CallTool(context.Background(), &sdk.CallToolParams{Name: tt.tool, Arguments: tt.args})
+			if tt.wantRPC {
+				var rpcErr *jsonrpc.Error
+				require.ErrorAs(t, err, &rpcErr)
+				assert.Empty(t, *calls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantError, res.IsError)
+			assert.Equal(t, tt.wantText, resultText(res))
+			assert.Len(t, *calls, tt.wantCalls)
+		})
+	}
+}
+
+func TestServer_Handler(t *testing.T) {
+	t.Parallel()
+	s, calls := newTestServer(t)
+	ts := httptest.NewServer(s.Handler(func(r *http.Request) []string {
+		return strings.Fields(r.Header.Get("X-Scopes"))
+	}))
+	t.Cleanup(ts.Close)
+
+	client := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.Header.Set("X-Scopes", auth.ScopeServiceKanboardRead)
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	ctx := context.Background()
+	cs, err := sdk.NewClient(&sdk.Implementation{Name: "test"}, nil).
+		Connect(ctx, &sdk.StreamableClientTransport{Endpoint: ts.URL, HTTPClient: client}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cs.Close() })
+
+	res, err := cs.CallTool(ctx, &sdk.CallToolParams{Name: "kanboard_list_tasks", Arguments: map[string]any{"project_id": 1}})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.Equal(t, map[string]any{"data": map[string]any{"params": map[string]any{"project_id": float64(1)}}}, res.StructuredContent)
+	assert.Equal(t, []string{"kanboard_list_tasks"}, *calls)
+
+	resp, err := http.Get(ts.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode, "stateless servers offer no server-to-client stream")
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -79,9 +79,8 @@ func (fh *fshandler) Upload(fdef *types.FileDef, file io.ReadSeeker) (string, in
 	// FIXME: create two-three levels of nested directories. Serving from a single directory
 	// with tens of thousands of files in it will not perform well.
 
-	// Generate a unique file name and attach it to path. Using base32 instead of base64 to avoid possible
-	// file name collisions on Windows due to case-insensitive file names there.
-	fdef.Location = filepath.Join(fh.fileUploadLocation, fdef.Uid().String())
+	// Name the file after its unique ID so uploads by the same user do not overwrite each other.
+	fdef.Location = filepath.Join(fh.fileUploadLocation, fdef.Id)
 
 	if fdef.Size > appConfig.App.Media.MaxFileUploadSize {
 		return "", 0, fmt.Errorf("error max file upload size, %d > %d", fdef.Size, appConfig.App.Media.MaxFileUploadSize)
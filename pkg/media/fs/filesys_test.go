@@ -203,7 +203,7 @@ func TestUpload(t *testing.T) {
 				assert.Empty(t, url)
 				assert.Zero(t, n)
 				if tt.startErr != nil {
-					_, statErr := os.Stat(filepath.Join(dir, "user-1"))
+					_, statErr := os.Stat(filepath.Join(dir, "file-1"))
 					assert.True(t, os.IsNotExist(statErr))
 				}
 				return
@@ -214,8 +214,8 @@ func TestUpload(t *testing.T) {
 			if tt.mime == "text/plain" {
 				assert.True(t, strings.HasSuffix(url, ".txt") || strings.Contains(url, "file-1"))
 			}
-			// Flat layout uses FileDef.Uid() which is User, not Id (FIXME nested dirs).
-			_, err = os.Stat(filepath.Join(dir, "user-1"))
+			// Flat layout names files by FileDef.Id (FIXME nested dirs).
+			_, err = os.Stat(filepath.Join(dir, "file-1"))
 			require.NoError(t, err)
 		})
 	}
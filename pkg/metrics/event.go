@@ -14,6 +14,7 @@ type EventCollector struct {
 	receivedTotal *prometheus.CounterVec
 	matchedTotal  *prometheus.CounterVec
 	dedupTotal    *prometheus.CounterVec
+	filteredTotal *prometheus.CounterVec
 	lagSeconds    *prometheus.HistogramVec
 }
 
@@ -40,6 +41,11 @@ func NewEventCollector(st *stats.Stats) *EventCollector {
 		log.Printf("[metrics] event: failed to register counter vec: %v", err)
 		return &EventCollector{}
 	}
+	c.filteredTotal, err = st.RegisterCounterVec("event_filtered_total", "Events rejected by a pipeline trigger filter", "event_type", "pipeline")
+	if err != nil {
+		log.Printf("[metrics] event: failed to register counter vec: %v", err)
+		return &EventCollector{}
+	}
 	c.lagSeconds, err = st.RegisterHistogramVec("event_lag_seconds", "Delay from event creation to consumption", "event_type")
 	if err != nil {
 		log.Printf("[metrics] event: failed to register histogram vec: %v", err)
@@ -75,6 +81,15 @@ func (c *EventCollector) IncDedup(eventType, pipeline string) {
 	c.dedupTotal.WithLabelValues(sanitizeLabel(eventType), sanitizeLabel(pipeline)).Inc()
 }
 
+// IncFiltered increments the filtered counter for the given event type and pipeline.
+func (c *EventCollector) IncFiltered(eventType, pipeline string) {
+	if c.filteredTotal == nil {
+		return
+	}
+	defer recoverLog("event_filtered_total")
+	c.filteredTotal.WithLabelValues(sanitizeLabel(eventType), sanitizeLabel(pipeline)).Inc()
+}
+
 // ObserveLag records a lag observation in seconds for the given event type.
 func (c *EventCollector) ObserveLag(eventType string, seconds float64) {
 	if c.lagSeconds == nil {
@@ -16,6 +16,7 @@ func TestNewEventCollector(t *testing.T) {
 		c.IncReceived("bookmark.created", "ability")
 		c.IncMatched("bookmark.created", "archive-items")
 		c.IncDedup("bookmark.created", "archive-items")
+		c.IncFiltered("bookmark.created", "archive-items")
 		c.ObserveLag("bookmark.created", 0.5)
 	})
 }
@@ -60,6 +61,7 @@ func TestEventCollector_NoopMethodsDontPanic(t *testing.T) {
 		{name: "IncReceived", fn: func() { c.IncReceived("e", "s") }},
 		{name: "IncMatched", fn: func() { c.IncMatched("e", "p") }},
 		{name: "IncDedup", fn: func() { c.IncDedup("e", "p") }},
+		{name: "IncFiltered", fn: func() { c.IncFiltered("e", "p") }},
 		{name: "ObserveLag", fn: func() { c.ObserveLag("e", 1.0) }},
 	}
 	for _, tt := range tests {
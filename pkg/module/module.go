@@ -326,6 +326,9 @@ func Shortcut(title, link string) (string, error) {
 	}
 
 	client := slash.GetClient()
+	if client == nil {
+		return "", fmt.Errorf("slash not configured")
+	}
 	err = client.CreateShortcut(slash.Shortcut{
 		Name:  name,
 		Link:  link,
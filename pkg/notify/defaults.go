@@ -22,6 +22,10 @@ const (
 	AgentApprovalTemplateID = "agent.approval"
 	// AgentApprovalTemplateBody is the default body for AgentApprovalTemplateID.
 	AgentApprovalTemplateBody = "{{ .summary }}"
+	// AgentBudgetTemplateID is the seeded template for LLM budget limit alerts.
+	AgentBudgetTemplateID = "agent.budget"
+	// AgentBudgetTemplateBody is the default body for AgentBudgetTemplateID.
+	AgentBudgetTemplateBody = "{{ .summary }}"
 	// LifeQuestCompletedTemplateID is the seeded template for life quest completion inbox alerts.
 	LifeQuestCompletedTemplateID = "life.quest.completed"
 	// LifeQuestCompletedTemplateBody is the default body for LifeQuestCompletedTemplateID.
@@ -30,6 +34,10 @@ const (
 	LifeQuestFailedTemplateID = "life.quest.failed"
 	// LifeQuestFailedTemplateBody is the default body for LifeQuestFailedTemplateID.
 	LifeQuestFailedTemplateBody = "{{ .summary }}"
+	// RunApprovalTemplateID is the seeded template for pipeline/workflow approval gates.
+	RunApprovalTemplateID = "run.approval"
+	// RunApprovalTemplateBody is the default body for RunApprovalTemplateID.
+	RunApprovalTemplateBody = "{{ .summary }}\n{{ .message }}\nApprove: {{ .approve_url }}\nReject: {{ .reject_url }}"
 	// InappChannelURI is the seeded URI for the system inapp channel.
 	InappChannelURI = "inapp://inbox"
 )
@@ -147,6 +155,12 @@ func SeedAgentApprovalTemplate(ctx context.Context) error {
 		"Chatagent tool-approval inbox template ({{ .summary }})", AgentApprovalTemplateBody)
 }
 
+// SeedAgentBudgetTemplate ensures the agent.budget template exists.
+func SeedAgentBudgetTemplate(ctx context.Context) error {
+	return seedNotifyTemplate(ctx, AgentBudgetTemplateID, "Agent budget",
+		"LLM budget soft and hard limit alert template ({{ .summary }})", AgentBudgetTemplateBody)
+}
+
 // SeedLifeQuestCompletedTemplate ensures the life.quest.completed template exists.
 func SeedLifeQuestCompletedTemplate(ctx context.Context) error {
 	return seedNotifyTemplate(ctx, LifeQuestCompletedTemplateID, "Life quest completed",
@@ -159,6 +173,12 @@ func SeedLifeQuestFailedTemplate(ctx context.Context) error {
 		"Life quest failure inbox template ({{ .summary }})", LifeQuestFailedTemplateBody)
 }
 
+// SeedRunApprovalTemplate ensures the run.approval template exists.
+func SeedRunApprovalTemplate(ctx context.Context) error {
+	return seedNotifyTemplate(ctx, RunApprovalTemplateID, "Run approval",
+		"Pipeline and workflow approval gate template ({{ .summary }}, approve/reject links)", RunApprovalTemplateBody)
+}
+
 func seedNotifyTemplate(ctx context.Context, templateID, name, description, body string) error {
 	ncs := GetNotifyConfigStore()
 	if ncs == nil {
@@ -275,3 +275,13 @@ func TestSeedLifeQuestTemplates(t *testing.T) {
 		})
 	}
 }
+
+func TestSeedAgentBudgetTemplate(t *testing.T) {
+	setupNotifyTestDB(t)
+	ctx := context.Background()
+	require.NoError(t, SeedAgentBudgetTemplate(ctx))
+	tpl, err := GetNotifyConfigStore().GetNotifyTemplateByTemplateID(ctx, AgentBudgetTemplateID)
+	require.NoError(t, err)
+	assert.Equal(t, AgentBudgetTemplateBody, tpl.DefaultTemplate)
+	require.NoError(t, SeedAgentBudgetTemplate(ctx))
+}
@@ -50,6 +50,7 @@ type Rule struct {
 	Action    RuleAction `json:"action"`
 	Match     RuleMatch  `json:"match"`
 	Condition string     `json:"condition"`
+	Timezone  string     `json:"timezone"`
 	Priority  int        `json:"priority"`
 	Params    RuleParams `json:"params"`
 }
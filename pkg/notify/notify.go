@@ -397,7 +397,7 @@ func evaluateAndRender(ctx context.Context, templateID, channel string, payload
 	var matchedRuleID string
 	ruleEngine := notifyrules.GetEngine()
 	if ruleEngine != nil {
-		ruleResult := ruleEngine.Evaluate(ctx, templateID, channel)
+		ruleResult := ruleEngine.Evaluate(ctx, templateID, channel, payload)
 		if ruleResult != nil {
 			matchedRuleID = ruleResult.RuleID
 			switch ruleResult.Action {
@@ -453,7 +453,7 @@ func testNotifyTemplate() Template {
 	}
 }
 
-func setupNotifyTestEnv(t *testing.T, templates []Template, rules []Rule, redisStore *cache.RedisStore) {
+func setupNotifyTestEnv(t *testing.T, templates []Template, rules []Rule, redisStore cache.Store) {
 	t.Helper()
 	require.NoError(t, notifytmpl.Init(templates))
 	require.NoError(t, notifyrules.Init(redisStore, rules))
@@ -604,7 +604,7 @@ func TestHandleThrottleRule(t *testing.T) {
 		},
 	}, redisStore)
 
-	ruleResult := notifyrules.GetEngine().Evaluate(context.Background(), "test.event", "slack")
+	ruleResult := notifyrules.GetEngine().Evaluate(context.Background(), "test.event", "slack", nil)
 	require.NotNil(t, ruleResult)
 
 	tests := []struct {
@@ -3,10 +3,10 @@ package rules
 
 import (
 	"context"
-	"fmt"
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/flowline-io/flowbot/pkg/cache"
 	"github.com/flowline-io/flowbot/pkg/flog"
@@ -17,18 +17,30 @@ import (
 // throttled, aggregated, or dropped.
 type Engine struct {
 	mu    sync.RWMutex
-	rules []manifest.Rule
-	store *cache.RedisStore
+	rules []compiledRule
+	store cache.Store
 }
 
+// compiledRule is a rule with its condition parsed and time zone resolved.
+// A rule whose condition or time zone is invalid never matches.
+type compiledRule struct {
+	manifest.Rule
+	cond    *Condition
+	loc     *time.Location
+	invalid bool
+}
+
+// nowFunc returns the current time. Tests override it.
+var nowFunc = time.Now
+
 // globalEngine is the singleton rule engine.
 var globalEngine struct {
 	mu     sync.RWMutex
 	engine *Engine
 }
 
-// Init initializes the global rule engine with the given rules and a RedisStore.
-func Init(store *cache.RedisStore, rules []manifest.Rule) error {
+// Init initializes the global rule engine with the given rules and a cache store.
+func Init(store cache.Store, rules []manifest.Rule) error {
 	engine := New(store)
 	if err := engine.LoadConfig(rules); err != nil {
 		return err
@@ -50,7 +62,7 @@ func GetEngine() *Engine {
 }
 
 // New creates a new rule Engine.
-func New(store *cache.RedisStore) *Engine {
+func New(store cache.Store) *Engine {
 	return &Engine{
 		store: store,
 	}
@@ -68,10 +80,38 @@ func (e *Engine) LoadConfig(rules []manifest.Rule) error {
 		return b.Priority - a.Priority
 	})
 
-	e.rules = sorted
+	compiled := make([]compiledRule, len(sorted))
+	for i, rule := range sorted {
+		compiled[i] = compileRule(rule)
+	}
+
+	e.rules = compiled
 	return nil
 }
 
+func compileRule(rule manifest.Rule) compiledRule {
+	cr := compiledRule{Rule: rule, loc: time.Local}
+	if rule.Timezone != "" {
+		loc, err := time.LoadLocation(rule.Timezone)
+		if err != nil {
+			flog.Warn("[notify-rules] rule %s: invalid timezone %q, rule disabled: %v", rule.ID, rule.Timezone, err)
+			cr.invalid = true
+			return cr
+		}
+		cr.loc = loc
+	}
+	if strings.TrimSpace(rule.Condition) != "" {
+		cond, err := CompileCondition(rule.Condition)
+		if err != nil {
+			flog.Warn("[notify-rules] rule %s: %v, rule disabled", rule.ID, err)
+			cr.invalid = true
+			return cr
+		}
+		cr.cond = cond
+	}
+	return cr
+}
+
 // Reload refreshes the rule list from the database.
 // Called after rule CRUD operations to enable hot-reload without restart.
 func (e *Engine) Reload(ctx context.Context, loader func(context.Context) ([]manifest.Rule, error)) error {
@@ -91,12 +131,18 @@ type EvalResult struct {
 	Muted  bool
 }
 
-// Evaluate checks all rules against an event type and channel, returning the first matching action.
-func (e *Engine) Evaluate(_ context.Context, eventType, channel string) *EvalResult {
+// Evaluate checks all rules against an event type, channel, and notification
+// payload, returning the first matching action. Rule conditions see the
+// payload and the current time in the rule's time zone.
+func (e *Engine) Evaluate(_ context.Context, eventType, channel string, payload map[string]any) *EvalResult {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
 
+	now := nowFunc()
 	for _, rule := range e.rules {
+		if rule.invalid {
+			continue
+		}
 		// match event pattern
 		if !matchPattern(rule.Match.Event, eventType) {
 			continue
@@ -106,9 +152,10 @@ func (e *Engine) Evaluate(_ context.Context, eventType, channel string) *EvalRes
 			continue
 		}
 
-		// check condition (time-based mute, etc.)
-		if rule.Condition != "" {
-			if evalCondition(rule.Condition) {
+		// check condition (time-based mute, payload filters, etc.)
+		if rule.cond != nil {
+			env := Env{Now: now.In(rule.loc), Event: eventType, Channel: channel, Payload: payload}
+			if rule.cond.Eval(env) {
 				return &EvalResult{
 					Action: rule.Action,
 					RuleID: rule.ID,
@@ -152,112 +199,3 @@ func matchPattern(pattern, value string) bool {
 	}
 	return false
 }
-
-// evalCondition evaluates a simple time-based condition expression.
-// Supported: time.hour >= N, time.hour < N, connected by || and &&.
-func evalCondition(condition string) bool {
-	// For simplicity, split by || and evaluate each part
-	parts := strings.SplitSeq(condition, "||")
-	for part := range parts {
-		part = strings.TrimSpace(part)
-		if evalSimpleCondition(part) {
-			return true
-		}
-	}
-	return false
-}
-
-func evalSimpleCondition(condition string) bool {
-	// handle && within a part
-	andParts := strings.SplitSeq(condition, "&&")
-	for part := range andParts {
-		part = strings.TrimSpace(part)
-		if !evalTimeCondition(part) {
-			return false
-		}
-	}
-	return true
-}
-
-func evalTimeCondition(condition string) bool {
-	condition = strings.TrimSpace(condition)
-	now := currentHour()
-
-	// parse: time.hour >= N or time.hour < N
-	if strings.Contains(condition, ">=") {
-		parts := strings.SplitN(condition, ">=", 2)
-		if strings.TrimSpace(parts[0]) == "time.hour" {
-			hour := parseHour(strings.TrimSpace(parts[1]))
-			return now >= hour
-		}
-	}
-	if strings.Contains(condition, "<=") {
-		parts := strings.SplitN(condition, "<=", 2)
-		if strings.TrimSpace(parts[0]) == "time.hour" {
-			hour := parseHour(strings.TrimSpace(parts[1]))
-			return now <= hour
-		}
-	}
-	if strings.Contains(condition, "<") {
-		parts := strings.SplitN(condition, "<", 2)
-		if strings.TrimSpace(parts[0]) == "time.hour" {
-			hour := parseHour(strings.TrimSpace(parts[1]))
-			return now < hour
-		}
-	}
-	if strings.Contains(condition, ">") {
-		parts := strings.SplitN(condition, ">", 2)
-		if strings.TrimSpace(parts[0]) == "time.hour" {
-			hour := parseHour(strings.TrimSpace(parts[1]))
-			return now > hour
-		}
-	}
-	if strings.Contains(condition, "==") {
-		parts := strings.SplitN(condition, "==", 2)
-		if strings.TrimSpace(parts[0]) == "time.hour" {
-			hour := parseHour(strings.TrimSpace(parts[1]))
-			return now == hour
-		}
-	}
-	return false
-}
-
-// ValidateCondition checks whether a condition expression string is syntactically valid.
-// It uses the same parsing logic as evalCondition but without evaluating time values.
-func ValidateCondition(condition string) error {
-	if condition == "" {
-		return nil
-	}
-	// validate each || part
-	parts := strings.SplitSeq(condition, "||")
-	for part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
-			return fmt.Errorf("rules: empty expression after ||")
-		}
-		andParts := strings.SplitSeq(part, "&&")
-		for ap := range andParts {
-			ap = strings.TrimSpace(ap)
-			if ap == "" {
-				return fmt.Errorf("rules: empty expression after &&")
-			}
-			if err := validateTimeExpression(ap); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
-
-func validateTimeExpression(expr string) error {
-	expr = strings.TrimSpace(expr)
-	if !strings.HasPrefix(expr, "time.hour ") {
-		return fmt.Errorf("rules: expected 'time.hour <op> N', got %q", expr)
-	}
-	for _, op := range []string{">=", "<=", "==", ">", "<"} {
-		if strings.Contains(expr, " "+op+" ") || strings.HasPrefix(expr[len("time.hour "):], op+" ") {
-			return nil
-		}
-	}
-	return fmt.Errorf("rules: unknown operator in %q", expr)
-}
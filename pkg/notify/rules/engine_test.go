@@ -3,6 +3,7 @@ package rules
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -190,7 +191,7 @@ func TestEngineEvaluate(t *testing.T) {
 			err := e.LoadConfig(tt.rules)
 			require.NoError(t, err)
 
-			result := e.Evaluate(context.TODO(), tt.eventType, tt.channel)
+			result := e.Evaluate(context.TODO(), tt.eventType, tt.channel, nil)
 			if tt.wantNil {
 				assert.Nil(t, result)
 				return
@@ -208,47 +209,53 @@ func TestEngineEvaluate(t *testing.T) {
 	}
 }
 
-func TestParseHour(t *testing.T) {
-	t.Parallel()
-	tests := []struct {
-		name  string
-		input string
-		want  int
-	}{
-		{name: "two-digit hour", input: "23", want: 23},
-		{name: "single-digit hour", input: "8", want: 8},
-		{name: "zero hour", input: "0", want: 0},
-	}
+func TestEngineEvaluate_ConditionUsesPayloadAndTimezone(t *testing.T) {
+	origNow := nowFunc
+	// Saturday 2026-10-17 02:30 UTC is Saturday 10:30 in Shanghai.
+	nowFunc = func() time.Time { return time.Date(2026, 10, 17, 2, 30, 0, 0, time.UTC) }
+	defer func() { nowFunc = origNow }()
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			assert.Equal(t, tt.want, parseHour(tt.input))
-		})
+	rules := []manifest.Rule{
+		{
+			ID: "weekend_low", Action: manifest.RuleActionMute, Priority: 100,
+			Match:     manifest.RuleMatch{Event: "*", Channel: "*"},
+			Condition: "payload.priority < 4 && weekday in [sat, sun]",
+		},
+		{
+			ID: "shanghai_office", Action: manifest.RuleActionMute, Priority: 50,
+			Match:     manifest.RuleMatch{Event: "*", Channel: "*"},
+			Condition: "time.hour >= 9 && time.hour < 18",
+			Timezone:  "Asia/Shanghai",
+		},
+		{
+			ID: "bad_zone", Action: manifest.RuleActionDrop, Priority: 200,
+			Match:    manifest.RuleMatch{Event: "*", Channel: "*"},
+			Timezone: "Mars/Olympus",
+		},
+		{
+			ID: "bad_condition", Action: manifest.RuleActionDrop, Priority: 150,
+			Match:     manifest.RuleMatch{Event: "*", Channel: "*"},
+			Condition: "time.hour >=",
+		},
 	}
-}
-
-func TestEvalTimeCondition(t *testing.T) {
-	origHour := currentHour
-	currentHour = func() int { return 14 }
-	defer func() { currentHour = origHour }()
+	e := New(nil)
+	require.NoError(t, e.LoadConfig(rules))
 
 	tests := []struct {
-		name      string
-		condition string
-		want      bool
+		name       string
+		payload    map[string]any
+		wantRuleID string
 	}{
-		{name: "hour >= 10 when hour is 14", condition: "time.hour >= 10", want: true},
-		{name: "hour >= 20 when hour is 14", condition: "time.hour >= 20", want: false},
-		{name: "hour < 16 when hour is 14", condition: "time.hour < 16", want: true},
-		{name: "hour < 10 when hour is 14", condition: "time.hour < 10", want: false},
-		{name: "hour == 14 when hour is 14", condition: "time.hour == 14", want: true},
-		{name: "hour == 15 when hour is 14", condition: "time.hour == 15", want: false},
+		{name: "low priority on a weekend", payload: map[string]any{"priority": 2}, wantRuleID: "weekend_low"},
+		{name: "high priority falls through to rule in its own time zone", payload: map[string]any{"priority": 5}, wantRuleID: "shanghai_office"},
+		{name: "missing payload field fails comparison", payload: nil, wantRuleID: "shanghai_office"},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, evalTimeCondition(tt.condition))
+			result := e.Evaluate(context.TODO(), "infra.host.down", "slack", tt.payload)
+			require.NotNil(t, result)
+			assert.Equal(t, tt.wantRuleID, result.RuleID)
+			assert.True(t, result.Muted)
 		})
 	}
 }
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/file"
+	"github.com/expr-lang/expr/vm"
+)
+
+// Condition is a compiled rule condition expression.
+//
+// Conditions use the expr language (github.com/expr-lang/expr) over
+// time.hour, time.minute, weekday, event, channel, and payload. Weekday
+// constants are sun..sat or their full names, in any case, and weekday
+// evaluates to the short lowercase name.
+type Condition struct {
+	src     string
+	program *vm.Program
+}
+
+// ConditionError is a compile error with the 1-based line and column where
+// it occurred.
+type ConditionError struct {
+	Line int
+	Pos  int
+	Msg  string
+}
+
+func (e *ConditionError) Error() string {
+	if e.Line > 1 {
+		return fmt.Sprintf("rules: condition at line %d, position %d: %s", e.Line, e.Pos, e.Msg)
+	}
+	return fmt.Sprintf("rules: condition at position %d: %s", e.Pos, e.Msg)
+}
+
+// Env is the data a condition is evaluated against.
+type Env struct {
+	Now     time.Time
+	Event   string
+	Channel string
+	Payload map[string]any
+}
+
+// exprEnv is the environment conditions compile and run against.
+type exprEnv struct {
+	Time    timeEnv        `expr:"time"`
+	Weekday string         `expr:"weekday"`
+	Event   string         `expr:"event"`
+	Channel string         `expr:"channel"`
+	Payload map[string]any `expr:"payload"`
+}
+
+type timeEnv struct {
+	Hour   int `expr:"hour"`
+	Minute int `expr:"minute"`
+}
+
+// CompileCondition compiles a condition expression.
+func CompileCondition(src string) (*Condition, error) {
+	program, err := expr.Compile(src,
+		expr.Env(exprEnv{}),
+		expr.AsBool(),
+		expr.Patch(weekdayConstants{}),
+	)
+	if err != nil {
+		var fileErr *file.Error
+		if errors.As(err, &fileErr) {
+			return nil, &ConditionError{Line: fileErr.Line, Pos: fileErr.Column + 1, Msg: fileErr.Message}
+		}
+		return nil, fmt.Errorf("rules: condition: %w", err)
+	}
+	return &Condition{src: src, program: program}, nil
+}
+
+// String returns the source expression.
+func (c *Condition) String() string {
+	return c.src
+}
+
+// Eval reports whether the condition holds for env. A condition that fails at
+// run time, such as ordering a string against a number, does not hold.
+func (c *Condition) Eval(env Env) bool {
+	out, err := expr.Run(c.program, exprEnv{
+		Time:    timeEnv{Hour: env.Now.Hour(), Minute: env.Now.Minute()},
+		Weekday: strings.ToLower(env.Now.Weekday().String()[:3]),
+		Event:   env.Event,
+		Channel: env.Channel,
+		Payload: env.Payload,
+	})
+	if err != nil {
+		return false
+	}
+	ok, _ := out.(bool)
+	return ok
+}
+
+// ValidateCondition checks whether a condition expression compiles. Syntax
+// and unknown-name errors are *ConditionError values carrying the column of
+// the problem.
+func ValidateCondition(condition string) error {
+	if strings.TrimSpace(condition) == "" {
+		return nil
+	}
+	_, err := CompileCondition(condition)
+	return err
+}
+
+var weekdayNames = map[string]string{
+	"sun": "sun", "sunday": "sun",
+	"mon": "mon", "monday": "mon",
+	"tue": "tue", "tuesday": "tue",
+	"wed": "wed", "wednesday": "wed",
+	"thu": "thu", "thursday": "thu",
+	"fri": "fri", "friday": "fri",
+	"sat": "sat", "saturday": "sat",
+}
+
+// weekdayConstants rewrites bare weekday names into string literals before
+// type checking, so `weekday in [sat, sun]` compares against "sat" and "sun".
+type weekdayConstants struct{}
+
+func (weekdayConstants) Visit(node *ast.Node) {
+	ident, ok := (*node).(*ast.IdentifierNode)
+	if !ok {
+		return
+	}
+	if day, ok := weekdayNames[strings.ToLower(ident.Value)]; ok {
+		ast.Patch(node, &ast.StringNode{Value: day})
+	}
+}
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionEval(t *testing.T) {
+	t.Parallel()
+	// Sunday 14:05.
+	env := Env{
+		Now:     time.Date(2026, 10, 18, 14, 5, 0, 0, time.UTC),
+		Event:   "infra.host.down",
+		Channel: "slack",
+		Payload: map[string]any{
+			"priority": 4.0,
+			"host":     "nas",
+			"count":    "12",
+			"muted":    true,
+			"labels":   map[string]any{"env": "prod"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		condition string
+		want      bool
+	}{
+		{name: "hour greater or equal", condition: "time.hour >= 10", want: true},
+		{name: "hour less than", condition: "time.hour < 10", want: false},
+		{name: "hour equality", condition: "time.hour == 14", want: true},
+		{name: "minute", condition: "time.minute < 10", want: true},
+		{name: "weekday equality", condition: "weekday == sun", want: true},
+		{name: "weekday full name", condition: "weekday == Sunday", want: true},
+		{name: "weekday constant is case insensitive", condition: "weekday == SUN", want: true},
+		{name: "weekday in list", condition: "weekday in [sat, sun]", want: true},
+		{name: "weekday not in list", condition: "weekday in [mon, tue]", want: false},
+		{name: "payload number", condition: "payload.priority >= 4", want: true},
+		{name: "payload numeric string", condition: "float(payload.count) > 10", want: true},
+		{name: "numeric string without conversion is false", condition: "payload.count > 10", want: false},
+		{name: "payload string", condition: `payload.host == "nas"`, want: true},
+		{name: "payload nested", condition: "payload.labels.env == 'prod'", want: true},
+		{name: "payload bool truthiness", condition: "payload.muted", want: true},
+		{name: "missing payload fails comparison", condition: "payload.missing == 1", want: false},
+		{name: "missing payload is unequal", condition: "payload.missing != 1", want: true},
+		{name: "missing nested payload with optional chaining", condition: "payload.missing?.env == 'prod'", want: false},
+		{name: "missing nested payload is false", condition: "payload.missing.env != 'prod'", want: false},
+		{name: "event and channel", condition: `event == "infra.host.down" && channel in ["slack", "ntfy"]`, want: true},
+		{name: "and binds tighter than or", condition: "time.hour < 10 && weekday == mon || payload.priority == 4", want: true},
+		{name: "parentheses override precedence", condition: "time.hour < 10 && (weekday == mon || payload.priority == 4)", want: false},
+		{name: "negation", condition: "!(weekday in [sat, sun])", want: false},
+		{name: "overnight window", condition: "time.hour >= 23 || time.hour < 8", want: false},
+		{name: "strings order lexically", condition: `payload.host > "a"`, want: true},
+		{name: "non-bool result is false", condition: "payload.host", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cond, err := CompileCondition(tt.condition)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cond.Eval(env))
+		})
+	}
+}
@@ -5,12 +5,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/alicebob/miniredis/v2"
-	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-
-	"github.com/flowline-io/flowbot/pkg/cache"
 )
 
 func TestScanExpiredAggregates(t *testing.T) {
@@ -53,47 +49,50 @@ func TestScanExpiredAggregates(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			var engine *Engine
-			if tt.name == "nil store returns empty" {
-				engine = New(nil)
-			} else {
-				mr := miniredis.RunT(t)
-				client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
-				engine = New(cache.NewRedisStore(client))
-			}
-			tt.setup(t, engine)
+	for _, backend := range stateBackends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				var engine *Engine
+				if tt.name == "nil store returns empty" {
+					engine = New(nil)
+				} else {
+					engine = New(backend.open(t))
+				}
+				tt.setup(t, engine)
 
-			keys, err := engine.ScanExpiredAggregates(context.Background())
-			require.NoError(t, err)
-			assert.Len(t, keys, tt.wantLen)
-			if tt.wantRule != "" {
-				assert.Equal(t, tt.wantRule, keys[0].RuleID)
-			}
-		})
+				keys, err := engine.ScanExpiredAggregates(context.Background())
+				require.NoError(t, err)
+				assert.Len(t, keys, tt.wantLen)
+				if tt.wantRule != "" {
+					assert.Equal(t, tt.wantRule, keys[0].RuleID)
+				}
+			})
+		}
 	}
 }
 
 func TestWorkerScanAndFlushExpiredTimer(t *testing.T) {
-	mr := miniredis.RunT(t)
-	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
-	engine := New(cache.NewRedisStore(client))
-	ctx := context.Background()
+	for _, backend := range stateBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			store := backend.open(t)
+			engine := New(store)
+			ctx := context.Background()
 
-	require.NoError(t, engine.EnqueueForAggregation(ctx, "worker-rule", "event.z", "ntfy", map[string]any{"n": 1}))
-	_, err := engine.SetAggregateTimer(ctx, "worker-rule", "event.z", "ntfy", 0)
-	require.NoError(t, err)
+			require.NoError(t, engine.EnqueueForAggregation(ctx, "worker-rule", "event.z", "ntfy", map[string]any{"n": 1}))
+			_, err := engine.SetAggregateTimer(ctx, "worker-rule", "event.z", "ntfy", 0)
+			require.NoError(t, err)
 
-	var flushed int
-	w := NewWorker(engine, time.Hour, func(_ context.Context, ruleID, eventType, channel string, items []map[string]any) {
-		flushed++
-		assert.Equal(t, "worker-rule", ruleID)
-		assert.Equal(t, "event.z", eventType)
-		assert.Equal(t, "ntfy", channel)
-		assert.Len(t, items, 1)
-	})
-	w.scanAndFlush(ctx)
-	assert.Equal(t, 1, flushed)
+			var flushed int
+			w := NewWorker(engine, time.Hour, func(_ context.Context, ruleID, eventType, channel string, items []map[string]any) {
+				flushed++
+				assert.Equal(t, "worker-rule", ruleID)
+				assert.Equal(t, "event.z", eventType)
+				assert.Equal(t, "ntfy", channel)
+				assert.Len(t, items, 1)
+			})
+			w.scanAndFlush(ctx)
+			assert.Equal(t, 1, flushed)
+		})
+	}
 }
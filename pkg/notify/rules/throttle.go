@@ -2,7 +2,6 @@ package rules
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/flowline-io/flowbot/pkg/cache"
@@ -37,16 +36,3 @@ func (e *Engine) ClearThrottle(ctx context.Context, ruleID, eventType, channel s
 		flog.Warn("[notify-rules] throttle clear error: %v", err)
 	}
 }
-
-// parseHour parses an hour string into an integer.
-func parseHour(s string) int {
-	var h int
-	_, _ = fmt.Sscanf(s, "%d", &h)
-	return h
-}
-
-// currentHour returns the current hour (0-23) for testing.
-// Override in tests via a variable.
-var currentHour = func() int {
-	return time.Now().Hour()
-}
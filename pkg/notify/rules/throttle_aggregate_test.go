@@ -14,13 +14,23 @@ import (
 	"github.com/flowline-io/flowbot/pkg/notify/manifest"
 )
 
-func newTestRedisStore(t *testing.T) *cache.RedisStore {
+func newTestRedisStore(t *testing.T) cache.Store {
 	t.Helper()
 	mr := miniredis.RunT(t)
 	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
 	return cache.NewRedisStore(client)
 }
 
+// stateBackends lists the stores rule state can live in. Tests that touch
+// throttle or aggregation state run against each of them.
+var stateBackends = []struct {
+	name string
+	open func(t *testing.T) cache.Store
+}{
+	{name: "redis", open: newTestRedisStore},
+	{name: "memory", open: func(*testing.T) cache.Store { return cache.NewMemoryStore() }},
+}
+
 func TestCheckThrottle(t *testing.T) {
 	t.Parallel()
 
@@ -50,21 +60,23 @@ func TestCheckThrottle(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			store := newTestRedisStore(t)
-			engine := New(store)
-			ctx := context.Background()
-
-			var got []bool
-			for range tt.calls {
-				allowed, err := engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, tt.limit)
-				require.NoError(t, err)
-				got = append(got, allowed)
-			}
-			assert.Equal(t, tt.wantAllow, got)
-		})
+	for _, backend := range stateBackends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				store := backend.open(t)
+				engine := New(store)
+				ctx := context.Background()
+
+				var got []bool
+				for range tt.calls {
+					allowed, err := engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, tt.limit)
+					require.NoError(t, err)
+					got = append(got, allowed)
+				}
+				assert.Equal(t, tt.wantAllow, got)
+			})
+		}
 	}
 }
 
@@ -78,23 +90,28 @@ func TestCheckThrottle_NilStore(t *testing.T) {
 
 func TestClearThrottle(t *testing.T) {
 	t.Parallel()
-	store := newTestRedisStore(t)
-	engine := New(store)
-	ctx := context.Background()
+	for _, backend := range stateBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			t.Parallel()
+			store := backend.open(t)
+			engine := New(store)
+			ctx := context.Background()
 
-	allowed, err := engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, 1)
-	require.NoError(t, err)
-	require.True(t, allowed)
+			allowed, err := engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, 1)
+			require.NoError(t, err)
+			require.True(t, allowed)
 
-	allowed, err = engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, 1)
-	require.NoError(t, err)
-	require.False(t, allowed)
+			allowed, err = engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, 1)
+			require.NoError(t, err)
+			require.False(t, allowed)
 
-	engine.ClearThrottle(ctx, "rule1", "event.a", "slack")
+			engine.ClearThrottle(ctx, "rule1", "event.a", "slack")
 
-	allowed, err = engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, 1)
-	require.NoError(t, err)
-	assert.True(t, allowed)
+			allowed, err = engine.CheckThrottle(ctx, "rule1", "event.a", "slack", time.Minute, 1)
+			require.NoError(t, err)
+			assert.True(t, allowed)
+		})
+	}
 }
 
 func TestEnqueueAndFlushAggregation(t *testing.T) {
@@ -128,27 +145,29 @@ func TestEnqueueAndFlushAggregation(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			store := newTestRedisStore(t)
-			engine := New(store)
-			ctx := context.Background()
+	for _, backend := range stateBackends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				store := backend.open(t)
+				engine := New(store)
+				ctx := context.Background()
 
-			for _, payload := range tt.payloads {
-				err := engine.EnqueueForAggregation(ctx, "agg1", "infra.down", "slack", payload)
-				require.NoError(t, err)
-			}
+				for _, payload := range tt.payloads {
+					err := engine.EnqueueForAggregation(ctx, "agg1", "infra.down", "slack", payload)
+					require.NoError(t, err)
+				}
 
-			got, err := engine.FlushAggregation(ctx, "agg1", "infra.down", "slack")
-			require.NoError(t, err)
-			if tt.wantLen == 0 {
-				assert.Nil(t, got)
-				return
-			}
-			require.Len(t, got, tt.wantLen)
-			assert.Equal(t, tt.payloads[0]["summary"], got[0]["summary"])
-		})
+				got, err := engine.FlushAggregation(ctx, "agg1", "infra.down", "slack")
+				require.NoError(t, err)
+				if tt.wantLen == 0 {
+					assert.Nil(t, got)
+					return
+				}
+				require.Len(t, got, tt.wantLen)
+				assert.Equal(t, tt.payloads[0]["summary"], got[0]["summary"])
+			})
+		}
 	}
 }
 
@@ -185,24 +204,26 @@ func TestEnqueueForAggregation_CapsBuffer(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			store := newTestRedisStore(t)
-			engine := New(store)
-			ctx := context.Background()
+	for _, backend := range stateBackends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				store := backend.open(t)
+				engine := New(store)
+				ctx := context.Background()
 
-			for i := range tt.pushCount {
-				err := engine.EnqueueForAggregation(ctx, "cap", "event.a", "slack", map[string]any{"n": i})
-				require.NoError(t, err)
-			}
+				for i := range tt.pushCount {
+					err := engine.EnqueueForAggregation(ctx, "cap", "event.a", "slack", map[string]any{"n": i})
+					require.NoError(t, err)
+				}
 
-			got, err := engine.FlushAggregation(ctx, "cap", "event.a", "slack")
-			require.NoError(t, err)
-			require.Len(t, got, tt.wantLen)
-			assert.InDelta(t, tt.wantFirst, got[0]["n"], 0)
-			assert.InDelta(t, tt.wantLast, got[len(got)-1]["n"], 0)
-		})
+				got, err := engine.FlushAggregation(ctx, "cap", "event.a", "slack")
+				require.NoError(t, err)
+				require.Len(t, got, tt.wantLen)
+				assert.InDelta(t, tt.wantFirst, got[0]["n"], 0)
+				assert.InDelta(t, tt.wantLast, got[len(got)-1]["n"], 0)
+			})
+		}
 	}
 }
 
@@ -217,37 +238,39 @@ func TestFlushAggregation_RemovesDueMember(t *testing.T) {
 		{name: "second flush stays empty"},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			store := newTestRedisStore(t)
-			engine := New(store)
-			ctx := context.Background()
-
-			if tt.name != "empty flush clears stale due member" {
-				require.NoError(t, engine.EnqueueForAggregation(ctx, "due1", "event.a", "slack", map[string]any{"n": 1}))
-			}
-			first, err := engine.SetAggregateTimer(ctx, "due1", "event.a", "slack", 0)
-			require.NoError(t, err)
-			require.True(t, first)
-
-			keys, err := engine.ScanExpiredAggregates(ctx)
-			require.NoError(t, err)
-			require.Len(t, keys, 1)
+	for _, backend := range stateBackends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				store := backend.open(t)
+				engine := New(store)
+				ctx := context.Background()
+
+				if tt.name != "empty flush clears stale due member" {
+					require.NoError(t, engine.EnqueueForAggregation(ctx, "due1", "event.a", "slack", map[string]any{"n": 1}))
+				}
+				first, err := engine.SetAggregateTimer(ctx, "due1", "event.a", "slack", 0)
+				require.NoError(t, err)
+				require.True(t, first)
 
-			_, err = engine.FlushAggregation(ctx, "due1", "event.a", "slack")
-			require.NoError(t, err)
+				keys, err := engine.ScanExpiredAggregates(ctx)
+				require.NoError(t, err)
+				require.Len(t, keys, 1)
 
-			keys, err = engine.ScanExpiredAggregates(ctx)
-			require.NoError(t, err)
-			assert.Empty(t, keys)
+				_, err = engine.FlushAggregation(ctx, "due1", "event.a", "slack")
+				require.NoError(t, err)
 
-			if tt.name == "second flush stays empty" {
-				got, err := engine.FlushAggregation(ctx, "due1", "event.a", "slack")
+				keys, err = engine.ScanExpiredAggregates(ctx)
 				require.NoError(t, err)
-				assert.Empty(t, got)
-			}
-		})
+				assert.Empty(t, keys)
+
+				if tt.name == "second flush stays empty" {
+					got, err := engine.FlushAggregation(ctx, "due1", "event.a", "slack")
+					require.NoError(t, err)
+					assert.Empty(t, got)
+				}
+			})
+		}
 	}
 }
 
@@ -294,21 +317,23 @@ func TestSetAggregateTimer(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			store := newTestRedisStore(t)
-			engine := New(store)
-			ctx := context.Background()
-
-			var got []bool
-			for range tt.calls {
-				first, err := engine.SetAggregateTimer(ctx, "agg1", "event.a", "slack", time.Minute)
-				require.NoError(t, err)
-				got = append(got, first)
-			}
-			assert.Equal(t, tt.wantFirst, got)
-		})
+	for _, backend := range stateBackends {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				store := backend.open(t)
+				engine := New(store)
+				ctx := context.Background()
+
+				var got []bool
+				for range tt.calls {
+					first, err := engine.SetAggregateTimer(ctx, "agg1", "event.a", "slack", time.Minute)
+					require.NoError(t, err)
+					got = append(got, first)
+				}
+				assert.Equal(t, tt.wantFirst, got)
+			})
+		}
 	}
 }
 
@@ -380,7 +405,7 @@ func TestReload(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result := engine.Evaluate(context.Background(), "any", "slack")
+	result := engine.Evaluate(context.Background(), "any", "slack", nil)
 	require.NotNil(t, result)
 	assert.Equal(t, "r1", result.RuleID)
 }
@@ -392,44 +417,48 @@ func TestGetEngine_AfterInit(t *testing.T) {
 	require.NoError(t, Init(newTestRedisStore(t), rules))
 	engine := GetEngine()
 	require.NotNil(t, engine)
-	result := engine.Evaluate(context.Background(), "x", "slack")
+	result := engine.Evaluate(context.Background(), "x", "slack", nil)
 	require.NotNil(t, result)
 	assert.Equal(t, "init_test", result.RuleID)
 }
 
 func TestWorker_ScanAndFlush(t *testing.T) {
-	store := newTestRedisStore(t)
-	engine := New(store)
-	ctx := context.Background()
-
-	require.NoError(t, engine.EnqueueForAggregation(ctx, "w1", "event.a", "slack", map[string]any{"n": 1}))
-	require.NoError(t, engine.EnqueueForAggregation(ctx, "w1", "event.a", "slack", map[string]any{"n": 2}))
-	_, err := engine.SetAggregateTimer(ctx, "w1", "event.a", "slack", time.Minute)
-	require.NoError(t, err)
-
-	var flushed int
-	var flushedItems []map[string]any
-	w := NewWorker(engine, time.Hour, func(_ context.Context, ruleID, eventType, channel string, items []map[string]any) {
-		flushed++
-		flushedItems = items
-		assert.Equal(t, "w1", ruleID)
-		assert.Equal(t, "event.a", eventType)
-		assert.Equal(t, "slack", channel)
-	})
+	for _, backend := range stateBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			store := backend.open(t)
+			engine := New(store)
+			ctx := context.Background()
 
-	// Active timer means the aggregation window is still open.
-	w.scanAndFlush(ctx)
-	assert.Equal(t, 0, flushed)
+			require.NoError(t, engine.EnqueueForAggregation(ctx, "w1", "event.a", "slack", map[string]any{"n": 1}))
+			require.NoError(t, engine.EnqueueForAggregation(ctx, "w1", "event.a", "slack", map[string]any{"n": 2}))
+			_, err := engine.SetAggregateTimer(ctx, "w1", "event.a", "slack", time.Minute)
+			require.NoError(t, err)
 
-	// Manual flush path via FlushAggregation + onFlush callback.
-	items, err := engine.FlushAggregation(ctx, "w1", "event.a", "slack")
-	require.NoError(t, err)
-	require.Len(t, items, 2)
-	if len(items) > 0 {
-		w.onFlush(ctx, "w1", "event.a", "slack", items)
+			var flushed int
+			var flushedItems []map[string]any
+			w := NewWorker(engine, time.Hour, func(_ context.Context, ruleID, eventType, channel string, items []map[string]any) {
+				flushed++
+				flushedItems = items
+				assert.Equal(t, "w1", ruleID)
+				assert.Equal(t, "event.a", eventType)
+				assert.Equal(t, "slack", channel)
+			})
+
+			// Active timer means the aggregation window is still open.
+			w.scanAndFlush(ctx)
+			assert.Equal(t, 0, flushed)
+
+			// Manual flush path via FlushAggregation + onFlush callback.
+			items, err := engine.FlushAggregation(ctx, "w1", "event.a", "slack")
+			require.NoError(t, err)
+			require.Len(t, items, 2)
+			if len(items) > 0 {
+				w.onFlush(ctx, "w1", "event.a", "slack", items)
+			}
+			assert.Equal(t, 1, flushed)
+			assert.Len(t, flushedItems, 2)
+		})
 	}
-	assert.Equal(t, 1, flushed)
-	assert.Len(t, flushedItems, 2)
 }
 
 func TestWorker_RunStopsOnCancel(t *testing.T) {
@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ func TestValidateCondition(t *testing.T) {
 		name      string
 		condition string
 		wantErr   bool
+		wantPos   int
 		errSub    string
 	}{
 		{
@@ -27,38 +29,77 @@ func TestValidateCondition(t *testing.T) {
 			wantErr:   false,
 		},
 		{
-			name:      "and expression is valid",
-			condition: "time.hour >= 22 && time.hour < 6",
+			name:      "payload and weekday expression is valid",
+			condition: "payload.priority >= 4 && weekday in [sat,sun]",
 			wantErr:   false,
 		},
 		{
-			name:      "or expression is valid",
-			condition: "time.hour >= 23 || time.hour < 6",
+			name:      "parenthesized expression is valid",
+			condition: "!(time.hour >= 9 && time.hour < 18) || payload.urgent",
 			wantErr:   false,
 		},
 		{
 			name:      "empty part after or is invalid",
 			condition: "time.hour >= 23 ||",
 			wantErr:   true,
-			errSub:    "empty expression after ||",
+			wantPos:   18,
+			errSub:    "unexpected token EOF",
 		},
 		{
 			name:      "empty part after and is invalid",
-			condition: "time.hour >= 23 &&",
+			condition: "time.hour >= 23 && ",
 			wantErr:   true,
-			errSub:    "empty expression after &&",
+			wantPos:   19,
+			errSub:    "unexpected token EOF",
 		},
 		{
-			name:      "unknown operator is invalid",
-			condition: "time.hour != 10",
+			name:      "unknown identifier is invalid",
+			condition: "time.hour >= 10 && day.hour >= 10",
 			wantErr:   true,
-			errSub:    "unknown operator",
+			wantPos:   20,
+			errSub:    "unknown name day",
 		},
 		{
-			name:      "non time.hour expression is invalid",
-			condition: "day.hour >= 10",
+			name:      "unknown payload root is invalid",
+			condition: "payloads.priority >= 4",
 			wantErr:   true,
-			errSub:    "expected 'time.hour",
+			wantPos:   1,
+			errSub:    "unknown name payloads",
+		},
+		{
+			name:      "unclosed parenthesis is invalid",
+			condition: "(time.hour >= 10",
+			wantErr:   true,
+			wantPos:   16,
+			errSub:    "unexpected token EOF",
+		},
+		{
+			name:      "in requires a list",
+			condition: "weekday in sat",
+			wantErr:   true,
+			wantPos:   9,
+			errSub:    "invalid operation: in",
+		},
+		{
+			name:      "unexpected character is invalid",
+			condition: "time.hour = 10",
+			wantErr:   true,
+			wantPos:   11,
+			errSub:    `unexpected token Operator("=")`,
+		},
+		{
+			name:      "trailing token is invalid",
+			condition: "time.hour >= 10 10",
+			wantErr:   true,
+			wantPos:   17,
+			errSub:    `unexpected token Number("10")`,
+		},
+		{
+			name:      "unterminated string is invalid",
+			condition: `channel == "slack`,
+			wantErr:   true,
+			wantPos:   18,
+			errSub:    "literal not terminated",
 		},
 	}
 
@@ -69,35 +110,9 @@ func TestValidateCondition(t *testing.T) {
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errSub)
-				return
-			}
-			require.NoError(t, err)
-		})
-	}
-}
-
-func TestValidateTimeExpression(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name    string
-		expr    string
-		wantErr bool
-	}{
-		{name: "greater than or equal", expr: "time.hour >= 10", wantErr: false},
-		{name: "less than", expr: "time.hour < 6", wantErr: false},
-		{name: "equals", expr: "time.hour == 14", wantErr: false},
-		{name: "greater than", expr: "time.hour > 9", wantErr: false},
-		{name: "missing operator", expr: "time.hour 10", wantErr: true},
-		{name: "wrong prefix", expr: "hour >= 10", wantErr: true},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			err := validateTimeExpression(tt.expr)
-			if tt.wantErr {
-				require.Error(t, err)
+				var condErr *ConditionError
+				require.True(t, errors.As(err, &condErr))
+				assert.Equal(t, tt.wantPos, condErr.Pos)
 				return
 			}
 			require.NoError(t, err)
@@ -0,0 +1,248 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/pipeline/template"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/trace"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+// approvalOperation is recorded as the operation of approval step runs.
+const approvalOperation = "approval"
+
+// ValidateApprovalSteps checks approval: steps. A gate takes the place of a
+// capability call, cannot fan out or compensate, and needs a resumable
+// pipeline because the paused run lives in its checkpoint.
+func ValidateApprovalSteps(steps []Step, resumable bool) error {
+	tpl := template.New()
+	for _, s := range steps {
+		for _, c := range s.OnFailure {
+			if c.Approval != nil {
+				return fmt.Errorf("step %s: on_failure step %s cannot be an approval", s.Name, c.Name)
+			}
+		}
+		if s.Approval == nil {
+			continue
+		}
+		if s.Capability != "" || s.Operation != "" {
+			return fmt.Errorf("step %s: approval steps cannot declare capability or operation", s.Name)
+		}
+		if s.Foreach != nil {
+			return fmt.Errorf("step %s: approval steps cannot declare foreach", s.Name)
+		}
+		if !resumable {
+			return fmt.Errorf("step %s: approval steps require resumable: true", s.Name)
+		}
+		if err := tpl.Parse(s.Approval.Message); err != nil {
+			return fmt.Errorf("step %s: invalid approval message: %w", s.Name, err)
+		}
+		if err := s.Approval.Validate(); err != nil {
+			return fmt.Errorf("step %s: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// executeApproval runs an approval gate. On first reach it opens a request,
+// saves it in the checkpoint, marks the run waiting and returns
+// runapproval.ErrPending. When the run resumes after a decision (or timeout),
+// approve records the decision as the step result and reject fails the step.
+func (e *Engine) executeApproval(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string, stepIndex int) error {
+	if e.store == nil || runID == 0 {
+		return fmt.Errorf("step %s: approval requires a run store", step.Name)
+	}
+	cp := &CheckpointData{}
+	if err := e.store.GetCheckpoint(ctx, runID, cp); err != nil {
+		return fmt.Errorf("step %s: load checkpoint: %w", step.Name, err)
+	}
+	if req := cp.Approval; req != nil && req.Step == step.Name && cp.StepIndex == stepIndex {
+		return e.resolveApproval(ctx, rc, step, req, runID, pipelineName, stepIndex)
+	}
+	return e.openApproval(ctx, rc, step, runID, pipelineName, stepIndex)
+}
+
+func (e *Engine) openApproval(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string, stepIndex int) error {
+	message, err := rc.RenderString(step.Approval.Message)
+	if err != nil {
+		return fmt.Errorf("render approval message step %s: %w", step.Name, err)
+	}
+	req, err := runapproval.NewRequest(runapproval.KindPipeline, runID, step.Name, message, *step.Approval, e.clock.Now())
+	if err != nil {
+		return fmt.Errorf("step %s: %w", step.Name, err)
+	}
+	params := map[string]any{
+		"message":    message,
+		"default":    string(req.Default),
+		"expires_at": req.ExpiresAt,
+	}
+	if e.callback != nil {
+		e.callback.OnStepStart(ctx, runID, pipelineName, stepIndex, step.Name, params)
+	}
+	req.StepRunID, err = e.createStepRunRecord(ctx, runID, step.Name, "", approvalOperation, params, 1)
+	if err != nil {
+		return err
+	}
+	e.updateStepRunRecord(ctx, req.StepRunID, int(types.PipelineWaiting), nil, "", 1)
+
+	if err := e.store.SaveCheckpoint(ctx, runID, &CheckpointData{
+		StepIndex:   stepIndex,
+		StepResults: buildStepResults(rc),
+		Event:       rc.Event,
+		HeartbeatAt: e.clock.Now(),
+		Approval:    req,
+	}); err != nil {
+		return fmt.Errorf("step %s: save approval checkpoint: %w", step.Name, err)
+	}
+	if err := e.store.UpdateRunStatus(context.WithoutCancel(ctx), runID, int(types.PipelineWaiting), ""); err != nil {
+		return fmt.Errorf("update run %d status: %w", runID, err)
+	}
+
+	cfg := *step.Approval
+	if strings.TrimSpace(cfg.UID) == "" {
+		cfg.UID = rc.Event.UID
+	}
+	if err := runapproval.Notify(ctx, cfg, req, fmt.Sprintf("pipeline %s run #%d", pipelineName, runID)); err != nil {
+		flog.Warn("pipeline %s run %d: approval notification for step %s failed: %v", pipelineName, runID, step.Name, err)
+	}
+	flog.Info("pipeline %s run %d waiting for approval at step %s", pipelineName, runID, step.Name)
+	return runapproval.ErrPending
+}
+
+func (e *Engine) resolveApproval(ctx context.Context, rc *RenderContext, step Step, req *runapproval.Request, runID int64, pipelineName string, stepIndex int) error {
+	req.ApplyTimeout(e.clock.Now())
+	decisionErr := req.Err()
+	if errors.Is(decisionErr, runapproval.ErrPending) {
+		// Resumed without a decision, e.g. a retry of a waiting run: wait again.
+		if err := e.store.UpdateRunStatus(context.WithoutCancel(ctx), runID, int(types.PipelineWaiting), ""); err != nil {
+			return fmt.Errorf("update run %d status: %w", runID, err)
+		}
+		return runapproval.ErrPending
+	}
+	elapsed := req.DecidedAt.Sub(req.RequestedAt)
+	if decisionErr != nil {
+		e.updateStepRunRecord(ctx, req.StepRunID, int(types.PipelineFailed), req.Result(), decisionErr.Error(), 1)
+		e.recordStepMetrics(pipelineName, step.Name, approvalOperation, "failed", elapsed.Seconds(), 1)
+		if e.callback != nil {
+			e.callback.OnStepError(ctx, runID, pipelineName, stepIndex, step.Name, decisionErr, elapsed.Milliseconds())
+		}
+		return &stepFailure{stepRunID: req.StepRunID, attempt: 1, err: decisionErr}
+	}
+	result := req.Result()
+	rc.RecordStepResult(step.Name, result)
+	e.updateStepRunRecord(ctx, req.StepRunID, int(types.PipelineDone), result, "", 1)
+	e.recordStepMetrics(pipelineName, step.Name, approvalOperation, "done", elapsed.Seconds(), 1)
+	if e.callback != nil {
+		e.callback.OnStepDone(ctx, runID, pipelineName, stepIndex, step.Name, result, elapsed.Milliseconds())
+	}
+	flog.Info("pipeline %s run %d step %s approved by %s", pipelineName, runID, step.Name, req.DecidedBy)
+	return nil
+}
+
+// GetApproval returns the waiting or decided approval request of a run by its token.
+func (e *Engine) GetApproval(ctx context.Context, token string) (*model.PipelineRun, *runapproval.Request, error) {
+	if e == nil {
+		return nil, nil, types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	kind, runID, err := runapproval.ParseToken(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if kind != runapproval.KindPipeline {
+		return nil, nil, types.Errorf(types.ErrInvalidArgument, "not a pipeline approval token")
+	}
+	run, err := e.getRun(ctx, runID)
+	if err != nil {
+		return nil, nil, err
+	}
+	cp, err := e.loadCheckpoint(ctx, runID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cp.Approval.MatchToken(token) {
+		return nil, nil, types.Errorf(types.ErrNotFound, "approval for pipeline run %d", runID)
+	}
+	return run, cp.Approval, nil
+}
+
+// DecideApproval records a decision for the waiting run identified by token
+// and resumes it in a detached goroutine.
+func (e *Engine) DecideApproval(ctx context.Context, token string, decision runapproval.Decision, by string) error {
+	if e == nil {
+		return types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	e.approvalMu.Lock()
+	defer e.approvalMu.Unlock()
+
+	run, req, err := e.GetApproval(ctx, token)
+	if err != nil {
+		return err
+	}
+	if run.Status != int(types.PipelineWaiting) {
+		return types.Errorf(types.ErrConflict, "pipeline run %d is not waiting for approval", run.ID)
+	}
+	if err := req.Decide(decision, by, e.clock.Now()); err != nil {
+		return err
+	}
+	return e.resumeApproved(ctx, run, req)
+}
+
+// ExpireApprovals applies the default decision to waiting runs whose approval
+// timed out and resumes them. It returns how many runs were resumed.
+func (e *Engine) ExpireApprovals(ctx context.Context) (int, error) {
+	if e == nil || e.store == nil {
+		return 0, nil
+	}
+	e.approvalMu.Lock()
+	defer e.approvalMu.Unlock()
+
+	runs, err := e.store.GetWaitingRuns(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list waiting pipeline runs: %w", err)
+	}
+	resumed := 0
+	for _, run := range runs {
+		cp, err := e.loadCheckpoint(ctx, run.ID)
+		if err != nil || !cp.Approval.ApplyTimeout(e.clock.Now()) {
+			continue
+		}
+		if err := e.resumeApproved(ctx, run, cp.Approval); err != nil {
+			flog.Error(fmt.Errorf("expire approval pipeline run %d: %w", run.ID, err))
+			continue
+		}
+		resumed++
+	}
+	return resumed, nil
+}
+
+// resumeApproved persists the decided request and resumes the run. The run is
+// moved out of the waiting state before returning so a second decision conflicts.
+func (e *Engine) resumeApproved(ctx context.Context, run *model.PipelineRun, req *runapproval.Request) error {
+	cp, err := e.loadCheckpoint(ctx, run.ID)
+	if err != nil {
+		return err
+	}
+	cp.Approval = req
+	if err := e.store.SaveCheckpoint(ctx, run.ID, cp); err != nil {
+		return fmt.Errorf("save approval decision for run %d: %w", run.ID, err)
+	}
+	if err := e.store.UpdateRunStatus(ctx, run.ID, int(types.PipelineStart), ""); err != nil {
+		return fmt.Errorf("update run %d status: %w", run.ID, err)
+	}
+	e.auditPipelineEvent(ctx, run.PipelineName, "pipeline.approval."+string(req.Decision), run.EventID, run.EventType)
+	flog.Info("pipeline %s run %d approval %s: %s by %s", run.PipelineName, run.ID, req.Step, req.Decision, req.DecidedBy)
+
+	runCtx := trace.DetachContext(ctx)
+	go func() {
+		if resumeErr := e.ResumePipeline(runCtx, run.ID); resumeErr != nil {
+			flog.Error(fmt.Errorf("resume pipeline %s run %d after approval: %w", run.PipelineName, run.ID, resumeErr))
+		}
+	}()
+	return nil
+}
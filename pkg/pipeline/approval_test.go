@@ -0,0 +1,170 @@
+package pipeline
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestValidateApprovalSteps(t *testing.T) {
+	t.Parallel()
+	gate := &runapproval.Config{Message: "ship?"}
+	tests := []struct {
+		name      string
+		steps     []Step
+		resumable bool
+		wantErr   string
+	}{
+		{name: "valid", steps: []Step{{Name: "gate", Approval: gate}}, resumable: true},
+		{name: "not resumable", steps: []Step{{Name: "gate", Approval: gate}}, wantErr: "resumable"},
+		{
+			name:      "with capability",
+			steps:     []Step{{Name: "gate", Capability: hub.CapExample, Operation: "op", Approval: gate}},
+			resumable: true,
+			wantErr:   "capability",
+		},
+		{
+			name:      "in on_failure",
+			steps:     []Step{{Name: "s", Capability: hub.CapExample, Operation: "op", OnFailure: []Step{{Name: "gate", Approval: gate}}}},
+			resumable: true,
+			wantErr:   "on_failure",
+		},
+		{
+			name:      "bad default",
+			steps:     []Step{{Name: "gate", Approval: &runapproval.Config{Message: "m", Default: "maybe"}}},
+			resumable: true,
+			wantErr:   "invalid approval decision",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateApprovalSteps(tt.steps, tt.resumable)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// approvalTestDef is a resumable pipeline whose "after" step runs behind a gate.
+func approvalTestDef(name, op string, cfg runapproval.Config) Definition {
+	cfg.Channels = []string{"inapp"}
+	return Definition{
+		Name: name, Enabled: true, Resumable: true,
+		Steps: []Step{
+			{Name: "gate", Approval: &cfg},
+			{Name: "after", Capability: hub.CapExample, Operation: op, Params: map[string]any{"decision": `{{step "gate" "decision"}}`}},
+		},
+	}
+}
+
+func onlyPipelineRunID(t *testing.T, store *mockPipelineStore) int64 {
+	t.Helper()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	require.Len(t, store.runs, 1)
+	for id := range store.runs {
+		return id
+	}
+	return 0
+}
+
+func TestEngine_ApprovalGate(t *testing.T) {
+	t.Parallel()
+	var decisions atomic.Value
+	registerExampleInvoker(t, "after-gate", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		decisions.Store(params["decision"])
+		return &capability.InvokeResult{Data: map[string]any{"ok": true}}, nil
+	})
+
+	tests := []struct {
+		name       string
+		decision   runapproval.Decision
+		wantStatus types.PipelineState
+	}{
+		{name: "approve resumes the run", decision: runapproval.Approve, wantStatus: types.PipelineDone},
+		{name: "reject fails the run", decision: runapproval.Reject, wantStatus: types.PipelineFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMockPipelineStore()
+			def := approvalTestDef("gate-pl", "after-gate", runapproval.Config{Message: "ship?"})
+			e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+			defer e.Stop()
+			decisions.Store("")
+
+			require.NoError(t, e.executePipeline(context.Background(), def, types.DataEvent{EventID: "evt-gate"}, "manual"))
+			runID := onlyPipelineRunID(t, store)
+			assert.Equal(t, int(types.PipelineWaiting), pipelineRunStatus(store, runID))
+			assert.Equal(t, int(types.PipelineWaiting), stepRunsByName(store)["gate"].Status)
+
+			cp, err := e.loadCheckpoint(context.Background(), runID)
+			require.NoError(t, err)
+			require.NotNil(t, cp.Approval)
+			_, req, err := e.GetApproval(context.Background(), cp.Approval.Token)
+			require.NoError(t, err)
+			assert.Equal(t, "ship?", req.Message)
+
+			require.NoError(t, e.DecideApproval(context.Background(), cp.Approval.Token, tt.decision, "alice"))
+			require.Eventually(t, func() bool {
+				return pipelineRunStatus(store, runID) == int(tt.wantStatus)
+			}, 5*time.Second, 10*time.Millisecond)
+
+			err = e.DecideApproval(context.Background(), cp.Approval.Token, tt.decision, "bob")
+			require.ErrorIs(t, err, types.ErrConflict)
+			if tt.decision == runapproval.Approve {
+				assert.Equal(t, "approve", decisions.Load())
+				assert.Equal(t, int(types.PipelineDone), stepRunsByName(store)["gate"].Status)
+			} else {
+				assert.Empty(t, decisions.Load())
+				assert.Equal(t, int(types.PipelineFailed), stepRunsByName(store)["gate"].Status)
+			}
+		})
+	}
+}
+
+func TestEngine_ExpireApprovals(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "after-timeout", func(context.Context, map[string]any) (*capability.InvokeResult, error) {
+		return &capability.InvokeResult{Data: map[string]any{"ok": true}}, nil
+	})
+
+	store := newMockPipelineStore()
+	clock := NewFakeClock(time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC))
+	def := approvalTestDef("timeout-pl", "after-timeout", runapproval.Config{Message: "ship?", Timeout: "1h", Default: "approve"})
+	e := NewEngineWithClock([]Definition{def}, store, nil, noopPC, noopEC, clock)
+	defer e.Stop()
+
+	require.NoError(t, e.executePipeline(context.Background(), def, types.DataEvent{EventID: "evt-timeout"}, "manual"))
+	runID := onlyPipelineRunID(t, store)
+
+	n, err := e.ExpireApprovals(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	clock.Advance(2 * time.Hour)
+	n, err = e.ExpireApprovals(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Eventually(t, func() bool {
+		return pipelineRunStatus(store, runID) == int(types.PipelineDone)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cp, err := e.loadCheckpoint(context.Background(), runID)
+	require.NoError(t, err)
+	require.NotNil(t, cp.Approval)
+	assert.Equal(t, runapproval.DecidedByTimeout, cp.Approval.DecidedBy)
+}
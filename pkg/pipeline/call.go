@@ -0,0 +1,233 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/runcall"
+	"github.com/flowline-io/flowbot/pkg/trace"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	// callOperation is recorded as the operation of call step runs.
+	callOperation = "call"
+	// CallEventType is the event type of runs started by a call step.
+	CallEventType = "pipeline.call"
+)
+
+// Call starts a child run from a step. Exactly one of Workflow and Pipeline is
+// set; the rendered step params are the child input.
+type Call struct {
+	Workflow string `json:"workflow,omitempty" yaml:"workflow,omitempty"`
+	Pipeline string `json:"pipeline,omitempty" yaml:"pipeline,omitempty"`
+	// Wait blocks until the child finishes; nil means true. Without waiting the
+	// step completes once the child has started.
+	Wait *bool `json:"wait,omitempty" yaml:"wait,omitempty"`
+}
+
+// Target returns the kind and name of the called run.
+func (c *Call) Target() (string, string) {
+	if name := strings.TrimSpace(c.Workflow); name != "" {
+		return runcall.KindWorkflow, name
+	}
+	return runcall.KindPipeline, strings.TrimSpace(c.Pipeline)
+}
+
+// Waits reports whether the step waits for the child to finish.
+func (c *Call) Waits() bool {
+	return c.Wait == nil || *c.Wait
+}
+
+// WorkflowCaller starts child workflow runs for call steps. The workflow
+// service implements it; this package cannot import pkg/workflow.
+type WorkflowCaller interface {
+	CallWorkflow(ctx context.Context, parent runcall.Parent, call runcall.Call) (runcall.Result, error)
+}
+
+var (
+	callerMu       sync.Mutex
+	workflowCaller WorkflowCaller
+)
+
+// SetWorkflowCaller wires the service that runs call: {workflow: ...} steps.
+func SetWorkflowCaller(c WorkflowCaller) {
+	callerMu.Lock()
+	defer callerMu.Unlock()
+	workflowCaller = c
+}
+
+func activeWorkflowCaller() WorkflowCaller {
+	callerMu.Lock()
+	defer callerMu.Unlock()
+	return workflowCaller
+}
+
+// validateCall checks a call: step. A call takes the place of a capability
+// call and cannot be combined with foreach or an approval gate.
+func validateCall(s Step) error {
+	if s.Call == nil {
+		return nil
+	}
+	hasWorkflow := strings.TrimSpace(s.Call.Workflow) != ""
+	hasPipeline := strings.TrimSpace(s.Call.Pipeline) != ""
+	if hasWorkflow == hasPipeline {
+		return fmt.Errorf("step %s: call requires exactly one of workflow or pipeline", s.Name)
+	}
+	if s.Capability != "" || s.Operation != "" {
+		return fmt.Errorf("step %s: call steps cannot declare capability or operation", s.Name)
+	}
+	if s.Foreach != nil {
+		return fmt.Errorf("step %s: call steps cannot declare foreach", s.Name)
+	}
+	if s.Approval != nil {
+		return fmt.Errorf("step %s: call steps cannot declare approval", s.Name)
+	}
+	return nil
+}
+
+// executeCall starts the child run of a call step. The step result is the
+// runcall.Result map: kind, name, run_id, status and, when waited for, the
+// child's step results under output.
+func (e *Engine) executeCall(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string, stepIndex int) error {
+	stepStart := time.Now()
+	params, err := rc.RenderParams(step.Params)
+	if err != nil {
+		return fmt.Errorf("render params step %s: %w", step.Name, err)
+	}
+	kind, name := step.Call.Target()
+	call := runcall.Call{Kind: kind, Name: name, Input: params, UID: rc.Event.UID, Wait: step.Call.Waits()}
+
+	if e.callback != nil {
+		e.callback.OnStepStart(ctx, runID, pipelineName, stepIndex, step.Name, params)
+	}
+	stepRunID, err := e.createStepRunRecord(ctx, runID, step.Name, kind, callOperation, params, 1)
+	if err != nil {
+		return err
+	}
+
+	result, err := e.startCall(ctx, runID, call)
+	if err != nil {
+		stepErr := fmt.Errorf("step %s: %w", step.Name, err)
+		e.recordStepFailure(ctx, stepRunID, pipelineName, step.Name, kind, err, 1, stepStart)
+		if e.callback != nil {
+			e.callback.OnStepError(ctx, runID, pipelineName, stepIndex, step.Name, stepErr, time.Since(stepStart).Milliseconds())
+		}
+		return &stepFailure{stepRunID: stepRunID, attempt: 1, err: stepErr}
+	}
+
+	out := result.Map()
+	rc.RecordStepResult(step.Name, out)
+	e.recordStepSuccess(ctx, stepRunID, pipelineName, step.Name, kind, out, 1, stepStart)
+	if e.callback != nil {
+		e.callback.OnStepDone(ctx, runID, pipelineName, stepIndex, step.Name, out, time.Since(stepStart).Milliseconds())
+	}
+	flog.Info("pipeline %s step %s called %s %s (run %d, %s)", pipelineName, step.Name, kind, name, result.RunID, result.Status)
+	return nil
+}
+
+func (e *Engine) startCall(ctx context.Context, runID int64, call runcall.Call) (runcall.Result, error) {
+	parent, err := runcall.Child(ctx, runcall.KindPipeline, runID)
+	if err != nil {
+		return runcall.Result{Kind: call.Kind, Name: call.Name}, err
+	}
+	if call.Kind == runcall.KindPipeline {
+		return e.CallPipeline(ctx, parent, call)
+	}
+	caller := activeWorkflowCaller()
+	if caller == nil {
+		return runcall.Result{Kind: call.Kind, Name: call.Name}, types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	return caller.CallWorkflow(ctx, parent, call)
+}
+
+// CallPipeline starts a run of the named pipeline as a child of parent. The
+// call input is the trigger event data. A waited-for child runs in ctx, so
+// cancelling the caller cancels it, and its step results are returned; a
+// child that stops at an approval gate fails a waiting call. Otherwise the
+// child runs detached and only its run ID is returned.
+func (e *Engine) CallPipeline(ctx context.Context, parent runcall.Parent, call runcall.Call) (runcall.Result, error) {
+	result := runcall.Result{Kind: runcall.KindPipeline, Name: call.Name}
+	if e == nil || e.store == nil {
+		return result, types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	e.reloadMu.Lock()
+	defs := e.defs
+	e.reloadMu.Unlock()
+	def, err := SelectManualDef(defs, call.Name, "")
+	if err != nil {
+		return result, err
+	}
+
+	event := types.DataEvent{
+		EventID:   fmt.Sprintf("call-%s-%d-%s", parent.Kind, parent.RunID, types.Id()),
+		EventType: CallEventType,
+		Source:    parent.Kind,
+		CreatedAt: e.clock.Now(),
+		UID:       call.UID,
+		Data:      types.KV(call.Input),
+	}
+	applyDefinitionUID(*def, &event)
+	e.auditPipelineEvent(ctx, def.Name, "pipeline.start", event.EventID, event.EventType)
+
+	runID, err := e.createRunRecord(ctx, def.Name, event.EventID, event.EventType, runcall.TriggerSource)
+	if err != nil {
+		return result, err
+	}
+	result.RunID = runID
+	if err := e.store.SetRunParent(context.WithoutCancel(ctx), runID, parent); err != nil {
+		return result, fmt.Errorf("link pipeline run %d to %s run %d: %w", runID, parent.Kind, parent.RunID, err)
+	}
+
+	rc := NewRenderContext(event)
+	if !call.Wait {
+		runCtx := runcall.WithDepth(trace.DetachContext(ctx), parent.Depth)
+		go func() {
+			if stepErr := e.runPipelineSteps(runCtx, *def, event, runID, rc); stepErr != nil {
+				flog.Error(fmt.Errorf("called pipeline %s run %d: %w", def.Name, runID, stepErr))
+			}
+		}()
+		result.Status = runcall.StatusStarted
+		return result, nil
+	}
+
+	stepErr := e.runPipelineSteps(runcall.WithDepth(ctx, parent.Depth), *def, event, runID, rc)
+	result.Status = runcall.StatusFailed
+	if run, err := e.store.GetRun(context.WithoutCancel(ctx), runID); err == nil && run != nil {
+		result.Status = pipelineCallStatus(types.PipelineState(run.Status))
+	}
+	if stepErr != nil {
+		if errors.Is(stepErr, context.Canceled) || errors.Is(stepErr, context.DeadlineExceeded) {
+			result.Status = runcall.StatusCancelled
+		}
+		return result, fmt.Errorf("pipeline %s run %d: %w", def.Name, runID, stepErr)
+	}
+	if result.Status == runcall.StatusWaiting {
+		return result, fmt.Errorf("pipeline %s run %d is waiting for approval; call it with wait: false", def.Name, runID)
+	}
+	result.Output = make(map[string]any, len(rc.Steps))
+	for name, out := range rc.Steps {
+		result.Output[name] = out
+	}
+	return result, nil
+}
+
+func pipelineCallStatus(st types.PipelineState) string {
+	switch st {
+	case types.PipelineDone:
+		return runcall.StatusDone
+	case types.PipelineCancel:
+		return runcall.StatusCancelled
+	case types.PipelineWaiting:
+		return runcall.StatusWaiting
+	case types.PipelineStart:
+		return runcall.StatusStarted
+	default:
+		return runcall.StatusFailed
+	}
+}
@@ -0,0 +1,206 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/runcall"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+func TestValidateSteps_Call(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		steps   []Step
+		wantErr string
+	}{
+		{name: "workflow", steps: []Step{{Name: "c", Call: &Call{Workflow: "notify"}}}},
+		{name: "pipeline", steps: []Step{{Name: "c", Call: &Call{Pipeline: "archive"}}}},
+		{name: "neither", steps: []Step{{Name: "c", Call: &Call{}}}, wantErr: "exactly one"},
+		{name: "both", steps: []Step{{Name: "c", Call: &Call{Workflow: "a", Pipeline: "b"}}}, wantErr: "exactly one"},
+		{
+			name:    "with capability",
+			steps:   []Step{{Name: "c", Capability: hub.CapExample, Operation: "op", Call: &Call{Pipeline: "p"}}},
+			wantErr: "capability",
+		},
+		{
+			name:    "with foreach",
+			steps:   []Step{{Name: "c", Foreach: &Foreach{Items: "event.urls"}, Call: &Call{Pipeline: "p"}}},
+			wantErr: "foreach",
+		},
+		{
+			name:    "with approval",
+			steps:   []Step{{Name: "c", Approval: &runapproval.Config{Message: "m"}, Call: &Call{Pipeline: "p"}}},
+			wantErr: "approval",
+		},
+		{
+			name:    "invalid in on_failure",
+			steps:   []Step{{Name: "s", Capability: hub.CapExample, Operation: "op", OnFailure: []Step{{Name: "c", Call: &Call{}}}}},
+			wantErr: "on_failure",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateSteps(tt.steps)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func runByName(store *mockPipelineStore, name string) *model.PipelineRun {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, run := range store.runs {
+		if run.PipelineName == name {
+			c := *run
+			return &c
+		}
+	}
+	return nil
+}
+
+func TestEngine_CallPipeline(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "call-child", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		return &capability.InvokeResult{Data: map[string]any{"archived": params["url"]}}, nil
+	})
+	var afterParams sync.Map
+	registerExampleInvoker(t, "call-after", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		afterParams.Store(params["pipeline"], params)
+		return &capability.InvokeResult{Data: map[string]any{"ok": true}}, nil
+	})
+
+	tests := []struct {
+		name       string
+		wait       bool
+		wantStatus string
+	}{
+		{name: "wait returns child results", wait: true, wantStatus: runcall.StatusDone},
+		{name: "fire and forget", wait: false, wantStatus: runcall.StatusStarted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			parentName := "caller-" + tt.wantStatus
+			child := Definition{Name: "archive", Enabled: true, Steps: []Step{
+				{Name: "fetch", Capability: hub.CapExample, Operation: "call-child", Params: map[string]any{"url": "{{event.url}}"}},
+			}}
+			wait := tt.wait
+			parent := Definition{Name: parentName, Enabled: true, Steps: []Step{
+				{Name: "sub", Call: &Call{Pipeline: "archive", Wait: &wait}, Params: map[string]any{"url": "{{event.url}}"}},
+				{Name: "after", Capability: hub.CapExample, Operation: "call-after", Params: map[string]any{
+					"pipeline": parentName,
+					"status":   `{{step "sub" "status"}}`,
+					"run_id":   `{{step "sub" "run_id"}}`,
+				}},
+			}}
+			store := newMockPipelineStore()
+			e := NewEngine([]Definition{child, parent}, store, nil, noopPC, noopEC)
+			defer e.Stop()
+
+			event := types.DataEvent{EventID: "evt-" + parentName, Data: types.KV{"url": "https://example.com"}}
+			require.NoError(t, e.executePipeline(context.Background(), parent, event, "manual"))
+
+			parentRun := runByName(store, parentName)
+			require.NotNil(t, parentRun)
+			assert.Equal(t, int(types.PipelineDone), parentRun.Status)
+			require.Eventually(t, func() bool {
+				run := runByName(store, "archive")
+				return run != nil && run.Status == int(types.PipelineDone)
+			}, 5*time.Second, 10*time.Millisecond)
+
+			childRun := runByName(store, "archive")
+			assert.Equal(t, runcall.TriggerSource, childRun.TriggerSource)
+			assert.Equal(t, runcall.KindPipeline, childRun.ParentKind)
+			require.NotNil(t, childRun.ParentRunID)
+			assert.Equal(t, parentRun.ID, *childRun.ParentRunID)
+			assert.Equal(t, 1, childRun.CallDepth)
+
+			raw, ok := afterParams.Load(parentName)
+			require.True(t, ok)
+			params := raw.(map[string]any)
+			assert.Equal(t, tt.wantStatus, params["status"])
+		})
+	}
+}
+
+func TestEngine_CallPipelineDepthLimit(t *testing.T) {
+	t.Parallel()
+	def := Definition{Name: "recurse", Enabled: true, Steps: []Step{
+		{Name: "again", Call: &Call{Pipeline: "recurse"}},
+	}}
+	store := newMockPipelineStore()
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	err := e.executePipeline(context.Background(), def, types.DataEvent{EventID: "evt-recurse"}, "manual")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "call depth")
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Len(t, store.runs, runcall.MaxDepth+1)
+	for _, run := range store.runs {
+		assert.Equal(t, int(types.PipelineFailed), run.Status)
+	}
+}
+
+type fakeWorkflowCaller struct {
+	calls atomic.Int32
+	got   runcall.Call
+	from  runcall.Parent
+}
+
+func (f *fakeWorkflowCaller) CallWorkflow(_ context.Context, parent runcall.Parent, call runcall.Call) (runcall.Result, error) {
+	f.calls.Add(1)
+	f.got = call
+	f.from = parent
+	return runcall.Result{Kind: runcall.KindWorkflow, Name: call.Name, RunID: 77, Status: runcall.StatusDone,
+		Output: map[string]any{"notify": "sent"}}, nil
+}
+
+// Not parallel: it wires the package-level workflow caller.
+func TestEngine_CallWorkflow(t *testing.T) {
+	caller := &fakeWorkflowCaller{}
+	SetWorkflowCaller(caller)
+	t.Cleanup(func() { SetWorkflowCaller(nil) })
+
+	def := Definition{Name: "wf-caller", Enabled: true, Steps: []Step{
+		{Name: "notify", Call: &Call{Workflow: "notify-me"}, Params: map[string]any{"msg": "{{event.msg}}"}},
+	}}
+	store := newMockPipelineStore()
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	event := types.DataEvent{EventID: "evt-wf-call", UID: "u1", Data: types.KV{"msg": "hello"}}
+	require.NoError(t, e.executePipeline(context.Background(), def, event, "manual"))
+
+	require.EqualValues(t, 1, caller.calls.Load())
+	assert.Equal(t, "notify-me", caller.got.Name)
+	assert.Equal(t, "hello", caller.got.Input["msg"])
+	assert.Equal(t, "u1", caller.got.UID)
+	assert.True(t, caller.got.Wait)
+	assert.Equal(t, runcall.Parent{Kind: runcall.KindPipeline, RunID: onlyPipelineRunID(t, store), Depth: 1}, caller.from)
+
+	sr := stepRunsByName(store)["notify"]
+	require.NotNil(t, sr)
+	assert.Equal(t, int(types.PipelineDone), sr.Status)
+	assert.Equal(t, callOperation, sr.Operation)
+}
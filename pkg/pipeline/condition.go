@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/pipeline/template"
+)
+
+// EvalCondition renders a step when: expression against the render context and
+// reports whether the step should run. An empty expression always runs.
+//
+// The expression is a template string; a bare expression without delimiters is
+// wrapped in {{ }} so `eq (event "status") "open"` and
+// `{{eq (event "status") "open"}}` are equivalent. The rendered result is false
+// when it is empty, "false", "0", "no", "nil" or "<no value>" (case-insensitive).
+func (rc *RenderContext) EvalCondition(expr string) (bool, error) {
+	expr = conditionTemplate(expr)
+	if expr == "" {
+		return true, nil
+	}
+	out, err := rc.RenderString(expr)
+	if err != nil {
+		return false, err
+	}
+	return isTruthy(out), nil
+}
+
+func conditionTemplate(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if expr != "" && !strings.Contains(expr, "{{") {
+		expr = "{{" + expr + "}}"
+	}
+	return expr
+}
+
+func isTruthy(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "false", "0", "no", "nil", "<no value>":
+		return false
+	default:
+		return true
+	}
+}
+
+// ValidateSteps checks when: and foreach: syntax and on_failure nesting.
+// Compensating steps may declare when: but not their own on_failure or foreach.
+func ValidateSteps(steps []Step) error {
+	tpl := template.New()
+	for _, s := range steps {
+		if err := tpl.Parse(conditionTemplate(s.When)); err != nil {
+			return fmt.Errorf("step %s: invalid when: %w", s.Name, err)
+		}
+		if s.Foreach != nil {
+			if strings.TrimSpace(s.Foreach.Items) == "" {
+				return fmt.Errorf("step %s: foreach requires items", s.Name)
+			}
+			if err := tpl.Parse(s.Foreach.Items); err != nil {
+				return fmt.Errorf("step %s: invalid foreach items: %w", s.Name, err)
+			}
+			if s.Foreach.Concurrency < 0 {
+				return fmt.Errorf("step %s: foreach concurrency must not be negative", s.Name)
+			}
+		}
+		if err := validateCall(s); err != nil {
+			return err
+		}
+		for _, c := range s.OnFailure {
+			if err := validateCall(c); err != nil {
+				return fmt.Errorf("step %s: on_failure %w", s.Name, err)
+			}
+			if len(c.OnFailure) > 0 {
+				return fmt.Errorf("step %s: on_failure step %s cannot declare on_failure", s.Name, c.Name)
+			}
+			if c.Foreach != nil {
+				return fmt.Errorf("step %s: on_failure step %s cannot declare foreach", s.Name, c.Name)
+			}
+			if err := tpl.Parse(conditionTemplate(c.When)); err != nil {
+				return fmt.Errorf("step %s: on_failure step %s: invalid when: %w", s.Name, c.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestRenderContext_EvalCondition(t *testing.T) {
+	t.Parallel()
+	rc := NewRenderContext(types.DataEvent{
+		EventType: "bookmark.created",
+		Data:      map[string]any{"status": "open", "count": 3},
+	})
+	rc.RecordStepResult("fetch", map[string]any{"ok": true, "total": "0"})
+
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{name: "empty expression runs", expr: "", want: true},
+		{name: "bare expression is wrapped", expr: `eq (event "status") "open"`, want: true},
+		{name: "delimited expression renders", expr: `{{eq (event "status") "closed"}}`, want: false},
+		{name: "step result truthy", expr: `step "fetch" "ok"`, want: true},
+		{name: "zero string is falsy", expr: `{{step "fetch" "total"}}`, want: false},
+		{name: "missing step is falsy", expr: `step "missing" "ok"`, want: false},
+		{name: "negation", expr: `not (step "fetch" "ok")`, want: false},
+		{name: "literal text is truthy", expr: "{{event.event_type}}", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := rc.EvalCondition(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderContext_EvalConditionReturnsRenderError(t *testing.T) {
+	t.Parallel()
+	rc := NewRenderContext(types.DataEvent{})
+	_, err := rc.EvalCondition(`nosuchfunc "x"`)
+	require.Error(t, err)
+}
+
+func TestValidateSteps(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		steps   []Step
+		wantErr string
+	}{
+		{
+			name:  "steps without conditions are valid",
+			steps: []Step{{Name: "a"}, {Name: "b"}},
+		},
+		{
+			name:  "valid when and on_failure",
+			steps: []Step{{Name: "a", When: `eq (event "x") "y"`, OnFailure: []Step{{Name: "notify", When: "true"}}}},
+		},
+		{
+			name:    "unclosed when is rejected",
+			steps:   []Step{{Name: "a", When: `{{eq (event "x") "y"`}},
+			wantErr: "step a: invalid when",
+		},
+		{
+			name:    "nested on_failure is rejected",
+			steps:   []Step{{Name: "a", OnFailure: []Step{{Name: "b", OnFailure: []Step{{Name: "c"}}}}}},
+			wantErr: "cannot declare on_failure",
+		},
+		{
+			name:  "valid foreach",
+			steps: []Step{{Name: "a", Foreach: &Foreach{Items: "steps.fetch.items", Concurrency: 4}}},
+		},
+		{
+			name:    "foreach without items is rejected",
+			steps:   []Step{{Name: "a", Foreach: &Foreach{}}},
+			wantErr: "foreach requires items",
+		},
+		{
+			name:    "foreach on compensating step is rejected",
+			steps:   []Step{{Name: "a", OnFailure: []Step{{Name: "b", Foreach: &Foreach{Items: "event.ids"}}}}},
+			wantErr: "cannot declare foreach",
+		},
+		{
+			name:    "invalid when on compensating step is rejected",
+			steps:   []Step{{Name: "a", OnFailure: []Step{{Name: "b", When: "{{"}}}},
+			wantErr: "on_failure step b: invalid when",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateSteps(tt.steps)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParseEditorYAML_WhenAndOnFailure(t *testing.T) {
+	t.Parallel()
+	ed, err := ParseEditorYAML(`name: bookmark_read_later
+enabled: true
+triggers:
+  - type: event
+    enabled: true
+    event: bookmark.created
+steps:
+  - name: bookmark
+    capability: karakeep
+    operation: create
+    when: 'eq (event "source") "rss"'
+    on_failure:
+      - name: notify_failure
+        capability: core
+        operation: notify_send
+`)
+	require.NoError(t, err)
+	require.Len(t, ed.Steps, 1)
+	assert.Equal(t, `eq (event "source") "rss"`, ed.Steps[0].When)
+	require.Len(t, ed.Steps[0].OnFailure, 1)
+	assert.Equal(t, "notify_failure", ed.Steps[0].OnFailure[0].Name)
+	assert.Equal(t, "notify_send", ed.Steps[0].OnFailure[0].Operation)
+}
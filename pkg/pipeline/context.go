@@ -9,9 +9,11 @@ import (
 )
 
 type RenderContext struct {
-	Event  types.DataEvent
-	Steps  map[string]map[string]any
-	Input  map[string]any
+	Event types.DataEvent
+	Steps map[string]map[string]any
+	Input map[string]any
+	// Item is the current element while a foreach step renders its params.
+	Item   any
 	engine *template.Engine
 }
 
@@ -27,6 +29,18 @@ func (rc *RenderContext) RecordStepResult(stepName string, result map[string]any
 	rc.Steps[stepName] = result
 }
 
+// withItem returns a copy of rc for one foreach element. Steps is cloned so
+// concurrent items can record results without racing on the shared map.
+func (rc *RenderContext) withItem(item any) *RenderContext {
+	return &RenderContext{
+		Event:  rc.Event,
+		Steps:  maps.Clone(rc.Steps),
+		Input:  rc.Input,
+		Item:   item,
+		engine: rc.engine,
+	}
+}
+
 func (rc *RenderContext) RenderParams(params map[string]any) (map[string]any, error) {
 	return rc.engine.Render(params, rc.templateData())
 }
@@ -59,5 +73,6 @@ func (rc *RenderContext) templateData() *template.TemplateData {
 		Event: event,
 		Steps: rc.Steps,
 		Input: rc.Input,
+		Item:  rc.Item,
 	}
 }
@@ -0,0 +1,200 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/trace"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+// trackRun registers a cancel func for runID so CancelRun can interrupt the
+// run's capability invocations. The returned func must be called when the run ends.
+func (e *Engine) trackRun(ctx context.Context, runID int64) (context.Context, func()) {
+	if runID == 0 {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	e.activeMu.Lock()
+	if e.active == nil {
+		e.active = make(map[int64]context.CancelFunc)
+	}
+	e.active[runID] = cancel
+	e.activeMu.Unlock()
+	return ctx, func() {
+		e.activeMu.Lock()
+		delete(e.active, runID)
+		e.activeMu.Unlock()
+		cancel()
+	}
+}
+
+// CancelRun stops a pipeline run. A run executing in this process has its
+// context cancelled and is recorded as cancelled when the current step returns.
+// A run waiting for approval is marked cancelled directly, as is a started run
+// whose heartbeat went stale because the process running it is gone. A started
+// run with a fresh heartbeat belongs to another server and is a conflict.
+func (e *Engine) CancelRun(ctx context.Context, runID int64) error {
+	if e == nil {
+		return types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	e.activeMu.Lock()
+	cancel, ok := e.active[runID]
+	e.activeMu.Unlock()
+	if ok {
+		cancel()
+		flog.Info("pipeline run %d cancel requested", runID)
+		return nil
+	}
+
+	run, err := e.getRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+	switch run.Status {
+	case int(types.PipelineWaiting):
+	case int(types.PipelineStart):
+		// A run that never reached its first checkpoint is judged by its
+		// start time and run heartbeat alone.
+		cp, _ := e.loadCheckpoint(ctx, runID)
+		if !runStale(run, cp, e.clock.Now()) {
+			return types.Errorf(types.ErrConflict, "pipeline run %d is running on another server", runID)
+		}
+	default:
+		return types.Errorf(types.ErrConflict, "pipeline run %d is not running", runID)
+	}
+	swapped, err := e.store.SwapRunStatus(ctx, runID, []int{run.Status}, int(types.PipelineCancel), "cancelled: run is not active on this server")
+	if err != nil {
+		return fmt.Errorf("update run %d status: %w", runID, err)
+	}
+	if !swapped {
+		return types.Errorf(types.ErrConflict, "pipeline run %d changed state", runID)
+	}
+	return nil
+}
+
+// RetryRun resumes a failed or cancelled run of a resumable pipeline from the
+// step that stopped it, reusing the step results saved in its checkpoint.
+// The run keeps its ID and continues in a detached goroutine.
+func (e *Engine) RetryRun(ctx context.Context, runID int64) error {
+	if e == nil {
+		return types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	run, err := e.getRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+	if run.Status != int(types.PipelineFailed) && run.Status != int(types.PipelineCancel) {
+		return types.Errorf(types.ErrConflict, "pipeline run %d is not failed or cancelled", runID)
+	}
+	if e.findResumableDef(run.PipelineName) == nil {
+		return types.Errorf(types.ErrInvalidArgument, "pipeline %s is not resumable; re-run it instead", run.PipelineName)
+	}
+	cp, err := e.loadCheckpoint(ctx, runID)
+	if err != nil {
+		return err
+	}
+	if cp.Approval.Decided() && cp.Approval.Decision != runapproval.Approve {
+		// A retried gate asks again instead of replaying the rejection.
+		cp.Approval = nil
+		if err := e.store.SaveCheckpoint(ctx, runID, cp); err != nil {
+			return fmt.Errorf("reset approval of run %d: %w", runID, err)
+		}
+	}
+	// Only one retry may move the run back to started and launch it.
+	swapped, err := e.store.SwapRunStatus(ctx, runID, []int{int(types.PipelineFailed), int(types.PipelineCancel)}, int(types.PipelineStart), "")
+	if err != nil {
+		return fmt.Errorf("update run %d status: %w", runID, err)
+	}
+	if !swapped {
+		return types.Errorf(types.ErrConflict, "pipeline run %d is already being retried", runID)
+	}
+
+	runCtx := trace.DetachContext(ctx)
+	go func() {
+		if resumeErr := e.ResumePipeline(runCtx, runID); resumeErr != nil {
+			flog.Error(fmt.Errorf("retry pipeline %s run %d: %w", run.PipelineName, runID, resumeErr))
+		}
+	}()
+	return nil
+}
+
+// RerunRun starts a new manual run of the same pipeline definition with the
+// event recorded in runID's checkpoint. It returns the new run ID.
+func (e *Engine) RerunRun(ctx context.Context, runID int64) (int64, error) {
+	if e == nil {
+		return 0, types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	run, err := e.getRun(ctx, runID)
+	if err != nil {
+		return 0, err
+	}
+	cp, err := e.loadCheckpoint(ctx, runID)
+	if err != nil {
+		return 0, err
+	}
+	def := e.findDef(run.PipelineName)
+	if def == nil {
+		return 0, types.Errorf(types.ErrNotFound, "pipeline %s", run.PipelineName)
+	}
+
+	event := cp.Event
+	event.EventID = "rerun-" + types.Id()
+	return e.startDetachedRun(ctx, *def, event)
+}
+
+// IsResumable reports whether the loaded definition name (as recorded on a
+// run) is resumable, i.e. whether its failed runs can be retried.
+func (e *Engine) IsResumable(name string) bool {
+	if e == nil {
+		return false
+	}
+	return e.findResumableDef(name) != nil
+}
+
+func (e *Engine) getRun(ctx context.Context, runID int64) (*model.PipelineRun, error) {
+	if e.store == nil {
+		return nil, types.Errorf(types.ErrUnavailable, "pipeline store not available")
+	}
+	if runID <= 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "run id is required")
+	}
+	run, err := e.store.GetRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, types.Errorf(types.ErrNotFound, "pipeline run %d", runID)
+	}
+	return run, nil
+}
+
+// loadCheckpoint returns the run's checkpoint, or ErrNotFound when the run
+// predates checkpointing or never reached its first step.
+func (e *Engine) loadCheckpoint(ctx context.Context, runID int64) (*CheckpointData, error) {
+	cp := &CheckpointData{}
+	if err := e.store.GetCheckpoint(ctx, runID, cp); err != nil {
+		return nil, types.WrapError(types.ErrNotFound, fmt.Sprintf("no checkpoint for pipeline run %d", runID), err)
+	}
+	if cp.HeartbeatAt.IsZero() && strings.TrimSpace(cp.Event.EventID) == "" {
+		return nil, types.Errorf(types.ErrNotFound, "no checkpoint for pipeline run %d", runID)
+	}
+	return cp, nil
+}
+
+// findDef returns the loaded definition with the given run name.
+func (e *Engine) findDef(name string) *Definition {
+	e.reloadMu.Lock()
+	defer e.reloadMu.Unlock()
+	for i := range e.defs {
+		if e.defs[i].Name == name {
+			def := e.defs[i]
+			return &def
+		}
+	}
+	return nil
+}
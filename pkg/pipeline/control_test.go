@@ -0,0 +1,269 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+func pipelineRunStatus(store *mockPipelineStore, runID int64) int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return store.runs[runID].Status
+}
+
+func TestEngine_CancelRun_InProcess(t *testing.T) {
+	t.Parallel()
+	started := make(chan struct{})
+	registerExampleInvoker(t, "block", func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name:    "cancel-pl",
+		Enabled: true,
+		Steps:   []Step{{Name: "wait", Capability: hub.CapExample, Operation: "block"}},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	runID, err := e.ExecuteManual(context.Background(), "cancel-pl", types.DataEvent{EventID: "manual-cancel"})
+	require.NoError(t, err)
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("step did not start")
+	}
+
+	require.NoError(t, e.CancelRun(context.Background(), runID))
+	require.Eventually(t, func() bool {
+		return pipelineRunStatus(store, runID) == int(types.PipelineCancel)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestEngine_CancelRun_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		status     types.PipelineState
+		runID      int64
+		heartbeat  time.Duration
+		wantErr    error
+		wantStatus types.PipelineState
+	}{
+		{name: "finished run", status: types.PipelineDone, wantErr: types.ErrConflict, wantStatus: types.PipelineDone},
+		{name: "orphaned started run", status: types.PipelineStart, wantStatus: types.PipelineCancel},
+		{name: "stale heartbeat", status: types.PipelineStart, heartbeat: -10 * time.Minute, wantStatus: types.PipelineCancel},
+		{name: "running on another server", status: types.PipelineStart, heartbeat: -time.Second, wantErr: types.ErrConflict, wantStatus: types.PipelineStart},
+		{name: "waiting run", status: types.PipelineWaiting, heartbeat: -time.Second, wantStatus: types.PipelineCancel},
+		{name: "invalid id", runID: -1, wantErr: types.ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := newMockPipelineStore()
+			e := NewEngine(nil, store, nil, noopPC, noopEC)
+			defer e.Stop()
+			run, err := store.CreateRun(context.Background(), "pl", "evt", "", "manual")
+			require.NoError(t, err)
+			run.Status = int(tt.status)
+			if tt.heartbeat != 0 {
+				beat := time.Now().Add(tt.heartbeat)
+				run.LastHeartbeat = &beat
+			}
+			runID := run.ID
+			if tt.runID != 0 {
+				runID = tt.runID
+			}
+
+			err = e.CancelRun(context.Background(), runID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantStatus != 0 {
+				assert.Equal(t, int(tt.wantStatus), pipelineRunStatus(store, run.ID))
+			}
+		})
+	}
+}
+
+func TestEngine_RetryRun(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "retry", func(_ context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		return &capability.InvokeResult{Data: map[string]any{"ok": true}}, nil
+	})
+	steps := []Step{
+		{Name: "s1", Capability: hub.CapExample, Operation: "retry"},
+		{Name: "s2", Capability: hub.CapExample, Operation: "retry"},
+	}
+	defs := []Definition{
+		{Name: "retry-pl", Enabled: true, Resumable: true, Steps: steps},
+		{Name: "plain-pl", Enabled: true, Steps: steps},
+	}
+
+	tests := []struct {
+		name       string
+		pipeline   string
+		status     types.PipelineState
+		checkpoint bool
+		wantErr    error
+	}{
+		{name: "resumes from failed step", pipeline: "retry-pl", status: types.PipelineFailed, checkpoint: true},
+		{name: "running run", pipeline: "retry-pl", status: types.PipelineStart, checkpoint: true, wantErr: types.ErrConflict},
+		{name: "not resumable", pipeline: "plain-pl", status: types.PipelineFailed, checkpoint: true, wantErr: types.ErrInvalidArgument},
+		{name: "missing checkpoint", pipeline: "retry-pl", status: types.PipelineCancel, wantErr: types.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := newMockPipelineStore()
+			e := NewEngine(defs, store, nil, noopPC, noopEC)
+			defer e.Stop()
+			run, err := store.CreateRun(context.Background(), tt.pipeline, "evt-"+tt.name, "", "manual")
+			require.NoError(t, err)
+			run.Status = int(tt.status)
+			if tt.checkpoint {
+				require.NoError(t, store.SaveCheckpoint(context.Background(), run.ID, &CheckpointData{
+					StepIndex:   1,
+					StepResults: map[string]*StepResult{"s1": {Name: "s1", Output: map[string]any{"ok": true}}},
+					Event:       types.DataEvent{EventID: run.EventID},
+					HeartbeatAt: time.Now(),
+				}))
+			}
+
+			err = e.RetryRun(context.Background(), run.ID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Eventually(t, func() bool {
+				return pipelineRunStatus(store, run.ID) == int(types.PipelineDone)
+			}, 5*time.Second, 10*time.Millisecond)
+
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			var names []string
+			for _, sr := range store.stepRuns {
+				names = append(names, sr.StepName)
+			}
+			assert.Equal(t, []string{"s2"}, names, "completed steps are not re-run")
+		})
+	}
+}
+
+// raceRetryStore lets another retry win the status change between RetryRun's
+// status check and its compare-and-set.
+type raceRetryStore struct {
+	*mockPipelineStore
+}
+
+func (s raceRetryStore) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	_ = s.UpdateRunStatus(ctx, runID, int(types.PipelineStart), "")
+	return s.mockPipelineStore.SwapRunStatus(ctx, runID, from, to, errMsg)
+}
+
+func TestEngine_RetryRun_LosesRace(t *testing.T) {
+	t.Parallel()
+	store := newMockPipelineStore()
+	def := Definition{
+		Name:      "race-pl",
+		Enabled:   true,
+		Resumable: true,
+		Steps:     []Step{{Name: "s1", Capability: hub.CapExample, Operation: "retry"}},
+	}
+	e := NewEngine([]Definition{def}, raceRetryStore{store}, nil, noopPC, noopEC)
+	defer e.Stop()
+	run, err := store.CreateRun(context.Background(), "race-pl", "evt-race", "", "manual")
+	require.NoError(t, err)
+	run.Status = int(types.PipelineFailed)
+	require.NoError(t, store.SaveCheckpoint(context.Background(), run.ID, &CheckpointData{
+		Event:       types.DataEvent{EventID: run.EventID},
+		HeartbeatAt: time.Now(),
+	}))
+
+	err = e.RetryRun(context.Background(), run.ID)
+	require.ErrorIs(t, err, types.ErrConflict)
+	assert.Empty(t, store.stepRuns, "the losing retry does not launch the run")
+}
+
+func TestEngine_RerunRun(t *testing.T) {
+	t.Parallel()
+	seen := make(chan map[string]any, 4)
+	registerExampleInvoker(t, "rerun", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		seen <- params
+		return &capability.InvokeResult{}, nil
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name:    "rerun-pl",
+		Enabled: true,
+		Steps: []Step{
+			{Name: "s1", Capability: hub.CapExample, Operation: "rerun", Params: map[string]any{"url": "{{event.url}}"}},
+			{Name: "s2", Capability: hub.CapExample, Operation: "rerun", Params: map[string]any{"url": "{{event.url}}"}},
+		},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	first, err := e.ExecuteManual(context.Background(), "rerun-pl", types.DataEvent{
+		EventID: "manual-first",
+		Data:    types.KV{"url": "https://a"},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return pipelineRunStatus(store, first) == int(types.PipelineDone)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	store.mu.Lock()
+	cp := store.checkpoints[first]
+	store.mu.Unlock()
+	require.NotNil(t, cp, "non-resumable runs record their trigger event")
+	assert.Equal(t, 0, cp.StepIndex)
+
+	second, err := e.RerunRun(context.Background(), first)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	require.Eventually(t, func() bool {
+		return pipelineRunStatus(store, second) == int(types.PipelineDone)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	store.mu.Lock()
+	rerun := &model.PipelineRun{}
+	*rerun = *store.runs[second]
+	store.mu.Unlock()
+	assert.True(t, strings.HasPrefix(rerun.EventID, "rerun-"))
+	assert.Equal(t, "manual", rerun.TriggerSource)
+
+	for range 4 {
+		params := <-seen
+		assert.Equal(t, "https://a", params["url"])
+	}
+}
+
+func TestEngine_RerunRun_NoCheckpoint(t *testing.T) {
+	t.Parallel()
+	store := newMockPipelineStore()
+	e := NewEngine(nil, store, nil, noopPC, noopEC)
+	defer e.Stop()
+	run, err := store.CreateRun(context.Background(), "gone", "evt", "", "event")
+	require.NoError(t, err)
+
+	_, err = e.RerunRun(context.Background(), run.ID)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
@@ -24,6 +24,7 @@ type TriggerEntry struct {
 	Cron        string         `json:"cron,omitempty" yaml:"cron,omitempty"`
 	CronTimeout string         `json:"cron_timeout,omitempty" yaml:"cron_timeout,omitempty"`
 	Webhook     *WebhookConfig `json:"webhook,omitempty" yaml:"webhook,omitempty"`
+	Filter      *TriggerFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
 }
 
 // DefinitionRecord holds a published pipeline definition loaded from the database.
@@ -17,6 +17,8 @@ import (
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/hub"
 	"github.com/flowline-io/flowbot/pkg/metrics"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/trace"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/audit"
@@ -33,6 +35,8 @@ type CheckpointData struct {
 	StepResults map[string]*StepResult `json:"step_results"`
 	Event       types.DataEvent        `json:"event"`
 	HeartbeatAt time.Time              `json:"heartbeat_at"`
+	// Approval is the gate opened at StepIndex while the run waits for a decision.
+	Approval *runapproval.Request `json:"approval,omitempty"`
 }
 
 // StepResult captures the output of a completed pipeline step.
@@ -71,10 +75,15 @@ func mergeTags(upstream types.KV, stepTags any) types.KV {
 type RunStore interface {
 	CreateRun(ctx context.Context, pipelineName, eventID, eventType, triggerSource string) (*model.PipelineRun, error)
 	UpdateRunStatus(ctx context.Context, runID int64, status int, errMsg string) error
+	// SwapRunStatus sets the status only while it is one of from and reports
+	// whether it did.
+	SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error)
 	CreateStepRun(ctx context.Context, runID int64, stepName, capName, operation string, params map[string]any, attempt int) (*model.PipelineStepRun, error)
 	UpdateStepRun(ctx context.Context, stepRunID int64, status int, result map[string]any, errMsg string, attempt int) error
 	SaveCheckpoint(ctx context.Context, runID int64, data any) error
 	GetIncompleteRuns(ctx context.Context) ([]*model.PipelineRun, error)
+	GetWaitingRuns(ctx context.Context) ([]*model.PipelineRun, error)
+	SetRunParent(ctx context.Context, runID int64, parent runcall.Parent) error
 	GetCheckpoint(ctx context.Context, runID int64, target any) error
 	GetRun(ctx context.Context, runID int64) (*model.PipelineRun, error)
 	UpdateRunHeartbeat(ctx context.Context, runID int64) error
@@ -95,6 +104,13 @@ type Engine struct {
 	clock           Clock
 	callback        StepCallback
 	reloadMu        sync.Mutex
+
+	// active holds cancel funcs for runs executing in this process.
+	activeMu sync.Mutex
+	active   map[int64]context.CancelFunc
+
+	// approvalMu serializes approval decisions so a gate resumes its run once.
+	approvalMu sync.Mutex
 }
 
 func NewEngine(defs []Definition, store RunStore, auditor audit.Auditor, pc *metrics.PipelineCollector, ec *metrics.EventCollector) *Engine {
@@ -110,6 +126,7 @@ func NewEngineWithClock(defs []Definition, store RunStore, auditor audit.Auditor
 		eventMetrics:    ec,
 		mu:              make(map[string]*sync.Mutex),
 		clock:           clock,
+		active:          make(map[int64]context.CancelFunc),
 	}
 	e.handler = e.handleEvent
 
@@ -184,6 +201,14 @@ func (e *Engine) handleEvent(ctx context.Context, event types.DataEvent) error {
 	}
 
 	for _, def := range matched {
+		// Filters run before dedup and run creation so rejected events leave
+		// no consumption record and no empty run behind.
+		if !def.Trigger.Filter.Match(event) {
+			if e.eventMetrics != nil {
+				e.eventMetrics.IncFiltered(event.EventType, def.Name)
+			}
+			continue
+		}
 		if e.eventMetrics != nil {
 			e.eventMetrics.IncMatched(event.EventType, def.Name)
 		}
@@ -215,7 +240,7 @@ func (e *Engine) executePipeline(ctx context.Context, def Definition, event type
 	if err != nil {
 		return err
 	}
-	return e.runPipelineSteps(ctx, def, event, runID)
+	return e.runPipelineSteps(ctx, def, event, runID, NewRenderContext(event))
 }
 
 // FindDefsByParentName returns loaded engine definitions for a parent pipeline name.
@@ -273,8 +298,13 @@ func (e *Engine) ExecuteManual(ctx context.Context, parentName string, event typ
 	if err != nil {
 		return 0, err
 	}
+	return e.startDetachedRun(ctx, *def, event)
+}
 
-	applyDefinitionUID(*def, &event)
+// startDetachedRun dedups the event, creates a manual run record and runs the
+// steps in a detached goroutine.
+func (e *Engine) startDetachedRun(ctx context.Context, def Definition, event types.DataEvent) (int64, error) {
+	applyDefinitionUID(def, &event)
 	e.auditPipelineEvent(ctx, def.Name, "pipeline.start", event.EventID, event.EventType)
 
 	alreadyDone, err := e.checkDedupAndRecord(ctx, def.Name, event.EventID, event.EventType)
@@ -295,14 +325,15 @@ func (e *Engine) ExecuteManual(ctx context.Context, parentName string, event typ
 
 	runCtx := trace.DetachContext(ctx)
 	go func() {
-		if stepErr := e.runPipelineSteps(runCtx, *def, event, runID); stepErr != nil {
+		if stepErr := e.runPipelineSteps(runCtx, def, event, runID, NewRenderContext(event)); stepErr != nil {
 			flog.Error(fmt.Errorf("manual pipeline %s run %d: %w", def.Name, runID, stepErr))
 		}
 	}()
 	return runID, nil
 }
 
-func (e *Engine) runPipelineSteps(ctx context.Context, def Definition, event types.DataEvent, runID int64) error {
+// runPipelineSteps runs def's steps for event, recording step results in rc.
+func (e *Engine) runPipelineSteps(ctx context.Context, def Definition, event types.DataEvent, runID int64, rc *RenderContext) error {
 	ctx, span := trace.StartSpan(ctx, "pipeline."+def.Name+".execute",
 		otelattr.String("pipeline.name", def.Name),
 		otelattr.String("event.id", event.EventID),
@@ -310,23 +341,31 @@ func (e *Engine) runPipelineSteps(ctx context.Context, def Definition, event typ
 	)
 	defer span.End()
 
+	ctx, untrack := e.trackRun(ctx, runID)
+	defer untrack()
+
 	runStart := time.Now()
 	e.emitRunStart(ctx, runID, &def)
 
-	rc := NewRenderContext(event)
 	failed := false
 	var finalErr error
 
 	for i, step := range def.Steps {
-		e.saveCheckpointIfResumable(ctx, def, event, rc, i, runID)
+		e.saveRunCheckpoint(ctx, def, event, rc, i, runID)
 
-		if err := e.executeStep(ctx, rc, step, runID, def.Name, i, def.Resumable); err != nil {
+		if err := e.runStep(ctx, rc, step, runID, def.Name, i, def.Resumable); err != nil {
 			failed = true
 			finalErr = err
 			break
 		}
 	}
 
+	if errors.Is(finalErr, runapproval.ErrPending) {
+		// The run stays waiting; DecideApproval or the timeout resumes it.
+		e.auditPipelineEvent(ctx, def.Name, "pipeline.waiting", event.EventID, event.EventType)
+		return nil
+	}
+
 	if e.pipelineMetrics != nil {
 		status := "done"
 		if finalErr != nil {
@@ -355,7 +394,99 @@ func (e *Engine) runPipelineSteps(ctx context.Context, def Definition, event typ
 	return nil
 }
 
+// stepFailure carries the persisted step run of a failed invocation so
+// on_failure handling can re-mark it as compensated.
+type stepFailure struct {
+	stepRunID int64
+	attempt   int
+	err       error
+}
+
+func (f *stepFailure) Error() string { return f.err.Error() }
+
+func (f *stepFailure) Unwrap() error { return f.err }
+
+// runStep evaluates the step's when: condition, executes it, and runs its
+// on_failure steps when it fails. A step whose on_failure steps all succeed is
+// recorded as compensated and the run continues; later steps can test
+// {{step "<name>" "compensated"}}. Cancellation is never compensated.
+func (e *Engine) runStep(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string, stepIndex int, resumable bool) error {
+	run, err := rc.EvalCondition(step.When)
+	if err != nil {
+		return fmt.Errorf("evaluate when step %s: %w", step.Name, err)
+	}
+	if !run {
+		return e.recordStepSkipped(ctx, rc, step, runID, pipelineName)
+	}
+
+	switch {
+	case step.Approval != nil:
+		err = e.executeApproval(ctx, rc, step, runID, pipelineName, stepIndex)
+	case step.Foreach != nil:
+		err = e.executeForeach(ctx, rc, step, runID, pipelineName, stepIndex, resumable)
+	default:
+		err = e.executeStep(ctx, rc, step, runID, pipelineName, stepIndex, resumable)
+	}
+	if err == nil || len(step.OnFailure) == 0 {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, runapproval.ErrPending) {
+		return err
+	}
+	return e.compensateStep(ctx, rc, step, err, runID, pipelineName, stepIndex, resumable)
+}
+
+// recordStepSkipped persists a skipped step run and exposes {skipped: true} to later templates.
+func (e *Engine) recordStepSkipped(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string) error {
+	stepRunID, err := e.createStepRunRecord(ctx, runID, step.Name, string(step.Capability), step.Operation, nil, 1)
+	if err != nil {
+		return err
+	}
+	e.updateStepRunRecord(ctx, stepRunID, int(types.PipelineSkipped), nil, "", 1)
+	e.recordStepMetrics(pipelineName, step.Name, string(step.Capability), "skipped", 0, 1)
+	rc.RecordStepResult(step.Name, map[string]any{"skipped": true})
+	flog.Info("pipeline %s step %s skipped: when condition is false", pipelineName, step.Name)
+	return nil
+}
+
+// compensateStep runs on_failure steps for a failed step. When every
+// compensating step succeeds (or is skipped) the failed step run is re-marked
+// compensated; otherwise the first compensation error fails the run.
+func (e *Engine) compensateStep(ctx context.Context, rc *RenderContext, step Step, stepErr error, runID int64, pipelineName string, stepIndex int, resumable bool) error {
+	rc.RecordStepResult(step.Name, map[string]any{"error": stepErr.Error(), "failed": true})
+	flog.Info("pipeline %s step %s failed, running %d on_failure step(s)", pipelineName, step.Name, len(step.OnFailure))
+
+	for _, comp := range step.OnFailure {
+		run, err := rc.EvalCondition(comp.When)
+		if err != nil {
+			return fmt.Errorf("evaluate when step %s: %w", comp.Name, err)
+		}
+		if !run {
+			if err := e.recordStepSkipped(ctx, rc, comp, runID, pipelineName); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := e.executeStep(ctx, rc, comp, runID, pipelineName, stepIndex, resumable); err != nil {
+			return fmt.Errorf("on_failure of step %s: %w (original error: %s)", step.Name, err, stepErr.Error())
+		}
+	}
+
+	var failure *stepFailure
+	if errors.As(stepErr, &failure) {
+		e.updateStepRunRecord(ctx, failure.stepRunID, int(types.PipelineCompensated), nil, stepErr.Error(), failure.attempt)
+	}
+	if e.pipelineMetrics != nil {
+		e.pipelineMetrics.IncStepTotal(pipelineName, step.Name, "compensated")
+	}
+	rc.RecordStepResult(step.Name, map[string]any{"error": stepErr.Error(), "failed": true, "compensated": true})
+	return nil
+}
+
 func (e *Engine) executeStep(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string, stepIndex int, resumable bool) error {
+	if step.Call != nil {
+		return e.executeCall(ctx, rc, step, runID, pipelineName, stepIndex)
+	}
 	ctx, span := trace.StartSpan(ctx, "pipeline."+pipelineName+".step."+step.Name,
 		otelattr.String("pipeline.step.name", step.Name),
 		otelattr.String("pipeline.step.capability", string(step.Capability)),
@@ -398,6 +529,29 @@ func (e *Engine) executeStep(ctx context.Context, rc *RenderContext, step Step,
 		e.pipelineMetrics.IncStepTotal(pipelineName, step.Name, "start")
 	}
 
+	stepResult, stepResource, attempt, retryErr := invokeWithRetry(ctx, step, step.Name, renderedParams, pipelineName)
+	if retryErr != nil {
+		stepErr := formatStepError(step.Name, retryErr, attempt)
+		e.recordStepFailure(ctx, stepRunID, pipelineName, step.Name, string(step.Capability), retryErr, attempt, stepStart)
+		if e.callback != nil {
+			e.callback.OnStepError(ctx, runID, pipelineName, stepIndex, step.Name, stepErr, time.Since(stepStart).Milliseconds())
+		}
+		return &stepFailure{stepRunID: stepRunID, attempt: attempt, err: stepErr}
+	}
+
+	rc.RecordStepResult(step.Name, stepResult)
+	e.saveResourceLink(ctx, rc, step, stepResource, runID, pipelineName)
+	e.recordStepSuccess(ctx, stepRunID, pipelineName, step.Name, string(step.Capability), stepResult, attempt, stepStart)
+	if e.callback != nil {
+		e.callback.OnStepDone(ctx, runID, pipelineName, stepIndex, step.Name, stepResult, time.Since(stepStart).Milliseconds())
+	}
+	flog.Info("pipeline %s step %s completed (attempt %d)", pipelineName, step.Name, attempt)
+	return nil
+}
+
+// invokeWithRetry invokes the step's capability with its retry policy and
+// returns the extracted result, the created resource, and the final attempt.
+func invokeWithRetry(ctx context.Context, step Step, label string, params map[string]any, pipelineName string) (map[string]any, *capability.ResourceMeta, int, error) {
 	retryCfg := step.Retry
 	if retryCfg == nil {
 		retryCfg = &backoff.Config{MaxAttempts: 0}
@@ -405,13 +559,13 @@ func (e *Engine) executeStep(ctx context.Context, rc *RenderContext, step Step,
 	boCfg := *retryCfg
 	boCfg.OnRetry = func(a int, d time.Duration, err error) {
 		flog.Info("pipeline %s step %s attempt %d failed, retrying in %v: %v",
-			pipelineName, step.Name, a, d, err)
+			pipelineName, label, a, d, err)
 	}
 
 	var stepResult map[string]any
 	var stepResource *capability.ResourceMeta
-	attempt, retryErr := backoff.Do(ctx, boCfg, func(ctx context.Context) error {
-		res, invokeErr := capability.Invoke(ctx, step.Capability, step.Operation, renderedParams)
+	attempt, err := backoff.Do(ctx, boCfg, func(ctx context.Context) error {
+		res, invokeErr := capability.Invoke(ctx, step.Capability, step.Operation, params)
 		if invokeErr != nil {
 			trace.RecordError(ctx, invokeErr)
 			return invokeErr
@@ -420,24 +574,7 @@ func (e *Engine) executeStep(ctx context.Context, rc *RenderContext, step Step,
 		stepResource = res.Resource
 		return nil
 	})
-
-	if retryErr != nil {
-		stepErr := formatStepError(step.Name, retryErr, attempt)
-		e.recordStepFailure(ctx, stepRunID, pipelineName, step.Name, string(step.Capability), retryErr, attempt, stepStart)
-		if e.callback != nil {
-			e.callback.OnStepError(ctx, runID, pipelineName, stepIndex, step.Name, stepErr, time.Since(stepStart).Milliseconds())
-		}
-		return stepErr
-	}
-
-	rc.RecordStepResult(step.Name, stepResult)
-	e.saveResourceLink(ctx, rc, step, stepResource, runID, pipelineName)
-	e.recordStepSuccess(ctx, stepRunID, pipelineName, step.Name, string(step.Capability), stepResult, attempt, stepStart)
-	if e.callback != nil {
-		e.callback.OnStepDone(ctx, runID, pipelineName, stepIndex, step.Name, stepResult, time.Since(stepStart).Milliseconds())
-	}
-	flog.Info("pipeline %s step %s completed (attempt %d)", pipelineName, step.Name, attempt)
-	return nil
+	return stepResult, stepResource, attempt, err
 }
 
 // injectTags merges event tags into rendered params for mutation steps.
@@ -445,9 +582,10 @@ func injectTags(rc *RenderContext, renderedParams map[string]any) {
 	renderedParams["tags"] = mergeTags(rc.Event.Tags, renderedParams["tags"])
 }
 
-// InjectAgentRunDefaults applies default uid and memory_scope for agent_run and notify_send steps.
+// InjectAgentRunDefaults applies default uid, memory_scope and pipeline_step for agent_run and notify_send steps.
 func InjectAgentRunDefaults(step Step, renderedParams map[string]any, rc *RenderContext, pipelineName string) {
 	injectAgentRunMemoryScope(step, renderedParams, pipelineName)
+	injectAgentRunPipelineStep(step, renderedParams, pipelineName)
 	injectEventUID(step, renderedParams, rc)
 }
 
@@ -479,6 +617,18 @@ func injectAgentRunMemoryScope(step Step, renderedParams map[string]any, pipelin
 	renderedParams["memory_scope"] = pipelineName
 }
 
+// injectAgentRunPipelineStep sets pipeline_step to "<pipeline>/<step>" so agent_run
+// usage is attributed to the step for LLM budgets. Templates cannot override it.
+func injectAgentRunPipelineStep(step Step, renderedParams map[string]any, pipelineName string) {
+	if step.Capability != hub.CapCore || step.Operation != capability.OpAgentRun {
+		return
+	}
+	if renderedParams == nil || pipelineName == "" {
+		return
+	}
+	renderedParams["pipeline_step"] = pipelineName + "/" + step.Name
+}
+
 // injectEventUID copies Event.UID into step params for agent_run and notify_send when unset.
 func injectEventUID(step Step, renderedParams map[string]any, rc *RenderContext) {
 	if !stepNeedsEventUID(step) {
@@ -568,8 +718,30 @@ func formatStepError(stepName string, err error, attempt int) error {
 	}
 }
 
+const (
+	// heartbeatInterval is how often a running run refreshes its heartbeat.
+	heartbeatInterval = 30 * time.Second
+	// staleRunAfter is how long a started run may go without a heartbeat
+	// before it is treated as abandoned by the process that ran it.
+	staleRunAfter = 3 * heartbeatInterval
+)
+
+// runStale reports whether no process has shown signs of executing run for
+// staleRunAfter. It uses the latest of the start time, the run heartbeat and
+// the checkpoint heartbeat, so cp may be nil.
+func runStale(run *model.PipelineRun, cp *CheckpointData, now time.Time) bool {
+	last := run.StartedAt
+	if run.LastHeartbeat != nil && run.LastHeartbeat.After(last) {
+		last = *run.LastHeartbeat
+	}
+	if cp != nil && cp.HeartbeatAt.After(last) {
+		last = cp.HeartbeatAt
+	}
+	return now.Sub(last) > staleRunAfter
+}
+
 func (e *Engine) heartbeatLoop(ctx context.Context, runID int64, pipelineName string) {
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(heartbeatInterval)
 	defer ticker.Stop()
 	for {
 		select {
@@ -625,7 +797,8 @@ func (e *Engine) updateStepRunRecord(ctx context.Context, stepRunID int64, statu
 	if result != nil {
 		resultJSON = convertToTypesKV(result)
 	}
-	if err := e.store.UpdateStepRun(ctx, stepRunID, status, resultJSON, errMsg, attempt); err != nil {
+	// Cancelled runs still record their final step state.
+	if err := e.store.UpdateStepRun(context.WithoutCancel(ctx), stepRunID, status, resultJSON, errMsg, attempt); err != nil {
 		flog.Error(fmt.Errorf("update step run %d: %w", stepRunID, err))
 	}
 }
@@ -643,7 +816,7 @@ func (e *Engine) finishRunRecord(ctx context.Context, runID int64, failed bool,
 			errMsg = finalErr.Error()
 		}
 	}
-	if err := e.store.UpdateRunStatus(ctx, runID, status, errMsg); err != nil {
+	if err := e.store.UpdateRunStatus(context.WithoutCancel(ctx), runID, status, errMsg); err != nil {
 		flog.Error(fmt.Errorf("update run %d status: %w", runID, err))
 	}
 }
@@ -706,8 +879,10 @@ func (e *Engine) checkDedupAndRecord(ctx context.Context, pipelineName, eventID,
 	return false, nil
 }
 
-func (e *Engine) saveCheckpointIfResumable(ctx context.Context, def Definition, event types.DataEvent, rc *RenderContext, stepIndex int, runID int64) {
-	if !def.Resumable || e.store == nil || runID == 0 {
+// saveRunCheckpoint persists the step boundary of resumable runs. Other runs
+// only record the trigger event before the first step so they can be re-run.
+func (e *Engine) saveRunCheckpoint(ctx context.Context, def Definition, event types.DataEvent, rc *RenderContext, stepIndex int, runID int64) {
+	if e.store == nil || runID == 0 || (!def.Resumable && stepIndex > 0) {
 		return
 	}
 	cp := &CheckpointData{
@@ -796,10 +971,18 @@ func (e *Engine) ResumePipeline(ctx context.Context, runID int64) error {
 		rc.RecordStepResult(name, sr.Output)
 	}
 
+	// A resumed child run keeps its depth so its own calls stay bounded.
+	ctx = runcall.WithDepth(ctx, run.CallDepth)
+	ctx, untrack := e.trackRun(ctx, runID)
+	defer untrack()
+
 	e.emitRunStart(ctx, runID, def)
 
 	startTime := e.clock.Now()
 	failed, finalErr := e.runResumeSteps(ctx, rc, def, runID, cp)
+	if errors.Is(finalErr, runapproval.ErrPending) {
+		return nil
+	}
 
 	e.finishRunRecord(ctx, runID, failed, finalErr)
 	e.emitRunComplete(ctx, runID, def, startTime, failed, finalErr)
@@ -809,6 +992,8 @@ func (e *Engine) ResumePipeline(ctx context.Context, runID int64) error {
 
 // findResumableDef returns the first resumable pipeline definition matching the given name.
 func (e *Engine) findResumableDef(name string) *Definition {
+	e.reloadMu.Lock()
+	defer e.reloadMu.Unlock()
 	for i := range e.defs {
 		if e.defs[i].Resumable && e.defs[i].Name == name {
 			return &e.defs[i]
@@ -822,16 +1007,20 @@ func (e *Engine) findResumableDef(name string) *Definition {
 func (e *Engine) runResumeSteps(ctx context.Context, rc *RenderContext, def *Definition, runID int64, cp *CheckpointData) (bool, error) {
 	for i := cp.StepIndex; i < len(def.Steps); i++ {
 		step := def.Steps[i]
-		if cpErr := e.store.SaveCheckpoint(ctx, runID, &CheckpointData{
+		next := &CheckpointData{
 			StepIndex:   i,
 			StepResults: buildStepResults(rc),
 			Event:       cp.Event,
 			HeartbeatAt: e.clock.Now(),
-		}); cpErr != nil {
+			// Keep the last gate so its decision applies when its step is
+			// resumed and its page still resolves after the run moves on.
+			Approval: cp.Approval,
+		}
+		if cpErr := e.store.SaveCheckpoint(ctx, runID, next); cpErr != nil {
 			flog.Error(fmt.Errorf("save checkpoint during resume run %d step %d: %w", runID, i, cpErr))
 		}
 
-		if err := e.executeStep(ctx, rc, step, runID, def.Name, i, true); err != nil {
+		if err := e.runStep(ctx, rc, step, runID, def.Name, i, true); err != nil {
 			return true, err
 		}
 	}
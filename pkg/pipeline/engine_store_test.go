@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/hub"
 	"github.com/flowline-io/flowbot/pkg/metrics"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 )
@@ -75,6 +78,18 @@ func (m *mockPipelineStore) UpdateRunStatus(_ context.Context, runID int64, stat
 	return nil
 }
 
+func (m *mockPipelineStore) SwapRunStatus(_ context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run, ok := m.runs[runID]
+	if !ok || !slices.Contains(from, run.Status) {
+		return false, nil
+	}
+	run.Status = to
+	run.Error = errMsg
+	return true, nil
+}
+
 func (m *mockPipelineStore) CreateStepRun(_ context.Context, _ int64, stepName, capName, operation string, params map[string]any, attempt int) (*model.PipelineStepRun, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -122,6 +137,29 @@ func (m *mockPipelineStore) GetIncompleteRuns(context.Context) ([]*model.Pipelin
 	return nil, nil
 }
 
+func (m *mockPipelineStore) GetWaitingRuns(context.Context) ([]*model.PipelineRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var waiting []*model.PipelineRun
+	for _, run := range m.runs {
+		if run.Status == int(types.PipelineWaiting) {
+			waiting = append(waiting, run)
+		}
+	}
+	return waiting, nil
+}
+
+func (m *mockPipelineStore) SetRunParent(_ context.Context, runID int64, parent runcall.Parent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if run, ok := m.runs[runID]; ok {
+		run.ParentKind = parent.Kind
+		run.ParentRunID = &parent.RunID
+		run.CallDepth = parent.Depth
+	}
+	return nil
+}
+
 func (m *mockPipelineStore) GetCheckpoint(_ context.Context, runID int64, target any) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -1020,3 +1058,139 @@ func TestCheckpointDataJSONRoundTrip(t *testing.T) {
 	require.NoError(t, sonic.Unmarshal(raw, &decoded))
 	assert.Equal(t, cp.StepIndex, decoded.StepIndex)
 }
+
+func stepRunsByName(store *mockPipelineStore) map[string]*model.PipelineStepRun {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	out := make(map[string]*model.PipelineStepRun, len(store.stepRuns))
+	for _, sr := range store.stepRuns {
+		out[sr.StepName] = sr
+	}
+	return out
+}
+
+func TestEngine_StepWhenSkipsStep(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	registerExampleInvoker(t, "when-echo", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		calls.Add(1)
+		return &capability.InvokeResult{Data: map[string]any{"value": params["value"]}}, nil
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "when-pl", Enabled: true, Trigger: Trigger{Event: "bookmark.created"},
+		Steps: []Step{
+			{Name: "tagged", Capability: hub.CapExample, Operation: "when-echo", When: `eq (event "tag") "read-later"`},
+			{Name: "untagged", Capability: hub.CapExample, Operation: "when-echo", When: `eq (event "tag") "archive"`},
+			{Name: "after_skip", Capability: hub.CapExample, Operation: "when-echo", When: `step "untagged" "skipped"`},
+		},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	event := types.DataEvent{EventID: "evt-when", EventType: "bookmark.created", Data: map[string]any{"tag": "read-later"}}
+	require.NoError(t, e.executePipeline(context.Background(), def, event, "event"))
+
+	assert.Equal(t, int32(2), calls.Load())
+	runs := stepRunsByName(store)
+	require.Len(t, runs, 3)
+	assert.Equal(t, int(types.PipelineDone), runs["tagged"].Status)
+	assert.Equal(t, int(types.PipelineSkipped), runs["untagged"].Status)
+	assert.Equal(t, int(types.PipelineDone), runs["after_skip"].Status)
+	for _, run := range store.runs {
+		assert.Equal(t, int(types.PipelineDone), run.Status)
+	}
+}
+
+func TestEngine_OnFailureCompensatesAndContinues(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "comp-fail", func(context.Context, map[string]any) (*capability.InvokeResult, error) {
+		return nil, errors.New("karakeep unavailable")
+	})
+	var notified atomic.Int32
+	var notifiedError atomic.Value
+	registerExampleInvoker(t, "comp-notify", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		notified.Add(1)
+		notifiedError.Store(params["error"])
+		return &capability.InvokeResult{Data: map[string]any{"sent": true}}, nil
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "comp-pl", Enabled: true, Trigger: Trigger{Event: "e1"},
+		Steps: []Step{
+			{
+				Name: "bookmark", Capability: hub.CapExample, Operation: "comp-fail",
+				OnFailure: []Step{{
+					Name: "notify_failure", Capability: hub.CapExample, Operation: "comp-notify",
+					Params: map[string]any{"error": `{{step "bookmark" "error"}}`},
+				}},
+			},
+			{Name: "after", Capability: hub.CapExample, Operation: "comp-notify", When: `not (step "bookmark" "compensated")`},
+		},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	require.NoError(t, e.executePipeline(context.Background(), def, types.DataEvent{EventID: "e1", EventType: "e1"}, "event"))
+
+	assert.Equal(t, int32(1), notified.Load())
+	assert.Contains(t, notifiedError.Load(), "karakeep unavailable")
+	runs := stepRunsByName(store)
+	assert.Equal(t, int(types.PipelineCompensated), runs["bookmark"].Status)
+	assert.Contains(t, runs["bookmark"].Error, "karakeep unavailable")
+	assert.Equal(t, int(types.PipelineDone), runs["notify_failure"].Status)
+	assert.Equal(t, int(types.PipelineSkipped), runs["after"].Status)
+	for _, run := range store.runs {
+		assert.Equal(t, int(types.PipelineDone), run.Status)
+	}
+}
+
+func TestEngine_OnFailureErrorFailsRun(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "comp2-fail", func(context.Context, map[string]any) (*capability.InvokeResult, error) {
+		return nil, errors.New("primary failed")
+	})
+	registerExampleInvoker(t, "comp2-notify-fail", func(context.Context, map[string]any) (*capability.InvokeResult, error) {
+		return nil, errors.New("notify failed")
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "comp2-pl", Enabled: true, Trigger: Trigger{Event: "e1"},
+		Steps: []Step{{
+			Name: "primary", Capability: hub.CapExample, Operation: "comp2-fail",
+			OnFailure: []Step{{Name: "notify", Capability: hub.CapExample, Operation: "comp2-notify-fail"}},
+		}},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	err := e.executePipeline(context.Background(), def, types.DataEvent{EventID: "e1", EventType: "e1"}, "event")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notify failed")
+	assert.Contains(t, err.Error(), "primary failed")
+	runs := stepRunsByName(store)
+	assert.Equal(t, int(types.PipelineFailed), runs["primary"].Status)
+	assert.Equal(t, int(types.PipelineFailed), runs["notify"].Status)
+	for _, run := range store.runs {
+		assert.Equal(t, int(types.PipelineFailed), run.Status)
+	}
+}
+
+func TestLoadConfig_WhenAndOnFailure(t *testing.T) {
+	t.Parallel()
+	defs := LoadConfig([]config.Pipeline{{
+		Name: "cfg-when", Enabled: true, Trigger: config.PipelineTrigger{Event: "e1"},
+		Steps: []config.PipelineStep{{
+			Name: "s1", Capability: "example", Operation: "op", When: `event "flag"`,
+			OnFailure: []config.PipelineStep{{Name: "undo", Capability: "core", Operation: "notify_send"}},
+		}},
+	}})
+	require.Len(t, defs, 1)
+	require.Len(t, defs[0].Steps, 1)
+	assert.Equal(t, `event "flag"`, defs[0].Steps[0].When)
+	require.Len(t, defs[0].Steps[0].OnFailure, 1)
+	assert.Equal(t, hub.CapCore, defs[0].Steps[0].OnFailure[0].Capability)
+}
@@ -0,0 +1,169 @@
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// TriggerFilter narrows an event trigger to matching DataEvents. Every set
+// predicate must match; an empty filter matches every event.
+//
+// App and Source match when the event value is one of the listed values.
+// Tags and Data map a key (a dotted path for Data) to an expected value:
+// a null value only requires the key to be present, a list matches any of
+// its elements, and when the event value is itself a list it matches if the
+// list contains the expected value. Values are compared by their string form,
+// so 3 and 3.0 are equal.
+type TriggerFilter struct {
+	App    []string       `json:"app,omitempty" yaml:"app,omitempty"`
+	Source []string       `json:"source,omitempty" yaml:"source,omitempty"`
+	Tags   map[string]any `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Data   map[string]any `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+// IsEmpty reports whether the filter has no predicates.
+func (f *TriggerFilter) IsEmpty() bool {
+	return f == nil || (len(f.App) == 0 && len(f.Source) == 0 && len(f.Tags) == 0 && len(f.Data) == 0)
+}
+
+// Match reports whether event satisfies every predicate of the filter.
+func (f *TriggerFilter) Match(event types.DataEvent) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if len(f.App) > 0 && !slices.Contains(f.App, event.App) {
+		return false
+	}
+	if len(f.Source) > 0 && !slices.Contains(f.Source, event.Source) {
+		return false
+	}
+	for key, want := range f.Tags {
+		got, ok := event.Tags[key]
+		if !ok || !filterValueMatches(got, want) {
+			return false
+		}
+	}
+	for path, want := range f.Data {
+		got, ok := lookupDataPath(event.Data, path)
+		if !ok || !filterValueMatches(got, want) {
+			return false
+		}
+	}
+	return true
+}
+
+// String renders the filter as sorted "key=value" predicates for list views.
+func (f *TriggerFilter) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+	var parts []string
+	if len(f.App) > 0 {
+		parts = append(parts, "app="+strings.Join(f.App, "|"))
+	}
+	if len(f.Source) > 0 {
+		parts = append(parts, "source="+strings.Join(f.Source, "|"))
+	}
+	for _, kv := range []struct {
+		prefix string
+		m      map[string]any
+	}{{"tags.", f.Tags}, {"data.", f.Data}} {
+		keys := make([]string, 0, len(kv.m))
+		for k := range kv.m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if kv.m[k] == nil {
+				parts = append(parts, kv.prefix+k)
+				continue
+			}
+			parts = append(parts, kv.prefix+k+"="+filterValueString(kv.m[k]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func lookupDataPath(data types.KV, path string) (any, bool) {
+	var cur any = map[string]any(data)
+	for p := range strings.SplitSeq(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		cur, ok = m[p]
+		if !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func filterValueMatches(got, want any) bool {
+	if want == nil {
+		return true
+	}
+	if wants, ok := asList(want); ok {
+		for _, w := range wants {
+			if filterValueMatches(got, w) {
+				return true
+			}
+		}
+		return false
+	}
+	if gots, ok := asList(got); ok {
+		for _, g := range gots {
+			if fmt.Sprint(g) == fmt.Sprint(want) {
+				return true
+			}
+		}
+		return false
+	}
+	return fmt.Sprint(got) == fmt.Sprint(want)
+}
+
+func filterValueString(v any) string {
+	if list, ok := asList(v); ok {
+		parts := make([]string, len(list))
+		for i, item := range list {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, "|")
+	}
+	return fmt.Sprint(v)
+}
+
+func asList(v any) ([]any, bool) {
+	if v == nil {
+		return nil, false
+	}
+	if list, ok := v.([]any); ok {
+		return list, true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	list := make([]any, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list, true
+}
+
+func convertTriggerFilter(cfg *config.PipelineTriggerFilter) *TriggerFilter {
+	if cfg == nil {
+		return nil
+	}
+	f := &TriggerFilter{App: cfg.App, Source: cfg.Source, Tags: cfg.Tags, Data: cfg.Data}
+	if f.IsEmpty() {
+		return nil
+	}
+	return f
+}
@@ -0,0 +1,147 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestTriggerFilter_Match(t *testing.T) {
+	t.Parallel()
+	event := types.DataEvent{
+		EventType: "bookmark.created",
+		App:       "karakeep",
+		Source:    "ability",
+		Tags:      types.KV{"project": "alpha"},
+		Data: types.KV{
+			"tags":   []any{"read-later", "go"},
+			"status": "open",
+			"count":  3.0,
+			"meta":   map[string]any{"lang": "en"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter *TriggerFilter
+		want   bool
+	}{
+		{name: "nil filter matches", filter: nil, want: true},
+		{name: "empty filter matches", filter: &TriggerFilter{}, want: true},
+		{name: "app in list", filter: &TriggerFilter{App: []string{"miniflux", "karakeep"}}, want: true},
+		{name: "app not in list", filter: &TriggerFilter{App: []string{"miniflux"}}, want: false},
+		{name: "source mismatch", filter: &TriggerFilter{Source: []string{"webhook"}}, want: false},
+		{name: "tag value", filter: &TriggerFilter{Tags: map[string]any{"project": "alpha"}}, want: true},
+		{name: "tag presence", filter: &TriggerFilter{Tags: map[string]any{"project": nil}}, want: true},
+		{name: "missing tag", filter: &TriggerFilter{Tags: map[string]any{"owner": nil}}, want: false},
+		{name: "data list contains value", filter: &TriggerFilter{Data: map[string]any{"tags": "read-later"}}, want: true},
+		{name: "data list lacks value", filter: &TriggerFilter{Data: map[string]any{"tags": "archive"}}, want: false},
+		{name: "data any of", filter: &TriggerFilter{Data: map[string]any{"status": []any{"closed", "open"}}}, want: true},
+		{name: "data number compares by value", filter: &TriggerFilter{Data: map[string]any{"count": 3}}, want: true},
+		{name: "data dotted path", filter: &TriggerFilter{Data: map[string]any{"meta.lang": "en"}}, want: true},
+		{name: "data missing path", filter: &TriggerFilter{Data: map[string]any{"meta.author": nil}}, want: false},
+		{
+			name: "all predicates must match",
+			filter: &TriggerFilter{
+				App:  []string{"karakeep"},
+				Data: map[string]any{"tags": "read-later", "status": "closed"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.filter.Match(event))
+		})
+	}
+}
+
+func TestTriggerFilter_String(t *testing.T) {
+	t.Parallel()
+	f := &TriggerFilter{
+		App:  []string{"karakeep"},
+		Tags: map[string]any{"project": nil},
+		Data: map[string]any{"tags": "read-later", "status": []any{"open", "new"}},
+	}
+	assert.Equal(t, "app=karakeep tags.project data.status=open|new data.tags=read-later", f.String())
+	assert.Empty(t, (*TriggerFilter)(nil).String())
+}
+
+func TestLoadConfig_TriggerFilter(t *testing.T) {
+	t.Parallel()
+	filter := &config.PipelineTriggerFilter{Data: map[string]any{"tags": "read-later"}}
+	defs := LoadConfig([]config.Pipeline{
+		{Name: "filtered", Enabled: true, Trigger: config.PipelineTrigger{Event: "bookmark.created", Filter: filter}},
+		{Name: "cron-filtered", Enabled: true, Trigger: config.PipelineTrigger{Cron: "@daily", Filter: filter}},
+	})
+	require.Len(t, defs, 1)
+	assert.Equal(t, "filtered", defs[0].Name)
+	require.NotNil(t, defs[0].Trigger.Filter)
+	assert.Equal(t, map[string]any{"tags": "read-later"}, defs[0].Trigger.Filter.Data)
+}
+
+func TestExpandDefinitions_TriggerFilter(t *testing.T) {
+	t.Parallel()
+	ed, err := ParseEditorYAML(`name: read_later
+enabled: true
+triggers:
+  - type: event
+    enabled: true
+    event: bookmark.created
+    filter:
+      app: [karakeep]
+      data:
+        tags: read-later
+  - type: cron
+    enabled: true
+    cron: "@daily"
+    filter:
+      app: [karakeep]
+`)
+	require.NoError(t, err)
+	defs := ExpandDefinitions([]EditorDefinition{*ed})
+	require.Len(t, defs, 2)
+	require.NotNil(t, defs[0].Trigger.Filter)
+	assert.Equal(t, []string{"karakeep"}, defs[0].Trigger.Filter.App)
+	assert.Nil(t, defs[1].Trigger.Filter)
+	assert.Equal(t, []string{"event:bookmark.created [app=karakeep data.tags=read-later]", "cron:@daily"}, triggerSummaries(ed.Triggers))
+}
+
+func TestHandleEvent_FilterSkipsBeforeDedupAndRun(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "filter-echo", func(context.Context, map[string]any) (*capability.InvokeResult, error) {
+		return &capability.InvokeResult{Data: map[string]any{"ok": true}}, nil
+	})
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "filter-pl", Enabled: true,
+		Trigger: Trigger{Event: "bookmark.created", Filter: &TriggerFilter{Data: map[string]any{"tags": "read-later"}}},
+		Steps:   []Step{{Name: "s1", Capability: hub.CapExample, Operation: "filter-echo"}},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	skipped := types.DataEvent{EventID: "evt-skip", EventType: "bookmark.created", Data: types.KV{"tags": []any{"go"}}}
+	require.NoError(t, e.Handler()(context.Background(), skipped))
+	store.mu.Lock()
+	assert.Empty(t, store.runs)
+	assert.Empty(t, store.consumed)
+	store.mu.Unlock()
+
+	matched := types.DataEvent{EventID: "evt-match", EventType: "bookmark.created", Data: types.KV{"tags": []any{"read-later"}}}
+	require.NoError(t, e.Handler()(context.Background(), matched))
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	require.Len(t, store.runs, 1)
+	for _, run := range store.runs {
+		assert.Equal(t, "evt-match", run.EventID)
+	}
+}
@@ -0,0 +1,284 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/pkg/bulkhead"
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/trace"
+	"github.com/flowline-io/flowbot/pkg/types"
+
+	otelattr "go.opentelemetry.io/otel/attribute"
+)
+
+// EvalList resolves a foreach items expression to a list.
+//
+// A plain path (steps.<name>[.<field>...], event.<field>, input.<field>) is
+// looked up directly so typed values survive; a path that ends at a map with
+// an items key, such as a list capability result, yields that list. Any other
+// expression is rendered as a template and must produce a JSON array.
+func (rc *RenderContext) EvalList(expr string) ([]any, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("empty foreach items")
+	}
+	if !strings.Contains(expr, "{{") {
+		v, err := rc.lookupPath(expr)
+		if err != nil {
+			return nil, err
+		}
+		return toList(v)
+	}
+	out, err := rc.RenderString(expr)
+	if err != nil {
+		return nil, err
+	}
+	return toList(strings.TrimSpace(out))
+}
+
+func (rc *RenderContext) lookupPath(path string) (any, error) {
+	parts := strings.Split(path, ".")
+	data := rc.templateData()
+	var cur any
+	switch parts[0] {
+	case "steps":
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("foreach items %q: missing step name", path)
+		}
+		step, ok := data.Steps[parts[1]]
+		if !ok {
+			return nil, fmt.Errorf("foreach items %q: step %s has no result", path, parts[1])
+		}
+		cur = step
+		parts = parts[2:]
+	case "event":
+		cur = data.Event
+		parts = parts[1:]
+	case "input":
+		cur = data.Input
+		parts = parts[1:]
+	default:
+		return nil, fmt.Errorf("foreach items %q: path must start with steps, event or input", path)
+	}
+	for _, p := range parts {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("foreach items %q: %s is not an object", path, p)
+		}
+		cur = m[p]
+	}
+	return cur, nil
+}
+
+// toList converts a looked-up or rendered value into a list of items.
+func toList(v any) ([]any, error) {
+	switch val := v.(type) {
+	case nil:
+		return []any{}, nil
+	case []any:
+		return val, nil
+	case map[string]any:
+		if items, ok := val["items"]; ok {
+			return toList(items)
+		}
+		return nil, fmt.Errorf("foreach items: object has no items list")
+	case string:
+		if val == "" {
+			return []any{}, nil
+		}
+		var out any
+		if err := sonic.UnmarshalString(val, &out); err != nil {
+			return nil, fmt.Errorf("foreach items: rendered value is not a JSON array: %w", err)
+		}
+		if list, ok := out.([]any); ok {
+			return list, nil
+		}
+		return nil, fmt.Errorf("foreach items: rendered value is not a JSON array")
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		list := make([]any, rv.Len())
+		for i := range list {
+			list[i] = rv.Index(i).Interface()
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("foreach items: %T is not a list", v)
+}
+
+// foreachItemName names the step run of one foreach element, e.g. "bookmark[2]".
+func foreachItemName(stepName string, index int) string {
+	return fmt.Sprintf("%s[%d]", stepName, index)
+}
+
+// foreachConcurrency clamps the configured concurrency to [1, items] and to
+// the capability bulkhead so a fan-out never queues past its own slots.
+func foreachConcurrency(step Step, items int) int {
+	n := step.Foreach.Concurrency
+	if n <= 0 {
+		n = 1
+	}
+	if limit := bulkhead.Get(string(step.Capability)).MaxConcurrent(); limit > 0 && n > limit {
+		n = limit
+	}
+	if n > items {
+		n = items
+	}
+	return max(n, 1)
+}
+
+// executeForeach runs step once per element of step.Foreach.Items. Each
+// element gets its own step run named "<step>[i]"; the parent step run and the
+// render context receive the aggregate {items, count, succeeded, failed, errors}.
+// Unless continue_on_error is set, the first failure stops dispatching further
+// elements and fails the step.
+func (e *Engine) executeForeach(ctx context.Context, rc *RenderContext, step Step, runID int64, pipelineName string, stepIndex int, resumable bool) error {
+	ctx, span := trace.StartSpan(ctx, "pipeline."+pipelineName+".step."+step.Name,
+		otelattr.String("pipeline.step.name", step.Name),
+		otelattr.String("pipeline.step.capability", string(step.Capability)),
+		otelattr.String("pipeline.step.operation", step.Operation),
+	)
+	defer span.End()
+
+	stepStart := time.Now()
+
+	items, err := rc.EvalList(step.Foreach.Items)
+	if err != nil {
+		return fmt.Errorf("step %s: %w", step.Name, err)
+	}
+
+	stepRunID, err := e.createStepRunRecord(ctx, runID, step.Name, string(step.Capability), step.Operation,
+		map[string]any{"foreach": step.Foreach.Items, "count": len(items)}, 1)
+	if err != nil {
+		return err
+	}
+
+	if resumable && e.store != nil && runID != 0 {
+		hbCtx, hbCancel := context.WithCancel(ctx)
+		defer hbCancel()
+		go e.heartbeatLoop(hbCtx, runID, pipelineName)
+	}
+
+	if e.callback != nil {
+		e.callback.OnStepStart(ctx, runID, pipelineName, stepIndex, step.Name, map[string]any{"count": len(items)})
+	}
+	if e.pipelineMetrics != nil {
+		e.pipelineMetrics.IncStepTotal(pipelineName, step.Name, "start")
+	}
+
+	results := make([]any, len(items))
+	itemErrs := make([]error, len(items))
+	var stopped bool
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, foreachConcurrency(step, len(items)))
+
+	for i, item := range items {
+		sem <- struct{}{}
+		mu.Lock()
+		stop := stopped
+		mu.Unlock()
+		if stop || ctx.Err() != nil {
+			<-sem
+			break
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			res, err := e.runForeachItem(ctx, rc.withItem(item), step, i, runID, pipelineName)
+			results[i] = res
+			itemErrs[i] = err
+			if err != nil && !step.Foreach.ContinueOnError {
+				mu.Lock()
+				stopped = true
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	succeeded := 0
+	errList := []any{}
+	var firstErr error
+	for i, err := range itemErrs {
+		if err == nil {
+			if results[i] != nil {
+				succeeded++
+			}
+			continue
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		errList = append(errList, map[string]any{"index": i, "error": err.Error()})
+	}
+	failed := len(errList)
+	aggregate := map[string]any{
+		"items":     results,
+		"count":     len(items),
+		"succeeded": succeeded,
+		"failed":    failed,
+		"errors":    errList,
+	}
+
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil && (!step.Foreach.ContinueOnError || ctx.Err() != nil) {
+		stepErr := fmt.Errorf("step %s: %d of %d items failed: %w", step.Name, failed, len(items), firstErr)
+		status, metricStatus := terminalStatusFromError(firstErr)
+		e.updateStepRunRecord(ctx, stepRunID, int(status), aggregate, stepErr.Error(), 1)
+		e.recordStepMetrics(pipelineName, step.Name, string(step.Capability), metricStatus, time.Since(stepStart).Seconds(), 1)
+		if e.callback != nil {
+			e.callback.OnStepError(ctx, runID, pipelineName, stepIndex, step.Name, stepErr, time.Since(stepStart).Milliseconds())
+		}
+		return &stepFailure{stepRunID: stepRunID, attempt: 1, err: stepErr}
+	}
+
+	rc.RecordStepResult(step.Name, aggregate)
+	e.recordStepSuccess(ctx, stepRunID, pipelineName, step.Name, string(step.Capability), aggregate, 1, stepStart)
+	if e.callback != nil {
+		e.callback.OnStepDone(ctx, runID, pipelineName, stepIndex, step.Name, aggregate, time.Since(stepStart).Milliseconds())
+	}
+	flog.Info("pipeline %s step %s completed foreach: %d succeeded, %d failed", pipelineName, step.Name, succeeded, failed)
+	return nil
+}
+
+// runForeachItem renders and invokes one foreach element with the step's
+// retry policy. The element's step run is persisted under "<step>[i]"; metrics
+// are recorded once for the parent step to keep label cardinality bounded.
+func (e *Engine) runForeachItem(ctx context.Context, rc *RenderContext, step Step, index int, runID int64, pipelineName string) (map[string]any, error) {
+	itemName := foreachItemName(step.Name, index)
+
+	renderedParams, err := rc.RenderParams(step.Params)
+	if err != nil {
+		return nil, fmt.Errorf("render params step %s: %w", itemName, err)
+	}
+	InjectAgentRunDefaults(step, renderedParams, rc, pipelineName)
+	if capability.IsMutation(step.Operation) && len(rc.Event.Tags) > 0 {
+		injectTags(rc, renderedParams)
+	}
+
+	stepRunID, err := e.createStepRunRecord(ctx, runID, itemName, string(step.Capability), step.Operation, renderedParams, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	result, resource, attempt, err := invokeWithRetry(ctx, step, itemName, renderedParams, pipelineName)
+	if err != nil {
+		status, _ := terminalStatusFromError(err)
+		e.updateStepRunRecord(ctx, stepRunID, int(status), nil, err.Error(), attempt)
+		return nil, formatStepError(itemName, err, attempt)
+	}
+
+	e.saveResourceLink(ctx, rc, step, resource, runID, pipelineName)
+	e.updateStepRunRecord(ctx, stepRunID, int(types.PipelineDone), result, "", attempt)
+	return result, nil
+}
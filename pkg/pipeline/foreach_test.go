@@ -0,0 +1,219 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/config"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestRenderContext_EvalList(t *testing.T) {
+	t.Parallel()
+	rc := NewRenderContext(types.DataEvent{EventType: "feed.entry", Data: types.KV{"urls": []any{"a", "b"}}})
+	rc.RecordStepResult("fetch", map[string]any{
+		"items": []any{map[string]any{"id": 1.0}, map[string]any{"id": 2.0}},
+		"meta":  map[string]any{"tags": []string{"x", "y", "z"}},
+	})
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    []any
+		wantErr string
+	}{
+		{name: "step list result path", expr: "steps.fetch.items", want: []any{map[string]any{"id": 1.0}, map[string]any{"id": 2.0}}},
+		{name: "step result with items key", expr: "steps.fetch", want: []any{map[string]any{"id": 1.0}, map[string]any{"id": 2.0}}},
+		{name: "nested typed slice", expr: "steps.fetch.meta.tags", want: []any{"x", "y", "z"}},
+		{name: "event field", expr: "event.urls", want: []any{"a", "b"}},
+		{name: "missing field is empty", expr: "event.missing", want: []any{}},
+		{name: "template rendering a JSON array", expr: `{{json (step "fetch" "items")}}`, want: []any{map[string]any{"id": 1.0}, map[string]any{"id": 2.0}}},
+		{name: "unknown step", expr: "steps.nope.items", wantErr: "step nope has no result"},
+		{name: "unknown root", expr: "foo.bar", wantErr: "must start with steps, event or input"},
+		{name: "template rendering a scalar", expr: `{{event "id"}}x`, wantErr: "not a JSON array"},
+		{name: "scalar path", expr: "event.event_type", wantErr: "not a JSON array"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := rc.EvalList(tt.expr)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestForeachConcurrency(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		concurrency int
+		items       int
+		want        int
+	}{
+		{name: "unset runs sequentially", concurrency: 0, items: 5, want: 1},
+		{name: "capped by item count", concurrency: 8, items: 3, want: 3},
+		{name: "configured value", concurrency: 2, items: 5, want: 2},
+		{name: "empty list still yields one slot", concurrency: 4, items: 0, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			step := Step{Capability: hub.CapExample, Foreach: &Foreach{Concurrency: tt.concurrency}}
+			assert.Equal(t, tt.want, foreachConcurrency(step, tt.items))
+		})
+	}
+}
+
+func TestEngine_ForeachRunsPerItemAndAggregates(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "fe-list", func(context.Context, map[string]any) (*capability.InvokeResult, error) {
+		return &capability.InvokeResult{Data: []any{
+			map[string]any{"url": "https://a.example"},
+			map[string]any{"url": "https://b.example"},
+			map[string]any{"url": "https://c.example"},
+		}}, nil
+	})
+	var inFlight, peak atomic.Int32
+	registerExampleInvoker(t, "fe-bookmark", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return &capability.InvokeResult{Data: map[string]any{"saved": params["url"]}}, nil
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "fe-pl", Enabled: true, Trigger: Trigger{Event: "e1"},
+		Steps: []Step{
+			{Name: "entries", Capability: hub.CapExample, Operation: "fe-list"},
+			{
+				Name: "bookmark", Capability: hub.CapExample, Operation: "fe-bookmark",
+				Params:  map[string]any{"url": "{{item.url}}"},
+				Foreach: &Foreach{Items: "steps.entries.items", Concurrency: 2},
+			},
+			{Name: "summary", Capability: hub.CapExample, Operation: "fe-bookmark", Params: map[string]any{"url": `{{step "bookmark" "succeeded"}}`}},
+		},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	require.NoError(t, e.executePipeline(context.Background(), def, types.DataEvent{EventID: "e1", EventType: "e1"}, "event"))
+
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+	runs := stepRunsByName(store)
+	for _, name := range []string{"bookmark[0]", "bookmark[1]", "bookmark[2]"} {
+		require.Contains(t, runs, name)
+		assert.Equal(t, int(types.PipelineDone), runs[name].Status)
+	}
+	assert.Equal(t, "https://b.example", runs["bookmark[1]"].Params["url"])
+	require.Contains(t, runs, "bookmark")
+	assert.Equal(t, int(types.PipelineDone), runs["bookmark"].Status)
+	assert.EqualValues(t, 3, runs["bookmark"].Result["succeeded"])
+	assert.Equal(t, "3", runs["summary"].Params["url"])
+}
+
+func TestEngine_ForeachFailureStopsAndFailsStep(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	registerExampleInvoker(t, "fe-fail", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		calls.Add(1)
+		if params["n"] == "1" {
+			return nil, errors.New("item rejected")
+		}
+		return &capability.InvokeResult{Data: map[string]any{"ok": true}}, nil
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "fe-fail-pl", Enabled: true, Trigger: Trigger{Event: "e1"},
+		Steps: []Step{{
+			Name: "each", Capability: hub.CapExample, Operation: "fe-fail",
+			Params:  map[string]any{"n": "{{item}}"},
+			Foreach: &Foreach{Items: "event.ids"},
+		}},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	event := types.DataEvent{EventID: "e1", EventType: "e1", Data: types.KV{"ids": []any{"0", "1", "2", "3"}}}
+	err := e.executePipeline(context.Background(), def, event, "event")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 4 items failed")
+	assert.Contains(t, err.Error(), "item rejected")
+
+	assert.Equal(t, int32(2), calls.Load())
+	runs := stepRunsByName(store)
+	assert.Equal(t, int(types.PipelineFailed), runs["each"].Status)
+	assert.Equal(t, int(types.PipelineFailed), runs["each[1]"].Status)
+	assert.NotContains(t, runs, "each[2]")
+}
+
+func TestEngine_ForeachContinueOnError(t *testing.T) {
+	t.Parallel()
+	registerExampleInvoker(t, "fe-cont", func(_ context.Context, params map[string]any) (*capability.InvokeResult, error) {
+		if params["n"] == "b" {
+			return nil, errors.New("bad item")
+		}
+		return &capability.InvokeResult{Data: map[string]any{"n": params["n"]}}, nil
+	})
+
+	store := newMockPipelineStore()
+	def := Definition{
+		Name: "fe-cont-pl", Enabled: true, Trigger: Trigger{Event: "e1"},
+		Steps: []Step{{
+			Name: "each", Capability: hub.CapExample, Operation: "fe-cont",
+			Params:  map[string]any{"n": "{{item}}"},
+			Foreach: &Foreach{Items: `{{json (event "ids")}}`, Concurrency: 3, ContinueOnError: true},
+		}},
+	}
+	e := NewEngine([]Definition{def}, store, nil, noopPC, noopEC)
+	defer e.Stop()
+
+	event := types.DataEvent{EventID: "e1", EventType: "e1", Data: types.KV{"ids": []any{"a", "b", "c"}}}
+	require.NoError(t, e.executePipeline(context.Background(), def, event, "event"))
+
+	runs := stepRunsByName(store)
+	assert.Equal(t, int(types.PipelineDone), runs["each"].Status)
+	assert.EqualValues(t, 2, runs["each"].Result["succeeded"])
+	assert.EqualValues(t, 1, runs["each"].Result["failed"])
+	assert.Equal(t, int(types.PipelineFailed), runs["each[1]"].Status)
+	assert.Equal(t, int(types.PipelineDone), runs["each[2]"].Status)
+}
+
+func TestLoadConfig_Foreach(t *testing.T) {
+	t.Parallel()
+	defs := LoadConfig([]config.Pipeline{{
+		Name: "cfg-foreach", Enabled: true, Trigger: config.PipelineTrigger{Event: "e1"},
+		Steps: []config.PipelineStep{
+			{
+				Name: "each", Capability: "example", Operation: "op",
+				Foreach: &config.PipelineStepForeach{Items: "steps.list.items", Concurrency: 3, ContinueOnError: true},
+			},
+			{Name: "blank", Capability: "example", Operation: "op", Foreach: &config.PipelineStepForeach{Items: " "}},
+		},
+	}})
+	require.Len(t, defs, 1)
+	require.Len(t, defs[0].Steps, 2)
+	assert.Equal(t, &Foreach{Items: "steps.list.items", Concurrency: 3, ContinueOnError: true}, defs[0].Steps[0].Foreach)
+	assert.Nil(t, defs[0].Steps[1].Foreach)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/config"
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
 )
 
 type Definition struct {
@@ -33,6 +34,8 @@ type Trigger struct {
 	Cron        string
 	CronTimeout time.Duration
 	Webhook     *WebhookConfig
+	// Filter narrows an event trigger; nil matches every event of the type.
+	Filter *TriggerFilter
 }
 
 type WebhookConfig struct {
@@ -56,6 +59,24 @@ type Step struct {
 	Operation  string
 	Params     map[string]any
 	Retry      *backoff.Config
+	// When is a template condition evaluated against the RenderContext; falsy skips the step.
+	When string `json:"when,omitempty" yaml:"when,omitempty"`
+	// OnFailure lists compensating steps run when this step fails.
+	OnFailure []Step `json:"on_failure,omitempty" yaml:"on_failure,omitempty"`
+	// Foreach runs the step once per element of a list; nil runs it once.
+	Foreach *Foreach `json:"foreach,omitempty" yaml:"foreach,omitempty"`
+	// Approval pauses the run until a person approves or rejects; it replaces capability/operation.
+	Approval *runapproval.Config `json:"approval,omitempty" yaml:"approval,omitempty"`
+	// Call starts a child workflow or pipeline run; it replaces capability/operation.
+	Call *Call `json:"call,omitempty" yaml:"call,omitempty"`
+}
+
+// Foreach fans a step out over a list. Items is a path such as
+// steps.fetch.items or event.urls, or a template rendering a JSON array.
+type Foreach struct {
+	Items           string `json:"items" yaml:"items"`
+	Concurrency     int    `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+	ContinueOnError bool   `json:"continue_on_error,omitempty" yaml:"continue_on_error,omitempty"`
 }
 
 func LoadConfig(cfg []config.Pipeline) []Definition {
@@ -82,25 +103,77 @@ func LoadConfig(cfg []config.Pipeline) []Definition {
 			Resumable:   p.Resumable,
 			Trigger:     trigger,
 		}
-		for _, s := range p.Steps {
-			retry, err := convertRetryConfig(s.Retry)
-			if err != nil {
-				flog.Error(fmt.Errorf("pipeline %s step %s: invalid retry config: %w", p.Name, s.Name, err))
-				continue
-			}
-			d.Steps = append(d.Steps, Step{
-				Name:       s.Name,
-				Capability: hub.CapabilityType(s.Capability),
-				Operation:  s.Operation,
-				Params:     s.Params,
-				Retry:      retry,
-			})
+		d.Steps = convertSteps(p.Name, p.Steps)
+		if err := ValidateApprovalSteps(d.Steps, d.Resumable); err != nil {
+			flog.Error(fmt.Errorf("pipeline %s: %w", p.Name, err))
+			continue
 		}
 		defs = append(defs, d)
 	}
 	return defs
 }
 
+// convertSteps maps config steps (and their on_failure steps) to engine steps.
+// Steps with an invalid retry config are logged and dropped.
+func convertSteps(pipelineName string, cfg []config.PipelineStep) []Step {
+	var steps []Step
+	for _, s := range cfg {
+		retry, err := convertRetryConfig(s.Retry)
+		if err != nil {
+			flog.Error(fmt.Errorf("pipeline %s step %s: invalid retry config: %w", pipelineName, s.Name, err))
+			continue
+		}
+		steps = append(steps, Step{
+			Name:       s.Name,
+			Capability: hub.CapabilityType(s.Capability),
+			Operation:  s.Operation,
+			Params:     s.Params,
+			Retry:      retry,
+			When:       s.When,
+			OnFailure:  convertSteps(pipelineName, s.OnFailure),
+			Foreach:    convertForeach(s.Foreach),
+			Approval:   convertApproval(s.Approval),
+			Call:       convertCall(s.Call),
+		})
+	}
+	return steps
+}
+
+func convertForeach(cfg *config.PipelineStepForeach) *Foreach {
+	if cfg == nil || strings.TrimSpace(cfg.Items) == "" {
+		return nil
+	}
+	return &Foreach{
+		Items:           cfg.Items,
+		Concurrency:     cfg.Concurrency,
+		ContinueOnError: cfg.ContinueOnError,
+	}
+}
+
+func convertApproval(cfg *config.PipelineStepApproval) *runapproval.Config {
+	if cfg == nil {
+		return nil
+	}
+	return &runapproval.Config{
+		Message:  cfg.Message,
+		Timeout:  cfg.Timeout,
+		Default:  cfg.Default,
+		Channels: cfg.Channels,
+		UID:      cfg.UID,
+	}
+}
+
+func convertCall(cfg *config.PipelineStepCall) *Call {
+	if cfg == nil {
+		return nil
+	}
+	return &Call{
+		Workflow: cfg.Workflow,
+		Pipeline: cfg.Pipeline,
+		Wait:     cfg.Wait,
+	}
+}
+
 func convertRetryConfig(cfg *config.PipelineStepRetry) (*backoff.Config, error) {
 	if cfg == nil || cfg.MaxAttempts <= 0 {
 		return nil, nil
@@ -146,7 +219,7 @@ func FindByEvent(defs []Definition, eventType string) []Definition {
 }
 
 func convertTrigger(name string, cfg config.PipelineTrigger) (Trigger, error) {
-	t := Trigger{Event: cfg.Event, Cron: cfg.Cron}
+	t := Trigger{Event: cfg.Event, Cron: cfg.Cron, Filter: convertTriggerFilter(cfg.Filter)}
 
 	if cfg.CronTimeout != "" {
 		d, err := time.ParseDuration(cfg.CronTimeout)
@@ -162,6 +235,9 @@ func convertTrigger(name string, cfg config.PipelineTrigger) (Trigger, error) {
 	if cfg.Webhook != nil && (cfg.Event != "" || cfg.Cron != "") {
 		return t, fmt.Errorf("pipeline %s: webhook trigger cannot be combined with event or cron", name)
 	}
+	if t.Filter != nil && cfg.Event == "" {
+		return t, fmt.Errorf("pipeline %s: trigger filter requires an event trigger", name)
+	}
 
 	wh, err := convertWebhookTrigger(name, cfg.Webhook)
 	if err != nil {
@@ -301,6 +377,9 @@ func (t TriggerEntry) toEngineTrigger() Trigger {
 	switch t.Type {
 	case "event":
 		tr.Event = t.Event
+		if !t.Filter.IsEmpty() {
+			tr.Filter = t.Filter
+		}
 	case "cron":
 		tr.Cron = t.Cron
 		if t.CronTimeout != "" {
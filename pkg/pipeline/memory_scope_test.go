@@ -59,6 +59,55 @@ func TestInjectAgentRunMemoryScope(t *testing.T) {
 	}
 }
 
+func TestInjectAgentRunPipelineStep(t *testing.T) {
+	tests := []struct {
+		name         string
+		step         Step
+		existing     map[string]any
+		pipelineName string
+		want         string
+		wantSet      bool
+	}{
+		{
+			name:         "injects pipeline and step name",
+			step:         Step{Name: "summarize", Capability: hub.CapCore, Operation: capability.OpAgentRun},
+			existing:     map[string]any{"prompt": "hi"},
+			pipelineName: "daily-digest",
+			want:         "daily-digest/summarize",
+			wantSet:      true,
+		},
+		{
+			name:         "overrides rendered value",
+			step:         Step{Name: "summarize", Capability: hub.CapCore, Operation: capability.OpAgentRun},
+			existing:     map[string]any{"pipeline_step": "other/step"},
+			pipelineName: "daily-digest",
+			want:         "daily-digest/summarize",
+			wantSet:      true,
+		},
+		{
+			name:         "skips non agent step",
+			step:         Step{Name: "list", Capability: hub.CapKarakeep, Operation: capability.OpBookmarkList},
+			existing:     map[string]any{},
+			pipelineName: "daily-digest",
+			wantSet:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]any{}
+			maps.Copy(params, tt.existing)
+			injectAgentRunPipelineStep(tt.step, params, tt.pipelineName)
+			raw, ok := params["pipeline_step"]
+			if !tt.wantSet {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, raw)
+		})
+	}
+}
+
 func TestInjectEventUID(t *testing.T) {
 	tests := []struct {
 		name     string
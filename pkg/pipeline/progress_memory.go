@@ -0,0 +1,168 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+)
+
+// LiveProgress is the in-process progress feed used for live run watches when
+// redis.backend is "memory". It is nil when progress goes to Redis Streams.
+var LiveProgress *MemoryProgress
+
+// MemoryProgress is a StepCallback that keeps each run's progress events in
+// process, in publish order. Like the Redis stream it replaces, a watcher
+// replays a run from its first event and then blocks for new ones; a run's
+// events are dropped StreamTTLDrain after it completes.
+type MemoryProgress struct {
+	mu       sync.Mutex
+	runs     map[int64]*memoryRun
+	failsafe time.Duration
+	drain    time.Duration
+}
+
+type memoryRun struct {
+	events []string
+	// changed is closed and replaced on every append to wake blocked readers.
+	changed chan struct{}
+	expiry  *time.Timer
+}
+
+// NewMemoryProgress creates an empty in-process progress feed.
+func NewMemoryProgress() *MemoryProgress {
+	return &MemoryProgress{
+		runs:     make(map[int64]*memoryRun),
+		failsafe: StreamTTLFailsafe,
+		drain:    StreamTTLDrain,
+	}
+}
+
+// Read returns the events of runID from index from onward. When there are
+// none it waits up to wait for one to arrive, returning nil on timeout, on
+// context cancellation or when the run is unknown.
+func (p *MemoryProgress) Read(ctx context.Context, runID int64, from int, wait time.Duration) []string {
+	events, changed := p.since(runID, from)
+	if len(events) > 0 {
+		return events
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-changed: // nil for an unknown run, so only the timer or ctx fire
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return nil
+	}
+	events, _ = p.since(runID, from)
+	return events
+}
+
+// since copies the events of runID from index from and returns the channel
+// that is closed on the next append, or nil when the run is unknown.
+func (p *MemoryProgress) since(runID int64, from int) ([]string, <-chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	run, ok := p.runs[runID]
+	if !ok {
+		return nil, nil
+	}
+	if from < 0 {
+		from = 0
+	}
+	if from >= len(run.events) {
+		return nil, run.changed
+	}
+	return append([]string(nil), run.events[from:]...), run.changed
+}
+
+func (p *MemoryProgress) publish(evt StepProgressEvent) {
+	data, err := sonic.MarshalString(evt)
+	if err != nil {
+		flog.Warn("pipeline live: marshal progress run=%d step=%s: %v", evt.RunID, evt.StepName, err)
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	run, ok := p.runs[evt.RunID]
+	if !ok {
+		run = &memoryRun{changed: make(chan struct{})}
+		p.runs[evt.RunID] = run
+	}
+	run.events = append(run.events, data)
+	close(run.changed)
+	run.changed = make(chan struct{})
+}
+
+// expire drops the events of runID after ttl, replacing any earlier expiry.
+func (p *MemoryProgress) expire(runID int64, ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	run, ok := p.runs[runID]
+	if !ok {
+		return
+	}
+	if run.expiry != nil {
+		run.expiry.Stop()
+	}
+	run.expiry = time.AfterFunc(ttl, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.runs[runID] == run {
+			delete(p.runs, runID)
+		}
+	})
+}
+
+func (p *MemoryProgress) OnRunStart(_ context.Context, runID int64, pipelineName string,
+	_ string, totalSteps int, _ []string) {
+	p.publish(StepProgressEvent{
+		RunID: runID, PipelineName: pipelineName,
+		StepIndex: -1, Status: "start", TotalSteps: totalSteps,
+	})
+	p.expire(runID, p.failsafe)
+}
+
+func (p *MemoryProgress) OnStepStart(_ context.Context, runID int64, pipelineName string,
+	stepIndex int, stepName string, input map[string]any) {
+	p.publish(StepProgressEvent{
+		RunID: runID, PipelineName: pipelineName,
+		StepIndex: stepIndex, StepName: stepName,
+		Status: "running", Input: input,
+	})
+}
+
+func (p *MemoryProgress) OnStepDone(_ context.Context, runID int64, pipelineName string,
+	stepIndex int, stepName string, output map[string]any, elapsedMs int64) {
+	p.publish(StepProgressEvent{
+		RunID: runID, PipelineName: pipelineName,
+		StepIndex: stepIndex, StepName: stepName,
+		Status: "done", Output: output, ElapsedMs: elapsedMs,
+	})
+}
+
+func (p *MemoryProgress) OnStepError(_ context.Context, runID int64, pipelineName string,
+	stepIndex int, stepName string, err error, elapsedMs int64) {
+	p.publish(StepProgressEvent{
+		RunID: runID, PipelineName: pipelineName,
+		StepIndex: stepIndex, StepName: stepName,
+		Status: "error", Error: err.Error(), ElapsedMs: elapsedMs,
+	})
+}
+
+func (p *MemoryProgress) OnRunComplete(_ context.Context, runID int64, pipelineName string,
+	elapsedMs int64, failed bool, errMsg string) {
+	status := "complete"
+	if failed {
+		status = "failed"
+	}
+	p.publish(StepProgressEvent{
+		RunID: runID, PipelineName: pipelineName,
+		StepIndex: -1, Status: status, ElapsedMs: elapsedMs, Error: errMsg,
+	})
+	p.expire(runID, p.drain)
+}
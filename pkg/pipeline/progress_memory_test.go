@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryProgressReplaysInOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewMemoryProgress()
+	p.OnRunStart(ctx, 7, "deploy", "manual", 2, []string{"a", "b"})
+	p.OnStepStart(ctx, 7, "deploy", 0, "a", map[string]any{"x": 1})
+	p.OnStepDone(ctx, 7, "deploy", 0, "a", map[string]any{"y": 2}, 5)
+	p.OnStepError(ctx, 7, "deploy", 1, "b", errors.New("boom"), 3)
+	p.OnRunComplete(ctx, 7, "deploy", 9, true, "boom")
+
+	events := p.Read(ctx, 7, 0, time.Second)
+	require.Len(t, events, 5)
+	assert.JSONEq(t, `{"run_id":7,"pipeline_name":"deploy","step_index":-1,"step_name":"","status":"start","total_steps":2}`, events[0])
+	assert.Contains(t, events[1], `"status":"running"`)
+	assert.Contains(t, events[2], `"status":"done"`)
+	assert.Contains(t, events[3], `"error":"boom"`)
+	assert.True(t, IsTerminalProgressJSON(events[4]))
+
+	assert.Equal(t, events[3:], p.Read(ctx, 7, 3, time.Second))
+}
+
+func TestMemoryProgressReadBlocks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewMemoryProgress()
+	p.OnRunStart(ctx, 1, "deploy", "manual", 1, nil)
+
+	assert.Nil(t, p.Read(ctx, 1, 1, 10*time.Millisecond), "times out without new events")
+	assert.Nil(t, p.Read(ctx, 2, 0, 10*time.Millisecond), "unknown run")
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		p.OnStepStart(ctx, 1, "deploy", 0, "a", nil)
+	}()
+	events := p.Read(ctx, 1, 1, 5*time.Second)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], `"step_name":"a"`)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Nil(t, p.Read(cancelled, 1, 2, 5*time.Second))
+}
+
+func TestMemoryProgressDropsRunAfterDrain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewMemoryProgress()
+	p.drain = 10 * time.Millisecond
+	p.OnRunStart(ctx, 1, "deploy", "manual", 0, nil)
+	p.OnRunComplete(ctx, 1, "deploy", 1, false, "")
+	require.Len(t, p.Read(ctx, 1, 0, time.Millisecond), 2)
+
+	assert.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return len(p.runs) == 0
+	}, time.Second, 5*time.Millisecond)
+}
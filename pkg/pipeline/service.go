@@ -64,6 +64,12 @@ func (s *Service) ApplyYAML(ctx context.Context, data []byte, createdBy string)
 	if err := ValidateName(name); err != nil {
 		return nil, types.WrapError(types.ErrInvalidArgument, "invalid pipeline name", err)
 	}
+	if err := ValidateSteps(ed.Steps); err != nil {
+		return nil, types.WrapError(types.ErrInvalidArgument, "invalid pipeline steps", err)
+	}
+	if err := ValidateApprovalSteps(ed.Steps, ed.Resumable); err != nil {
+		return nil, types.WrapError(types.ErrInvalidArgument, "invalid pipeline steps", err)
+	}
 
 	def, err := s.catalog.GetDefinitionByName(ctx, name)
 	if err != nil {
@@ -235,6 +241,44 @@ func (s *Service) ListRuns(ctx context.Context, name string) ([]*model.PipelineR
 	return s.catalog.GetRunsByParentName(ctx, name)
 }
 
+// CancelRun stops a running pipeline run.
+func (s *Service) CancelRun(ctx context.Context, runID int64) error {
+	engine, err := s.runEngine()
+	if err != nil {
+		return err
+	}
+	return engine.CancelRun(ctx, runID)
+}
+
+// RetryRun resumes a failed or cancelled run from its failed step.
+func (s *Service) RetryRun(ctx context.Context, runID int64) error {
+	engine, err := s.runEngine()
+	if err != nil {
+		return err
+	}
+	return engine.RetryRun(ctx, runID)
+}
+
+// RerunRun starts a new run with the same event as runID and returns the new run ID.
+func (s *Service) RerunRun(ctx context.Context, runID int64) (int64, error) {
+	engine, err := s.runEngine()
+	if err != nil {
+		return 0, err
+	}
+	return engine.RerunRun(ctx, runID)
+}
+
+func (s *Service) runEngine() (*Engine, error) {
+	if s == nil {
+		return nil, types.Errorf(types.ErrUnavailable, "pipeline service not ready")
+	}
+	engine := ActiveEngine()
+	if engine == nil {
+		return nil, types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+	}
+	return engine, nil
+}
+
 func (s *Service) publishedYAML(ctx context.Context, name string) (string, *model.PipelineDefinition, error) {
 	if s == nil || s.catalog == nil {
 		return "", nil, types.Errorf(types.ErrUnavailable, "pipeline service not ready")
@@ -258,11 +302,14 @@ func triggerSummaries(triggers []TriggerEntry) []string {
 	for _, t := range triggers {
 		switch t.Type {
 		case "event":
+			summary := "event"
 			if t.Event != "" {
-				out = append(out, "event:"+t.Event)
-			} else {
-				out = append(out, "event")
+				summary += ":" + t.Event
+			}
+			if f := t.Filter.String(); f != "" {
+				summary += " [" + f + "]"
 			}
+			out = append(out, summary)
 		case "cron":
 			if t.Cron != "" {
 				out = append(out, "cron:"+t.Cron)
@@ -29,6 +29,8 @@ type TemplateData struct {
 	Steps map[string]map[string]any
 	Env   map[string]string
 	Input map[string]any
+	// Item is the current element of a pipeline foreach step; nil outside foreach.
+	Item any
 }
 
 // New returns a new template Engine.
@@ -38,6 +40,7 @@ func New() *Engine {
 
 var reInput = regexp.MustCompile(`\{\{input\.(\w+)\}\}`)
 var reEvent = regexp.MustCompile(`\{\{event\.(\w+)\}\}`)
+var reItem = regexp.MustCompile(`\{\{item\.(\w+)\}\}`)
 var reSteps = regexp.MustCompile(`\{\{steps\.(\w+)\.(\w+)\}\}`)
 var reStepLegacy = regexp.MustCompile(`\{\{(\w+)\.(id|result)\}\}`)
 
@@ -46,6 +49,7 @@ var pooledSonic = sonic.Config{}.Froze()
 func preprocessTemplate(s string) string {
 	s = reInput.ReplaceAllString(s, `{{input "$1"}}`)
 	s = reEvent.ReplaceAllString(s, `{{event "$1"}}`)
+	s = reItem.ReplaceAllString(s, `{{item "$1"}}`)
 	s = reSteps.ReplaceAllString(s, `{{step "$1" "$2"}}`)
 	s = reStepLegacy.ReplaceAllString(s, `{{step "$1" "$2"}}`)
 	return s
@@ -85,6 +89,24 @@ func makeFuncs(data *TemplateData) txtpl.FuncMap {
 		return ""
 	}
 
+	// item returns the current foreach element, or one field of it when it is a map.
+	fm["item"] = func(fields ...string) any {
+		if data == nil || data.Item == nil {
+			return ""
+		}
+		if len(fields) == 0 {
+			return data.Item
+		}
+		m, ok := data.Item.(map[string]any)
+		if !ok {
+			return ""
+		}
+		if v, ok := m[fields[0]]; ok {
+			return v
+		}
+		return ""
+	}
+
 	fm["step"] = func(stepName, field string) any {
 		if data != nil && data.Steps != nil {
 			if step, ok := data.Steps[stepName]; ok {
@@ -203,6 +225,9 @@ func (*Engine) RenderString(tmpl string, data *TemplateData) (string, error) {
 		if data.Input != nil {
 			tplData["Input"] = data.Input
 		}
+		if data.Item != nil {
+			tplData["Item"] = data.Item
+		}
 	}
 
 	var buf strings.Builder
@@ -214,6 +239,17 @@ func (*Engine) RenderString(tmpl string, data *TemplateData) (string, error) {
 	return buf.String(), nil
 }
 
+// Parse checks template syntax without executing it.
+func (*Engine) Parse(tmpl string) error {
+	if !strings.Contains(tmpl, "{{") {
+		return nil
+	}
+	if _, err := txtpl.New("parse").Funcs(makeFuncs(nil)).Parse(preprocessTemplate(tmpl)); err != nil {
+		return fmt.Errorf("template parse: %w", err)
+	}
+	return nil
+}
+
 const maxRenderDepth = 32
 
 // Render traverses a map of parameters and renders any template strings
@@ -627,6 +627,32 @@ func TestRenderString_InputFields(t *testing.T) {
 	}
 }
 
+func TestRenderString_ItemFields(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		template string
+		item     any
+		want     string
+	}{
+		{name: "ScalarItem", template: `{{item}}`, item: "https://example.com", want: "https://example.com"},
+		{name: "MapField", template: `{{item "url"}}`, item: map[string]any{"url": "https://x.com"}, want: "https://x.com"},
+		{name: "MapField_DotSyntax", template: `{{item.url}}`, item: map[string]any{"url": "https://x.com"}, want: "https://x.com"},
+		{name: "MapField_Missing", template: `{{item "title"}}`, item: map[string]any{"url": "https://x.com"}, want: ""},
+		{name: "FieldOfScalar", template: `{{item "url"}}`, item: 42, want: ""},
+		{name: "NilItem", template: `{{item}}`, item: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := New().RenderString(tt.template, &TemplateData{Item: tt.item})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
 // --- paramsTest holds cases for TestRender_Params ---
 type paramsTest struct {
 	name       string
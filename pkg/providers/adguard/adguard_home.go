@@ -3,6 +3,8 @@ package adguard
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"resty.dev/v3"
 
@@ -21,10 +23,14 @@ type AdGuardHome struct {
 	c *resty.Client
 }
 
+// GetClient returns a client from provider config, or nil when no endpoint is set.
 func GetClient() *AdGuardHome {
 	endpoint, _ := providers.GetConfig(ID, EndpointKey)
 	username, _ := providers.GetConfig(ID, UsernameKey)
 	password, _ := providers.GetConfig(ID, PasswordKey)
+	if endpoint.String() == "" {
+		return nil
+	}
 
 	return NewAdGuardHome(endpoint.String(), username.String(), password.String())
 }
@@ -68,3 +74,86 @@ func (v *AdGuardHome) GetStats() (*Stats, error) {
 	}
 	return result, nil
 }
+
+// QueryLog returns query log entries older than params.OlderThan, newest first.
+func (v *AdGuardHome) QueryLog(params QueryLogParams) (*QueryLog, error) {
+	req := v.c.R().SetResult(&QueryLog{})
+	if params.OlderThan != "" {
+		req.SetQueryParam("older_than", params.OlderThan)
+	}
+	if params.Limit > 0 {
+		req.SetQueryParam("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Search != "" {
+		req.SetQueryParam("search", params.Search)
+	}
+	if params.ResponseStatus != "" {
+		req.SetQueryParam("response_status", params.ResponseStatus)
+	}
+	resp, err := req.Get("/querylog")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get query log: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return nil, fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+	}
+
+	result, ok := resp.Result().(*QueryLog)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type from adguard")
+	}
+	return result, nil
+}
+
+// SetProtection enables or disables DNS protection. A positive duration
+// disables protection only for that long.
+func (v *AdGuardHome) SetProtection(enabled bool, duration time.Duration) error {
+	body := map[string]any{"enabled": enabled}
+	if !enabled && duration > 0 {
+		body["duration"] = duration.Milliseconds()
+	}
+	resp, err := v.c.R().
+		SetBody(body).
+		Post("/protection")
+	if err != nil {
+		return fmt.Errorf("failed to set protection: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+	}
+	return nil
+}
+
+// GetFilteringStatus returns the filtering configuration.
+func (v *AdGuardHome) GetFilteringStatus() (*FilteringStatus, error) {
+	resp, err := v.c.R().
+		SetResult(&FilteringStatus{}).
+		Get("/filtering/status")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filtering status: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return nil, fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+	}
+
+	result, ok := resp.Result().(*FilteringStatus)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type from adguard")
+	}
+	return result, nil
+}
+
+// SetFilteringConfig enables or disables filtering. interval is the filter
+// list update interval in hours.
+func (v *AdGuardHome) SetFilteringConfig(enabled bool, interval int) error {
+	resp, err := v.c.R().
+		SetBody(FilteringConfig{Enabled: enabled, Interval: interval}).
+		Post("/filtering/config")
+	if err != nil {
+		return fmt.Errorf("failed to set filtering config: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/bytedance/sonic"
 
@@ -139,3 +140,105 @@ func TestNewAdGuardHome(t *testing.T) {
 		assert.NotNil(t, client.c)
 	})
 }
+
+func TestAdGuardHome_QueryLog(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/querylog", r.URL.Path)
+		assert.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("older_than"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "ads", r.URL.Query().Get("search"))
+		assert.Equal(t, "blocked", r.URL.Query().Get("response_status"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"oldest":"2025-12-31T23:59:00Z","data":[{"client":"10.0.0.2","question":{"class":"IN","name":"ads.example.com","type":"A"},"reason":"FilteredBlackList","rules":[{"filter_list_id":1,"text":"||ads.example.com^"}],"status":"NOERROR","time":"2025-12-31T23:59:00Z","elapsedMs":"0.5"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewAdGuardHome(server.URL, "admin", "secret")
+	result, err := client.QueryLog(QueryLogParams{OlderThan: "2026-01-01T00:00:00Z", Limit: 10, Search: "ads", ResponseStatus: "blocked"})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-12-31T23:59:00Z", result.Oldest)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "ads.example.com", result.Data[0].Question.Name)
+	assert.Equal(t, "FilteredBlackList", result.Data[0].Reason)
+	require.Len(t, result.Data[0].Rules, 1)
+}
+
+func TestAdGuardHome_Toggles(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		path     string
+		wantBody map[string]any
+		status   int
+		call     func(c *AdGuardHome) error
+		wantErr  bool
+	}{
+		{
+			name:     "disable protection for a while",
+			path:     "/protection",
+			wantBody: map[string]any{"enabled": false, "duration": float64(60000)},
+			status:   http.StatusOK,
+			call:     func(c *AdGuardHome) error { return c.SetProtection(false, time.Minute) },
+		},
+		{
+			name:     "enable protection ignores duration",
+			path:     "/protection",
+			wantBody: map[string]any{"enabled": true},
+			status:   http.StatusOK,
+			call:     func(c *AdGuardHome) error { return c.SetProtection(true, time.Minute) },
+		},
+		{
+			name:     "set filtering config",
+			path:     "/filtering/config",
+			wantBody: map[string]any{"enabled": true, "interval": float64(24)},
+			status:   http.StatusOK,
+			call:     func(c *AdGuardHome) error { return c.SetFilteringConfig(true, 24) },
+		},
+		{
+			name:    "server error",
+			path:    "/protection",
+			status:  http.StatusInternalServerError,
+			call:    func(c *AdGuardHome) error { return c.SetProtection(true, 0) },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.path, r.URL.Path)
+				assert.Equal(t, http.MethodPost, r.Method)
+				if tt.wantBody != nil {
+					var body map[string]any
+					assert.NoError(t, sonic.ConfigDefault.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, tt.wantBody, body)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := tt.call(NewAdGuardHome(server.URL, "admin", "secret"))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAdGuardHome_GetFilteringStatus(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/filtering/status", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"enabled":true,"interval":12}`))
+	}))
+	defer server.Close()
+
+	result, err := NewAdGuardHome(server.URL, "admin", "secret").GetFilteringStatus()
+	require.NoError(t, err)
+	assert.True(t, result.Enabled)
+	assert.Equal(t, 12, result.Interval)
+}
@@ -47,3 +47,66 @@ type TopArrayEntry struct {
 	DomainOrIp           *float32 `json:"domain_or_ip,omitempty"`
 	AdditionalProperties map[string]any
 }
+
+// QueryLogParams filters a query log request.
+type QueryLogParams struct {
+	// OlderThan is the RFC 3339 time of the oldest entry already seen.
+	OlderThan string
+	Limit     int
+	Search    string
+	// ResponseStatus is one of all, filtered, blocked, blocked_safebrowsing,
+	// blocked_parental, whitelisted, rewritten, safe_search, or processed.
+	ResponseStatus string
+}
+
+// QueryLog is a page of query log entries.
+type QueryLog struct {
+	// Oldest is the time of the last entry, used as the next OlderThan.
+	Oldest string         `json:"oldest"`
+	Data   []QueryLogItem `json:"data"`
+}
+
+// QueryLogItem is one DNS query log entry.
+type QueryLogItem struct {
+	Client     string           `json:"client"`
+	ClientInfo *QueryLogClient  `json:"client_info,omitempty"`
+	Question   QueryLogQuestion `json:"question"`
+	Reason     string           `json:"reason"`
+	Rules      []QueryLogRule   `json:"rules,omitempty"`
+	Status     string           `json:"status"`
+	Time       string           `json:"time"`
+	ElapsedMs  string           `json:"elapsedMs"`
+	Upstream   string           `json:"upstream,omitempty"`
+	Cached     bool             `json:"cached"`
+}
+
+// QueryLogClient identifies the client that sent a query.
+type QueryLogClient struct {
+	Name string `json:"name"`
+}
+
+// QueryLogQuestion is the DNS question of a query log entry.
+type QueryLogQuestion struct {
+	Class       string `json:"class"`
+	Name        string `json:"name"`
+	UnicodeName string `json:"unicode_name,omitempty"`
+	Type        string `json:"type"`
+}
+
+// QueryLogRule is a filtering rule that matched a query.
+type QueryLogRule struct {
+	FilterListID int64  `json:"filter_list_id"`
+	Text         string `json:"text"`
+}
+
+// FilteringStatus is the filtering configuration.
+type FilteringStatus struct {
+	Enabled  bool `json:"enabled"`
+	Interval int  `json:"interval"`
+}
+
+// FilteringConfig is the request body for updating filtering.
+type FilteringConfig struct {
+	Enabled  bool `json:"enabled"`
+	Interval int  `json:"interval"`
+}
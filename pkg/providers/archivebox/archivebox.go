@@ -3,6 +3,7 @@ package archivebox
 
 import (
 	"fmt"
+	"strconv"
 
 	"resty.dev/v3"
 
@@ -20,9 +21,13 @@ type ArchiveBox struct {
 	c *resty.Client
 }
 
+// GetClient returns a client from provider config, or nil when no endpoint is set.
 func GetClient() *ArchiveBox {
 	endpoint, _ := providers.GetConfig(ID, EndpointKey)
 	tokenKey, _ := providers.GetConfig(ID, TokenKey)
+	if endpoint.String() == "" {
+		return nil
+	}
 	return NewArchiveBox(endpoint.String(), tokenKey.String())
 }
 
@@ -50,3 +55,53 @@ func (i *ArchiveBox) Add(data Data) (*Response, error) {
 	}
 	return result, nil
 }
+
+// ListSnapshots returns a page of snapshots, most recently added first.
+func (i *ArchiveBox) ListSnapshots(q SnapshotQuery) (*SnapshotPage, error) {
+	req := i.c.R().SetResult(&SnapshotPage{})
+	if q.Limit > 0 {
+		req.SetQueryParam("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Offset > 0 {
+		req.SetQueryParam("offset", strconv.Itoa(q.Offset))
+	}
+	if q.Search != "" {
+		req.SetQueryParam("search", q.Search)
+	}
+	if q.Tag != "" {
+		req.SetQueryParam("tag", q.Tag)
+	}
+	resp, err := req.Get("/api/v1/core/snapshots")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return nil, fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+	}
+
+	result, ok := resp.Result().(*SnapshotPage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type from archivebox")
+	}
+	return result, nil
+}
+
+// GetSnapshot returns a snapshot by ID or ABID.
+func (i *ArchiveBox) GetSnapshot(id string) (*Snapshot, error) {
+	resp, err := i.c.R().
+		SetResult(&Snapshot{}).
+		SetPathParam("id", id).
+		Get("/api/v1/core/snapshot/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return nil, fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+	}
+
+	result, ok := resp.Result().(*Snapshot)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type from archivebox")
+	}
+	return result, nil
+}
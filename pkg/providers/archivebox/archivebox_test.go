@@ -146,3 +146,72 @@ func TestNewArchiveBox(t *testing.T) {
 		assert.NotNil(t, client.c)
 	})
 }
+
+func TestArchiveBox_ListSnapshots(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		query      SnapshotQuery
+		wantQuery  map[string]string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "list with filters",
+			query:      SnapshotQuery{Limit: 5, Offset: 10, Search: "go", Tag: "dev"},
+			wantQuery:  map[string]string{"limit": "5", "offset": "10", "search": "go", "tag": "dev"},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "list without filters",
+			wantQuery:  map[string]string{"limit": "", "offset": "", "search": "", "tag": ""},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/core/snapshots", r.URL.Path)
+				for k, v := range tt.wantQuery {
+					assert.Equal(t, v, r.URL.Query().Get(k), k)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(`{"total_items":11,"limit":5,"offset":10,"items":[{"id":"01J","url":"https://go.dev","title":"Go","tags":"dev,go","downloaded_at":"2026-01-02T03:04:05Z"}]}`))
+			}))
+			defer server.Close()
+
+			result, err := NewArchiveBox(server.URL, "token").ListSnapshots(tt.query)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 11, result.TotalItems)
+			require.Len(t, result.Items, 1)
+			assert.Equal(t, "https://go.dev", result.Items[0].URL)
+			require.NotNil(t, result.Items[0].DownloadedAt)
+		})
+	}
+}
+
+func TestArchiveBox_GetSnapshot(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/core/snapshot/01J", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"01J","url":"https://go.dev","title":"Go"}`))
+	}))
+	defer server.Close()
+
+	result, err := NewArchiveBox(server.URL, "token").GetSnapshot("01J")
+	require.NoError(t, err)
+	assert.Equal(t, "Go", result.Title)
+}
@@ -1,5 +1,7 @@
 package archivebox
 
+import "time"
+
 type Data struct {
 	Urls       []string `json:"urls"`
 	Tag        string   `json:"tag"`
@@ -20,3 +22,35 @@ type Response struct {
 	Stdout  string   `json:"stdout"`
 	Stderr  string   `json:"stderr"`
 }
+
+// SnapshotQuery filters and paginates a snapshot list request.
+type SnapshotQuery struct {
+	Limit  int
+	Offset int
+	Search string
+	Tag    string
+}
+
+// SnapshotPage is a page of snapshots.
+type SnapshotPage struct {
+	TotalItems int        `json:"total_items"`
+	Limit      int        `json:"limit"`
+	Offset     int        `json:"offset"`
+	Items      []Snapshot `json:"items"`
+}
+
+// Snapshot is one archived URL.
+type Snapshot struct {
+	ID                string     `json:"id"`
+	ABID              string     `json:"abid"`
+	URL               string     `json:"url"`
+	Title             string     `json:"title"`
+	Tags              string     `json:"tags"`
+	Timestamp         string     `json:"timestamp"`
+	ArchivePath       string     `json:"archive_path"`
+	BookmarkedAt      *time.Time `json:"bookmarked_at"`
+	DownloadedAt      *time.Time `json:"downloaded_at"`
+	CreatedAt         *time.Time `json:"created_at"`
+	ModifiedAt        *time.Time `json:"modified_at"`
+	NumArchiveResults int        `json:"num_archiveresults"`
+}
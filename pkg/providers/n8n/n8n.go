@@ -4,6 +4,7 @@ package n8n
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"resty.dev/v3"
 
@@ -196,6 +197,36 @@ func (v *N8N) ExecuteWorkflow(id string, data map[string]any) error {
 	return fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
 }
 
+// ListExecutions returns a page of workflow executions, newest first.
+func (v *N8N) ListExecutions(q ExecutionQuery) (*ExecutionList, error) {
+	req := v.c.R().SetResult(&ExecutionList{})
+	if q.WorkflowID != "" {
+		req.SetQueryParam("workflowId", q.WorkflowID)
+	}
+	if q.Status != "" {
+		req.SetQueryParam("status", q.Status)
+	}
+	if q.Limit > 0 {
+		req.SetQueryParam("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Cursor != "" {
+		req.SetQueryParam("cursor", q.Cursor)
+	}
+	resp, err := req.Get("/executions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list executions: %w", err)
+	}
+
+	if resp.StatusCode() == http.StatusOK {
+		result, ok := resp.Result().(*ExecutionList)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type from n8n")
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unexpected status code: %d, %s", resp.StatusCode(), resp.String())
+}
+
 func findWebhookNode(nodes []Node) (path, id string) {
 	for _, node := range nodes {
 		if node.Type == "n8n-nodes-base.webhook" || node.Type == "n8n-nodes-base.webhookV2" {
@@ -392,3 +392,66 @@ func TestNewN8N(t *testing.T) {
 		assert.NotNil(t, client.c)
 	})
 }
+
+func TestN8N_ListExecutions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		query      ExecutionQuery
+		body       string
+		statusCode int
+		wantIDs    []FlexID
+		wantErr    bool
+	}{
+		{
+			name:       "numeric ids",
+			query:      ExecutionQuery{WorkflowID: "7", Status: "error", Limit: 2, Cursor: "abc"},
+			body:       `{"data":[{"id":1000,"workflowId":7,"status":"error","mode":"webhook","finished":false,"startedAt":"2026-01-01T00:00:00Z"}],"nextCursor":"def"}`,
+			statusCode: http.StatusOK,
+			wantIDs:    []FlexID{"1000"},
+		},
+		{
+			name:       "string ids",
+			body:       `{"data":[{"id":"12","workflowId":"wf1","status":"success","finished":true}]}`,
+			statusCode: http.StatusOK,
+			wantIDs:    []FlexID{"12"},
+		},
+		{
+			name:       "unauthorized",
+			body:       `{"message":"unauthorized"}`,
+			statusCode: http.StatusUnauthorized,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/executions", r.URL.Path)
+				assert.Equal(t, tt.query.WorkflowID, r.URL.Query().Get("workflowId"))
+				assert.Equal(t, tt.query.Status, r.URL.Query().Get("status"))
+				assert.Equal(t, tt.query.Cursor, r.URL.Query().Get("cursor"))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			result, err := NewN8N(server.URL, "key").ListExecutions(tt.query)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]FlexID, 0, len(result.Data))
+			for _, e := range result.Data {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			if tt.query.Cursor != "" {
+				assert.Equal(t, "def", result.NextCursor)
+				assert.Equal(t, FlexID("7"), result.Data[0].WorkflowID)
+			}
+		})
+	}
+}
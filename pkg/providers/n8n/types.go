@@ -1,6 +1,9 @@
 package n8n
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Workflow represents an n8n workflow
 type Workflow struct {
@@ -45,3 +48,44 @@ type Tag struct {
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
+
+// ExecutionQuery filters and paginates an execution list request.
+type ExecutionQuery struct {
+	WorkflowID string
+	// Status is one of success, error, running, waiting, or canceled.
+	Status string
+	Limit  int
+	Cursor string
+}
+
+// ExecutionList is a page of executions.
+type ExecutionList struct {
+	Data       []Execution `json:"data"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+}
+
+// Execution represents one run of an n8n workflow.
+type Execution struct {
+	ID         FlexID     `json:"id"`
+	WorkflowID FlexID     `json:"workflowId"`
+	Status     string     `json:"status,omitempty"`
+	Mode       string     `json:"mode,omitempty"`
+	Finished   bool       `json:"finished"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	StoppedAt  *time.Time `json:"stoppedAt,omitempty"`
+}
+
+// FlexID is an n8n identifier. Older n8n versions encode execution and workflow
+// IDs as numbers, newer ones as strings.
+type FlexID string
+
+// UnmarshalJSON accepts both string and numeric IDs.
+func (i *FlexID) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*i = ""
+		return nil
+	}
+	*i = FlexID(strings.Trim(s, `"`))
+	return nil
+}
@@ -21,9 +21,13 @@ type Slash struct {
 	token string
 }
 
+// GetClient returns a client from provider config, or nil when no endpoint is set.
 func GetClient() *Slash {
 	endpoint, _ := providers.GetConfig(ID, EndpointKey)
 	token, _ := providers.GetConfig(ID, TokenKey)
+	if endpoint.String() == "" {
+		return nil
+	}
 
 	return NewSlash(endpoint.String(), token.String())
 }
@@ -21,7 +21,15 @@ var Client *redis.Client
 // NewClient creates and returns a single Redis client configured from config.App.Redis.
 // Connection pool parameters use go-redis defaults when set to zero, except ReadTimeout
 // and WriteTimeout which fall back to 60s for backward compatibility.
+//
+// When redis.backend is "memory" no connection is made and NewClient returns a
+// nil client; cache.NewStore and the event pub/sub constructors then select
+// their in-process implementations.
 func NewClient(lc fx.Lifecycle, _ *config.Type) (*redis.Client, error) {
+	if config.App.Redis.InMemory() {
+		flog.Info("redis: backend is memory; cache, pub/sub and rule state stay in process")
+		return nil, nil
+	}
 	opts, err := redisOptions(config.App.Redis)
 	if err != nil {
 		return nil, fmt.Errorf("redis options: %w", err)
@@ -90,7 +98,9 @@ func redisOptions(cfg config.Redis) (*redis.Options, error) {
 // the ping fails (e.g. Redis is unreachable during shutdown).
 func Shutdown(ctx context.Context) {
 	if Client == nil {
-		flog.Warn("redis not initialized")
+		if !config.App.Redis.InMemory() {
+			flog.Warn("redis not initialized")
+		}
 		return
 	}
 
@@ -273,3 +273,19 @@ func TestNewClient(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClient_MemoryBackend(t *testing.T) {
+	prev := Client
+	t.Cleanup(func() { Client = prev })
+	orig := config.App.Redis
+	t.Cleanup(func() { config.App.Redis = orig })
+	config.App.Redis = config.Redis{Backend: config.RedisBackendMemory}
+	Client = nil
+
+	lc := &testLifecycle{}
+	client, err := NewClient(lc, &config.Type{})
+	require.NoError(t, err)
+	assert.Nil(t, client)
+	assert.Nil(t, Client)
+	assert.Empty(t, lc.hooks)
+}
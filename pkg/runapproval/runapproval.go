@@ -0,0 +1,320 @@
+// Package runapproval implements human approval gates for pipeline and workflow
+// runs: gate config, the pending request kept in run checkpoints, decision
+// tokens and the approve/reject notification.
+package runapproval
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/notify"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// Decision is the outcome of an approval gate.
+type Decision string
+
+const (
+	// Approve lets the run continue past the gate.
+	Approve Decision = "approve"
+	// Reject fails the gate step.
+	Reject Decision = "reject"
+)
+
+const (
+	// KindPipeline marks a gate in a pipeline run.
+	KindPipeline = "pipeline"
+	// KindWorkflow marks a gate in a workflow run.
+	KindWorkflow = "workflow"
+
+	// DefaultTimeout applies when a gate does not set timeout.
+	DefaultTimeout = 24 * time.Hour
+	// DecidedByTimeout is recorded as DecidedBy when the default decision applies.
+	DecidedByTimeout = "timeout"
+
+	// WebPathPrefix is the Web UI path of the decision page; the token follows it.
+	WebPathPrefix = "/service/web/approvals/"
+)
+
+// ErrPending is returned by a gate step whose request has no decision yet.
+// The run is suspended rather than failed.
+var ErrPending = errors.New("waiting for approval")
+
+// ParseDecision parses approve or reject (case-insensitive).
+func ParseDecision(raw string) (Decision, error) {
+	switch Decision(strings.ToLower(strings.TrimSpace(raw))) {
+	case Approve:
+		return Approve, nil
+	case Reject:
+		return Reject, nil
+	default:
+		return "", fmt.Errorf("invalid approval decision %q (want approve or reject)", raw)
+	}
+}
+
+// Config declares an approval gate.
+type Config struct {
+	// Message is shown to the approver; it is template-rendered before the gate opens.
+	Message string `json:"message" yaml:"message"`
+	// Timeout is a Go duration such as 30m or 24h; empty means DefaultTimeout.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Default is the decision applied when the timeout passes; empty means reject.
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+	// Channels are notify channel names; empty means the inbox plus the default channel.
+	Channels []string `json:"channels,omitempty" yaml:"channels,omitempty"`
+	// UID is the user notified; pipelines fall back to the event UID.
+	UID string `json:"uid,omitempty" yaml:"uid,omitempty"`
+}
+
+// Validate checks the timeout and default decision.
+func (c Config) Validate() error {
+	if _, err := c.TimeoutDuration(); err != nil {
+		return err
+	}
+	if _, err := c.DefaultDecision(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TimeoutDuration parses Timeout, returning DefaultTimeout when it is empty.
+func (c Config) TimeoutDuration() (time.Duration, error) {
+	raw := strings.TrimSpace(c.Timeout)
+	if raw == "" {
+		return DefaultTimeout, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid approval timeout %q: %w", c.Timeout, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("approval timeout must be positive, got %q", c.Timeout)
+	}
+	return d, nil
+}
+
+// DefaultDecision parses Default, returning Reject when it is empty.
+func (c Config) DefaultDecision() (Decision, error) {
+	if strings.TrimSpace(c.Default) == "" {
+		return Reject, nil
+	}
+	return ParseDecision(c.Default)
+}
+
+// ConfigFromParams reads a gate config from rendered task params
+// (message, timeout, default, channels, uid).
+func ConfigFromParams(params map[string]any) (Config, error) {
+	cfg := Config{
+		Message: paramString(params, "message"),
+		Timeout: paramString(params, "timeout"),
+		Default: paramString(params, "default"),
+		UID:     paramString(params, "uid"),
+	}
+	switch v := params["channels"].(type) {
+	case nil:
+	case string:
+		for ch := range strings.SplitSeq(v, ",") {
+			if ch = strings.TrimSpace(ch); ch != "" {
+				cfg.Channels = append(cfg.Channels, ch)
+			}
+		}
+	case []string:
+		cfg.Channels = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				cfg.Channels = append(cfg.Channels, strings.TrimSpace(s))
+			}
+		}
+	default:
+		return Config{}, fmt.Errorf("approval channels must be a list of channel names")
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+func paramString(params map[string]any, key string) string {
+	switch v := params[key].(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case nil:
+		return ""
+	default:
+		return strings.TrimSpace(fmt.Sprint(v))
+	}
+}
+
+// Request is an open or decided approval gate. It is stored in the run checkpoint
+// so a suspended run survives restarts.
+type Request struct {
+	Token       string    `json:"token"`
+	Step        string    `json:"step"`
+	StepRunID   int64     `json:"step_run_id,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	Default     Decision  `json:"default"`
+	RequestedAt time.Time `json:"requested_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Decision    Decision  `json:"decision,omitempty"`
+	DecidedBy   string    `json:"decided_by,omitempty"`
+	DecidedAt   time.Time `json:"decided_at,omitzero"`
+}
+
+// NewRequest opens a gate for step of the given run. message is the rendered Message.
+func NewRequest(kind string, runID int64, step, message string, cfg Config, now time.Time) (*Request, error) {
+	timeout, err := cfg.TimeoutDuration()
+	if err != nil {
+		return nil, err
+	}
+	def, err := cfg.DefaultDecision()
+	if err != nil {
+		return nil, err
+	}
+	token, err := newToken(kind, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &Request{
+		Token:       token,
+		Step:        step,
+		Message:     message,
+		Default:     def,
+		RequestedAt: now,
+		ExpiresAt:   now.Add(timeout),
+	}, nil
+}
+
+// Decided reports whether the gate has a decision.
+func (r *Request) Decided() bool {
+	return r != nil && r.Decision != ""
+}
+
+// Expired reports whether the gate is undecided and past its timeout.
+func (r *Request) Expired(now time.Time) bool {
+	return r != nil && !r.Decided() && !now.Before(r.ExpiresAt)
+}
+
+// Decide records a decision. A gate is decided once; later calls return ErrConflict.
+func (r *Request) Decide(d Decision, by string, now time.Time) error {
+	if r.Decided() {
+		return types.Errorf(types.ErrConflict, "approval %s already decided: %s", r.Step, r.Decision)
+	}
+	r.Decision = d
+	r.DecidedBy = by
+	r.DecidedAt = now
+	return nil
+}
+
+// ApplyTimeout records the default decision when the gate has expired.
+// It reports whether a decision was recorded.
+func (r *Request) ApplyTimeout(now time.Time) bool {
+	if !r.Expired(now) {
+		return false
+	}
+	_ = r.Decide(r.Default, DecidedByTimeout, now)
+	return true
+}
+
+// MatchToken compares token with the request token in constant time.
+func (r *Request) MatchToken(token string) bool {
+	if r == nil || r.Token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Token), []byte(token)) == 1
+}
+
+// Result is the step result recorded for a decided gate; templates read it
+// through {{step "<name>" "decision"}}.
+func (r *Request) Result() map[string]any {
+	return map[string]any{
+		"decision":   string(r.Decision),
+		"decided_by": r.DecidedBy,
+		"decided_at": r.DecidedAt.UTC().Format(time.RFC3339),
+		"message":    r.Message,
+	}
+}
+
+// Err returns nil for an approved gate, ErrPending for an undecided one, and a
+// rejection error otherwise.
+func (r *Request) Err() error {
+	switch r.Decision {
+	case Approve:
+		return nil
+	case "":
+		return ErrPending
+	default:
+		return fmt.Errorf("approval %s rejected by %s", r.Step, r.DecidedBy)
+	}
+}
+
+var tokenPrefixes = map[string]string{KindPipeline: "p", KindWorkflow: "w"}
+
+// newToken returns <p|w>-<runID>-<secret>. The run ID lets the decision handler
+// find the checkpoint; the secret authorizes the decision.
+func newToken(kind string, runID int64) (string, error) {
+	prefix, ok := tokenPrefixes[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown approval kind %q", kind)
+	}
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate approval token: %w", err)
+	}
+	return prefix + "-" + strconv.FormatInt(runID, 10) + "-" + hex.EncodeToString(secret), nil
+}
+
+// ParseToken returns the run kind and run ID encoded in a decision token.
+func ParseToken(token string) (string, int64, error) {
+	parts := strings.Split(strings.TrimSpace(token), "-")
+	if len(parts) != 3 || parts[2] == "" {
+		return "", 0, types.Errorf(types.ErrInvalidArgument, "invalid approval token")
+	}
+	var kind string
+	for k, prefix := range tokenPrefixes {
+		if parts[0] == prefix {
+			kind = k
+		}
+	}
+	runID, err := strconv.ParseInt(parts[1], 10, 64)
+	if kind == "" || err != nil || runID <= 0 {
+		return "", 0, types.Errorf(types.ErrInvalidArgument, "invalid approval token")
+	}
+	return kind, runID, nil
+}
+
+// DecisionURL returns the absolute Web UI link that records decision d.
+func DecisionURL(token string, d Decision) string {
+	return strings.TrimRight(types.AppUrl(), "/") + WebPathPrefix + token + "?decision=" + string(d)
+}
+
+// Notify sends the approve/reject links for req. source names the run,
+// e.g. "pipeline deploy #42".
+func Notify(ctx context.Context, cfg Config, req *Request, source string) error {
+	channels := cfg.Channels
+	if len(channels) == 0 {
+		channels = notify.DefaultInboxChannels(ctx)
+	}
+	summary := fmt.Sprintf("Needs approval · %s · %s", source, req.Step)
+	payload := map[string]any{
+		notify.PayloadKeySummary:       summary,
+		notify.PayloadKeyTitle:         req.Message,
+		notify.PayloadKeyURL:           DecisionURL(req.Token, ""),
+		notify.PayloadKeyCorrelationID: req.Token,
+		"message":                      req.Message,
+		"source":                       source,
+		"step":                         req.Step,
+		"approve_url":                  DecisionURL(req.Token, Approve),
+		"reject_url":                   DecisionURL(req.Token, Reject),
+		"expires_at":                   req.ExpiresAt.UTC().Format(time.RFC3339),
+		"default":                      string(req.Default),
+	}
+	return notify.GatewaySend(ctx, types.Uid(cfg.UID), notify.RunApprovalTemplateID, channels, payload)
+}
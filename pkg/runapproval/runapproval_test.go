@@ -0,0 +1,118 @@
+package runapproval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestConfigFromParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]any
+		want    Config
+		wantErr bool
+	}{
+		{
+			name:   "defaults",
+			params: map[string]any{"message": " deploy? "},
+			want:   Config{Message: "deploy?"},
+		},
+		{
+			name:   "channel string list",
+			params: map[string]any{"message": "m", "timeout": "30m", "default": "approve", "channels": "slack, ntfy"},
+			want:   Config{Message: "m", Timeout: "30m", Default: "approve", Channels: []string{"slack", "ntfy"}},
+		},
+		{
+			name:   "channel yaml list",
+			params: map[string]any{"message": "m", "channels": []any{"slack", ""}},
+			want:   Config{Message: "m", Channels: []string{"slack"}},
+		},
+		{name: "bad timeout", params: map[string]any{"timeout": "soon"}, wantErr: true},
+		{name: "negative timeout", params: map[string]any{"timeout": "-1m"}, wantErr: true},
+		{name: "bad default", params: map[string]any{"default": "maybe"}, wantErr: true},
+		{name: "bad channels", params: map[string]any{"channels": 3}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConfigFromParams(tt.params)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRequestLifecycle(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	req, err := NewRequest(KindPipeline, 42, "gate", "ship it?", Config{Timeout: "1h"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, Reject, req.Default)
+	assert.Equal(t, now.Add(time.Hour), req.ExpiresAt)
+	assert.ErrorIs(t, req.Err(), ErrPending)
+
+	kind, runID, err := ParseToken(req.Token)
+	require.NoError(t, err)
+	assert.Equal(t, KindPipeline, kind)
+	assert.Equal(t, int64(42), runID)
+	assert.True(t, req.MatchToken(req.Token))
+	assert.False(t, req.MatchToken(req.Token+"0"))
+
+	assert.False(t, req.ApplyTimeout(now.Add(time.Minute)))
+	require.NoError(t, req.Decide(Approve, "alice", now.Add(2*time.Minute)))
+	require.NoError(t, req.Err())
+	assert.Equal(t, "approve", req.Result()["decision"])
+
+	err = req.Decide(Reject, "bob", now.Add(3*time.Minute))
+	assert.True(t, errors.Is(err, types.ErrConflict))
+	assert.Equal(t, Approve, req.Decision)
+}
+
+func TestRequestApplyTimeout(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	req, err := NewRequest(KindWorkflow, 7, "gate", "", Config{Timeout: "10m", Default: "approve"}, now)
+	require.NoError(t, err)
+
+	assert.True(t, req.ApplyTimeout(now.Add(10*time.Minute)))
+	assert.Equal(t, Approve, req.Decision)
+	assert.Equal(t, DecidedByTimeout, req.DecidedBy)
+	assert.False(t, req.ApplyTimeout(now.Add(time.Hour)), "a decided gate does not time out again")
+
+	rejected, err := NewRequest(KindWorkflow, 7, "gate", "", Config{Timeout: "10m"}, now)
+	require.NoError(t, err)
+	rejected.ApplyTimeout(now.Add(time.Hour))
+	require.Error(t, rejected.Err())
+	assert.NotErrorIs(t, rejected.Err(), ErrPending)
+}
+
+func TestParseToken(t *testing.T) {
+	tests := []struct {
+		token string
+		ok    bool
+	}{
+		{token: "w-3-abcdef", ok: true},
+		{token: "p-0-abcdef"},
+		{token: "x-3-abcdef"},
+		{token: "p-3-"},
+		{token: "p-three-abc"},
+		{token: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			_, _, err := ParseToken(tt.token)
+			if tt.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, types.ErrInvalidArgument)
+			}
+		})
+	}
+}
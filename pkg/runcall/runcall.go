@@ -0,0 +1,158 @@
+// Package runcall links child pipeline and workflow runs to the run that
+// started them and bounds how deeply runs may call each other.
+package runcall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	// KindPipeline marks a pipeline run.
+	KindPipeline = "pipeline"
+	// KindWorkflow marks a workflow run.
+	KindWorkflow = "workflow"
+
+	// MaxDepth is the deepest a chain of calls may nest. A top-level run has
+	// depth 0; the run it calls has depth 1.
+	MaxDepth = 5
+
+	// TriggerSource is recorded as the trigger of runs started by a call.
+	TriggerSource = "call"
+)
+
+// Child run statuses reported in Result.Status.
+const (
+	StatusStarted   = "started"
+	StatusDone      = "done"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+	StatusWaiting   = "waiting"
+)
+
+// Parent is the run that started a child run.
+type Parent struct {
+	Kind  string `json:"kind"`
+	RunID int64  `json:"run_id"`
+	// Depth is the call depth of the child run.
+	Depth int `json:"depth"`
+}
+
+type depthKey struct{}
+
+// WithDepth returns ctx carrying the call depth of the run executing in it.
+func WithDepth(ctx context.Context, depth int) context.Context {
+	return context.WithValue(ctx, depthKey{}, depth)
+}
+
+// Depth returns the call depth of the run executing in ctx; 0 outside calls.
+func Depth(ctx context.Context) int {
+	if d, ok := ctx.Value(depthKey{}).(int); ok {
+		return d
+	}
+	return 0
+}
+
+// Child returns the parent link for a run started by run runID of the given
+// kind executing in ctx. It fails once the chain would exceed MaxDepth, which
+// also stops runs that call themselves.
+func Child(ctx context.Context, kind string, runID int64) (Parent, error) {
+	if kind != KindPipeline && kind != KindWorkflow {
+		return Parent{}, fmt.Errorf("unknown run kind %q", kind)
+	}
+	depth := Depth(ctx) + 1
+	if depth > MaxDepth {
+		return Parent{}, types.Errorf(types.ErrInvalidArgument,
+			"call depth %d exceeds the limit of %d nested runs", depth, MaxDepth)
+	}
+	return Parent{Kind: kind, RunID: runID, Depth: depth}, nil
+}
+
+// Call is a call step: the child to start, its input and whether to wait.
+type Call struct {
+	Kind  string
+	Name  string
+	Input map[string]any
+	// UID is the user the child runs as; empty uses the child's own default.
+	UID string
+	// Wait blocks until the child finishes; false returns once it has started.
+	Wait bool
+}
+
+// Result is what a call step reports about its child run. Templates and
+// later workflow tasks read it as the step result.
+type Result struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	RunID  int64  `json:"run_id"`
+	Status string `json:"status"`
+	// Output holds the child's step or task results; empty when not waited for.
+	Output map[string]any `json:"output,omitempty"`
+}
+
+// Map returns the result as a step result map.
+func (r Result) Map() map[string]any {
+	out := map[string]any{
+		"kind":   r.Kind,
+		"name":   r.Name,
+		"run_id": r.RunID,
+		"status": r.Status,
+	}
+	if r.Output != nil {
+		out["output"] = r.Output
+	}
+	return out
+}
+
+// JSON returns the result as a JSON string, the form workflow task results take.
+func (r Result) JSON() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+// CallFromParams builds a call to the named child from rendered step params.
+// The reserved wait param (default true) is removed; the rest is the child input.
+func CallFromParams(kind, name string, params map[string]any) (Call, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Call{}, fmt.Errorf("%s call requires a name", kind)
+	}
+	input := make(map[string]any, len(params))
+	wait := true
+	for k, v := range params {
+		if k != "wait" {
+			input[k] = v
+			continue
+		}
+		w, err := parseWait(v)
+		if err != nil {
+			return Call{}, err
+		}
+		wait = w
+	}
+	return Call{Kind: kind, Name: name, Input: input, Wait: wait}, nil
+}
+
+func parseWait(v any) (bool, error) {
+	switch w := v.(type) {
+	case nil:
+		return true, nil
+	case bool:
+		return w, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(w)) {
+		case "", "true", "yes":
+			return true, nil
+		case "false", "no":
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("call wait must be true or false, got %v", v)
+}
@@ -0,0 +1,60 @@
+package runcall
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChild(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	parent, err := Child(ctx, KindWorkflow, 7)
+	require.NoError(t, err)
+	assert.Equal(t, Parent{Kind: KindWorkflow, RunID: 7, Depth: 1}, parent)
+
+	parent, err = Child(WithDepth(ctx, MaxDepth-1), KindPipeline, 8)
+	require.NoError(t, err)
+	assert.Equal(t, MaxDepth, parent.Depth)
+
+	_, err = Child(WithDepth(ctx, MaxDepth), KindPipeline, 8)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "call depth")
+
+	_, err = Child(ctx, "job", 1)
+	require.Error(t, err)
+}
+
+func TestCallFromParams(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		params   map[string]any
+		wantWait bool
+		wantErr  bool
+	}{
+		{name: "default waits", params: map[string]any{"url": "u"}, wantWait: true},
+		{name: "bool", params: map[string]any{"url": "u", "wait": false}},
+		{name: "string", params: map[string]any{"url": "u", "wait": "no"}},
+		{name: "invalid", params: map[string]any{"wait": "later"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			call, err := CallFromParams(KindWorkflow, " notify ", tt.params)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "notify", call.Name)
+			assert.Equal(t, tt.wantWait, call.Wait)
+			assert.Equal(t, map[string]any{"url": "u"}, call.Input)
+		})
+	}
+
+	_, err := CallFromParams(KindPipeline, "", nil)
+	require.Error(t, err)
+}
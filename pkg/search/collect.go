@@ -0,0 +1,62 @@
+package search
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/pkg/hub"
+)
+
+const (
+	memoPageSize = 100
+	// maxCollectPages bounds one collector run against a provider.
+	maxCollectPages = 50
+)
+
+// MemoCollector lists memos through the memos capability. Memos emit no
+// DataEvents, so the periodic sync is how they reach the index.
+func MemoCollector(invoke InvokeFunc) Collector {
+	return func(ctx context.Context) ([]Document, error) {
+		var docs []Document
+		cursor := ""
+		for range maxCollectPages {
+			params := map[string]any{"limit": memoPageSize}
+			if cursor != "" {
+				params["cursor"] = cursor
+			}
+			res, err := invoke(ctx, hub.CapMemos, "list", params)
+			if err != nil {
+				return nil, err
+			}
+			if items, ok := toList(res.Data); ok {
+				for _, item := range items {
+					if doc, ok := documentFromRecord(SourceMemo, toRecord(item)); ok && doc.ID != "" {
+						docs = append(docs, doc)
+					}
+				}
+			}
+			if res.Page == nil || res.Page.NextCursor == "" || !res.Page.HasMore {
+				break
+			}
+			cursor = res.Page.NextCursor
+		}
+		return docs, nil
+	}
+}
+
+// toList converts a provider list (typed slice or []any) to generic items.
+func toList(v any) ([]any, bool) {
+	if items, ok := v.([]any); ok {
+		return items, true
+	}
+	var items []any
+	data, err := sonic.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	if err := sonic.Unmarshal(data, &items); err != nil {
+		return nil, false
+	}
+	return items, true
+}
@@ -0,0 +1,208 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// eventSources maps DataEvent type prefixes to document sources.
+var eventSources = []struct {
+	prefix string
+	source string
+}{
+	{"bookmark.", SourceBookmark},
+	{"reader.entry.", SourceFeed},
+	{"kanban.task.", SourceTask},
+	{"forge.issue.", SourceIssue},
+	{"note/", SourceNote},
+	{"memo", SourceMemo},
+}
+
+// capabilitySources maps capabilities to sources for events whose type does
+// not name one, such as polling events.
+var capabilitySources = map[hub.CapabilityType]string{
+	hub.CapKarakeep: SourceBookmark,
+	hub.CapMiniflux: SourceFeed,
+	hub.CapKanboard: SourceTask,
+	hub.CapTrilium:  SourceNote,
+	hub.CapMemos:    SourceMemo,
+}
+
+// hydrateOps names the get operation used to load a document when its event
+// carries only the entity ID.
+var hydrateOps = map[hub.CapabilityType]struct {
+	operation string
+	param     string
+}{
+	hub.CapKarakeep: {"get", "id"},
+	hub.CapTrilium:  {"get", "id"},
+	hub.CapMemos:    {"get", "name"},
+}
+
+// recordKeys are the Data keys that hold the changed item, in lookup order.
+var recordKeys = []string{"item", "entry", "bookmark", "issue", "task", "memo", "note"}
+
+// SourceForEvent returns the document source of a DataEvent, or "" when the
+// event is not indexed.
+func SourceForEvent(de types.DataEvent) string {
+	for _, es := range eventSources {
+		if strings.HasPrefix(de.EventType, es.prefix) {
+			return es.source
+		}
+	}
+	return capabilitySources[hub.CapabilityType(de.Capability)]
+}
+
+// DocumentsFromEvent returns the documents a DataEvent creates or changes and
+// the ones it deletes. Documents of events that carry only an entity ID have
+// an empty title and content; Service.HandleEvent hydrates them.
+func DocumentsFromEvent(de types.DataEvent) (upserts, deletes []Document) {
+	source := SourceForEvent(de)
+	if source == "" {
+		return nil, nil
+	}
+	var records []map[string]any
+	if entries, ok := de.Data["entries"].([]any); ok {
+		for _, e := range entries {
+			if r := toRecord(e); r != nil {
+				records = append(records, r)
+			}
+		}
+	} else {
+		records = append(records, eventRecord(de.Data))
+	}
+
+	for _, r := range records {
+		doc, _ := documentFromRecord(source, r)
+		if doc.ID == "" && len(records) == 1 {
+			doc.ID = de.EntityID
+		}
+		if doc.ID == "" {
+			continue
+		}
+		doc.UID = de.UID
+		if doc.UpdatedAt.IsZero() {
+			doc.UpdatedAt = de.CreatedAt
+		}
+		if strings.HasSuffix(de.EventType, ".deleted") {
+			deletes = append(deletes, Document{Source: source, ID: doc.ID})
+			continue
+		}
+		upserts = append(upserts, doc)
+	}
+	return upserts, deletes
+}
+
+// eventRecord returns the item an event's Data describes. Kanboard nests the
+// task under event_data.
+func eventRecord(data types.KV) map[string]any {
+	for _, key := range recordKeys {
+		if r := toRecord(data[key]); r != nil {
+			return r
+		}
+	}
+	if ed := toRecord(data["event_data"]); ed != nil {
+		if r := toRecord(ed["task"]); r != nil {
+			return r
+		}
+	}
+	return map[string]any{}
+}
+
+// documentFromRecord maps a provider record to a document. ok is false when
+// the record has nothing to search.
+func documentFromRecord(source string, r map[string]any) (Document, bool) {
+	doc := Document{
+		Source:  source,
+		ID:      firstString(r, "id", "noteId", "name", "number"),
+		Title:   firstString(r, "title", "subject"),
+		Content: firstString(r, "content", "body", "description", "summary", "text", "snippet"),
+		URL:     firstString(r, "url", "html_url", "link"),
+		Tags:    stringList(r["tags"]),
+	}
+	if doc.Title == "" {
+		doc.Title = firstLine(doc.Content, 80)
+	}
+	for _, key := range []string{"updated_at", "update_time", "changed_at", "published_at", "created_at"} {
+		if t, ok := r[key].(string); ok {
+			if parsed, err := time.Parse(time.RFC3339, t); err == nil {
+				doc.UpdatedAt = parsed
+				break
+			}
+		}
+	}
+	return doc, doc.Title != "" || doc.Content != ""
+}
+
+// toRecord converts a provider value (map or struct) to a generic map.
+func toRecord(v any) map[string]any {
+	switch m := v.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		return m
+	case types.KV:
+		return m
+	}
+	data, err := sonic.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out map[string]any
+	if err := sonic.Unmarshal(data, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+func firstString(r map[string]any, keys ...string) string {
+	for _, key := range keys {
+		switch v := r[key].(type) {
+		case string:
+			if s := strings.TrimSpace(v); s != "" {
+				return s
+			}
+		case float64:
+			return fmt.Sprintf("%.0f", v)
+		case int, int64:
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+// stringList reads tags given as strings or as objects with a name.
+func stringList(v any) []string {
+	var out []string
+	switch items := v.(type) {
+	case []string:
+		out = append(out, items...)
+	case []any:
+		for _, item := range items {
+			switch t := item.(type) {
+			case string:
+				out = append(out, t)
+			case map[string]any:
+				if name := firstString(t, "name", "title"); name != "" {
+					out = append(out, name)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func firstLine(s string, maxRunes int) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	line = strings.TrimSpace(strings.TrimLeft(line, "# "))
+	if r := []rune(line); len(r) > maxRunes {
+		return string(r[:maxRunes]) + "…"
+	}
+	return line
+}
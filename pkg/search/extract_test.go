@@ -0,0 +1,105 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestDocumentsFromEvent(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		event       types.DataEvent
+		wantUpserts []Document
+		wantDeletes []Document
+	}{
+		{
+			name: "karakeep webhook bookmark",
+			event: types.DataEvent{
+				EventType: "bookmark.created", UID: "u1", CreatedAt: at,
+				Data: types.KV{"bookmark": map[string]any{"id": "bk1", "url": "https://example.com", "title": "Example"}},
+			},
+			wantUpserts: []Document{{Source: SourceBookmark, ID: "bk1", UID: "u1", Title: "Example", URL: "https://example.com", UpdatedAt: at}},
+		},
+		{
+			name: "miniflux entries list",
+			event: types.DataEvent{
+				EventType: "reader.entry.created", CreatedAt: at,
+				Data: types.KV{"entries": []any{
+					map[string]any{"id": float64(10), "title": "A", "content": "alpha"},
+					map[string]any{"id": float64(11), "title": "B"},
+					map[string]any{"title": "no id"},
+				}},
+			},
+			wantUpserts: []Document{
+				{Source: SourceFeed, ID: "10", Title: "A", Content: "alpha", UpdatedAt: at},
+				{Source: SourceFeed, ID: "11", Title: "B", UpdatedAt: at},
+			},
+		},
+		{
+			name: "kanboard nested task",
+			event: types.DataEvent{
+				EventType: "kanban.task.updated", CreatedAt: at,
+				Data: types.KV{"event_data": map[string]any{"task": map[string]any{"id": float64(3), "title": "Ship", "description": "release notes"}}},
+			},
+			wantUpserts: []Document{{Source: SourceTask, ID: "3", Title: "Ship", Content: "release notes", UpdatedAt: at}},
+		},
+		{
+			name: "trilium polling item",
+			event: types.DataEvent{
+				EventType: "note/events.created", Capability: "trilium", CreatedAt: at,
+				Data: types.KV{"item": map[string]any{"noteId": "n1", "title": "Plan"}},
+			},
+			wantUpserts: []Document{{Source: SourceNote, ID: "n1", Title: "Plan", UpdatedAt: at}},
+		},
+		{
+			name: "entity id only",
+			event: types.DataEvent{
+				EventType: "bookmark.created", Capability: "karakeep", EntityID: "bk2", CreatedAt: at,
+			},
+			wantUpserts: []Document{{Source: SourceBookmark, ID: "bk2", UpdatedAt: at}},
+		},
+		{
+			name: "delete",
+			event: types.DataEvent{
+				EventType: "forge.issue.deleted", EntityID: "7",
+			},
+			wantDeletes: []Document{{Source: SourceIssue, ID: "7"}},
+		},
+		{
+			name:  "unindexed event",
+			event: types.DataEvent{EventType: "infra.host.down", EntityID: "h1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			upserts, deletes := DocumentsFromEvent(tt.event)
+			assert.Equal(t, tt.wantUpserts, upserts)
+			assert.Equal(t, tt.wantDeletes, deletes)
+		})
+	}
+}
+
+func TestDocumentFromRecord(t *testing.T) {
+	t.Parallel()
+	doc, ok := documentFromRecord(SourceMemo, map[string]any{
+		"name":        "memos/5",
+		"content":     "# Groceries\nmilk, eggs",
+		"tags":        []any{"home", map[string]any{"name": "list"}},
+		"update_time": "2026-10-02T08:00:00Z",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "memos/5", doc.ID)
+	assert.Equal(t, "Groceries", doc.Title)
+	assert.Equal(t, []string{"home", "list"}, doc.Tags)
+	assert.Equal(t, time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC), doc.UpdatedAt)
+
+	_, ok = documentFromRecord(SourceMemo, map[string]any{"name": "memos/6"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,80 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const snippetRunes = 160
+
+// Tokenize lowercases text and splits it into unique words.
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !slices.Contains(out, f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// toHits converts store matches to results in the store's order. With
+// snippets, Content is cut to the text around the first term.
+func toHits(matches []Match, terms []string, snippets bool) []Hit {
+	out := make([]Hit, 0, len(matches))
+	for _, m := range matches {
+		h := Hit{
+			ID:        m.ID,
+			Title:     m.Title,
+			Source:    m.Source,
+			URL:       m.URL,
+			Score:     m.Rank,
+			UpdatedAt: m.UpdatedAt,
+		}
+		if snippets {
+			h.Content = snippet(m.Content, terms)
+			h.Tags = m.Tags
+		}
+		out = append(out, h)
+	}
+	return out
+}
+
+// snippet returns about snippetRunes of content around the first term match.
+func snippet(content string, terms []string) string {
+	runes := []rune(strings.Join(strings.Fields(content), " "))
+	if len(runes) <= snippetRunes {
+		return string(runes)
+	}
+	lower := []rune(strings.ToLower(string(runes)))
+	at := -1
+	for _, term := range terms {
+		if i := runeIndex(lower, []rune(term)); i >= 0 && (at < 0 || i < at) {
+			at = i
+		}
+	}
+	start := max(at-snippetRunes/4, 0)
+	end := min(start+snippetRunes, len(runes))
+	start = max(end-snippetRunes, 0)
+	out := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		out = "…" + out
+	}
+	if end < len(runes) {
+		out += "…"
+	}
+	return out
+}
+
+func runeIndex(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if slices.Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
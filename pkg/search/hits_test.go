@@ -0,0 +1,51 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"go", "generics", "1", "22"}, Tokenize("Go generics, go 1.22!"))
+	assert.Empty(t, Tokenize(" -- "))
+}
+
+func hitIDs(hits []Hit) []string {
+	ids := make([]string, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+func TestToHits(t *testing.T) {
+	t.Parallel()
+	matches := []Match{
+		{Document: Document{ID: "b", Title: "Second", Content: "body", Tags: []string{"x"}}, Rank: 0.75},
+		{Document: Document{ID: "a", Title: "First", Content: "body"}, Rank: 0.5},
+	}
+	hits := toHits(matches, []string{"body"}, true)
+	assert.Equal(t, []string{"b", "a"}, hitIDs(hits), "store order is kept")
+	assert.InDelta(t, 0.75, hits[0].Score, 1e-9)
+	assert.Equal(t, "body", hits[0].Content)
+	assert.Equal(t, []string{"x"}, hits[0].Tags)
+
+	hits = toHits(matches, []string{"body"}, false)
+	assert.Empty(t, hits[0].Content)
+	assert.Empty(t, hits[0].Tags)
+}
+
+func TestSnippet(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "short text", snippet("short \n text", []string{"x"}))
+
+	long := strings.Repeat("lorem ", 60) + "needle " + strings.Repeat("ipsum ", 60)
+	s := snippet(long, []string{"needle"})
+	assert.Contains(t, s, "needle")
+	assert.True(t, strings.HasPrefix(s, "…"))
+	assert.True(t, strings.HasSuffix(s, "…"))
+	assert.LessOrEqual(t, len([]rune(s)), snippetRunes+2)
+}
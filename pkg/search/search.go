@@ -0,0 +1,331 @@
+// Package search maintains the unified full-text index over bookmarks, feed
+// entries, memos, notes, tasks, issues, clips and agent knowledge. Documents
+// arrive from DataEvents and from periodic collectors; queries are matched and
+// ranked by the store's full-text index.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// Document sources.
+const (
+	SourceBookmark  = "bookmark"
+	SourceFeed      = "feed"
+	SourceMemo      = "memo"
+	SourceNote      = "note"
+	SourceTask      = "task"
+	SourceIssue     = "issue"
+	SourceClip      = "clip"
+	SourceKnowledge = "knowledge"
+)
+
+// Sources lists every document source in display order.
+var Sources = []string{
+	SourceBookmark, SourceFeed, SourceMemo, SourceNote,
+	SourceTask, SourceIssue, SourceClip, SourceKnowledge,
+}
+
+const (
+	defaultQueryLimit        = 20
+	maxQueryLimit            = 100
+	defaultAutocompleteLimit = 8
+	maxAutocompleteLimit     = 20
+	// maxTerms bounds the store predicates built for one query.
+	maxTerms = 8
+)
+
+// Document is one indexed item.
+type Document struct {
+	Source string `json:"source"`
+	// ID is the item's ID in its source, e.g. a bookmark ID or clip slug.
+	ID string `json:"id"`
+	// UID scopes the document to one user; empty means visible to all.
+	UID       string    `json:"uid,omitempty"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Hit is one ranked query result. Content is a snippet around the first match.
+type Hit struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content,omitempty"`
+	Source    string    `json:"source"`
+	URL       string    `json:"url,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	Score     float64   `json:"score"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Query is a search request.
+type Query struct {
+	Text string
+	// Sources restricts results; empty searches every source.
+	Sources []string
+	// UID sees its own documents plus unscoped ones.
+	UID   string
+	Limit int
+}
+
+// Match is a document found by the store with its full-text rank; a higher
+// rank is a better match.
+type Match struct {
+	Document
+	Rank float64
+}
+
+// Filter selects documents in the store.
+type Filter struct {
+	// Terms must each appear as a word or word prefix in the title, tags, URL
+	// or content; with TitleOnly, in the title.
+	Terms     []string
+	TitleOnly bool
+	Sources   []string
+	UID       string
+	Limit     int
+}
+
+// Store persists the index.
+type Store interface {
+	UpsertSearchDocuments(ctx context.Context, docs []Document) error
+	DeleteSearchDocuments(ctx context.Context, source string, ids []string) error
+	// PruneSearchDocuments deletes documents of source last indexed before t.
+	PruneSearchDocuments(ctx context.Context, source string, before time.Time) (int, error)
+	// FindSearchDocuments returns at most f.Limit matches, best first.
+	FindSearchDocuments(ctx context.Context, f Filter) ([]Match, error)
+}
+
+// InvokeFunc calls a capability operation; capability.Invoke in production.
+type InvokeFunc func(ctx context.Context, capType hub.CapabilityType, operation string, params map[string]any) (*capability.InvokeResult, error)
+
+// Collector returns every current document of one source. Sync indexes them
+// and prunes documents the collector no longer returns.
+type Collector func(ctx context.Context) ([]Document, error)
+
+// Service indexes documents and answers queries.
+type Service struct {
+	store      Store
+	invoke     InvokeFunc
+	collectors map[string]Collector
+	now        func() time.Time
+}
+
+// NewService creates a Service over store.
+func NewService(store Store) *Service {
+	return &Service{store: store, collectors: map[string]Collector{}, now: time.Now}
+}
+
+// WithInvoker sets how events that carry only an entity ID are hydrated.
+func (s *Service) WithInvoker(invoke InvokeFunc) *Service {
+	s.invoke = invoke
+	return s
+}
+
+// WithCollector registers the collector of one source.
+func (s *Service) WithCollector(source string, c Collector) *Service {
+	s.collectors[source] = c
+	return s
+}
+
+var (
+	activeMu sync.RWMutex
+	active   *Service
+)
+
+// SetActiveService sets the service used by the HTTP API and agent tool.
+func SetActiveService(s *Service) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = s
+}
+
+// ActiveService returns the running service, or nil before initialization.
+func ActiveService() *Service {
+	activeMu.RLock()
+	defer activeMu.RUnlock()
+	return active
+}
+
+// ParseSources splits a comma-separated source list and checks each name.
+func ParseSources(raw string) ([]string, error) {
+	var out []string
+	for part := range strings.SplitSeq(raw, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		if !slices.Contains(Sources, part) {
+			return nil, types.Errorf(types.ErrInvalidArgument, "unknown search source %q (want one of %s)", part, strings.Join(Sources, ", "))
+		}
+		if !slices.Contains(out, part) {
+			out = append(out, part)
+		}
+	}
+	return out, nil
+}
+
+// HandleEvent indexes the documents a DataEvent creates or changes and drops
+// the ones it deletes. Events from other sources are ignored.
+func (s *Service) HandleEvent(ctx context.Context, de types.DataEvent) error {
+	upserts, deletes := DocumentsFromEvent(de)
+	for _, d := range deletes {
+		if err := s.store.DeleteSearchDocuments(ctx, d.Source, []string{d.ID}); err != nil {
+			return fmt.Errorf("search: delete %s %s: %w", d.Source, d.ID, err)
+		}
+	}
+	docs := make([]Document, 0, len(upserts))
+	for _, d := range upserts {
+		if d.Title == "" && d.Content == "" {
+			d = s.hydrate(ctx, de, d)
+		}
+		if d.Title == "" && d.Content == "" {
+			continue
+		}
+		if d.UpdatedAt.IsZero() {
+			d.UpdatedAt = s.now()
+		}
+		docs = append(docs, d)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	if err := s.store.UpsertSearchDocuments(ctx, docs); err != nil {
+		return fmt.Errorf("search: index %s event %s: %w", de.EventType, de.EventID, err)
+	}
+	return nil
+}
+
+// hydrate loads a document whose event carried only its ID through the
+// source capability's get operation.
+func (s *Service) hydrate(ctx context.Context, de types.DataEvent, d Document) Document {
+	spec, ok := hydrateOps[hub.CapabilityType(de.Capability)]
+	if !ok || s.invoke == nil || d.ID == "" {
+		return d
+	}
+	res, err := s.invoke(ctx, hub.CapabilityType(de.Capability), spec.operation, map[string]any{spec.param: d.ID})
+	if err != nil || res == nil {
+		flog.Debug("search: hydrate %s %s: %v", de.Capability, d.ID, err)
+		return d
+	}
+	full, ok := documentFromRecord(d.Source, toRecord(res.Data))
+	if !ok {
+		return d
+	}
+	full.UID = d.UID
+	full.UpdatedAt = d.UpdatedAt
+	if full.ID == "" {
+		full.ID = d.ID
+	}
+	return full
+}
+
+// Sync runs every collector, indexes what it returns and prunes documents of
+// that source it no longer returns. A failing collector leaves its source as is.
+func (s *Service) Sync(ctx context.Context) error {
+	names := make([]string, 0, len(s.collectors))
+	for name := range s.collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, source := range names {
+		started := s.now()
+		docs, err := s.collectors[source](ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("collect %s: %w", source, err))
+			continue
+		}
+		for i := range docs {
+			docs[i].Source = source
+			if docs[i].UpdatedAt.IsZero() {
+				docs[i].UpdatedAt = started
+			}
+		}
+		if len(docs) > 0 {
+			if err := s.store.UpsertSearchDocuments(ctx, docs); err != nil {
+				errs = append(errs, fmt.Errorf("index %s: %w", source, err))
+				continue
+			}
+		}
+		if _, err := s.store.PruneSearchDocuments(ctx, source, started); err != nil {
+			errs = append(errs, fmt.Errorf("prune %s: %w", source, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("search sync: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Query returns documents matching every term of q.Text, best first.
+func (s *Service) Query(ctx context.Context, q Query) ([]Hit, error) {
+	terms, err := queryTerms(q.Text)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.store.FindSearchDocuments(ctx, Filter{
+		Terms:   terms,
+		Sources: q.Sources,
+		UID:     q.UID,
+		Limit:   clampLimit(q.Limit, defaultQueryLimit, maxQueryLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search: query: %w", err)
+	}
+	return toHits(matches, terms, true), nil
+}
+
+// Autocomplete returns documents whose title words start with the query
+// terms, for type-ahead. The last term may be a partial word.
+func (s *Service) Autocomplete(ctx context.Context, q Query) ([]Hit, error) {
+	terms, err := queryTerms(q.Text)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.store.FindSearchDocuments(ctx, Filter{
+		Terms:     terms,
+		TitleOnly: true,
+		Sources:   q.Sources,
+		UID:       q.UID,
+		Limit:     clampLimit(q.Limit, defaultAutocompleteLimit, maxAutocompleteLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search: autocomplete: %w", err)
+	}
+	return toHits(matches, terms, false), nil
+}
+
+func queryTerms(text string) ([]string, error) {
+	terms := Tokenize(text)
+	if len(terms) == 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "query is required")
+	}
+	if len(terms) > maxTerms {
+		terms = terms[:maxTerms]
+	}
+	return terms, nil
+}
+
+func clampLimit(limit, def, maxLimit int) int {
+	if limit <= 0 {
+		return def
+	}
+	return min(limit, maxLimit)
+}
@@ -0,0 +1,204 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// memStore is an in-memory Store keyed by source and ID.
+type memStore struct {
+	mu      sync.Mutex
+	docs    map[string]Document
+	indexed map[string]time.Time
+	now     func() time.Time
+}
+
+func newMemStore(now func() time.Time) *memStore {
+	return &memStore{docs: map[string]Document{}, indexed: map[string]time.Time{}, now: now}
+}
+
+func (m *memStore) UpsertSearchDocuments(_ context.Context, docs []Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range docs {
+		m.docs[d.Source+":"+d.ID] = d
+		m.indexed[d.Source+":"+d.ID] = m.now()
+	}
+	return nil
+}
+
+func (m *memStore) DeleteSearchDocuments(_ context.Context, source string, ids []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range ids {
+		delete(m.docs, source+":"+id)
+	}
+	return nil
+}
+
+func (m *memStore) PruneSearchDocuments(_ context.Context, source string, before time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for key, d := range m.docs {
+		if d.Source == source && m.indexed[key].Before(before) {
+			delete(m.docs, key)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// FindSearchDocuments matches terms as word prefixes and orders by ID.
+func (m *memStore) FindSearchDocuments(_ context.Context, f Filter) ([]Match, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Match
+	for _, d := range m.docs {
+		if d.UID != "" && d.UID != f.UID {
+			continue
+		}
+		if len(f.Sources) > 0 && !slices.Contains(f.Sources, d.Source) {
+			continue
+		}
+		text := d.Title
+		if !f.TitleOnly {
+			text += " " + d.Content + " " + d.URL + " " + strings.Join(d.Tags, " ")
+		}
+		words := Tokenize(text)
+		matched := true
+		for _, term := range f.Terms {
+			if !slices.ContainsFunc(words, func(w string) bool { return strings.HasPrefix(w, term) }) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			out = append(out, Match{Document: d})
+		}
+	}
+	slices.SortFunc(out, func(a, b Match) int { return strings.Compare(a.ID, b.ID) })
+	if f.Limit > 0 && len(out) > f.Limit {
+		out = out[:f.Limit]
+	}
+	return out, nil
+}
+
+func (m *memStore) ids() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.docs))
+	for key := range m.docs {
+		ids = append(ids, key)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func TestParseSources(t *testing.T) {
+	t.Parallel()
+	got, err := ParseSources(" Memo, clip,,memo ")
+	require.NoError(t, err)
+	assert.Equal(t, []string{SourceMemo, SourceClip}, got)
+
+	_, err = ParseSources("memo,wiki")
+	assert.ErrorIs(t, err, types.ErrInvalidArgument)
+}
+
+func TestServiceHandleEvent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := newMemStore(time.Now)
+	var calls []string
+	svc := NewService(store).WithInvoker(func(_ context.Context, capType hub.CapabilityType, operation string, params map[string]any) (*capability.InvokeResult, error) {
+		calls = append(calls, string(capType)+"."+operation)
+		return &capability.InvokeResult{Data: map[string]any{"id": params["id"], "title": "Hydrated", "url": "https://h.example"}}, nil
+	})
+
+	require.NoError(t, svc.HandleEvent(ctx, types.DataEvent{
+		EventType: "bookmark.created", Capability: string(hub.CapKarakeep), EntityID: "bk1",
+	}))
+	assert.Equal(t, []string{"karakeep.get"}, calls)
+	assert.Equal(t, []string{"bookmark:bk1"}, store.ids())
+
+	hits, err := svc.Query(ctx, Query{Text: "hydrated"})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "https://h.example", hits[0].URL)
+
+	require.NoError(t, svc.HandleEvent(ctx, types.DataEvent{
+		EventType: "bookmark.deleted", Capability: string(hub.CapKarakeep), EntityID: "bk1",
+	}))
+	assert.Empty(t, store.ids())
+
+	// Without a hydrator an empty document is skipped.
+	require.NoError(t, NewService(store).HandleEvent(ctx, types.DataEvent{
+		EventType: "bookmark.created", EntityID: "bk2",
+	}))
+	assert.Empty(t, store.ids())
+}
+
+func TestServiceSync(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	clock := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time { return clock }
+	store := newMemStore(now)
+	svc := NewService(store)
+	svc.now = now
+
+	clips := []Document{{ID: "a", Title: "A"}, {ID: "b", Title: "B"}}
+	svc.WithCollector(SourceClip, func(context.Context) ([]Document, error) { return clips, nil })
+	svc.WithCollector(SourceMemo, func(context.Context) ([]Document, error) { return nil, errors.New("memos down") })
+	require.NoError(t, store.UpsertSearchDocuments(ctx, []Document{{Source: SourceMemo, ID: "m", Title: "kept"}}))
+
+	err := svc.Sync(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collect memo: memos down")
+	assert.Equal(t, []string{"clip:a", "clip:b", "memo:m"}, store.ids())
+
+	clock = clock.Add(time.Minute)
+	clips = []Document{{ID: "b", Title: "B"}}
+	require.Error(t, svc.Sync(ctx))
+	assert.Equal(t, []string{"clip:b", "memo:m"}, store.ids())
+}
+
+func TestServiceQueryAndAutocomplete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := newMemStore(time.Now)
+	svc := NewService(store)
+	require.NoError(t, store.UpsertSearchDocuments(ctx, []Document{
+		{Source: SourceNote, ID: "n1", Title: "Deploy runbook", Content: "steps to deploy"},
+		{Source: SourceClip, ID: "c1", Title: "Deployment logs", UID: "alice"},
+		{Source: SourceTask, ID: "t1", Title: "Fix deploy script", Content: "deploy fails"},
+	}))
+
+	_, err := svc.Query(ctx, Query{Text: "  "})
+	assert.ErrorIs(t, err, types.ErrInvalidArgument)
+
+	hits, err := svc.Query(ctx, Query{Text: "deploy", Sources: []string{SourceNote, SourceTask}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"n1", "t1"}, hitIDs(hits))
+
+	hits, err = svc.Autocomplete(ctx, Query{Text: "depl", UID: "alice"})
+	require.NoError(t, err)
+	// Hits keep the store's order.
+	assert.Equal(t, []string{"c1", "n1", "t1"}, hitIDs(hits))
+
+	hits, err = svc.Query(ctx, Query{Text: "deploy", UID: "alice", Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, hits, 2, "the limit is passed to the store")
+}
@@ -0,0 +1,42 @@
+package types
+
+import "time"
+
+// ClipAttachment is a file stored through the media handler and listed under a clip.
+type ClipAttachment struct {
+	Name     string `json:"name"`
+	FileID   string `json:"file_id"`
+	MimeType string `json:"mime_type,omitempty"`
+	Size     int64  `json:"size"`
+	// Location is the handler-specific storage location passed to media Delete.
+	Location string `json:"location,omitempty"`
+}
+
+// ClipState is the lifecycle state of a shared clip.
+type ClipState string
+
+const (
+	// ClipActive clips are served to readers that pass the access checks.
+	ClipActive ClipState = "active"
+	// ClipExpired clips passed their expires_at and are no longer served.
+	ClipExpired ClipState = "expired"
+	// ClipBurned clips were read once with burn-after-read; their body is gone.
+	ClipBurned ClipState = "burned"
+	// ClipExhausted clips reached their view limit.
+	ClipExhausted ClipState = "exhausted"
+)
+
+// ClipStateAt derives the clip state from its limits at now. Burned wins over
+// expired so a burned clip never reports a body that could come back.
+func ClipStateAt(expiresAt, burnedAt *time.Time, maxViews, viewCount int, now time.Time) ClipState {
+	switch {
+	case burnedAt != nil:
+		return ClipBurned
+	case expiresAt != nil && !now.Before(*expiresAt):
+		return ClipExpired
+	case maxViews > 0 && viewCount >= maxViews:
+		return ClipExhausted
+	default:
+		return ClipActive
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 // GatewayCLI identifies which local CLI a gateway job should run.
 type GatewayCLI string
@@ -10,6 +13,16 @@ const (
 	GatewayCLIOpenCode GatewayCLI = "opencode"
 )
 
+var gatewayCLIPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+// ValidGatewayCLI reports whether cli is a well-formed runner name. Besides the
+// built-in cursor and opencode runners, workers may register generic command
+// runners under any such name; the server cannot know which ones exist, so
+// jobs for unknown names simply stay pending until a matching worker claims them.
+func ValidGatewayCLI(cli GatewayCLI) bool {
+	return gatewayCLIPattern.MatchString(string(cli))
+}
+
 // GatewayJobStatus is the lifecycle state of a local-CLI gateway job.
 type GatewayJobStatus string
 
@@ -42,6 +55,8 @@ type GatewayCreateJob struct {
 // GatewayClaimRequest is the body for POST /gateway/v1/claim.
 type GatewayClaimRequest struct {
 	WorkerID string `json:"worker_id"`
+	// CLIs limits the claim to jobs this worker has runners for. Empty claims any job.
+	CLIs []GatewayCLI `json:"clis,omitempty"`
 }
 
 // GatewayClaimResponse is returned when a job is claimed (or empty when none).
@@ -66,6 +81,28 @@ type GatewayHeartbeatRequest struct {
 	JobID    string `json:"job_id,omitempty"`
 }
 
+// GatewayLogAppendRequest is the body for POST /gateway/v1/jobs/{id}/logs.
+type GatewayLogAppendRequest struct {
+	WorkerID string `json:"worker_id"`
+	Content  string `json:"content"`
+}
+
+// GatewayLogChunk is one streamed slice of a running job's combined output.
+// Seq starts at 1 and increases by one per stored chunk of the job.
+type GatewayLogChunk struct {
+	JobID     string    `json:"job_id"`
+	Seq       int64     `json:"seq"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GatewayLogsResponse is returned by GET /gateway/v1/jobs/{id}/logs.
+type GatewayLogsResponse struct {
+	Chunks []GatewayLogChunk `json:"chunks"`
+	// Status lets pollers stop once the job is terminal and no chunks remain.
+	Status GatewayJobStatus `json:"status"`
+}
+
 // GatewayJob is the shared job view for store, HTTP, and capability.
 type GatewayJob struct {
 	JobID      string           `json:"job_id"`
@@ -80,8 +117,10 @@ type GatewayJob struct {
 	Truncated  bool             `json:"truncated,omitempty"`
 	DurationMs int64            `json:"duration_ms,omitempty"`
 	WorkerID   string           `json:"worker_id,omitempty"`
-	LeaseUntil *time.Time       `json:"lease_until,omitempty"`
-	CreatedAt  time.Time        `json:"created_at"`
-	ClaimedAt  *time.Time       `json:"claimed_at,omitempty"`
-	FinishedAt *time.Time       `json:"finished_at,omitempty"`
+	// LogSeq is the seq of the newest streamed log chunk (0 when none).
+	LogSeq     int64      `json:"log_seq,omitempty"`
+	LeaseUntil *time.Time `json:"lease_until,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ClaimedAt  *time.Time `json:"claimed_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
 }
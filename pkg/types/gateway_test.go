@@ -29,3 +29,27 @@ func TestGatewayJobTerminal(t *testing.T) {
 		})
 	}
 }
+
+func TestValidGatewayCLI(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		cli  types.GatewayCLI
+		want bool
+	}{
+		{name: "cursor", cli: types.GatewayCLICursor, want: true},
+		{name: "opencode", cli: types.GatewayCLIOpenCode, want: true},
+		{name: "generic", cli: "flowbot-agent", want: true},
+		{name: "dotted", cli: "aider.v2", want: true},
+		{name: "empty", cli: "", want: false},
+		{name: "uppercase", cli: "Aider", want: false},
+		{name: "space", cli: "my cli", want: false},
+		{name: "leading dash", cli: "-x", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, types.ValidGatewayCLI(tt.cli))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// AgentMCPServer is the health of one external MCP server for the agents page.
+type AgentMCPServer struct {
+	Name        string    `json:"name"`
+	Transport   string    `json:"transport"`
+	Agents      []string  `json:"agents"`
+	State       string    `json:"state"`
+	Error       string    `json:"error,omitempty"`
+	Tools       []string  `json:"tools"`
+	Dropped     []string  `json:"dropped,omitempty"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
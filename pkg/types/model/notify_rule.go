@@ -11,6 +11,7 @@ type NotifyRule struct {
 	EventPattern   string    `json:"event_pattern"`
 	ChannelPattern string    `json:"channel_pattern"`
 	Condition      string    `json:"condition"`
+	Timezone       string    `json:"timezone"`
 	Priority       int       `json:"priority"`
 	ParamsJSON     string    `json:"params_json"` // JSON string for form display
 	Enabled        bool      `json:"enabled"`
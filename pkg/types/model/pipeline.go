@@ -26,6 +26,12 @@ type PipelineRun struct {
 	CreatedAt     time.Time  `json:"created_at"`
 	StartedAt     time.Time  `json:"started_at"`
 	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+	// ParentKind and ParentRunID name the run whose call step started this one.
+	ParentKind  string `json:"parent_kind,omitempty"`
+	ParentRunID *int64 `json:"parent_run_id,omitempty"`
+	// CallDepth is 0 for top-level runs and counts nested calls otherwise.
+	CallDepth int `json:"call_depth,omitempty"`
 }
 
 // PipelineStepRun is a pipeline step run row for UI and engine persistence.
@@ -25,26 +25,35 @@ type WorkflowTrigger struct {
 
 // WorkflowTaskRow is a normalized workflow task row used to rebuild metadata.
 type WorkflowTaskRow struct {
-	TaskID   string         `json:"task_id"`
-	Action   string         `json:"action"`
-	Describe string         `json:"describe,omitempty"`
-	Params   map[string]any `json:"params,omitempty"`
-	Vars     []string       `json:"vars,omitempty"`
-	Conn     []string       `json:"conn,omitempty"`
-	Retry    map[string]any `json:"retry,omitempty"`
+	TaskID   string           `json:"task_id"`
+	Action   string           `json:"action"`
+	Describe string           `json:"describe,omitempty"`
+	Params   map[string]any   `json:"params,omitempty"`
+	Vars     []string         `json:"vars,omitempty"`
+	Conn     []string         `json:"conn,omitempty"`
+	Retry    map[string]any   `json:"retry,omitempty"`
+	Outputs  []map[string]any `json:"outputs,omitempty"`
 }
 
 // WorkflowRun is a workflow run row for UI and engine persistence.
 type WorkflowRun struct {
-	ID           int64      `json:"id"`
-	WorkflowID   *int64     `json:"workflow_id,omitempty"`
-	WorkflowName string     `json:"workflow_name,omitempty"`
-	Status       int        `json:"status"`
-	TriggerType  string     `json:"trigger_type,omitempty"`
-	StartedAt    time.Time  `json:"started_at"`
-	CreatedAt    time.Time  `json:"created_at"`
-	CompletedAt  *time.Time `json:"completed_at,omitempty"`
-	Error        string     `json:"error,omitempty"`
+	ID            int64      `json:"id"`
+	WorkflowID    *int64     `json:"workflow_id,omitempty"`
+	WorkflowName  string     `json:"workflow_name,omitempty"`
+	Status        int        `json:"status"`
+	TriggerType   string     `json:"trigger_type,omitempty"`
+	StartedAt     time.Time  `json:"started_at"`
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	// InputParams are the validated inputs the run started with.
+	InputParams map[string]any `json:"input_params,omitempty"`
+	// ParentKind and ParentRunID name the run whose call step started this one.
+	ParentKind  string `json:"parent_kind,omitempty"`
+	ParentRunID *int64 `json:"parent_run_id,omitempty"`
+	// CallDepth is 0 for top-level runs and counts nested calls otherwise.
+	CallDepth int `json:"call_depth,omitempty"`
 }
 
 // WorkflowStepRun is a workflow step run row for UI and engine persistence.
@@ -11,6 +11,12 @@ const (
 	PipelineDone
 	PipelineCancel
 	PipelineFailed
+	// PipelineSkipped marks a step whose when: condition evaluated false.
+	PipelineSkipped
+	// PipelineCompensated marks a failed step whose on_failure steps all succeeded.
+	PipelineCompensated
+	// PipelineWaiting marks a run or approval step suspended until a person decides.
+	PipelineWaiting
 )
 
 // Value implements driver.Valuer for database persistence.
@@ -26,6 +32,10 @@ const (
 	WorkflowRunRunning
 	WorkflowRunDone
 	WorkflowRunFailed
+	// WorkflowRunCancelled marks a run or step stopped by a cancel request.
+	WorkflowRunCancelled
+	// WorkflowRunWaiting marks a run or approval step suspended until a person decides.
+	WorkflowRunWaiting
 )
 
 // Value implements driver.Valuer for database persistence.
@@ -44,6 +44,9 @@ type Task struct {
 	Timeout     string            `json:"timeout,omitempty"`
 	Result      string            `json:"result,omitempty"`
 	GPUs        string            `json:"gpus,omitempty"`
+	// Outputs are workdir-relative paths collected into Artifacts after a successful run.
+	Outputs   []string          `json:"outputs,omitempty"`
+	Artifacts map[string]string `json:"artifacts,omitempty"`
 }
 
 type TaskRetry struct {
@@ -103,6 +106,8 @@ func (t *Task) Clone() *Task {
 		Timeout:     t.Timeout,
 		Result:      t.Result,
 		GPUs:        t.GPUs,
+		Outputs:     slices.Clone(t.Outputs),
+		Artifacts:   maps.Clone(t.Artifacts),
 	}
 }
 
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // TokenUsageStats holds aggregated LLM token usage for chart rendering.
 type TokenUsageStats struct {
 	Summary     TokenUsageSummary  `json:"summary"`
@@ -43,4 +45,30 @@ type LLMUsageRecordInput struct {
 	CacheRead        int
 	CacheWrite       int
 	Source           string
+	// Provider is the provider that served Model.
+	Provider string
+	// Agent is "chat" or the subagent name that made the call.
+	Agent string
+	// TaskID is the scheduled task id when the call ran for one.
+	TaskID string
+	// PipelineStep is "pipeline/step" when the call ran for a pipeline agent_run step.
+	PipelineStep string
+	// Cost is the estimated USD cost from model pricing.
+	Cost float64
+}
+
+// LLMUsageFilter selects usage rows for one budget subject since a window start.
+// Empty fields are not filtered.
+type LLMUsageFilter struct {
+	UID          string
+	Agent        string
+	TaskID       string
+	PipelineStep string
+	Since        time.Time
+}
+
+// LLMUsageTotal is the summed tokens and estimated cost of matching usage rows.
+type LLMUsageTotal struct {
+	Tokens int64
+	Cost   float64
 }
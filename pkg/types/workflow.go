@@ -89,4 +89,22 @@ type WorkflowTask struct {
 	Vars     []string     `json:"vars,omitempty" yaml:"vars"`
 	Conn     []string     `json:"conn,omitempty" yaml:"conn"`
 	Retry    *RetryConfig `json:"retry,omitempty" yaml:"retry,omitempty"`
+	// Outputs lists files a docker or shell task publishes as run artifacts.
+	Outputs []WorkflowOutputDef `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+}
+
+// WorkflowOutputDef declares one file a task publishes after it succeeds.
+type WorkflowOutputDef struct {
+	Name string `json:"name" yaml:"name"`
+	Path string `json:"path" yaml:"path"` // relative to the task workdir
+}
+
+// WorkflowArtifact is a task output stored through the media handler.
+type WorkflowArtifact struct {
+	Task     string `json:"task"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	FileID   string `json:"file_id"`
+	MimeType string `json:"mime_type,omitempty"`
+	Size     int64  `json:"size"`
 }
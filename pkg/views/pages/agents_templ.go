@@ -17,7 +17,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/views/partials"
 )
 
-func AgentsPage(ctx context.Context, items []model.AgentSession, nextCursor string, endpoints partials.ChatAgentEndpoints, chatEnabled bool) templ.Component {
+func AgentsPage(ctx context.Context, items []model.AgentSession, nextCursor string, endpoints partials.ChatAgentEndpoints, chatEnabled bool, mcpServers []model.AgentMCPServer) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
@@ -58,6 +58,12 @@ func AgentsPage(ctx context.Context, items []model.AgentSession, nextCursor stri
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
+			if chatEnabled && len(mcpServers) > 0 {
+				templ_7745c5c3_Err = partials.ChatAgentMCPServers(ctx, mcpServers).Render(ctx, templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
 			if chatEnabled {
 				templ_7745c5c3_Err = partials.ChatAgentSessionList(ctx, items, nextCursor, endpoints).Render(ctx, templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
@@ -0,0 +1,22 @@
+package pages
+
+import "time"
+
+// ApprovalData holds one approval gate of a pipeline or workflow run for the
+// approval page. ActionPath is the page path; decisions post to ActionPath/<decision>.
+type ApprovalData struct {
+	ActionPath  string
+	RunLabel    string
+	RunURL      string
+	Step        string
+	Message     string
+	Default     string
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+	Pending     bool
+	Decision    string
+	DecidedBy   string
+	DecidedAt   time.Time
+	// Highlight is the decision picked from a notification link (?decision=).
+	Highlight string
+}
@@ -3,22 +3,47 @@ package pages
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/flowline-io/flowbot/pkg/i18n"
 )
 
+const clipDateLayout = "Jan 2, 2006, 3:04 PM UTC"
+
 // clipPageTitle returns the HTML document title for a clip page.
 func clipPageTitle(ctx context.Context, d ClipPageData) string {
-	if d.NotFound {
+	switch {
+	case d.NotFound:
 		return i18n.T(ctx, "clip.not_found.title")
-	}
-	if d.Title == "" {
+	case d.Gone:
+		return i18n.T(ctx, "clip.gone.title")
+	case d.LinkInvalid:
+		return i18n.T(ctx, "clip.link_invalid.title")
+	case d.Protected && !d.ShowBody:
+		return i18n.T(ctx, "clip.protected.title")
+	case d.Title == "":
 		return i18n.T(ctx, "clip.default_title")
 	}
 	return d.Title
 }
 
+// clipPageDescription returns the meta description; protected clips do not
+// leak theirs to link previews.
+func clipPageDescription(ctx context.Context, d ClipPageData) string {
+	switch {
+	case d.NotFound:
+		return i18n.T(ctx, "clip.not_found.meta")
+	case d.Gone:
+		return clipGoneMessage(ctx, d.State)
+	case d.LinkInvalid:
+		return i18n.T(ctx, "clip.link_invalid.meta")
+	case d.Protected && !d.ShowBody:
+		return i18n.T(ctx, "clip.protected.meta")
+	}
+	return d.Description
+}
+
 // formatClipMeta formats created-at and word-count for the clip subtitle line.
 func formatClipMeta(ctx context.Context, createdAt time.Time, wordCount int) string {
 	if createdAt.IsZero() {
@@ -27,9 +52,71 @@ func formatClipMeta(ctx context.Context, createdAt time.Time, wordCount int) str
 		}
 		return i18n.TData(ctx, "clip.meta.words_only", map[string]any{"Count": wordCount})
 	}
-	stamp := createdAt.UTC().Format("Jan 2, 2006, 3:04 PM UTC")
+	stamp := createdAt.UTC().Format(clipDateLayout)
 	if wordCount <= 0 {
 		return i18n.TData(ctx, "clip.meta.date_only", map[string]any{"Date": stamp})
 	}
 	return i18n.TData(ctx, "clip.meta.date_words", map[string]any{"Date": stamp, "Count": wordCount})
 }
+
+// clipStateLabel returns the localized label for a clip state.
+func clipStateLabel(ctx context.Context, state string) string {
+	if state == "" {
+		state = "active"
+	}
+	return i18n.T(ctx, "clip.state."+state)
+}
+
+// clipGoneMessage explains why a clip is no longer served.
+func clipGoneMessage(ctx context.Context, state string) string {
+	return i18n.T(ctx, "clip.gone."+state)
+}
+
+// formatClipViews formats the view count, with the limit when one is set.
+func formatClipViews(ctx context.Context, views, maxViews int) string {
+	if maxViews > 0 {
+		return i18n.TData(ctx, "clip.status.views_limit", map[string]any{"Count": views, "Max": maxViews})
+	}
+	return i18n.TData(ctx, "clip.status.views", map[string]any{"Count": views})
+}
+
+// formatClipExpiry formats the expiry chip.
+func formatClipExpiry(ctx context.Context, expiresAt time.Time) string {
+	return i18n.TData(ctx, "clip.status.expires", map[string]any{"Date": expiresAt.UTC().Format(clipDateLayout)})
+}
+
+// formatClipVersion formats the version chip with the last edit time.
+func formatClipVersion(ctx context.Context, version int, updatedAt *time.Time) string {
+	if updatedAt == nil {
+		return i18n.TData(ctx, "clip.status.version", map[string]any{"Version": version})
+	}
+	return i18n.TData(ctx, "clip.status.version_edited", map[string]any{
+		"Version": version,
+		"Date":    updatedAt.UTC().Format(clipDateLayout),
+	})
+}
+
+// clipOpenHint tells the reader what opening the clip costs.
+func clipOpenHint(ctx context.Context, d ClipPageData) string {
+	switch {
+	case d.BurnAfterRead:
+		return i18n.T(ctx, "clip.open.hint_burn")
+	case d.MaxViews > 0:
+		return i18n.TData(ctx, "clip.open.hint_views", map[string]any{"Left": max(d.MaxViews-d.Views, 0)})
+	}
+	return i18n.T(ctx, "clip.open.hint_password")
+}
+
+// formatClipSize formats an attachment size in binary units.
+func formatClipSize(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -22,6 +22,9 @@ func TestClipPageTitle(t *testing.T) {
 		{name: "not found en", d: ClipPageData{NotFound: true}, want: "Clip not found"},
 		{name: "empty title en", d: ClipPageData{}, want: "Clip"},
 		{name: "with title", d: ClipPageData{Title: "Hello"}, want: "Hello"},
+		{name: "gone", d: ClipPageData{Title: "Hello", Gone: true, State: "burned"}, want: "Clip no longer available"},
+		{name: "protected hides title", d: ClipPageData{Title: "Hello", Protected: true}, want: "Protected clip"},
+		{name: "protected after open", d: ClipPageData{Title: "Hello", Protected: true, ShowBody: true}, want: "Hello"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -75,3 +78,35 @@ func TestLifeModuleShellChineseNav(t *testing.T) {
 	body := buf.String()
 	assert.Contains(t, body, "任务")
 }
+
+func TestClipStatusFormatting(t *testing.T) {
+	t.Parallel()
+	ctx := i18n.DefaultContext()
+	edited := time.Date(2026, 7, 18, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "views", got: formatClipViews(ctx, 3, 0), want: "3 views"},
+		{name: "views with limit", got: formatClipViews(ctx, 1, 5), want: "1 of 5 views"},
+		{name: "expiry", got: formatClipExpiry(ctx, edited), want: "Expires Jul 18, 2026, 9:30 AM UTC"},
+		{name: "version", got: formatClipVersion(ctx, 2, nil), want: "Version 2"},
+		{name: "version edited", got: formatClipVersion(ctx, 3, &edited), want: "Version 3 · edited Jul 18, 2026, 9:30 AM UTC"},
+		{name: "state", got: clipStateLabel(ctx, "exhausted"), want: "View limit reached"},
+		{name: "empty state", got: clipStateLabel(ctx, ""), want: "Active"},
+		{name: "open hint burn", got: clipOpenHint(ctx, ClipPageData{BurnAfterRead: true, MaxViews: 1}), want: "This clip is deleted as soon as you open it."},
+		{name: "open hint views", got: clipOpenHint(ctx, ClipPageData{MaxViews: 3, Views: 1}), want: "Opening uses one of 2 remaining views."},
+		{name: "size bytes", got: formatClipSize(12), want: "12 B"},
+		{name: "size kib", got: formatClipSize(2048), want: "2.0 KiB"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+	zhCtx := i18n.WithLocalizer(t.Context(), i18n.LocalizerForCookie(i18n.CookieZH))
+	assert.Equal(t, "1 / 5 次查看", formatClipViews(zhCtx, 1, 5))
+}
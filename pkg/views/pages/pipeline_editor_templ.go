@@ -633,235 +633,235 @@ func PipelineEditorPage(ctx context.Context, name string) templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 47, "\"><option :value=\"selectedTrigger().event\" x-text=\"customEventLabel(selectedTrigger().event)\" selected></option></optgroup></template></select></div><div x-show=\"selectedTrigger().type === 'webhook'\"><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 47, "\"><option :value=\"selectedTrigger().event\" x-text=\"customEventLabel(selectedTrigger().event)\" selected></option></optgroup></template></select> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var46 string
-			templ_7745c5c3_Var46, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.path"))
+			templ_7745c5c3_Var46, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.trigger_filter"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 308, Col: 97}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 306, Col: 107}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var46))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 48, "</label> <input type=\"text\" x-model=\"selectedTrigger().webhook.path\" class=\"input input-bordered w-full mb-3\" placeholder=\"/github-push\" data-testid=\"webhook-path-input\" @input=\"drawerDirty = true\"> <label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 48, "</label> <textarea rows=\"4\" x-model=\"selectedTrigger().filterText\" class=\"textarea textarea-bordered w-full font-mono mb-1\" placeholder='{ \"data\": { \"tags\": \"read-later\" } }' data-testid=\"trigger-filter-input\" @input=\"drawerDirty = true\"></textarea><p class=\"text-xs text-base-content/50 mb-3\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var47 string
-			templ_7745c5c3_Var47, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.method"))
+			templ_7745c5c3_Var47, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.trigger_filter_hint"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 313, Col: 99}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 312, Col: 111}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var47))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 49, "</label> <select x-model=\"selectedTrigger().webhook.method\" class=\"select select-bordered w-full mb-3\" data-testid=\"webhook-method-select\" @change=\"drawerDirty = true\"><option value=\"POST\">POST</option> <option value=\"GET\">GET</option> <option value=\"PUT\">PUT</option></select> <label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 49, "</p></div><div x-show=\"selectedTrigger().type === 'webhook'\"><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var48 string
-			templ_7745c5c3_Var48, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.token"))
+			templ_7745c5c3_Var48, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.path"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 321, Col: 98}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 315, Col: 97}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var48))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 50, "</label> <input type=\"text\" x-model=\"selectedTrigger().webhook.auth.token\" class=\"input input-bordered w-full mb-3\" data-testid=\"webhook-token-input\" @input=\"drawerDirty = true\"> <label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 50, "</label> <input type=\"text\" x-model=\"selectedTrigger().webhook.path\" class=\"input input-bordered w-full mb-3\" placeholder=\"/github-push\" data-testid=\"webhook-path-input\" @input=\"drawerDirty = true\"> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var49 string
-			templ_7745c5c3_Var49, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.hmac_secret"))
+			templ_7745c5c3_Var49, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.method"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 325, Col: 104}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 320, Col: 99}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var49))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 51, "</label> <input type=\"text\" x-model=\"selectedTrigger().webhook.auth.hmac_secret\" class=\"input input-bordered w-full mb-3\" data-testid=\"webhook-hmac-input\" @input=\"drawerDirty = true\"><div class=\"mb-3\" x-show=\"selectedTrigger().webhook.path\"><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 51, "</label> <select x-model=\"selectedTrigger().webhook.method\" class=\"select select-bordered w-full mb-3\" data-testid=\"webhook-method-select\" @change=\"drawerDirty = true\"><option value=\"POST\">POST</option> <option value=\"GET\">GET</option> <option value=\"PUT\">PUT</option></select> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var50 string
-			templ_7745c5c3_Var50, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.webhook_url"))
+			templ_7745c5c3_Var50, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.token"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 330, Col: 105}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 328, Col: 98}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var50))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 52, "</label><div class=\"flex items-center gap-1 mb-1\"><input type=\"text\" readonly class=\"input input-bordered w-full font-mono text-xs\" :value=\"webhookTriggerLabel(selectedTrigger())\" data-testid=\"webhook-url-display\"> <button type=\"button\" class=\"btn btn-ghost btn-sm btn-square shrink-0\" title=\"")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 52, "</label> <input type=\"text\" x-model=\"selectedTrigger().webhook.auth.token\" class=\"input input-bordered w-full mb-3\" data-testid=\"webhook-token-input\" @input=\"drawerDirty = true\"> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var51 string
-			templ_7745c5c3_Var51, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.copy_webhook_url"))
+			templ_7745c5c3_Var51, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.hmac_secret"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 338, Col: 72}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 332, Col: 104}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var51)
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var51))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 53, "\" aria-label=\"")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 53, "</label> <input type=\"text\" x-model=\"selectedTrigger().webhook.auth.hmac_secret\" class=\"input input-bordered w-full mb-3\" data-testid=\"webhook-hmac-input\" @input=\"drawerDirty = true\"><div class=\"mb-3\" x-show=\"selectedTrigger().webhook.path\"><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var52 string
-			templ_7745c5c3_Var52, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.copy_webhook_url"))
+			templ_7745c5c3_Var52, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.webhook_url"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 339, Col: 77}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 337, Col: 105}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var52)
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var52))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 54, "\" @click=\"copyWebhookURL(selectedTrigger())\" data-testid=\"btn-copy-webhook-url-drawer\"><svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"0 0 16 16\" fill=\"currentColor\" class=\"w-4 h-4\" aria-hidden=\"true\"><path d=\"M5 6.5A1.5 1.5 0 0 1 6.5 5h6A1.5 1.5 0 0 1 14 6.5v6a1.5 1.5 0 0 1-1.5 1.5h-6A1.5 1.5 0 0 1 5 12.5v-6Z\"></path> <path d=\"M3.5 2A1.5 1.5 0 0 0 2 3.5v6A1.5 1.5 0 0 0 3.5 11V6.5a3 3 0 0 1 3-3H11A1.5 1.5 0 0 0 9.5 2h-6Z\"></path></svg></button></div><p class=\"text-xs text-base-content/50 mb-1\" x-text=\"webhookAuthHint(selectedTrigger())\" data-testid=\"webhook-auth-hint-drawer\"></p><p class=\"text-xs text-warning mb-1\" x-show=\"status === 'published'\" data-testid=\"webhook-publish-hint\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 54, "</label><div class=\"flex items-center gap-1 mb-1\"><input type=\"text\" readonly class=\"input input-bordered w-full font-mono text-xs\" :value=\"webhookTriggerLabel(selectedTrigger())\" data-testid=\"webhook-url-display\"> <button type=\"button\" class=\"btn btn-ghost btn-sm btn-square shrink-0\" title=\"")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var53 string
-			templ_7745c5c3_Var53, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.webhook_publish_hint"))
+			templ_7745c5c3_Var53, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.copy_webhook_url"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 350, Col: 69}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 345, Col: 72}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var53))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var53)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 55, "</p><button type=\"button\" class=\"btn btn-ghost btn-xs px-0 text-primary\" @click=\"copyWebhookCurl(selectedTrigger())\" data-testid=\"btn-copy-webhook-curl\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 55, "\" aria-label=\"")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var54 string
-			templ_7745c5c3_Var54, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.copy_curl"))
+			templ_7745c5c3_Var54, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.copy_webhook_url"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 355, Col: 94}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 346, Col: 77}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var54))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var54)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 56, "</button></div></div><div x-show=\"selectedTrigger().type === 'cron'\"><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 56, "\" @click=\"copyWebhookURL(selectedTrigger())\" data-testid=\"btn-copy-webhook-url-drawer\"><svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"0 0 16 16\" fill=\"currentColor\" class=\"w-4 h-4\" aria-hidden=\"true\"><path d=\"M5 6.5A1.5 1.5 0 0 1 6.5 5h6A1.5 1.5 0 0 1 14 6.5v6a1.5 1.5 0 0 1-1.5 1.5h-6A1.5 1.5 0 0 1 5 12.5v-6Z\"></path> <path d=\"M3.5 2A1.5 1.5 0 0 0 2 3.5v6A1.5 1.5 0 0 0 3.5 11V6.5a3 3 0 0 1 3-3H11A1.5 1.5 0 0 0 9.5 2h-6Z\"></path></svg></button></div><p class=\"text-xs text-base-content/50 mb-1\" x-text=\"webhookAuthHint(selectedTrigger())\" data-testid=\"webhook-auth-hint-drawer\"></p><p class=\"text-xs text-warning mb-1\" x-show=\"status === 'published'\" data-testid=\"webhook-publish-hint\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var55 string
-			templ_7745c5c3_Var55, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.cron_expression"))
+			templ_7745c5c3_Var55, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.webhook_publish_hint"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 359, Col: 108}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 357, Col: 69}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var55))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 57, "</label> <input type=\"text\" x-model=\"selectedTrigger().cron\" class=\"input input-bordered w-full mb-3\" placeholder=\"*/5 * * * *\" data-testid=\"cron-expr-input\" @input=\"drawerDirty = true\"></div></div></template><template x-if=\"selectedStep()\"><div><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 57, "</p><button type=\"button\" class=\"btn btn-ghost btn-xs px-0 text-primary\" @click=\"copyWebhookCurl(selectedTrigger())\" data-testid=\"btn-copy-webhook-curl\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var56 string
-			templ_7745c5c3_Var56, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.step_name"))
+			templ_7745c5c3_Var56, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.copy_curl"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 369, Col: 101}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 362, Col: 94}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var56))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 58, "</label> <input type=\"text\" x-model=\"selectedStep().name\" class=\"input input-bordered w-full mb-3\" placeholder=\"my-step\" data-testid=\"step-name-input\" @input=\"drawerDirty = true\"> <label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 58, "</button></div></div><div x-show=\"selectedTrigger().type === 'cron'\"><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var57 string
-			templ_7745c5c3_Var57, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.capability"))
+			templ_7745c5c3_Var57, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.cron_expression"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 374, Col: 102}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 366, Col: 108}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var57))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 59, "</label> <select x-model=\"selectedStep().capability\" @change=\"onCapabilityChange(selectedStepIndex())\" class=\"select select-bordered w-full mb-3\" data-testid=\"step-capability-select\"><option value=\"\" disabled>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 59, "</label> <input type=\"text\" x-model=\"selectedTrigger().cron\" class=\"input input-bordered w-full mb-3\" placeholder=\"*/5 * * * *\" data-testid=\"cron-expr-input\" @input=\"drawerDirty = true\"></div></div></template><template x-if=\"selectedStep()\"><div><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var58 string
-			templ_7745c5c3_Var58, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.select_capability"))
+			templ_7745c5c3_Var58, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.step_name"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 379, Col: 90}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 376, Col: 101}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var58))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 60, "</option><template x-for=\"cap in capabilities\" :key=\"cap.type\"><option :value=\"cap.type\" x-text=\"cap.type\" :title=\"cap.description\"></option></template></select> <label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 60, "</label> <input type=\"text\" x-model=\"selectedStep().name\" class=\"input input-bordered w-full mb-3\" placeholder=\"my-step\" data-testid=\"step-name-input\" @input=\"drawerDirty = true\"> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var59 string
-			templ_7745c5c3_Var59, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.operation"))
+			templ_7745c5c3_Var59, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.capability"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 384, Col: 101}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 381, Col: 102}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var59))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 61, "</label> <select x-model=\"selectedStep().operation\" @change=\"onOperationChange(selectedStepIndex())\" class=\"select select-bordered w-full mb-3\" data-testid=\"step-operation-select\"><option value=\"\" disabled>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 61, "</label> <select x-model=\"selectedStep().capability\" @change=\"onCapabilityChange(selectedStepIndex())\" class=\"select select-bordered w-full mb-3\" data-testid=\"step-capability-select\"><option value=\"\" disabled>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var60 string
-			templ_7745c5c3_Var60, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.select_operation"))
+			templ_7745c5c3_Var60, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.select_capability"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 389, Col: 89}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 386, Col: 90}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var60))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 62, "</option><template x-for=\"op in selectedStepOperations()\" :key=\"op.name\"><option :value=\"op.name\" x-text=\"op.name\" :title=\"op.description\"></option></template></select><div x-show=\"isAgentRunStep(selectedStepIndex())\" class=\"mb-3\" data-testid=\"agent-run-options\"><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 62, "</option><template x-for=\"cap in capabilities\" :key=\"cap.type\"><option :value=\"cap.type\" x-text=\"cap.type\" :title=\"cap.description\"></option></template></select> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var61 string
-			templ_7745c5c3_Var61, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.tools"))
+			templ_7745c5c3_Var61, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.operation"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 395, Col: 98}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 391, Col: 101}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var61))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 63, "</label><p class=\"text-xs text-base-content/50 mb-2\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 63, "</label> <select x-model=\"selectedStep().operation\" @change=\"onOperationChange(selectedStepIndex())\" class=\"select select-bordered w-full mb-3\" data-testid=\"step-operation-select\"><option value=\"\" disabled>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var62 string
-			templ_7745c5c3_Var62, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.tools_hint"))
+			templ_7745c5c3_Var62, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.select_operation"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 396, Col: 102}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 396, Col: 89}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var62))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 64, "</p><div class=\"grid grid-cols-2 md:grid-cols-3 gap-2 rounded-lg border border-base-300 p-3 bg-base-100 mb-3\"><template x-for=\"toolName in agentRunOptions.tools\" :key=\"toolName\"><label class=\"flex items-center gap-2 text-sm cursor-pointer\"><input type=\"checkbox\" :checked=\"isAgentRunOptionSelected(selectedStepIndex(), 'tools', toolName)\" @change=\"toggleAgentRunOption(selectedStepIndex(), 'tools', toolName)\" class=\"checkbox checkbox-xs\" :data-testid=\"'agent-run-tool-' + toolName\"> <span class=\"font-mono text-xs\" x-text=\"toolName\"></span></label></template></div><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 64, "</option><template x-for=\"op in selectedStepOperations()\" :key=\"op.name\"><option :value=\"op.name\" x-text=\"op.name\" :title=\"op.description\"></option></template></select><div x-show=\"isAgentRunStep(selectedStepIndex())\" class=\"mb-3\" data-testid=\"agent-run-options\"><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var63 string
-			templ_7745c5c3_Var63, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.skills"))
+			templ_7745c5c3_Var63, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.tools"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 409, Col: 99}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 402, Col: 98}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var63))
 			if templ_7745c5c3_Err != nil {
@@ -872,327 +872,392 @@ func PipelineEditorPage(ctx context.Context, name string) templ.Component {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var64 string
-			templ_7745c5c3_Var64, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.skills_hint"))
+			templ_7745c5c3_Var64, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.tools_hint"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 410, Col: 103}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 403, Col: 102}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var64))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 66, "</p><template x-if=\"agentRunOptions.skills.length === 0\"><p class=\"text-xs text-base-content/50 mb-3\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 66, "</p><div class=\"grid grid-cols-2 md:grid-cols-3 gap-2 rounded-lg border border-base-300 p-3 bg-base-100 mb-3\"><template x-for=\"toolName in agentRunOptions.tools\" :key=\"toolName\"><label class=\"flex items-center gap-2 text-sm cursor-pointer\"><input type=\"checkbox\" :checked=\"isAgentRunOptionSelected(selectedStepIndex(), 'tools', toolName)\" @change=\"toggleAgentRunOption(selectedStepIndex(), 'tools', toolName)\" class=\"checkbox checkbox-xs\" :data-testid=\"'agent-run-tool-' + toolName\"> <span class=\"font-mono text-xs\" x-text=\"toolName\"></span></label></template></div><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var65 string
-			templ_7745c5c3_Var65, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.no_skills"))
+			templ_7745c5c3_Var65, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.skills"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 412, Col: 102}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 416, Col: 99}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var65))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 67, "</p></template><template x-if=\"agentRunOptions.skills.length > 0\"><div class=\"grid grid-cols-1 md:grid-cols-2 gap-2 rounded-lg border border-base-300 p-3 bg-base-100 max-h-48 overflow-y-auto mb-3\"><template x-for=\"skill in agentRunOptions.skills\" :key=\"skill.name\"><label class=\"flex items-start gap-2 text-sm cursor-pointer\"><input type=\"checkbox\" :checked=\"isAgentRunOptionSelected(selectedStepIndex(), 'skills', skill.name)\" @change=\"toggleAgentRunOption(selectedStepIndex(), 'skills', skill.name)\" class=\"checkbox checkbox-xs mt-0.5\" :data-testid=\"'agent-run-skill-' + skill.name\"> <span><span class=\"font-mono text-xs\" x-text=\"skill.name\"></span> <span class=\"block text-xs text-base-content/50\" x-text=\"skill.description\" x-show=\"skill.description\"></span></span></label></template></div></template></div><template x-if=\"getFormOperationInput(selectedStepIndex()).length > 0\"><div class=\"mb-3\" data-testid=\"params-form\"><label class=\"block text-sm font-medium mb-2\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 67, "</label><p class=\"text-xs text-base-content/50 mb-2\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var66 string
-			templ_7745c5c3_Var66, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.parameters"))
+			templ_7745c5c3_Var66, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.skills_hint"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 434, Col: 104}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 417, Col: 103}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var66))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 68, "</label><div class=\"space-y-4\"><template x-for=\"p in getFormOperationInput(selectedStepIndex())\" :key=\"p.name\"><div class=\"border border-base-300 rounded-box p-3 bg-base-100\"><div class=\"flex items-center gap-2 mb-1\"><span class=\"text-sm font-mono font-medium\" x-text=\"p.name\"></span> <span class=\"text-xs font-mono text-base-content/30 bg-base-200 rounded-box px-1.5 py-0.5\" x-text=\"p.type\"></span> <span x-show=\"p.required\" class=\"flowbot-chip flowbot-chip-error\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 68, "</p><template x-if=\"agentRunOptions.skills.length === 0\"><p class=\"text-xs text-base-content/50 mb-3\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var67 string
-			templ_7745c5c3_Var67, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.required"))
+			templ_7745c5c3_Var67, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.no_skills"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 441, Col: 112}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 419, Col: 102}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var67))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 69, "</span> <span x-show=\"!p.required\" class=\"flowbot-chip flowbot-chip-muted\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 69, "</p></template><template x-if=\"agentRunOptions.skills.length > 0\"><div class=\"grid grid-cols-1 md:grid-cols-2 gap-2 rounded-lg border border-base-300 p-3 bg-base-100 max-h-48 overflow-y-auto mb-3\"><template x-for=\"skill in agentRunOptions.skills\" :key=\"skill.name\"><label class=\"flex items-start gap-2 text-sm cursor-pointer\"><input type=\"checkbox\" :checked=\"isAgentRunOptionSelected(selectedStepIndex(), 'skills', skill.name)\" @change=\"toggleAgentRunOption(selectedStepIndex(), 'skills', skill.name)\" class=\"checkbox checkbox-xs mt-0.5\" :data-testid=\"'agent-run-skill-' + skill.name\"> <span><span class=\"font-mono text-xs\" x-text=\"skill.name\"></span> <span class=\"block text-xs text-base-content/50\" x-text=\"skill.description\" x-show=\"skill.description\"></span></span></label></template></div></template></div><template x-if=\"getFormOperationInput(selectedStepIndex()).length > 0\"><div class=\"mb-3\" data-testid=\"params-form\"><label class=\"block text-sm font-medium mb-2\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var68 string
-			templ_7745c5c3_Var68, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.optional"))
+			templ_7745c5c3_Var68, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.parameters"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 442, Col: 113}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 441, Col: 104}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var68))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 70, "</span> <button type=\"button\" x-show=\"!p.required\" @click=\"clearStepParam(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-xs ml-auto text-base-content/50\" :data-testid=\"'btn-clear-param-' + p.name\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 70, "</label><div class=\"space-y-4\"><template x-for=\"p in getFormOperationInput(selectedStepIndex())\" :key=\"p.name\"><div class=\"border border-base-300 rounded-box p-3 bg-base-100\"><div class=\"flex items-center gap-2 mb-1\"><span class=\"text-sm font-mono font-medium\" x-text=\"p.name\"></span> <span class=\"text-xs font-mono text-base-content/30 bg-base-200 rounded-box px-1.5 py-0.5\" x-text=\"p.type\"></span> <span x-show=\"p.required\" class=\"flowbot-chip flowbot-chip-error\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var69 string
-			templ_7745c5c3_Var69, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.clear"))
+			templ_7745c5c3_Var69, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.required"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 447, Col: 87}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 448, Col: 112}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var69))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 71, "</button></div><p x-show=\"p.description\" class=\"text-xs text-base-content/50 mb-2\" x-text=\"p.description\"></p><template x-if=\"isParamTypeString(p)\"><div class=\"flex gap-2 items-start\"><textarea rows=\"3\" :value=\"getStepParamString(selectedStepIndex(), p.name)\" @input=\"setStepParamString(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" :placeholder=\"paramFieldPlaceholder(p)\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-sm text-primary shrink-0 mt-1\" :data-testid=\"'btn-var-' + p.name\">&#123;x&#125;</button></div></template><template x-if=\"isParamTypeNumber(p)\"><div class=\"flex gap-2 items-start\"><input type=\"text\" inputmode=\"numeric\" :value=\"getStepParamNumber(selectedStepIndex(), p.name)\" @input=\"setStepParamNumber(selectedStepIndex(), p.name, $event.target.value, p.type)\" class=\"input input-bordered input-sm w-full font-mono\" :placeholder=\"numberParamPlaceholder(p)\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-sm text-primary shrink-0 mt-1\" :data-testid=\"'btn-var-' + p.name\">&#123;x&#125;</button></div></template><template x-if=\"isParamTypeBool(p)\"><div><select :value=\"getStepParamBoolMode(selectedStepIndex(), p.name)\" @change=\"setStepParamBoolMode(selectedStepIndex(), p.name, $event.target.value)\" class=\"select select-bordered select-sm w-full\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"><option value=\"unset\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 71, "</span> <span x-show=\"!p.required\" class=\"flowbot-chip flowbot-chip-muted\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var70 string
-			templ_7745c5c3_Var70, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.not_set"))
+			templ_7745c5c3_Var70, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.optional"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 489, Col: 69}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 449, Col: 113}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var70))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 72, "</option> <option value=\"true\">true</option> <option value=\"false\">false</option></select></div></template><template x-if=\"isParamTypeStringList(p)\"><div><textarea rows=\"3\" :value=\"getStepParamStringList(selectedStepIndex(), p.name)\" @input=\"setStepParamStringList(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" placeholder=\"")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 72, "</span> <button type=\"button\" x-show=\"!p.required\" @click=\"clearStepParam(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-xs ml-auto text-base-content/50\" :data-testid=\"'btn-clear-param-' + p.name\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var71 string
-			templ_7745c5c3_Var71, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.placeholder_comma_values"))
+			templ_7745c5c3_Var71, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.clear"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 501, Col: 90}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 454, Col: 87}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var71)
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var71))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 73, "\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea></div></template><template x-if=\"isParamTypeIntList(p)\"><div class=\"flex gap-2 items-start\"><textarea rows=\"3\" :value=\"getStepParamIntList(selectedStepIndex(), p.name)\" @input=\"setStepParamIntList(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" placeholder=\"")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 73, "</button></div><p x-show=\"p.description\" class=\"text-xs text-base-content/50 mb-2\" x-text=\"p.description\"></p><template x-if=\"isParamTypeString(p)\"><div class=\"flex gap-2 items-start\"><textarea rows=\"3\" :value=\"getStepParamString(selectedStepIndex(), p.name)\" @input=\"setStepParamString(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" :placeholder=\"paramFieldPlaceholder(p)\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-sm text-primary shrink-0 mt-1\" :data-testid=\"'btn-var-' + p.name\">&#123;x&#125;</button></div></template><template x-if=\"isParamTypeNumber(p)\"><div class=\"flex gap-2 items-start\"><input type=\"text\" inputmode=\"numeric\" :value=\"getStepParamNumber(selectedStepIndex(), p.name)\" @input=\"setStepParamNumber(selectedStepIndex(), p.name, $event.target.value, p.type)\" class=\"input input-bordered input-sm w-full font-mono\" :placeholder=\"numberParamPlaceholder(p)\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-sm text-primary shrink-0 mt-1\" :data-testid=\"'btn-var-' + p.name\">&#123;x&#125;</button></div></template><template x-if=\"isParamTypeBool(p)\"><div><select :value=\"getStepParamBoolMode(selectedStepIndex(), p.name)\" @change=\"setStepParamBoolMode(selectedStepIndex(), p.name, $event.target.value)\" class=\"select select-bordered select-sm w-full\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"><option value=\"unset\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var72 string
-			templ_7745c5c3_Var72, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.placeholder_comma_ids"))
+			templ_7745c5c3_Var72, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.not_set"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 512, Col: 87}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 496, Col: 69}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var72)
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var72))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 74, "\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-sm text-primary shrink-0 mt-1\" :data-testid=\"'btn-var-' + p.name\">&#123;x&#125;</button></div></template><template x-if=\"isParamTypeMap(p)\"><div><textarea rows=\"4\" :value=\"getStepParamMapJSON(selectedStepIndex(), p.name)\" @input=\"setStepParamMapJSON(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" :class=\"isParamFieldError(selectedStepIndex(), p.name) ? 'textarea-error' : ''\" placeholder=\"{}\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea><p x-show=\"isParamFieldError(selectedStepIndex(), p.name)\" class=\"text-xs text-error mt-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 74, "</option> <option value=\"true\">true</option> <option value=\"false\">false</option></select></div></template><template x-if=\"isParamTypeStringList(p)\"><div><textarea rows=\"3\" :value=\"getStepParamStringList(selectedStepIndex(), p.name)\" @input=\"setStepParamStringList(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" placeholder=\"")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var73 string
-			templ_7745c5c3_Var73, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.invalid_json_object"))
+			templ_7745c5c3_Var73, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.placeholder_comma_values"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 532, Col: 105}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 508, Col: 90}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var73))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var73)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 75, "</p></div></template></div></template></div></div></template><template x-if=\"getExtraParamKeys(selectedStepIndex()).length > 0\"><p class=\"text-xs text-base-content/50 mb-3\" data-testid=\"params-extra-keys-info\" x-text=\"extraFieldsHint(selectedStepIndex())\"></p></template><div class=\"collapse collapse-arrow border border-base-300 rounded-box bg-base-200 mb-3\"><input type=\"checkbox\" x-model=\"paramsAdvancedOpen\" data-testid=\"params-advanced-toggle\"><div class=\"collapse-title text-sm font-medium min-h-0 py-3\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 75, "\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea></div></template><template x-if=\"isParamTypeIntList(p)\"><div class=\"flex gap-2 items-start\"><textarea rows=\"3\" :value=\"getStepParamIntList(selectedStepIndex(), p.name)\" @input=\"setStepParamIntList(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" placeholder=\"")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var74 string
-			templ_7745c5c3_Var74, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.advanced_json"))
+			templ_7745c5c3_Var74, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.placeholder_comma_ids"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 547, Col: 121}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 519, Col: 87}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var74))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var74)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 76, "</div><div class=\"collapse-content\"><textarea rows=\"8\" x-model=\"selectedStep().paramsText\" class=\"textarea textarea-bordered w-full font-mono\" placeholder='{ \"title\": \"event.title\" }' @input=\"drawerDirty = true; onParamsTextInput(selectedStepIndex())\" data-testid=\"params-editor\"></textarea> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), null)\" class=\"btn btn-ghost btn-sm text-primary mt-2\" data-testid=\"btn-open-var-picker\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 76, "\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), p.name)\" class=\"btn btn-ghost btn-sm text-primary shrink-0 mt-1\" :data-testid=\"'btn-var-' + p.name\">&#123;x&#125;</button></div></template><template x-if=\"isParamTypeMap(p)\"><div><textarea rows=\"4\" :value=\"getStepParamMapJSON(selectedStepIndex(), p.name)\" @input=\"setStepParamMapJSON(selectedStepIndex(), p.name, $event.target.value)\" class=\"textarea textarea-bordered textarea-sm w-full font-mono\" :class=\"isParamFieldError(selectedStepIndex(), p.name) ? 'textarea-error' : ''\" placeholder=\"{}\" :data-param-field=\"p.name\" :data-testid=\"'param-field-' + p.name\"></textarea><p x-show=\"isParamFieldError(selectedStepIndex(), p.name)\" class=\"text-xs text-error mt-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var75 string
-			templ_7745c5c3_Var75, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.insert_variable"))
+			templ_7745c5c3_Var75, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.invalid_json_object"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 556, Col: 98}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 539, Col: 105}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var75))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 77, "</button></div></div></div></template></div><div x-show=\"drawerTab === 'setup' && (selectedStep() || selectedTrigger())\" class=\"sticky bottom-0 -mx-6 -mb-6 mt-6 border-t border-base-300 bg-base-100 px-6 py-4 flex justify-end gap-2\" data-testid=\"drawer-setup-actions\"><button type=\"button\" @click=\"closeDrawer\" class=\"btn btn-ghost btn-sm\" data-testid=\"btn-drawer-cancel\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 77, "</p></div></template></div></template></div></div></template><template x-if=\"getExtraParamKeys(selectedStepIndex()).length > 0\"><p class=\"text-xs text-base-content/50 mb-3\" data-testid=\"params-extra-keys-info\" x-text=\"extraFieldsHint(selectedStepIndex())\"></p></template><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var76 string
-			templ_7745c5c3_Var76, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.cancel"))
+			templ_7745c5c3_Var76, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.step_when"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 568, Col: 69}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 552, Col: 101}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var76))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 78, "</button> <button type=\"button\" @click.stop=\"saveDrawer()\" :disabled=\"saving\" class=\"btn btn-primary btn-sm\" data-testid=\"btn-drawer-save\"><span x-show=\"!saving\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 78, "</label> <input type=\"text\" x-model=\"selectedStep().when\" class=\"input input-bordered w-full font-mono mb-1\" placeholder='eq (event \"status\") \"open\"' data-testid=\"step-when-input\" @input=\"drawerDirty = true\"><p class=\"text-xs text-base-content/50 mb-3\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var77 string
-			templ_7745c5c3_Var77, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.save"))
+			templ_7745c5c3_Var77, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.step_when_hint"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 573, Col: 58}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 557, Col: 105}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var77))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 79, "</span> <span x-show=\"saving\" class=\"flex items-center gap-1\"><span class=\"inline-block w-3 h-3 border-2 border-current border-r-transparent rounded-full animate-spin\"></span> ")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 79, "</p><div class=\"collapse collapse-arrow border border-base-300 rounded-box bg-base-200 mb-3\"><input type=\"checkbox\" x-model=\"paramsAdvancedOpen\" data-testid=\"params-advanced-toggle\"><div class=\"collapse-title text-sm font-medium min-h-0 py-3\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var78 string
-			templ_7745c5c3_Var78, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.saving"))
+			templ_7745c5c3_Var78, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.advanced_json"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 576, Col: 52}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 560, Col: 121}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var78))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 80, "</span></button></div><!-- Test Tab --><div x-show=\"drawerTab === 'test'\" data-testid=\"drawer-test\"><template x-if=\"selectedStep()\"><div><label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 80, "</div><div class=\"collapse-content\"><textarea rows=\"8\" x-model=\"selectedStep().paramsText\" class=\"textarea textarea-bordered w-full font-mono\" placeholder='{ \"title\": \"event.title\" }' @input=\"drawerDirty = true; onParamsTextInput(selectedStepIndex())\" data-testid=\"params-editor\"></textarea> <button type=\"button\" @click=\"openVariablePicker(selectedStepIndex(), null)\" class=\"btn btn-ghost btn-sm text-primary mt-2\" data-testid=\"btn-open-var-picker\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var79 string
-			templ_7745c5c3_Var79, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_trigger_source"))
+			templ_7745c5c3_Var79, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.insert_variable"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 585, Col: 111}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 569, Col: 98}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var79))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 81, "</label> <select x-model=\"testTriggerSource\" class=\"select select-bordered w-full mb-3\" data-testid=\"test-trigger-select\"><template x-for=\"t in enabledTriggers\" :key=\"t.type\"><option :value=\"t.type\" x-text=\"t.type\"></option></template></select> <label class=\"block text-sm font-medium mb-1\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 81, "</button></div></div></div></template></div><div x-show=\"drawerTab === 'setup' && (selectedStep() || selectedTrigger())\" class=\"sticky bottom-0 -mx-6 -mb-6 mt-6 border-t border-base-300 bg-base-100 px-6 py-4 flex justify-end gap-2\" data-testid=\"drawer-setup-actions\"><button type=\"button\" @click=\"closeDrawer\" class=\"btn btn-ghost btn-sm\" data-testid=\"btn-drawer-cancel\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var80 string
-			templ_7745c5c3_Var80, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_mock_payload"))
+			templ_7745c5c3_Var80, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.cancel"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 592, Col: 109}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 581, Col: 69}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var80))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 82, "</label> <textarea x-model=\"testMockPayload\" rows=\"4\" class=\"textarea textarea-bordered w-full font-mono mb-3\" placeholder=\"")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 82, "</button> <button type=\"button\" @click.stop=\"saveDrawer()\" :disabled=\"saving\" class=\"btn btn-primary btn-sm\" data-testid=\"btn-drawer-save\"><span x-show=\"!saving\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var81 string
-			templ_7745c5c3_Var81, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.test_mock_placeholder"))
+			templ_7745c5c3_Var81, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.save"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 595, Col: 80}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 586, Col: 58}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var81)
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var81))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 83, "\" data-testid=\"mock-payload\"></textarea> <button type=\"button\" @click=\"loadMockPayload\" class=\"btn btn-ghost btn-sm text-primary mb-3\" data-testid=\"btn-load-mock\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 83, "</span> <span x-show=\"saving\" class=\"flex items-center gap-1\"><span class=\"inline-block w-3 h-3 border-2 border-current border-r-transparent rounded-full animate-spin\"></span> ")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var82 string
-			templ_7745c5c3_Var82, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_load_sample"))
+			templ_7745c5c3_Var82, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.saving"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 598, Col: 91}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 589, Col: 52}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var82))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 84, "</button> <button type=\"button\" @click=\"runTest\" :disabled=\"testing\" class=\"btn btn-primary btn-block mb-4\" data-testid=\"btn-run-test\"><span x-show=\"!testing\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 84, "</span></button></div><!-- Test Tab --><div x-show=\"drawerTab === 'test'\" data-testid=\"drawer-test\"><template x-if=\"selectedStep()\"><div><label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var83 string
-			templ_7745c5c3_Var83, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_run"))
+			templ_7745c5c3_Var83, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_trigger_source"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 603, Col: 79}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 598, Col: 111}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var83))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 85, "</span> <span x-show=\"testing\" class=\"flex items-center justify-center gap-1\"><span class=\"inline-block w-3 h-3 border-2 border-current border-r-transparent rounded-full animate-spin\"></span> ")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 85, "</label> <select x-model=\"testTriggerSource\" class=\"select select-bordered w-full mb-3\" data-testid=\"test-trigger-select\"><template x-for=\"t in enabledTriggers\" :key=\"t.type\"><option :value=\"t.type\" x-text=\"t.type\"></option></template></select> <label class=\"block text-sm font-medium mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var84 string
-			templ_7745c5c3_Var84, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_testing"))
+			templ_7745c5c3_Var84, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_mock_payload"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 606, Col: 60}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 605, Col: 109}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var84))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 86, "</span></button><div x-show=\"testResults\" data-testid=\"test-results\" class=\"border-t border-base-300 pt-4\"><template x-if=\"hasTestResultSteps()\"><template x-for=\"r in testResults.steps\" :key=\"r.name\"><div class=\"mb-3 text-sm\"><div class=\"flex items-center gap-2\"><span x-show=\"r.status === 'ok'\" class=\"text-success font-bold\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 86, "</label> <textarea x-model=\"testMockPayload\" rows=\"4\" class=\"textarea textarea-bordered w-full font-mono mb-3\" placeholder=\"")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var85 string
-			templ_7745c5c3_Var85, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_status_ok"))
+			templ_7745c5c3_Var85, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.test_mock_placeholder"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 614, Col: 128}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 608, Col: 80}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var85))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var85)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 87, "</span> <span x-show=\"r.status === 'error'\" class=\"text-error font-bold\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 87, "\" data-testid=\"mock-payload\"></textarea> <button type=\"button\" @click=\"loadMockPayload\" class=\"btn btn-ghost btn-sm text-primary mb-3\" data-testid=\"btn-load-mock\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var86 string
-			templ_7745c5c3_Var86, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_status_err"))
+			templ_7745c5c3_Var86, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_load_sample"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 615, Col: 130}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 611, Col: 91}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var86))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 88, "</span> <span class=\"font-medium\" x-text=\"r.name\"></span> <span class=\"text-xs text-base-content/30\" x-text=\"r.duration_ms + 'ms'\"></span></div><pre x-show=\"r.output\" class=\"text-xs bg-base-200 p-2 rounded-box mt-1 overflow-x-auto\" x-text=\"JSON.stringify(r.output, null, 2)\"></pre><div x-show=\"r.error\" class=\"text-error text-xs mt-1\" x-text=\"r.error\"></div></div></template></template></div></div></template></div></div></div><!-- Variable Picker Modal --><div x-show=\"variablePickerOpen\" class=\"fixed inset-0 z-60 flex items-center justify-center\" @click.self=\"variablePickerOpen = false\"><div class=\"bg-base-100 rounded-box border border-base-300 p-6 w-96 max-h-96 overflow-y-auto\" data-testid=\"var-picker\"><h4 class=\"font-medium text-base-content mb-3\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 88, "</button> <button type=\"button\" @click=\"runTest\" :disabled=\"testing\" class=\"btn btn-primary btn-block mb-4\" data-testid=\"btn-run-test\"><span x-show=\"!testing\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var87 string
-			templ_7745c5c3_Var87, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.var_picker_title"))
+			templ_7745c5c3_Var87, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_run"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 636, Col: 106}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 616, Col: 79}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var87))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 89, "</h4><div class=\"text-sm space-y-1\"><div class=\"text-xs text-base-content/30 uppercase mb-2\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 89, "</span> <span x-show=\"testing\" class=\"flex items-center justify-center gap-1\"><span class=\"inline-block w-3 h-3 border-2 border-current border-r-transparent rounded-full animate-spin\"></span> ")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var88 string
-			templ_7745c5c3_Var88, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.var_picker_event_data"))
+			templ_7745c5c3_Var88, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_testing"))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 638, Col: 122}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 619, Col: 60}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var88))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 90, "</div><button @click=\"insertVariable('event.event_id')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.event_id</button> <button @click=\"insertVariable('event.event_type')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.event_type</button> <button @click=\"insertVariable('event.title')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.title</button> <button @click=\"insertVariable('event.entity_id')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.entity_id</button> <button @click=\"insertVariable('event.source')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.source</button> <button @click=\"insertVariable('event.capability')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.capability</button><template x-for=\"idx in priorStepIndexes()\" :key=\"idx\"><div x-show=\"steps[idx]\"><div class=\"text-xs text-base-content/30 uppercase mt-2 mb-1\" x-text=\"'steps.' + stepNameAt(idx)\"></div><button type=\"button\" @click=\"insertStepVariable(idx, 'id')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\"><span x-text=\"stepVarPath(idx, 'id')\"></span></button> <button type=\"button\" @click=\"insertStepVariable(idx, 'result')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\"><span x-text=\"stepVarPath(idx, 'result')\"></span></button></div></template></div></div></div>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 90, "</span></button><div x-show=\"testResults\" data-testid=\"test-results\" class=\"border-t border-base-300 pt-4\"><template x-if=\"hasTestResultSteps()\"><template x-for=\"r in testResults.steps\" :key=\"r.name\"><div class=\"mb-3 text-sm\"><div class=\"flex items-center gap-2\"><span x-show=\"r.status === 'ok'\" class=\"text-success font-bold\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var89 string
+			templ_7745c5c3_Var89, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_status_ok"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 627, Col: 128}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var89))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 91, "</span> <span x-show=\"r.status === 'error'\" class=\"text-error font-bold\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var90 string
+			templ_7745c5c3_Var90, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_status_err"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 628, Col: 130}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var90))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 92, "</span> <span x-show=\"r.status === 'skipped'\" class=\"text-base-content/50 font-bold\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var91 string
+			templ_7745c5c3_Var91, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.test_status_skip"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 629, Col: 143}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var91))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 93, "</span> <span class=\"font-medium\" x-text=\"r.name\"></span> <span class=\"text-xs text-base-content/30\" x-text=\"r.duration_ms + 'ms'\"></span></div><pre x-show=\"r.output\" class=\"text-xs bg-base-200 p-2 rounded-box mt-1 overflow-x-auto\" x-text=\"JSON.stringify(r.output, null, 2)\"></pre><div x-show=\"r.error\" class=\"text-error text-xs mt-1\" x-text=\"r.error\"></div></div></template></template></div></div></template></div></div></div><!-- Variable Picker Modal --><div x-show=\"variablePickerOpen\" class=\"fixed inset-0 z-60 flex items-center justify-center\" @click.self=\"variablePickerOpen = false\"><div class=\"bg-base-100 rounded-box border border-base-300 p-6 w-96 max-h-96 overflow-y-auto\" data-testid=\"var-picker\"><h4 class=\"font-medium text-base-content mb-3\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var92 string
+			templ_7745c5c3_Var92, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.var_picker_title"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 650, Col: 106}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var92))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 94, "</h4><div class=\"text-sm space-y-1\"><div class=\"text-xs text-base-content/30 uppercase mb-2\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var93 string
+			templ_7745c5c3_Var93, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "page.pipeline_editor.var_picker_event_data"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/pages/pipeline_editor.templ`, Line: 652, Col: 122}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var93))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 95, "</div><button @click=\"insertVariable('event.event_id')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.event_id</button> <button @click=\"insertVariable('event.event_type')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.event_type</button> <button @click=\"insertVariable('event.title')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.title</button> <button @click=\"insertVariable('event.entity_id')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.entity_id</button> <button @click=\"insertVariable('event.source')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.source</button> <button @click=\"insertVariable('event.capability')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\">event.capability</button><template x-for=\"idx in priorStepIndexes()\" :key=\"idx\"><div x-show=\"steps[idx]\"><div class=\"text-xs text-base-content/30 uppercase mt-2 mb-1\" x-text=\"'steps.' + stepNameAt(idx)\"></div><button type=\"button\" @click=\"insertStepVariable(idx, 'id')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\"><span x-text=\"stepVarPath(idx, 'id')\"></span></button> <button type=\"button\" @click=\"insertStepVariable(idx, 'result')\" class=\"block w-full text-left px-2 py-1 hover:bg-primary/10 rounded text-base-content\"><span x-text=\"stepVarPath(idx, 'result')\"></span></button></div></template></div></div></div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -1200,7 +1265,7 @@ func PipelineEditorPage(ctx context.Context, name string) templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 91, "</div>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 96, "</div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -354,6 +354,18 @@ func chatAgentSessionBadgeClass(state string) string {
 	}
 }
 
+// chatAgentMCPStateBadgeClass returns badge classes for an MCP server connection state.
+func chatAgentMCPStateBadgeClass(state string) string {
+	switch state {
+	case "ready":
+		return "badge badge-success badge-sm"
+	case "failed":
+		return "badge badge-error badge-sm"
+	default:
+		return "badge badge-warning badge-sm"
+	}
+}
+
 // ChatAgentPendingPromptKey returns the sessionStorage key for a pending first prompt.
 func ChatAgentPendingPromptKey(sessionID string) string {
 	return ChatAgentPendingPromptKeyPrefix + sessionID
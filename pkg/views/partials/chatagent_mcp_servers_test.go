@@ -0,0 +1,56 @@
+package partials
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+func TestChatAgentMCPServers(t *testing.T) {
+	t.Parallel()
+	servers := []model.AgentMCPServer{
+		{
+			Name: "github", Transport: "stdio", Agents: []string{"chat"}, State: "ready",
+			Tools: []string{"mcp__github__list_issues", "mcp__github__create_issue"}, RefreshedAt: time.Now().Add(-2 * time.Hour),
+			Dropped: []string{"list.issues"},
+		},
+		{Name: "docs", Transport: "http", Agents: []string{"reviewer"}, State: "failed", Error: "status 401"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, ChatAgentMCPServers(context.Background(), servers).Render(context.Background(), &buf))
+	html := buf.String()
+
+	assert.Contains(t, html, `data-testid="chatagent-mcp-server-github"`)
+	assert.Contains(t, html, "2 tools")
+	assert.Contains(t, html, "refreshed 2h ago")
+	assert.Contains(t, html, `data-testid="chatagent-mcp-error-docs"`)
+	assert.Contains(t, html, "status 401")
+	assert.NotContains(t, html, `data-testid="chatagent-mcp-error-github"`)
+	assert.Contains(t, html, `data-testid="chatagent-mcp-dropped-github"`)
+	assert.Contains(t, html, "1 dropped (name collision): list.issues")
+	assert.NotContains(t, html, `data-testid="chatagent-mcp-dropped-docs"`)
+}
+
+func TestChatAgentMCPStateBadgeClass(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{state: "ready", want: "badge badge-success badge-sm"},
+		{state: "failed", want: "badge badge-error badge-sm"},
+		{state: "connecting", want: "badge badge-warning badge-sm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, chatAgentMCPStateBadgeClass(tt.state))
+		})
+	}
+}
@@ -56,6 +56,7 @@ var permissionKeyCatalog = []PermissionKeyMeta{
 	{Key: "edit", Label: "Edit Files", Description: "Controls write_file access by file path pattern.", SupportsPatterns: true, DisallowAllow: true},
 	{Key: "bash", Label: "Shell / Code", Description: "Controls run_terminal and run_code by command pattern.", SupportsPatterns: true, DisallowAllow: true},
 	{Key: permission.KeyExternalDirectory, Label: "External Paths", Description: "Controls access to paths outside the workspace.", SupportsPatterns: true, DisallowAllow: true},
+	{Key: permission.KeyMCP, Label: "MCP Tools", Description: "Controls tools from external MCP servers by server/tool pattern.", SupportsPatterns: true, DisallowAllow: false},
 }
 
 // BuildPermissionFormFields builds form rows from a permissions API view.
@@ -1,7 +1,10 @@
 // Package partials provides reusable Templ fragments for HTMX responses.
 package partials
 
-import "time"
+import (
+	"strconv"
+	"time"
+)
 
 // formatClipListTime formats a clip created-at timestamp for the list table.
 func formatClipListTime(t time.Time) string {
@@ -10,3 +13,23 @@ func formatClipListTime(t time.Time) string {
 	}
 	return t.UTC().Format("2006-01-02 15:04 UTC")
 }
+
+// formatClipListViews formats the views column as "n" or "n / max".
+func formatClipListViews(views, maxViews int) string {
+	if maxViews > 0 {
+		return strconv.Itoa(views) + " / " + strconv.Itoa(maxViews)
+	}
+	return strconv.Itoa(views)
+}
+
+// clipStateChipClass maps a clip state to its chip color.
+func clipStateChipClass(state string) string {
+	switch state {
+	case "active":
+		return "flowbot-chip-success"
+	case "burned", "exhausted":
+		return "flowbot-chip-muted"
+	default:
+		return "flowbot-chip-warning"
+	}
+}
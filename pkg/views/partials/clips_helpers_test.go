@@ -27,3 +27,23 @@ func TestFormatClipListTime(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatClipListViews(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		views    int
+		maxViews int
+		want     string
+	}{
+		{name: "no limit", views: 4, want: "4"},
+		{name: "with limit", views: 1, maxViews: 3, want: "1 / 3"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, formatClipListViews(tt.views, tt.maxViews))
+		})
+	}
+}
@@ -35,14 +35,14 @@ func TriggerCard(ctx context.Context) templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"flowbot-surface card-body p-3 cursor-pointer\" :class=\"getTriggerErrorClass(idx)\" @click=\"selectNode('trigger', idx)\" data-testid=\"trigger-card\"><div class=\"flex flex-row items-center justify-between gap-2\"><div class=\"flex items-center gap-3 min-w-0 flex-1\"><div class=\"w-8 h-8 shrink-0 rounded bg-primary/10 flex items-center justify-center text-primary text-sm font-bold\"><span x-show=\"t.type === 'event'\">E</span> <span x-show=\"t.type === 'webhook'\">W</span> <span x-show=\"t.type === 'cron'\">C</span></div><div class=\"min-w-0 flex-1\"><div class=\"text-sm font-medium text-base-content\" x-text=\"triggerEventLabel(t)\" x-show=\"t.type === 'event'\"></div><div class=\"flex items-center gap-1 min-w-0\" x-show=\"t.type === 'webhook'\"><div class=\"min-w-0 flex-1\"><div class=\"text-sm font-medium text-base-content font-mono truncate\" x-text=\"webhookTriggerLabel(t)\" data-testid=\"webhook-trigger-url\"></div><div class=\"text-xs text-base-content/50 truncate\" x-text=\"webhookAuthHint(t)\" data-testid=\"webhook-auth-hint\"></div></div><button type=\"button\" class=\"btn btn-ghost btn-xs btn-square shrink-0\" title=\"")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"flowbot-surface card-body p-3 cursor-pointer\" :class=\"getTriggerErrorClass(idx)\" @click=\"selectNode('trigger', idx)\" data-testid=\"trigger-card\"><div class=\"flex flex-row items-center justify-between gap-2\"><div class=\"flex items-center gap-3 min-w-0 flex-1\"><div class=\"w-8 h-8 shrink-0 rounded bg-primary/10 flex items-center justify-center text-primary text-sm font-bold\"><span x-show=\"t.type === 'event'\">E</span> <span x-show=\"t.type === 'webhook'\">W</span> <span x-show=\"t.type === 'cron'\">C</span></div><div class=\"min-w-0 flex-1\"><div class=\"text-sm font-medium text-base-content\" x-text=\"triggerEventLabel(t)\" x-show=\"t.type === 'event'\"></div><div class=\"text-xs text-base-content/50 font-mono truncate\" x-text=\"triggerFilterLabel(t)\" x-show=\"t.type === 'event' && triggerFilterLabel(t)\" data-testid=\"trigger-filter-summary\"></div><div class=\"flex items-center gap-1 min-w-0\" x-show=\"t.type === 'webhook'\"><div class=\"min-w-0 flex-1\"><div class=\"text-sm font-medium text-base-content font-mono truncate\" x-text=\"webhookTriggerLabel(t)\" data-testid=\"webhook-trigger-url\"></div><div class=\"text-xs text-base-content/50 truncate\" x-text=\"webhookAuthHint(t)\" data-testid=\"webhook-auth-hint\"></div></div><button type=\"button\" class=\"btn btn-ghost btn-xs btn-square shrink-0\" title=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
 		var templ_7745c5c3_Var2 string
 		templ_7745c5c3_Var2, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.copy_webhook_url"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 29, Col: 67}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 30, Col: 67}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var2)
 		if templ_7745c5c3_Err != nil {
@@ -55,7 +55,7 @@ func TriggerCard(ctx context.Context) templ.Component {
 		var templ_7745c5c3_Var3 string
 		templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.ResolveAttributeValue(i18n.T(ctx, "page.pipeline_editor.copy_webhook_url"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 30, Col: 72}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 31, Col: 72}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ_7745c5c3_Var3)
 		if templ_7745c5c3_Err != nil {
@@ -97,7 +97,7 @@ func StepCard(ctx context.Context) templ.Component {
 		var templ_7745c5c3_Var5 string
 		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.copy"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 69, Col: 167}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 70, Col: 167}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
 		if templ_7745c5c3_Err != nil {
@@ -110,7 +110,7 @@ func StepCard(ctx context.Context) templ.Component {
 		var templ_7745c5c3_Var6 string
 		templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "common.delete"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 70, Col: 161}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `pkg/views/partials/pipeline_partials.templ`, Line: 71, Col: 161}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 		if templ_7745c5c3_Err != nil {
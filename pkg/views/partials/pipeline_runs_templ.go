@@ -1102,8 +1102,10 @@ func runsStatusClass(status int) string {
 		return "flowbot-chip flowbot-chip-success"
 	case 4:
 		return "flowbot-chip flowbot-chip-error"
-	case 3:
+	case 3, 6:
 		return "flowbot-chip flowbot-chip-warning"
+	case 7:
+		return "flowbot-chip flowbot-chip-primary"
 	default:
 		return "flowbot-chip flowbot-chip-muted"
 	}
@@ -1119,6 +1121,12 @@ func runsStatusText(ctx context.Context, status int) string {
 		return i18n.T(ctx, "events.run_status.cancelled")
 	case 1:
 		return i18n.T(ctx, "events.run_status.running")
+	case 5:
+		return i18n.T(ctx, "events.run_status.skipped")
+	case 6:
+		return i18n.T(ctx, "events.run_status.compensated")
+	case 7:
+		return i18n.T(ctx, "events.run_status.waiting")
 	default:
 		return i18n.T(ctx, "events.run_status.started")
 	}
@@ -201,7 +201,9 @@ func PipelineWaterfallBarClass(status int) string {
 		return "run-waterfall-bar run-waterfall-bar-success"
 	case types.PipelineFailed:
 		return "run-waterfall-bar run-waterfall-bar-error"
-	case types.PipelineStart:
+	case types.PipelineCompensated:
+		return "run-waterfall-bar run-waterfall-bar-warning"
+	case types.PipelineStart, types.PipelineWaiting:
 		return "run-waterfall-bar run-waterfall-bar-running"
 	default:
 		return "run-waterfall-bar run-waterfall-bar-muted"
@@ -215,7 +217,9 @@ func WorkflowWaterfallBarClass(status int) string {
 		return "run-waterfall-bar run-waterfall-bar-success"
 	case types.WorkflowRunFailed:
 		return "run-waterfall-bar run-waterfall-bar-error"
-	case types.WorkflowRunRunning:
+	case types.WorkflowRunCancelled:
+		return "run-waterfall-bar run-waterfall-bar-warning"
+	case types.WorkflowRunRunning, types.WorkflowRunWaiting:
 		return "run-waterfall-bar run-waterfall-bar-running"
 	default:
 		return "run-waterfall-bar run-waterfall-bar-muted"
@@ -174,6 +174,8 @@ func TestWaterfallBarClass(t *testing.T) {
 		{name: "pipeline running", fn: PipelineWaterfallBarClass, status: 1, want: "run-waterfall-bar run-waterfall-bar-running"},
 		{name: "workflow failed status 3", fn: WorkflowWaterfallBarClass, status: 3, want: "run-waterfall-bar run-waterfall-bar-error"},
 		{name: "pipeline cancel muted", fn: PipelineWaterfallBarClass, status: 3, want: "run-waterfall-bar run-waterfall-bar-muted"},
+		{name: "pipeline compensated warning", fn: PipelineWaterfallBarClass, status: 6, want: "run-waterfall-bar run-waterfall-bar-warning"},
+		{name: "pipeline skipped muted", fn: PipelineWaterfallBarClass, status: 5, want: "run-waterfall-bar run-waterfall-bar-muted"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -12,6 +12,8 @@ func TestRunsStatusClass(t *testing.T) {
 		{name: "success", status: 2, want: "flowbot-chip flowbot-chip-success"},
 		{name: "failed", status: 4, want: "flowbot-chip flowbot-chip-error"},
 		{name: "cancelled", status: 3, want: "flowbot-chip flowbot-chip-warning"},
+		{name: "skipped", status: 5, want: "flowbot-chip flowbot-chip-muted"},
+		{name: "compensated", status: 6, want: "flowbot-chip flowbot-chip-warning"},
 		{name: "started default", status: 0, want: "flowbot-chip flowbot-chip-muted"},
 	}
 	for _, tt := range tests {
@@ -191,6 +191,10 @@ func WorkflowRunStatusText(ctx context.Context, status int) string {
 		return i18n.T(ctx, "workflow.run_status.failed")
 	case types.WorkflowRunRunning:
 		return i18n.T(ctx, "workflow.run_status.running")
+	case types.WorkflowRunCancelled:
+		return i18n.T(ctx, "workflow.run_status.cancelled")
+	case types.WorkflowRunWaiting:
+		return i18n.T(ctx, "workflow.run_status.waiting")
 	default:
 		return i18n.T(ctx, "workflow.run_status.unknown")
 	}
@@ -201,9 +205,11 @@ type workflowRunStatusInfo struct {
 }
 
 var workflowRunStatusMeta = map[types.WorkflowRunState]workflowRunStatusInfo{
-	types.WorkflowRunDone:    {class: "flowbot-chip flowbot-chip-success"},
-	types.WorkflowRunFailed:  {class: "flowbot-chip flowbot-chip-error"},
-	types.WorkflowRunRunning: {class: "flowbot-chip flowbot-chip-warning"},
+	types.WorkflowRunDone:      {class: "flowbot-chip flowbot-chip-success"},
+	types.WorkflowRunFailed:    {class: "flowbot-chip flowbot-chip-error"},
+	types.WorkflowRunRunning:   {class: "flowbot-chip flowbot-chip-warning"},
+	types.WorkflowRunCancelled: {class: "flowbot-chip flowbot-chip-muted"},
+	types.WorkflowRunWaiting:   {class: "flowbot-chip flowbot-chip-primary"},
 }
 
 // WorkflowRunDuration formats the elapsed time for a workflow run.
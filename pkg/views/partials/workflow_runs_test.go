@@ -172,3 +172,48 @@ func TestWorkflowStepRunDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflowRunArtifacts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		artifacts []WorkflowArtifactView
+		contains  []string
+		excludes  []string
+	}{
+		{
+			name:     "no artifacts renders nothing",
+			excludes: []string{`data-testid="workflow-run-artifacts"`},
+		},
+		{
+			name: "signed and unsigned artifacts",
+			artifacts: []WorkflowArtifactView{
+				{Task: "build", Name: "bin", Path: "out/app", Size: 2048, URL: "https://media.example/f1?sig=abc"},
+				{Task: "build", Name: "log", Path: "build.log", Size: 12},
+			},
+			contains: []string{
+				`data-testid="workflow-run-artifacts"`,
+				`data-testid="workflow-artifact-build-bin"`,
+				`href="https://media.example/f1?sig=abc"`,
+				"2.0 KiB",
+				`data-testid="workflow-artifact-build-log"`,
+				"12 B",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			err := WorkflowRunArtifacts(context.Background(), tt.artifacts).Render(context.Background(), &buf)
+			require.NoError(t, err)
+			html := buf.String()
+			for _, want := range tt.contains {
+				assert.Contains(t, html, want)
+			}
+			for _, unwanted := range tt.excludes {
+				assert.NotContains(t, html, unwanted)
+			}
+		})
+	}
+}
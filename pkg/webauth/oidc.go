@@ -0,0 +1,269 @@
+package webauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// Identity providers recorded on linked identities.
+const (
+	ProviderOIDC        = "oidc"
+	ProviderForwardAuth = "forward_auth"
+)
+
+// CookieOIDCState binds an OIDC callback to the browser that started the login.
+const CookieOIDCState = "oidcState"
+
+// OIDCOptions configures the OpenID Connect relying party.
+type OIDCOptions struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Scopes are requested in addition to openid.
+	Scopes []string
+}
+
+// OIDCClient runs the authorization-code flow with PKCE against one issuer.
+// Discovery happens on first use so Flowbot can start before the identity
+// provider, and is retried after a failure.
+type OIDCClient struct {
+	opts OIDCOptions
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+	oauth    *oauth2.Config
+}
+
+// NewOIDCClient creates a client for opts without contacting the issuer.
+func NewOIDCClient(opts OIDCOptions) *OIDCClient {
+	return &OIDCClient{opts: opts}
+}
+
+// Issuer returns the configured issuer URL.
+func (c *OIDCClient) Issuer() string {
+	return c.opts.Issuer
+}
+
+func (c *OIDCClient) discover(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.provider != nil {
+		return nil
+	}
+	provider, err := oidc.NewProvider(ctx, c.opts.Issuer)
+	if err != nil {
+		return fmt.Errorf("webauth: oidc discovery: %w", err)
+	}
+	scopes := []string{oidc.ScopeOpenID}
+	for _, s := range c.opts.Scopes {
+		if s != oidc.ScopeOpenID {
+			scopes = append(scopes, s)
+		}
+	}
+	c.provider = provider
+	c.verifier = provider.Verifier(&oidc.Config{ClientID: c.opts.ClientID})
+	c.oauth = &oauth2.Config{
+		ClientID:     c.opts.ClientID,
+		ClientSecret: c.opts.ClientSecret,
+		RedirectURL:  c.opts.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	return nil
+}
+
+// OIDCRequest is one started login. State goes to the browser; Nonce and
+// Verifier stay server-side until the callback.
+type OIDCRequest struct {
+	URL      string
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// Begin prepares the redirect to the provider's authorization endpoint.
+func (c *OIDCClient) Begin(ctx context.Context) (OIDCRequest, error) {
+	if err := c.discover(ctx); err != nil {
+		return OIDCRequest{}, err
+	}
+	state, err := randomToken()
+	if err != nil {
+		return OIDCRequest{}, err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return OIDCRequest{}, err
+	}
+	verifier := oauth2.GenerateVerifier()
+	return OIDCRequest{
+		URL:      c.oauth.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)),
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+	}, nil
+}
+
+// OIDCIdentity is the verified outcome of a callback.
+type OIDCIdentity struct {
+	Issuer  string
+	Subject string
+	Claims  map[string]any
+}
+
+// Complete exchanges code, verifies the ID token and its nonce, and returns
+// the token claims. Claims missing from the ID token are filled from the
+// userinfo endpoint, since some providers keep profile claims there.
+func (c *OIDCClient) Complete(ctx context.Context, code, verifier, nonce string) (*OIDCIdentity, error) {
+	if err := c.discover(ctx); err != nil {
+		return nil, err
+	}
+	token, err := c.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("webauth: oidc code exchange: %w", err)
+	}
+	rawID, ok := token.Extra("id_token").(string)
+	if !ok || rawID == "" {
+		return nil, errors.New("webauth: oidc token response has no id_token")
+	}
+	idToken, err := c.verifier.Verify(ctx, rawID)
+	if err != nil {
+		return nil, fmt.Errorf("webauth: oidc id token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("webauth: oidc nonce mismatch")
+	}
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("webauth: oidc claims: %w", err)
+	}
+	if c.provider.UserInfoEndpoint() != "" {
+		if info, err := c.provider.UserInfo(ctx, oauth2.StaticTokenSource(token)); err == nil && info.Subject == idToken.Subject {
+			extra := map[string]any{}
+			if err := info.Claims(&extra); err == nil {
+				for k, v := range extra {
+					if _, ok := claims[k]; !ok {
+						claims[k] = v
+					}
+				}
+			}
+		}
+	}
+	return &OIDCIdentity{Issuer: idToken.Issuer, Subject: idToken.Subject, Claims: claims}, nil
+}
+
+// ClaimString returns claim name as a trimmed string, or "" when absent.
+func ClaimString(claims map[string]any, name string) string {
+	s, _ := claims[name].(string)
+	return strings.TrimSpace(s)
+}
+
+// ClaimStrings returns claim name as a list. A single string is split on
+// commas, which is how forward-auth proxies send groups.
+func ClaimStrings(claims map[string]any, name string) []string {
+	var out []string
+	switch v := claims[name].(type) {
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+	case []string:
+		for _, s := range v {
+			if strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+	}
+	return out
+}
+
+// InAnyGroup reports whether groups shares a member with allowed. An empty
+// allowed list admits everyone.
+func InAnyGroup(groups, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, g := range groups {
+		for _, a := range allowed {
+			if g == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TrustedProxies is the set of peers allowed to assert a user via header.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses CIDRs and bare IP addresses.
+func ParseTrustedProxies(entries []string) (TrustedProxies, error) {
+	out := make(TrustedProxies, 0, len(entries))
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(e); err == nil {
+			out = append(out, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(e)
+		if err != nil {
+			return nil, fmt.Errorf("webauth: trusted proxy %q is not an IP or CIDR", e)
+		}
+		out = append(out, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return out, nil
+}
+
+// Contains reports whether ip falls inside any trusted prefix.
+func (t TrustedProxies) Contains(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range t {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnusablePasswordHash returns a bcrypt hash of random bytes, for accounts
+// created by single sign-on that have no local password.
+func UnusablePasswordHash() (string, error) {
+	secret, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	return HashPassword(secret)
+}
+
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("webauth: random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
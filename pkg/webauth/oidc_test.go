@@ -0,0 +1,252 @@
+package webauth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/webauth"
+)
+
+// fakeProvider is a minimal OpenID provider: discovery, JWKS, a token
+// endpoint that enforces PKCE and a userinfo endpoint.
+type fakeProvider struct {
+	t         *testing.T
+	srv       *httptest.Server
+	signer    jose.Signer
+	challenge string
+	nonce     string
+	claims    map[string]any
+	userinfo  map[string]any
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "k1"))
+	require.NoError(t, err)
+	p := &fakeProvider{t: t, signer: signer}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		p.writeJSON(w, map[string]any{
+			"issuer":                                p.srv.URL,
+			"authorization_endpoint":                p.srv.URL + "/authorize",
+			"token_endpoint":                        p.srv.URL + "/token",
+			"userinfo_endpoint":                     p.srv.URL + "/userinfo",
+			"jwks_uri":                              p.srv.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		p.writeJSON(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "k1", Algorithm: "RS256", Use: "sig"}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "good-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != p.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			p.writeJSON(w, map[string]any{"error": "invalid_grant"})
+			return
+		}
+		p.writeJSON(w, map[string]any{
+			"access_token": "at",
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"id_token":     p.idToken(),
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, _ *http.Request) {
+		p.writeJSON(w, p.userinfo)
+	})
+	p.srv = httptest.NewServer(mux)
+	t.Cleanup(p.srv.Close)
+	return p
+}
+
+func (p *fakeProvider) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(p.t, json.NewEncoder(w).Encode(v))
+}
+
+func (p *fakeProvider) idToken() string {
+	claims := map[string]any{
+		"iss":   p.srv.URL,
+		"aud":   "flowbot",
+		"sub":   "user-123",
+		"nonce": p.nonce,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+	payload, err := json.Marshal(claims)
+	require.NoError(p.t, err)
+	obj, err := p.signer.Sign(payload)
+	require.NoError(p.t, err)
+	raw, err := obj.CompactSerialize()
+	require.NoError(p.t, err)
+	return raw
+}
+
+// authorize records what the browser would carry to the provider.
+func (p *fakeProvider) authorize(t *testing.T, authURL string) {
+	t.Helper()
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Contains(t, q.Get("scope"), "openid")
+	p.challenge = q.Get("code_challenge")
+	p.nonce = q.Get("nonce")
+}
+
+func TestOIDCClientFlow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		claims   map[string]any
+		userinfo map[string]any
+		code     string
+		nonce    string
+		wantErr  string
+		wantUser string
+	}{
+		{
+			name:     "id token claims",
+			claims:   map[string]any{"preferred_username": "alice", "groups": []string{"admins"}},
+			userinfo: map[string]any{"sub": "user-123", "preferred_username": "ignored"},
+			code:     "good-code",
+			wantUser: "alice",
+		},
+		{
+			name:     "missing claims come from userinfo",
+			userinfo: map[string]any{"sub": "user-123", "preferred_username": "bob"},
+			code:     "good-code",
+			wantUser: "bob",
+		},
+		{
+			name:     "userinfo for another subject is ignored",
+			userinfo: map[string]any{"sub": "someone-else", "preferred_username": "mallory"},
+			code:     "good-code",
+			wantUser: "",
+		},
+		{
+			name:    "bad code fails exchange",
+			code:    "bad-code",
+			wantErr: "code exchange",
+		},
+		{
+			name:    "nonce mismatch",
+			code:    "good-code",
+			nonce:   "replayed",
+			wantErr: "nonce",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := newFakeProvider(t)
+			p.claims = tt.claims
+			p.userinfo = tt.userinfo
+			c := webauth.NewOIDCClient(webauth.OIDCOptions{
+				Issuer:      p.srv.URL,
+				ClientID:    "flowbot",
+				RedirectURL: "https://flowbot.example.com/service/web/login/oidc/callback",
+				Scopes:      []string{"openid", "profile"},
+			})
+			req, err := c.Begin(t.Context())
+			require.NoError(t, err)
+			require.NotEmpty(t, req.State)
+			p.authorize(t, req.URL)
+
+			nonce := req.Nonce
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+			id, err := c.Complete(t.Context(), tt.code, req.Verifier, nonce)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, p.srv.URL, id.Issuer)
+			assert.Equal(t, "user-123", id.Subject)
+			assert.Equal(t, tt.wantUser, webauth.ClaimString(id.Claims, "preferred_username"))
+		})
+	}
+}
+
+func TestOIDCClientDiscoveryError(t *testing.T) {
+	t.Parallel()
+	p := newFakeProvider(t)
+	c := webauth.NewOIDCClient(webauth.OIDCOptions{Issuer: p.srv.URL + "/missing", ClientID: "flowbot"})
+	_, err := c.Begin(t.Context())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "discovery")
+}
+
+func TestClaimStringsAndGroups(t *testing.T) {
+	t.Parallel()
+	claims := map[string]any{
+		"list":   []any{"a", " b ", 3, ""},
+		"csv":    "x, y,,z",
+		"number": 42,
+	}
+	assert.Equal(t, []string{"a", "b"}, webauth.ClaimStrings(claims, "list"))
+	assert.Equal(t, []string{"x", "y", "z"}, webauth.ClaimStrings(claims, "csv"))
+	assert.Empty(t, webauth.ClaimStrings(claims, "number"))
+	assert.Empty(t, webauth.ClaimString(claims, "number"))
+
+	assert.True(t, webauth.InAnyGroup(nil, nil), "no allow list admits everyone")
+	assert.True(t, webauth.InAnyGroup([]string{"users", "admins"}, []string{"admins"}))
+	assert.False(t, webauth.InAnyGroup([]string{"users"}, []string{"admins"}))
+}
+
+func TestTrustedProxies(t *testing.T) {
+	t.Parallel()
+	_, err := webauth.ParseTrustedProxies([]string{"not-an-ip"})
+	require.Error(t, err)
+
+	tp, err := webauth.ParseTrustedProxies([]string{"10.0.0.0/8", " 192.168.1.5 ", "", "fd00::/8"})
+	require.NoError(t, err)
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "10.1.2.3", want: true},
+		{ip: "192.168.1.5", want: true},
+		{ip: "192.168.1.6", want: false},
+		{ip: "::ffff:10.0.0.1", want: true},
+		{ip: "fd00::1", want: true},
+		{ip: "", want: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tp.Contains(tt.ip), tt.ip)
+	}
+}
+
+func TestUnusablePasswordHash(t *testing.T) {
+	t.Parallel()
+	hash, err := webauth.UnusablePasswordHash()
+	require.NoError(t, err)
+	assert.False(t, webauth.CheckPassword(hash, ""))
+	other, err := webauth.UnusablePasswordHash()
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
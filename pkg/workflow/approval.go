@@ -0,0 +1,288 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+// approvalActionType is the action type of human approval tasks (action: "approval:").
+const approvalActionType = "approval"
+
+// openedApproval is a gate opened during this execution whose notification is
+// sent once the run is suspended and its checkpoint saved.
+type openedApproval struct {
+	cfg runapproval.Config
+	req *runapproval.Request
+}
+
+// validateApprovalTasks rejects approval tasks in workflows that cannot be
+// resumed, since the paused run lives in its checkpoint.
+func validateApprovalTasks(wf *types.WorkflowMetadata) error {
+	for _, t := range wf.Tasks {
+		if ParseAction(t.Action).Type != approvalActionType {
+			continue
+		}
+		if !wf.Resumable {
+			return fmt.Errorf("task %s: approval tasks require resumable: true", t.ID)
+		}
+		cfg, err := runapproval.ConfigFromParams(map[string]any(t.Params))
+		if err != nil {
+			return fmt.Errorf("task %s: %w", t.ID, err)
+		}
+		if cfg.Message == "" {
+			return fmt.Errorf("task %s: approval tasks require a message param", t.ID)
+		}
+	}
+	return nil
+}
+
+// approvalSnapshot returns a copy of the gates for checkpointing.
+func (r *Runner) approvalSnapshot() map[string]*runapproval.Request {
+	r.approvalsMu.Lock()
+	defer r.approvalsMu.Unlock()
+	if len(r.approvals) == 0 {
+		return nil
+	}
+	snapshot := make(map[string]*runapproval.Request, len(r.approvals))
+	for id, req := range r.approvals {
+		c := *req
+		snapshot[id] = &c
+	}
+	return snapshot
+}
+
+// restoreApprovals reloads the gates recorded in a checkpoint before a resume.
+func (r *Runner) restoreApprovals(saved map[string]*runapproval.Request) {
+	r.approvalsMu.Lock()
+	defer r.approvalsMu.Unlock()
+	r.approvals = maps.Clone(saved)
+}
+
+// pendingApprovals reports whether a gate is still waiting for a decision.
+func (r *Runner) pendingApprovals() bool {
+	r.approvalsMu.Lock()
+	defer r.approvalsMu.Unlock()
+	for _, req := range r.approvals {
+		if !req.Decided() {
+			return true
+		}
+	}
+	return false
+}
+
+// executeApprovalTask runs an approval task. The first time it opens a gate
+// and returns runapproval.ErrPending; once the gate is decided (or timed out)
+// it returns the decision as the task result, or an error for a rejection.
+func (r *Runner) executeApprovalTask(ctx context.Context, taskID string, wt types.WorkflowTask, params types.KV, run *model.WorkflowRun) (string, error) {
+	r.approvalsMu.Lock()
+	req := r.approvals[taskID]
+	r.approvalsMu.Unlock()
+
+	if req == nil {
+		return "", r.openApproval(ctx, taskID, wt, params, run)
+	}
+
+	req.ApplyTimeout(time.Now())
+	decisionErr := req.Err()
+	if errors.Is(decisionErr, runapproval.ErrPending) {
+		return "", decisionErr
+	}
+	if r.store != nil && req.StepRunID != 0 {
+		status := types.WorkflowRunDone
+		errMsg := ""
+		if decisionErr != nil {
+			status = types.WorkflowRunFailed
+			errMsg = decisionErr.Error()
+		}
+		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), req.StepRunID, int(status), req.Result(), errMsg, 1)
+	}
+	if decisionErr != nil {
+		return "", fmt.Errorf("step %s failed: %w", taskID, decisionErr)
+	}
+	flog.Info("[workflow] approval %s approved by %s", taskID, req.DecidedBy)
+	return string(req.Decision), nil
+}
+
+func (r *Runner) openApproval(ctx context.Context, taskID string, wt types.WorkflowTask, params types.KV, run *model.WorkflowRun) error {
+	if r.store == nil || run == nil {
+		return fmt.Errorf("step %s: approval requires a run store", taskID)
+	}
+	cfg, err := runapproval.ConfigFromParams(map[string]any(params))
+	if err != nil {
+		return fmt.Errorf("step %s: %w", taskID, err)
+	}
+	req, err := runapproval.NewRequest(runapproval.KindWorkflow, run.ID, taskID, cfg.Message, cfg, time.Now())
+	if err != nil {
+		return fmt.Errorf("step %s: %w", taskID, err)
+	}
+	stepRun, err := r.store.CreateStepRun(workflowStoreCtx(ctx), run.ID, taskID, wt.Describe, wt.Action, approvalActionType, map[string]any(params), 1)
+	if err != nil {
+		flog.Error(fmt.Errorf("[workflow] create step run record %s: %w", taskID, err))
+	} else if stepRun != nil {
+		req.StepRunID = stepRun.ID
+		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunWaiting), nil, "", 1)
+	}
+
+	r.approvalsMu.Lock()
+	if r.approvals == nil {
+		r.approvals = make(map[string]*runapproval.Request)
+	}
+	r.approvals[taskID] = req
+	r.opened = append(r.opened, openedApproval{cfg: cfg, req: req})
+	r.approvalsMu.Unlock()
+	flog.Info("[workflow] run %d waiting for approval at step %s", run.ID, taskID)
+	return runapproval.ErrPending
+}
+
+// dropRejectedApprovals removes rejected gates from cp and reports whether any were removed.
+func dropRejectedApprovals(cp *CheckpointData) bool {
+	dropped := false
+	for id, req := range cp.Approvals {
+		if req.Decided() && req.Decision != runapproval.Approve {
+			delete(cp.Approvals, id)
+			dropped = true
+		}
+	}
+	return dropped
+}
+
+// suspendRun parks a run at its open approval gates: it saves the checkpoint,
+// marks the run waiting and then sends the approve/reject links.
+func (r *Runner) suspendRun(ctx context.Context, wfName string, run *model.WorkflowRun, cancelHeartbeat context.CancelFunc, saveCheckpoint func()) {
+	if cancelHeartbeat != nil {
+		cancelHeartbeat()
+	}
+	saveCheckpoint()
+	if r.store != nil && run != nil {
+		_ = r.store.UpdateRunStatus(workflowStoreCtx(ctx), run.ID, int(types.WorkflowRunWaiting), "")
+	}
+	r.auditWorkflowEvent(ctx, wfName, "workflow.waiting")
+
+	r.approvalsMu.Lock()
+	opened := r.opened
+	r.opened = nil
+	r.approvalsMu.Unlock()
+	for _, o := range opened {
+		source := fmt.Sprintf("workflow %s run #%d", wfName, run.ID)
+		if err := runapproval.Notify(workflowStoreCtx(ctx), o.cfg, o.req, source); err != nil {
+			flog.Warn("[workflow] approval notification for step %s failed: %v", o.req.Step, err)
+		}
+	}
+}
+
+// GetApproval returns the waiting or decided approval request of a run by its token.
+func (s *Service) GetApproval(ctx context.Context, token string) (*model.WorkflowRun, *runapproval.Request, error) {
+	run, _, req, err := s.loadApproval(ctx, token)
+	return run, req, err
+}
+
+func (s *Service) loadApproval(ctx context.Context, token string) (*model.WorkflowRun, *CheckpointData, *runapproval.Request, error) {
+	if s == nil {
+		return nil, nil, nil, types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	kind, runID, err := runapproval.ParseToken(token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if kind != runapproval.KindWorkflow {
+		return nil, nil, nil, types.Errorf(types.ErrInvalidArgument, "not a workflow approval token")
+	}
+	run, err := s.getRun(ctx, runID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var cp CheckpointData
+	if err := s.runs.GetCheckpoint(ctx, runID, &cp); err != nil {
+		return nil, nil, nil, types.WrapError(types.ErrNotFound, fmt.Sprintf("no checkpoint for workflow run %d", runID), err)
+	}
+	for _, req := range cp.Approvals {
+		if req.MatchToken(token) {
+			return run, &cp, req, nil
+		}
+	}
+	return nil, nil, nil, types.Errorf(types.ErrNotFound, "approval for workflow run %d", runID)
+}
+
+// DecideApproval records a decision for the waiting run identified by token
+// and resumes it in a detached goroutine.
+func (s *Service) DecideApproval(ctx context.Context, token string, decision runapproval.Decision, by string) error {
+	if s == nil || s.catalog == nil {
+		return types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	s.approvalMu.Lock()
+	defer s.approvalMu.Unlock()
+
+	run, cp, req, err := s.loadApproval(ctx, token)
+	if err != nil {
+		return err
+	}
+	if run.Status != int(types.WorkflowRunWaiting) {
+		return types.Errorf(types.ErrConflict, "workflow run %d is not waiting for approval", run.ID)
+	}
+	if err := req.Decide(decision, by, time.Now()); err != nil {
+		return err
+	}
+	flog.Info("[workflow] run %d approval %s: %s by %s", run.ID, req.Step, req.Decision, req.DecidedBy)
+	return s.resumeApproved(ctx, run, cp)
+}
+
+// ExpireApprovals applies the default decision to waiting runs whose approval
+// timed out and resumes them. It returns how many runs were resumed.
+func (s *Service) ExpireApprovals(ctx context.Context) (int, error) {
+	if s == nil || s.runs == nil || s.catalog == nil {
+		return 0, nil
+	}
+	s.approvalMu.Lock()
+	defer s.approvalMu.Unlock()
+
+	runs, err := s.runs.GetWaitingRuns(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list waiting workflow runs: %w", err)
+	}
+	now := time.Now()
+	resumed := 0
+	for _, run := range runs {
+		var cp CheckpointData
+		if err := s.runs.GetCheckpoint(ctx, run.ID, &cp); err != nil {
+			continue
+		}
+		expired := false
+		for _, req := range cp.Approvals {
+			if req.ApplyTimeout(now) {
+				expired = true
+				flog.Info("[workflow] run %d approval %s timed out: %s", run.ID, req.Step, req.Decision)
+			}
+		}
+		if !expired {
+			continue
+		}
+		if err := s.resumeApproved(ctx, run, &cp); err != nil {
+			flog.Error(fmt.Errorf("expire approval workflow run %d: %w", run.ID, err))
+			continue
+		}
+		resumed++
+	}
+	return resumed, nil
+}
+
+// resumeApproved persists the checkpoint holding a new decision and resumes
+// the run. The run leaves the waiting state before returning so a second
+// decision conflicts; gates still open suspend it again when reached.
+func (s *Service) resumeApproved(ctx context.Context, run *model.WorkflowRun, cp *CheckpointData) error {
+	if err := s.runs.SaveCheckpoint(ctx, run.ID, cp); err != nil {
+		return fmt.Errorf("save approval decision for workflow run %d: %w", run.ID, err)
+	}
+	if err := s.runs.UpdateRunStatus(ctx, run.ID, int(types.WorkflowRunRunning), ""); err != nil {
+		return fmt.Errorf("update workflow run %d status: %w", run.ID, err)
+	}
+	go s.resumeRun(run)
+	return nil
+}
@@ -0,0 +1,162 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+func TestValidateApprovalTasks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		wf      types.WorkflowMetadata
+		wantErr string
+	}{
+		{
+			name: "valid",
+			wf:   types.WorkflowMetadata{Resumable: true, Tasks: []types.WorkflowTask{{ID: "gate", Action: "approval:", Params: types.KV{"message": "ship?"}}}},
+		},
+		{
+			name:    "not resumable",
+			wf:      types.WorkflowMetadata{Tasks: []types.WorkflowTask{{ID: "gate", Action: "approval:", Params: types.KV{"message": "ship?"}}}},
+			wantErr: "resumable",
+		},
+		{
+			name:    "missing message",
+			wf:      types.WorkflowMetadata{Resumable: true, Tasks: []types.WorkflowTask{{ID: "gate", Action: "approval:"}}},
+			wantErr: "message",
+		},
+		{
+			name:    "bad timeout",
+			wf:      types.WorkflowMetadata{Resumable: true, Tasks: []types.WorkflowTask{{ID: "gate", Action: "approval:", Params: types.KV{"message": "m", "timeout": "later"}}}},
+			wantErr: "timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateApprovalTasks(&tt.wf)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func approvalWorkflow(name string, parallel bool) *types.WorkflowMetadata {
+	gate := types.WorkflowTask{ID: "gate", Action: "approval:", Params: types.KV{"message": "ship?", "channels": "inapp"}}
+	after := types.WorkflowTask{ID: "after", Action: "mapper:", Params: types.KV{"done": "yes"}}
+	wf := &types.WorkflowMetadata{Name: name, Resumable: true}
+	if parallel {
+		after.Conn = []string{"gate"}
+		side := types.WorkflowTask{ID: "side", Action: "mapper:", Params: types.KV{"side": "yes"}}
+		wf.MaxConcurrency = 2
+		wf.Tasks = []types.WorkflowTask{gate, side, after}
+	} else {
+		wf.Pipeline = []string{"gate", "after"}
+		wf.Tasks = []types.WorkflowTask{gate, after}
+	}
+	return wf
+}
+
+func waitingApproval(t *testing.T, store *mockWorkflowStore, runID int64) *runapproval.Request {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return runStatus(store, runID) == int(types.WorkflowRunWaiting)
+	}, 5*time.Second, 10*time.Millisecond)
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	cp := store.checkpoints[runID]
+	require.NotNil(t, cp)
+	req := cp.Approvals["gate"]
+	require.NotNil(t, req)
+	c := *req
+	return &c
+}
+
+func workflowStepStatuses(store *mockWorkflowStore) map[string]int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	out := make(map[string]int)
+	for _, sr := range store.stepRuns {
+		out[sr.StepID] = sr.Status
+	}
+	return out
+}
+
+func TestServiceApprovalGate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		parallel   bool
+		decision   runapproval.Decision
+		wantStatus types.WorkflowRunState
+	}{
+		{name: "sequential approve", decision: runapproval.Approve, wantStatus: types.WorkflowRunDone},
+		{name: "sequential reject", decision: runapproval.Reject, wantStatus: types.WorkflowRunFailed},
+		{name: "parallel approve", parallel: true, decision: runapproval.Approve, wantStatus: types.WorkflowRunDone},
+		{name: "parallel reject", parallel: true, decision: runapproval.Reject, wantStatus: types.WorkflowRunFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := newMockWorkflowStore()
+			svc := controlService(store, approvalWorkflow("gated", tt.parallel))
+
+			runID, err := svc.StartRunAsync(context.Background(), "gated", "manual", nil)
+			require.NoError(t, err)
+			req := waitingApproval(t, store, runID)
+
+			run, got, err := svc.GetApproval(context.Background(), req.Token)
+			require.NoError(t, err)
+			assert.Equal(t, runID, run.ID)
+			assert.Equal(t, "ship?", got.Message)
+
+			require.NoError(t, svc.DecideApproval(context.Background(), req.Token, tt.decision, "alice"))
+			require.Eventually(t, func() bool {
+				return runStatus(store, runID) == int(tt.wantStatus)
+			}, 5*time.Second, 10*time.Millisecond)
+
+			steps := workflowStepStatuses(store)
+			assert.Equal(t, tt.decision == runapproval.Approve, steps["after"] == int(types.WorkflowRunDone))
+			assert.Equal(t, int(tt.wantStatus), steps["gate"])
+			require.ErrorIs(t, svc.DecideApproval(context.Background(), req.Token, tt.decision, "bob"), types.ErrConflict)
+		})
+	}
+}
+
+func TestServiceExpireApprovals(t *testing.T) {
+	t.Parallel()
+	store := newMockWorkflowStore()
+	wf := approvalWorkflow("expiring", false)
+	wf.Tasks[0].Params["default"] = "approve"
+	svc := controlService(store, wf)
+
+	runID, err := svc.StartRunAsync(context.Background(), "expiring", "manual", nil)
+	require.NoError(t, err)
+	waitingApproval(t, store, runID)
+
+	n, err := svc.ExpireApprovals(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	store.mu.Lock()
+	store.checkpoints[runID].Approvals["gate"].ExpiresAt = time.Now().Add(-time.Minute)
+	store.mu.Unlock()
+	n, err = svc.ExpireApprovals(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Eventually(t, func() bool {
+		return runStatus(store, runID) == int(types.WorkflowRunDone)
+	}, 5*time.Second, 10*time.Millisecond)
+}
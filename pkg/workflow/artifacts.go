@@ -0,0 +1,240 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"mime"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/executor/runtime"
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+const (
+	// ArtifactMountDir is the workdir directory where dependency artifacts are mounted.
+	ArtifactMountDir = "artifacts"
+	// ArtifactURLTTL is the lifetime of signed artifact URLs handed to tasks and the Web UI.
+	ArtifactURLTTL = time.Hour
+
+	artifactFileOwner = "system:workflow"
+	artifactLocation  = "workflow-artifacts"
+)
+
+// outputNamePattern restricts output names so they are safe as file names.
+var outputNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// validateTaskOutputs checks output declarations: only docker and shell tasks may
+// publish files, names are unique per task, and paths stay inside the workdir.
+func validateTaskOutputs(tasks []types.WorkflowTask) error {
+	for _, t := range tasks {
+		if len(t.Outputs) == 0 {
+			continue
+		}
+		info := ParseAction(t.Action)
+		if info.Type != "docker" && info.Type != "shell" {
+			return fmt.Errorf("task %s: outputs are only supported on docker and shell actions", t.ID)
+		}
+		seen := make(map[string]struct{}, len(t.Outputs))
+		for _, out := range t.Outputs {
+			if !outputNamePattern.MatchString(out.Name) || out.Name == ".." {
+				return fmt.Errorf("task %s: invalid output name %q", t.ID, out.Name)
+			}
+			if _, ok := seen[out.Name]; ok {
+				return fmt.Errorf("task %s: duplicate output %q", t.ID, out.Name)
+			}
+			seen[out.Name] = struct{}{}
+			if _, err := runtime.CleanOutputPath(out.Path); err != nil {
+				return fmt.Errorf("task %s: output %s: %w", t.ID, out.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ArtifactMountPath returns the workdir-relative path where a dependent task finds an artifact.
+func ArtifactMountPath(taskID, name string) string {
+	return path.Join(ArtifactMountDir, taskID, name)
+}
+
+// WithMedia sets the media handler used to store and load artifacts.
+// Without it the active media.FileSystem handler is used.
+func (r *Runner) WithMedia(h media.Handler) *Runner {
+	if r == nil {
+		return nil
+	}
+	r.media = h
+	return r
+}
+
+func (r *Runner) mediaHandler() media.Handler {
+	if r.media != nil {
+		return r.media
+	}
+	return media.FileSystem
+}
+
+// taskArtifacts returns the artifacts published by the given tasks.
+func (r *Runner) taskArtifacts(taskIDs []string) []types.WorkflowArtifact {
+	r.artifactsMu.RLock()
+	defer r.artifactsMu.RUnlock()
+	var out []types.WorkflowArtifact
+	for _, id := range taskIDs {
+		out = append(out, r.artifacts[id]...)
+	}
+	return out
+}
+
+// artifactSnapshot returns a copy of all artifacts published so far, for checkpoints.
+func (r *Runner) artifactSnapshot() map[string][]types.WorkflowArtifact {
+	r.artifactsMu.RLock()
+	defer r.artifactsMu.RUnlock()
+	if len(r.artifacts) == 0 {
+		return nil
+	}
+	return maps.Clone(r.artifacts)
+}
+
+// restoreArtifacts reloads artifacts recorded in a checkpoint before a resume.
+func (r *Runner) restoreArtifacts(saved map[string][]types.WorkflowArtifact) {
+	r.artifactsMu.Lock()
+	defer r.artifactsMu.Unlock()
+	r.artifacts = maps.Clone(saved)
+}
+
+// mountArtifacts copies the artifacts of the task's conn dependencies into its
+// workdir under artifacts/<task>/<name>.
+func (r *Runner) mountArtifacts(ctx context.Context, task *types.Task, conn []string) error {
+	deps := r.taskArtifacts(conn)
+	if len(deps) == 0 {
+		return nil
+	}
+	accessor, ok := media.AsAccessor(r.mediaHandler())
+	if !ok || accessor == nil {
+		return fmt.Errorf("mount artifacts: media handler cannot open files")
+	}
+	if task.Files == nil {
+		task.Files = make(map[string]string, len(deps))
+	}
+	for _, a := range deps {
+		_, data, err := media.ReadAll(ctx, accessor, a.FileID)
+		if err != nil {
+			return fmt.Errorf("mount artifact %s.%s: %w", a.Task, a.Name, err)
+		}
+		task.Files[ArtifactMountPath(a.Task, a.Name)] = string(data)
+	}
+	return nil
+}
+
+// publishArtifacts stores the files collected for a task's declared outputs
+// through the media handler and records them for dependent tasks.
+func (r *Runner) publishArtifacts(taskID string, outputs []types.WorkflowOutputDef, collected map[string]string) ([]types.WorkflowArtifact, error) {
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+	h := r.mediaHandler()
+	if h == nil {
+		return nil, fmt.Errorf("publish artifacts: media handler is not configured")
+	}
+	artifacts := make([]types.WorkflowArtifact, 0, len(outputs))
+	for _, out := range outputs {
+		p, err := runtime.CleanOutputPath(out.Path)
+		if err != nil {
+			return nil, err
+		}
+		data, ok := collected[p]
+		if !ok {
+			return nil, fmt.Errorf("output %s was not collected", out.Name)
+		}
+		fdef := &types.FileDef{
+			ObjHeader: types.ObjHeader{Id: types.Id()},
+			Name:      path.Base(p),
+			User:      artifactFileOwner,
+			MimeType:  artifactMimeType(p),
+			Size:      int64(len(data)),
+			Location:  artifactLocation,
+		}
+		if _, _, err := h.Upload(fdef, bytes.NewReader([]byte(data))); err != nil {
+			return nil, fmt.Errorf("store output %s: %w", out.Name, err)
+		}
+		artifacts = append(artifacts, types.WorkflowArtifact{
+			Task:     taskID,
+			Name:     out.Name,
+			Path:     p,
+			FileID:   fdef.Id,
+			MimeType: fdef.MimeType,
+			Size:     fdef.Size,
+		})
+	}
+	r.artifactsMu.Lock()
+	if r.artifacts == nil {
+		r.artifacts = make(map[string][]types.WorkflowArtifact)
+	}
+	r.artifacts[taskID] = artifacts
+	r.artifactsMu.Unlock()
+	return artifacts, nil
+}
+
+// artifactURLs returns signed download URLs for the artifacts of the given
+// tasks, keyed by task ID and output name. Artifacts that cannot be signed are omitted.
+func (r *Runner) artifactURLs(ctx context.Context, taskIDs []string) map[string]map[string]any {
+	deps := r.taskArtifacts(taskIDs)
+	if len(deps) == 0 {
+		return nil
+	}
+	accessor, ok := media.AsAccessor(r.mediaHandler())
+	if !ok || accessor == nil {
+		return nil
+	}
+	out := make(map[string]map[string]any)
+	for _, a := range deps {
+		u, err := accessor.SignGetURL(ctx, a.FileID, ArtifactURLTTL)
+		if err != nil {
+			flog.Warn("[workflow] sign artifact %s.%s: %v", a.Task, a.Name, err)
+			continue
+		}
+		if out[a.Task] == nil {
+			out[a.Task] = make(map[string]any)
+		}
+		out[a.Task][a.Name] = u
+	}
+	return out
+}
+
+// resolveTaskParams renders task params; artifacts of the task's conn
+// dependencies are available as signed URLs through {{step "<id>" "artifacts"}}.
+func (r *Runner) resolveTaskParams(ctx context.Context, wt types.WorkflowTask, results map[string]string, input types.KV) (types.KV, error) {
+	return renderParams(wt.Params, results, r.artifactURLs(ctx, wt.Conn), input)
+}
+
+// ArtifactsFromStepResult decodes the artifacts recorded in a step run result.
+func ArtifactsFromStepResult(result map[string]any) []types.WorkflowArtifact {
+	raw, ok := result["artifacts"]
+	if !ok || raw == nil {
+		return nil
+	}
+	if list, ok := raw.([]types.WorkflowArtifact); ok {
+		return list
+	}
+	data, err := pooledSonic.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	var list []types.WorkflowArtifact
+	if err := pooledSonic.Unmarshal(data, &list); err != nil {
+		return nil
+	}
+	return list
+}
+
+func artifactMimeType(p string) string {
+	if t := mime.TypeByExtension(path.Ext(p)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
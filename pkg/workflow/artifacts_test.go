@@ -0,0 +1,178 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/media"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// memMedia is an in-memory media.Accessor for artifact tests.
+type memMedia struct {
+	mu    sync.Mutex
+	files map[string]*types.FileDef
+	data  map[string]string
+}
+
+func newMemMedia() *memMedia {
+	return &memMedia{files: map[string]*types.FileDef{}, data: map[string]string{}}
+}
+
+func (*memMedia) Init(string) error { return nil }
+
+func (*memMedia) Headers(*http.Request, bool) (http.Header, int, error) { return nil, 0, nil }
+
+func (m *memMedia) Upload(fdef *types.FileDef, file io.ReadSeeker) (string, int64, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", 0, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[fdef.Id] = fdef
+	m.data[fdef.Id] = string(data)
+	return "/files/" + fdef.Id, int64(len(data)), nil
+}
+
+func (*memMedia) Download(string) (*types.FileDef, media.ReadSeekCloser, error) {
+	return nil, nil, fmt.Errorf("not implemented")
+}
+
+func (*memMedia) Delete([]string) error { return nil }
+
+func (*memMedia) GetIdFromUrl(string) types.Uid { return "" }
+
+func (*memMedia) SignGetURL(_ context.Context, fileID string, _ time.Duration) (string, error) {
+	return "https://files.example/" + fileID + "?sig=x", nil
+}
+
+type nopCloser struct{ *strings.Reader }
+
+func (nopCloser) Close() error { return nil }
+
+func (m *memMedia) OpenByID(_ context.Context, fileID string) (*types.FileDef, media.ReadSeekCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fd, ok := m.files[fileID]
+	if !ok {
+		return nil, nil, types.Errorf(types.ErrNotFound, "file %s", fileID)
+	}
+	return fd, nopCloser{strings.NewReader(m.data[fileID])}, nil
+}
+
+func TestValidateTaskOutputs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		task    types.WorkflowTask
+		wantErr string
+	}{
+		{name: "shell output", task: types.WorkflowTask{ID: "a", Action: "shell:true", Outputs: []types.WorkflowOutputDef{{Name: "report.json", Path: "out/report.json"}}}},
+		{name: "docker output", task: types.WorkflowTask{ID: "a", Action: "docker:alpine", Outputs: []types.WorkflowOutputDef{{Name: "log", Path: "log.txt"}}}},
+		{name: "capability action", task: types.WorkflowTask{ID: "a", Action: "capability:example.echo", Outputs: []types.WorkflowOutputDef{{Name: "x", Path: "x"}}}, wantErr: "only supported on docker and shell"},
+		{name: "invalid name", task: types.WorkflowTask{ID: "a", Action: "shell:true", Outputs: []types.WorkflowOutputDef{{Name: "a/b", Path: "x"}}}, wantErr: "invalid output name"},
+		{name: "duplicate name", task: types.WorkflowTask{ID: "a", Action: "shell:true", Outputs: []types.WorkflowOutputDef{{Name: "x", Path: "x"}, {Name: "x", Path: "y"}}}, wantErr: "duplicate output"},
+		{name: "absolute path", task: types.WorkflowTask{ID: "a", Action: "shell:true", Outputs: []types.WorkflowOutputDef{{Name: "x", Path: "/etc/passwd"}}}, wantErr: "relative to the workdir"},
+		{name: "escaping path", task: types.WorkflowTask{ID: "a", Action: "shell:true", Outputs: []types.WorkflowOutputDef{{Name: "x", Path: "out/../../x"}}}, wantErr: "escapes the workdir"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateTaskOutputs([]types.WorkflowTask{tt.task})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestWorkflowTaskToTask_Outputs(t *testing.T) {
+	t.Parallel()
+	task, err := WorkflowTaskToTask(types.WorkflowTask{
+		ID:      "build",
+		Action:  "shell:make",
+		Outputs: []types.WorkflowOutputDef{{Name: "bin", Path: "out/app"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"out/app"}, task.Outputs)
+}
+
+func TestRunnerArtifacts_PublishAndMount(t *testing.T) {
+	t.Parallel()
+	store := newMemMedia()
+	r := NewRunner().WithMedia(store)
+	defer r.Close()
+
+	outputs := []types.WorkflowOutputDef{{Name: "report.json", Path: "./out/report.json"}}
+	arts, err := r.publishArtifacts("build", outputs, map[string]string{"out/report.json": `{"ok":true}`})
+	require.NoError(t, err)
+	require.Len(t, arts, 1)
+	assert.Equal(t, "build", arts[0].Task)
+	assert.Equal(t, "out/report.json", arts[0].Path)
+	assert.Equal(t, "application/json", arts[0].MimeType)
+	assert.Equal(t, int64(11), arts[0].Size)
+
+	task := &types.Task{ID: "test"}
+	require.NoError(t, r.mountArtifacts(context.Background(), task, []string{"build", "other"}))
+	assert.Equal(t, map[string]string{"artifacts/build/report.json": `{"ok":true}`}, task.Files)
+
+	params, err := r.resolveTaskParams(context.Background(), types.WorkflowTask{
+		ID:     "test",
+		Conn:   []string{"build"},
+		Params: types.KV{"url": `{{index (step "build" "artifacts") "report.json"}}`},
+	}, map[string]string{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://files.example/"+arts[0].FileID+"?sig=x", params["url"])
+
+	_, err = r.publishArtifacts("lint", []types.WorkflowOutputDef{{Name: "log", Path: "lint.log"}}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was not collected")
+}
+
+func TestRunnerArtifacts_CheckpointRestore(t *testing.T) {
+	t.Parallel()
+	store := newMemMedia()
+	first := NewRunner().WithMedia(store)
+	defer first.Close()
+	_, err := first.publishArtifacts("build", []types.WorkflowOutputDef{{Name: "bin", Path: "app"}}, map[string]string{"app": "binary"})
+	require.NoError(t, err)
+
+	cp := CheckpointData{Artifacts: first.artifactSnapshot()}
+	data, err := pooledSonic.Marshal(cp)
+	require.NoError(t, err)
+	var loaded CheckpointData
+	require.NoError(t, pooledSonic.Unmarshal(data, &loaded))
+
+	resumed := NewRunner().WithMedia(store)
+	defer resumed.Close()
+	resumed.restoreArtifacts(loaded.Artifacts)
+	task := &types.Task{ID: "deploy"}
+	require.NoError(t, resumed.mountArtifacts(context.Background(), task, []string{"build"}))
+	assert.Equal(t, "binary", task.Files["artifacts/build/bin"])
+}
+
+func TestArtifactsFromStepResult(t *testing.T) {
+	t.Parallel()
+	want := []types.WorkflowArtifact{{Task: "build", Name: "bin", Path: "app", FileID: "f1", Size: 6}}
+	stored, err := pooledSonic.Marshal(map[string]any{"result": "ok", "artifacts": want})
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, pooledSonic.Unmarshal(stored, &decoded))
+
+	assert.Equal(t, want, ArtifactsFromStepResult(decoded))
+	assert.Equal(t, want, ArtifactsFromStepResult(map[string]any{"artifacts": want}))
+	assert.Nil(t, ArtifactsFromStepResult(map[string]any{"result": "ok"}))
+}
@@ -0,0 +1,161 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/runcall"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+// Call tasks start a child run: action "workflow:<name>" runs a stored
+// workflow and "pipeline:<name>" a loaded pipeline. Task params are the child
+// input; the reserved wait param (default true) chooses between waiting for
+// the result and fire-and-forget.
+const (
+	callWorkflowActionType = runcall.KindWorkflow
+	callPipelineActionType = runcall.KindPipeline
+)
+
+// validateCallTasks checks that call tasks name their target.
+func validateCallTasks(wf *types.WorkflowMetadata) error {
+	for _, t := range wf.Tasks {
+		info := ParseAction(t.Action)
+		if !info.IsCall {
+			continue
+		}
+		if info.Details == "" {
+			return fmt.Errorf("task %s: %s action requires a name, e.g. %s:<name>", t.ID, info.Type, info.Type)
+		}
+	}
+	return nil
+}
+
+// executeCallTask starts the child run of a call task and records it as a
+// step run. The task result is the runcall.Result as JSON: kind, name, run_id,
+// status and, when waited for, the child's task or step results under output.
+func (r *Runner) executeCallTask(ctx context.Context, taskID string, wt types.WorkflowTask, params types.KV, info ActionInfo, run *model.WorkflowRun) (string, error) {
+	var stepRun *model.WorkflowStepRun
+	if r.store != nil && run != nil {
+		var err error
+		stepRun, err = r.store.CreateStepRun(workflowStoreCtx(ctx), run.ID, taskID, wt.Describe, wt.Action, info.Type, map[string]any(params), 1)
+		if err != nil {
+			flog.Error(fmt.Errorf("[workflow] create step run record %s: %w", taskID, err))
+		}
+	}
+
+	call, err := runcall.CallFromParams(info.Type, info.Details, map[string]any(params))
+	if err == nil {
+		var result runcall.Result
+		result, err = r.startCall(ctx, run, call)
+		if err == nil {
+			if r.store != nil && stepRun != nil {
+				_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), result.Map(), "", 1)
+			}
+			flog.Info("[workflow] step %s called %s %s (run %d, %s)", taskID, call.Kind, call.Name, result.RunID, result.Status)
+			return result.JSON(), nil
+		}
+	}
+	err = fmt.Errorf("step %s: %w", taskID, err)
+	r.failStep(ctx, stepRun, err, 1)
+	return "", err
+}
+
+func (r *Runner) startCall(ctx context.Context, run *model.WorkflowRun, call runcall.Call) (runcall.Result, error) {
+	failed := runcall.Result{Kind: call.Kind, Name: call.Name}
+	var runID int64
+	if run != nil {
+		runID = run.ID
+	}
+	parent, err := runcall.Child(ctx, runcall.KindWorkflow, runID)
+	if err != nil {
+		return failed, err
+	}
+	if call.Kind == runcall.KindPipeline {
+		engine := pipeline.ActiveEngine()
+		if engine == nil {
+			return failed, types.Errorf(types.ErrUnavailable, "pipeline engine not ready")
+		}
+		return engine.CallPipeline(ctx, parent, call)
+	}
+	svc := ActiveService()
+	if svc == nil {
+		return failed, types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	return svc.CallWorkflow(ctx, parent, call)
+}
+
+// CallWorkflow starts a run of the named workflow as a child of parent with
+// call.Input as its inputs. A waited-for child runs in ctx, so cancelling the
+// caller cancels it, and its task results are returned; a child that stops
+// at an approval gate fails a waiting call. Otherwise the child runs in the
+// background like StartRunAsync and only its run ID is returned.
+func (s *Service) CallWorkflow(ctx context.Context, parent runcall.Parent, call runcall.Call) (runcall.Result, error) {
+	result := runcall.Result{Kind: runcall.KindWorkflow, Name: call.Name}
+	meta, run, input, err := s.createRun(ctx, call.Name, runcall.TriggerSource, types.KV(call.Input))
+	if err != nil {
+		return result, err
+	}
+	result.RunID = run.ID
+	if parent.RunID != 0 {
+		if err := s.runs.SetRunParent(workflowStoreCtx(ctx), run.ID, parent); err != nil {
+			return result, fmt.Errorf("link workflow run %d to %s run %d: %w", run.ID, parent.Kind, parent.RunID, err)
+		}
+		run.ParentKind = parent.Kind
+		run.ParentRunID = &parent.RunID
+	}
+	run.CallDepth = parent.Depth
+
+	if !call.Wait {
+		go s.executeRun(meta, run, runcall.TriggerSource, input)
+		result.Status = runcall.StatusStarted
+		return result, nil
+	}
+
+	runCtx, untrack := s.trackRun(runcall.WithDepth(ctx, parent.Depth), run.ID)
+	defer untrack()
+	runner := s.newRunner(run, runcall.TriggerSource)
+	execErr := runner.Execute(runCtx, *meta, input, "db")
+	outputs := runner.Outputs()
+	if cerr := runner.Close(); cerr != nil {
+		flog.Error(fmt.Errorf("workflow %s: close runner: %w", meta.Name, cerr))
+	}
+
+	result.Status = runcall.StatusFailed
+	if latest, err := s.runs.GetRun(workflowStoreCtx(ctx), run.ID); err == nil && latest != nil {
+		result.Status = workflowCallStatus(types.WorkflowRunState(latest.Status))
+	}
+	if execErr != nil {
+		if errors.Is(execErr, context.Canceled) || errors.Is(execErr, context.DeadlineExceeded) {
+			result.Status = runcall.StatusCancelled
+		}
+		return result, fmt.Errorf("workflow %s run %d: %w", meta.Name, run.ID, execErr)
+	}
+	if result.Status == runcall.StatusWaiting {
+		return result, fmt.Errorf("workflow %s run %d is waiting for approval; call it with wait: false", meta.Name, run.ID)
+	}
+	result.Output = make(map[string]any, len(outputs))
+	for id, out := range outputs {
+		result.Output[id] = out
+	}
+	return result, nil
+}
+
+func workflowCallStatus(st types.WorkflowRunState) string {
+	switch st {
+	case types.WorkflowRunDone:
+		return runcall.StatusDone
+	case types.WorkflowRunCancelled:
+		return runcall.StatusCancelled
+	case types.WorkflowRunWaiting:
+		return runcall.StatusWaiting
+	case types.WorkflowRunRunning:
+		return runcall.StatusStarted
+	default:
+		return runcall.StatusFailed
+	}
+}
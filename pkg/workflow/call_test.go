@@ -0,0 +1,158 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/runcall"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+func TestParseAction_Call(t *testing.T) {
+	t.Parallel()
+	info := ParseAction("workflow: notify ")
+	assert.True(t, info.IsCall)
+	assert.Equal(t, "workflow", info.Type)
+	assert.Equal(t, "notify", info.Details)
+
+	info = ParseAction("pipeline:archive")
+	assert.True(t, info.IsCall)
+	assert.Equal(t, "archive", info.Details)
+
+	assert.False(t, ParseAction("mapper:").IsCall)
+}
+
+func TestValidateCallTasks(t *testing.T) {
+	t.Parallel()
+	valid := &types.WorkflowMetadata{Tasks: []types.WorkflowTask{{ID: "c", Action: "workflow:notify"}}}
+	require.NoError(t, validateCallTasks(valid))
+
+	missing := &types.WorkflowMetadata{Tasks: []types.WorkflowTask{{ID: "c", Action: "pipeline:"}}}
+	err := validateCallTasks(missing)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires a name")
+}
+
+// callWorkflows returns a child workflow that echoes its text input and a
+// parent that calls it and maps the call result.
+func callWorkflows(wait string) (*types.WorkflowMetadata, *types.WorkflowMetadata) {
+	child := &types.WorkflowMetadata{
+		Name:     "notify",
+		Inputs:   []types.WorkflowInputDef{{Name: "text", Type: types.WorkflowInputTypeString, Required: true}},
+		Pipeline: []string{"say"},
+		Tasks:    []types.WorkflowTask{{ID: "say", Action: "mapper:", Params: types.KV{"said": "{{input.text}}"}}},
+	}
+	parent := &types.WorkflowMetadata{
+		Name:     "caller",
+		Pipeline: []string{"call"},
+		Tasks: []types.WorkflowTask{
+			{ID: "call", Action: "workflow:notify", Params: types.KV{"text": "hi", "wait": wait}},
+		},
+	}
+	return child, parent
+}
+
+func workflowRunByName(store *mockWorkflowStore, name string) *model.WorkflowRun {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, run := range store.runs {
+		if run.WorkflowName == name {
+			c := *run
+			return &c
+		}
+	}
+	return nil
+}
+
+func callStepResult(store *mockWorkflowStore, stepID string) map[string]any {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, sr := range store.stepRuns {
+		if sr.StepID == stepID && sr.ActionType == callWorkflowActionType {
+			return sr.Result
+		}
+	}
+	return nil
+}
+
+// Not parallel: call tasks reach the service through ActiveService.
+func TestServiceCallWorkflow(t *testing.T) {
+	tests := []struct {
+		name       string
+		wait       string
+		wantStatus string
+		wantOutput bool
+	}{
+		{name: "wait returns child results", wait: "true", wantStatus: runcall.StatusDone, wantOutput: true},
+		{name: "fire and forget", wait: "false", wantStatus: runcall.StatusStarted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMockWorkflowStore()
+			child, parent := callWorkflows(tt.wait)
+			svc := controlService(store, child, parent)
+			SetReloadService(svc)
+			t.Cleanup(func() { SetReloadService(nil) })
+
+			parentID, err := svc.StartRunAsync(context.Background(), "caller", "manual", nil)
+			require.NoError(t, err)
+			require.Eventually(t, func() bool {
+				child := workflowRunByName(store, "notify")
+				return runStatus(store, parentID) == int(types.WorkflowRunDone) &&
+					child != nil && child.Status == int(types.WorkflowRunDone)
+			}, 5*time.Second, 10*time.Millisecond)
+
+			childRun := workflowRunByName(store, "notify")
+			assert.Equal(t, runcall.TriggerSource, childRun.TriggerType)
+			assert.Equal(t, runcall.KindWorkflow, childRun.ParentKind)
+			require.NotNil(t, childRun.ParentRunID)
+			assert.Equal(t, parentID, *childRun.ParentRunID)
+			assert.Equal(t, 1, childRun.CallDepth)
+			assert.Equal(t, "hi", childRun.InputParams["text"])
+
+			result := callStepResult(store, "call")
+			require.NotNil(t, result)
+			assert.Equal(t, tt.wantStatus, result["status"])
+			assert.Equal(t, childRun.ID, result["run_id"])
+			if tt.wantOutput {
+				assert.Equal(t, map[string]any{"say": `{"said":"hi"}`}, result["output"])
+			} else {
+				assert.NotContains(t, result, "output")
+			}
+		})
+	}
+}
+
+// Not parallel: call tasks reach the service through ActiveService.
+func TestServiceCallWorkflowDepthLimit(t *testing.T) {
+	store := newMockWorkflowStore()
+	loop := &types.WorkflowMetadata{
+		Name:     "loop",
+		Pipeline: []string{"again"},
+		Tasks:    []types.WorkflowTask{{ID: "again", Action: "workflow:loop"}},
+	}
+	svc := controlService(store, loop)
+	SetReloadService(svc)
+	t.Cleanup(func() { SetReloadService(nil) })
+
+	runID, err := svc.StartRunAsync(context.Background(), "loop", "manual", nil)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return runStatus(store, runID) == int(types.WorkflowRunFailed)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Len(t, store.runs, runcall.MaxDepth+1)
+	deepest := 0
+	for _, run := range store.runs {
+		assert.Equal(t, int(types.WorkflowRunFailed), run.Status)
+		deepest = max(deepest, run.CallDepth)
+	}
+	assert.Equal(t, runcall.MaxDepth, deepest)
+}
@@ -0,0 +1,169 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	fbtrace "github.com/flowline-io/flowbot/pkg/trace"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+// trackRun registers a cancel func for runID so CancelRun can interrupt the
+// run's executor tasks. The returned func must be called when the run ends.
+func (s *Service) trackRun(ctx context.Context, runID int64) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.activeMu.Lock()
+	if s.active == nil {
+		s.active = make(map[int64]context.CancelFunc)
+	}
+	s.active[runID] = cancel
+	s.activeMu.Unlock()
+	return ctx, func() {
+		s.activeMu.Lock()
+		delete(s.active, runID)
+		s.activeMu.Unlock()
+		cancel()
+	}
+}
+
+// CancelRun stops a workflow run. A run executing in this process has its
+// context cancelled and is recorded as cancelled when the current task returns.
+// A run waiting for approval, or a running run whose heartbeat went stale
+// because the process executing it is gone, is marked cancelled directly.
+func (s *Service) CancelRun(ctx context.Context, runID int64) error {
+	if s == nil {
+		return types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	s.activeMu.Lock()
+	cancel, ok := s.active[runID]
+	s.activeMu.Unlock()
+	if ok {
+		cancel()
+		flog.Info("[workflow] run %d cancel requested", runID)
+		return nil
+	}
+
+	run, err := s.getRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+	switch types.WorkflowRunState(run.Status) {
+	case types.WorkflowRunWaiting:
+	case types.WorkflowRunRunning:
+		// Without a checkpoint, HeartbeatAt stays zero and only the run's own
+		// start time and heartbeat count.
+		var cp CheckpointData
+		_ = s.runs.GetCheckpoint(ctx, runID, &cp)
+		if !runStale(run, &cp, time.Now()) {
+			return types.Errorf(types.ErrConflict, "workflow run %d is running on another server", runID)
+		}
+	default:
+		return types.Errorf(types.ErrConflict, "workflow run %d is not running", runID)
+	}
+	swapped, err := s.runs.SwapRunStatus(ctx, runID, []int{run.Status}, int(types.WorkflowRunCancelled), "cancelled: run is not active on this server")
+	if err != nil {
+		return fmt.Errorf("update workflow run %d status: %w", runID, err)
+	}
+	if !swapped {
+		return types.Errorf(types.ErrConflict, "workflow run %d changed state", runID)
+	}
+	return nil
+}
+
+// RetryRun resumes a failed or cancelled run of a resumable workflow from the
+// task that stopped it, reusing the results saved in its checkpoint.
+// The run keeps its ID and continues in a detached goroutine.
+func (s *Service) RetryRun(ctx context.Context, runID int64) error {
+	if s == nil || s.catalog == nil {
+		return types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	run, err := s.getRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+	if run.Status != int(types.WorkflowRunFailed) && run.Status != int(types.WorkflowRunCancelled) {
+		return types.Errorf(types.ErrConflict, "workflow run %d is not failed or cancelled", runID)
+	}
+	meta, err := s.catalog.GetMetadata(ctx, run.WorkflowName)
+	if err != nil {
+		return err
+	}
+	if meta == nil {
+		return types.Errorf(types.ErrNotFound, "workflow %s", run.WorkflowName)
+	}
+	if !meta.Resumable {
+		return types.Errorf(types.ErrInvalidArgument, "workflow %s is not resumable; re-run it instead", run.WorkflowName)
+	}
+	var cp CheckpointData
+	if err := s.runs.GetCheckpoint(ctx, runID, &cp); err != nil || cp.HeartbeatAt.IsZero() {
+		return types.Errorf(types.ErrNotFound, "no checkpoint for workflow run %d", runID)
+	}
+	if dropRejectedApprovals(&cp) {
+		// A retried gate asks again instead of replaying the rejection.
+		if err := s.runs.SaveCheckpoint(ctx, runID, &cp); err != nil {
+			return fmt.Errorf("reset approvals of workflow run %d: %w", runID, err)
+		}
+	}
+	// Only one retry may move the run back to running and resume it.
+	retryable := []int{int(types.WorkflowRunFailed), int(types.WorkflowRunCancelled)}
+	swapped, err := s.runs.SwapRunStatus(ctx, runID, retryable, int(types.WorkflowRunRunning), "")
+	if err != nil {
+		return fmt.Errorf("update workflow run %d status: %w", runID, err)
+	}
+	if !swapped {
+		return types.Errorf(types.ErrConflict, "workflow run %d is already being retried", runID)
+	}
+
+	go s.resumeRun(run)
+	return nil
+}
+
+func (s *Service) resumeRun(run *model.WorkflowRun) {
+	asyncCtx, asyncSpan := fbtrace.StartSpan(context.Background(), "workflow.run.retry")
+	defer asyncSpan.End()
+	ctx, cancel := fbtrace.DetachWithTimeout(asyncCtx, 10*time.Minute)
+	defer cancel()
+	ctx, untrack := s.trackRun(ctx, run.ID)
+	defer untrack()
+
+	// ResumeWorkflow closes the runner's executor engines itself.
+	runner := NewRunnerWithStore(s.runs, s.auditor, s.metrics, "db", run.TriggerType).
+		WithDefinitionStore(s.catalog).
+		WithWorkflowID(runWorkflowID(run))
+	if err := runner.ResumeWorkflow(ctx, run.ID); err != nil {
+		flog.Error(fmt.Errorf("workflow %s retry run %d: %w", run.WorkflowName, run.ID, err))
+	}
+}
+
+// RerunRun starts a new manual run of the same workflow with the inputs runID
+// started with. It returns the new run ID.
+func (s *Service) RerunRun(ctx context.Context, runID int64) (int64, error) {
+	if s == nil {
+		return 0, types.Errorf(types.ErrUnavailable, "workflow service not ready")
+	}
+	run, err := s.getRun(ctx, runID)
+	if err != nil {
+		return 0, err
+	}
+	return s.StartRunAsync(ctx, run.WorkflowName, "manual", types.KV(run.InputParams))
+}
+
+func (s *Service) getRun(ctx context.Context, runID int64) (*model.WorkflowRun, error) {
+	if s.runs == nil {
+		return nil, types.Errorf(types.ErrUnavailable, "workflow run store not ready")
+	}
+	if runID <= 0 {
+		return nil, types.Errorf(types.ErrInvalidArgument, "run id is required")
+	}
+	run, err := s.runs.GetRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, types.Errorf(types.ErrNotFound, "workflow run %d", runID)
+	}
+	return run, nil
+}
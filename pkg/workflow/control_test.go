@@ -0,0 +1,262 @@
+package workflow
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/capability"
+	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+func controlService(store *mockWorkflowStore, metas ...*types.WorkflowMetadata) *Service {
+	catalog := &mockCatalog{meta: map[string]*types.WorkflowMetadata{}}
+	for i, m := range metas {
+		catalog.meta[m.Name] = m
+		catalog.defs = append(catalog.defs, &model.Workflow{ID: int64(i + 1), Name: m.Name, Enabled: true})
+	}
+	return NewService(catalog, store, nil, nil)
+}
+
+func runStatus(store *mockWorkflowStore, runID int64) int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return store.runs[runID].Status
+}
+
+func TestServiceCancelRun_InProcess(t *testing.T) {
+	started := make(chan struct{})
+	require.NoError(t, capability.RegisterInvoker(hub.CapExample, "block", func(ctx context.Context, _ map[string]any) (*capability.InvokeResult, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+	t.Cleanup(func() { capability.UnregisterInvoker(hub.CapExample, "block") })
+
+	store := newMockWorkflowStore()
+	svc := controlService(store, &types.WorkflowMetadata{
+		Name:     "stuck",
+		Pipeline: []string{"wait"},
+		Tasks:    []types.WorkflowTask{{ID: "wait", Action: "capability:example.block"}},
+	})
+
+	runID, err := svc.StartRunAsync(context.Background(), "stuck", "manual", nil)
+	require.NoError(t, err)
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task did not start")
+	}
+
+	require.NoError(t, svc.CancelRun(context.Background(), runID))
+	require.Eventually(t, func() bool {
+		return runStatus(store, runID) == int(types.WorkflowRunCancelled)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Contains(t, store.stepStatus, int(types.WorkflowRunCancelled))
+}
+
+func TestServiceCancelRun_NotInProcess(t *testing.T) {
+	t.Parallel()
+	stale := time.Now().Add(-2 * staleRunAfter)
+	tests := []struct {
+		name       string
+		status     types.WorkflowRunState
+		startedAt  time.Time
+		heartbeat  time.Time
+		runID      int64
+		wantErr    error
+		wantStatus types.WorkflowRunState
+	}{
+		{name: "finished run", status: types.WorkflowRunDone, wantErr: types.ErrConflict, wantStatus: types.WorkflowRunDone},
+		{name: "waiting run", status: types.WorkflowRunWaiting, startedAt: time.Now(), wantStatus: types.WorkflowRunCancelled},
+		{name: "stale running run", status: types.WorkflowRunRunning, startedAt: stale, wantStatus: types.WorkflowRunCancelled},
+		{name: "recently started run", status: types.WorkflowRunRunning, startedAt: time.Now(), wantErr: types.ErrConflict, wantStatus: types.WorkflowRunRunning},
+		{name: "checkpoint heartbeat is fresh", status: types.WorkflowRunRunning, startedAt: stale, heartbeat: time.Now(), wantErr: types.ErrConflict, wantStatus: types.WorkflowRunRunning},
+		{name: "checkpoint heartbeat is stale", status: types.WorkflowRunRunning, startedAt: stale, heartbeat: stale, wantStatus: types.WorkflowRunCancelled},
+		{name: "invalid id", runID: -1, wantErr: types.ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := newMockWorkflowStore()
+			svc := controlService(store)
+			run, err := store.CreateRun(context.Background(), 0, "wf", "db", "manual", nil, nil)
+			require.NoError(t, err)
+			run.Status = int(tt.status)
+			run.StartedAt = tt.startedAt
+			if !tt.heartbeat.IsZero() {
+				require.NoError(t, store.SaveCheckpoint(context.Background(), run.ID, &CheckpointData{HeartbeatAt: tt.heartbeat}))
+			}
+			runID := run.ID
+			if tt.runID != 0 {
+				runID = tt.runID
+			}
+
+			err = svc.CancelRun(context.Background(), runID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantStatus != 0 {
+				assert.Equal(t, int(tt.wantStatus), runStatus(store, run.ID))
+			}
+		})
+	}
+}
+
+// barrierSwapStore holds every SwapRunStatus call until n callers arrive, so
+// concurrent retries all pass their status check before any of them swaps.
+type barrierSwapStore struct {
+	*mockWorkflowStore
+	arrived sync.WaitGroup
+}
+
+func (s *barrierSwapStore) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error) {
+	s.arrived.Done()
+	s.arrived.Wait()
+	return s.mockWorkflowStore.SwapRunStatus(ctx, runID, from, to, errMsg)
+}
+
+func TestServiceRetryRun_Concurrent(t *testing.T) {
+	registerWorkflowCapabilityInvoker(t)
+	const retries = 2
+	store := &barrierSwapStore{mockWorkflowStore: newMockWorkflowStore()}
+	store.arrived.Add(retries)
+	catalog := &mockCatalog{meta: map[string]*types.WorkflowMetadata{"race-wf": {
+		Name:      "race-wf",
+		Resumable: true,
+		Pipeline:  []string{"m1", "cap1"},
+		Tasks: []types.WorkflowTask{
+			{ID: "m1", Action: "mapper:", Params: types.KV{"seed": "v"}},
+			{ID: "cap1", Action: "capability:example.echo", Params: types.KV{"value": `{{step "m1" "seed"}}`}},
+		},
+	}}}
+	svc := NewService(catalog, store, nil, nil)
+	run, err := store.CreateRun(context.Background(), 0, "race-wf", "db", "manual", nil, nil)
+	require.NoError(t, err)
+	run.Status = int(types.WorkflowRunFailed)
+	require.NoError(t, store.SaveCheckpoint(context.Background(), run.ID, &CheckpointData{
+		StepIndex:   1,
+		StepResults: map[string]string{"m1": `{"seed":"v"}`},
+		HeartbeatAt: time.Now(),
+	}))
+
+	errs := make(chan error, retries)
+	for range retries {
+		go func() { errs <- svc.RetryRun(context.Background(), run.ID) }()
+	}
+	var won, lost int
+	for range retries {
+		if err := <-errs; err == nil {
+			won++
+		} else {
+			require.ErrorIs(t, err, types.ErrConflict)
+			lost++
+		}
+	}
+	assert.Equal(t, 1, won)
+	assert.Equal(t, retries-1, lost)
+	require.Eventually(t, func() bool {
+		return runStatus(store.mockWorkflowStore, run.ID) == int(types.WorkflowRunDone)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Len(t, store.stepRuns, 1, "only the winning retry resumes the run")
+}
+
+func TestServiceRetryRun(t *testing.T) {
+	registerWorkflowCapabilityInvoker(t)
+
+	resumable := &types.WorkflowMetadata{
+		Name:      "retry-wf",
+		Resumable: true,
+		Pipeline:  []string{"m1", "cap1"},
+		Tasks: []types.WorkflowTask{
+			{ID: "m1", Action: "mapper:", Params: types.KV{"seed": "v"}},
+			{ID: "cap1", Action: "capability:example.echo", Params: types.KV{"value": `{{step "m1" "seed"}}`}},
+		},
+	}
+	oneShot := &types.WorkflowMetadata{Name: "one-shot", Pipeline: []string{"m1"}, Tasks: []types.WorkflowTask{{ID: "m1", Action: "mapper:"}}}
+
+	tests := []struct {
+		name       string
+		workflow   string
+		status     types.WorkflowRunState
+		checkpoint *CheckpointData
+		wantErr    error
+	}{
+		{
+			name:     "resumes from failed step",
+			workflow: "retry-wf",
+			status:   types.WorkflowRunFailed,
+			checkpoint: &CheckpointData{
+				StepIndex:   1,
+				StepResults: map[string]string{"m1": `{"seed":"v"}`},
+				HeartbeatAt: time.Now(),
+			},
+		},
+		{name: "running run", workflow: "retry-wf", status: types.WorkflowRunRunning, wantErr: types.ErrConflict},
+		{name: "not resumable", workflow: "one-shot", status: types.WorkflowRunFailed, wantErr: types.ErrInvalidArgument},
+		{name: "missing checkpoint", workflow: "retry-wf", status: types.WorkflowRunCancelled, wantErr: types.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMockWorkflowStore()
+			svc := controlService(store, resumable, oneShot)
+			run, err := store.CreateRun(context.Background(), 0, tt.workflow, "db", "manual", nil, nil)
+			require.NoError(t, err)
+			run.Status = int(tt.status)
+			if tt.checkpoint != nil {
+				require.NoError(t, store.SaveCheckpoint(context.Background(), run.ID, tt.checkpoint))
+			}
+
+			err = svc.RetryRun(context.Background(), run.ID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Eventually(t, func() bool {
+				return runStatus(store, run.ID) == int(types.WorkflowRunDone)
+			}, 5*time.Second, 10*time.Millisecond)
+
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			var steps []string
+			for _, sr := range store.stepRuns {
+				steps = append(steps, sr.StepID)
+			}
+			assert.Equal(t, []string{"cap1"}, steps, "completed steps are not re-run")
+		})
+	}
+}
+
+func TestServiceRerunRun(t *testing.T) {
+	t.Parallel()
+	store := newMockWorkflowStore()
+	svc := controlService(store, &types.WorkflowMetadata{
+		Name:     "rerun-wf",
+		Inputs:   []types.WorkflowInputDef{{Name: "url", Type: "string", Required: true}},
+		Pipeline: []string{"m1"},
+		Tasks:    []types.WorkflowTask{{ID: "m1", Action: "mapper:", Params: types.KV{"url": "{{input.url}}"}}},
+	})
+	first, err := svc.StartRunAsync(context.Background(), "rerun-wf", "cron", types.KV{"url": "https://a"})
+	require.NoError(t, err)
+
+	second, err := svc.RerunRun(context.Background(), first)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Equal(t, "manual", store.runs[second].TriggerType)
+	assert.Equal(t, map[string]any{"url": "https://a"}, store.runs[second].InputParams)
+}
@@ -46,6 +46,7 @@ func MetadataFromRows(rows WorkflowRows) (*types.WorkflowMetadata, error) {
 			Vars:     append([]string(nil), t.Vars...),
 			Conn:     append([]string(nil), t.Conn...),
 			Retry:    retryFromMap(t.Retry),
+			Outputs:  outputsFromMaps(t.Outputs),
 		})
 	}
 	for _, tr := range rows.Triggers {
@@ -86,6 +87,21 @@ func InputsToMaps(inputs []types.WorkflowInputDef) []map[string]any {
 	return out
 }
 
+// OutputsToMaps converts task output declarations to JSON-friendly maps for storage.
+func OutputsToMaps(outputs []types.WorkflowOutputDef) []map[string]any {
+	if len(outputs) == 0 {
+		return nil
+	}
+	out := make([]map[string]any, 0, len(outputs))
+	for _, o := range outputs {
+		out = append(out, map[string]any{
+			"name": o.Name,
+			"path": o.Path,
+		})
+	}
+	return out
+}
+
 // RetryToMap converts RetryConfig to a JSON map for storage.
 func RetryToMap(r *types.RetryConfig) map[string]any {
 	if r == nil {
@@ -201,6 +217,20 @@ func inputsFromMaps(raw []map[string]any) []types.WorkflowInputDef {
 	return out
 }
 
+func outputsFromMaps(raw []map[string]any) []types.WorkflowOutputDef {
+	if len(raw) == 0 {
+		return nil
+	}
+	out := make([]types.WorkflowOutputDef, 0, len(raw))
+	for _, m := range raw {
+		out = append(out, types.WorkflowOutputDef{
+			Name: stringFromAny(m["name"]),
+			Path: stringFromAny(m["path"]),
+		})
+	}
+	return out
+}
+
 func retryFromMap(m map[string]any) *types.RetryConfig {
 	if len(m) == 0 {
 		return nil
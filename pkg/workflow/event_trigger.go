@@ -0,0 +1,259 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/types"
+)
+
+// EventConsumptionStore is the (consumer, event id) ledger the pipeline engine
+// uses for DataEvent idempotency. Workflow event triggers record into the same
+// ledger under the consumer name "workflow:<name>".
+type EventConsumptionStore interface {
+	HasConsumed(ctx context.Context, consumerName, eventID string) (bool, error)
+	RecordConsumption(ctx context.Context, consumerName, eventID string) error
+}
+
+// eventTrigger is one enabled `type: event` workflow trigger.
+type eventTrigger struct {
+	workflow string
+	patterns []string
+	filter   *pipeline.TriggerFilter
+	// inputs maps a workflow input name to a dotted event path; empty copies event.Data.
+	inputs   map[string]string
+	declared []types.WorkflowInputDef
+}
+
+// matches reports whether the event type matches a pattern and the filter.
+func (t eventTrigger) matches(event types.DataEvent) bool {
+	for _, p := range t.patterns {
+		if ok, _ := path.Match(p, event.EventType); ok {
+			return t.filter.Match(event)
+		}
+	}
+	return false
+}
+
+// input builds the run input from the event: mapped paths when rule.inputs is
+// set, otherwise a copy of event.Data. The event envelope is kept under _event.
+func (t eventTrigger) input(event types.DataEvent) types.KV {
+	input := types.KV{}
+	if len(t.inputs) == 0 {
+		maps.Copy(input, event.Data)
+	} else {
+		envelope := eventMeta(event)
+		envelope["data"] = map[string]any(event.Data)
+		envelope["tags"] = map[string]any(event.Tags)
+		for name, p := range t.inputs {
+			if v, ok := lookupEventPath(envelope, p); ok {
+				input[name] = v
+			}
+		}
+	}
+	input["_event"] = eventMeta(event)
+	return input
+}
+
+func eventConsumerName(workflowName string) string {
+	return "workflow:" + workflowName
+}
+
+// eventMeta is the event envelope without data and tags.
+func eventMeta(event types.DataEvent) map[string]any {
+	return map[string]any{
+		"event_id":   event.EventID,
+		"event_type": event.EventType,
+		"source":     event.Source,
+		"capability": event.Capability,
+		"operation":  event.Operation,
+		"app":        event.App,
+		"entity_id":  event.EntityID,
+		"uid":        event.UID,
+	}
+}
+
+func lookupEventPath(envelope map[string]any, dotted string) (any, bool) {
+	var cur any = envelope
+	for p := range strings.SplitSeq(dotted, ".") {
+		switch m := cur.(type) {
+		case map[string]any:
+			cur = m[p]
+		case types.KV:
+			cur = m[p]
+		default:
+			return nil, false
+		}
+		if cur == nil {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// eventTriggerFromRule parses rule.event (string or list of glob patterns),
+// rule.filter (pipeline trigger filter fields) and rule.inputs (name -> path).
+func eventTriggerFromRule(name string, declared []types.WorkflowInputDef, rule types.KV) (eventTrigger, error) {
+	patterns := stringsFromRule(rule, "event")
+	if len(patterns) == 0 {
+		return eventTrigger{}, fmt.Errorf("event trigger requires rule.event")
+	}
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return eventTrigger{}, fmt.Errorf("invalid event pattern %q: %w", p, err)
+		}
+	}
+	filter, err := eventFilterFromRule(rule)
+	if err != nil {
+		return eventTrigger{}, err
+	}
+	inputs, err := eventInputsFromRule(rule)
+	if err != nil {
+		return eventTrigger{}, err
+	}
+	return eventTrigger{
+		workflow: name,
+		patterns: patterns,
+		filter:   filter,
+		inputs:   inputs,
+		declared: declared,
+	}, nil
+}
+
+func stringsFromRule(rule types.KV, key string) []string {
+	if s := stringFromRule(rule, key); s != "" {
+		return []string{s}
+	}
+	items, ok := rule[key].([]any)
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, item := range items {
+		if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+			out = append(out, strings.TrimSpace(s))
+		}
+	}
+	return out
+}
+
+func eventFilterFromRule(rule types.KV) (*pipeline.TriggerFilter, error) {
+	raw, ok := rule["filter"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	data, err := sonic.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("event trigger filter: %w", err)
+	}
+	var filter pipeline.TriggerFilter
+	if err := sonic.Unmarshal(data, &filter); err != nil {
+		return nil, fmt.Errorf("event trigger filter: %w", err)
+	}
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+	return &filter, nil
+}
+
+func eventInputsFromRule(rule types.KV) (map[string]string, error) {
+	raw, ok := rule["inputs"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	m, ok := raw.(map[string]any)
+	if !ok {
+		if kv, isKV := raw.(types.KV); isKV {
+			m = map[string]any(kv)
+		} else {
+			return nil, fmt.Errorf("event trigger inputs must be a map of input name to event path")
+		}
+	}
+	out := make(map[string]string, len(m))
+	for name, v := range m {
+		p, ok := v.(string)
+		if !ok || strings.TrimSpace(p) == "" {
+			return nil, fmt.Errorf("event trigger input %q must be an event path such as data.url", name)
+		}
+		out[name] = strings.TrimSpace(p)
+	}
+	return out, nil
+}
+
+// HandleEvent starts a run of every enabled workflow whose event trigger
+// matches the DataEvent. Like the pipeline engine, filters run before dedup so
+// rejected events leave no consumption record, and each workflow consumes an
+// event id at most once. Errors are logged per workflow; the event is never
+// failed back to the bus.
+func (s *Service) HandleEvent(ctx context.Context, event types.DataEvent) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	triggers := s.events
+	s.mu.RUnlock()
+
+	started := make(map[string]bool)
+	for _, tr := range triggers {
+		if started[tr.workflow] || !tr.matches(event) {
+			continue
+		}
+		input := ApplyInputDefaults(tr.declared, tr.input(event))
+		if err := ValidateInputs(tr.declared, input); err != nil {
+			flog.Warn("workflow %s: event %s (%s) skipped: %v", tr.workflow, event.EventID, event.EventType, err)
+			continue
+		}
+		started[tr.workflow] = true
+
+		consumed, err := s.consumeEvent(ctx, tr.workflow, event.EventID)
+		if err != nil {
+			flog.Error(fmt.Errorf("workflow %s: event %s: %w", tr.workflow, event.EventID, err))
+			continue
+		}
+		if consumed {
+			continue
+		}
+		runID, err := s.StartRunAsync(ctx, tr.workflow, "event", input)
+		if err != nil {
+			flog.Error(fmt.Errorf("workflow %s: event %s start: %w", tr.workflow, event.EventID, err))
+			continue
+		}
+		flog.Info("workflow %s: event %s (%s) started run %d", tr.workflow, event.EventID, event.EventType, runID)
+	}
+	return nil
+}
+
+// consumeEvent records the event for the workflow and reports whether it was
+// already consumed, mirroring the pipeline engine's checkDedupAndRecord.
+func (s *Service) consumeEvent(ctx context.Context, workflowName, eventID string) (bool, error) {
+	if s.consumptions == nil || eventID == "" {
+		return false, nil
+	}
+	consumer := eventConsumerName(workflowName)
+	consumed, err := s.consumptions.HasConsumed(ctx, consumer, eventID)
+	if err != nil {
+		return false, fmt.Errorf("check consumption: %w", err)
+	}
+	if consumed {
+		flog.Info("workflow %s already consumed event %s", workflowName, eventID)
+		return true, nil
+	}
+	if err := s.consumptions.RecordConsumption(ctx, consumer, eventID); err != nil {
+		// Concurrent deliveries may race past HasConsumed; the unique
+		// (consumer_name, event_id) index then rejects the second record.
+		consumedAfter, checkErr := s.consumptions.HasConsumed(ctx, consumer, eventID)
+		if checkErr == nil && consumedAfter {
+			flog.Info("workflow %s already consumed event %s (record race)", workflowName, eventID)
+			return true, nil
+		}
+		return false, fmt.Errorf("record consumption: %w", err)
+	}
+	return false, nil
+}
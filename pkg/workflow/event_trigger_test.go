@@ -0,0 +1,171 @@
+package workflow
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flowline-io/flowbot/pkg/types"
+	"github.com/flowline-io/flowbot/pkg/types/model"
+)
+
+type mockConsumptions struct {
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+func (m *mockConsumptions) HasConsumed(_ context.Context, consumer, eventID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.seen[consumer+"|"+eventID], nil
+}
+
+func (m *mockConsumptions) RecordConsumption(_ context.Context, consumer, eventID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.seen == nil {
+		m.seen = make(map[string]bool)
+	}
+	m.seen[consumer+"|"+eventID] = true
+	return nil
+}
+
+func TestEventTriggerFromRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		rule       types.KV
+		event      types.DataEvent
+		wantMatch  bool
+		wantErr    string
+		wantInputs types.KV
+	}{
+		{
+			name:       "exact type copies data",
+			rule:       types.KV{"event": "bookmark.created"},
+			event:      types.DataEvent{EventID: "e1", EventType: "bookmark.created", Data: types.KV{"url": "https://a"}},
+			wantMatch:  true,
+			wantInputs: types.KV{"url": "https://a"},
+		},
+		{
+			name:      "glob pattern list",
+			rule:      types.KV{"event": []any{"github.*", "kanban.task.*"}},
+			event:     types.DataEvent{EventType: "kanban.task.moved"},
+			wantMatch: true,
+		},
+		{
+			name:  "pattern mismatch",
+			rule:  types.KV{"event": "github.*"},
+			event: types.DataEvent{EventType: "bookmark.created"},
+		},
+		{
+			name: "filter rejects other app",
+			rule: types.KV{
+				"event":  "bookmark.*",
+				"filter": map[string]any{"app": []any{"karakeep"}, "data": map[string]any{"archived": false}},
+			},
+			event: types.DataEvent{EventType: "bookmark.created", App: "linkding", Data: types.KV{"archived": false}},
+		},
+		{
+			name: "filter and mapped inputs",
+			rule: types.KV{
+				"event":  "bookmark.*",
+				"filter": map[string]any{"app": []any{"karakeep"}},
+				"inputs": map[string]any{"url": "data.link.url", "id": "entity_id", "missing": "data.nope"},
+			},
+			event: types.DataEvent{
+				EventType: "bookmark.created",
+				App:       "karakeep",
+				EntityID:  "b-1",
+				Data:      types.KV{"link": map[string]any{"url": "https://b"}, "other": 1},
+			},
+			wantMatch:  true,
+			wantInputs: types.KV{"url": "https://b", "id": "b-1"},
+		},
+		{
+			name:    "missing event rejected",
+			rule:    types.KV{},
+			wantErr: "rule.event",
+		},
+		{
+			name:    "bad pattern rejected",
+			rule:    types.KV{"event": "a["},
+			wantErr: "invalid event pattern",
+		},
+		{
+			name:    "non-string input path rejected",
+			rule:    types.KV{"event": "a", "inputs": map[string]any{"x": 1}},
+			wantErr: "event path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tr, err := eventTriggerFromRule("wf", nil, tt.rule)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMatch, tr.matches(tt.event))
+			if tt.wantInputs == nil {
+				return
+			}
+			input := tr.input(tt.event)
+			meta, ok := input["_event"].(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, tt.event.EventType, meta["event_type"])
+			delete(input, "_event")
+			assert.Equal(t, tt.wantInputs, input)
+		})
+	}
+}
+
+func TestService_HandleEvent(t *testing.T) {
+	meta := sampleMeta("on-bookmark")
+	meta.Triggers = []types.WorkflowTriggerDef{
+		{Type: "event", Enabled: true, Rule: types.KV{"event": "bookmark.*", "filter": map[string]any{"source": []any{"capability"}}}},
+		{Type: "event", Enabled: true, Rule: types.KV{"event": "bookmark.created"}},
+		{Type: "event", Enabled: false, Rule: types.KV{"event": "*"}},
+	}
+	runs := &mockRunStore{}
+	consumptions := &mockConsumptions{}
+	svc := NewService(&mockCatalog{
+		meta: map[string]*types.WorkflowMetadata{"on-bookmark": meta},
+		defs: []*model.Workflow{{ID: 7, Name: "on-bookmark", Enabled: true}},
+	}, runs, nil, nil).WithConsumptions(consumptions)
+	require.NoError(t, svc.ReloadTriggers(context.Background()))
+	t.Cleanup(svc.Stop)
+
+	created := func() int {
+		runs.mu.Lock()
+		defer runs.mu.Unlock()
+		return len(runs.created)
+	}
+	ctx := context.Background()
+
+	event := types.DataEvent{EventID: "e1", EventType: "bookmark.created", Source: "capability", Data: types.KV{"url": "https://a"}}
+	require.NoError(t, svc.HandleEvent(ctx, event))
+	assert.Equal(t, 1, created(), "two matching triggers start one run")
+
+	require.NoError(t, svc.HandleEvent(ctx, event))
+	assert.Equal(t, 1, created(), "redelivered event is deduped")
+
+	require.NoError(t, svc.HandleEvent(ctx, types.DataEvent{EventID: "e2", EventType: "bookmark.created", Data: types.KV{"url": 3}}))
+	assert.Equal(t, 1, created(), "invalid input skips the run")
+	assert.False(t, consumptions.seen["workflow:on-bookmark|e2"], "skipped event leaves no consumption record")
+
+	require.NoError(t, svc.HandleEvent(ctx, types.DataEvent{EventID: "e3", EventType: "github.push", Data: types.KV{"url": "x"}}))
+	assert.Equal(t, 1, created())
+
+	require.NoError(t, svc.HandleEvent(ctx, types.DataEvent{EventID: "e4", EventType: "bookmark.updated", Source: "capability", Data: types.KV{"url": "https://b"}}))
+	assert.Equal(t, 2, created())
+	assert.Equal(t, "event", runs.created[1].TriggerType)
+
+	time.Sleep(50 * time.Millisecond)
+}
@@ -68,6 +68,15 @@ func ParseYAML(data []byte) (*types.WorkflowMetadata, error) {
 	if err := ValidateDAG(wf.Tasks); err != nil {
 		return nil, fmt.Errorf("workflow dag: %w", err)
 	}
+	if err := validateTaskOutputs(wf.Tasks); err != nil {
+		return nil, err
+	}
+	if err := validateApprovalTasks(&wf); err != nil {
+		return nil, err
+	}
+	if err := validateCallTasks(&wf); err != nil {
+		return nil, err
+	}
 	return &wf, nil
 }
 
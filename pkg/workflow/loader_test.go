@@ -82,6 +82,49 @@ tasks:
 			wantErr:     true,
 			errContains: "workflow dag",
 		},
+		{
+			name: "task outputs",
+			setup: func(t *testing.T) string {
+				t.Helper()
+				path := filepath.Join(t.TempDir(), "outputs.yaml")
+				content := []byte(`name: outputs-wf
+pipeline:
+  - build
+tasks:
+  - id: build
+    action: shell:make
+    outputs:
+      - name: bin
+        path: out/app
+`)
+				require.NoError(t, os.WriteFile(path, content, 0o600))
+				return path
+			},
+			check: func(t *testing.T, wf *types.WorkflowMetadata) {
+				assert.Equal(t, []types.WorkflowOutputDef{{Name: "bin", Path: "out/app"}}, wf.Tasks[0].Outputs)
+			},
+		},
+		{
+			name: "outputs on mapper task",
+			setup: func(t *testing.T) string {
+				t.Helper()
+				path := filepath.Join(t.TempDir(), "bad-outputs.yaml")
+				content := []byte(`name: bad-outputs-wf
+pipeline:
+  - m
+tasks:
+  - id: m
+    action: "mapper:"
+    outputs:
+      - name: x
+        path: x
+`)
+				require.NoError(t, os.WriteFile(path, content, 0o600))
+				return path
+			},
+			wantErr:     true,
+			errContains: "outputs are only supported",
+		},
 	}
 
 	for _, tt := range tests {
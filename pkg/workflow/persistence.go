@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 )
@@ -13,18 +15,27 @@ type CheckpointData struct {
 	StepIndex      int               `json:"step_index"`
 	CompletedTasks map[string]bool   `json:"completed_tasks"`
 	StepResults    map[string]string `json:"step_results"`
-	Input          types.KV          `json:"input"`
-	HeartbeatAt    time.Time         `json:"heartbeat_at"`
+	// Artifacts are the outputs published by completed tasks, keyed by task ID.
+	Artifacts map[string][]types.WorkflowArtifact `json:"artifacts,omitempty"`
+	// Approvals are the approval gates opened so far, keyed by task ID.
+	Approvals   map[string]*runapproval.Request `json:"approvals,omitempty"`
+	Input       types.KV                        `json:"input"`
+	HeartbeatAt time.Time                       `json:"heartbeat_at"`
 }
 
 // WorkflowRunStore persists workflow runs, step runs, and checkpoint data.
 type WorkflowRunStore interface {
 	CreateRun(ctx context.Context, workflowID int64, workflowName, workflowFile, triggerType string, triggerInfo, inputParams map[string]any) (*model.WorkflowRun, error)
 	UpdateRunStatus(ctx context.Context, runID int64, status int, errMsg string) error
+	// SwapRunStatus sets the status only while it is one of from and reports
+	// whether the run was updated.
+	SwapRunStatus(ctx context.Context, runID int64, from []int, to int, errMsg string) (bool, error)
 	CreateStepRun(ctx context.Context, runID int64, stepID, stepName, action, actionType string, params map[string]any, attempt int) (*model.WorkflowStepRun, error)
 	UpdateStepRun(ctx context.Context, stepRunID int64, status int, result map[string]any, errMsg string, attempt int) error
 	SaveCheckpoint(ctx context.Context, runID int64, data any) error
 	GetIncompleteRuns(ctx context.Context) ([]*model.WorkflowRun, error)
+	GetWaitingRuns(ctx context.Context) ([]*model.WorkflowRun, error)
+	SetRunParent(ctx context.Context, runID int64, parent runcall.Parent) error
 	GetCheckpoint(ctx context.Context, runID int64, target any) error
 	GetRun(ctx context.Context, runID int64) (*model.WorkflowRun, error)
 	UpdateRunHeartbeat(ctx context.Context, runID int64) error
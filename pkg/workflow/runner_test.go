@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/capability"
 	"github.com/flowline-io/flowbot/pkg/executor/runtime"
 	"github.com/flowline-io/flowbot/pkg/hub"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 )
@@ -38,7 +40,7 @@ func newMockWorkflowStore() *mockWorkflowStore {
 	}
 }
 
-func (m *mockWorkflowStore) CreateRun(_ context.Context, workflowID int64, workflowName, _, triggerType string, _, _ map[string]any) (*model.WorkflowRun, error) {
+func (m *mockWorkflowStore) CreateRun(_ context.Context, workflowID int64, workflowName, _, triggerType string, _, inputParams map[string]any) (*model.WorkflowRun, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.nextRunID++
@@ -47,6 +49,7 @@ func (m *mockWorkflowStore) CreateRun(_ context.Context, workflowID int64, workf
 		WorkflowName: workflowName,
 		TriggerType:  triggerType,
 		Status:       int(types.WorkflowRunRunning),
+		InputParams:  inputParams,
 	}
 	if workflowID != 0 {
 		run.WorkflowID = &workflowID
@@ -97,6 +100,21 @@ func (m *mockWorkflowStore) UpdateRunStatus(ctx context.Context, runID int64, st
 	return nil
 }
 
+func (m *mockWorkflowStore) SwapRunStatus(ctx context.Context, runID int64, from []int, to int, _ string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run, ok := m.runs[runID]
+	if !ok || !slices.Contains(from, run.Status) {
+		return false, nil
+	}
+	m.statusLog = append(m.statusLog, to)
+	run.Status = to
+	return true, nil
+}
+
 func (m *mockWorkflowStore) CreateStepRun(_ context.Context, _ int64, stepID, stepName, action, actionType string, params map[string]any, attempt int) (*model.WorkflowStepRun, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -147,6 +165,29 @@ func (m *mockWorkflowStore) GetIncompleteRuns(context.Context) ([]*model.Workflo
 	return nil, nil
 }
 
+func (m *mockWorkflowStore) GetWaitingRuns(context.Context) ([]*model.WorkflowRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var waiting []*model.WorkflowRun
+	for _, run := range m.runs {
+		if run.Status == int(types.WorkflowRunWaiting) {
+			waiting = append(waiting, run)
+		}
+	}
+	return waiting, nil
+}
+
+func (m *mockWorkflowStore) SetRunParent(_ context.Context, runID int64, parent runcall.Parent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if run, ok := m.runs[runID]; ok {
+		run.ParentKind = parent.Kind
+		run.ParentRunID = &parent.RunID
+		run.CallDepth = parent.Depth
+	}
+	return nil
+}
+
 func (m *mockWorkflowStore) GetCheckpoint(_ context.Context, runID int64, target any) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -2,6 +2,7 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"sync"
@@ -10,6 +11,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/backoff"
 	"github.com/flowline-io/flowbot/pkg/executor"
 	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 )
@@ -74,6 +76,9 @@ type parallelTaskFn func(
 func (r *Runner) runParallel(ctx context.Context, wf types.WorkflowMetadata, input types.KV, taskMap map[string]types.WorkflowTask, run *model.WorkflowRun, cancelHeartbeat context.CancelFunc) error {
 	nodes, ready, err := buildDAG(wf.Tasks)
 	if err != nil {
+		if cancelHeartbeat != nil {
+			cancelHeartbeat()
+		}
 		return fmt.Errorf("build dag: %w", err)
 	}
 
@@ -82,7 +87,9 @@ func (r *Runner) runParallel(ctx context.Context, wf types.WorkflowMetadata, inp
 	defer func() {
 		if r.metrics != nil {
 			status := "done"
-			if finalErr != nil {
+			if errors.Is(finalErr, runapproval.ErrPending) {
+				status = "waiting"
+			} else if finalErr != nil {
 				status = "failed"
 			}
 			r.metrics.IncRunTotal(wf.Name, status)
@@ -106,6 +113,9 @@ func (r *Runner) runParallel(ctx context.Context, wf types.WorkflowMetadata, inp
 
 	for totalRemaining > 0 {
 		r.dispatchReadyTasks(ctx, wf, &ready, &activeCount, taskMap, nodes, input, &results, &mu, run, sem, &wg, done, &firstErr, &errOnce, cancel, r.runParallelTaskHandler)
+		if r.parallelIdle(&mu, activeCount) {
+			break
+		}
 
 		select {
 		case <-ctx.Done():
@@ -123,10 +133,20 @@ func (r *Runner) runParallel(ctx context.Context, wf types.WorkflowMetadata, inp
 
 drain:
 	finalErr = firstErr
+	if r.suspendParallel(ctx, wf, run, cancelHeartbeat, firstErr, totalRemaining, results, &mu, input) {
+		finalErr = runapproval.ErrPending
+		wg.Wait()
+		return nil
+	}
 	// Persist terminal status before waiting on cancelled siblings so a hung
 	// branch cannot leave the run stuck in Running.
 	statusErr := r.finalizeParallelStatus(ctx, run, 0, finalErr)
 	wg.Wait()
+	if statusErr == nil {
+		mu.RLock()
+		r.setOutputs(results)
+		mu.RUnlock()
+	}
 	if cancelHeartbeat != nil {
 		cancelHeartbeat()
 	}
@@ -211,6 +231,12 @@ func (r *Runner) runParallelTaskHandler(
 	}
 
 	rerr := r.executeParallelTask(ctx, taskID, wt, nodes, input, results, mu, run, ready, wf)
+	if errors.Is(rerr, runapproval.ErrPending) {
+		if r.metrics != nil {
+			r.metrics.IncStepTotal(wf.Name, taskID, "waiting")
+		}
+		return
+	}
 	if rerr != nil {
 		errOnce.Do(func() {
 			*firstErr = rerr
@@ -246,7 +272,7 @@ func (r *Runner) runParallelResumeTaskHandler(
 	cancel context.CancelFunc,
 ) {
 	rerr := r.executeParallelTask(ctx, taskID, wt, nodes, input, results, mu, run, ready, wf)
-	if rerr != nil {
+	if rerr != nil && !errors.Is(rerr, runapproval.ErrPending) {
 		errOnce.Do(func() {
 			*firstErr = rerr
 			cancel()
@@ -267,7 +293,7 @@ func (r *Runner) finalizeParallelStatus(ctx context.Context, run *model.Workflow
 	}
 	storeCtx := workflowStoreCtx(ctx)
 	if err != nil {
-		_ = r.store.UpdateRunStatus(storeCtx, id, int(types.WorkflowRunFailed), err.Error())
+		_ = r.store.UpdateRunStatus(storeCtx, id, int(terminalRunStatus(err)), err.Error())
 		return err
 	}
 	_ = r.store.UpdateRunStatus(storeCtx, id, int(types.WorkflowRunDone), "")
@@ -294,7 +320,7 @@ func (r *Runner) executeParallelTask(
 	}
 
 	mu.RLock()
-	params, err := resolveParams(wt.Params, *results, input)
+	params, err := r.resolveTaskParams(ctx, wt, *results, input)
 	mu.RUnlock()
 	if err != nil {
 		r.failRun(ctx, run, nil, fmt.Errorf("resolve params step %s: %w", taskID, err))
@@ -302,6 +328,28 @@ func (r *Runner) executeParallelTask(
 	}
 
 	info := ParseAction(wt.Action)
+	if info.Type == approvalActionType {
+		result, aerr := r.executeApprovalTask(ctx, taskID, wt, params, run)
+		if aerr != nil {
+			return aerr
+		}
+		mu.Lock()
+		(*results)[taskID] = result
+		mu.Unlock()
+		r.enqueueDependentsAndSaveCheckpoint(ctx, nodes, taskID, results, mu, ready, wf, input, run)
+		return nil
+	}
+	if info.IsCall {
+		result, cerr := r.executeCallTask(ctx, taskID, wt, params, info, run)
+		if cerr != nil {
+			return cerr
+		}
+		mu.Lock()
+		(*results)[taskID] = result
+		mu.Unlock()
+		r.enqueueDependentsAndSaveCheckpoint(ctx, nodes, taskID, results, mu, ready, wf, input, run)
+		return nil
+	}
 
 	var stepRun *model.WorkflowStepRun
 	if r.store != nil && run != nil {
@@ -381,6 +429,11 @@ func (r *Runner) executeExecutorStep(
 		r.failStep(ctx, stepRun, err, 1)
 		return err
 	}
+	if err := r.mountArtifacts(ctx, task, wt.Conn); err != nil {
+		err = fmt.Errorf("step %s: %w", taskID, err)
+		r.failStep(ctx, stepRun, err, 1)
+		return err
+	}
 
 	rt := DetermineRuntimeType(task)
 	engine := executor.New(rt)
@@ -406,6 +459,13 @@ func (r *Runner) executeExecutorStep(
 		return fmt.Errorf("step %s failed: %w", taskID, rerr)
 	}
 
+	artifacts, err := r.publishArtifacts(taskID, wt.Outputs, task.Artifacts)
+	if err != nil {
+		err = fmt.Errorf("step %s: %w", taskID, err)
+		r.failStep(ctx, stepRun, err, attempt)
+		return err
+	}
+
 	if task.Result != "" {
 		mu.Lock()
 		(*results)[taskID] = task.Result
@@ -413,11 +473,7 @@ func (r *Runner) executeExecutorStep(
 	}
 
 	if r.store != nil && stepRun != nil {
-		result := map[string]any{}
-		if task.Result != "" {
-			result["result"] = task.Result
-		}
-		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), result, "", attempt)
+		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), stepResult(task, artifacts), "", attempt)
 	}
 
 	flog.Info("[workflow] step %s completed (parallel)", taskID)
@@ -449,25 +505,56 @@ func (r *Runner) enqueueDependentsAndSaveCheckpoint(
 		}
 	}
 
-	if wf.Resumable && r.store != nil && run != nil {
-		completedTasks := make(map[string]bool)
-		for taskID := range *results {
-			completedTasks[taskID] = true
-		}
-		resultCopy := make(map[string]string, len(*results))
-		maps.Copy(resultCopy, *results)
-		cp := CheckpointData{
-			CompletedTasks: completedTasks,
-			StepResults:    resultCopy,
-			Input:          input,
-			HeartbeatAt:    time.Now(),
-		}
-		if cerr := r.store.SaveCheckpoint(workflowStoreCtx(ctx), run.ID, &cp); cerr != nil {
-			flog.Error(fmt.Errorf("[workflow] save checkpoint step %s: %w", taskID, cerr))
-		}
+	r.saveParallelCheckpoint(ctx, *results, wf, input, run)
+}
+
+// saveParallelCheckpoint persists the completed tasks of a resumable parallel
+// run. The caller must hold the results lock.
+func (r *Runner) saveParallelCheckpoint(ctx context.Context, results map[string]string, wf *types.WorkflowMetadata, input types.KV, run *model.WorkflowRun) {
+	if !wf.Resumable || r.store == nil || run == nil {
+		return
+	}
+	completedTasks := make(map[string]bool)
+	for taskID := range results {
+		completedTasks[taskID] = true
+	}
+	resultCopy := make(map[string]string, len(results))
+	maps.Copy(resultCopy, results)
+	cp := CheckpointData{
+		CompletedTasks: completedTasks,
+		StepResults:    resultCopy,
+		Artifacts:      r.artifactSnapshot(),
+		Approvals:      r.approvalSnapshot(),
+		Input:          input,
+		HeartbeatAt:    time.Now(),
+	}
+	if cerr := r.store.SaveCheckpoint(workflowStoreCtx(ctx), run.ID, &cp); cerr != nil {
+		flog.Error(fmt.Errorf("[workflow] save checkpoint run %d: %w", run.ID, cerr))
 	}
 }
 
+// parallelIdle reports whether no task is running after a dispatch round. With
+// tasks remaining, that means every remaining task waits behind an approval gate.
+func (*Runner) parallelIdle(mu *sync.RWMutex, activeCount int) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return activeCount == 0
+}
+
+// suspendParallel parks a parallel run whose remaining tasks wait on approval
+// gates. It reports false when the run should be finalized instead.
+func (r *Runner) suspendParallel(ctx context.Context, wf types.WorkflowMetadata, run *model.WorkflowRun, cancelHeartbeat context.CancelFunc, firstErr error, totalRemaining int, results map[string]string, mu *sync.RWMutex, input types.KV) bool {
+	if firstErr != nil || totalRemaining == 0 || !r.pendingApprovals() {
+		return false
+	}
+	r.suspendRun(ctx, wf.Name, run, cancelHeartbeat, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		r.saveParallelCheckpoint(ctx, results, &wf, input, run)
+	})
+	return true
+}
+
 // premarkCompletedTasksForResume decrements the in-degree of dependents
 // for all tasks that have already completed (recorded in the checkpoint).
 func (*Runner) premarkCompletedTasksForResume(cp CheckpointData, nodes map[string]*dagNode) {
@@ -537,6 +624,7 @@ func (r *Runner) runParallelResume(ctx context.Context, runID int64, wf types.Wo
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	go r.heartbeat(ctx, runID)
 
 	sem := make(chan struct{}, wf.MaxConcurrency)
 	var mu sync.RWMutex
@@ -555,6 +643,9 @@ func (r *Runner) runParallelResume(ctx context.Context, runID int64, wf types.Wo
 
 	for totalRemaining > 0 {
 		r.dispatchReadyTasks(ctx, wf, &ready, &activeCount, taskMap, nodes, input, &results, &mu, run, sem, &wg, done, &firstErr, &errOnce, cancel, r.runParallelResumeTaskHandler)
+		if r.parallelIdle(&mu, activeCount) {
+			break
+		}
 
 		select {
 		case <-ctx.Done():
@@ -568,6 +659,10 @@ func (r *Runner) runParallelResume(ctx context.Context, runID int64, wf types.Wo
 	}
 
 drain:
+	if r.suspendParallel(ctx, wf, run, nil, firstErr, totalRemaining, results, &mu, input) {
+		wg.Wait()
+		return nil
+	}
 	statusErr := r.finalizeParallelStatus(ctx, run, 0, firstErr)
 	wg.Wait()
 	return statusErr
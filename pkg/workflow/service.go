@@ -14,6 +14,7 @@ import (
 	"github.com/flowline-io/flowbot/pkg/flog"
 	"github.com/flowline-io/flowbot/pkg/metrics"
 	"github.com/flowline-io/flowbot/pkg/pipeline"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	fbtrace "github.com/flowline-io/flowbot/pkg/trace"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/audit"
@@ -42,9 +43,19 @@ type Service struct {
 	auditor audit.Auditor
 	metrics *metrics.WorkflowCollector
 
+	consumptions EventConsumptionStore
+
 	mu       sync.RWMutex
 	cron     *cron.Cron
 	webhooks map[string]*WebhookEndpoint // path -> endpoint
+	events   []eventTrigger
+
+	// active holds cancel funcs for runs executing in this process.
+	activeMu sync.Mutex
+	active   map[int64]context.CancelFunc
+
+	// approvalMu serializes approval decisions so a gate resumes its run once.
+	approvalMu sync.Mutex
 }
 
 // NewService creates a workflow Service.
@@ -55,21 +66,40 @@ func NewService(catalog Catalog, runs WorkflowRunStore, auditor audit.Auditor, w
 		auditor:  auditor,
 		metrics:  wc,
 		webhooks: make(map[string]*WebhookEndpoint),
+		active:   make(map[int64]context.CancelFunc),
 	}
 }
 
+// WithConsumptions sets the ledger used to dedup DataEvents for event triggers.
+// Without it, a redelivered event starts another run.
+func (s *Service) WithConsumptions(cs EventConsumptionStore) *Service {
+	s.consumptions = cs
+	return s
+}
+
 // StartRunAsync validates inputs, creates a run record, and executes the workflow in a goroutine.
 // It returns the new run ID immediately.
 func (s *Service) StartRunAsync(ctx context.Context, name, triggerType string, input types.KV) (int64, error) {
+	if triggerType == "" {
+		triggerType = "manual"
+	}
+	meta, run, input, err := s.createRun(ctx, name, triggerType, input)
+	if err != nil {
+		return 0, err
+	}
+	go s.executeRun(meta, run, triggerType, input)
+	return run.ID, nil
+}
+
+// createRun loads the named workflow, applies and validates input defaults
+// and creates its run record. It returns the definition, run and inputs.
+func (s *Service) createRun(ctx context.Context, name, triggerType string, input types.KV) (*types.WorkflowMetadata, *model.WorkflowRun, types.KV, error) {
 	if s == nil || s.catalog == nil {
-		return 0, types.Errorf(types.ErrUnavailable, "workflow service not ready")
+		return nil, nil, nil, types.Errorf(types.ErrUnavailable, "workflow service not ready")
 	}
 	name = strings.TrimSpace(name)
 	if name == "" {
-		return 0, types.Errorf(types.ErrInvalidArgument, "workflow name is required")
-	}
-	if triggerType == "" {
-		triggerType = "manual"
+		return nil, nil, nil, types.Errorf(types.ErrInvalidArgument, "workflow name is required")
 	}
 	if input == nil {
 		input = types.KV{}
@@ -77,34 +107,32 @@ func (s *Service) StartRunAsync(ctx context.Context, name, triggerType string, i
 
 	meta, err := s.catalog.GetMetadata(ctx, name)
 	if err != nil {
-		return 0, err
+		return nil, nil, nil, err
 	}
 	if meta == nil {
-		return 0, types.Errorf(types.ErrNotFound, "workflow %s", name)
+		return nil, nil, nil, types.Errorf(types.ErrNotFound, "workflow %s", name)
 	}
 	input = ApplyInputDefaults(meta.Inputs, input)
 	if err := ValidateInputs(meta.Inputs, input); err != nil {
-		return 0, types.WrapError(types.ErrInvalidArgument, "input validation failed", err)
+		return nil, nil, nil, types.WrapError(types.ErrInvalidArgument, "input validation failed", err)
 	}
 
 	workflowID, err := s.lookupWorkflowID(ctx, name)
 	if err != nil {
-		return 0, err
+		return nil, nil, nil, err
 	}
 
 	if s.runs == nil {
-		return 0, types.Errorf(types.ErrUnavailable, "workflow run store not ready")
+		return nil, nil, nil, types.Errorf(types.ErrUnavailable, "workflow run store not ready")
 	}
 	run, err := s.runs.CreateRun(ctx, workflowID, name, "db", triggerType, nil, map[string]any(input))
 	if err != nil {
-		return 0, fmt.Errorf("create workflow run: %w", err)
+		return nil, nil, nil, fmt.Errorf("create workflow run: %w", err)
 	}
 	if run == nil {
-		return 0, types.Errorf(types.ErrInternal, "create workflow run returned nil")
+		return nil, nil, nil, types.Errorf(types.ErrInternal, "create workflow run returned nil")
 	}
-
-	go s.executeRun(meta, run, triggerType, input)
-	return run.ID, nil
+	return meta, run, input, nil
 }
 
 func (s *Service) executeRun(meta *types.WorkflowMetadata, run *model.WorkflowRun, triggerType string, input types.KV) {
@@ -112,11 +140,10 @@ func (s *Service) executeRun(meta *types.WorkflowMetadata, run *model.WorkflowRu
 	defer asyncSpan.End()
 	ctx, cancel := fbtrace.DetachWithTimeout(asyncCtx, 10*time.Minute)
 	defer cancel()
+	ctx, untrack := s.trackRun(runcall.WithDepth(ctx, run.CallDepth), run.ID)
+	defer untrack()
 
-	runner := NewRunnerWithStore(s.runs, s.auditor, s.metrics, "db", triggerType).
-		WithDefinitionStore(s.catalog).
-		WithWorkflowID(runWorkflowID(run)).
-		WithExistingRun(run)
+	runner := s.newRunner(run, triggerType)
 	defer func() {
 		if cerr := runner.Close(); cerr != nil {
 			flog.Error(fmt.Errorf("workflow %s: close runner: %w", meta.Name, cerr))
@@ -128,6 +155,14 @@ func (s *Service) executeRun(meta *types.WorkflowMetadata, run *model.WorkflowRu
 	}
 }
 
+// newRunner returns a Runner that continues the already created run.
+func (s *Service) newRunner(run *model.WorkflowRun, triggerType string) *Runner {
+	return NewRunnerWithStore(s.runs, s.auditor, s.metrics, "db", triggerType).
+		WithDefinitionStore(s.catalog).
+		WithWorkflowID(runWorkflowID(run)).
+		WithExistingRun(run)
+}
+
 func runWorkflowID(run *model.WorkflowRun) int64 {
 	if run == nil || run.WorkflowID == nil {
 		return 0
@@ -217,7 +252,7 @@ func (s *Service) LookupWebhook(path string) (*WebhookEndpoint, bool) {
 	return ep, ok
 }
 
-// ReloadTriggers rebuilds cron jobs and webhook configs from enabled workflow definitions.
+// ReloadTriggers rebuilds cron jobs, webhook configs and event triggers from enabled workflow definitions.
 func (s *Service) ReloadTriggers(ctx context.Context) error {
 	if s == nil || s.catalog == nil {
 		return nil
@@ -227,7 +262,7 @@ func (s *Service) ReloadTriggers(ctx context.Context) error {
 		return fmt.Errorf("list workflows for trigger reload: %w", err)
 	}
 
-	webhooks, jobs := s.collectTriggers(ctx, defs)
+	set := s.collectTriggers(ctx, defs)
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -244,7 +279,7 @@ func (s *Service) ReloadTriggers(ctx context.Context) error {
 		s.cron.Start()
 	}
 
-	for _, job := range jobs {
+	for _, job := range set.jobs {
 		name := job.name
 		spec := job.spec
 		_, err := s.cron.AddFunc(spec, func(_ context.Context) error {
@@ -258,8 +293,9 @@ func (s *Service) ReloadTriggers(ctx context.Context) error {
 		flog.Info("workflow %s: registered cron trigger %q", name, spec)
 	}
 
-	s.webhooks = webhooks
-	flog.Info("workflow service reloaded triggers: %d cron, %d webhook", len(jobs), len(webhooks))
+	s.webhooks = set.webhooks
+	s.events = set.events
+	flog.Info("workflow service reloaded triggers: %d cron, %d webhook, %d event", len(set.jobs), len(set.webhooks), len(set.events))
 	return nil
 }
 
@@ -268,9 +304,14 @@ type cronJobSpec struct {
 	spec string
 }
 
-func (s *Service) collectTriggers(ctx context.Context, defs []*model.Workflow) (map[string]*WebhookEndpoint, []cronJobSpec) {
-	webhooks := make(map[string]*WebhookEndpoint)
-	var jobs []cronJobSpec
+type triggerSet struct {
+	webhooks map[string]*WebhookEndpoint
+	jobs     []cronJobSpec
+	events   []eventTrigger
+}
+
+func (s *Service) collectTriggers(ctx context.Context, defs []*model.Workflow) triggerSet {
+	set := triggerSet{webhooks: make(map[string]*WebhookEndpoint)}
 	for _, row := range defs {
 		if row == nil || !row.Enabled {
 			continue
@@ -280,23 +321,30 @@ func (s *Service) collectTriggers(ctx context.Context, defs []*model.Workflow) (
 			flog.Error(fmt.Errorf("workflow %s: load metadata for triggers: %w", row.Name, err))
 			continue
 		}
-		appendTriggerJobs(row.Name, meta.Triggers, webhooks, &jobs)
+		appendTriggerJobs(row.Name, meta, &set)
 	}
-	return webhooks, jobs
+	return set
 }
 
-func appendTriggerJobs(name string, triggers []types.WorkflowTriggerDef, webhooks map[string]*WebhookEndpoint, jobs *[]cronJobSpec) {
-	for _, tr := range triggers {
+func appendTriggerJobs(name string, meta *types.WorkflowMetadata, set *triggerSet) {
+	for _, tr := range meta.Triggers {
 		if !tr.Enabled {
 			continue
 		}
 		switch strings.ToLower(tr.Type) {
 		case "cron":
 			if job, ok := cronJobFromTrigger(name, tr); ok {
-				*jobs = append(*jobs, job)
+				set.jobs = append(set.jobs, job)
 			}
 		case "webhook":
-			registerWebhookTrigger(name, tr, webhooks)
+			registerWebhookTrigger(name, tr, set.webhooks)
+		case "event":
+			et, err := eventTriggerFromRule(name, meta.Inputs, tr.Rule)
+			if err != nil {
+				flog.Error(fmt.Errorf("workflow %s: %w", name, err))
+				continue
+			}
+			set.events = append(set.events, et)
 		}
 	}
 }
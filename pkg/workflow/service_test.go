@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/model"
 )
@@ -77,6 +78,9 @@ func (m *mockRunStore) CreateRun(_ context.Context, workflowID int64, workflowNa
 }
 
 func (*mockRunStore) UpdateRunStatus(context.Context, int64, int, string) error { return nil }
+func (*mockRunStore) SwapRunStatus(context.Context, int64, []int, int, string) (bool, error) {
+	return true, nil
+}
 func (*mockRunStore) CreateStepRun(context.Context, int64, string, string, string, string, map[string]any, int) (*model.WorkflowStepRun, error) {
 	return &model.WorkflowStepRun{ID: 1}, nil
 }
@@ -87,7 +91,11 @@ func (*mockRunStore) SaveCheckpoint(context.Context, int64, any) error { return
 func (*mockRunStore) GetIncompleteRuns(context.Context) ([]*model.WorkflowRun, error) {
 	return nil, nil
 }
-func (*mockRunStore) GetCheckpoint(context.Context, int64, any) error { return nil }
+func (*mockRunStore) GetWaitingRuns(context.Context) ([]*model.WorkflowRun, error) {
+	return nil, nil
+}
+func (*mockRunStore) SetRunParent(context.Context, int64, runcall.Parent) error { return nil }
+func (*mockRunStore) GetCheckpoint(context.Context, int64, any) error           { return nil }
 func (*mockRunStore) GetRun(context.Context, int64) (*model.WorkflowRun, error) {
 	return nil, errors.New("not found")
 }
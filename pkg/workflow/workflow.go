@@ -2,9 +2,11 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -14,8 +16,11 @@ import (
 	"github.com/flowline-io/flowbot/pkg/executor/runtime"
 	capabilityruntime "github.com/flowline-io/flowbot/pkg/executor/runtime/capability"
 	"github.com/flowline-io/flowbot/pkg/flog"
+	"github.com/flowline-io/flowbot/pkg/media"
 	"github.com/flowline-io/flowbot/pkg/metrics"
 	"github.com/flowline-io/flowbot/pkg/pipeline/template"
+	"github.com/flowline-io/flowbot/pkg/runapproval"
+	"github.com/flowline-io/flowbot/pkg/runcall"
 	"github.com/flowline-io/flowbot/pkg/types"
 	"github.com/flowline-io/flowbot/pkg/types/audit"
 	"github.com/flowline-io/flowbot/pkg/types/model"
@@ -23,12 +28,21 @@ import (
 
 var pooledSonic = sonic.Config{}.Froze()
 
+const (
+	heartbeatInterval = 30 * time.Second
+	// staleRunAfter is how long a running run may go without a heartbeat
+	// before another server treats it as abandoned.
+	staleRunAfter = 3 * heartbeatInterval
+)
+
 type ActionInfo struct {
 	Type         string
 	Details      string
 	IsCapability bool
 	CapType      string
 	Operation    string
+	// IsCall marks workflow:<name> and pipeline:<name> actions; Details is the name.
+	IsCall bool
 }
 
 func ParseAction(action string) ActionInfo {
@@ -55,6 +69,10 @@ func ParseAction(action string) ActionInfo {
 	if len(parts) > 1 {
 		info.Details = parts[1]
 	}
+	if info.Type == callWorkflowActionType || info.Type == callPipelineActionType {
+		info.IsCall = true
+		info.Details = strings.TrimSpace(info.Details)
+	}
 	return info
 }
 
@@ -75,6 +93,9 @@ func WorkflowTaskToTask(wt types.WorkflowTask) (*types.Task, error) {
 	}
 
 	applyActionParams(task, info, wt.Params)
+	for _, out := range wt.Outputs {
+		task.Outputs = append(task.Outputs, out.Path)
+	}
 	return task, nil
 }
 
@@ -154,6 +175,21 @@ type Runner struct {
 	workflowID   int64
 	triggerType  string
 	existingRun  *model.WorkflowRun
+	media        media.Handler
+
+	// artifacts holds the outputs published by completed tasks, keyed by task ID.
+	artifactsMu sync.RWMutex
+	artifacts   map[string][]types.WorkflowArtifact
+
+	// approvals holds the approval gates of this run, keyed by task ID;
+	// opened lists gates whose notification is still to be sent.
+	approvalsMu sync.Mutex
+	approvals   map[string]*runapproval.Request
+	opened      []openedApproval
+
+	// outputs are the task results of the last run that completed.
+	outputsMu sync.Mutex
+	outputs   map[string]string
 }
 
 // NewRunner creates a Runner without persistence. Use NewRunnerWithStore to enable run records.
@@ -280,9 +316,10 @@ func (r *Runner) executeWithRunRecord(ctx context.Context, wf types.WorkflowMeta
 		}
 	}
 
-	// Start heartbeat goroutine if resumable and store is available.
+	// Start heartbeat goroutine if store is available, so other servers can
+	// tell this run is still alive.
 	var cancelHeartbeat context.CancelFunc
-	if wf.Resumable && r.store != nil && run != nil {
+	if r.store != nil && run != nil {
 		var hbCtx context.Context
 		hbCtx, cancelHeartbeat = context.WithCancel(ctx)
 		go r.heartbeat(hbCtx, run.ID)
@@ -302,7 +339,9 @@ func (r *Runner) runSequential(ctx context.Context, wf types.WorkflowMetadata, i
 	defer func() {
 		if r.metrics != nil {
 			status := "done"
-			if runErr != nil {
+			if errors.Is(runErr, runapproval.ErrPending) {
+				status = "waiting"
+			} else if runErr != nil {
 				status = "failed"
 			}
 			r.metrics.IncRunTotal(wf.Name, status)
@@ -315,7 +354,15 @@ func (r *Runner) runSequential(ctx context.Context, wf types.WorkflowMetadata, i
 	for stepIndex, stepID := range wf.Pipeline {
 		saveCheckpoint(ctx, stepIndex, r, wf, results, input, run)
 
-		if err := r.executeSequentialStep(ctx, stepID, taskMap, wf, results, input, run); err != nil {
+		err := r.executeSequentialStep(ctx, stepID, taskMap, wf, results, input, run)
+		if errors.Is(err, runapproval.ErrPending) {
+			r.suspendRun(ctx, wf.Name, run, cancelHeartbeat, func() {
+				saveCheckpoint(ctx, stepIndex, r, wf, results, input, run)
+			})
+			runErr = err
+			return nil
+		}
+		if err != nil {
 			r.failRun(ctx, run, cancelHeartbeat, err)
 			r.auditWorkflowEvent(ctx, wf.Name, "workflow.fail")
 			runErr = err
@@ -330,10 +377,25 @@ func (r *Runner) runSequential(ctx context.Context, wf types.WorkflowMetadata, i
 		_ = r.store.UpdateRunStatus(workflowStoreCtx(ctx), run.ID, int(types.WorkflowRunDone), "")
 	}
 
+	r.setOutputs(results)
 	r.auditWorkflowEvent(ctx, wf.Name, "workflow.complete")
 	return nil
 }
 
+// Outputs returns the task results of the last run this Runner completed,
+// keyed by task ID; nil until a run completes.
+func (r *Runner) Outputs() map[string]string {
+	r.outputsMu.Lock()
+	defer r.outputsMu.Unlock()
+	return r.outputs
+}
+
+func (r *Runner) setOutputs(results map[string]string) {
+	r.outputsMu.Lock()
+	defer r.outputsMu.Unlock()
+	r.outputs = resultCopy(results)
+}
+
 // executeSequentialStep executes a single step of a sequential workflow.
 func (r *Runner) executeSequentialStep(
 	ctx context.Context,
@@ -349,12 +411,26 @@ func (r *Runner) executeSequentialStep(
 		return fmt.Errorf("task %s not found in workflow", stepID)
 	}
 
-	params, err := resolveParams(wt.Params, results, input)
+	params, err := r.resolveTaskParams(ctx, wt, results, input)
 	if err != nil {
 		return fmt.Errorf("resolve params step %s: %w", stepID, err)
 	}
 
 	info := ParseAction(wt.Action)
+	if info.Type == approvalActionType {
+		result, err := r.executeApprovalTask(ctx, stepID, wt, params, run)
+		if err == nil {
+			results[stepID] = result
+		}
+		return err
+	}
+	if info.IsCall {
+		result, err := r.executeCallTask(ctx, stepID, wt, params, info, run)
+		if err == nil {
+			results[stepID] = result
+		}
+		return err
+	}
 
 	var stepRun *model.WorkflowStepRun
 	if r.store != nil && run != nil {
@@ -426,6 +502,11 @@ func (r *Runner) executeSequentialExecutorStep(
 		r.failStep(ctx, stepRun, err, 1)
 		return err
 	}
+	if err := r.mountArtifacts(ctx, task, wt.Conn); err != nil {
+		err = fmt.Errorf("step %s: %w", stepID, err)
+		r.failStep(ctx, stepRun, err, 1)
+		return err
+	}
 
 	flog.Info("[workflow] running step %s: %s", stepID, wt.Action)
 	stepStart := time.Now()
@@ -454,16 +535,19 @@ func (r *Runner) executeSequentialExecutorStep(
 		}
 	}
 
+	artifacts, err := r.publishArtifacts(stepID, wt.Outputs, task.Artifacts)
+	if err != nil {
+		err = fmt.Errorf("step %s: %w", stepID, err)
+		r.failStep(ctx, stepRun, err, attempt)
+		return err
+	}
+
 	if task.Result != "" {
 		results[stepID] = task.Result
 	}
 
 	if r.store != nil && stepRun != nil {
-		result := map[string]any{}
-		if task.Result != "" {
-			result["result"] = task.Result
-		}
-		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), result, "", attempt)
+		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), stepResult(task, artifacts), "", attempt)
 	}
 
 	flog.Info("[workflow] step %s completed", stepID)
@@ -476,6 +560,8 @@ func saveCheckpoint(ctx context.Context, stepIndex int, r *Runner, wf types.Work
 		cp := CheckpointData{
 			StepIndex:   stepIndex,
 			StepResults: resultCopy(results),
+			Artifacts:   r.artifactSnapshot(),
+			Approvals:   r.approvalSnapshot(),
 			Input:       input,
 			HeartbeatAt: time.Now(),
 		}
@@ -502,7 +588,8 @@ func (r *Runner) ResumeWorkflow(ctx context.Context, runID int64) error {
 		return fmt.Errorf("get run %d: %w", runID, err)
 	}
 
-	if run.Status != int(types.WorkflowRunRunning) && run.Status != int(types.WorkflowRunFailed) {
+	if run.Status != int(types.WorkflowRunRunning) && run.Status != int(types.WorkflowRunFailed) &&
+		run.Status != int(types.WorkflowRunCancelled) && run.Status != int(types.WorkflowRunWaiting) {
 		return fmt.Errorf("workflow run %d status is %d, not resumable", runID, run.Status)
 	}
 
@@ -510,6 +597,8 @@ func (r *Runner) ResumeWorkflow(ctx context.Context, runID int64) error {
 	if err != nil {
 		return fmt.Errorf("load workflow definition %s: %w", run.WorkflowName, err)
 	}
+	// A resumed child run keeps its depth so its own calls stay bounded.
+	ctx = runcall.WithDepth(ctx, run.CallDepth)
 
 	if r.metrics != nil {
 		r.metrics.IncResume(wf.Name)
@@ -519,6 +608,8 @@ func (r *Runner) ResumeWorkflow(ctx context.Context, runID int64) error {
 	if err := r.store.GetCheckpoint(ctx, runID, &cp); err != nil {
 		return fmt.Errorf("get checkpoint for run %d: %w", runID, err)
 	}
+	r.restoreArtifacts(cp.Artifacts)
+	r.restoreApprovals(cp.Approvals)
 
 	// Parallel resume path.
 	if wf.MaxConcurrency > 1 {
@@ -533,18 +624,26 @@ func (r *Runner) ResumeWorkflow(ctx context.Context, runID int64) error {
 	results := resultCopy(cp.StepResults)
 	input := cp.Input
 
-	// Start heartbeat goroutine if resumable.
+	// Start heartbeat goroutine.
 	var cancelHeartbeat context.CancelFunc
-	if wf.Resumable {
+	if r.store != nil {
 		var hbCtx context.Context
 		hbCtx, cancelHeartbeat = context.WithCancel(ctx)
 		go r.heartbeat(hbCtx, runID)
 	}
 
-	// Skip already-completed steps and resume from checkpoint.
-	for i := cp.StepIndex + 1; i < len(wf.Pipeline); i++ {
+	// Checkpoints are saved before each step, so StepIndex is the step that
+	// did not complete; earlier steps are skipped.
+	for i := cp.StepIndex; i < len(wf.Pipeline); i++ {
 		stepID := wf.Pipeline[i]
-		if err := r.executeResumeStep(ctx, stepID, i, wf, taskMap, results, input, runID, run, cancelHeartbeat); err != nil {
+		err := r.executeResumeStep(ctx, stepID, i, wf, taskMap, results, input, runID, run, cancelHeartbeat)
+		if errors.Is(err, runapproval.ErrPending) {
+			r.suspendRun(ctx, wf.Name, run, cancelHeartbeat, func() {
+				saveCheckpoint(ctx, i, r, *wf, results, input, run)
+			})
+			return nil
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -580,6 +679,8 @@ func (r *Runner) executeResumeStep(
 		cpData := CheckpointData{
 			StepIndex:   index,
 			StepResults: resultCopy(results),
+			Artifacts:   r.artifactSnapshot(),
+			Approvals:   r.approvalSnapshot(),
 			Input:       input,
 			HeartbeatAt: time.Now(),
 		}
@@ -588,7 +689,7 @@ func (r *Runner) executeResumeStep(
 		}
 	}
 
-	params, err := resolveParams(wt.Params, results, input)
+	params, err := r.resolveTaskParams(ctx, wt, results, input)
 	if err != nil {
 		err = fmt.Errorf("resolve params step %s: %w", stepID, err)
 		r.failRun(ctx, run, cancelHeartbeat, err)
@@ -596,6 +697,24 @@ func (r *Runner) executeResumeStep(
 	}
 
 	info := ParseAction(wt.Action)
+	if info.Type == approvalActionType {
+		result, err := r.executeApprovalTask(ctx, stepID, wt, params, run)
+		if err == nil {
+			results[stepID] = result
+		} else if !errors.Is(err, runapproval.ErrPending) {
+			r.failRun(ctx, run, cancelHeartbeat, err)
+		}
+		return err
+	}
+	if info.IsCall {
+		result, err := r.executeCallTask(ctx, stepID, wt, params, info, run)
+		if err != nil {
+			r.failRun(ctx, run, cancelHeartbeat, err)
+			return err
+		}
+		results[stepID] = result
+		return nil
+	}
 
 	var stepRun *model.WorkflowStepRun
 	stepRun, err = r.store.CreateStepRun(workflowStoreCtx(ctx), runID, stepID, wt.Describe, wt.Action, info.Type, map[string]any(params), 1)
@@ -654,6 +773,12 @@ func (r *Runner) executeResumeExecutorStep(
 		r.failRun(ctx, run, cancelHeartbeat, err)
 		return err
 	}
+	if err := r.mountArtifacts(ctx, task, wt.Conn); err != nil {
+		err = fmt.Errorf("step %s: %w", stepID, err)
+		r.failStep(ctx, stepRun, err, 1)
+		r.failRun(ctx, run, cancelHeartbeat, err)
+		return err
+	}
 
 	attempt, rerr := r.runWithRetry(ctx, task, wt.Retry, stepID, stepRun)
 	if rerr != nil {
@@ -662,34 +787,59 @@ func (r *Runner) executeResumeExecutorStep(
 		return fmt.Errorf("step %s failed: %w", stepID, rerr)
 	}
 
+	artifacts, err := r.publishArtifacts(stepID, wt.Outputs, task.Artifacts)
+	if err != nil {
+		err = fmt.Errorf("step %s: %w", stepID, err)
+		r.failStep(ctx, stepRun, err, attempt)
+		r.failRun(ctx, run, cancelHeartbeat, err)
+		return err
+	}
+
 	if task.Result != "" {
 		results[stepID] = task.Result
 	}
 
 	if stepRun != nil {
-		result := map[string]any{}
-		if task.Result != "" {
-			result["result"] = task.Result
-		}
-		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), result, "", attempt)
+		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunDone), stepResult(task, artifacts), "", attempt)
 	}
 	return nil
 }
 
+// stepResult builds the persisted result of a completed executor step.
+func stepResult(task *types.Task, artifacts []types.WorkflowArtifact) map[string]any {
+	result := map[string]any{}
+	if task.Result != "" {
+		result["result"] = task.Result
+	}
+	if len(artifacts) > 0 {
+		result["artifacts"] = artifacts
+	}
+	return result
+}
+
 // failRun marks a workflow run as failed if run is non-nil and cancels the heartbeat.
 func (r *Runner) failRun(ctx context.Context, run *model.WorkflowRun, cancelHeartbeat context.CancelFunc, err error) {
 	if cancelHeartbeat != nil {
 		cancelHeartbeat()
 	}
 	if r.store != nil && run != nil {
-		_ = r.store.UpdateRunStatus(workflowStoreCtx(ctx), run.ID, int(types.WorkflowRunFailed), err.Error())
+		_ = r.store.UpdateRunStatus(workflowStoreCtx(ctx), run.ID, int(terminalRunStatus(err)), err.Error())
 	}
 }
 
+// terminalRunStatus records runs and steps stopped by context cancellation as
+// cancelled; every other error is a failure.
+func terminalRunStatus(err error) types.WorkflowRunState {
+	if errors.Is(err, context.Canceled) {
+		return types.WorkflowRunCancelled
+	}
+	return types.WorkflowRunFailed
+}
+
 // failStep marks a step run as failed if stepRun is non-nil.
 func (r *Runner) failStep(ctx context.Context, stepRun *model.WorkflowStepRun, err error, attempt int) {
 	if r.store != nil && stepRun != nil {
-		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(types.WorkflowRunFailed), nil, err.Error(), attempt)
+		_ = r.store.UpdateStepRun(workflowStoreCtx(ctx), stepRun.ID, int(terminalRunStatus(err)), nil, err.Error(), attempt)
 	}
 }
 
@@ -701,9 +851,9 @@ func workflowStoreCtx(ctx context.Context) context.Context {
 	return context.WithoutCancel(ctx)
 }
 
-// heartbeat periodically updates last_heartbeat for a resumable workflow run.
+// heartbeat periodically updates last_heartbeat for a workflow run.
 func (r *Runner) heartbeat(ctx context.Context, runID int64) {
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(heartbeatInterval)
 	defer ticker.Stop()
 	for {
 		select {
@@ -717,6 +867,20 @@ func (r *Runner) heartbeat(ctx context.Context, runID int64) {
 	}
 }
 
+// runStale reports whether no process has shown signs of executing run for
+// staleRunAfter. It uses the latest of the start time, the run heartbeat and
+// the checkpoint heartbeat, so cp may be nil.
+func runStale(run *model.WorkflowRun, cp *CheckpointData, now time.Time) bool {
+	last := run.StartedAt
+	if run.LastHeartbeat != nil && run.LastHeartbeat.After(last) {
+		last = *run.LastHeartbeat
+	}
+	if cp != nil && cp.HeartbeatAt.After(last) {
+		last = cp.HeartbeatAt
+	}
+	return now.Sub(last) > staleRunAfter
+}
+
 // resultCopy returns a shallow copy of the results map.
 func resultCopy(src map[string]string) map[string]string {
 	dst := make(map[string]string, len(src))
@@ -790,7 +954,9 @@ func ValidateDAG(tasks []types.WorkflowTask) error {
 
 var workflowEngine = template.New()
 
-func resolveParams(params types.KV, results map[string]string, input types.KV) (types.KV, error) {
+// renderParams renders params against step results; artifactURLs adds an
+// "artifacts" field (output name to signed URL) to the listed steps.
+func renderParams(params types.KV, results map[string]string, artifactURLs map[string]map[string]any, input types.KV) (types.KV, error) {
 	steps := make(map[string]map[string]any, len(results))
 	for stepID, result := range results {
 		steps[stepID] = map[string]any{
@@ -798,6 +964,12 @@ func resolveParams(params types.KV, results map[string]string, input types.KV) (
 			"result": result,
 		}
 	}
+	for stepID, urls := range artifactURLs {
+		if steps[stepID] == nil {
+			steps[stepID] = map[string]any{}
+		}
+		steps[stepID]["artifacts"] = urls
+	}
 
 	data := &template.TemplateData{
 		Steps: steps,
@@ -392,7 +392,7 @@ tasks:
 	}
 }
 
-func TestResolveParams(t *testing.T) {
+func TestRenderParams(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name        string
@@ -596,7 +596,7 @@ func TestResolveParams(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			resolved, err := resolveParams(tt.params, tt.results, tt.input)
+			resolved, err := renderParams(tt.params, tt.results, nil, tt.input)
 			if tt.wantErr {
 				require.Error(t, err)
 				if tt.errContains != "" {
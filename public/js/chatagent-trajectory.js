@@ -263,6 +263,9 @@
         (row.turn ? ' Turn ' + row.turn : '') +
         (row.kind === 'tool_call' && row.tool_name
           ? ' · ' + row.tool_name
+          : '') +
+        (row.model
+          ? ' · ' + row.model + (row.provider ? ' (' + row.provider + ')' : '')
           : '');
     }
     root.querySelectorAll('[data-inspector-tab]').forEach(function (btn) {
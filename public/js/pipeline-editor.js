@@ -561,6 +561,50 @@
         ).replace('{{.Value}}', val);
       },
 
+      // Returns the parsed trigger filter object, or null when empty or invalid.
+      parseTriggerFilter(t) {
+        var text = t && t.filterText ? t.filterText.trim() : '';
+        if (!text) return null;
+        try {
+          var obj = JSON.parse(text);
+          if (!obj || typeof obj !== 'object' || Array.isArray(obj)) return null;
+          return Object.keys(obj).length > 0 ? obj : null;
+        } catch {
+          return null;
+        }
+      },
+
+      triggerFilterLabel(t) {
+        var filter = this.parseTriggerFilter(t);
+        if (!filter) return '';
+        var parts = [];
+        ['app', 'source'].forEach(function (key) {
+          if (Array.isArray(filter[key]) && filter[key].length > 0) {
+            parts.push(key + '=' + filter[key].join('|'));
+          }
+        });
+        ['tags', 'data'].forEach(function (key) {
+          var m = filter[key];
+          if (!m || typeof m !== 'object') return;
+          Object.keys(m)
+            .sort()
+            .forEach(function (k) {
+              var v = m[k];
+              if (v === null || v === undefined) {
+                parts.push(key + '.' + k);
+              } else {
+                parts.push(
+                  key + '.' + k + '=' + (Array.isArray(v) ? v.join('|') : v),
+                );
+              }
+            });
+        });
+        return flowbotI18n(
+          'client.pipeline.trigger_filter_prefix',
+          'Filter: {{.Value}}',
+        ).replace('{{.Value}}', parts.join(' '));
+      },
+
       triggerCronLabel(t) {
         var val =
           t && t.cron
@@ -1156,12 +1200,16 @@
             event: t.event || '',
             cron: t.cron || '',
             webhook: normalizeWebhookConfig(t.webhook),
+            filterText: t.filter ? JSON.stringify(t.filter, null, 2) : '',
           }));
           this.steps = (obj.steps || []).map((s) => ({
             name: s.name || '',
             capability: s.capability || '',
             operation: s.operation || '',
             paramsText: JSON.stringify(s.params || {}, null, 2),
+            when: s.when || '',
+            onFailure: s.on_failure || [],
+            foreach: s.foreach || null,
           }));
           this.validate();
           this.validateSelectedNode();
@@ -1178,23 +1226,35 @@
           resumable: false,
           triggers: this.triggers.map((t) => {
             const e = { type: t.type, enabled: t.enabled };
-            if (t.type === 'event') e.event = t.event;
+            if (t.type === 'event') {
+              e.event = t.event;
+              var filter = this.parseTriggerFilter(t);
+              if (filter) e.filter = filter;
+            }
             if (t.type === 'cron') e.cron = t.cron;
             if (t.type === 'webhook') e.webhook = t.webhook;
             return e;
           }),
-          steps: this.steps.map((s) => ({
-            name: s.name,
-            capability: s.capability,
-            operation: s.operation,
-            params: (() => {
-              try {
-                return JSON.parse(s.paramsText || '{}');
-              } catch {
-                return {};
-              }
-            })(),
-          })),
+          steps: this.steps.map((s) => {
+            const step = {
+              name: s.name,
+              capability: s.capability,
+              operation: s.operation,
+              params: (() => {
+                try {
+                  return JSON.parse(s.paramsText || '{}');
+                } catch {
+                  return {};
+                }
+              })(),
+            };
+            if (s.when) step.when = s.when;
+            if (s.onFailure && s.onFailure.length > 0) {
+              step.on_failure = s.onFailure;
+            }
+            if (s.foreach && s.foreach.items) step.foreach = s.foreach;
+            return step;
+          }),
         };
         return jsyaml.dump(obj);
       },
@@ -1239,6 +1299,7 @@
           event: '',
           cron: '',
           webhook: normalizeWebhookConfig(null),
+          filterText: '',
         });
         this.markDirty();
       },
@@ -1305,6 +1366,9 @@
           capability: '',
           operation: '',
           paramsText: '{}',
+          when: '',
+          onFailure: [],
+          foreach: null,
         });
         this.markDirty();
         this.selectNode('step', afterIdx);
@@ -1580,6 +1644,19 @@
                 'Event type is required',
               ),
             });
+          if (
+            t.type === 'event' &&
+            t.filterText &&
+            t.filterText.trim() &&
+            !this.parseTriggerFilter(t)
+          )
+            this.errors.push({
+              node: { type: 'trigger', index: i },
+              message: flowbotI18n(
+                'client.pipeline.filter_invalid',
+                'Filter must be a JSON object',
+              ),
+            });
           if (t.type === 'webhook' && (!t.webhook || !t.webhook.path))
             this.errors.push({
               node: { type: 'trigger', index: i },
@@ -314,6 +314,7 @@
               running: 'badge-info',
               done: 'badge-success',
               failed: 'badge-error',
+              cancelled: 'badge-warning',
             }[this.runStatus] || 'badge-ghost'
           );
         },
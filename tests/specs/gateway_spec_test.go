@@ -145,7 +145,7 @@ var _ = Describe("Local CLI Gateway API", Label("gateway", "api"), func() {
 		})
 		Expect(err).NotTo(HaveOccurred())
 
-		claimed, err := gs.Claim(ctx, "lease-w1", time.Millisecond)
+		claimed, err := gs.Claim(ctx, "lease-w1", nil, time.Millisecond)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(claimed).NotTo(BeNil())
 		Expect(claimed.JobID).To(Equal(job.JobID))
@@ -172,4 +172,41 @@ var _ = Describe("Local CLI Gateway API", Label("gateway", "api"), func() {
 		Expect(again.Job.JobID).To(Equal(job.JobID))
 		Expect(again.Job.WorkerID).To(Equal("lease-w2"))
 	})
+
+	It("appends and lists streamed job logs", func() {
+		ctx := context.Background()
+		token := gatewayWorkerToken(ctx)
+		gs := store.GatewayStoreFromDB()
+
+		job, err := gs.Create(ctx, types.GatewayCreateJob{CLI: types.GatewayCLICursor, Prompt: "stream"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = gs.Claim(ctx, "logs-w1", nil, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, content := range []string{"line 1\n", "line 2\n"} {
+			body, _ := sonic.Marshal(types.GatewayLogAppendRequest{WorkerID: "logs-w1", Content: content})
+			resp, err := App.Test(gatewayRequest(http.MethodPost, "/gateway/v1/jobs/"+job.JobID+"/logs", token, body))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		}
+
+		listResp, err := App.Test(gatewayRequest(http.MethodGet, "/gateway/v1/jobs/"+job.JobID+"/logs?after=1", token, nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listResp.StatusCode).To(Equal(http.StatusOK))
+		var listOut protocol.Response
+		Expect(sonic.Unmarshal(ReadBody(listResp), &listOut)).To(Succeed())
+		raw, err := sonic.Marshal(listOut.Data)
+		Expect(err).NotTo(HaveOccurred())
+		var logs types.GatewayLogsResponse
+		Expect(sonic.Unmarshal(raw, &logs)).To(Succeed())
+		Expect(logs.Status).To(Equal(types.GatewayJobRunning))
+		Expect(logs.Chunks).To(HaveLen(1))
+		Expect(logs.Chunks[0].Seq).To(Equal(int64(2)))
+		Expect(logs.Chunks[0].Content).To(Equal("line 2\n"))
+
+		otherBody, _ := sonic.Marshal(types.GatewayLogAppendRequest{WorkerID: "logs-w2", Content: "x"})
+		otherResp, err := App.Test(gatewayRequest(http.MethodPost, "/gateway/v1/jobs/"+job.JobID+"/logs", token, otherBody))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(otherResp.StatusCode).To(Equal(http.StatusForbidden))
+	})
 })